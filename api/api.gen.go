@@ -20,6 +20,8 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
 	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/eventtype"
+	"github.com/openmeterio/openmeter/internal/subject"
 	"github.com/openmeterio/openmeter/pkg/models"
 )
 
@@ -32,6 +34,7 @@ const (
 
 // Defines values for LedgerEntryType.
 const (
+	AMENDMENT  LedgerEntryType = "AMENDMENT"
 	GRANT      LedgerEntryType = "GRANT"
 	GRANTUSAGE LedgerEntryType = "GRANT_USAGE"
 	RESET      LedgerEntryType = "RESET"
@@ -69,10 +72,15 @@ const (
 // CreateFeatureRequest A feature is a feature or service offered to a customer.
 // For example: CPU-Hours, Tokens, API Calls, etc.
 type CreateFeatureRequest struct {
-	// Archived If the feature is archived, it will not be used for grants or usage.
+	// Archived If the feature is archived, it cannot be granted. Existing grants of the feature are burned down until they expire or are voided,
+	// grant schedules of the feature skip their occurrences while the feature is archived.
+	// Occurrences are checked against the feature when they are issued, which may be later than they fall due:
+	// occurrences issued while the feature is archived are skipped even if they fell due before it was archived,
+	// and occurrences not yet issued when the feature is unarchived are issued.
 	Archived *bool `json:"archived,omitempty"`
 
 	// MeterGroupByFilters Optional meter group by filters. Useful if the meter scope is broader than what feature tracks.
+	// Active features of a meter must have the same filters or filters that differ in the value of a group by, overlapping filters are rejected.
 	MeterGroupByFilters *map[string]string `json:"meterGroupByFilters,omitempty"`
 
 	// MeterSlug The meter that the feature is associated with and decreases grants by usage.
@@ -80,6 +88,11 @@ type CreateFeatureRequest struct {
 
 	// Name The name of the feature.
 	Name string `json:"name"`
+
+	// UnitPrice The price of a unit of usage of the feature, it cannot be changed once the feature is created.
+	// Grants denominated in the currency of the price are burned down by the cost of the usage of the feature.
+	// The price is either a flat amount per unit or graduated tiers.
+	UnitPrice *UnitPrice `json:"unitPrice,omitempty"`
 }
 
 // CreateLedger A ledger represented in open meter. A ledger must be assigned to a single
@@ -94,12 +107,18 @@ type CreateLedgerGrantRequest struct {
 	// CreatedAt The time the grant was created.
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
-	// EffectiveAt The effective date.
+	// Currency The ISO 4217 currency the amount is denominated in, the amount is in units of usage if not set.
+	// Grants in a currency are burned down by the cost of the usage of features with a unit price in the currency.
+	// Grants with feature must be in the currency of the unit price of the feature.
+	Currency *string `json:"currency,omitempty"`
+
+	// EffectiveAt The effective time. Provided value will be ceiled to metering windowSize (minute).
 	EffectiveAt time.Time                    `json:"effectiveAt"`
 	Expiration  *LedgerGrantExpirationPeriod `json:"expiration,omitempty"`
 
 	// FeatureID The unique feature ULID that the grant is associated with, if any.
-	FeatureID string             `json:"featureID"`
+	// Grants without feature can be used by any feature, they are burned down after the grants of the feature.
+	FeatureID *string            `json:"featureID,omitempty"`
 	Metadata  *map[string]string `json:"metadata,omitempty"`
 
 	// ParentId The parent grant ULID that the grant is associated with, if any.
@@ -127,9 +146,16 @@ type CreateLedgerGrantRequest struct {
 // Event CloudEvents Specification JSON Schema
 type Event = event.Event
 
+// EventType An event type describes the data of events with a CloudEvents type with a JSON Schema.
+type EventType = eventtype.EventType
+
 // Feature defines model for Feature.
 type Feature struct {
-	// Archived If the feature is archived, it will not be used for grants or usage.
+	// Archived If the feature is archived, it cannot be granted. Existing grants of the feature are burned down until they expire or are voided,
+	// grant schedules of the feature skip their occurrences while the feature is archived.
+	// Occurrences are checked against the feature when they are issued, which may be later than they fall due:
+	// occurrences issued while the feature is archived are skipped even if they fell due before it was archived,
+	// and occurrences not yet issued when the feature is unarchived are issued.
 	Archived *bool `json:"archived,omitempty"`
 
 	// CreatedAt The time the feature was created.
@@ -138,7 +164,11 @@ type Feature struct {
 	// Id Readonly unique ULID identifier of the feature.
 	Id *string `json:"id,omitempty"`
 
+	// MeterGroupByFilterVersions The versions of the meter group by filters in the order they take effect, not set if the filters were never changed.
+	MeterGroupByFilterVersions *[]FeatureFilterVersion `json:"meterGroupByFilterVersions,omitempty"`
+
 	// MeterGroupByFilters Optional meter group by filters. Useful if the meter scope is broader than what feature tracks.
+	// Active features of a meter must have the same filters or filters that differ in the value of a group by, overlapping filters are rejected.
 	MeterGroupByFilters *map[string]string `json:"meterGroupByFilters,omitempty"`
 
 	// MeterSlug The meter that the feature is associated with and decreases grants by usage.
@@ -147,13 +177,22 @@ type Feature struct {
 	// Name The name of the feature.
 	Name string `json:"name"`
 
+	// UnitPrice The price of a unit of usage of the feature, it cannot be changed once the feature is created.
+	// Grants denominated in the currency of the price are burned down by the cost of the usage of the feature.
+	// The price is either a flat amount per unit or graduated tiers.
+	UnitPrice *UnitPrice `json:"unitPrice,omitempty"`
+
 	// UpdatedAt The time the feature was last updated.
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 }
 
 // FeatureBalance defines model for FeatureBalance.
 type FeatureBalance struct {
-	// Archived If the feature is archived, it will not be used for grants or usage.
+	// Archived If the feature is archived, it cannot be granted. Existing grants of the feature are burned down until they expire or are voided,
+	// grant schedules of the feature skip their occurrences while the feature is archived.
+	// Occurrences are checked against the feature when they are issued, which may be later than they fall due:
+	// occurrences issued while the feature is archived are skipped even if they fell due before it was archived,
+	// and occurrences not yet issued when the feature is unarchived are issued.
 	Archived *bool `json:"archived,omitempty"`
 
 	// Balance The balance of the feature.
@@ -165,22 +204,51 @@ type FeatureBalance struct {
 	// Id Readonly unique ULID identifier of the feature.
 	Id *string `json:"id,omitempty"`
 
+	// MeterGroupByFilterVersions The versions of the meter group by filters in the order they take effect, not set if the filters were never changed.
+	MeterGroupByFilterVersions *[]FeatureFilterVersion `json:"meterGroupByFilterVersions,omitempty"`
+
 	// MeterGroupByFilters Optional meter group by filters. Useful if the meter scope is broader than what feature tracks.
+	// Active features of a meter must have the same filters or filters that differ in the value of a group by, overlapping filters are rejected.
 	MeterGroupByFilters *map[string]string `json:"meterGroupByFilters,omitempty"`
 
 	// MeterSlug The meter that the feature is associated with and decreases grants by usage.
 	MeterSlug string `json:"meterSlug"`
 
+	// MonetaryUsage The usage of a feature paid with grants denominated in the currency of the unit price of the feature since the last reset.
+	// Set for features grants in a currency pay for, the units are part of the usage of the feature.
+	MonetaryUsage *MonetaryUsage `json:"monetaryUsage,omitempty"`
+
 	// Name The name of the feature.
 	Name string `json:"name"`
 
+	// Overage The usage of the feature not covered by grants within the overage limits.
+	Overage float64 `json:"overage"`
+
+	// UnitPrice The price of a unit of usage of the feature, it cannot be changed once the feature is created.
+	// Grants denominated in the currency of the price are burned down by the cost of the usage of the feature.
+	// The price is either a flat amount per unit or graduated tiers.
+	UnitPrice *UnitPrice `json:"unitPrice,omitempty"`
+
 	// UpdatedAt The time the feature was last updated.
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 
-	// Usage The usage of the feature.
+	// Usage The usage of the feature covered by grants.
 	Usage float64 `json:"usage"`
 }
 
+// FeatureFilterVersion The meter group by filters of a feature in effect from a time on.
+type FeatureFilterVersion = credit.FeatureFilterVersion
+
+// Freshness How complete the stored usage data of the namespace is.
+type Freshness struct {
+	// CompleteUntil Usage data is complete until this time, it is the earliest of the partitions.
+	// Not set if no progress was reported yet.
+	CompleteUntil *time.Time `json:"completeUntil,omitempty"`
+
+	// Partitions The progress of storing events per partition of the events topic.
+	Partitions []PartitionFreshness `json:"partitions"`
+}
+
 // IdOrSlug A unique identifier.
 type IdOrSlug = string
 
@@ -199,6 +267,13 @@ type LedgerAlreadyExistsProblem = credit.LedgerAlreadyExistsProblemResponse
 
 // LedgerBalance Balance of a subject.
 type LedgerBalance struct {
+	// CompleteUntil Usage data the balance is calculated from is complete until this time.
+	// Not set if the freshness of usage data is unknown.
+	CompleteUntil *time.Time `json:"completeUntil,omitempty"`
+
+	// CurrentPeriod The usage period the balance is calculated in if the ledger has a usage period.
+	CurrentPeriod *Period `json:"currentPeriod,omitempty"`
+
 	// FeatureBalances Features with balances.
 	FeatureBalances []FeatureBalance `json:"featureBalances"`
 
@@ -209,16 +284,26 @@ type LedgerBalance struct {
 	LastReset *time.Time         `json:"lastReset,omitempty"`
 	Metadata  *map[string]string `json:"metadata,omitempty"`
 
+	// Overage The total usage not covered by grants within the overage limits.
+	Overage float64 `json:"overage"`
+
 	// Subject The subject of the ledger.
 	Subject string `json:"subject"`
 }
 
+// LedgerEntitlement Entitlement of a subject to a feature.
+type LedgerEntitlement = credit.Entitlement
+
 // LedgerEntry A ledger entry.
 type LedgerEntry struct {
 	// Amount The amount to apply. Can be positive or negative number. If applicable.
+	// The amount is in the currency of the grant if the grant is denominated in a currency, in units of usage otherwise.
 	Amount float64 `json:"amount"`
 
-	// FeatureID The unique feature ULID that the entry is associated with, if any.
+	// Currency The ISO 4217 currency of the amount, if the grant is denominated in a currency.
+	Currency *string `json:"currency,omitempty"`
+
+	// FeatureID The unique feature ULID that the entry is associated with.
 	FeatureID string `json:"featureID"`
 
 	// Id Readonly unique ULID identifier of the ledger entry.
@@ -230,11 +315,17 @@ type LedgerEntry struct {
 	// Time The time the ledger entry was created.
 	Time time.Time       `json:"time"`
 	Type LedgerEntryType `json:"type"`
+
+	// Units The units of usage the amount paid for, set for the usage of grants denominated in a currency.
+	Units *float64 `json:"units,omitempty"`
 }
 
 // LedgerEntryType defines model for LedgerEntryType.
 type LedgerEntryType string
 
+// LedgerGrantAmendment Amendments change the terms of a grant from their effective time, at least one of the terms must be changed.
+type LedgerGrantAmendment = credit.GrantAmendment
+
 // LedgerGrantBalance defines model for LedgerGrantBalance.
 type LedgerGrantBalance struct {
 	// Amount The amount to grant. Can be positive or negative number.
@@ -246,7 +337,12 @@ type LedgerGrantBalance struct {
 	// CreatedAt The time the grant was created.
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
-	// EffectiveAt The effective date.
+	// Currency The ISO 4217 currency the amount is denominated in, the amount is in units of usage if not set.
+	// Grants in a currency are burned down by the cost of the usage of features with a unit price in the currency.
+	// Grants with feature must be in the currency of the unit price of the feature.
+	Currency *string `json:"currency,omitempty"`
+
+	// EffectiveAt The effective time. Provided value will be ceiled to metering windowSize (minute).
 	EffectiveAt time.Time                    `json:"effectiveAt"`
 	Expiration  *LedgerGrantExpirationPeriod `json:"expiration,omitempty"`
 
@@ -254,7 +350,8 @@ type LedgerGrantBalance struct {
 	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 
 	// FeatureID The unique feature ULID that the grant is associated with, if any.
-	FeatureID string `json:"featureID"`
+	// Grants without feature can be used by any feature, they are burned down after the grants of the feature.
+	FeatureID *string `json:"featureID,omitempty"`
 
 	// Id Readonly unique ULID identifier of the grant.
 	Id *string `json:"id,omitempty"`
@@ -277,6 +374,9 @@ type LedgerGrantBalance struct {
 	// Rollover Grant rollover configuration.
 	Rollover *LedgerGrantRollover `json:"rollover,omitempty"`
 
+	// ScheduleId The grant schedule ULID that issued the grant, if any.
+	ScheduleId *string `json:"scheduleId,omitempty"`
+
 	// Type The grant type:
 	// - `USAGE` - Increase balance by the amount in the unit of the associated meter.
 	Type LedgerGrantType `json:"type"`
@@ -308,7 +408,12 @@ type LedgerGrantResponse struct {
 	// CreatedAt The time the grant was created.
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
-	// EffectiveAt The effective date.
+	// Currency The ISO 4217 currency the amount is denominated in, the amount is in units of usage if not set.
+	// Grants in a currency are burned down by the cost of the usage of features with a unit price in the currency.
+	// Grants with feature must be in the currency of the unit price of the feature.
+	Currency *string `json:"currency,omitempty"`
+
+	// EffectiveAt The effective time. Provided value will be ceiled to metering windowSize (minute).
 	EffectiveAt time.Time                    `json:"effectiveAt"`
 	Expiration  *LedgerGrantExpirationPeriod `json:"expiration,omitempty"`
 
@@ -316,7 +421,8 @@ type LedgerGrantResponse struct {
 	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 
 	// FeatureID The unique feature ULID that the grant is associated with, if any.
-	FeatureID string `json:"featureID"`
+	// Grants without feature can be used by any feature, they are burned down after the grants of the feature.
+	FeatureID *string `json:"featureID,omitempty"`
 
 	// Id Readonly unique ULID identifier of the grant.
 	Id *string `json:"id,omitempty"`
@@ -339,6 +445,9 @@ type LedgerGrantResponse struct {
 	// Rollover Grant rollover configuration.
 	Rollover *LedgerGrantRollover `json:"rollover,omitempty"`
 
+	// ScheduleId The grant schedule ULID that issued the grant, if any.
+	ScheduleId *string `json:"scheduleId,omitempty"`
+
 	// Type The grant type:
 	// - `USAGE` - Increase balance by the amount in the unit of the associated meter.
 	Type LedgerGrantType `json:"type"`
@@ -358,13 +467,33 @@ type LedgerGrantRollover = credit.GrantRollover
 // - `ORIGINAL_AMOUNT` - Rollover re-applies the full grant amount.
 type LedgerGrantRolloverType = credit.GrantRolloverType
 
+// LedgerGrantSchedule Grant schedules issue a grant on a recurring schedule, for example the monthly allowance of a subscription.
+type LedgerGrantSchedule = credit.GrantSchedule
+
 // LedgerGrantType The grant type:
 // - `USAGE` - Increase balance by the amount in the unit of the associated meter.
 type LedgerGrantType string
 
+// LedgerNotificationDelivery The delivery of a notification event to a webhook.
+type LedgerNotificationDelivery = credit.NotificationDelivery
+
+// LedgerNotificationEvent The payload sent to the webhook when a notification rule is triggered.
+type LedgerNotificationEvent = credit.NotificationEvent
+
+// LedgerNotificationRule Notification rules notify a webhook when the balance of a ledger crosses a threshold.
+// The balance watched is the remaining balance of the grants of the feature, or of all grants of the ledger if no feature is set.
+type LedgerNotificationRule = credit.NotificationRule
+
+// LedgerOverageLimits Overage limits of a ledger. Overage is usage not covered by any grant.
+// Features without a limit of their own and without a ledger limit do not allow overage.
+type LedgerOverageLimits = credit.OverageLimits
+
 // LedgerReset Ledger reset configuration.
 type LedgerReset = credit.Reset
 
+// LedgerUsagePeriod Recurrence of grant schedules and usage periods.
+type LedgerUsagePeriod = Recurrence
+
 // Meter A meter is a configuration that defines how to match and aggregate events.
 type Meter = models.Meter
 
@@ -373,9 +502,12 @@ type MeterAggregation = models.MeterAggregation
 
 // MeterQueryResult The result of a meter query.
 type MeterQueryResult struct {
-	Data []MeterQueryRow `json:"data"`
-	From *time.Time      `json:"from,omitempty"`
-	To   *time.Time      `json:"to,omitempty"`
+	// CompleteUntil Usage data is complete until this time, later windows may still miss events.
+	// Not set if the freshness of usage data is unknown.
+	CompleteUntil *time.Time      `json:"completeUntil,omitempty"`
+	Data          []MeterQueryRow `json:"data"`
+	From          *time.Time      `json:"from,omitempty"`
+	To            *time.Time      `json:"to,omitempty"`
 
 	// WindowSize Aggregation window size.
 	WindowSize *WindowSize `json:"windowSize,omitempty"`
@@ -384,6 +516,21 @@ type MeterQueryResult struct {
 // MeterQueryRow A row in the result of a meter query.
 type MeterQueryRow = models.MeterQueryRow
 
+// MonetaryUsage The usage of a feature paid with grants denominated in the currency of the unit price of the feature since the last reset.
+// Set for features grants in a currency pay for, the units are part of the usage of the feature.
+type MonetaryUsage = credit.MonetaryUsage
+
+// PartitionFreshness The progress of storing events of a partition of the events topic.
+type PartitionFreshness struct {
+	// CompleteUntil Events of the partition are stored until this time.
+	CompleteUntil time.Time `json:"completeUntil"`
+
+	// Offset The offset of the next event to store.
+	Offset    int64     `json:"offset"`
+	Partition int32     `json:"partition"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 // Period A time period
 type Period struct {
 	// From Period start time where the amount was applied. If applicable.
@@ -407,10 +554,16 @@ type PortalToken struct {
 	Token *string `json:"token,omitempty"`
 }
 
+// PriceTier A tier of a graduated unit price.
+type PriceTier = credit.PriceTier
+
 // Problem A Problem Details object (RFC 7807).
 // Additional properties specific to the problem type may be present.
 type Problem = models.StatusProblem
 
+// Recurrence Recurrence of grant schedules and usage periods.
+type Recurrence = credit.Recurrence
+
 // Subject A subject is a unique identifier for a user or entity.
 type Subject struct {
 	CurrentPeriodEnd   *time.Time              `json:"currentPeriodEnd"`
@@ -422,21 +575,41 @@ type Subject struct {
 	StripeCustomerId   *string                 `json:"stripeCustomerId"`
 }
 
+// SubjectAlias A subject alias maps a subject sent by a source to a canonical subject.
+type SubjectAlias = subject.Alias
+
+// UnitPrice The price of a unit of usage of the feature, it cannot be changed once the feature is created.
+// Grants denominated in the currency of the price are burned down by the cost of the usage of the feature.
+// The price is either a flat amount per unit or graduated tiers.
+type UnitPrice = credit.UnitPrice
+
+// UpdateFeatureRequest Update of a feature, fields not set are left unchanged.
+type UpdateFeatureRequest = credit.FeatureUpdate
+
 // WindowSize Aggregation window size.
 type WindowSize = models.WindowSize
 
+// EventTypeName defines model for eventTypeName.
+type EventTypeName = string
+
 // FeatureID defines model for featureID.
 type FeatureID = credit.FeatureID
 
 // LedgerGrantID defines model for ledgerGrantID.
 type LedgerGrantID = credit.GrantID
 
+// LedgerGrantScheduleID defines model for ledgerGrantScheduleID.
+type LedgerGrantScheduleID = credit.GrantScheduleID
+
 // LedgerID defines model for ledgerID.
 type LedgerID = credit.LedgerID
 
 // LedgerIncludeVoids defines model for ledgerIncludeVoids.
 type LedgerIncludeVoids = bool
 
+// LedgerNotificationRuleID defines model for ledgerNotificationRuleID.
+type LedgerNotificationRuleID = credit.NotificationRuleID
+
 // LedgerQueryLimit defines model for ledgerQueryLimit.
 type LedgerQueryLimit = int
 
@@ -446,6 +619,9 @@ type LedgerQueryOffset = int
 // MeterIdOrSlug A unique identifier.
 type MeterIdOrSlug = IdOrSlug
 
+// QueryExcludeIncomplete defines model for queryExcludeIncomplete.
+type QueryExcludeIncomplete = bool
+
 // QueryFilterGroupBy Simple filter for group bys with exact match.
 //
 // Usage: `?filterGroupBy[type]=input&filterGroupBy[model]=gpt-4`
@@ -472,6 +648,9 @@ type QueryWindowSize = WindowSize
 // QueryWindowTimeZone defines model for queryWindowTimeZone.
 type QueryWindowTimeZone = string
 
+// SubjectAliasID defines model for subjectAliasID.
+type SubjectAliasID = subject.AliasID
+
 // SubjectIdOrKey defines model for subjectIdOrKey.
 type SubjectIdOrKey = string
 
@@ -490,6 +669,10 @@ type NotFoundProblemResponse = Problem
 // Additional properties specific to the problem type may be present.
 type NotImplementedProblemResponse = Problem
 
+// TooManyRequestsProblemResponse A Problem Details object (RFC 7807).
+// Additional properties specific to the problem type may be present.
+type TooManyRequestsProblemResponse = Problem
+
 // UnauthorizedProblemResponse A Problem Details object (RFC 7807).
 // Additional properties specific to the problem type may be present.
 type UnauthorizedProblemResponse = Problem
@@ -554,6 +737,15 @@ type ListLedgersParams struct {
 // ListLedgersParamsOrderBy defines parameters for ListLedgers.
 type ListLedgersParamsOrderBy string
 
+// CheckLedgerEntitlementParams defines parameters for CheckLedgerEntitlement.
+type CheckLedgerEntitlementParams struct {
+	// Subject The subject to check.
+	Subject string `form:"subject" json:"subject"`
+
+	// FeatureID The feature to check.
+	FeatureID string `form:"featureID" json:"featureID"`
+}
+
 // ListLedgerGrantsParams defines parameters for ListLedgerGrants.
 type ListLedgerGrantsParams struct {
 	// LedgerID Filtering and group by multiple subjects.
@@ -598,6 +790,12 @@ type GetLedgerHistoryParams struct {
 	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// ListLedgerNotificationDeliveriesParams defines parameters for ListLedgerNotificationDeliveries.
+type ListLedgerNotificationDeliveriesParams struct {
+	// Limit Number of entries to return
+	Limit *LedgerQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // QueryMeterParams defines parameters for QueryMeter.
 type QueryMeterParams struct {
 	// From Start date-time in RFC 3339 format.
@@ -624,6 +822,9 @@ type QueryMeterParams struct {
 	// GroupBy If not specified a single aggregate will be returned for each subject and time window.
 	// `subject` is a reserved group by value.
 	GroupBy *QueryGroupBy `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+
+	// ExcludeIncomplete Exclude windows of usage data that may still miss events, `to` is limited to the time usage data is complete until.
+	ExcludeIncomplete *QueryExcludeIncomplete `form:"excludeIncomplete,omitempty" json:"excludeIncomplete,omitempty"`
 }
 
 // QueryPortalMeterParams defines parameters for QueryPortalMeter.
@@ -667,6 +868,9 @@ type InvalidatePortalTokensJSONBody struct {
 // UpsertSubjectJSONBody defines parameters for UpsertSubject.
 type UpsertSubjectJSONBody = []Subject
 
+// UpsertEventTypeJSONRequestBody defines body for UpsertEventType for application/json ContentType.
+type UpsertEventTypeJSONRequestBody = EventType
+
 // IngestEventsApplicationCloudeventsPlusJSONRequestBody defines body for IngestEvents for application/cloudevents+json ContentType.
 type IngestEventsApplicationCloudeventsPlusJSONRequestBody = Event
 
@@ -676,15 +880,33 @@ type IngestEventsApplicationCloudeventsBatchPlusJSONRequestBody = IngestEventsAp
 // CreateFeatureJSONRequestBody defines body for CreateFeature for application/json ContentType.
 type CreateFeatureJSONRequestBody = CreateFeatureRequest
 
+// UpdateFeatureJSONRequestBody defines body for UpdateFeature for application/json ContentType.
+type UpdateFeatureJSONRequestBody = UpdateFeatureRequest
+
 // CreateLedgerJSONRequestBody defines body for CreateLedger for application/json ContentType.
 type CreateLedgerJSONRequestBody = CreateLedger
 
+// CreateLedgerGrantScheduleJSONRequestBody defines body for CreateLedgerGrantSchedule for application/json ContentType.
+type CreateLedgerGrantScheduleJSONRequestBody = LedgerGrantSchedule
+
 // CreateLedgerGrantJSONRequestBody defines body for CreateLedgerGrant for application/json ContentType.
 type CreateLedgerGrantJSONRequestBody = CreateLedgerGrantRequest
 
+// AmendLedgerGrantJSONRequestBody defines body for AmendLedgerGrant for application/json ContentType.
+type AmendLedgerGrantJSONRequestBody = LedgerGrantAmendment
+
+// CreateLedgerNotificationRuleJSONRequestBody defines body for CreateLedgerNotificationRule for application/json ContentType.
+type CreateLedgerNotificationRuleJSONRequestBody = LedgerNotificationRule
+
+// UpdateLedgerOverageLimitsJSONRequestBody defines body for UpdateLedgerOverageLimits for application/json ContentType.
+type UpdateLedgerOverageLimitsJSONRequestBody = LedgerOverageLimits
+
 // ResetLedgerJSONRequestBody defines body for ResetLedger for application/json ContentType.
 type ResetLedgerJSONRequestBody = LedgerReset
 
+// UpdateLedgerUsagePeriodJSONRequestBody defines body for UpdateLedgerUsagePeriod for application/json ContentType.
+type UpdateLedgerUsagePeriodJSONRequestBody = LedgerUsagePeriod
+
 // CreateMeterJSONRequestBody defines body for CreateMeter for application/json ContentType.
 type CreateMeterJSONRequestBody = Meter
 
@@ -694,11 +916,26 @@ type CreatePortalTokenJSONRequestBody = PortalToken
 // InvalidatePortalTokensJSONRequestBody defines body for InvalidatePortalTokens for application/json ContentType.
 type InvalidatePortalTokensJSONRequestBody InvalidatePortalTokensJSONBody
 
+// UpsertSubjectAliasJSONRequestBody defines body for UpsertSubjectAlias for application/json ContentType.
+type UpsertSubjectAliasJSONRequestBody = SubjectAlias
+
 // UpsertSubjectJSONRequestBody defines body for UpsertSubject for application/json ContentType.
 type UpsertSubjectJSONRequestBody = UpsertSubjectJSONBody
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List event types
+	// (GET /api/v1/event-types)
+	ListEventTypes(w http.ResponseWriter, r *http.Request)
+	// Upsert event type
+	// (POST /api/v1/event-types)
+	UpsertEventType(w http.ResponseWriter, r *http.Request)
+	// Delete event type
+	// (DELETE /api/v1/event-types/{eventType})
+	DeleteEventType(w http.ResponseWriter, r *http.Request, eventType EventTypeName)
+	// Get event type
+	// (GET /api/v1/event-types/{eventType})
+	GetEventType(w http.ResponseWriter, r *http.Request, eventType EventTypeName)
 	// List ingested events
 	// (GET /api/v1/events)
 	ListEvents(w http.ResponseWriter, r *http.Request, params ListEventsParams)
@@ -717,18 +954,39 @@ type ServerInterface interface {
 	// Get feature
 	// (GET /api/v1/features/{featureID})
 	GetFeature(w http.ResponseWriter, r *http.Request, featureID FeatureID)
+	// Update feature
+	// (PATCH /api/v1/features/{featureID})
+	UpdateFeature(w http.ResponseWriter, r *http.Request, featureID FeatureID)
+	// Get usage data freshness
+	// (GET /api/v1/freshness)
+	GetFreshness(w http.ResponseWriter, r *http.Request)
 	// List the already defined ledgers.
 	// (GET /api/v1/ledgers)
 	ListLedgers(w http.ResponseWriter, r *http.Request, params ListLedgersParams)
 	// Creates the specified ledger
 	// (POST /api/v1/ledgers)
 	CreateLedger(w http.ResponseWriter, r *http.Request)
+	// Check the entitlement of a subject to a feature.
+	// (GET /api/v1/ledgers/entitlement)
+	CheckLedgerEntitlement(w http.ResponseWriter, r *http.Request, params CheckLedgerEntitlementParams)
 	// List grants for multiple ledgers.
 	// (GET /api/v1/ledgers/grants)
 	ListLedgerGrants(w http.ResponseWriter, r *http.Request, params ListLedgerGrantsParams)
 	// Get the balance of a specific subject.
 	// (GET /api/v1/ledgers/{ledgerID}/balance)
 	GetLedgerBalance(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, params GetLedgerBalanceParams)
+	// List grant schedules
+	// (GET /api/v1/ledgers/{ledgerID}/grant-schedules)
+	ListLedgerGrantSchedules(w http.ResponseWriter, r *http.Request, ledgerID LedgerID)
+	// Create a grant schedule on a specific ledger.
+	// (POST /api/v1/ledgers/{ledgerID}/grant-schedules)
+	CreateLedgerGrantSchedule(w http.ResponseWriter, r *http.Request, ledgerID LedgerID)
+	// Delete grant schedule
+	// (DELETE /api/v1/ledgers/{ledgerID}/grant-schedules/{ledgerGrantScheduleID})
+	DeleteLedgerGrantSchedule(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID)
+	// Get a single grant schedule.
+	// (GET /api/v1/ledgers/{ledgerID}/grant-schedules/{ledgerGrantScheduleID})
+	GetLedgerGrantSchedule(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID)
 	// List ledger grants
 	// (GET /api/v1/ledgers/{ledgerID}/grants)
 	ListLedgerGrantsByLedger(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, params ListLedgerGrantsByLedgerParams)
@@ -741,12 +999,42 @@ type ServerInterface interface {
 	// Get a single grant.
 	// (GET /api/v1/ledgers/{ledgerID}/grants/{ledgerGrantID})
 	GetLedgerGrant(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerGrantID LedgerGrantID)
+	// List grant amendments
+	// (GET /api/v1/ledgers/{ledgerID}/grants/{ledgerGrantID}/amendments)
+	ListLedgerGrantAmendments(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerGrantID LedgerGrantID)
+	// Amend grant
+	// (POST /api/v1/ledgers/{ledgerID}/grants/{ledgerGrantID}/amendments)
+	AmendLedgerGrant(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerGrantID LedgerGrantID)
 	// Get the history of a ledger
 	// (GET /api/v1/ledgers/{ledgerID}/history)
 	GetLedgerHistory(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, params GetLedgerHistoryParams)
+	// List notification rules
+	// (GET /api/v1/ledgers/{ledgerID}/notification-rules)
+	ListLedgerNotificationRules(w http.ResponseWriter, r *http.Request, ledgerID LedgerID)
+	// Create a notification rule on a specific ledger.
+	// (POST /api/v1/ledgers/{ledgerID}/notification-rules)
+	CreateLedgerNotificationRule(w http.ResponseWriter, r *http.Request, ledgerID LedgerID)
+	// Delete notification rule
+	// (DELETE /api/v1/ledgers/{ledgerID}/notification-rules/{ledgerNotificationRuleID})
+	DeleteLedgerNotificationRule(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID)
+	// Get a single notification rule.
+	// (GET /api/v1/ledgers/{ledgerID}/notification-rules/{ledgerNotificationRuleID})
+	GetLedgerNotificationRule(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID)
+	// List notification deliveries
+	// (GET /api/v1/ledgers/{ledgerID}/notification-rules/{ledgerNotificationRuleID}/deliveries)
+	ListLedgerNotificationDeliveries(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, params ListLedgerNotificationDeliveriesParams)
+	// Update the overage limits of a ledger
+	// (PUT /api/v1/ledgers/{ledgerID}/overage-limits)
+	UpdateLedgerOverageLimits(w http.ResponseWriter, r *http.Request, ledgerID LedgerID)
 	// Reset the ledger's balance
 	// (POST /api/v1/ledgers/{ledgerID}/reset)
 	ResetLedger(w http.ResponseWriter, r *http.Request, ledgerID LedgerID)
+	// Delete the usage period of a ledger
+	// (DELETE /api/v1/ledgers/{ledgerID}/usage-period)
+	DeleteLedgerUsagePeriod(w http.ResponseWriter, r *http.Request, ledgerID LedgerID)
+	// Update the usage period of a ledger
+	// (PUT /api/v1/ledgers/{ledgerID}/usage-period)
+	UpdateLedgerUsagePeriod(w http.ResponseWriter, r *http.Request, ledgerID LedgerID)
 	// List meters
 	// (GET /api/v1/meters)
 	ListMeters(w http.ResponseWriter, r *http.Request)
@@ -777,6 +1065,18 @@ type ServerInterface interface {
 	// ☁ Invalidate portal tokens
 	// (POST /api/v1/portal/tokens/invalidate)
 	InvalidatePortalTokens(w http.ResponseWriter, r *http.Request)
+	// List subject aliases
+	// (GET /api/v1/subject-aliases)
+	ListSubjectAliases(w http.ResponseWriter, r *http.Request)
+	// Upsert subject alias
+	// (POST /api/v1/subject-aliases)
+	UpsertSubjectAlias(w http.ResponseWriter, r *http.Request)
+	// Delete subject alias
+	// (DELETE /api/v1/subject-aliases/{subjectAliasID})
+	DeleteSubjectAlias(w http.ResponseWriter, r *http.Request, subjectAliasID SubjectAliasID)
+	// Get subject alias
+	// (GET /api/v1/subject-aliases/{subjectAliasID})
+	GetSubjectAlias(w http.ResponseWriter, r *http.Request, subjectAliasID SubjectAliasID)
 	// ☁ List subjects
 	// (GET /api/v1/subjects)
 	ListSubjects(w http.ResponseWriter, r *http.Request)
@@ -795,6 +1095,30 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
+// List event types
+// (GET /api/v1/event-types)
+func (_ Unimplemented) ListEventTypes(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upsert event type
+// (POST /api/v1/event-types)
+func (_ Unimplemented) UpsertEventType(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete event type
+// (DELETE /api/v1/event-types/{eventType})
+func (_ Unimplemented) DeleteEventType(w http.ResponseWriter, r *http.Request, eventType EventTypeName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get event type
+// (GET /api/v1/event-types/{eventType})
+func (_ Unimplemented) GetEventType(w http.ResponseWriter, r *http.Request, eventType EventTypeName) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List ingested events
 // (GET /api/v1/events)
 func (_ Unimplemented) ListEvents(w http.ResponseWriter, r *http.Request, params ListEventsParams) {
@@ -831,6 +1155,18 @@ func (_ Unimplemented) GetFeature(w http.ResponseWriter, r *http.Request, featur
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Update feature
+// (PATCH /api/v1/features/{featureID})
+func (_ Unimplemented) UpdateFeature(w http.ResponseWriter, r *http.Request, featureID FeatureID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get usage data freshness
+// (GET /api/v1/freshness)
+func (_ Unimplemented) GetFreshness(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List the already defined ledgers.
 // (GET /api/v1/ledgers)
 func (_ Unimplemented) ListLedgers(w http.ResponseWriter, r *http.Request, params ListLedgersParams) {
@@ -843,6 +1179,12 @@ func (_ Unimplemented) CreateLedger(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Check the entitlement of a subject to a feature.
+// (GET /api/v1/ledgers/entitlement)
+func (_ Unimplemented) CheckLedgerEntitlement(w http.ResponseWriter, r *http.Request, params CheckLedgerEntitlementParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List grants for multiple ledgers.
 // (GET /api/v1/ledgers/grants)
 func (_ Unimplemented) ListLedgerGrants(w http.ResponseWriter, r *http.Request, params ListLedgerGrantsParams) {
@@ -855,6 +1197,30 @@ func (_ Unimplemented) GetLedgerBalance(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List grant schedules
+// (GET /api/v1/ledgers/{ledgerID}/grant-schedules)
+func (_ Unimplemented) ListLedgerGrantSchedules(w http.ResponseWriter, r *http.Request, ledgerID LedgerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a grant schedule on a specific ledger.
+// (POST /api/v1/ledgers/{ledgerID}/grant-schedules)
+func (_ Unimplemented) CreateLedgerGrantSchedule(w http.ResponseWriter, r *http.Request, ledgerID LedgerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete grant schedule
+// (DELETE /api/v1/ledgers/{ledgerID}/grant-schedules/{ledgerGrantScheduleID})
+func (_ Unimplemented) DeleteLedgerGrantSchedule(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a single grant schedule.
+// (GET /api/v1/ledgers/{ledgerID}/grant-schedules/{ledgerGrantScheduleID})
+func (_ Unimplemented) GetLedgerGrantSchedule(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List ledger grants
 // (GET /api/v1/ledgers/{ledgerID}/grants)
 func (_ Unimplemented) ListLedgerGrantsByLedger(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, params ListLedgerGrantsByLedgerParams) {
@@ -879,18 +1245,78 @@ func (_ Unimplemented) GetLedgerGrant(w http.ResponseWriter, r *http.Request, le
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List grant amendments
+// (GET /api/v1/ledgers/{ledgerID}/grants/{ledgerGrantID}/amendments)
+func (_ Unimplemented) ListLedgerGrantAmendments(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerGrantID LedgerGrantID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Amend grant
+// (POST /api/v1/ledgers/{ledgerID}/grants/{ledgerGrantID}/amendments)
+func (_ Unimplemented) AmendLedgerGrant(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerGrantID LedgerGrantID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get the history of a ledger
 // (GET /api/v1/ledgers/{ledgerID}/history)
 func (_ Unimplemented) GetLedgerHistory(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, params GetLedgerHistoryParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List notification rules
+// (GET /api/v1/ledgers/{ledgerID}/notification-rules)
+func (_ Unimplemented) ListLedgerNotificationRules(w http.ResponseWriter, r *http.Request, ledgerID LedgerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a notification rule on a specific ledger.
+// (POST /api/v1/ledgers/{ledgerID}/notification-rules)
+func (_ Unimplemented) CreateLedgerNotificationRule(w http.ResponseWriter, r *http.Request, ledgerID LedgerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete notification rule
+// (DELETE /api/v1/ledgers/{ledgerID}/notification-rules/{ledgerNotificationRuleID})
+func (_ Unimplemented) DeleteLedgerNotificationRule(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a single notification rule.
+// (GET /api/v1/ledgers/{ledgerID}/notification-rules/{ledgerNotificationRuleID})
+func (_ Unimplemented) GetLedgerNotificationRule(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List notification deliveries
+// (GET /api/v1/ledgers/{ledgerID}/notification-rules/{ledgerNotificationRuleID}/deliveries)
+func (_ Unimplemented) ListLedgerNotificationDeliveries(w http.ResponseWriter, r *http.Request, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, params ListLedgerNotificationDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the overage limits of a ledger
+// (PUT /api/v1/ledgers/{ledgerID}/overage-limits)
+func (_ Unimplemented) UpdateLedgerOverageLimits(w http.ResponseWriter, r *http.Request, ledgerID LedgerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Reset the ledger's balance
 // (POST /api/v1/ledgers/{ledgerID}/reset)
 func (_ Unimplemented) ResetLedger(w http.ResponseWriter, r *http.Request, ledgerID LedgerID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Delete the usage period of a ledger
+// (DELETE /api/v1/ledgers/{ledgerID}/usage-period)
+func (_ Unimplemented) DeleteLedgerUsagePeriod(w http.ResponseWriter, r *http.Request, ledgerID LedgerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the usage period of a ledger
+// (PUT /api/v1/ledgers/{ledgerID}/usage-period)
+func (_ Unimplemented) UpdateLedgerUsagePeriod(w http.ResponseWriter, r *http.Request, ledgerID LedgerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List meters
 // (GET /api/v1/meters)
 func (_ Unimplemented) ListMeters(w http.ResponseWriter, r *http.Request) {
@@ -951,6 +1377,30 @@ func (_ Unimplemented) InvalidatePortalTokens(w http.ResponseWriter, r *http.Req
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List subject aliases
+// (GET /api/v1/subject-aliases)
+func (_ Unimplemented) ListSubjectAliases(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upsert subject alias
+// (POST /api/v1/subject-aliases)
+func (_ Unimplemented) UpsertSubjectAlias(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete subject alias
+// (DELETE /api/v1/subject-aliases/{subjectAliasID})
+func (_ Unimplemented) DeleteSubjectAlias(w http.ResponseWriter, r *http.Request, subjectAliasID SubjectAliasID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get subject alias
+// (GET /api/v1/subject-aliases/{subjectAliasID})
+func (_ Unimplemented) GetSubjectAlias(w http.ResponseWriter, r *http.Request, subjectAliasID SubjectAliasID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ☁ List subjects
 // (GET /api/v1/subjects)
 func (_ Unimplemented) ListSubjects(w http.ResponseWriter, r *http.Request) {
@@ -984,6 +1434,104 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// ListEventTypes operation middleware
+func (siw *ServerInterfaceWrapper) ListEventTypes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEventTypes(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpsertEventType operation middleware
+func (siw *ServerInterfaceWrapper) UpsertEventType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpsertEventType(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteEventType operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEventType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "eventType" -------------
+	var eventType EventTypeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventType", chi.URLParam(r, "eventType"), &eventType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventType", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteEventType(w, r, eventType)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetEventType operation middleware
+func (siw *ServerInterfaceWrapper) GetEventType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "eventType" -------------
+	var eventType EventTypeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventType", chi.URLParam(r, "eventType"), &eventType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventType", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEventType(w, r, eventType)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // ListEvents operation middleware
 func (siw *ServerInterfaceWrapper) ListEvents(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1186,48 +1734,97 @@ func (siw *ServerInterfaceWrapper) GetFeature(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListLedgers operation middleware
-func (siw *ServerInterfaceWrapper) ListLedgers(w http.ResponseWriter, r *http.Request) {
+// UpdateFeature operation middleware
+func (siw *ServerInterfaceWrapper) UpdateFeature(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
-
-	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListLedgersParams
-
-	// ------------- Optional query parameter "subject" -------------
+	// ------------- Path parameter "featureID" -------------
+	var featureID FeatureID
 
-	err = runtime.BindQueryParameter("form", true, false, "subject", r.URL.Query(), &params.Subject)
+	err = runtime.BindStyledParameterWithOptions("simple", "featureID", chi.URLParam(r, "featureID"), &featureID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subject", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "featureID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "subjectSimilarTo" -------------
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "subjectSimilarTo", r.URL.Query(), &params.SubjectSimilarTo)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subjectSimilarTo", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "limit" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateFeature(w, r, featureID)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+// GetFreshness operation middleware
+func (siw *ServerInterfaceWrapper) GetFreshness(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFreshness(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListLedgers operation middleware
+func (siw *ServerInterfaceWrapper) ListLedgers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLedgersParams
+
+	// ------------- Optional query parameter "subject" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subject", r.URL.Query(), &params.Subject)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subject", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "subjectSimilarTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subjectSimilarTo", r.URL.Query(), &params.SubjectSimilarTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subjectSimilarTo", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
@@ -1269,6 +1866,60 @@ func (siw *ServerInterfaceWrapper) CreateLedger(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// CheckLedgerEntitlement operation middleware
+func (siw *ServerInterfaceWrapper) CheckLedgerEntitlement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CheckLedgerEntitlementParams
+
+	// ------------- Required query parameter "subject" -------------
+
+	if paramValue := r.URL.Query().Get("subject"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "subject"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "subject", r.URL.Query(), &params.Subject)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subject", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "featureID" -------------
+
+	if paramValue := r.URL.Query().Get("featureID"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "featureID"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "featureID", r.URL.Query(), &params.FeatureID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "featureID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckLedgerEntitlement(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // ListLedgerGrants operation middleware
 func (siw *ServerInterfaceWrapper) ListLedgerGrants(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1336,19 +1987,524 @@ func (siw *ServerInterfaceWrapper) GetLedgerBalance(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLedgerBalanceParams
-
-	// ------------- Optional query parameter "time" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "time", r.URL.Query(), &params.Time)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "time", Err: err})
-		return
-	}
-
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLedgerBalanceParams
+
+	// ------------- Optional query parameter "time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "time", r.URL.Query(), &params.Time)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "time", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLedgerBalance(w, r, ledgerID, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListLedgerGrantSchedules operation middleware
+func (siw *ServerInterfaceWrapper) ListLedgerGrantSchedules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLedgerGrantSchedules(w, r, ledgerID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateLedgerGrantSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CreateLedgerGrantSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLedgerGrantSchedule(w, r, ledgerID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteLedgerGrantSchedule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLedgerGrantSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "ledgerGrantScheduleID" -------------
+	var ledgerGrantScheduleID LedgerGrantScheduleID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerGrantScheduleID", chi.URLParam(r, "ledgerGrantScheduleID"), &ledgerGrantScheduleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerGrantScheduleID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLedgerGrantSchedule(w, r, ledgerID, ledgerGrantScheduleID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLedgerGrantSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetLedgerGrantSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "ledgerGrantScheduleID" -------------
+	var ledgerGrantScheduleID LedgerGrantScheduleID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerGrantScheduleID", chi.URLParam(r, "ledgerGrantScheduleID"), &ledgerGrantScheduleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerGrantScheduleID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLedgerGrantSchedule(w, r, ledgerID, ledgerGrantScheduleID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListLedgerGrantsByLedger operation middleware
+func (siw *ServerInterfaceWrapper) ListLedgerGrantsByLedger(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLedgerGrantsByLedgerParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeVoids" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeVoids", r.URL.Query(), &params.IncludeVoids)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeVoids", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLedgerGrantsByLedger(w, r, ledgerID, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateLedgerGrant operation middleware
+func (siw *ServerInterfaceWrapper) CreateLedgerGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLedgerGrant(w, r, ledgerID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// VoidLedgerGrant operation middleware
+func (siw *ServerInterfaceWrapper) VoidLedgerGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "ledgerGrantID" -------------
+	var ledgerGrantID LedgerGrantID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerGrantID", chi.URLParam(r, "ledgerGrantID"), &ledgerGrantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerGrantID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VoidLedgerGrant(w, r, ledgerID, ledgerGrantID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLedgerGrant operation middleware
+func (siw *ServerInterfaceWrapper) GetLedgerGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "ledgerGrantID" -------------
+	var ledgerGrantID LedgerGrantID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerGrantID", chi.URLParam(r, "ledgerGrantID"), &ledgerGrantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerGrantID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLedgerGrant(w, r, ledgerID, ledgerGrantID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListLedgerGrantAmendments operation middleware
+func (siw *ServerInterfaceWrapper) ListLedgerGrantAmendments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "ledgerGrantID" -------------
+	var ledgerGrantID LedgerGrantID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerGrantID", chi.URLParam(r, "ledgerGrantID"), &ledgerGrantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerGrantID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLedgerGrantAmendments(w, r, ledgerID, ledgerGrantID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AmendLedgerGrant operation middleware
+func (siw *ServerInterfaceWrapper) AmendLedgerGrant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "ledgerGrantID" -------------
+	var ledgerGrantID LedgerGrantID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerGrantID", chi.URLParam(r, "ledgerGrantID"), &ledgerGrantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerGrantID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AmendLedgerGrant(w, r, ledgerID, ledgerGrantID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLedgerHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetLedgerHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLedgerHistoryParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLedgerHistory(w, r, ledgerID, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListLedgerNotificationRules operation middleware
+func (siw *ServerInterfaceWrapper) ListLedgerNotificationRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLedgerNotificationRules(w, r, ledgerID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateLedgerNotificationRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateLedgerNotificationRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLedgerBalance(w, r, ledgerID, params)
+		siw.Handler.CreateLedgerNotificationRule(w, r, ledgerID)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1358,8 +2514,8 @@ func (siw *ServerInterfaceWrapper) GetLedgerBalance(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListLedgerGrantsByLedger operation middleware
-func (siw *ServerInterfaceWrapper) ListLedgerGrantsByLedger(w http.ResponseWriter, r *http.Request) {
+// DeleteLedgerNotificationRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLedgerNotificationRule(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1373,31 +2529,21 @@ func (siw *ServerInterfaceWrapper) ListLedgerGrantsByLedger(w http.ResponseWrite
 		return
 	}
 
-	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
-
-	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListLedgerGrantsByLedgerParams
-
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "ledgerNotificationRuleID" -------------
+	var ledgerNotificationRuleID LedgerNotificationRuleID
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerNotificationRuleID", chi.URLParam(r, "ledgerNotificationRuleID"), &ledgerNotificationRuleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerNotificationRuleID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "includeVoids" -------------
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "includeVoids", r.URL.Query(), &params.IncludeVoids)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeVoids", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListLedgerGrantsByLedger(w, r, ledgerID, params)
+		siw.Handler.DeleteLedgerNotificationRule(w, r, ledgerID, ledgerNotificationRuleID)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1407,8 +2553,8 @@ func (siw *ServerInterfaceWrapper) ListLedgerGrantsByLedger(w http.ResponseWrite
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateLedgerGrant operation middleware
-func (siw *ServerInterfaceWrapper) CreateLedgerGrant(w http.ResponseWriter, r *http.Request) {
+// GetLedgerNotificationRule operation middleware
+func (siw *ServerInterfaceWrapper) GetLedgerNotificationRule(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1422,12 +2568,21 @@ func (siw *ServerInterfaceWrapper) CreateLedgerGrant(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Path parameter "ledgerNotificationRuleID" -------------
+	var ledgerNotificationRuleID LedgerNotificationRuleID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerNotificationRuleID", chi.URLParam(r, "ledgerNotificationRuleID"), &ledgerNotificationRuleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerNotificationRuleID", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
 
 	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateLedgerGrant(w, r, ledgerID)
+		siw.Handler.GetLedgerNotificationRule(w, r, ledgerID, ledgerNotificationRuleID)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1437,8 +2592,8 @@ func (siw *ServerInterfaceWrapper) CreateLedgerGrant(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// VoidLedgerGrant operation middleware
-func (siw *ServerInterfaceWrapper) VoidLedgerGrant(w http.ResponseWriter, r *http.Request) {
+// ListLedgerNotificationDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListLedgerNotificationDeliveries(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1452,12 +2607,12 @@ func (siw *ServerInterfaceWrapper) VoidLedgerGrant(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// ------------- Path parameter "ledgerGrantID" -------------
-	var ledgerGrantID LedgerGrantID
+	// ------------- Path parameter "ledgerNotificationRuleID" -------------
+	var ledgerNotificationRuleID LedgerNotificationRuleID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ledgerGrantID", chi.URLParam(r, "ledgerGrantID"), &ledgerGrantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerNotificationRuleID", chi.URLParam(r, "ledgerNotificationRuleID"), &ledgerNotificationRuleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerGrantID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerNotificationRuleID", Err: err})
 		return
 	}
 
@@ -1465,8 +2620,19 @@ func (siw *ServerInterfaceWrapper) VoidLedgerGrant(w http.ResponseWriter, r *htt
 
 	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLedgerNotificationDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.VoidLedgerGrant(w, r, ledgerID, ledgerGrantID)
+		siw.Handler.ListLedgerNotificationDeliveries(w, r, ledgerID, ledgerNotificationRuleID, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1476,8 +2642,8 @@ func (siw *ServerInterfaceWrapper) VoidLedgerGrant(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLedgerGrant operation middleware
-func (siw *ServerInterfaceWrapper) GetLedgerGrant(w http.ResponseWriter, r *http.Request) {
+// UpdateLedgerOverageLimits operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLedgerOverageLimits(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1491,21 +2657,12 @@ func (siw *ServerInterfaceWrapper) GetLedgerGrant(w http.ResponseWriter, r *http
 		return
 	}
 
-	// ------------- Path parameter "ledgerGrantID" -------------
-	var ledgerGrantID LedgerGrantID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "ledgerGrantID", chi.URLParam(r, "ledgerGrantID"), &ledgerGrantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerGrantID", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
 
 	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLedgerGrant(w, r, ledgerID, ledgerGrantID)
+		siw.Handler.UpdateLedgerOverageLimits(w, r, ledgerID)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1515,8 +2672,8 @@ func (siw *ServerInterfaceWrapper) GetLedgerGrant(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLedgerHistory operation middleware
-func (siw *ServerInterfaceWrapper) GetLedgerHistory(w http.ResponseWriter, r *http.Request) {
+// ResetLedger operation middleware
+func (siw *ServerInterfaceWrapper) ResetLedger(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1534,50 +2691,38 @@ func (siw *ServerInterfaceWrapper) GetLedgerHistory(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLedgerHistoryParams
-
-	// ------------- Optional query parameter "limit" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResetLedger(w, r, ledgerID)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	// ------------- Required query parameter "from" -------------
+// DeleteLedgerUsagePeriod operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLedgerUsagePeriod(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+	var err error
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
-		return
-	}
+	// ------------- Path parameter "ledgerID" -------------
+	var ledgerID LedgerID
 
-	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	err = runtime.BindStyledParameterWithOptions("simple", "ledgerID", chi.URLParam(r, "ledgerID"), &ledgerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ledgerID", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "to" -------------
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLedgerHistory(w, r, ledgerID, params)
+		siw.Handler.DeleteLedgerUsagePeriod(w, r, ledgerID)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1587,8 +2732,8 @@ func (siw *ServerInterfaceWrapper) GetLedgerHistory(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ResetLedger operation middleware
-func (siw *ServerInterfaceWrapper) ResetLedger(w http.ResponseWriter, r *http.Request) {
+// UpdateLedgerUsagePeriod operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLedgerUsagePeriod(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1607,7 +2752,7 @@ func (siw *ServerInterfaceWrapper) ResetLedger(w http.ResponseWriter, r *http.Re
 	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ResetLedger(w, r, ledgerID)
+		siw.Handler.UpdateLedgerUsagePeriod(w, r, ledgerID)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1793,6 +2938,14 @@ func (siw *ServerInterfaceWrapper) QueryMeter(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Optional query parameter "excludeIncomplete" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "excludeIncomplete", r.URL.Query(), &params.ExcludeIncomplete)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "excludeIncomplete", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.QueryMeter(w, r, meterIdOrSlug, params)
 	}))
@@ -1985,6 +3138,104 @@ func (siw *ServerInterfaceWrapper) InvalidatePortalTokens(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// ListSubjectAliases operation middleware
+func (siw *ServerInterfaceWrapper) ListSubjectAliases(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSubjectAliases(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpsertSubjectAlias operation middleware
+func (siw *ServerInterfaceWrapper) UpsertSubjectAlias(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpsertSubjectAlias(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteSubjectAlias operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSubjectAlias(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "subjectAliasID" -------------
+	var subjectAliasID SubjectAliasID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subjectAliasID", chi.URLParam(r, "subjectAliasID"), &subjectAliasID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subjectAliasID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSubjectAlias(w, r, subjectAliasID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSubjectAlias operation middleware
+func (siw *ServerInterfaceWrapper) GetSubjectAlias(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "subjectAliasID" -------------
+	var subjectAliasID SubjectAliasID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subjectAliasID", chi.URLParam(r, "subjectAliasID"), &subjectAliasID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subjectAliasID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, CloudTokenAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CloudCookieAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubjectAlias(w, r, subjectAliasID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // ListSubjects operation middleware
 func (siw *ServerInterfaceWrapper) ListSubjects(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -2196,6 +3447,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/event-types", wrapper.ListEventTypes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/event-types", wrapper.UpsertEventType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/event-types/{eventType}", wrapper.DeleteEventType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/event-types/{eventType}", wrapper.GetEventType)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/events", wrapper.ListEvents)
 	})
@@ -2214,18 +3477,39 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/features/{featureID}", wrapper.GetFeature)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/v1/features/{featureID}", wrapper.UpdateFeature)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/freshness", wrapper.GetFreshness)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/ledgers", wrapper.ListLedgers)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/ledgers", wrapper.CreateLedger)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/ledgers/entitlement", wrapper.CheckLedgerEntitlement)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/ledgers/grants", wrapper.ListLedgerGrants)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/ledgers/{ledgerID}/balance", wrapper.GetLedgerBalance)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/ledgers/{ledgerID}/grant-schedules", wrapper.ListLedgerGrantSchedules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/ledgers/{ledgerID}/grant-schedules", wrapper.CreateLedgerGrantSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/ledgers/{ledgerID}/grant-schedules/{ledgerGrantScheduleID}", wrapper.DeleteLedgerGrantSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/ledgers/{ledgerID}/grant-schedules/{ledgerGrantScheduleID}", wrapper.GetLedgerGrantSchedule)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/ledgers/{ledgerID}/grants", wrapper.ListLedgerGrantsByLedger)
 	})
@@ -2238,12 +3522,42 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/ledgers/{ledgerID}/grants/{ledgerGrantID}", wrapper.GetLedgerGrant)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/ledgers/{ledgerID}/grants/{ledgerGrantID}/amendments", wrapper.ListLedgerGrantAmendments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/ledgers/{ledgerID}/grants/{ledgerGrantID}/amendments", wrapper.AmendLedgerGrant)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/ledgers/{ledgerID}/history", wrapper.GetLedgerHistory)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/ledgers/{ledgerID}/notification-rules", wrapper.ListLedgerNotificationRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/ledgers/{ledgerID}/notification-rules", wrapper.CreateLedgerNotificationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/ledgers/{ledgerID}/notification-rules/{ledgerNotificationRuleID}", wrapper.DeleteLedgerNotificationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/ledgers/{ledgerID}/notification-rules/{ledgerNotificationRuleID}", wrapper.GetLedgerNotificationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/ledgers/{ledgerID}/notification-rules/{ledgerNotificationRuleID}/deliveries", wrapper.ListLedgerNotificationDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/ledgers/{ledgerID}/overage-limits", wrapper.UpdateLedgerOverageLimits)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/ledgers/{ledgerID}/reset", wrapper.ResetLedger)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/ledgers/{ledgerID}/usage-period", wrapper.DeleteLedgerUsagePeriod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/ledgers/{ledgerID}/usage-period", wrapper.UpdateLedgerUsagePeriod)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/meters", wrapper.ListMeters)
 	})
@@ -2274,6 +3588,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/portal/tokens/invalidate", wrapper.InvalidatePortalTokens)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/subject-aliases", wrapper.ListSubjectAliases)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/subject-aliases", wrapper.UpsertSubjectAlias)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/subject-aliases/{subjectAliasID}", wrapper.DeleteSubjectAlias)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/subject-aliases/{subjectAliasID}", wrapper.GetSubjectAlias)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/subjects", wrapper.ListSubjects)
 	})
@@ -2293,154 +3619,275 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x963LbOJbwq6D4bdUkPZQsyXYSu2pqSnFsR534El+SdMf+0hAJSehQBEOAthWXf+xb",
-	"7PPtk3yFGwmSIEXJcuIvk62uncgkgYODcz8HB7eOR6YRCVHIqLN960QwhlPEUCx+jRBkSYwGr/gPH1Ev",
-	"xhHDJHS2nT5IQvw1QeD87eAVwD4KGR5hFIMRiQEE6su24zqYvx5BNnFcJ4RT5Gwb47pOjL4mOEa+s83i",
-	"BLkO9SZoCvmE6AZOo4C/3+n2T/5cP3y1++bs9P3Gycne3rtnW/ube/33juuwWcTfoSzG4dhxnZvWmLTU",
-	"H70Y+Zi194z50sctPI1IzOSq2cTZdsaYTZJh2yPTNRKhUOABk+zfazhkKA5hsCbHde7u7lwnQP4Yxfsx",
-	"DFktoko4kh+CMf+yAlH5sb8PsrLZHghVy2CpFj+NUeMllJEpilvYb4aLt9n4D4WM0AsSH70n2KdltKin",
-	"4IpgH6CQxRhRgEPAJgjEiEYkpBmPfU1QPMtwg82RTXz4aASTgDnbIxhQ5Gb4kYhTGBgSEiAYOhmo7/j4",
-	"b/EUszKgh8l0iGJARimUjIAYsSQOK8ALxEBWuLqdTscAq8t/TeENniZT/XCKQ/UzBZgjeYziIsBHoxFF",
-	"TSGmX3BUAS+R41gBLkOrwetYwRNUMfCP4tMgGTdnBr7r4tMKbsgPW8cS/xWjkbPt/J+1TPqvyad0LR2A",
-	"QyqQsIcDxqUQSaKXM/65DT+j3EvmZND3MV8ZDI5jEqGYYSRIPc9/d24BC6eYIxTIccX6x3xwMJxRcI3Z",
-	"BKAb6DEwhcybtC/Ci/CcwjHaBn/9OwfKJz7N5b9wGCXsIul0es/yj6fER8Hlv8YRa238dcGpNd3KW0c8",
-	"5BzOnzrGXkYJ4xCr32T4N/LEHyibCUnjIxQdpX81sPi2UgTK5zgcAxj66VrBNAkY5oigiRiP5teqJeC/",
-	"Ot3XH5+9f7O5s7H14uV6/+UfW8cn3c6zrePjwqqc6jerWDWTgtmu/mDpaaD0VCKmDqPz8Phv9cd/pRqi",
-	"K2ml9PfeRZVAU6/mkIQZmtppXf0BxjGcGZwWk2l5HacMxgz4kKEWw1PEFcDJ3g5YX1/f4nwxhax9EQpV",
-	"QfEValdCOOKj2zVjr9Nbb3W6rU73rNPZFv/96biOHJ3Ts568pDdT2A35UFBiIxASBmiEPC7LfAABxeE4",
-	"QACOxzEaQ4bANQ4CMERKZSBf8DuC3kRvl2AKsfprHPrkun0R/qUe/QUwBZDrQxRfIYN1rmCQ1KBjbJFV",
-	"KUY+Kd5Xy710F97LM1JGxW7or2AfGZm3i72ld/GDwO4p/obmb6Sb7WTC+WjefnL9xRVajNiMK17+O6OK",
-	"iLN9xcaLrapGyHUGdFMtZ6yzsPYzPEV/ktCy/rMJkjTFCY4Dz6fXCxE7+o2ECEAKfDTCfNXKVBv0D/uA",
-	"jwv4wOAVZHAIKQJPJoxF22tr19fXbQxD2CbxeI0P1OID0aecHEo45wOen+2ICcV8GtcJRf48HKWLs9ox",
-	"zvnZTk5V9Kcoxh5cO0TXn/8g8Rcr3aiN4nbDGzRbxLZXX1aYM4Vx72/iC8WhzWbByi+hf4K+Joiy45gM",
-	"AzQ9UU/5Q4+EDIVCr8AoCrAH+YLWIvnmP/+mfHW3prngIwYxtxcmCPooBjtyhNbZLEJgAilIQnQTIY8h",
-	"XxHSRW7om2lw4fCtYZAl1Nne4LYkw0ys7CX0gQI2W1kSh9sKIKFet4fQb8XqrbumzKAWLxGU3zxz1jvX",
-	"kSq9H8QI+rPdG0wZXQnmPBKOAuwxHI53QxZLK9NXxsr7g85pZ+fgz99P3/XW97cO3nw8eXf83BF2NPQh",
-	"E+vjexyhYzibopAN+KcR/rxxFPe/TN5ezfAEk61oszvZwngvfOlkZJsRWqsrjVC1h8o9R2KRuU3ZyjZF",
-	"vQQlQrKXq7ZHvdB4Z6rxbdss+TbYTSc5JGyPJKG/WuoWZpkQSyM+eA43GxluDgkDe+qFKnyEhLXkIKsg",
-	"1mxGufYBB53TA1oxBlQAS+AAZ5MYmNjsdPOYGOReq8OHOeCqsDLIj3kewoRNSIy/rRozU0y5SQBIDHB4",
-	"BQPsA0a+oDBHJAZqTEhq8JKYr60CKeeFAc9TybxafBgSH8UxiXMk0jHxkL63q96rxoV+dUWYKEB4l44q",
-	"dOROjCBDKoSqVUGlW69iSkULQDOMsNT1DxIDbrJjjxtRIxQjTisAAq3D2xfhHrcGJVq3wc7xees1SWLq",
-	"gjNOU9QF/eMB2IFBQF2AmCetnygXZYCxN8FX3GiwmLPcljJBU++6ADNpVnEGV6aVCkHAkFEOubB423nX",
-	"Wi2eC+ujMJhVRdRUAEg5TNJLpfeIlBxF8iMZHMr8HxnooG1wTtEoCQAeZSEkQD0SiUUPYyKMFTaBIbie",
-	"QJZihMXQ+0Lb9UERWxREzGCPbZ2lADA+VXEDKCUehpwWRYiH2/4+8mIEKaIa+cOZFfmOkDP0MyMMBmXb",
-	"T5uVNoBMO15nLgqD9weK5qwmcGaZfpLTmDi4tGBIMpXU1zaLWdkVMYq4W8vFNvclSIRCFQEE6TvThAoa",
-	"hZTicah5SPplF6E2sS2cYVpPjSnPoIOFLa5ytKwqcpNSCVcl2hFkE0z1ogVDMiJJVBPGiMRynfUbpGct",
-	"7UtNEGvlIaw8CYiciyFb89jYl8uDMUrXjUPJFGAIAxgKAap9RM+McJXF4ZQkYQXG5TM+vMxJgR0YcsqK",
-	"CMUMXwmBHXL/nv87FPHzApuI4HcWbiDJMDBiDfITvvGeWLvfrwBE+NKcGQUc4BpSoL4osuUicasKsWzQ",
-	"9miEPL64KrjSF0QQ5z6wWPgqwjGUc906MAiORs72pybOgaCO3fTzYxFGce4uXec6xgxlK75z6xK6fH3K",
-	"T9cCWaR2UzEt96IspF2uV2A4a5fCzI3zkHfuIxBGEYzVpzbUyKcKCd8TMVGMSYzZLJ8hc20gqje1JlNM",
-	"rKSH0KcTPJ6gOHuTixRh0XLzBseU64lj/VDYainv+8jDUxgovqdt8IEPGJBr7grKvwEc+sI2Dsd6Jikq",
-	"uYTKG3MuH9qAt8tnmxIu4eIxR7SwRvLv9NoX4YcJEiE1DneMAEVXKIaBVgDwCuIADgOUhhsp1+xKHsrQ",
-	"GZ1RhqaAooCLSFPK8PXwnwJ0ytK5RVAWeMIEuRZTq+nohMOQTpPCGqArFLjG0F5AKB+RC25GQcbrudhd",
-	"ugMDGTXkM4q9vCZ6xgm80mE0DwZ6RoyosJSMcbmsobkFi5kSaspVQcGGcE0ByIl0I/fa29ysT726TkyC",
-	"gFxJo6ah7DrRn6Rc2fjTM/76neskkb+gPgkgZUB99oBKpWB6iKeuVsJurhDG1D45fWCzH3evtD/a3Avb",
-	"CUjiiw8pOFW2gqSW30+PDsGpdCRzUlVL5JzJ32JJPCRcVkmDeNvp9tZt2VERwNv0up0R9FGr622h1ob/",
-	"zGu96D3fbHmbPW/92fP1rr/ucaeYJLEnMCc9wpYyo7lRc4ViKpfQbXccM3JXiPbiaXH7utviv3an0/0z",
-	"gzCKyTSSQj+nYOoVkNxgi1XAUQoiOAsI9Ns1vlIF4mzKiEOiog6aJQqbKR8C/lQLfP6RSnqBA+4VQF+I",
-	"K0ZEnqnX2Xim80wcypAz8adcRENEMi5NXig9FQLgLQrH3PLtuk6YBELkVlpVHCoz15BzwXVGQApi+ZqU",
-	"S2IxcgEUMNI2GTCJ8eJwYH/u/GInczvYlHzzsJTm1tQ9Z36x4zeMu3rXE+xx/1dR1wRGEQpRnryKvGLi",
-	"pxWjEYpR6KEG0Jk8Zk16yYeazkxBQnOCREKdopLrG5oHWXLwPICq/MJX4tdQk4tyDBVYckqVctOozD2L",
-	"YuInHorBkzQV5YPhDMjteZqHNC9b5kAsRU8Jd3iKKIPTiINxrUwXQDwvicXWZNtq49f19fWtdqViKkg2",
-	"q3JakEPskiaPcy1vJEJjFAjDgXvifGUxHuNQGoDZKvNrULJ3nqYUSFdsk6dQV2vRhn68ZGqpMJt68R6n",
-	"cPEhXaP+l9aYrF311sQfBKQqGtrcVbMGUe/c24ICauoXaw9tRZ5xI1l5gqBPwmBWVfhbHz+rdXrmeuZN",
-	"7TsTL6uy8ObSadk+u/yxAfCSNaHo7qWMFTWnWk3mZUIdZkOVt8OISVVTRNNQkQj1VoQrRNHJCmYp7Kle",
-	"nJ7cssF3rrNQ/Wa7LlJNQqQ2o5CZ4Rz25DzEXPTBIJiBcznuW3SDPTKOYTThfmAwA6fcy+aOb2pRxE8L",
-	"/Lf/bPPP55ub/b0P/Tevd7u9wz86O++29l47LheADMV8yv/7qdPa6r/cebW7t//69zcHh8fvTk7P3n/4",
-	"+Mefl7e9Z3f/ZZEWt9Urm8IbrYCerRf1kTkrbH3rtLYu//nk39uf0x9Pf7NMd2kRD4NwjChD/jJeUT8E",
-	"WH2ulJoIBBCdUxE5TGndiPRYwcBHespFXKUFfCP/x/lG2cplRrKU+Je1WlIpFl2pFC918mVXf1uayqQp",
-	"nUdGSoUv5nLLr2w+dJZ9WUSHq6+W190qg/EIVbc6arFCzb2ksqzIfiWxCmbZ1Nz3Td7U1OUsFD/IV+y4",
-	"1SVRKgKc1UQdvvr9ZHO9t/ti/+zl+9Od3sc3m682nMZlTU9ULLldPdhTs6yJUSbYXftc2eCug0PKpDUg",
-	"ihVU+dl2QDwYrP1+cBR4jL55/6LV4f/XrSql2lHrzXYWDknCtocBDL+UBYwVPfPDhiYuynp7kkxh2OKL",
-	"FsoU3UQBDKXwT7NrwtfB1HTjFP+oKo28rh8Sf5blaGWoLSXZMvemqCwDd34yAKlXL4MkuBA/0TA2hK3Z",
-	"bhXCLmVvXe2mTeq9Pjs7BvIF4BEfgTEKUSx8xuHM8BmFHZwec2mM3Y2ceYdDtt5zjHj15taWEa+W1ZSl",
-	"iLWivzK+IaATEjO3SBU0mU5hPCvAJTzjPHqtFZvz3G1RK+qRkEEccl+B77ptr6unra0Jnbed9oC1xFG6",
-	"1SkLLZJCry0afSgJ/bLKT3mZ+ShZHbIlXz7KuU4WKlc+kkqwKddBpd7TIwMNnCwNaek8geuIrEU1BGeT",
-	"NCOlc3kqHpNbVyNgjNxKDUDctT5B1mN1HBjheXPNzeoti3vZOY+8eqUQm7QjwFSi9XxYJMMiUdREw1Je",
-	"SAurK8wtxJ8vXzPCaW/WqGYEDEZA5RWGwbJxgfuUMoiVPljG/t4WuLkTq4qgydM1c8tEVfFIZUDb4shI",
-	"ZD6UO9M8ISzIWyaEbbHkVJPJVImReFXkPYdxzhQgOm22f9I/PHNc5/2RGORk93SX/xR//nx+2t/fzSfS",
-	"9PulFVpE7jIVQKkqvV+wThaNrDCIZg+e1ZUulU/NZXUN6pCY0Nq5/gkWseVVSy1UGlG8DAL8BYFuD0xJ",
-	"yCbFytduz2Y++klWttVkIv2+nEtMpOZRhPX66PzEcZ1X/T8c1/mwu/vGcZ2Do8Oz147r/LHbP3Eu5ymL",
-	"FCRX4aCatPOks1QoJFe7WCY+gQFE+/N3gsuBOjJcbbHfPaS0Fbj7iefqzhhnmaQdvGrfQy1dEVxdBJ/W",
-	"sPG3LAXwdsPyHzQVHzCcTUm8ZEG8TV4LcA3EzJUjJ0blkaV+FujKJO5cjfBY8Yi1MBre9CtMngPpWhpm",
-	"jx42F5PKzJQFC5r0Iqx6TJ9EbuBy5THyUN5VGWQr+aaYF8ljRkBC0fZF2AJ/newe9AeHg8P9z/2Do/PD",
-	"s79AC+jxQIymEIeiIYLAdlt8cnQy2B8c9t/av2hJQpUu8igJVGlgNoIhaIuTO65TGDyvwYsPm3fTyaHo",
-	"QTejehNUyd8sUqgXJgrH3qBYSq4CM4rEVT1FEuLUmTGsZnkYIYdWi+0j/2TDF//oEE45330qruNcJt/S",
-	"BVZ4nG91sJj7m3N5e26NtzRviRrP8N3AgAEPhkogKrSMEluy+0cF8AXMDxu/z9cpNhNGcuNWT/cH/InN",
-	"oZV5KpFqzxGEqiwTh/MpmJBrvtGigYyon83aF8jSj0KqTz9WTTnOD5xS5mIgDzvKzDT/mo9zlkusuWnf",
-	"CSNZ+F/tXLcJ/gemSlupSDEXE6uiyk8FO2byfX06yWiGsO0cDA7Pz3bL0fPcWuq1k8By33i/eP6sjH/j",
-	"tybNtG9R+UgVOFfYmlugZKDztrIQUyuZdDeb1R/l9qUqoJQNU9qxqsN5XLr5Iux/DEXToihGlGISioZT",
-	"6IbF0NPnCMy+JRSMYjI1itd8yGAbvEEzmqYTlDTgtOuRkGLKgJASMIgmMExE8wbxNAl9FFOPxAh4E8hn",
-	"RDGtKFWtocWSE2GTVY26VzUsTJhrN9PaAoxS6L4SJFl/d18kfofyhxLrF9duobQiocnWEyl55csf/kFl",
-	"6a2SF7P2RXhmvqQ+JjE4PT9wQf/9vgsOBoeuQNFB/yMwRAuVMjhUrdZEyw+xDimIPRUqhDHVlU/pqbI9",
-	"EoPzw8G7893PO9zaMod182BLiLIUm5yiDfgQpW8zBGgUchjxOCRxOXJlSNXSNlznGuUs0GomdxRRNmoz",
-	"pbEp5XKzzNG1gmlpW+rE++na6Mt4TQ6Xqdl+XmFYAsEmjjM738L6yk6UCnRHmdLmXjuu03+/77hce/H/",
-	"3/+YNyfll3XGt4mMfg65q8aL6DF4gqg4lmV1fsQzGbGSdolozNO2HbT4dGszDgqVRMUynaqKH0HkMmol",
-	"qWg39Kt7RClCY5CjxG7A3l26soVYpYHLyNwJ6s0SndDJ2nA9eow0SqsZpEKubRm1kWr8tqqoOVlZY7AV",
-	"STqxs7Y4ZB41FlUek2uj5WkDXnrMBFMk+AY2Z10Ss+H6Kgzr5VKZEvHymFHNgYm5xrxC7q01wJ4F0AzE",
-	"r5qi5U7dPkhduFxdfipzMQto85Q5Vqq9qpItfRkBkdkKW0GEtUWlHA1Q0alStmmUR1myQNI1TOsT6jPA",
-	"qxB9VuhQ2kLyh8BWTOnLbpyMWMXiMYkZDIR/bNsj7qlw1wSI49WBDDsU4xVBQK6Rf6AbkYgYW85Ducx1",
-	"Yqham8zg+Ea/6DSjU8WGqkyxxrOrrFOWK3bI9LNc2udBP3y1fhx9+NDr9z7EL6Zbf4++odfB/scXN9Od",
-	"j9f77dnm143TVv/D173k2de/R3DvW+fbu68bu996L05oOHt//fto9HHz683BFbEEQspIuq1oryOqA3Rn",
-	"R+Ei5htYyr756ZFuNbK5J2X0VzcVneJwIB92C+aC60jvVj1WrR1y5ccP1SBDU8Jtgx5HucTfcoK7YRJv",
-	"ZcGETAs2bCiZ0qs1kiyCW5hKWkk7oXLHl+8VJnmGfSiSXzTIW1dItFSNcx+oz8ArUTVIVf0reHKytwOe",
-	"v+g8f9q+CPvpeCDj0ELVbb7qEUzhTMQPZJF40aPSBc+1Bbir67RZcGR+lRj/KjH+VWL88CXGylA+FV9p",
-	"8bRSQ9loL79Qy0PtNokQaFUH5ITKwCgSRxgKIkySJ5PWq+l69orqPPdmrQfqOj6mUQBnh7LJ8o5Sb+BQ",
-	"nimbb7l9QbPy6XijGHeSDGlEZEltt7e+sflMcnCMI6RnEw+9hH7OhIHlhEdp+WU7otfIsJnri9rwt6wV",
-	"Nb8hhrkB5izFvWjY0mJV5s8X2by7sekzvwJbTmRQtJ025ocnSsRTANOko7l4K8gfvmybrVPXCd+IKav7",
-	"CADF35AZ3lYhTtes58tFsdMXGgSyP5gN7lcm2zhmkZfEmM1E8x/JdqK7xw4hXzDqJ3zUW1sfIXHw/BoN",
-	"uasMPPG27uKe/lJ93D9/pjIPZZzsivAbJAKfYjDD2dVTDhGMUbynGYxE8KuIp9hAsTrDusO9MJDEYNn0",
-	"E8aidPKlp+UYaDzV/CX+fc3KE9lWpjuBtuS5YJA1xpgDxZ3wymQdxSviWeypV8RLpihkOluSxIH6mm6v",
-	"ZWTUxmTN5wMIc3JEbD4zCg+MKgyBsFCeApDH9rP2nvJQs8ozZh9y9AofmoIZSWTTyzGiTBVnuDL2q8KR",
-	"YkyZg5zCkI8fI4ke2r4IW63WRfjbUYRilZZMe7397//8N3gioHsKQiLXLTrLyRRw2k8OhwZkYvvbv4nI",
-	"UIA9pEpnFbn3I+hNEOiJ49gZAtU1D1A8FRc9qE/p2tvBzu7h6W6r1+60J2waGCajk8OH4zq5897tDn+V",
-	"bwuMsLPtrLc77XV5nn8idncNRnjtqis7l8iAs7WEClNWSANLHOEQQBkyi2GoO+4SjUchxvm3sheQmDi7",
-	"PrCihDh7ZS278ubObfbyGRGvVt7kJSG/39VjdTePzbl47LJwvUSv06lpH67bhttun1myscD/hz3YRP+I",
-	"BdfKLQfjAHjCzMVudXt+13/xvNXZgn5rY+h5Lbj53G9tDtc3N3sbW+vI7z30YntVi22aMMx3tCjfMVS+",
-	"+kHUraQswL0LHw2T8RiH4zYfYEPSom3SlGbXqu9DESN0549Qd7OAAFox2vxxqvrxyytnhH9cIbo43qEM",
-	"dyvJdMmdC2LrrSwRTbNOayQGQ1ERaCKTqyFZtlLT/cwmHeXwqXxULvJL4s9qBIPRfeqfZSHRoKHGnVs1",
-	"Xkus7Z/1oudn7/r488uZpmKmsXjJyist/JGaZsqGU1QOCnFJk3GMGKWoC+NGVwxFwKviNaoGrxplmm+g",
-	"V+TUyoankmvzV0vdlfT4huVWvsTzEKWjJAhmqQT6mUXtwDS/bTL2zk0NTnW+co7Jqd+qsiv12fqFLcvS",
-	"hbUNDMzynbFlU/Mo9lEsr9ZAgV91Uyx/qXCvYHbFmqyhV0EC8SPL3JmN7myn/KquB9b3lmQIrb8TuK/v",
-	"RKm7Frh0SOu+1u0i3RAWMXmyRT9qI2WUEXPKOaFw86ZCQj3ZvYlQjIX7HTytMVnk4Uujj6CNfXINJxtb",
-	"HotZG/amlnblkd4pQ9Qx8XYDqdtdGagpWZWhU4/S0+uPR4JvNhmj/pqzh6Bmue16RxuTs0U7rN2m5/Dv",
-	"JJ0HiFlb0PK/G40zhzPwBc1sdC9fzeh+Mb2RtQWwSLuNyuYvQALur0wCbcjJ5u689XK/h9hztQOL7rlr",
-	"V/77iDXYyn3EHmQfO99TqohGiT8vXRg7uYwgkDbXHCtR1OmpTnS6/Ep9WGU4vlXjlugmP7ww9vRYufKL",
-	"9AqlZS77th5HzN1kRUCgV6bO9pOR9eqq8uXS9WsQgW0NvEzqi3tS8BQHMDaqaK6wj3zA0A2bs8RT+ekZ",
-	"KRiLhZpRdMPAKIbjKZLH4iniRmnNlVw/jQ2fkYJpyOdaXaar/i4mdNbosKkFLcq6uVPPiVSz1s8eNKwT",
-	"Kyuy0cU9kcKjK5SKmu3AbHZ72rf04cx2TSV2c11JJUbAMLWMv6vBXg+evrBI4fHxkOpGZ2v+GA36IT6c",
-	"3U6tpHgP5b0mew/W6/DshkYwTQKGowA10+Hy9rIlU4vi/tO3uoPMg+ocFYp5T7BPne8p54vNvxoLfbN9",
-	"1s8v7esI8D7Ef6sbFN2tGW3WKr0elu+4BkuXdlY4QvlupsuFQxULFI7GEBxKG0D1PZFVFbqP6TZIS9sA",
-	"DtNrafQ1UuCV3CyRAwjJteo0ajGoVHGcJeF0ryM1D+nd5XFefd2+xlVWLvJz+3vzaXhFDNVEseSkmCqq",
-	"TeGpNrOKCublLDW57sFav7SLXbs8wtjqw2iZ3KpX5URA1buLhM1ou9Sc8R5EffnwLki+f6Td3lfNy35M",
-	"7sDKCVXaQPYa/A+g9ybEuVI9oP8iMDwnYcAFZaFDLBjOwOBVGxzDmGFxZxKJgUzei8t3taRKO7zJTpjt",
-	"i/C9+Ie+O5aE/1iiI2aeQ/mI9+dPhYgFtEjTtMZbE28KDz+tSSNoxaSUFWQ3zHulpU2iJpBEWGfj31di",
-	"u4tSz8Ob0A1FZ4qvnz5jAgHF4TjQnYRXJCYnmDIiO/zX+p/qvYLtLsepo8zXavzHaSLXB/3Fma7UzRXF",
-	"7JmzKweY4+pWeraqj0PeHlm9p1ta0m7o32tBC/ju5BF67gs4MfLiiybOS86h14zy83vzOYlw/4CwIZNi",
-	"3bPX7uGIzrDUyINm9pMgy28oJkWnXidRYejrhs8zAEVHWm3EiSxoXb/vvIQTUNzb/38gV8nsfWwhWd1S",
-	"QbfZky2Af4B/VAlhgakUgI8oY/PImPKk0Hg6Y4lleFJTcl0MTb7TtgbJDuT3KzzY9GPaKDfoSLSSRssL",
-	"dQRcJKCmN+lRx7+mmlo0nSryqY5x/dafc8byt4G6Nb984tXVLZdgVr5p3P9v9P0e4QDxwS7CNGxhdK63",
-	"hc90H9WHEOlq7+2hLnmedeWhrh/ex/x7MaB7303Y+VUH3Izj//d//hsodpoqbimxfUkTrd2K/9WXp9dG",
-	"8ebKhoswrQyWbCPCK+Ja/SAZV5cHa+ZezNTLAd4wkiYBzpcH38/eeMTEoDajkhhqqoEt22cLRDzQxnV+",
-	"CdAVClBJ82YY7xG6vctLLFlbVGlRvzO6UnCnTFBA2bYWr62EnN2H67PQ5FWz5/NCn5zhKfqThM0/k+Vc",
-	"uh3XYl/tK1Zr+lX6/r2FxX9sZ/cFpIjZRJ8zIEM3bM2jVxVOpJrxs+g27KofKPRdhTBX4Nfl+HQFri5C",
-	"27Lcwh+74o8a1Z+7rrE9rjje7nZ7paG6+aEkanrzh+p1SkP1bEOt54fq5YaSR9LdDUt8tSSWxS078haZ",
-	"nzgtbYjf5aS7PjxRHzJJj1jI4GR6uUVFAOVUD/pDTBdbHKYgUjL6qu9K3DRakZ2fWQmpPWC0IgV1DrnI",
-	"Dlx5qlnGHig0K2uDswmmAIV+JCoxMQVRMgywF8wAuokIFe4PI+l3tMKWkA3GKiyKJW5oEokg0VYuzQOl",
-	"a26Y7ioYoosfAfqpDJfvbIL8Ur+/1O93U7+qi6SQNaVOh58uOcnbGz1+uuTkXVTfqtthUYvLr61iWbfs",
-	"qRDCDSJJQiNYO0lWnlAxVjP3qGnWpC43dNarLs3HcxXQ22wv3ruut2m0ruM/vmPnukY5D/NOiwVsifxe",
-	"/ArLNojECdTl8GZjo7l1x5W3jNgyJubuLpo3WfTWErtn3NjpzBGiPQ2Ta7i6UDZmdcp5Dpg7/zFFxoW+",
-	"vo31wRoOr2CAfSizC0tmIC/CQToMrVARRui6+hxXNkxBcyzFLILcsiEBDAJbe0PzeVGUZpdwcRQa74rV",
-	"1I+W5xBZ12oMODdcXZ6x8hKyukXo6qDs5FEKQYWQuMuJiXzLedt9tg0WXXeTzH2WUm5cXuhSXhYKgxEI",
-	"CcC+QYrcoEjP+7piXjWhvqooYxO/vVwzvOPcQnLj/dLX8/W1QRZztbYh7ObGqpravWm4psLSNeJXD28m",
-	"ZhH+ZcNNj1CTpVaZJdyUYvceZTIX4XlEUcyoIUGAPvGftqGghnoajMyDK8AniIb/YADdYMpcgFkqHfRh",
-	"ptIn4lWaezdKT9eoToZZq/W06Yzou2IlNbmE07ShyrLFN6smM/MWTEZAIsBcvS24arDVqwreX7K4GZdK",
-	"IgRZWx8Lm1pk8Nqt+tfAP4rfoNnKKls04aUWZm3nu4x5Fks05IFvWN2iCSxf3/IzVPU+/iqbWvp0lzYH",
-	"9hFrTHH7iD0cua3OjU4lZrWE/MnPnHGyMTa2QqYVArlp0NYeyjVvCFJRXHG/ni34KS7+K96G0u09b3fa",
-	"nXZ3+8WLFy8sZ7ZE2/qaS2jkcz6zWo3lhJRIqlEQo0AYI2mnchyOxQGS9I4EdSuNbG7dvgg/vUUwDsGU",
-	"xOjySeUFOGtjxPhYLZELQf6aGGWNXKH4CqPrp4JpVORWtcm2HuQqgymvCA3H8k4bEQTmUKoq8KXhU+xn",
-	"BVBlPRsCqIqsc7nMxmBNSYgY/obWfEgnQwJjXwVuWj66QgEXM61xgn2UA1C5QA0BNHyaJZGlR8gBkXJM",
-	"QzCQcVJjCQSZn1fQVc1RkLvLu/8XAAD//1EynL7i1wAA",
+	"H4sIAAAAAAAC/+y9+3LbtrYw/ioY/vbMaXsoWXbitPHMnjOK4yTejZ3Ul6QX5xfDJCRhhwJYALStZvzH",
+	"9xbf850n+QZXgiQoUbLseKc50zk7FklgYWFhYd3X5yih05wSRASPdj5HOWRwigRi6i90iYg4meXoEE6R",
+	"/CFFPGE4F5iSaCc6mSCwm9Ei3ZPvcSBmOQJ0BMQEAfUp70dxhOWrORSTKI6IGqccN4ojhv4sMENptCNY",
+	"geKIJxM0hWr2azjNM/l+zug0F1EcyRminYgLhsk4urmJoxGComBo/3kTvCEoCP6zQOD09f5zgFNEBB5h",
+	"xMCIMgCB+bIFxHLcbiAONodHvz86fL7388nxu8dHRy9e/PLk6cvtF8N3DbDj6Lo3pj3zY8JQikX/hTef",
+	"e9zD05wyoTdGTKKdaIzFpLjoJ3S6QXNE1FZhWv57AxOBGIHZhh43upFIylA6Ruwlg0TMRVQDR/pDMJZf",
+	"tiCqOvb9IKuc7S5RdZxMUFpkaHWUAW6GWIw7b7J7xGFl1jvC5Srom4uvzihKCi7oFLEeTrvh5HU5/l0h",
+	"gyRZkaJ3FKe8iRbzFFxSnAJEBMOIA0wUQ2WI55TwkpT+LBCblbjB/sg+PlI0gkUmop0RzDiKS/xoxBkM",
+	"XFCaIUiiEtRDKjcmgRK2o1WPAfEGAWzRSQhMeT+HITjxHZHAL3LfXuMpFk18HhbTC8TkHWp3X1DAkCgY",
+	"adn2TA0U3O/NwWDgbfem/GsKr/G0mNqHU0zMnw5NEvIxYlEN4DejEUddIeafcN4CL9XjBAFuQmvBGwTB",
+	"U6jeT9+w46wYdydOeZrUpy20WB12HgH+g6FRtBP9fxulELWhn/INN4CEVCFh71qd0H0i386QCEhU5hVw",
+	"hUlKr7hEa8HhGIEUCgjEBAowhTPABc4yMMWcGzkrBueCngPMgaIHlMpdkOsUeIr8ITAHdnZQEIGz/lkb",
+	"ZaEGuPO4SoCNqPFe4EzIK44W+bOZ/DA01ajykj8NTFMscQOzt4zmiAmMFN+sCYJxDY/HWIIM9Lhq08dy",
+	"cHAx4+AKiwlA1zCRuBTJpH9GzsipRNEOOP+fCih/yGk+/BOTvBBnxWCw9aT6eEpTlH345zgXvcfnCpGO",
+	"fj9H6qHkFfJp5BFwXojoxv1NL/6NEvUDFzP5ZZQilL9xv3pYfN16n+rnmIwBJKlbK5gWmcASEbxQ4/Hq",
+	"Wu11+s/B5qtfn7z7eXv38dOfnj0aPvvt6dujzcGTp2/f1lYVtb/Zxp/KK7Xc1S98FXsoPdaImYfRRXj8",
+	"H/PjP524salppfH7VutZM69WkIQFmoZp3fwAGYMz76QxOm2u41hAJuTZRz3FCzABRy92waNHj57KczGF",
+	"on9GlNzB8SVq5wYjOXr48t0abD3qDTZ7g82TwWBH/fd7FEd6dEnPdvKgAqfm8fhDTSIaSREC8BwlkoGn",
+	"AAKOyThDAI7HDI2hkMwyy8AFMvckStV5RzCZ2O1Sh0KtXvPV/hk5N48Uz4SAIY7YJfKOziXMijnoGAd4",
+	"lcPIH+bsm+V+iJfeyxMauBtIuoZ9FHTRLm6tvIvvFXaP8V9o8UbG5U7q62nBfiqbAhGYITGzNoaSKnJ5",
+	"7Fs2Xm1VO0KuSqC7Xu3eOmtrP8FT9DslLbYSRVOS4CTwcnq7ELWjf1GCAOQgRSMsV23k/v3h4RDIcYEc",
+	"GDyHAl5AjsB3EyHynY2Nq6urPoYE9ikbb8iBenIg/r0khwbO5YCnJ7tqQjWfxXXBUboIR25xQTkgOj3Z",
+	"rVwVwyliOIEbh+jq42+UfQrSjdmoYYYhX8GG4/ZZft8iztWmuGOFwszWL6dby/VlhtX3l/lDSpg/o9ky",
+	"WpmFbi6m7Li3V7IVtFZxVfzvGUyP0J8F4uItoxcZmh6Zp/JhQolARCEI5nlm9LGNXL/53//mlFTmlusW",
+	"EGfRTjRBMEUM7OoRetK2CCaQg4Kg6xwlAqXm9J1Vhr6eZmeRpGcBRcGjncdS6xBYqJU9gykwwJYrKxjZ",
+	"MQCpTd25gGmPmbduunIQs3iNoOrm+bPexJGWg4YZQzCd7V1jLvhaMJdQMspwIjAZ7xHBtGieGgnv3cHg",
+	"eLB78Pu/jn/ZevTy6cHPvx798vbHSGlcUOoR8m25xzl6C2dTRMS+/DTHHx+/YcNPk9eXMzzB9Gm+vTl5",
+	"ivEL8iwqybYktN6mltzNHhqTAVKLrGzK03JTzEtQI6R8uW17zAudd6Yd36HN0m+DPTfJIRUvaEHS9VK3",
+	"YgaKl4/k4BXcPC5xc0gFeGFeaMMHoaKnB1kHsZYz6rXvS9AlPaA1Y8CY4xUOcDmJh4ntwWYVE/uV1+bh",
+	"wx9wXVjZr455QukBJDNzsPl6kSPZN89hggCDAmkTAEDXCUKpvPQZEmwG4EggBjYrB2vLO1gnlAIJomU+",
+	"8w6VvfTXgazQvJqbqwvjSALfG0rg5xmfOEooSZXx6QpiAS7QiDKkl47JuB9SP0tTkoTqlMBCTCjDf62b",
+	"dqWdRiqSlAFMLmGGUyDoJ0Qqx9gjXh+SOZtQ+K+tYydOawOeurtzvfjw7mTEGGWVQzzw8eDe2zPvtePC",
+	"vromTNQgvHGjKqLcZQgKZFx29rJutVYZC1ldRrMsTSmg9g/KgNREcSJ1gxFi2pIHgZWy+mfkBWXAoHUH",
+	"7L497b2iBeMxOJE0xWMwfLsPdmGW8RggkWihPq8YzyBLJvgSpUEtTUxQBTTzbgywAAkkkgFfIO3eQmlf",
+	"X32SutUvHNDqCJAhcKG1uJReEW1zlG/IqzvHesXyJenyQGl8RqqOs8aA0rQsf8AM0CQpGEMkQRxcTXCG",
+	"2mDvn5E33rtyumSCkk8oBXAMMeGi8uXVBBENIlTj8EIu/2qCk4myvl4gkEGBpE4KzYsjmGUgLdDOGfGh",
+	"0t/OBw5As6ocpcqaC/DIDIr0oJaZYQGuoLcjZ0Sqt/58cnNmSJTzIlKftiCVifWb/aqVzxAsQzB9Q7JZ",
+	"m6fIGOCN7UYbzPgtjLZvcv2RNs6Xphhtc+V9cMrRqMgMfsxbPKG5WtgFozC1m3IlLeV21YLB5JM02Q0T",
+	"gS8dNhRpQTPMtOACTOCl3iYudXMzrSRQ+09lgE+xPJhWPddahRrJAhwDeolYBvNcngv7LVTX0b8VV+nP",
+	"txWHjMMKzLCf48QhQ8FXpzPOaYKhQKm2fEuaSVHCEOSI21N7MdNWmBpgkbqn+EdBBcya2p1VHEMA+eYN",
+	"G2lRG3y4b3hWaOSCYPGW4QQtYuOn7sWbG19j/UMD52PuQwCvmpVrOT6kSRt9g6GcIa7EObnzUkM3PiTg",
+	"3lE0dIEkxvGYWM6tjVxnxKreAX7sa1Wdz45HPUtrYg0sSIKFY6S8krybivSm8omv3rXSpzwO5i0gJtJZ",
+	"pRFXcI0sdVAtSY4o07hqkoak1LfK4tcN1FPvgzqRWKgbtDHHK7F2n0SVDFVkhidVVLH5UqMHMuTwhok+",
+	"zuACZpAo0cEa/RLfZdEUBKa0IC07pp/J4XX0D9iFRFJ3TjlWbJQyQNAYqn8TJYjXDrhy4Zb2Y1pcZJ7x",
+	"WH8i9zNRa0+HLYAo46hkIwoOdQWaL2rzLeWIaLncSiozt+osDNT+8RvweGvzR2BfA6LEGeYgRYROMYGa",
+	"X8S1p1gKQlh4/l1sTLVIMgizxZgAWI5fl6Qu9JQJ5cLyWT0UHZU3nOb4ajKQSyZpry07bDmbetV86FhZ",
+	"7W03UTnefBZ/evw8dILRaITUXdy25+4Ftft98JbRSykfmuvWWq0ThDN9BBx/Kc354LspJoVA39+GTAJs",
+	"N8cMalA/RzDL3oyinT+6cCGF5z33ueVHH+LoimGBSmKcH9V4MkHWvGt3S9nG3d2vj0nz5o8lkUFS23Ja",
+	"lIJSok+4YisXM/mufRSX8rBPg9qm4CblC6hhCaP6TfwAbsUcMvNpaBP0U4PupbfgFojJGaYMi1k12CcO",
+	"gWjetPtiOLl/4id4PEGsfFNusVLoUQpGmHHJj97ah3JF5QWQogRPYWaYP++D93LAjF4hZn8DmKTKNEDG",
+	"diZ9X8prqqrLxnJoD95NOduUymuOjSWilWBffWerf0beGyXHKJscSbkkswQJLyHO4EWGnBNRyfbmUtR8",
+	"mc+4QFPAUSbvSf+qkeuRfyrQuXBzK1crSJQEfaWmNtPxiYTBTeNgzdAlymJv6CSjXI4ob2/BQclVKh45",
+	"twP7hg/LS17u5RW1MyqdRft5EpjZGTHiStD3xpVcjVcWrGYquH+5Kgr2blgHQOVe98LItra350eRxRGj",
+	"WSbly26ymhZ+7CfuVHb+VEWVSyExT5cUKjLIBTCf3aFkUZM/1dPYSmLVq7Fy24SUFxV3v6ThyQ/YPzZC",
+	"oqaQfx2/OQTHCqVV7dRy4YqW2hMFu6BRbHTEaCfa3HoUinNSXqXtZHMwginqbSZPUe9x+iTp/bT143Yv",
+	"2d5KHj358dFm+iiJ4ojTgiUKW9oI1jM6nJRmLxHjegmb/UHku5NqLkg8rW/Z5o76rz8YbP5eQmiyCm7q",
+	"UvH8S0dvapOiVCAeyOEsozDtz1HvWxAXuoAkJMbQao9BbTP1w0rmhfzIhK+AAynHwVSxKEFVxMjW4PET",
+	"GzEioSTy4P5RMeIq4+0Hn/4bT9Whf43IWKo8m3FEikyx2VZxWkLlRw1UrI7WTa2Zr37NGFvkYvQCOBC0",
+	"7x+6guHl4cDpwvnVTlZ2sCv5VmFpzG2pe8H8asevhRS+tdEREkNdE5jniKAqedXPio+fHkMjpIyDHaDz",
+	"z1gwfEU/tHTmMxJeYSQaaodKecfwKsj6BC8CqM2g8Fz9dWHJRb9WSTxyiotBZeVZzmhaJIiB71x8hJJ3",
+	"9fZ8X4W0ylsWQKxZTwN3eIq4gNNcguFssqXdttzW0HmVEV791suoxtmCF9KSJyTMaao4t/xGI5ShDHox",
+	"x5ThMSZa6CtXWV3DnIwu/3ZUSDfHpkqhsb05Oxpw9KHWF2ZX800iKVx9yDd4+qk3phuXWxvqBwXpnktj",
+	"W9L3Y8+zQmNawaxieBazToFvZNmZ370rO2Tf6WpZ8aC5L/NKBZqm2dX7u3p45UB1wF6/PgCaoIKm5EXR",
+	"5G03uodcDwC1Qco+YqPHq3d9dQfsHf95US5DRSbUH4UkvpYfVsjMrOGw7UB2l6RrRPRFxWmz5V14giKo",
+	"PS8hdS2mXTe0gtB4irtbjIIO5pv484rn23k213O4OwlVRwimlGSztgDOW5iKFjKXpl/ynb42+FzJxtmv",
+	"wg5IK1RQpv2MaAYE/GSNlbE14Fr/pP3qCjEECLpEDCQTSMYW9y4mfB4hGBKoLCK6aUWBCSPvfmh9yljX",
+	"iV14pTcZ24cvGx7R4KoG7c+0P6X7uTXfBY7qRTlUczs8v037mejqTplSggRkM+X1WkRfB5WXS09gi93Z",
+	"Ohh80pFkn8jPtBQ9Lo2L9rzoIXVcGm+sqtOiCr4cUA2AVkJmjXTtHlpwSmwFKLqkourhneO+bzAcFVbg",
+	"DgIxnAbIXBwA9SGmJCT4LXSwlAzATCU1/Jkaea03w3ojRE5KgBvsNkgGWMfE6G9WiLuoEYCP1m4O4yAJ",
+	"rN99/IIhPiGIB+63V/SqTPlU+rKg8mR4SaEGaWUYKQ56i+0opzKGqznR6ZwsU+3ul0Si4shMCgyCLMOo",
+	"9F/mkAlFFnL6w3J7CZXi/ZghztVFxZDEGUrBDIk51Lr1aGf76VLUWgLQ4vKxUEgHu6DyM6uo5YiV8FdF",
+	"bSBojpMlLv23dpxyW0OZYj5lepCH1IalkqT780KAKEHmHqztvhTvvjslWMpSMMtm4FSP+xpd44SOGcwn",
+	"0kORzcAxZUK5ZJzdi31fE/5ePtn+/cft7eGL98OfX+1tbh3+Ntj95emLV5ImoZD0H+1E//8fg97T4bPd",
+	"53svXr76188Hh29/OTo+eff+199+//B568nNPwJb/Ll9ZVN4bc0kTx7VrSb+rLD316D39MN/f/c/Ox/d",
+	"H9//EJjuQxOAaJ+MERcoXcV2PyQAm8+NuqXsANQGzqngYm2DU3GrNTM0slMuY9BfwoKffjkLfrlyHSrc",
+	"yJnQuYHadNO4LS1e5h3KPfttYyqfpmyANzKGpuW0V/1V6ACXAWrLKJDmq9UVRxOg9QD1Rg3ZetVGgq7F",
+	"EeJIzMWLvS3RdRmmnAKGPFnEoE0moEFz0eoM1TD2tu7AENJVzWqJbyyY8RKHFKT7DY2bk5G1lEmvmqsV",
+	"tyfDmdCKMhvu8Pm/jrYfbe399PLk2bvj3a1ff95+/jjqnND2nQnS6LcP9r2f0Ca4UNzKDArKweMIEy60",
+	"HqmSIEzi4U5GE5ht/OvgTZYI/vO7n3oD+X+bbUl0u2a95c7CC1qInYsMkk9N/hhEz2J/vI+LptgxKaaQ",
+	"9OSilSyArvMMEn13udhF5VDA3PeVWFFRU0BVVLmg6ayMwtX2W0eyTebjUNkE7vRoHzjXmfZE4pqT0sLY",
+	"EbZuu1XzbTZgtrsZYk6vTk7eAv0CSGiKwBgRxJRj5mLmOWaUBcWVwumM3ccVjRkT8Wgr8gJBtp8+9QJB",
+	"1MvNUBBDf018Q8AnlIm4ThW8mE4hm9Xg0k6ACnqDubqLfFoqS1i6CGUOCIBq10N73T7t3GzgRdsZNl1r",
+	"HLmtdkdomQDluenCd8Whn7VZuJ6V1q0yA/1W+qXwbGZS14RZUmgXpDKNzFE/q4qlHGdkdaxasSWVK/OJ",
+	"0CuyVhVTHzVRhq93k+e8aNE2C5ipgdGOG0w6ySdl+KnZuAC/eVEJMDbT8eXt2maGpnobR8ps1w7BSRl0",
+	"asMVjfu5QmGdgPHCx+YAJM3jSjoMAyMfGylwroh6W3Paw8gUaZGPpSptCOpezMJzc05qgSHh7fCFq/n8",
+	"uX4o6iRaQjPPLmy5pRKkdIZ4qNSPe1jhmtrZUXEPLOFlYGgKMVElrfRrcqfkLWvEAxfrrUtJyhdF1Tkx",
+	"DsaNh0zrzSQPx4cWKp12Qlvyx2JCpVUwfGnZvNJK3bB3Gq2wIB7feR10VuntwrsnkA+TJGjLfT9BYmIi",
+	"7i1JKCau3rcM0O2jXIy75gyI8nW3o6w8hGhUN6W2JX22V3U9Ka8WOvKBbBjrfeAJXUWZb6BtecfVBZpR",
+	"kpZRwCg1KTo1QELkbBPm0KiFmi1i/QnL0o0FR+2scC7DGqzKBVtoszv/K7mbXxu7pNbY84/ZzagiqsYF",
+	"ukm0PqO8K9HVVcBpsY4g+Xz1BDrlW+uUQAf2R8DE2l5kkrGe1PPGQulYJt2k8kc9Bc1LJYsD+WdU8pUr",
+	"zFd1Oi+bLWcg10uLu8PeMcHsNvlTarMDyTu3Y0+3NnX6RLgug2feKYnW6h6t8a0Bi7FG4l3ZjbvnhKiT",
+	"7XJCiMluDlKEfxxK2gQ5xMp5EKv7y2YRuQvFiERdCbYn6y6vEHpQFlczu1DlwhrW+eKmwcPOZxfz//Jo",
+	"eHgSxdG7N2qQo73jPfmn+vnj6fHw5V4UR8ODvcPnB3uHJ9WMAPttY288ZWo4RSQNS7juETdBUQqrArEp",
+	"t8UUJCtQcp6u9lFNCY0BFCBDUueixN3s+ntrAaxEW3Xn2wRd2b2vps2FZWg9C7dCtK4Ooc2G1OPWouR1",
+	"AqTUFOsYU3cF1Ahla83J09AivMuh3Nxeg8zcPQZFwYZSs31lJEoMjOVcibaEXt1RInCX5YYTgREfzqEi",
+	"L/vO9xxpcgrC8mg1fjhu6+xx4mHXNfFY1+1xiyvNUeN677NOqsn+8/56Q02Xt8iEycUOVaGTGDCUZzAx",
+	"pujgO42wJl7kUjg+wcknJOQMvZYUs5tOIniNmd+VFF4xwK2SXO9M3LcLvwzh9FbxguHowHlVAQKNAewb",
+	"1s6rbslKf56gSb31mkONEdXLIMOfENjcAlNKxKRhltsKuXXSoqyI0GUi+76eS01k5jGCyas3p0dRHD0f",
+	"/hbF0fu9vZ+jODp4c3gi439+2xseRR8WKasOpNjgoF00qpLOShEWlYotTeJbcFXUkrQ7XBNrsmrdgoEH",
+	"gXtozDuYCaTaHaVzHAouqMPTC01BNY8rr1DIYSEGZA281pp8Tjc2lfJUOTgp/piKfGEvyH+V1j5IZlPa",
+	"sNZ2rPUWUkcUuN6+LWRzR14lgEBRI2ArBUif7AiPzREOVsyC18MWEf5Ae6Q984sdthLKUkrQSxYYsIs4",
+	"sck9DR/uh+6XqsPInd6pFZDDrgGLeQmAxFnBZS3DHjg/2jsY7h/uH778ODx4c3p4cg56wI7naUPOcNoD",
+	"52+O9l/uHw5fh7/oaULV4syoyEypjqrp1dwD9cmjOKoNXlVK6w+7N0KroOhON8O2XGs7BGXlS8V0nCpM",
+	"CYCAIalNSoTb12Id2KhRoEVEeZ9mMwCzjF5VHO9ushVNmXSkuysYbri6nLRszS/Hke8rO7Va5Wnl2k4r",
+	"GiJRBcO61tudVBK6rQBQ9jf8W6pxa3WsE3QttKLVqSZaJQxVb4agmm7uMMj0NsWnKjR9y5JCyEasLTqe",
+	"R+Wb/yGliCq87oHUJCq5YQX3Swg67ta707u1XcAxJ2SWG7FGWbelZLJfr515US3lSMqCh9Z2VXJiBVRV",
+	"ZFEDV6US/VNIFpEfydbKXH5ZW4fOyCwlaL9F5XOUyTybFm9bap7qe7/SgdPk6FMAwRW6mFD6KSgKCIFU",
+	"MYXg8MQV3XcT2Q/AFKaoebSbB7hy/a+rMmOXLI4mLl1eB06rwCx3lcqTOtRoWOuq5Lguz6S5GSrNxzkK",
+	"JbcweyHlAyzACErDeEshXfn+sQoz3aVpy8HxA4rNNLahj1U169M2xZKtcDiwf8+vind5/90F3pnreLsq",
+	"ZPNitfUzi1B7jjRrert3+Hz/8KVkTpXTLD3SeqEojQFlgCHBVEs8Ac4raDjXetjx6e7u3t7zved2KHPk",
+	"zQamriI42Lq+9jdaf/5iuP9673kDDAZleoiOSzPHvsoBDfxRHDkAojjSo1UZo/98ftmRNW1qwbLwfljM",
+	"IJLmFBPhV5Lidu3KuFK/hSdC5HxnY8P8ou4uMxovL65lY8GVYYXZRsReD025AsvqvBhxx7G7XcnBu+Su",
+	"buYms20pp6oq5wFubijhbYsqmgWbzaTl5giGx+MyuftW8ZDmPF7Jwj6toup2JwW3ovitykJMWNq8YNuq",
+	"p3o+5B1185BWeDpXGVT0GBuGZBz4jmFwRNKyJOr+89uprOu4M9bA28uIu0CpuM3QJ2LCEJ/QrCplbHXb",
+	"kg6BN8zqDLUDseaQG8vlnw1fDw939z4+23v95n0UV//++HbvaHfv8MT7fe/XV8PT45P6FdD2WQf2aCB1",
+	"XNJoLX7DYRexWEYm2iO1PKNcrnbcbbjkUdA8eFjnflwzxBmAVUZZC9p2XsKEUc4RBxA4WjQRhvZtyz5M",
+	"fYZWBhms9q1kEjlfltVeMPPrSg5eaQ6OxG2q1jWvg/syD3YMB1fNyIQphVmiUEcS+TtUYgzzchP8lgBf",
+	"xsjXwPAXsPN5vE3iha8XhApXbjd8M5AjliAjGPrpVXSaQyMZgiPDoqQN4bzC2c5VNe7zILc714e5oTKF",
+	"LoZAEUHvkrCMP7AWViCvDZXslVcpVGKFqakltsW9n9xsC88pq1iz1nI9Ld7XVktQcO1a86ptWa9yTmWL",
+	"KZTRK/WNI5uK46tlezuNY+nL57AoPLy7SuXQh9Qf2Uvj+CL3dHdjZ5h535O9cxlNUAOqwNkzv3EgY1co",
+	"zS9g8ikGOcOXUCB1xDNMPvVUfjeAacqQvnBNlTTlkUPp/aiQRhySC11e1jm6S1Ptm3qzqVobuEYyjJVg",
+	"+sA+k8m5oSxDSGbOLVnJUZUWC6iHNEcMM6BamJDUf0HNY95LablrNkUnJK/YbjvzfEdLsvImXRr3iIUD",
+	"5Ig5OaOhU32edyvubA5C/ie15pbqdGZurXFaCIzgYlffTdmcs+pusYhV4rkrEm3J831ti6VwJBYHqXQM",
+	"gKZmPC/lC+z7vTaND2JUmGTLE9d20IY/jzJK2T03QrqFWKnWu045LqQeLl+rT2/6XZHUabVrXbcgQ999",
+	"2Uj9P/XT/its0hOfMTfk5bKbUhfHoT+N/bTelhgsdYXJRygFJpRK12JkLuldF7Hndh79yAB3IStwQdeE",
+	"WtRrFpS64E0cHSARbsWoMAxUPdgqcLpTBBphgjiYSNGK6uLcirvD8ZihMRSu8nWVU9rHepbj04OoUSRp",
+	"X/drBrbqJyrrvpd1s8e6yKVfVu0fff0vR33/6AvjE+aqGF+9BJ061ebimOn3bYPM8jjLGLf9w9OTvWah",
+	"nspaFhR+RQKxoff+SjXZtQM02NUTnBpsLWw4gPwy+m2NVWxgmtvNfsfy5d6+tF3O5TCNHWuL2Zde21RV",
+	"GHoLxQSg65whrmtICwrQtWAwsb3ATFFXtbncpTR55eT74Gc0K/OWDPOUtJtQwrHKcVIBXfkEkmKKGE7U",
+	"04KkiPGEqsxzKGdEjLe0nplDiw1RAKed6kS6ZLgAFcwt4bhQOudzS1U2qgS1gqT7adwWifdQKLJx9Otr",
+	"D1BandDUCCV5VQtF/hdXlAYMv5gZBu5eMh9TBo5PD2IwfPcyBgf7h7FC0cHwV+CxFq55sI0DYAACvQ7N",
+	"iE0XwRwybstzu/agsjj36eH+L6d7H3dlhKY/bAxEE6Kympeeog/kEI1vSwRYFEoY8ZjQgKHB46qNbfDZ",
+	"7HwG+r58s5EpL8k3rnBjn8tVZlkgmqhDy/sHRgO8jWiSfxpv6OEUwI0LIGwA83FcxgYHjr6xN+gLdNeE",
+	"3/p7HcXR8J10Cx/sH8r/P/y17g0+WBCw6yNjWEHuuvHyS4HY7AhxFd0W9h3KZ34H8T/lJ/1Q47Q/PoeE",
+	"g1rN1XpB07baqIrIdSKOpqI9kvqi/FZFlDeEJiAT/ku+vC/lSsk02vUBQRdOMF8sWVPhaN10X8/IvWIa",
+	"U8x52dXky5b3sjGkbriHv/mdSmR5p4JehapjaRJaW6SGoC2Dba0w2FqYutrZUBZZFTUBqYXRK6vDd2Mb",
+	"D5lg6me7g3g9L2664/padIhyE5YpBKYRrzskzun1tlBvMcj9HEyPLG15HuLXTdF6pz7fSZ8WvbrqVP5i",
+	"lhBc3OFY70Vd73Myp+xT2UVDlfNQlo5w5Y6l2p0Djm2hsrL8X/+MHJtKISNrgh6HOrrncAZUYRHhCpBA",
+	"prsgNDq6N9rCdE/eaXaIX7jMFQOH5tVXURPbWirN8VdJr9bTtRc/CRr5qlSzfmNfoHfDsq0kFL126CWx",
+	"lJC158audNpQFGc7gjRKpa5PLqKjUWvlTP2sktDi4tQVaA1y2XpcLUX85HEUii92q6zwyFAZ4+a3i8NP",
+	"NRI2V+WvJXAOO3FtE30oQpJHW7GAodo/Y1wNOayMrFb9So8GuOT0egDd2tzLhpAuWpNn3CxVFsXrFf6C",
+	"0KlYwi8HW20PFSIVvMHtoUzATBlDQ3skzVLSDgVUP/xM25jrxmntMVa3qOzfwsv2kMYc9aGSTNG2Nl2B",
+	"IHXp3l5FgjZBBKeLzHit7Tv0iiM6/aiX9nF/SJ4/epu/f7813HrPfpo+/ffoL/Qqe/nrT9fT3V+vXvZn",
+	"238+Pu4N3//5onjy579H8MVfg79++fPx3l9bPx1xMnt39a/R6NftP68PLmnA6t1EUsOnnGvBVKVNak9B",
+	"qu2BXi13m9DDnV/NjOzvSRP9Tn9qJhVisq8fbtYUJnVX/lkg81iwAq0nK6ZbZqsOEVoc41MpXLGa6Nqx",
+	"CMXaLMdzA3PLKmpVdhM8oTrGWnoyMNe0wpAoGNEZF2qvpOO3wlruiOSXdYC29kSWXEkKeCc47GITxkur",
+	"ks7TQgnFdbGwevjk0+Ec6dOJzVAP5FU9lZPVq5v2B5vdRM38hM6TNO34wFYbKHIgaFmH1tovdUgaRiGR",
+	"dDWJdLiMNFpuxh1Ioqs0SRkC8xl4rtoOcNNAA3wne5H/+NPgRxlFMHTjgZIaam07qm0TlLlOegV0l5m6",
+	"ndR2TJnbwaNsZTIoW5kEGz/MbcvQuD++9Sj51qPkW4+Se+hRYmxCOuXUsqe12oSOKoUB6sFJPgmNa8VW",
+	"lCvYi0kJdsCEJJm0ZeL6RRl08EtJs3dUt3Il5SpvURrdGi6QuEKIAHFFvSVwHSL9fPibjDreU5ldKZzZ",
+	"cB9/9RpLOmZZFm8rP7hC6BMw3lr5bzlA4CNV6q38ShW0sZ81PtFpJ6S8zc0b6rAiVtax64FzWTuuHHeG",
+	"IGsdthpFPbcSXbkx9skCzV/vQGzJqWt0miPt9csKx6XYvEw7SmdZVwEB4QARCAquwwSQ6h1Wu/or/XB8",
+	"70SjXknlzblOijhKMc8zOJNROrLdmZH/gfq7i2r7Cc0aeoNfImZSXPCc6kIvsrTm9hN98zGcIzubepgU",
+	"/GN5iQZco43lf16xcstCd0UIf6uqmQsnq2yAP0t9LxaOtF79UG3sErrh4rpAeiKPosO0sdiD1SCeGpg+",
+	"HS3EW43lyGWHlEFz7ocZhnzlww/l12AKc+51qlFp3DIiH+iOsKZPPySUyDbAc/uA2ZeOuzj1jF1c1cgS",
+	"guGLQgQT9RdsdNekw+qi7yvh8BbB1hWA15s8Z3v9BrfH7PoE6ZmdIi4T5Ybu3wDpHHH9tkpfRdNcxacN",
+	"ifnS6uroGibCvirgJ8RBzlCCUi3TSX0B+t/4QzeogU0X5HW3UxzkjrrFBLVMUPBWUuuaItUktS9TDqpM",
+	"o26czAWiiz3kmr+sSWyx4EgwTwkWyobSzfhUtjupZS5jP9/C9A0A1Pp2vZTl8rC/7Ow+1lBAhsCFNh+m",
+	"MvHIUE/TO9vw9pYrwRwgrDpAQTDKoHA9KhAzC2Se4U5gxPiy3uI6yrC/BtOuyRt4ecvdsp1i/MpDIed0",
+	"WwsYBWSwyKaPnthDvDFNQvVE4lpNl1oVR6GjWm7DGA6lAY87xFuVwnN5+/EGdSJpCSE48Y2TFvFFLnda",
+	"ZVPo1IaymZAH3RKdD0v7YyOkq8YE3LZ101bKg7l+ZeVUMUCT3mcNKssJL3qISmRIDEYYZSl3JmJ5YDM0",
+	"EqAg8zqJsGQiW9IFLEb6iTyTBTGvNeM46oWYA06Yzn00CLoCI5wpF9bcLhpHkoJQqo3ipdddmxt0VXYR",
+	"rDgZrCtz65aWiL3UUWQvNPDr6aWAWJl7YbASA2jkC2vWZmhKL20xYv1SI5eyEqNGw8U7YVt9FgkMgRp7",
+	"7ZvvsmZ40HrQ4cCZ46AJ+w4O3ftKDGWN0r0gcW2+Ahz/hfywcBMaHPul/aumE/tChwBwD5Y1Wg+l+Cdt",
+	"LFjMZLXKqaa33YwW6S6lnzAaFmLSXLx6AQzf7svUcXnsQKLeVs3a5f7YvzSNRB8/cp2/Ua4V5vhnpKJo",
+	"1WBe3ICd8gJBhtgLe6BoDv9UwXkhUIJxBZEufq8g0IOV00+EyN3kK08rMdB5qsVL/PeVaE4UWpm8UOWO",
+	"9oxicImYwe4CKG6Ug1tT+nOaBGSF5zQppogIm2Wgiga4dH1HRn1MN1I5gHLYjGgo/ACRAy97USGM6M5/",
+	"Wkxw5l/p+NBCjuQe5YcSvSocgYMZLVStX5UpY/TfWAcS63H0mDp3ZwqJHJ8hjR5lCO31zsgPb3LETDqP",
+	"Ek8kc/rf//t/wHcKuu/lNVimfurUqUuIM51wRTzI1Pb3f1AMLcMJMl00DLkPc5hMENjqDyoI3NnYuLq6",
+	"6kP1tE/ZeMN8yjde7+/uHR7v9bb6g/5ETDPPKRNV8BHFkd3snWizP+gP5KtyW2COo53oUX/QfxSplKyJ",
+	"2t0NmOONy80NhTLFM9TP42CqN+bCSz3kgKEx5gIxacPHugX58O1+H+x579jIEivjNVJopWyRyWGMLEHt",
+	"JuynZs49mybElZ6mK20qMLcGg0jF+RFhiuiZECf5/ca/uQ5z05Kd/FcnAdBNFxAAG5erwgkd+Wjpy+8e",
+	"Dzbb5nEr2DglsBATyvBfKK23pL+Jo+0uYxxSsS/vC3kog6O4itSLoUHXOUpCoygzgHLpBcggiiMBdRiW",
+	"Qh1XFXpzygMkdJpzxARXMocboQ9045jaz7qep2n0hIUhJWxKC5xMTNaKRT4v8+Tc4VXDSCK7hBlWJgIA",
+	"x9JrqNUXvePxGTEDSFJUSdK2z7N+QY0wheyTImM1lKx8or8x5ULVTMoJqTvIe1+qD7yCGpof5Ywm8tLT",
+	"M01DtK+RteclyRkX5TOazpai+47kfhOUHavJxoUCqh/5qpCJ1brV0VwZxJLZGNhQak7gYDHNP4Op0ZYC",
+	"J+crPsOatLytDR3imzh0PWx8dmmbN/qAZ0gEJODn6vf6Sd+rHVVV/eAKsrQsCdQ8q/Lwa1oIHRM9kX9M",
+	"csigDltsrSZRvrLhlqPcLzL9q0bIj1tCxzXR6fWna+P6jwePF49xSMULqbD+51CcIYf5FBeHBY+XyCdV",
+	"ZSaUxBSghZdI3CEh3D9HG8lN/kZa80mrSh5dONkCGbdaJ4D7VtApAkwavvpn5LmUPkwrNMW4VOAa5l5M",
+	"LC/rAMgxFCM7l1KLISIJ7/lcsZcvTcVK6XnB6FR13evy8glVr9aqebiWBi7LxizMavHq21KJ18WyYu8A",
+	"lL1QBgPPrqH+cgFo+q85rU1ufQ79vGPXEME6sFuSLbXdSTur/VyYvHDVQKLtZHMwginqbSZPUe9x+iTp",
+	"/bT143Yv2d5KHj358dFm+iiJSo9gZNTYHtFefp6jpKKrzUsfwNO6UXFzR/3XHww2f2+mh97Ey69V+uXL",
+	"kWgh/MU+3dxKN9OffuwNnsK09/giSXpw+8e0t33xaHt76/HTRyjduuvFbrUttmvG9r45166/xVLanfag",
+	"puiiGI9l2Y2HI2TejYpX44LLqHka0Z4+Rxm4UNWnfGRK043VtJTFRI/rYnS9KnJV7qiHd/yxXSv6YeOH",
+	"aOfzTVzhEMqYo4H47xVu7eimfbyeWuR/z+dBXznrib9+htOV33TmM6WaHTgozq5prB6G3EEtbN4/QV4I",
+	"PaA6BEROLQdteY2bwdtGUfPAVGcY0saRPfaPrP7w2CpszlSSSDgyTiW8KloEk8ogF5hANgPm/AJJPTsG",
+	"LS6MyeaDc65tiucJ6v1wDiYIpojxM/Kd3/pRPvQI4TxWv+DU/EPTjvlDiWLmd00n598ra7Goo1zWJub1",
+	"cmVnRC3a1lEx6cOQA8xjcO6zi+ve1dVVTzoCewXLEJHO/PTcq/VCLpURA2Ci4sO8XYjPCCQzQFW4Q2gq",
+	"BTBDOWXC/KTNVVJl0hKfLWU933QT0HiPi0Taq2R30pm7Gh7QHfh46+niMU4oPYBkZiDh93GV7vsuiQW6",
+	"iV81t107qVaXbeoNtsjv0pqDLo+pKlOoWrJdFAjvmzc6Y7upSrxhKWLa5YyytEV9oPKlZ7OwAmHKlxrH",
+	"qfqjDE4Mp4SXruKmcJJkRYqADVMoEdoCGtYfmOCFNAxiS6zCrbWXTheN2fJlRNpy0Q9aCB2VxOxODlGu",
+	"r6kcFny3d50jhuUfMPt+jkjqXAzVcIPq8dEvWWzejb29ModrkB+WCbyuGZreu9jdN9cGqiOrJnTmkQv+",
+	"+2ZxX0DNetvtjnYm58DtsPHZ9VrpZIB3VHQxA5/QrN2EXtL9cveGA6ej8dzSzkOznN+VzXvZPZ9jBV+8",
+	"lS+RuJN9HNwnV3lYZu87MVgvTxS51AtDOQ9STImtOFONrYSVTlQ6ZlNZocPBgLIcrfqXedWPmTQNIuoB",
+	"kMQPF75AI8rkBFoxUZUoEeQF853yZi6pvenBgOwZIh+RM8IpmGBV/CmxTVy47TqhYeqDGohllHoJm4k2",
+	"ySHXKrWGy0UUj/wR+mdE4zA1xY8bgqHWfuUkE2gCVm38IiRaG4N63jmyRSVE99Znc/1ySTCEuE0u0WHB",
+	"tcpvKjog7Sil3Cs/sfkhD0hdfWg8SW//reQTv7pb6w0mexG4erYqxsZUWvPr0JbhNZB59a8hn5Fkwiih",
+	"Bc9mcfWbepXc80rhsHNbK1d/U1bKvUCqWK406Lfcpm5Zd0nDbpIAFXvFgB2Ov2bvgySTIrDmBdYTbYpY",
+	"YDzRyXcMwXTmQhPNh232lNdm3AbLrg6vbCB2rEotFGNPbLUvlMlkAbU/2PFDRcXqr+QEmV2Znl32Uwx3",
+	"N6+ns8xfg7qxLfDa0w1Vgc8pziDzStroMg4CXYsFSzzWn57Qmg2lukQ5EBgxOJ7aiodIXslllcyAiecr",
+	"MW15eYWefQunIcPWvViWNPEvY1hS5YRtEKU9Wl+7r3QeW1mT6UoJ9vqSrhbo0/O0m7PMFt6lNctSSVha",
+	"NFxJKMePoet7tWPNB89AZPH4kGTEDi4Nvbahpr29a3xPbg1rTg2R4irCo/6Sb6Dyg9aLfHeCEtXX2aT9",
+	"2ptQRV4rL5WurGD1EqnVjVG1T63KYrRZ9KXWpp2gHhBSsEwR0+qg7SNTa/3sbl0/RT0G2m0guaDXTIwW",
+	"wpvumdVw5b2ayLyPNLZF8RXWlaBKqAAMjTK5Rqv0TmGqsmq19mlroukQlTKFcQqvARcwQwRxfkYMhGqi",
+	"GHAkPaQwS4pM3zTnfXBsb/tG/0el+RLqI5jMnHXhjDhd2eV0UPpJ51NqrVo1lihVajuewWWQfcl91vTt",
+	"0dEiOcwvSyAoSOQgiyWvKjOKQ6Eb9u1QL/ubOASGb76fB0ap3HcDZJlm13dqymtuTiictXxcOyOWTO2B",
+	"+OZEWMR1FecTNRalUrY9lJYurttwYs205mtT+h3FdKZFJnCeoW7alK4UsWJsqzLevbaN0u9U+je+4ncU",
+	"pzy6T4lbIcinpa7it2HXJiv9a5e75xHgbYj/s+3Df7NhLqi5Rq2aUABL9b9S06lhW9J7baSAFeM1zBGo",
+	"lYan2HBb009Xp8IaGPkOcPUHpKlc1rF99OjRU6DLE/TB81p1hLO2oG9TwSB0Sd2mpPzd31kW56GTpE+Q",
+	"8z64HN+v2yG1mIbXdKDUme25MqOL7XVMlXl0grRXobQKZ7siXLt4jt3cqx+5+74LLMzL3AU1dPX/A3h5",
+	"Ce26zCawlX6k/tQkHzA0r2EOMOeFqnqlm0M3Ky4zV4E0PiOqTYicxlRHMlVTgSmLRUfeALziolReDj2X",
+	"LGuGsgwUJFN61gTN1OO6C9NrGN5u9KlSzi2Jff3GoyB5h400tZ37QgFRHQF+WQX24cVIPbgLyJ3VLif0",
+	"bu4h+6iyu11DrGpgX8zA/vM+OKRgKg+tkVK9U27rJJoPXL95a0M2r8kvPqFctMdsrfecd1WLajjqGPdV",
+	"OxZ/l/CvKm2sIQqMmybq1ZMyKm11mvzmqRwPkGAGX5hL/w1iziCQcQ5ZnXbWylAXyPMV84Qphr68AM+f",
+	"zZxX665J929oNnqAEsvdqByVVa9P4dDHi5JutN0Q2B+eoN4AcUF8oEbAlxfSvb1vMfOo1/4O9N6FONd6",
+	"D1Tk6QVytGSUpfiiITQytGoeC2XSIWVAZx8WHKWOU7ng30sqo5D6Z+Sd+ocZ5YqS/1KPTR9M63IyNq3/",
+	"4s7mBclsSsOhu3LE259Pg4glbpGugvVrH28GD1+tIKNoxaeUNUvUWiZZTpK+Lwn6HiXnxazT4etvJjff",
+	"LZvcgFNE0uniIkW6z69913Wo1Gn1QrWNSxEzBkSZxWEy/J1x05UpcqWJdKM5mAGB2JTH5RQo1T8pg4TL",
+	"LzfzBKNhOgjxw3Kh/0HHZ1lh3K1yecN9ubtf7/HyLP/Qp4fbSeIK6V4n7Ng0S8mxJkdgAr9sKyP/9Lgo",
+	"r2ap98qRqx+ngrcfJd967wbQQOlsp1ktlsxUFGPoimEhkKyCUx6XYMZT3T/gprEjK0B1AWQtIgBVKUO1",
+	"Pm5KU+bYh46xAuRLXn536ojwzmtYtykRKyhgKKEs/WL6zVxgX5an6pvzYR4P0txiOVly/tVuDvXCmBHv",
+	"8De0sXlC5ysz/sO0fs1PmVBt9lxoiiqmWAao6AEWhKe0RqNI1t0xhPI20SmNJe2R9FYLWiLehj7AaJsl",
+	"RKI9ItiskyRUCcKxB+Xrj8CpcITbh9N7PIlQ4Sp09Vi3yBv/G8BWDLk59AY5+s+JuqmDvYz83sTbA4+9",
+	"aQK8xvAb6V1TE8xUcIxsCjOh9JNubdiqQeomxl43qvrjhFHOVUUfMWGIy7JsMpVfUSlkCCDZV1pl9uju",
+	"wrJJn8wa9iHCkqKJbbMoBfeEEt1lCVyghE6RKpWgO4vJauSm7cdIaNXaez3JEGRKYtaKgV0lQwnClwrO",
+	"8yZlqUTWc6CqC799c3ziSs1xPCZ+1QT5t26GkjBUBtKrnvA7Z0T+cf5rzzUD6b3X0/eO8Zgo9NkieVK3",
+	"OL/c/Oe5qcBZRiNM0DUw9ejAq4Phbu/41XBr+wmgozNy/jk0+H560w8+OMFTxAWc5jf9z7Jo3o2scvwC",
+	"qs7eKcrwJWIYWXOCYNiuFF1rQsYwU1Uh6GgUmzc44Fap43Bqi++FrXO+v6Jxjh9oGFST34T1j8ZR/bJ+",
+	"li5gHzZA/hYS1d1h09zwu3TeNMUE+7S+011jo5rwG9eOauGimcEMZHTsgirlO5iryCfFeXNEVB5djXPo",
+	"Ag7mx7Ctwg+SWiMj6KoJNVHW0aPTPDF/l2ipBrWszb0TOEdLxUw9WPoZPAiW/nfyAzVI6Ytw342SHy5W",
+	"5eqMNsCXYzClKrI8QdIOjhkX3TW75yUsX+ZcrGLR+kLqpMHVbGWVstz4r9wz1LLqNZ02eokYHKOeal2i",
+	"9jEvRKjCnmlCp7yq+hugv/GvkD54Y54pb5D8h6paR5V0Ii8YmTFv3DC6RB40CliZsa+Hba8fp4nJTPRa",
+	"w/1AFZoqkC3aTBOdng/5XsvIzS8SYirIPcQiIQ+zkNz8s7KmA8wQ15de2AR1JB9zj6LKcC9lav8LMVqP",
+	"QbZ59LqSfk/XvzTVHb06HoBJ48klYtWOpqGDq6C4dbjynR5VBWKI9J8jAXHGXaUUnXX2BcwMrRDWHAUG",
+	"wG/Hs+14KkQGj8SazqS6+Xra5DrPPnDktZlX3xgzrc8m4kZMBMgokX+ofQawEHQKhbbsLtL/VRnFtxqu",
+	"L+eBWPMV9PUaAubRxTLOiZBEd2yvhbYJtHGqJDxDbkLnAZv3LyRGIPPdAXqP4jPC9NVjiyHZ6GOGBKP6",
+	"NpFllSmzg2BUCRLS4ywSA9dI0Xd1s/ggttF1bQ++CYBfjQB46/PrXTWWtOcZOvQ7/aDJ4kB/v8ZmknA8",
+	"ZmgMLU85PYjqhDPcB6rbmUZEFEeuxWzZTSyOVBX2ZzO/Y9o/+vpfzj7wj74wHelVd7TB5ssn27//uL09",
+	"fPF++POrvc2tw98Gu788ffEqiiOeFeNox/RZ+yiogHIg6Q1Fb5lEjJjpEdULURxdYZLSq2P8l5zpYP/w",
+	"9GSve5MzhddlLBl2kx60Q3xqqcXSqSGfdn/3D0NbJ0Z6c51LVHc46/9wRn7YN3HZOSKm9xgwncliM5+u",
+	"A2jqUZrw6op+ISvXIznYGXH+IfVpuxdU78/d8Hiz92GOqeBav3PSncCv/QDGt92E3W+9ebqd+P/9v/8H",
+	"mOM0NaelcewbN9HGZ/W/++kbdpwV47m+0IW84Yw4d6k+NsodBSgDkpbaNRt7uJeT/SqAd/RIaoCrXsiv",
+	"spu4JAazGa3EMKdDT2D7Qi7FO9q4wTcGukYGqh5UHJsP0Cm5OsfS5TRbJWrdDGBqcGA6ZzRla/XaWsg5",
+	"vrve9l1efV/S0HKfyGC33ynp/pmuYGpqLS/51Utz1Lp+tez7e9eqdMU+se1T1sBmdD/oPz6H+EStN3St",
+	"l3Rrz2bFC6KdzS179vdI6ofBb1XC4A17EJCJtlh5uUqVP9AaSy/owgluwX/UMTpCXPIHeXQFuhYbCb9s",
+	"UT/NjB9VzbnY/IFIGhuExQq/scRnrHB1RkLLims/bqofLao/bsbe9sSqB3m8uXVGgl/VULO1eKitQWOo",
+	"rdBQj6pDbVWG0n3D48eBxIY5nXS+5lIPHuNe7V4wNLTA2GLfMh40rYa2m16O7aBfROgJWXBqLKWkr8i3",
+	"fSxqotNq5yjb/qyF1O7QzuFAXUAuOWUCZlWqWUWSSCjhxRQxoAeUxnbMASJprsoWYw7y4iLDSTZTAeFc",
+	"KU6Cuu94ixTyVg3XIovUuwwVBP9ZIIBTRFQiAHNOTkfJKgMrh2JSJmC5NXfMM6uJsMt3LvqqRJ6VhZe7",
+	"9Lp9u36/Xb9r4KsoKRiWeukfnyPNiE7k2R8WYhLt/PFBkrwy94SefWhe35o1Nm5x/XWQLRtluI0Jd7BB",
+	"qRuhxpy1+t/azsFbzcIOeYfF9EL3yakMrfPoRcGIS4SVV8DWdlu7FBVOFO6mtrUdl4dU/jGF13haTKOd",
+	"zcEgjqaYmL8ctWIikHb73UswqIevZWSJ6l58M+h2sOEp1FXwFjpGC7MXg8eh3+Jr8Xd3WY+Lb5bTYaEH",
+	"VtZQIFckiQ9tmnFnpbNCiGEHjr/i5fw467ucF4C5+7cp3OdvxjL3wQYmlzDDKdR+iRV9l2dk3w3DW64I",
+	"z+jd3vSkHKZ2c6x0WBS5lUPKeOrm4qrP66zUmZI+SxR676rVzB+tekLUB/6ACw3dzRndoV5mETaEtWzT",
+	"4SBoYRI3FTaRa5O6SSPB6UqLbqjJa1pKQ2Oyv1A9fIAp7I8AoQCnHimqfvS2TWGs5jUTXpkm0OUx6VTO",
+	"J+Ahe1tZSGW8b/f14vvaI4uFt7bH7GxTPphhyFE3kxUwL7cJtcZUNTRD3odc6E+5gpHJregrJ5TQLnoE",
+	"4myM7YLdac4RExzA6jB9YDuaQqJ/Kfvtcl0YAhJKZKSxd72ZJvVlg/qRQOwKspSXhRs0lGk5HUMACsHw",
+	"RSHKerSN0WNd08HVkfMYmcqLNtFB5/YF8/wcoGuBCFdF7nQspfLYygJyGl0KAIY4zS51kJHLapzQfCcM",
+	"jF8YzqIHC46y0RlRpTHci6pLOOYtw9CRaVhqts4fV6MpHPIqt6xyQu4mnKl6CMNCcYVq5O4VCrr7lYoX",
+	"AXpcAVJD+O0e6hK6KjFV3eMwe2m/gTY+c293uhZJqJKVKZBg2ItrCmPZzCeEcnnCMAtypZZYodoBWs4J",
+	"U11Tx5ihKhk+tAoGD5QGDUl0oME5UUghcgrXNrhjqvhSPO9hVSN4oKTWoJSuvO72VmbnHJ0vgt+r8H0L",
+	"5+4DtBs5G2jAudtBTu6wkQ1RuhSiPdm5vJf2q82/U4q4bM6ArjHXGfFWF7fVoRqfqFd55d3c9YewGTpO",
+	"8s4ZvVSFjkcYZSlfKFreQqpcN5nVmtjflYy5brDNq98kzqVOaVXq7MyDnaApY2N+RrO1RaBbwnP23E9o",
+	"tlCsXFV2MMAvKVFWZcmvIQ3v4UfDz6XPeGVxwBdCFlFcKa/eAbmtXVSdxyG/8mpZkmy8jW3habWwCRci",
+	"EQ6c2KX0E0aVmAlV/TQUapDRRMVbFSyLdqKJEPnOxsbm1o/9QX/Q39z56aeffgqUJk/kNJWv+M7GBs0R",
+	"0SFh+vnNB7eaQCFwFcLGAUMZNOY9rbar0rckBSm6KMZj+ZdOjlXWOSmb/PEaQUZUw9gP3zXnxnQjpQnf",
+	"GCMhx+qpyCOU6nILqpLRJUZX35+RMk5Cmw+im7gTmErowmSsW0SokAsJpcnWXBk+c/yCAJoYw44AmmTI",
+	"SuRgZ7CmlCCB/0IbKeSTCwpZatykvRRdokyymd64wCmqAGgcDh0B9HSaFZFlR6gA4U5MRzCQl1G9AoL8",
+	"z1voak7K9s2Hm/83AJnQMt1AiwEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
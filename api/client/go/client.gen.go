@@ -21,6 +21,8 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oapi-codegen/runtime"
 	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/eventtype"
+	"github.com/openmeterio/openmeter/internal/subject"
 	"github.com/openmeterio/openmeter/pkg/models"
 )
 
@@ -33,6 +35,7 @@ const (
 
 // Defines values for LedgerEntryType.
 const (
+	AMENDMENT  LedgerEntryType = "AMENDMENT"
 	GRANT      LedgerEntryType = "GRANT"
 	GRANTUSAGE LedgerEntryType = "GRANT_USAGE"
 	RESET      LedgerEntryType = "RESET"
@@ -70,10 +73,15 @@ const (
 // CreateFeatureRequest A feature is a feature or service offered to a customer.
 // For example: CPU-Hours, Tokens, API Calls, etc.
 type CreateFeatureRequest struct {
-	// Archived If the feature is archived, it will not be used for grants or usage.
+	// Archived If the feature is archived, it cannot be granted. Existing grants of the feature are burned down until they expire or are voided,
+	// grant schedules of the feature skip their occurrences while the feature is archived.
+	// Occurrences are checked against the feature when they are issued, which may be later than they fall due:
+	// occurrences issued while the feature is archived are skipped even if they fell due before it was archived,
+	// and occurrences not yet issued when the feature is unarchived are issued.
 	Archived *bool `json:"archived,omitempty"`
 
 	// MeterGroupByFilters Optional meter group by filters. Useful if the meter scope is broader than what feature tracks.
+	// Active features of a meter must have the same filters or filters that differ in the value of a group by, overlapping filters are rejected.
 	MeterGroupByFilters *map[string]string `json:"meterGroupByFilters,omitempty"`
 
 	// MeterSlug The meter that the feature is associated with and decreases grants by usage.
@@ -81,6 +89,11 @@ type CreateFeatureRequest struct {
 
 	// Name The name of the feature.
 	Name string `json:"name"`
+
+	// UnitPrice The price of a unit of usage of the feature, it cannot be changed once the feature is created.
+	// Grants denominated in the currency of the price are burned down by the cost of the usage of the feature.
+	// The price is either a flat amount per unit or graduated tiers.
+	UnitPrice *UnitPrice `json:"unitPrice,omitempty"`
 }
 
 // CreateLedger A ledger represented in open meter. A ledger must be assigned to a single
@@ -95,12 +108,18 @@ type CreateLedgerGrantRequest struct {
 	// CreatedAt The time the grant was created.
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
-	// EffectiveAt The effective date.
+	// Currency The ISO 4217 currency the amount is denominated in, the amount is in units of usage if not set.
+	// Grants in a currency are burned down by the cost of the usage of features with a unit price in the currency.
+	// Grants with feature must be in the currency of the unit price of the feature.
+	Currency *string `json:"currency,omitempty"`
+
+	// EffectiveAt The effective time. Provided value will be ceiled to metering windowSize (minute).
 	EffectiveAt time.Time                    `json:"effectiveAt"`
 	Expiration  *LedgerGrantExpirationPeriod `json:"expiration,omitempty"`
 
 	// FeatureID The unique feature ULID that the grant is associated with, if any.
-	FeatureID string             `json:"featureID"`
+	// Grants without feature can be used by any feature, they are burned down after the grants of the feature.
+	FeatureID *string            `json:"featureID,omitempty"`
 	Metadata  *map[string]string `json:"metadata,omitempty"`
 
 	// ParentId The parent grant ULID that the grant is associated with, if any.
@@ -128,9 +147,16 @@ type CreateLedgerGrantRequest struct {
 // Event CloudEvents Specification JSON Schema
 type Event = event.Event
 
+// EventType An event type describes the data of events with a CloudEvents type with a JSON Schema.
+type EventType = eventtype.EventType
+
 // Feature defines model for Feature.
 type Feature struct {
-	// Archived If the feature is archived, it will not be used for grants or usage.
+	// Archived If the feature is archived, it cannot be granted. Existing grants of the feature are burned down until they expire or are voided,
+	// grant schedules of the feature skip their occurrences while the feature is archived.
+	// Occurrences are checked against the feature when they are issued, which may be later than they fall due:
+	// occurrences issued while the feature is archived are skipped even if they fell due before it was archived,
+	// and occurrences not yet issued when the feature is unarchived are issued.
 	Archived *bool `json:"archived,omitempty"`
 
 	// CreatedAt The time the feature was created.
@@ -139,7 +165,11 @@ type Feature struct {
 	// Id Readonly unique ULID identifier of the feature.
 	Id *string `json:"id,omitempty"`
 
+	// MeterGroupByFilterVersions The versions of the meter group by filters in the order they take effect, not set if the filters were never changed.
+	MeterGroupByFilterVersions *[]FeatureFilterVersion `json:"meterGroupByFilterVersions,omitempty"`
+
 	// MeterGroupByFilters Optional meter group by filters. Useful if the meter scope is broader than what feature tracks.
+	// Active features of a meter must have the same filters or filters that differ in the value of a group by, overlapping filters are rejected.
 	MeterGroupByFilters *map[string]string `json:"meterGroupByFilters,omitempty"`
 
 	// MeterSlug The meter that the feature is associated with and decreases grants by usage.
@@ -148,13 +178,22 @@ type Feature struct {
 	// Name The name of the feature.
 	Name string `json:"name"`
 
+	// UnitPrice The price of a unit of usage of the feature, it cannot be changed once the feature is created.
+	// Grants denominated in the currency of the price are burned down by the cost of the usage of the feature.
+	// The price is either a flat amount per unit or graduated tiers.
+	UnitPrice *UnitPrice `json:"unitPrice,omitempty"`
+
 	// UpdatedAt The time the feature was last updated.
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 }
 
 // FeatureBalance defines model for FeatureBalance.
 type FeatureBalance struct {
-	// Archived If the feature is archived, it will not be used for grants or usage.
+	// Archived If the feature is archived, it cannot be granted. Existing grants of the feature are burned down until they expire or are voided,
+	// grant schedules of the feature skip their occurrences while the feature is archived.
+	// Occurrences are checked against the feature when they are issued, which may be later than they fall due:
+	// occurrences issued while the feature is archived are skipped even if they fell due before it was archived,
+	// and occurrences not yet issued when the feature is unarchived are issued.
 	Archived *bool `json:"archived,omitempty"`
 
 	// Balance The balance of the feature.
@@ -166,22 +205,51 @@ type FeatureBalance struct {
 	// Id Readonly unique ULID identifier of the feature.
 	Id *string `json:"id,omitempty"`
 
+	// MeterGroupByFilterVersions The versions of the meter group by filters in the order they take effect, not set if the filters were never changed.
+	MeterGroupByFilterVersions *[]FeatureFilterVersion `json:"meterGroupByFilterVersions,omitempty"`
+
 	// MeterGroupByFilters Optional meter group by filters. Useful if the meter scope is broader than what feature tracks.
+	// Active features of a meter must have the same filters or filters that differ in the value of a group by, overlapping filters are rejected.
 	MeterGroupByFilters *map[string]string `json:"meterGroupByFilters,omitempty"`
 
 	// MeterSlug The meter that the feature is associated with and decreases grants by usage.
 	MeterSlug string `json:"meterSlug"`
 
+	// MonetaryUsage The usage of a feature paid with grants denominated in the currency of the unit price of the feature since the last reset.
+	// Set for features grants in a currency pay for, the units are part of the usage of the feature.
+	MonetaryUsage *MonetaryUsage `json:"monetaryUsage,omitempty"`
+
 	// Name The name of the feature.
 	Name string `json:"name"`
 
+	// Overage The usage of the feature not covered by grants within the overage limits.
+	Overage float64 `json:"overage"`
+
+	// UnitPrice The price of a unit of usage of the feature, it cannot be changed once the feature is created.
+	// Grants denominated in the currency of the price are burned down by the cost of the usage of the feature.
+	// The price is either a flat amount per unit or graduated tiers.
+	UnitPrice *UnitPrice `json:"unitPrice,omitempty"`
+
 	// UpdatedAt The time the feature was last updated.
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 
-	// Usage The usage of the feature.
+	// Usage The usage of the feature covered by grants.
 	Usage float64 `json:"usage"`
 }
 
+// FeatureFilterVersion The meter group by filters of a feature in effect from a time on.
+type FeatureFilterVersion = credit.FeatureFilterVersion
+
+// Freshness How complete the stored usage data of the namespace is.
+type Freshness struct {
+	// CompleteUntil Usage data is complete until this time, it is the earliest of the partitions.
+	// Not set if no progress was reported yet.
+	CompleteUntil *time.Time `json:"completeUntil,omitempty"`
+
+	// Partitions The progress of storing events per partition of the events topic.
+	Partitions []PartitionFreshness `json:"partitions"`
+}
+
 // IdOrSlug A unique identifier.
 type IdOrSlug = string
 
@@ -200,6 +268,13 @@ type LedgerAlreadyExistsProblem = credit.LedgerAlreadyExistsProblemResponse
 
 // LedgerBalance Balance of a subject.
 type LedgerBalance struct {
+	// CompleteUntil Usage data the balance is calculated from is complete until this time.
+	// Not set if the freshness of usage data is unknown.
+	CompleteUntil *time.Time `json:"completeUntil,omitempty"`
+
+	// CurrentPeriod The usage period the balance is calculated in if the ledger has a usage period.
+	CurrentPeriod *Period `json:"currentPeriod,omitempty"`
+
 	// FeatureBalances Features with balances.
 	FeatureBalances []FeatureBalance `json:"featureBalances"`
 
@@ -210,16 +285,26 @@ type LedgerBalance struct {
 	LastReset *time.Time         `json:"lastReset,omitempty"`
 	Metadata  *map[string]string `json:"metadata,omitempty"`
 
+	// Overage The total usage not covered by grants within the overage limits.
+	Overage float64 `json:"overage"`
+
 	// Subject The subject of the ledger.
 	Subject string `json:"subject"`
 }
 
+// LedgerEntitlement Entitlement of a subject to a feature.
+type LedgerEntitlement = credit.Entitlement
+
 // LedgerEntry A ledger entry.
 type LedgerEntry struct {
 	// Amount The amount to apply. Can be positive or negative number. If applicable.
+	// The amount is in the currency of the grant if the grant is denominated in a currency, in units of usage otherwise.
 	Amount float64 `json:"amount"`
 
-	// FeatureID The unique feature ULID that the entry is associated with, if any.
+	// Currency The ISO 4217 currency of the amount, if the grant is denominated in a currency.
+	Currency *string `json:"currency,omitempty"`
+
+	// FeatureID The unique feature ULID that the entry is associated with.
 	FeatureID string `json:"featureID"`
 
 	// Id Readonly unique ULID identifier of the ledger entry.
@@ -231,11 +316,17 @@ type LedgerEntry struct {
 	// Time The time the ledger entry was created.
 	Time time.Time       `json:"time"`
 	Type LedgerEntryType `json:"type"`
+
+	// Units The units of usage the amount paid for, set for the usage of grants denominated in a currency.
+	Units *float64 `json:"units,omitempty"`
 }
 
 // LedgerEntryType defines model for LedgerEntryType.
 type LedgerEntryType string
 
+// LedgerGrantAmendment Amendments change the terms of a grant from their effective time, at least one of the terms must be changed.
+type LedgerGrantAmendment = credit.GrantAmendment
+
 // LedgerGrantBalance defines model for LedgerGrantBalance.
 type LedgerGrantBalance struct {
 	// Amount The amount to grant. Can be positive or negative number.
@@ -247,7 +338,12 @@ type LedgerGrantBalance struct {
 	// CreatedAt The time the grant was created.
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
-	// EffectiveAt The effective date.
+	// Currency The ISO 4217 currency the amount is denominated in, the amount is in units of usage if not set.
+	// Grants in a currency are burned down by the cost of the usage of features with a unit price in the currency.
+	// Grants with feature must be in the currency of the unit price of the feature.
+	Currency *string `json:"currency,omitempty"`
+
+	// EffectiveAt The effective time. Provided value will be ceiled to metering windowSize (minute).
 	EffectiveAt time.Time                    `json:"effectiveAt"`
 	Expiration  *LedgerGrantExpirationPeriod `json:"expiration,omitempty"`
 
@@ -255,7 +351,8 @@ type LedgerGrantBalance struct {
 	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 
 	// FeatureID The unique feature ULID that the grant is associated with, if any.
-	FeatureID string `json:"featureID"`
+	// Grants without feature can be used by any feature, they are burned down after the grants of the feature.
+	FeatureID *string `json:"featureID,omitempty"`
 
 	// Id Readonly unique ULID identifier of the grant.
 	Id *string `json:"id,omitempty"`
@@ -278,6 +375,9 @@ type LedgerGrantBalance struct {
 	// Rollover Grant rollover configuration.
 	Rollover *LedgerGrantRollover `json:"rollover,omitempty"`
 
+	// ScheduleId The grant schedule ULID that issued the grant, if any.
+	ScheduleId *string `json:"scheduleId,omitempty"`
+
 	// Type The grant type:
 	// - `USAGE` - Increase balance by the amount in the unit of the associated meter.
 	Type LedgerGrantType `json:"type"`
@@ -309,7 +409,12 @@ type LedgerGrantResponse struct {
 	// CreatedAt The time the grant was created.
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
-	// EffectiveAt The effective date.
+	// Currency The ISO 4217 currency the amount is denominated in, the amount is in units of usage if not set.
+	// Grants in a currency are burned down by the cost of the usage of features with a unit price in the currency.
+	// Grants with feature must be in the currency of the unit price of the feature.
+	Currency *string `json:"currency,omitempty"`
+
+	// EffectiveAt The effective time. Provided value will be ceiled to metering windowSize (minute).
 	EffectiveAt time.Time                    `json:"effectiveAt"`
 	Expiration  *LedgerGrantExpirationPeriod `json:"expiration,omitempty"`
 
@@ -317,7 +422,8 @@ type LedgerGrantResponse struct {
 	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 
 	// FeatureID The unique feature ULID that the grant is associated with, if any.
-	FeatureID string `json:"featureID"`
+	// Grants without feature can be used by any feature, they are burned down after the grants of the feature.
+	FeatureID *string `json:"featureID,omitempty"`
 
 	// Id Readonly unique ULID identifier of the grant.
 	Id *string `json:"id,omitempty"`
@@ -340,6 +446,9 @@ type LedgerGrantResponse struct {
 	// Rollover Grant rollover configuration.
 	Rollover *LedgerGrantRollover `json:"rollover,omitempty"`
 
+	// ScheduleId The grant schedule ULID that issued the grant, if any.
+	ScheduleId *string `json:"scheduleId,omitempty"`
+
 	// Type The grant type:
 	// - `USAGE` - Increase balance by the amount in the unit of the associated meter.
 	Type LedgerGrantType `json:"type"`
@@ -359,13 +468,33 @@ type LedgerGrantRollover = credit.GrantRollover
 // - `ORIGINAL_AMOUNT` - Rollover re-applies the full grant amount.
 type LedgerGrantRolloverType = credit.GrantRolloverType
 
+// LedgerGrantSchedule Grant schedules issue a grant on a recurring schedule, for example the monthly allowance of a subscription.
+type LedgerGrantSchedule = credit.GrantSchedule
+
 // LedgerGrantType The grant type:
 // - `USAGE` - Increase balance by the amount in the unit of the associated meter.
 type LedgerGrantType string
 
+// LedgerNotificationDelivery The delivery of a notification event to a webhook.
+type LedgerNotificationDelivery = credit.NotificationDelivery
+
+// LedgerNotificationEvent The payload sent to the webhook when a notification rule is triggered.
+type LedgerNotificationEvent = credit.NotificationEvent
+
+// LedgerNotificationRule Notification rules notify a webhook when the balance of a ledger crosses a threshold.
+// The balance watched is the remaining balance of the grants of the feature, or of all grants of the ledger if no feature is set.
+type LedgerNotificationRule = credit.NotificationRule
+
+// LedgerOverageLimits Overage limits of a ledger. Overage is usage not covered by any grant.
+// Features without a limit of their own and without a ledger limit do not allow overage.
+type LedgerOverageLimits = credit.OverageLimits
+
 // LedgerReset Ledger reset configuration.
 type LedgerReset = credit.Reset
 
+// LedgerUsagePeriod Recurrence of grant schedules and usage periods.
+type LedgerUsagePeriod = Recurrence
+
 // Meter A meter is a configuration that defines how to match and aggregate events.
 type Meter = models.Meter
 
@@ -374,9 +503,12 @@ type MeterAggregation = models.MeterAggregation
 
 // MeterQueryResult The result of a meter query.
 type MeterQueryResult struct {
-	Data []MeterQueryRow `json:"data"`
-	From *time.Time      `json:"from,omitempty"`
-	To   *time.Time      `json:"to,omitempty"`
+	// CompleteUntil Usage data is complete until this time, later windows may still miss events.
+	// Not set if the freshness of usage data is unknown.
+	CompleteUntil *time.Time      `json:"completeUntil,omitempty"`
+	Data          []MeterQueryRow `json:"data"`
+	From          *time.Time      `json:"from,omitempty"`
+	To            *time.Time      `json:"to,omitempty"`
 
 	// WindowSize Aggregation window size.
 	WindowSize *WindowSize `json:"windowSize,omitempty"`
@@ -385,6 +517,21 @@ type MeterQueryResult struct {
 // MeterQueryRow A row in the result of a meter query.
 type MeterQueryRow = models.MeterQueryRow
 
+// MonetaryUsage The usage of a feature paid with grants denominated in the currency of the unit price of the feature since the last reset.
+// Set for features grants in a currency pay for, the units are part of the usage of the feature.
+type MonetaryUsage = credit.MonetaryUsage
+
+// PartitionFreshness The progress of storing events of a partition of the events topic.
+type PartitionFreshness struct {
+	// CompleteUntil Events of the partition are stored until this time.
+	CompleteUntil time.Time `json:"completeUntil"`
+
+	// Offset The offset of the next event to store.
+	Offset    int64     `json:"offset"`
+	Partition int32     `json:"partition"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 // Period A time period
 type Period struct {
 	// From Period start time where the amount was applied. If applicable.
@@ -408,10 +555,16 @@ type PortalToken struct {
 	Token *string `json:"token,omitempty"`
 }
 
+// PriceTier A tier of a graduated unit price.
+type PriceTier = credit.PriceTier
+
 // Problem A Problem Details object (RFC 7807).
 // Additional properties specific to the problem type may be present.
 type Problem = models.StatusProblem
 
+// Recurrence Recurrence of grant schedules and usage periods.
+type Recurrence = credit.Recurrence
+
 // Subject A subject is a unique identifier for a user or entity.
 type Subject struct {
 	CurrentPeriodEnd   *time.Time              `json:"currentPeriodEnd"`
@@ -423,21 +576,41 @@ type Subject struct {
 	StripeCustomerId   *string                 `json:"stripeCustomerId"`
 }
 
+// SubjectAlias A subject alias maps a subject sent by a source to a canonical subject.
+type SubjectAlias = subject.Alias
+
+// UnitPrice The price of a unit of usage of the feature, it cannot be changed once the feature is created.
+// Grants denominated in the currency of the price are burned down by the cost of the usage of the feature.
+// The price is either a flat amount per unit or graduated tiers.
+type UnitPrice = credit.UnitPrice
+
+// UpdateFeatureRequest Update of a feature, fields not set are left unchanged.
+type UpdateFeatureRequest = credit.FeatureUpdate
+
 // WindowSize Aggregation window size.
 type WindowSize = models.WindowSize
 
+// EventTypeName defines model for eventTypeName.
+type EventTypeName = string
+
 // FeatureID defines model for featureID.
 type FeatureID = credit.FeatureID
 
 // LedgerGrantID defines model for ledgerGrantID.
 type LedgerGrantID = credit.GrantID
 
+// LedgerGrantScheduleID defines model for ledgerGrantScheduleID.
+type LedgerGrantScheduleID = credit.GrantScheduleID
+
 // LedgerID defines model for ledgerID.
 type LedgerID = credit.LedgerID
 
 // LedgerIncludeVoids defines model for ledgerIncludeVoids.
 type LedgerIncludeVoids = bool
 
+// LedgerNotificationRuleID defines model for ledgerNotificationRuleID.
+type LedgerNotificationRuleID = credit.NotificationRuleID
+
 // LedgerQueryLimit defines model for ledgerQueryLimit.
 type LedgerQueryLimit = int
 
@@ -447,6 +620,9 @@ type LedgerQueryOffset = int
 // MeterIdOrSlug A unique identifier.
 type MeterIdOrSlug = IdOrSlug
 
+// QueryExcludeIncomplete defines model for queryExcludeIncomplete.
+type QueryExcludeIncomplete = bool
+
 // QueryFilterGroupBy Simple filter for group bys with exact match.
 //
 // Usage: `?filterGroupBy[type]=input&filterGroupBy[model]=gpt-4`
@@ -473,6 +649,9 @@ type QueryWindowSize = WindowSize
 // QueryWindowTimeZone defines model for queryWindowTimeZone.
 type QueryWindowTimeZone = string
 
+// SubjectAliasID defines model for subjectAliasID.
+type SubjectAliasID = subject.AliasID
+
 // SubjectIdOrKey defines model for subjectIdOrKey.
 type SubjectIdOrKey = string
 
@@ -491,6 +670,10 @@ type NotFoundProblemResponse = Problem
 // Additional properties specific to the problem type may be present.
 type NotImplementedProblemResponse = Problem
 
+// TooManyRequestsProblemResponse A Problem Details object (RFC 7807).
+// Additional properties specific to the problem type may be present.
+type TooManyRequestsProblemResponse = Problem
+
 // UnauthorizedProblemResponse A Problem Details object (RFC 7807).
 // Additional properties specific to the problem type may be present.
 type UnauthorizedProblemResponse = Problem
@@ -555,6 +738,15 @@ type ListLedgersParams struct {
 // ListLedgersParamsOrderBy defines parameters for ListLedgers.
 type ListLedgersParamsOrderBy string
 
+// CheckLedgerEntitlementParams defines parameters for CheckLedgerEntitlement.
+type CheckLedgerEntitlementParams struct {
+	// Subject The subject to check.
+	Subject string `form:"subject" json:"subject"`
+
+	// FeatureID The feature to check.
+	FeatureID string `form:"featureID" json:"featureID"`
+}
+
 // ListLedgerGrantsParams defines parameters for ListLedgerGrants.
 type ListLedgerGrantsParams struct {
 	// LedgerID Filtering and group by multiple subjects.
@@ -599,6 +791,12 @@ type GetLedgerHistoryParams struct {
 	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// ListLedgerNotificationDeliveriesParams defines parameters for ListLedgerNotificationDeliveries.
+type ListLedgerNotificationDeliveriesParams struct {
+	// Limit Number of entries to return
+	Limit *LedgerQueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // QueryMeterParams defines parameters for QueryMeter.
 type QueryMeterParams struct {
 	// From Start date-time in RFC 3339 format.
@@ -625,6 +823,9 @@ type QueryMeterParams struct {
 	// GroupBy If not specified a single aggregate will be returned for each subject and time window.
 	// `subject` is a reserved group by value.
 	GroupBy *QueryGroupBy `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+
+	// ExcludeIncomplete Exclude windows of usage data that may still miss events, `to` is limited to the time usage data is complete until.
+	ExcludeIncomplete *QueryExcludeIncomplete `form:"excludeIncomplete,omitempty" json:"excludeIncomplete,omitempty"`
 }
 
 // QueryPortalMeterParams defines parameters for QueryPortalMeter.
@@ -668,6 +869,9 @@ type InvalidatePortalTokensJSONBody struct {
 // UpsertSubjectJSONBody defines parameters for UpsertSubject.
 type UpsertSubjectJSONBody = []Subject
 
+// UpsertEventTypeJSONRequestBody defines body for UpsertEventType for application/json ContentType.
+type UpsertEventTypeJSONRequestBody = EventType
+
 // IngestEventsApplicationCloudeventsPlusJSONRequestBody defines body for IngestEvents for application/cloudevents+json ContentType.
 type IngestEventsApplicationCloudeventsPlusJSONRequestBody = Event
 
@@ -677,15 +881,33 @@ type IngestEventsApplicationCloudeventsBatchPlusJSONRequestBody = IngestEventsAp
 // CreateFeatureJSONRequestBody defines body for CreateFeature for application/json ContentType.
 type CreateFeatureJSONRequestBody = CreateFeatureRequest
 
+// UpdateFeatureJSONRequestBody defines body for UpdateFeature for application/json ContentType.
+type UpdateFeatureJSONRequestBody = UpdateFeatureRequest
+
 // CreateLedgerJSONRequestBody defines body for CreateLedger for application/json ContentType.
 type CreateLedgerJSONRequestBody = CreateLedger
 
+// CreateLedgerGrantScheduleJSONRequestBody defines body for CreateLedgerGrantSchedule for application/json ContentType.
+type CreateLedgerGrantScheduleJSONRequestBody = LedgerGrantSchedule
+
 // CreateLedgerGrantJSONRequestBody defines body for CreateLedgerGrant for application/json ContentType.
 type CreateLedgerGrantJSONRequestBody = CreateLedgerGrantRequest
 
+// AmendLedgerGrantJSONRequestBody defines body for AmendLedgerGrant for application/json ContentType.
+type AmendLedgerGrantJSONRequestBody = LedgerGrantAmendment
+
+// CreateLedgerNotificationRuleJSONRequestBody defines body for CreateLedgerNotificationRule for application/json ContentType.
+type CreateLedgerNotificationRuleJSONRequestBody = LedgerNotificationRule
+
+// UpdateLedgerOverageLimitsJSONRequestBody defines body for UpdateLedgerOverageLimits for application/json ContentType.
+type UpdateLedgerOverageLimitsJSONRequestBody = LedgerOverageLimits
+
 // ResetLedgerJSONRequestBody defines body for ResetLedger for application/json ContentType.
 type ResetLedgerJSONRequestBody = LedgerReset
 
+// UpdateLedgerUsagePeriodJSONRequestBody defines body for UpdateLedgerUsagePeriod for application/json ContentType.
+type UpdateLedgerUsagePeriodJSONRequestBody = LedgerUsagePeriod
+
 // CreateMeterJSONRequestBody defines body for CreateMeter for application/json ContentType.
 type CreateMeterJSONRequestBody = Meter
 
@@ -695,6 +917,9 @@ type CreatePortalTokenJSONRequestBody = PortalToken
 // InvalidatePortalTokensJSONRequestBody defines body for InvalidatePortalTokens for application/json ContentType.
 type InvalidatePortalTokensJSONRequestBody InvalidatePortalTokensJSONBody
 
+// UpsertSubjectAliasJSONRequestBody defines body for UpsertSubjectAlias for application/json ContentType.
+type UpsertSubjectAliasJSONRequestBody = SubjectAlias
+
 // UpsertSubjectJSONRequestBody defines body for UpsertSubject for application/json ContentType.
 type UpsertSubjectJSONRequestBody = UpsertSubjectJSONBody
 
@@ -771,6 +996,20 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ListEventTypes request
+	ListEventTypes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpsertEventTypeWithBody request with any body
+	UpsertEventTypeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpsertEventType(ctx context.Context, body UpsertEventTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteEventType request
+	DeleteEventType(ctx context.Context, eventType EventTypeName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEventType request
+	GetEventType(ctx context.Context, eventType EventTypeName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListEvents request
 	ListEvents(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -795,6 +1034,14 @@ type ClientInterface interface {
 	// GetFeature request
 	GetFeature(ctx context.Context, featureID FeatureID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateFeatureWithBody request with any body
+	UpdateFeatureWithBody(ctx context.Context, featureID FeatureID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateFeature(ctx context.Context, featureID FeatureID, body UpdateFeatureJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFreshness request
+	GetFreshness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListLedgers request
 	ListLedgers(ctx context.Context, params *ListLedgersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -803,12 +1050,29 @@ type ClientInterface interface {
 
 	CreateLedger(ctx context.Context, body CreateLedgerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CheckLedgerEntitlement request
+	CheckLedgerEntitlement(ctx context.Context, params *CheckLedgerEntitlementParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListLedgerGrants request
 	ListLedgerGrants(ctx context.Context, params *ListLedgerGrantsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetLedgerBalance request
 	GetLedgerBalance(ctx context.Context, ledgerID LedgerID, params *GetLedgerBalanceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListLedgerGrantSchedules request
+	ListLedgerGrantSchedules(ctx context.Context, ledgerID LedgerID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateLedgerGrantScheduleWithBody request with any body
+	CreateLedgerGrantScheduleWithBody(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateLedgerGrantSchedule(ctx context.Context, ledgerID LedgerID, body CreateLedgerGrantScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteLedgerGrantSchedule request
+	DeleteLedgerGrantSchedule(ctx context.Context, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLedgerGrantSchedule request
+	GetLedgerGrantSchedule(ctx context.Context, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListLedgerGrantsByLedger request
 	ListLedgerGrantsByLedger(ctx context.Context, ledgerID LedgerID, params *ListLedgerGrantsByLedgerParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -823,14 +1087,52 @@ type ClientInterface interface {
 	// GetLedgerGrant request
 	GetLedgerGrant(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListLedgerGrantAmendments request
+	ListLedgerGrantAmendments(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AmendLedgerGrantWithBody request with any body
+	AmendLedgerGrantWithBody(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AmendLedgerGrant(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, body AmendLedgerGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetLedgerHistory request
 	GetLedgerHistory(ctx context.Context, ledgerID LedgerID, params *GetLedgerHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListLedgerNotificationRules request
+	ListLedgerNotificationRules(ctx context.Context, ledgerID LedgerID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateLedgerNotificationRuleWithBody request with any body
+	CreateLedgerNotificationRuleWithBody(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateLedgerNotificationRule(ctx context.Context, ledgerID LedgerID, body CreateLedgerNotificationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteLedgerNotificationRule request
+	DeleteLedgerNotificationRule(ctx context.Context, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLedgerNotificationRule request
+	GetLedgerNotificationRule(ctx context.Context, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLedgerNotificationDeliveries request
+	ListLedgerNotificationDeliveries(ctx context.Context, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, params *ListLedgerNotificationDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateLedgerOverageLimitsWithBody request with any body
+	UpdateLedgerOverageLimitsWithBody(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateLedgerOverageLimits(ctx context.Context, ledgerID LedgerID, body UpdateLedgerOverageLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ResetLedgerWithBody request with any body
 	ResetLedgerWithBody(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	ResetLedger(ctx context.Context, ledgerID LedgerID, body ResetLedgerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteLedgerUsagePeriod request
+	DeleteLedgerUsagePeriod(ctx context.Context, ledgerID LedgerID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateLedgerUsagePeriodWithBody request with any body
+	UpdateLedgerUsagePeriodWithBody(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateLedgerUsagePeriod(ctx context.Context, ledgerID LedgerID, body UpdateLedgerUsagePeriodJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListMeters request
 	ListMeters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -867,6 +1169,20 @@ type ClientInterface interface {
 
 	InvalidatePortalTokens(ctx context.Context, body InvalidatePortalTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListSubjectAliases request
+	ListSubjectAliases(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpsertSubjectAliasWithBody request with any body
+	UpsertSubjectAliasWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpsertSubjectAlias(ctx context.Context, body UpsertSubjectAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSubjectAlias request
+	DeleteSubjectAlias(ctx context.Context, subjectAliasID SubjectAliasID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSubjectAlias request
+	GetSubjectAlias(ctx context.Context, subjectAliasID SubjectAliasID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListSubjects request
 	ListSubjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -882,6 +1198,66 @@ type ClientInterface interface {
 	GetSubject(ctx context.Context, subjectIdOrKey SubjectIdOrKey, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) ListEventTypes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListEventTypesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpsertEventTypeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpsertEventTypeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpsertEventType(ctx context.Context, body UpsertEventTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpsertEventTypeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteEventType(ctx context.Context, eventType EventTypeName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteEventTypeRequest(c.Server, eventType)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEventType(ctx context.Context, eventType EventTypeName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEventTypeRequest(c.Server, eventType)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListEvents(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListEventsRequest(c.Server, params)
 	if err != nil {
@@ -990,6 +1366,42 @@ func (c *Client) GetFeature(ctx context.Context, featureID FeatureID, reqEditors
 	return c.Client.Do(req)
 }
 
+func (c *Client) UpdateFeatureWithBody(ctx context.Context, featureID FeatureID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFeatureRequestWithBody(c.Server, featureID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateFeature(ctx context.Context, featureID FeatureID, body UpdateFeatureJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFeatureRequest(c.Server, featureID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetFreshness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFreshnessRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListLedgers(ctx context.Context, params *ListLedgersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListLedgersRequest(c.Server, params)
 	if err != nil {
@@ -1026,6 +1438,18 @@ func (c *Client) CreateLedger(ctx context.Context, body CreateLedgerJSONRequestB
 	return c.Client.Do(req)
 }
 
+func (c *Client) CheckLedgerEntitlement(ctx context.Context, params *CheckLedgerEntitlementParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckLedgerEntitlementRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListLedgerGrants(ctx context.Context, params *ListLedgerGrantsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListLedgerGrantsRequest(c.Server, params)
 	if err != nil {
@@ -1050,8 +1474,8 @@ func (c *Client) GetLedgerBalance(ctx context.Context, ledgerID LedgerID, params
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListLedgerGrantsByLedger(ctx context.Context, ledgerID LedgerID, params *ListLedgerGrantsByLedgerParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListLedgerGrantsByLedgerRequest(c.Server, ledgerID, params)
+func (c *Client) ListLedgerGrantSchedules(ctx context.Context, ledgerID LedgerID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLedgerGrantSchedulesRequest(c.Server, ledgerID)
 	if err != nil {
 		return nil, err
 	}
@@ -1062,8 +1486,8 @@ func (c *Client) ListLedgerGrantsByLedger(ctx context.Context, ledgerID LedgerID
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateLedgerGrantWithBody(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateLedgerGrantRequestWithBody(c.Server, ledgerID, contentType, body)
+func (c *Client) CreateLedgerGrantScheduleWithBody(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLedgerGrantScheduleRequestWithBody(c.Server, ledgerID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1074,8 +1498,8 @@ func (c *Client) CreateLedgerGrantWithBody(ctx context.Context, ledgerID LedgerI
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateLedgerGrant(ctx context.Context, ledgerID LedgerID, body CreateLedgerGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateLedgerGrantRequest(c.Server, ledgerID, body)
+func (c *Client) CreateLedgerGrantSchedule(ctx context.Context, ledgerID LedgerID, body CreateLedgerGrantScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLedgerGrantScheduleRequest(c.Server, ledgerID, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1086,8 +1510,8 @@ func (c *Client) CreateLedgerGrant(ctx context.Context, ledgerID LedgerID, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) VoidLedgerGrant(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVoidLedgerGrantRequest(c.Server, ledgerID, ledgerGrantID)
+func (c *Client) DeleteLedgerGrantSchedule(ctx context.Context, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteLedgerGrantScheduleRequest(c.Server, ledgerID, ledgerGrantScheduleID)
 	if err != nil {
 		return nil, err
 	}
@@ -1098,8 +1522,8 @@ func (c *Client) VoidLedgerGrant(ctx context.Context, ledgerID LedgerID, ledgerG
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetLedgerGrant(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLedgerGrantRequest(c.Server, ledgerID, ledgerGrantID)
+func (c *Client) GetLedgerGrantSchedule(ctx context.Context, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLedgerGrantScheduleRequest(c.Server, ledgerID, ledgerGrantScheduleID)
 	if err != nil {
 		return nil, err
 	}
@@ -1110,8 +1534,8 @@ func (c *Client) GetLedgerGrant(ctx context.Context, ledgerID LedgerID, ledgerGr
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetLedgerHistory(ctx context.Context, ledgerID LedgerID, params *GetLedgerHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLedgerHistoryRequest(c.Server, ledgerID, params)
+func (c *Client) ListLedgerGrantsByLedger(ctx context.Context, ledgerID LedgerID, params *ListLedgerGrantsByLedgerParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLedgerGrantsByLedgerRequest(c.Server, ledgerID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1122,8 +1546,8 @@ func (c *Client) GetLedgerHistory(ctx context.Context, ledgerID LedgerID, params
 	return c.Client.Do(req)
 }
 
-func (c *Client) ResetLedgerWithBody(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewResetLedgerRequestWithBody(c.Server, ledgerID, contentType, body)
+func (c *Client) CreateLedgerGrantWithBody(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLedgerGrantRequestWithBody(c.Server, ledgerID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1134,8 +1558,8 @@ func (c *Client) ResetLedgerWithBody(ctx context.Context, ledgerID LedgerID, con
 	return c.Client.Do(req)
 }
 
-func (c *Client) ResetLedger(ctx context.Context, ledgerID LedgerID, body ResetLedgerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewResetLedgerRequest(c.Server, ledgerID, body)
+func (c *Client) CreateLedgerGrant(ctx context.Context, ledgerID LedgerID, body CreateLedgerGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLedgerGrantRequest(c.Server, ledgerID, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1146,8 +1570,8 @@ func (c *Client) ResetLedger(ctx context.Context, ledgerID LedgerID, body ResetL
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMeters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMetersRequest(c.Server)
+func (c *Client) VoidLedgerGrant(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVoidLedgerGrantRequest(c.Server, ledgerID, ledgerGrantID)
 	if err != nil {
 		return nil, err
 	}
@@ -1158,8 +1582,8 @@ func (c *Client) ListMeters(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMeterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMeterRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetLedgerGrant(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLedgerGrantRequest(c.Server, ledgerID, ledgerGrantID)
 	if err != nil {
 		return nil, err
 	}
@@ -1170,8 +1594,8 @@ func (c *Client) CreateMeterWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMeter(ctx context.Context, body CreateMeterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMeterRequest(c.Server, body)
+func (c *Client) ListLedgerGrantAmendments(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLedgerGrantAmendmentsRequest(c.Server, ledgerID, ledgerGrantID)
 	if err != nil {
 		return nil, err
 	}
@@ -1182,8 +1606,8 @@ func (c *Client) CreateMeter(ctx context.Context, body CreateMeterJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteMeter(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteMeterRequest(c.Server, meterIdOrSlug)
+func (c *Client) AmendLedgerGrantWithBody(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAmendLedgerGrantRequestWithBody(c.Server, ledgerID, ledgerGrantID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1194,8 +1618,8 @@ func (c *Client) DeleteMeter(ctx context.Context, meterIdOrSlug MeterIdOrSlug, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMeter(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMeterRequest(c.Server, meterIdOrSlug)
+func (c *Client) AmendLedgerGrant(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, body AmendLedgerGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAmendLedgerGrantRequest(c.Server, ledgerID, ledgerGrantID, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1206,8 +1630,8 @@ func (c *Client) GetMeter(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) QueryMeter(ctx context.Context, meterIdOrSlug MeterIdOrSlug, params *QueryMeterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQueryMeterRequest(c.Server, meterIdOrSlug, params)
+func (c *Client) GetLedgerHistory(ctx context.Context, ledgerID LedgerID, params *GetLedgerHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLedgerHistoryRequest(c.Server, ledgerID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1218,8 +1642,8 @@ func (c *Client) QueryMeter(ctx context.Context, meterIdOrSlug MeterIdOrSlug, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMeterSubjects(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMeterSubjectsRequest(c.Server, meterIdOrSlug)
+func (c *Client) ListLedgerNotificationRules(ctx context.Context, ledgerID LedgerID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLedgerNotificationRulesRequest(c.Server, ledgerID)
 	if err != nil {
 		return nil, err
 	}
@@ -1230,8 +1654,8 @@ func (c *Client) ListMeterSubjects(ctx context.Context, meterIdOrSlug MeterIdOrS
 	return c.Client.Do(req)
 }
 
-func (c *Client) QueryPortalMeter(ctx context.Context, meterSlug string, params *QueryPortalMeterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQueryPortalMeterRequest(c.Server, meterSlug, params)
+func (c *Client) CreateLedgerNotificationRuleWithBody(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLedgerNotificationRuleRequestWithBody(c.Server, ledgerID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1242,8 +1666,8 @@ func (c *Client) QueryPortalMeter(ctx context.Context, meterSlug string, params
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListPortalTokens(ctx context.Context, params *ListPortalTokensParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListPortalTokensRequest(c.Server, params)
+func (c *Client) CreateLedgerNotificationRule(ctx context.Context, ledgerID LedgerID, body CreateLedgerNotificationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLedgerNotificationRuleRequest(c.Server, ledgerID, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1254,8 +1678,8 @@ func (c *Client) ListPortalTokens(ctx context.Context, params *ListPortalTokensP
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePortalTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePortalTokenRequestWithBody(c.Server, contentType, body)
+func (c *Client) DeleteLedgerNotificationRule(ctx context.Context, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteLedgerNotificationRuleRequest(c.Server, ledgerID, ledgerNotificationRuleID)
 	if err != nil {
 		return nil, err
 	}
@@ -1266,8 +1690,8 @@ func (c *Client) CreatePortalTokenWithBody(ctx context.Context, contentType stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePortalToken(ctx context.Context, body CreatePortalTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePortalTokenRequest(c.Server, body)
+func (c *Client) GetLedgerNotificationRule(ctx context.Context, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLedgerNotificationRuleRequest(c.Server, ledgerID, ledgerNotificationRuleID)
 	if err != nil {
 		return nil, err
 	}
@@ -1278,8 +1702,8 @@ func (c *Client) CreatePortalToken(ctx context.Context, body CreatePortalTokenJS
 	return c.Client.Do(req)
 }
 
-func (c *Client) InvalidatePortalTokensWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewInvalidatePortalTokensRequestWithBody(c.Server, contentType, body)
+func (c *Client) ListLedgerNotificationDeliveries(ctx context.Context, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, params *ListLedgerNotificationDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLedgerNotificationDeliveriesRequest(c.Server, ledgerID, ledgerNotificationRuleID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1290,8 +1714,8 @@ func (c *Client) InvalidatePortalTokensWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) InvalidatePortalTokens(ctx context.Context, body InvalidatePortalTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewInvalidatePortalTokensRequest(c.Server, body)
+func (c *Client) UpdateLedgerOverageLimitsWithBody(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLedgerOverageLimitsRequestWithBody(c.Server, ledgerID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1302,8 +1726,8 @@ func (c *Client) InvalidatePortalTokens(ctx context.Context, body InvalidatePort
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListSubjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListSubjectsRequest(c.Server)
+func (c *Client) UpdateLedgerOverageLimits(ctx context.Context, ledgerID LedgerID, body UpdateLedgerOverageLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLedgerOverageLimitsRequest(c.Server, ledgerID, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1314,8 +1738,8 @@ func (c *Client) ListSubjects(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpsertSubjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpsertSubjectRequestWithBody(c.Server, contentType, body)
+func (c *Client) ResetLedgerWithBody(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetLedgerRequestWithBody(c.Server, ledgerID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1326,8 +1750,8 @@ func (c *Client) UpsertSubjectWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpsertSubject(ctx context.Context, body UpsertSubjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpsertSubjectRequest(c.Server, body)
+func (c *Client) ResetLedger(ctx context.Context, ledgerID LedgerID, body ResetLedgerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetLedgerRequest(c.Server, ledgerID, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1338,8 +1762,8 @@ func (c *Client) UpsertSubject(ctx context.Context, body UpsertSubjectJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteSubject(ctx context.Context, subjectIdOrKey SubjectIdOrKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteSubjectRequest(c.Server, subjectIdOrKey)
+func (c *Client) DeleteLedgerUsagePeriod(ctx context.Context, ledgerID LedgerID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteLedgerUsagePeriodRequest(c.Server, ledgerID)
 	if err != nil {
 		return nil, err
 	}
@@ -1350,8 +1774,8 @@ func (c *Client) DeleteSubject(ctx context.Context, subjectIdOrKey SubjectIdOrKe
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSubject(ctx context.Context, subjectIdOrKey SubjectIdOrKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSubjectRequest(c.Server, subjectIdOrKey)
+func (c *Client) UpdateLedgerUsagePeriodWithBody(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLedgerUsagePeriodRequestWithBody(c.Server, ledgerID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1362,256 +1786,342 @@ func (c *Client) GetSubject(ctx context.Context, subjectIdOrKey SubjectIdOrKey,
 	return c.Client.Do(req)
 }
 
-// NewListEventsRequest generates requests for ListEvents
-func NewListEventsRequest(server string, params *ListEventsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateLedgerUsagePeriod(ctx context.Context, ledgerID LedgerID, body UpdateLedgerUsagePeriodJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLedgerUsagePeriodRequest(c.Server, ledgerID, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/events")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListMeters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMetersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.From != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.To != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewIngestEventsRequestWithApplicationCloudeventsPlusJSONBody calls the generic IngestEvents builder with application/cloudevents+json body
-func NewIngestEventsRequestWithApplicationCloudeventsPlusJSONBody(server string, body IngestEventsApplicationCloudeventsPlusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateMeterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMeterRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewIngestEventsRequestWithBody(server, "application/cloudevents+json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewIngestEventsRequestWithApplicationCloudeventsBatchPlusJSONBody calls the generic IngestEvents builder with application/cloudevents-batch+json body
-func NewIngestEventsRequestWithApplicationCloudeventsBatchPlusJSONBody(server string, body IngestEventsApplicationCloudeventsBatchPlusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateMeter(ctx context.Context, body CreateMeterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMeterRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewIngestEventsRequestWithBody(server, "application/cloudevents-batch+json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewIngestEventsRequestWithBody generates requests for IngestEvents with any type of body
-func NewIngestEventsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteMeter(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMeterRequest(c.Server, meterIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/events")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetMeter(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMeterRequest(c.Server, meterIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListFeaturesRequest generates requests for ListFeatures
-func NewListFeaturesRequest(server string, params *ListFeaturesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) QueryMeter(ctx context.Context, meterIdOrSlug MeterIdOrSlug, params *QueryMeterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryMeterRequest(c.Server, meterIdOrSlug, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/features")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListMeterSubjects(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMeterSubjectsRequest(c.Server, meterIdOrSlug)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderBy != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.IncludeArchived != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeArchived", runtime.ParamLocationQuery, *params.IncludeArchived); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) QueryPortalMeter(ctx context.Context, meterSlug string, params *QueryPortalMeterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryPortalMeterRequest(c.Server, meterSlug, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateFeatureRequest calls the generic CreateFeature builder with application/json body
-func NewCreateFeatureRequest(server string, body CreateFeatureJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListPortalTokens(ctx context.Context, params *ListPortalTokensParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPortalTokensRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateFeatureRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateFeatureRequestWithBody generates requests for CreateFeature with any type of body
-func NewCreateFeatureRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreatePortalTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePortalTokenRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/features")
+func (c *Client) CreatePortalToken(ctx context.Context, body CreatePortalTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePortalTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InvalidatePortalTokensWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvalidatePortalTokensRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InvalidatePortalTokens(ctx context.Context, body InvalidatePortalTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInvalidatePortalTokensRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSubjectAliases(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSubjectAliasesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpsertSubjectAliasWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpsertSubjectAliasRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpsertSubjectAlias(ctx context.Context, body UpsertSubjectAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpsertSubjectAliasRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSubjectAlias(ctx context.Context, subjectAliasID SubjectAliasID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSubjectAliasRequest(c.Server, subjectAliasID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSubjectAlias(ctx context.Context, subjectAliasID SubjectAliasID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSubjectAliasRequest(c.Server, subjectAliasID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSubjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSubjectsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpsertSubjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpsertSubjectRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpsertSubject(ctx context.Context, body UpsertSubjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpsertSubjectRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSubject(ctx context.Context, subjectIdOrKey SubjectIdOrKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSubjectRequest(c.Server, subjectIdOrKey)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSubject(ctx context.Context, subjectIdOrKey SubjectIdOrKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSubjectRequest(c.Server, subjectIdOrKey)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListEventTypesRequest generates requests for ListEventTypes
+func NewListEventTypesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event-types")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpsertEventTypeRequest calls the generic UpsertEventType builder with application/json body
+func NewUpsertEventTypeRequest(server string, body UpsertEventTypeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpsertEventTypeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpsertEventTypeRequestWithBody generates requests for UpsertEventType with any type of body
+func NewUpsertEventTypeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/event-types")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1631,13 +2141,13 @@ func NewCreateFeatureRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewDeleteFeatureRequest generates requests for DeleteFeature
-func NewDeleteFeatureRequest(server string, featureID FeatureID) (*http.Request, error) {
+// NewDeleteEventTypeRequest generates requests for DeleteEventType
+func NewDeleteEventTypeRequest(server string, eventType EventTypeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "featureID", runtime.ParamLocationPath, featureID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventType", runtime.ParamLocationPath, eventType)
 	if err != nil {
 		return nil, err
 	}
@@ -1647,7 +2157,7 @@ func NewDeleteFeatureRequest(server string, featureID FeatureID) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/features/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event-types/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1665,13 +2175,13 @@ func NewDeleteFeatureRequest(server string, featureID FeatureID) (*http.Request,
 	return req, nil
 }
 
-// NewGetFeatureRequest generates requests for GetFeature
-func NewGetFeatureRequest(server string, featureID FeatureID) (*http.Request, error) {
+// NewGetEventTypeRequest generates requests for GetEventType
+func NewGetEventTypeRequest(server string, eventType EventTypeName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "featureID", runtime.ParamLocationPath, featureID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "eventType", runtime.ParamLocationPath, eventType)
 	if err != nil {
 		return nil, err
 	}
@@ -1681,7 +2191,7 @@ func NewGetFeatureRequest(server string, featureID FeatureID) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/features/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event-types/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1699,8 +2209,8 @@ func NewGetFeatureRequest(server string, featureID FeatureID) (*http.Request, er
 	return req, nil
 }
 
-// NewListLedgersRequest generates requests for ListLedgers
-func NewListLedgersRequest(server string, params *ListLedgersParams) (*http.Request, error) {
+// NewListEventsRequest generates requests for ListEvents
+func NewListEventsRequest(server string, params *ListEventsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1708,7 +2218,7 @@ func NewListLedgersRequest(server string, params *ListLedgersParams) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/ledgers")
+	operationPath := fmt.Sprintf("/api/v1/events")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1721,9 +2231,9 @@ func NewListLedgersRequest(server string, params *ListLedgersParams) (*http.Requ
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Subject != nil {
+		if params.From != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1737,9 +2247,9 @@ func NewListLedgersRequest(server string, params *ListLedgersParams) (*http.Requ
 
 		}
 
-		if params.SubjectSimilarTo != nil {
+		if params.To != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subjectSimilarTo", runtime.ParamLocationQuery, *params.SubjectSimilarTo); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1769,38 +2279,6 @@ func NewListLedgersRequest(server string, params *ListLedgersParams) (*http.Requ
 
 		}
 
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderBy != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -1812,27 +2290,38 @@ func NewListLedgersRequest(server string, params *ListLedgersParams) (*http.Requ
 	return req, nil
 }
 
-// NewCreateLedgerRequest calls the generic CreateLedger builder with application/json body
-func NewCreateLedgerRequest(server string, body CreateLedgerJSONRequestBody) (*http.Request, error) {
+// NewIngestEventsRequestWithApplicationCloudeventsPlusJSONBody calls the generic IngestEvents builder with application/cloudevents+json body
+func NewIngestEventsRequestWithApplicationCloudeventsPlusJSONBody(server string, body IngestEventsApplicationCloudeventsPlusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateLedgerRequestWithBody(server, "application/json", bodyReader)
+	return NewIngestEventsRequestWithBody(server, "application/cloudevents+json", bodyReader)
 }
 
-// NewCreateLedgerRequestWithBody generates requests for CreateLedger with any type of body
-func NewCreateLedgerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
+// NewIngestEventsRequestWithApplicationCloudeventsBatchPlusJSONBody calls the generic IngestEvents builder with application/cloudevents-batch+json body
+func NewIngestEventsRequestWithApplicationCloudeventsBatchPlusJSONBody(server string, body IngestEventsApplicationCloudeventsBatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewIngestEventsRequestWithBody(server, "application/cloudevents-batch+json", bodyReader)
+}
+
+// NewIngestEventsRequestWithBody generates requests for IngestEvents with any type of body
+func NewIngestEventsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/ledgers")
+	operationPath := fmt.Sprintf("/api/v1/events")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1852,8 +2341,8 @@ func NewCreateLedgerRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
-// NewListLedgerGrantsRequest generates requests for ListLedgerGrants
-func NewListLedgerGrantsRequest(server string, params *ListLedgerGrantsParams) (*http.Request, error) {
+// NewListFeaturesRequest generates requests for ListFeatures
+func NewListFeaturesRequest(server string, params *ListFeaturesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1861,7 +2350,7 @@ func NewListLedgerGrantsRequest(server string, params *ListLedgerGrantsParams) (
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/ledgers/grants")
+	operationPath := fmt.Sprintf("/api/v1/features")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1874,9 +2363,9 @@ func NewListLedgerGrantsRequest(server string, params *ListLedgerGrantsParams) (
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.LedgerID != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ledgerID", runtime.ParamLocationQuery, *params.LedgerID); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1890,9 +2379,9 @@ func NewListLedgerGrantsRequest(server string, params *ListLedgerGrantsParams) (
 
 		}
 
-		if params.Limit != nil {
+		if params.Offset != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1906,9 +2395,25 @@ func NewListLedgerGrantsRequest(server string, params *ListLedgerGrantsParams) (
 
 		}
 
-		if params.IncludeVoids != nil {
+		if params.OrderBy != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeVoids", runtime.ParamLocationQuery, *params.IncludeVoids); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeArchived != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeArchived", runtime.ParamLocationQuery, *params.IncludeArchived); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1933,23 +2438,27 @@ func NewListLedgerGrantsRequest(server string, params *ListLedgerGrantsParams) (
 	return req, nil
 }
 
-// NewGetLedgerBalanceRequest generates requests for GetLedgerBalance
-func NewGetLedgerBalanceRequest(server string, ledgerID LedgerID, params *GetLedgerBalanceParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+// NewCreateFeatureRequest calls the generic CreateFeature builder with application/json body
+func NewCreateFeatureRequest(server string, body CreateFeatureJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateFeatureRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateFeatureRequestWithBody generates requests for CreateFeature with any type of body
+func NewCreateFeatureRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/balance", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/features")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1959,43 +2468,23 @@ func NewGetLedgerBalanceRequest(server string, ledgerID LedgerID, params *GetLed
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Time != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "time", runtime.ParamLocationQuery, *params.Time); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewListLedgerGrantsByLedgerRequest generates requests for ListLedgerGrantsByLedger
-func NewListLedgerGrantsByLedgerRequest(server string, ledgerID LedgerID, params *ListLedgerGrantsByLedgerParams) (*http.Request, error) {
+// NewDeleteFeatureRequest generates requests for DeleteFeature
+func NewDeleteFeatureRequest(server string, featureID FeatureID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "featureID", runtime.ParamLocationPath, featureID)
 	if err != nil {
 		return nil, err
 	}
@@ -2005,7 +2494,7 @@ func NewListLedgerGrantsByLedgerRequest(server string, ledgerID LedgerID, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grants", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/features/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2015,45 +2504,7 @@ func NewListLedgerGrantsByLedgerRequest(server string, ledgerID LedgerID, params
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.IncludeVoids != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeVoids", runtime.ParamLocationQuery, *params.IncludeVoids); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2061,24 +2512,13 @@ func NewListLedgerGrantsByLedgerRequest(server string, ledgerID LedgerID, params
 	return req, nil
 }
 
-// NewCreateLedgerGrantRequest calls the generic CreateLedgerGrant builder with application/json body
-func NewCreateLedgerGrantRequest(server string, ledgerID LedgerID, body CreateLedgerGrantJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateLedgerGrantRequestWithBody(server, ledgerID, "application/json", bodyReader)
-}
-
-// NewCreateLedgerGrantRequestWithBody generates requests for CreateLedgerGrant with any type of body
-func NewCreateLedgerGrantRequestWithBody(server string, ledgerID LedgerID, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetFeatureRequest generates requests for GetFeature
+func NewGetFeatureRequest(server string, featureID FeatureID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "featureID", runtime.ParamLocationPath, featureID)
 	if err != nil {
 		return nil, err
 	}
@@ -2088,7 +2528,7 @@ func NewCreateLedgerGrantRequestWithBody(server string, ledgerID LedgerID, conte
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grants", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/features/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2098,30 +2538,32 @@ func NewCreateLedgerGrantRequestWithBody(server string, ledgerID LedgerID, conte
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewVoidLedgerGrantRequest generates requests for VoidLedgerGrant
-func NewVoidLedgerGrantRequest(server string, ledgerID LedgerID, ledgerGrantID LedgerGrantID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+// NewUpdateFeatureRequest calls the generic UpdateFeature builder with application/json body
+func NewUpdateFeatureRequest(server string, featureID FeatureID, body UpdateFeatureJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateFeatureRequestWithBody(server, featureID, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewUpdateFeatureRequestWithBody generates requests for UpdateFeature with any type of body
+func NewUpdateFeatureRequestWithBody(server string, featureID FeatureID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ledgerGrantID", runtime.ParamLocationPath, ledgerGrantID)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "featureID", runtime.ParamLocationPath, featureID)
 	if err != nil {
 		return nil, err
 	}
@@ -2131,7 +2573,7 @@ func NewVoidLedgerGrantRequest(server string, ledgerID LedgerID, ledgerGrantID L
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grants/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/features/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2141,38 +2583,26 @@ func NewVoidLedgerGrantRequest(server string, ledgerID LedgerID, ledgerGrantID L
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetLedgerGrantRequest generates requests for GetLedgerGrant
-func NewGetLedgerGrantRequest(server string, ledgerID LedgerID, ledgerGrantID LedgerGrantID) (*http.Request, error) {
+// NewGetFreshnessRequest generates requests for GetFreshness
+func NewGetFreshnessRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ledgerGrantID", runtime.ParamLocationPath, ledgerGrantID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grants/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/freshness")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2190,23 +2620,16 @@ func NewGetLedgerGrantRequest(server string, ledgerID LedgerID, ledgerGrantID Le
 	return req, nil
 }
 
-// NewGetLedgerHistoryRequest generates requests for GetLedgerHistory
-func NewGetLedgerHistoryRequest(server string, ledgerID LedgerID, params *GetLedgerHistoryParams) (*http.Request, error) {
+// NewListLedgersRequest generates requests for ListLedgers
+func NewListLedgersRequest(server string, params *ListLedgersParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/history", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/ledgers")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2219,9 +2642,9 @@ func NewGetLedgerHistoryRequest(server string, ledgerID LedgerID, params *GetLed
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Limit != nil {
+		if params.Subject != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2235,9 +2658,9 @@ func NewGetLedgerHistoryRequest(server string, ledgerID LedgerID, params *GetLed
 
 		}
 
-		if params.Offset != nil {
+		if params.SubjectSimilarTo != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subjectSimilarTo", runtime.ParamLocationQuery, *params.SubjectSimilarTo); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2251,21 +2674,25 @@ func NewGetLedgerHistoryRequest(server string, ledgerID LedgerID, params *GetLed
 
 		}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
-		if params.To != nil {
+		if params.Offset != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2279,10 +2706,26 @@ func NewGetLedgerHistoryRequest(server string, ledgerID LedgerID, params *GetLed
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		if params.OrderBy != nil {
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2290,34 +2733,27 @@ func NewGetLedgerHistoryRequest(server string, ledgerID LedgerID, params *GetLed
 	return req, nil
 }
 
-// NewResetLedgerRequest calls the generic ResetLedger builder with application/json body
-func NewResetLedgerRequest(server string, ledgerID LedgerID, body ResetLedgerJSONRequestBody) (*http.Request, error) {
+// NewCreateLedgerRequest calls the generic CreateLedger builder with application/json body
+func NewCreateLedgerRequest(server string, body CreateLedgerJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewResetLedgerRequestWithBody(server, ledgerID, "application/json", bodyReader)
+	return NewCreateLedgerRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewResetLedgerRequestWithBody generates requests for ResetLedger with any type of body
-func NewResetLedgerRequestWithBody(server string, ledgerID LedgerID, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateLedgerRequestWithBody generates requests for CreateLedger with any type of body
+func NewCreateLedgerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/reset", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/ledgers")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2337,8 +2773,8 @@ func NewResetLedgerRequestWithBody(server string, ledgerID LedgerID, contentType
 	return req, nil
 }
 
-// NewListMetersRequest generates requests for ListMeters
-func NewListMetersRequest(server string) (*http.Request, error) {
+// NewCheckLedgerEntitlementRequest generates requests for CheckLedgerEntitlement
+func NewCheckLedgerEntitlementRequest(server string, params *CheckLedgerEntitlementParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2346,7 +2782,7 @@ func NewListMetersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/meters")
+	operationPath := fmt.Sprintf("/api/v1/ledgers/entitlement")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2356,6 +2792,36 @@ func NewListMetersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, params.Subject); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "featureID", runtime.ParamLocationQuery, params.FeatureID); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2364,19 +2830,8 @@ func NewListMetersRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateMeterRequest calls the generic CreateMeter builder with application/json body
-func NewCreateMeterRequest(server string, body CreateMeterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateMeterRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewCreateMeterRequestWithBody generates requests for CreateMeter with any type of body
-func NewCreateMeterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewListLedgerGrantsRequest generates requests for ListLedgerGrants
+func NewListLedgerGrantsRequest(server string, params *ListLedgerGrantsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2384,7 +2839,7 @@ func NewCreateMeterRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/meters")
+	operationPath := fmt.Sprintf("/api/v1/ledgers/grants")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2394,23 +2849,75 @@ func NewCreateMeterRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.LedgerID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ledgerID", runtime.ParamLocationQuery, *params.LedgerID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeVoids != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeVoids", runtime.ParamLocationQuery, *params.IncludeVoids); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteMeterRequest generates requests for DeleteMeter
-func NewDeleteMeterRequest(server string, meterIdOrSlug MeterIdOrSlug) (*http.Request, error) {
+// NewGetLedgerBalanceRequest generates requests for GetLedgerBalance
+func NewGetLedgerBalanceRequest(server string, ledgerID LedgerID, params *GetLedgerBalanceParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "meterIdOrSlug", runtime.ParamLocationPath, meterIdOrSlug)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
 	if err != nil {
 		return nil, err
 	}
@@ -2420,7 +2927,7 @@ func NewDeleteMeterRequest(server string, meterIdOrSlug MeterIdOrSlug) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/meters/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/balance", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2430,7 +2937,29 @@ func NewDeleteMeterRequest(server string, meterIdOrSlug MeterIdOrSlug) (*http.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Time != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "time", runtime.ParamLocationQuery, *params.Time); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2438,13 +2967,13 @@ func NewDeleteMeterRequest(server string, meterIdOrSlug MeterIdOrSlug) (*http.Re
 	return req, nil
 }
 
-// NewGetMeterRequest generates requests for GetMeter
-func NewGetMeterRequest(server string, meterIdOrSlug MeterIdOrSlug) (*http.Request, error) {
+// NewListLedgerGrantSchedulesRequest generates requests for ListLedgerGrantSchedules
+func NewListLedgerGrantSchedulesRequest(server string, ledgerID LedgerID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "meterIdOrSlug", runtime.ParamLocationPath, meterIdOrSlug)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
 	if err != nil {
 		return nil, err
 	}
@@ -2454,7 +2983,7 @@ func NewGetMeterRequest(server string, meterIdOrSlug MeterIdOrSlug) (*http.Reque
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/meters/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grant-schedules", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2472,13 +3001,24 @@ func NewGetMeterRequest(server string, meterIdOrSlug MeterIdOrSlug) (*http.Reque
 	return req, nil
 }
 
-// NewQueryMeterRequest generates requests for QueryMeter
-func NewQueryMeterRequest(server string, meterIdOrSlug MeterIdOrSlug, params *QueryMeterParams) (*http.Request, error) {
+// NewCreateLedgerGrantScheduleRequest calls the generic CreateLedgerGrantSchedule builder with application/json body
+func NewCreateLedgerGrantScheduleRequest(server string, ledgerID LedgerID, body CreateLedgerGrantScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateLedgerGrantScheduleRequestWithBody(server, ledgerID, "application/json", bodyReader)
+}
+
+// NewCreateLedgerGrantScheduleRequestWithBody generates requests for CreateLedgerGrantSchedule with any type of body
+func NewCreateLedgerGrantScheduleRequestWithBody(server string, ledgerID LedgerID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "meterIdOrSlug", runtime.ParamLocationPath, meterIdOrSlug)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
 	if err != nil {
 		return nil, err
 	}
@@ -2488,7 +3028,7 @@ func NewQueryMeterRequest(server string, meterIdOrSlug MeterIdOrSlug, params *Qu
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/meters/%s/query", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grant-schedules", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2498,125 +3038,50 @@ func NewQueryMeterRequest(server string, meterIdOrSlug MeterIdOrSlug, params *Qu
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.From != nil {
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req.Header.Add("Content-Type", contentType)
 
-		}
+	return req, nil
+}
 
-		if params.To != nil {
+// NewDeleteLedgerGrantScheduleRequest generates requests for DeleteLedgerGrantSchedule
+func NewDeleteLedgerGrantScheduleRequest(server string, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.WindowSize != nil {
+	var pathParam1 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "windowSize", runtime.ParamLocationQuery, *params.WindowSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ledgerGrantScheduleID", runtime.ParamLocationPath, ledgerGrantScheduleID)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.WindowTimeZone != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "windowTimeZone", runtime.ParamLocationQuery, *params.WindowTimeZone); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Subject != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.FilterGroupBy != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("deepObject", true, "filterGroupBy", runtime.ParamLocationQuery, *params.FilterGroupBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.GroupBy != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groupBy", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grant-schedules/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2624,13 +3089,20 @@ func NewQueryMeterRequest(server string, meterIdOrSlug MeterIdOrSlug, params *Qu
 	return req, nil
 }
 
-// NewListMeterSubjectsRequest generates requests for ListMeterSubjects
-func NewListMeterSubjectsRequest(server string, meterIdOrSlug MeterIdOrSlug) (*http.Request, error) {
+// NewGetLedgerGrantScheduleRequest generates requests for GetLedgerGrantSchedule
+func NewGetLedgerGrantScheduleRequest(server string, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "meterIdOrSlug", runtime.ParamLocationPath, meterIdOrSlug)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ledgerGrantScheduleID", runtime.ParamLocationPath, ledgerGrantScheduleID)
 	if err != nil {
 		return nil, err
 	}
@@ -2640,7 +3112,7 @@ func NewListMeterSubjectsRequest(server string, meterIdOrSlug MeterIdOrSlug) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/meters/%s/subjects", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grant-schedules/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2658,13 +3130,13 @@ func NewListMeterSubjectsRequest(server string, meterIdOrSlug MeterIdOrSlug) (*h
 	return req, nil
 }
 
-// NewQueryPortalMeterRequest generates requests for QueryPortalMeter
-func NewQueryPortalMeterRequest(server string, meterSlug string, params *QueryPortalMeterParams) (*http.Request, error) {
+// NewListLedgerGrantsByLedgerRequest generates requests for ListLedgerGrantsByLedger
+func NewListLedgerGrantsByLedgerRequest(server string, ledgerID LedgerID, params *ListLedgerGrantsByLedgerParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "meterSlug", runtime.ParamLocationPath, meterSlug)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
 	if err != nil {
 		return nil, err
 	}
@@ -2674,7 +3146,7 @@ func NewQueryPortalMeterRequest(server string, meterSlug string, params *QueryPo
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/portal/meters/%s/query", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grants", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2687,73 +3159,9 @@ func NewQueryPortalMeterRequest(server string, meterSlug string, params *QueryPo
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.From != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.To != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.WindowSize != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "windowSize", runtime.ParamLocationQuery, *params.WindowSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.WindowTimeZone != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "windowTimeZone", runtime.ParamLocationQuery, *params.WindowTimeZone); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.FilterGroupBy != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("deepObject", true, "filterGroupBy", runtime.ParamLocationQuery, *params.FilterGroupBy); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2767,9 +3175,9 @@ func NewQueryPortalMeterRequest(server string, meterSlug string, params *QueryPo
 
 		}
 
-		if params.GroupBy != nil {
+		if params.IncludeVoids != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groupBy", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeVoids", runtime.ParamLocationQuery, *params.IncludeVoids); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2794,16 +3202,34 @@ func NewQueryPortalMeterRequest(server string, meterSlug string, params *QueryPo
 	return req, nil
 }
 
-// NewListPortalTokensRequest generates requests for ListPortalTokens
-func NewListPortalTokensRequest(server string, params *ListPortalTokensParams) (*http.Request, error) {
+// NewCreateLedgerGrantRequest calls the generic CreateLedgerGrant builder with application/json body
+func NewCreateLedgerGrantRequest(server string, ledgerID LedgerID, body CreateLedgerGrantJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateLedgerGrantRequestWithBody(server, ledgerID, "application/json", bodyReader)
+}
+
+// NewCreateLedgerGrantRequestWithBody generates requests for CreateLedgerGrant with any type of body
+func NewCreateLedgerGrantRequestWithBody(server string, ledgerID LedgerID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/portal/tokens")
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grants", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2813,57 +3239,40 @@ func NewListPortalTokensRequest(server string, params *ListPortalTokensParams) (
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Limit != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewVoidLedgerGrantRequest generates requests for VoidLedgerGrant
+func NewVoidLedgerGrantRequest(server string, ledgerID LedgerID, ledgerGrantID LedgerGrantID) (*http.Request, error) {
+	var err error
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+	var pathParam0 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	var pathParam1 string
 
-// NewCreatePortalTokenRequest calls the generic CreatePortalToken builder with application/json body
-func NewCreatePortalTokenRequest(server string, body CreatePortalTokenJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ledgerGrantID", runtime.ParamLocationPath, ledgerGrantID)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreatePortalTokenRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewCreatePortalTokenRequestWithBody generates requests for CreatePortalToken with any type of body
-func NewCreatePortalTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/portal/tokens")
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grants/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2873,37 +3282,38 @@ func NewCreatePortalTokenRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewInvalidatePortalTokensRequest calls the generic InvalidatePortalTokens builder with application/json body
-func NewInvalidatePortalTokensRequest(server string, body InvalidatePortalTokensJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetLedgerGrantRequest generates requests for GetLedgerGrant
+func NewGetLedgerGrantRequest(server string, ledgerID LedgerID, ledgerGrantID LedgerGrantID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewInvalidatePortalTokensRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewInvalidatePortalTokensRequestWithBody generates requests for InvalidatePortalTokens with any type of body
-func NewInvalidatePortalTokensRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ledgerGrantID", runtime.ParamLocationPath, ledgerGrantID)
+	if err != nil {
+		return nil, err
+	}
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/portal/tokens/invalidate")
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grants/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2913,26 +3323,38 @@ func NewInvalidatePortalTokensRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewListSubjectsRequest generates requests for ListSubjects
-func NewListSubjectsRequest(server string) (*http.Request, error) {
+// NewListLedgerGrantAmendmentsRequest generates requests for ListLedgerGrantAmendments
+func NewListLedgerGrantAmendmentsRequest(server string, ledgerID LedgerID, ledgerGrantID LedgerGrantID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ledgerGrantID", runtime.ParamLocationPath, ledgerGrantID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/subjects")
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grants/%s/amendments", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2950,27 +3372,41 @@ func NewListSubjectsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpsertSubjectRequest calls the generic UpsertSubject builder with application/json body
-func NewUpsertSubjectRequest(server string, body UpsertSubjectJSONRequestBody) (*http.Request, error) {
+// NewAmendLedgerGrantRequest calls the generic AmendLedgerGrant builder with application/json body
+func NewAmendLedgerGrantRequest(server string, ledgerID LedgerID, ledgerGrantID LedgerGrantID, body AmendLedgerGrantJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpsertSubjectRequestWithBody(server, "application/json", bodyReader)
+	return NewAmendLedgerGrantRequestWithBody(server, ledgerID, ledgerGrantID, "application/json", bodyReader)
 }
 
-// NewUpsertSubjectRequestWithBody generates requests for UpsertSubject with any type of body
-func NewUpsertSubjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAmendLedgerGrantRequestWithBody generates requests for AmendLedgerGrant with any type of body
+func NewAmendLedgerGrantRequestWithBody(server string, ledgerID LedgerID, ledgerGrantID LedgerGrantID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ledgerGrantID", runtime.ParamLocationPath, ledgerGrantID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/subjects")
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/grants/%s/amendments", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2990,13 +3426,13 @@ func NewUpsertSubjectRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewDeleteSubjectRequest generates requests for DeleteSubject
-func NewDeleteSubjectRequest(server string, subjectIdOrKey SubjectIdOrKey) (*http.Request, error) {
+// NewGetLedgerHistoryRequest generates requests for GetLedgerHistory
+func NewGetLedgerHistoryRequest(server string, ledgerID LedgerID, params *GetLedgerHistoryParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subjectIdOrKey", runtime.ParamLocationPath, subjectIdOrKey)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
 	if err != nil {
 		return nil, err
 	}
@@ -3006,7 +3442,7 @@ func NewDeleteSubjectRequest(server string, subjectIdOrKey SubjectIdOrKey) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/subjects/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/history", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3016,7 +3452,73 @@ func NewDeleteSubjectRequest(server string, subjectIdOrKey SubjectIdOrKey) (*htt
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3024,13 +3526,13 @@ func NewDeleteSubjectRequest(server string, subjectIdOrKey SubjectIdOrKey) (*htt
 	return req, nil
 }
 
-// NewGetSubjectRequest generates requests for GetSubject
-func NewGetSubjectRequest(server string, subjectIdOrKey SubjectIdOrKey) (*http.Request, error) {
+// NewListLedgerNotificationRulesRequest generates requests for ListLedgerNotificationRules
+func NewListLedgerNotificationRulesRequest(server string, ledgerID LedgerID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subjectIdOrKey", runtime.ParamLocationPath, subjectIdOrKey)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
 	if err != nil {
 		return nil, err
 	}
@@ -3040,7 +3542,7 @@ func NewGetSubjectRequest(server string, subjectIdOrKey SubjectIdOrKey) (*http.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/subjects/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/notification-rules", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3058,1278 +3560,4397 @@ func NewGetSubjectRequest(server string, subjectIdOrKey SubjectIdOrKey) (*http.R
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewCreateLedgerNotificationRuleRequest calls the generic CreateLedgerNotificationRule builder with application/json body
+func NewCreateLedgerNotificationRuleRequest(server string, ledgerID LedgerID, body CreateLedgerNotificationRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateLedgerNotificationRuleRequestWithBody(server, ledgerID, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewCreateLedgerNotificationRuleRequestWithBody generates requests for CreateLedgerNotificationRule with any type of body
+func NewCreateLedgerNotificationRuleRequestWithBody(server string, ledgerID LedgerID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListEventsWithResponse request
-	ListEventsWithResponse(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*ListEventsResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/notification-rules", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// IngestEventsWithBodyWithResponse request with any body
-	IngestEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IngestEventsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	IngestEventsWithApplicationCloudeventsPlusJSONBodyWithResponse(ctx context.Context, body IngestEventsApplicationCloudeventsPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*IngestEventsResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	IngestEventsWithApplicationCloudeventsBatchPlusJSONBodyWithResponse(ctx context.Context, body IngestEventsApplicationCloudeventsBatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*IngestEventsResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// ListFeaturesWithResponse request
-	ListFeaturesWithResponse(ctx context.Context, params *ListFeaturesParams, reqEditors ...RequestEditorFn) (*ListFeaturesResponse, error)
+	return req, nil
+}
 
-	// CreateFeatureWithBodyWithResponse request with any body
-	CreateFeatureWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFeatureResponse, error)
+// NewDeleteLedgerNotificationRuleRequest generates requests for DeleteLedgerNotificationRule
+func NewDeleteLedgerNotificationRuleRequest(server string, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID) (*http.Request, error) {
+	var err error
 
-	CreateFeatureWithResponse(ctx context.Context, body CreateFeatureJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFeatureResponse, error)
+	var pathParam0 string
 
-	// DeleteFeatureWithResponse request
-	DeleteFeatureWithResponse(ctx context.Context, featureID FeatureID, reqEditors ...RequestEditorFn) (*DeleteFeatureResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetFeatureWithResponse request
-	GetFeatureWithResponse(ctx context.Context, featureID FeatureID, reqEditors ...RequestEditorFn) (*GetFeatureResponse, error)
+	var pathParam1 string
 
-	// ListLedgersWithResponse request
-	ListLedgersWithResponse(ctx context.Context, params *ListLedgersParams, reqEditors ...RequestEditorFn) (*ListLedgersResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ledgerNotificationRuleID", runtime.ParamLocationPath, ledgerNotificationRuleID)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateLedgerWithBodyWithResponse request with any body
-	CreateLedgerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLedgerResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateLedgerWithResponse(ctx context.Context, body CreateLedgerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLedgerResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/notification-rules/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListLedgerGrantsWithResponse request
-	ListLedgerGrantsWithResponse(ctx context.Context, params *ListLedgerGrantsParams, reqEditors ...RequestEditorFn) (*ListLedgerGrantsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetLedgerBalanceWithResponse request
-	GetLedgerBalanceWithResponse(ctx context.Context, ledgerID LedgerID, params *GetLedgerBalanceParams, reqEditors ...RequestEditorFn) (*GetLedgerBalanceResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListLedgerGrantsByLedgerWithResponse request
-	ListLedgerGrantsByLedgerWithResponse(ctx context.Context, ledgerID LedgerID, params *ListLedgerGrantsByLedgerParams, reqEditors ...RequestEditorFn) (*ListLedgerGrantsByLedgerResponse, error)
+	return req, nil
+}
 
-	// CreateLedgerGrantWithBodyWithResponse request with any body
-	CreateLedgerGrantWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLedgerGrantResponse, error)
+// NewGetLedgerNotificationRuleRequest generates requests for GetLedgerNotificationRule
+func NewGetLedgerNotificationRuleRequest(server string, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID) (*http.Request, error) {
+	var err error
 
-	CreateLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, body CreateLedgerGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLedgerGrantResponse, error)
+	var pathParam0 string
 
-	// VoidLedgerGrantWithResponse request
-	VoidLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*VoidLedgerGrantResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetLedgerGrantWithResponse request
-	GetLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*GetLedgerGrantResponse, error)
+	var pathParam1 string
 
-	// GetLedgerHistoryWithResponse request
-	GetLedgerHistoryWithResponse(ctx context.Context, ledgerID LedgerID, params *GetLedgerHistoryParams, reqEditors ...RequestEditorFn) (*GetLedgerHistoryResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ledgerNotificationRuleID", runtime.ParamLocationPath, ledgerNotificationRuleID)
+	if err != nil {
+		return nil, err
+	}
 
-	// ResetLedgerWithBodyWithResponse request with any body
-	ResetLedgerWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetLedgerResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	ResetLedgerWithResponse(ctx context.Context, ledgerID LedgerID, body ResetLedgerJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetLedgerResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/notification-rules/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListMetersWithResponse request
-	ListMetersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMetersResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateMeterWithBodyWithResponse request with any body
-	CreateMeterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMeterResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateMeterWithResponse(ctx context.Context, body CreateMeterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMeterResponse, error)
+	return req, nil
+}
 
-	// DeleteMeterWithResponse request
-	DeleteMeterWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteMeterResponse, error)
+// NewListLedgerNotificationDeliveriesRequest generates requests for ListLedgerNotificationDeliveries
+func NewListLedgerNotificationDeliveriesRequest(server string, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, params *ListLedgerNotificationDeliveriesParams) (*http.Request, error) {
+	var err error
 
-	// GetMeterWithResponse request
-	GetMeterWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*GetMeterResponse, error)
+	var pathParam0 string
 
-	// QueryMeterWithResponse request
-	QueryMeterWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, params *QueryMeterParams, reqEditors ...RequestEditorFn) (*QueryMeterResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListMeterSubjectsWithResponse request
-	ListMeterSubjectsWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*ListMeterSubjectsResponse, error)
+	var pathParam1 string
 
-	// QueryPortalMeterWithResponse request
-	QueryPortalMeterWithResponse(ctx context.Context, meterSlug string, params *QueryPortalMeterParams, reqEditors ...RequestEditorFn) (*QueryPortalMeterResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ledgerNotificationRuleID", runtime.ParamLocationPath, ledgerNotificationRuleID)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListPortalTokensWithResponse request
-	ListPortalTokensWithResponse(ctx context.Context, params *ListPortalTokensParams, reqEditors ...RequestEditorFn) (*ListPortalTokensResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreatePortalTokenWithBodyWithResponse request with any body
-	CreatePortalTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePortalTokenResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/notification-rules/%s/deliveries", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreatePortalTokenWithResponse(ctx context.Context, body CreatePortalTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePortalTokenResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// InvalidatePortalTokensWithBodyWithResponse request with any body
-	InvalidatePortalTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InvalidatePortalTokensResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	InvalidatePortalTokensWithResponse(ctx context.Context, body InvalidatePortalTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*InvalidatePortalTokensResponse, error)
+		if params.Limit != nil {
 
-	// ListSubjectsWithResponse request
-	ListSubjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSubjectsResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// UpsertSubjectWithBodyWithResponse request with any body
-	UpsertSubjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertSubjectResponse, error)
+		}
 
-	UpsertSubjectWithResponse(ctx context.Context, body UpsertSubjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertSubjectResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// DeleteSubjectWithResponse request
-	DeleteSubjectWithResponse(ctx context.Context, subjectIdOrKey SubjectIdOrKey, reqEditors ...RequestEditorFn) (*DeleteSubjectResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetSubjectWithResponse request
-	GetSubjectWithResponse(ctx context.Context, subjectIdOrKey SubjectIdOrKey, reqEditors ...RequestEditorFn) (*GetSubjectResponse, error)
+	return req, nil
 }
 
-type ListEventsResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *[]IngestedEvent
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+// NewUpdateLedgerOverageLimitsRequest calls the generic UpdateLedgerOverageLimits builder with application/json body
+func NewUpdateLedgerOverageLimitsRequest(server string, ledgerID LedgerID, body UpdateLedgerOverageLimitsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateLedgerOverageLimitsRequestWithBody(server, ledgerID, "application/json", bodyReader)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListEventsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateLedgerOverageLimitsRequestWithBody generates requests for UpdateLedgerOverageLimits with any type of body
+func NewUpdateLedgerOverageLimitsRequestWithBody(server string, ledgerID LedgerID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListEventsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type IngestEventsResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/overage-limits", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r IngestEventsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r IngestEventsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListFeaturesResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *[]Feature
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r ListFeaturesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListFeaturesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewResetLedgerRequest calls the generic ResetLedger builder with application/json body
+func NewResetLedgerRequest(server string, ledgerID LedgerID, body ResetLedgerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewResetLedgerRequestWithBody(server, ledgerID, "application/json", bodyReader)
 }
 
-type CreateFeatureResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON201                       *Feature
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON501     *NotImplementedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+// NewResetLedgerRequestWithBody generates requests for ResetLedger with any type of body
+func NewResetLedgerRequestWithBody(server string, ledgerID LedgerID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r CreateFeatureResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateFeatureResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteFeatureResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON404     *NotFoundProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/reset", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteFeatureResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteFeatureResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-type GetFeatureResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *Feature
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON404     *NotFoundProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+// NewDeleteLedgerUsagePeriodRequest generates requests for DeleteLedgerUsagePeriod
+func NewDeleteLedgerUsagePeriodRequest(server string, ledgerID LedgerID) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetFeatureResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFeatureResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListLedgersResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *[]Ledger
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/usage-period", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListLedgersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListLedgersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateLedgerResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON201                       *Ledger
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON409     *LedgerAlreadyExistsProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateLedgerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateLedgerUsagePeriodRequest calls the generic UpdateLedgerUsagePeriod builder with application/json body
+func NewUpdateLedgerUsagePeriodRequest(server string, ledgerID LedgerID, body UpdateLedgerUsagePeriodJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateLedgerUsagePeriodRequestWithBody(server, ledgerID, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateLedgerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateLedgerUsagePeriodRequestWithBody generates requests for UpdateLedgerUsagePeriod with any type of body
+func NewUpdateLedgerUsagePeriodRequestWithBody(server string, ledgerID LedgerID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type ListLedgerGrantsResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *[]LedgerGrantResponse
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r ListLedgerGrantsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ledgerID", runtime.ParamLocationPath, ledgerID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListLedgerGrantsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetLedgerBalanceResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *LedgerBalance
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON404     *NotFoundProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/ledgers/%s/usage-period", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetLedgerBalanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLedgerBalanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListLedgerGrantsByLedgerResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *[]LedgerGrantResponse
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListLedgerGrantsByLedgerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewListMetersRequest generates requests for ListMeters
+func NewListMetersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListLedgerGrantsByLedgerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/meters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type CreateLedgerGrantResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON201                       *LedgerGrantResponse
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateLedgerGrantResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateLedgerGrantResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateMeterRequest calls the generic CreateMeter builder with application/json body
+func NewCreateMeterRequest(server string, body CreateMeterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMeterRequestWithBody(server, "application/json", bodyReader)
 }
 
-type VoidLedgerGrantResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON404     *NotFoundProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+// NewCreateMeterRequestWithBody generates requests for CreateMeter with any type of body
+func NewCreateMeterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r VoidLedgerGrantResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r VoidLedgerGrantResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/meters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetLedgerGrantResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *LedgerGrantResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON404     *NotFoundProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetLedgerGrantResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLedgerGrantResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewDeleteMeterRequest generates requests for DeleteMeter
+func NewDeleteMeterRequest(server string, meterIdOrSlug MeterIdOrSlug) (*http.Request, error) {
+	var err error
 
-type GetLedgerHistoryResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *[]LedgerEntry
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON404     *NotFoundProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetLedgerHistoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "meterIdOrSlug", runtime.ParamLocationPath, meterIdOrSlug)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLedgerHistoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type ResetLedgerResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON201                       *LedgerReset
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON404     *NotFoundProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r ResetLedgerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v1/meters/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ResetLedgerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type ListMetersResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *[]Meter
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r ListMetersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListMetersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-type CreateMeterResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON201                       *Meter
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON501     *NotImplementedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+// NewGetMeterRequest generates requests for GetMeter
+func NewGetMeterRequest(server string, meterIdOrSlug MeterIdOrSlug) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r CreateMeterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateMeterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "meterIdOrSlug", runtime.ParamLocationPath, meterIdOrSlug)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type DeleteMeterResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	ApplicationproblemJSON404     *NotFoundProblemResponse
-	ApplicationproblemJSON501     *NotImplementedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r DeleteMeterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteMeterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/meters/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetMeterResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *Meter
-	ApplicationproblemJSON404     *NotFoundProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r GetMeterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMeterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type QueryMeterResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *MeterQueryResult
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r QueryMeterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryMeterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewQueryMeterRequest generates requests for QueryMeter
+func NewQueryMeterRequest(server string, meterIdOrSlug MeterIdOrSlug, params *QueryMeterParams) (*http.Request, error) {
+	var err error
 
-type ListMeterSubjectsResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *[]string
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r ListMeterSubjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "meterIdOrSlug", runtime.ParamLocationPath, meterIdOrSlug)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListMeterSubjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type QueryPortalMeterResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *MeterQueryResult
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r QueryPortalMeterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v1/meters/%s/query", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryPortalMeterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListPortalTokensResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *[]PortalToken
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WindowSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "windowSize", runtime.ParamLocationQuery, *params.WindowSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WindowTimeZone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "windowTimeZone", runtime.ParamLocationQuery, *params.WindowTimeZone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Subject != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FilterGroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("deepObject", true, "filterGroupBy", runtime.ParamLocationQuery, *params.FilterGroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groupBy", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExcludeIncomplete != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "excludeIncomplete", runtime.ParamLocationQuery, *params.ExcludeIncomplete); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListMeterSubjectsRequest generates requests for ListMeterSubjects
+func NewListMeterSubjectsRequest(server string, meterIdOrSlug MeterIdOrSlug) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "meterIdOrSlug", runtime.ParamLocationPath, meterIdOrSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/meters/%s/subjects", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewQueryPortalMeterRequest generates requests for QueryPortalMeter
+func NewQueryPortalMeterRequest(server string, meterSlug string, params *QueryPortalMeterParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "meterSlug", runtime.ParamLocationPath, meterSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/portal/meters/%s/query", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WindowSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "windowSize", runtime.ParamLocationQuery, *params.WindowSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WindowTimeZone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "windowTimeZone", runtime.ParamLocationQuery, *params.WindowTimeZone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FilterGroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("deepObject", true, "filterGroupBy", runtime.ParamLocationQuery, *params.FilterGroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groupBy", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPortalTokensRequest generates requests for ListPortalTokens
+func NewListPortalTokensRequest(server string, params *ListPortalTokensParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/portal/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePortalTokenRequest calls the generic CreatePortalToken builder with application/json body
+func NewCreatePortalTokenRequest(server string, body CreatePortalTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePortalTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePortalTokenRequestWithBody generates requests for CreatePortalToken with any type of body
+func NewCreatePortalTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/portal/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewInvalidatePortalTokensRequest calls the generic InvalidatePortalTokens builder with application/json body
+func NewInvalidatePortalTokensRequest(server string, body InvalidatePortalTokensJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInvalidatePortalTokensRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewInvalidatePortalTokensRequestWithBody generates requests for InvalidatePortalTokens with any type of body
+func NewInvalidatePortalTokensRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/portal/tokens/invalidate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListSubjectAliasesRequest generates requests for ListSubjectAliases
+func NewListSubjectAliasesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/subject-aliases")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpsertSubjectAliasRequest calls the generic UpsertSubjectAlias builder with application/json body
+func NewUpsertSubjectAliasRequest(server string, body UpsertSubjectAliasJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpsertSubjectAliasRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpsertSubjectAliasRequestWithBody generates requests for UpsertSubjectAlias with any type of body
+func NewUpsertSubjectAliasRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/subject-aliases")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSubjectAliasRequest generates requests for DeleteSubjectAlias
+func NewDeleteSubjectAliasRequest(server string, subjectAliasID SubjectAliasID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subjectAliasID", runtime.ParamLocationPath, subjectAliasID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/subject-aliases/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSubjectAliasRequest generates requests for GetSubjectAlias
+func NewGetSubjectAliasRequest(server string, subjectAliasID SubjectAliasID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subjectAliasID", runtime.ParamLocationPath, subjectAliasID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/subject-aliases/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListSubjectsRequest generates requests for ListSubjects
+func NewListSubjectsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/subjects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpsertSubjectRequest calls the generic UpsertSubject builder with application/json body
+func NewUpsertSubjectRequest(server string, body UpsertSubjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpsertSubjectRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpsertSubjectRequestWithBody generates requests for UpsertSubject with any type of body
+func NewUpsertSubjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/subjects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSubjectRequest generates requests for DeleteSubject
+func NewDeleteSubjectRequest(server string, subjectIdOrKey SubjectIdOrKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subjectIdOrKey", runtime.ParamLocationPath, subjectIdOrKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/subjects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSubjectRequest generates requests for GetSubject
+func NewGetSubjectRequest(server string, subjectIdOrKey SubjectIdOrKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subjectIdOrKey", runtime.ParamLocationPath, subjectIdOrKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/subjects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListEventTypesWithResponse request
+	ListEventTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListEventTypesResponse, error)
+
+	// UpsertEventTypeWithBodyWithResponse request with any body
+	UpsertEventTypeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertEventTypeResponse, error)
+
+	UpsertEventTypeWithResponse(ctx context.Context, body UpsertEventTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertEventTypeResponse, error)
+
+	// DeleteEventTypeWithResponse request
+	DeleteEventTypeWithResponse(ctx context.Context, eventType EventTypeName, reqEditors ...RequestEditorFn) (*DeleteEventTypeResponse, error)
+
+	// GetEventTypeWithResponse request
+	GetEventTypeWithResponse(ctx context.Context, eventType EventTypeName, reqEditors ...RequestEditorFn) (*GetEventTypeResponse, error)
+
+	// ListEventsWithResponse request
+	ListEventsWithResponse(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*ListEventsResponse, error)
+
+	// IngestEventsWithBodyWithResponse request with any body
+	IngestEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IngestEventsResponse, error)
+
+	IngestEventsWithApplicationCloudeventsPlusJSONBodyWithResponse(ctx context.Context, body IngestEventsApplicationCloudeventsPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*IngestEventsResponse, error)
+
+	IngestEventsWithApplicationCloudeventsBatchPlusJSONBodyWithResponse(ctx context.Context, body IngestEventsApplicationCloudeventsBatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*IngestEventsResponse, error)
+
+	// ListFeaturesWithResponse request
+	ListFeaturesWithResponse(ctx context.Context, params *ListFeaturesParams, reqEditors ...RequestEditorFn) (*ListFeaturesResponse, error)
+
+	// CreateFeatureWithBodyWithResponse request with any body
+	CreateFeatureWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFeatureResponse, error)
+
+	CreateFeatureWithResponse(ctx context.Context, body CreateFeatureJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFeatureResponse, error)
+
+	// DeleteFeatureWithResponse request
+	DeleteFeatureWithResponse(ctx context.Context, featureID FeatureID, reqEditors ...RequestEditorFn) (*DeleteFeatureResponse, error)
+
+	// GetFeatureWithResponse request
+	GetFeatureWithResponse(ctx context.Context, featureID FeatureID, reqEditors ...RequestEditorFn) (*GetFeatureResponse, error)
+
+	// UpdateFeatureWithBodyWithResponse request with any body
+	UpdateFeatureWithBodyWithResponse(ctx context.Context, featureID FeatureID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFeatureResponse, error)
+
+	UpdateFeatureWithResponse(ctx context.Context, featureID FeatureID, body UpdateFeatureJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFeatureResponse, error)
+
+	// GetFreshnessWithResponse request
+	GetFreshnessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFreshnessResponse, error)
+
+	// ListLedgersWithResponse request
+	ListLedgersWithResponse(ctx context.Context, params *ListLedgersParams, reqEditors ...RequestEditorFn) (*ListLedgersResponse, error)
+
+	// CreateLedgerWithBodyWithResponse request with any body
+	CreateLedgerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLedgerResponse, error)
+
+	CreateLedgerWithResponse(ctx context.Context, body CreateLedgerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLedgerResponse, error)
+
+	// CheckLedgerEntitlementWithResponse request
+	CheckLedgerEntitlementWithResponse(ctx context.Context, params *CheckLedgerEntitlementParams, reqEditors ...RequestEditorFn) (*CheckLedgerEntitlementResponse, error)
+
+	// ListLedgerGrantsWithResponse request
+	ListLedgerGrantsWithResponse(ctx context.Context, params *ListLedgerGrantsParams, reqEditors ...RequestEditorFn) (*ListLedgerGrantsResponse, error)
+
+	// GetLedgerBalanceWithResponse request
+	GetLedgerBalanceWithResponse(ctx context.Context, ledgerID LedgerID, params *GetLedgerBalanceParams, reqEditors ...RequestEditorFn) (*GetLedgerBalanceResponse, error)
+
+	// ListLedgerGrantSchedulesWithResponse request
+	ListLedgerGrantSchedulesWithResponse(ctx context.Context, ledgerID LedgerID, reqEditors ...RequestEditorFn) (*ListLedgerGrantSchedulesResponse, error)
+
+	// CreateLedgerGrantScheduleWithBodyWithResponse request with any body
+	CreateLedgerGrantScheduleWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLedgerGrantScheduleResponse, error)
+
+	CreateLedgerGrantScheduleWithResponse(ctx context.Context, ledgerID LedgerID, body CreateLedgerGrantScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLedgerGrantScheduleResponse, error)
+
+	// DeleteLedgerGrantScheduleWithResponse request
+	DeleteLedgerGrantScheduleWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID, reqEditors ...RequestEditorFn) (*DeleteLedgerGrantScheduleResponse, error)
+
+	// GetLedgerGrantScheduleWithResponse request
+	GetLedgerGrantScheduleWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID, reqEditors ...RequestEditorFn) (*GetLedgerGrantScheduleResponse, error)
+
+	// ListLedgerGrantsByLedgerWithResponse request
+	ListLedgerGrantsByLedgerWithResponse(ctx context.Context, ledgerID LedgerID, params *ListLedgerGrantsByLedgerParams, reqEditors ...RequestEditorFn) (*ListLedgerGrantsByLedgerResponse, error)
+
+	// CreateLedgerGrantWithBodyWithResponse request with any body
+	CreateLedgerGrantWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLedgerGrantResponse, error)
+
+	CreateLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, body CreateLedgerGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLedgerGrantResponse, error)
+
+	// VoidLedgerGrantWithResponse request
+	VoidLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*VoidLedgerGrantResponse, error)
+
+	// GetLedgerGrantWithResponse request
+	GetLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*GetLedgerGrantResponse, error)
+
+	// ListLedgerGrantAmendmentsWithResponse request
+	ListLedgerGrantAmendmentsWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*ListLedgerGrantAmendmentsResponse, error)
+
+	// AmendLedgerGrantWithBodyWithResponse request with any body
+	AmendLedgerGrantWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AmendLedgerGrantResponse, error)
+
+	AmendLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, body AmendLedgerGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*AmendLedgerGrantResponse, error)
+
+	// GetLedgerHistoryWithResponse request
+	GetLedgerHistoryWithResponse(ctx context.Context, ledgerID LedgerID, params *GetLedgerHistoryParams, reqEditors ...RequestEditorFn) (*GetLedgerHistoryResponse, error)
+
+	// ListLedgerNotificationRulesWithResponse request
+	ListLedgerNotificationRulesWithResponse(ctx context.Context, ledgerID LedgerID, reqEditors ...RequestEditorFn) (*ListLedgerNotificationRulesResponse, error)
+
+	// CreateLedgerNotificationRuleWithBodyWithResponse request with any body
+	CreateLedgerNotificationRuleWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLedgerNotificationRuleResponse, error)
+
+	CreateLedgerNotificationRuleWithResponse(ctx context.Context, ledgerID LedgerID, body CreateLedgerNotificationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLedgerNotificationRuleResponse, error)
+
+	// DeleteLedgerNotificationRuleWithResponse request
+	DeleteLedgerNotificationRuleWithResponse(ctx context.Context, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, reqEditors ...RequestEditorFn) (*DeleteLedgerNotificationRuleResponse, error)
+
+	// GetLedgerNotificationRuleWithResponse request
+	GetLedgerNotificationRuleWithResponse(ctx context.Context, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, reqEditors ...RequestEditorFn) (*GetLedgerNotificationRuleResponse, error)
+
+	// ListLedgerNotificationDeliveriesWithResponse request
+	ListLedgerNotificationDeliveriesWithResponse(ctx context.Context, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, params *ListLedgerNotificationDeliveriesParams, reqEditors ...RequestEditorFn) (*ListLedgerNotificationDeliveriesResponse, error)
+
+	// UpdateLedgerOverageLimitsWithBodyWithResponse request with any body
+	UpdateLedgerOverageLimitsWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLedgerOverageLimitsResponse, error)
+
+	UpdateLedgerOverageLimitsWithResponse(ctx context.Context, ledgerID LedgerID, body UpdateLedgerOverageLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLedgerOverageLimitsResponse, error)
+
+	// ResetLedgerWithBodyWithResponse request with any body
+	ResetLedgerWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetLedgerResponse, error)
+
+	ResetLedgerWithResponse(ctx context.Context, ledgerID LedgerID, body ResetLedgerJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetLedgerResponse, error)
+
+	// DeleteLedgerUsagePeriodWithResponse request
+	DeleteLedgerUsagePeriodWithResponse(ctx context.Context, ledgerID LedgerID, reqEditors ...RequestEditorFn) (*DeleteLedgerUsagePeriodResponse, error)
+
+	// UpdateLedgerUsagePeriodWithBodyWithResponse request with any body
+	UpdateLedgerUsagePeriodWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLedgerUsagePeriodResponse, error)
+
+	UpdateLedgerUsagePeriodWithResponse(ctx context.Context, ledgerID LedgerID, body UpdateLedgerUsagePeriodJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLedgerUsagePeriodResponse, error)
+
+	// ListMetersWithResponse request
+	ListMetersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMetersResponse, error)
+
+	// CreateMeterWithBodyWithResponse request with any body
+	CreateMeterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMeterResponse, error)
+
+	CreateMeterWithResponse(ctx context.Context, body CreateMeterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMeterResponse, error)
+
+	// DeleteMeterWithResponse request
+	DeleteMeterWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteMeterResponse, error)
+
+	// GetMeterWithResponse request
+	GetMeterWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*GetMeterResponse, error)
+
+	// QueryMeterWithResponse request
+	QueryMeterWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, params *QueryMeterParams, reqEditors ...RequestEditorFn) (*QueryMeterResponse, error)
+
+	// ListMeterSubjectsWithResponse request
+	ListMeterSubjectsWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*ListMeterSubjectsResponse, error)
+
+	// QueryPortalMeterWithResponse request
+	QueryPortalMeterWithResponse(ctx context.Context, meterSlug string, params *QueryPortalMeterParams, reqEditors ...RequestEditorFn) (*QueryPortalMeterResponse, error)
+
+	// ListPortalTokensWithResponse request
+	ListPortalTokensWithResponse(ctx context.Context, params *ListPortalTokensParams, reqEditors ...RequestEditorFn) (*ListPortalTokensResponse, error)
+
+	// CreatePortalTokenWithBodyWithResponse request with any body
+	CreatePortalTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePortalTokenResponse, error)
+
+	CreatePortalTokenWithResponse(ctx context.Context, body CreatePortalTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePortalTokenResponse, error)
+
+	// InvalidatePortalTokensWithBodyWithResponse request with any body
+	InvalidatePortalTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InvalidatePortalTokensResponse, error)
+
+	InvalidatePortalTokensWithResponse(ctx context.Context, body InvalidatePortalTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*InvalidatePortalTokensResponse, error)
+
+	// ListSubjectAliasesWithResponse request
+	ListSubjectAliasesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSubjectAliasesResponse, error)
+
+	// UpsertSubjectAliasWithBodyWithResponse request with any body
+	UpsertSubjectAliasWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertSubjectAliasResponse, error)
+
+	UpsertSubjectAliasWithResponse(ctx context.Context, body UpsertSubjectAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertSubjectAliasResponse, error)
+
+	// DeleteSubjectAliasWithResponse request
+	DeleteSubjectAliasWithResponse(ctx context.Context, subjectAliasID SubjectAliasID, reqEditors ...RequestEditorFn) (*DeleteSubjectAliasResponse, error)
+
+	// GetSubjectAliasWithResponse request
+	GetSubjectAliasWithResponse(ctx context.Context, subjectAliasID SubjectAliasID, reqEditors ...RequestEditorFn) (*GetSubjectAliasResponse, error)
+
+	// ListSubjectsWithResponse request
+	ListSubjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSubjectsResponse, error)
+
+	// UpsertSubjectWithBodyWithResponse request with any body
+	UpsertSubjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertSubjectResponse, error)
+
+	UpsertSubjectWithResponse(ctx context.Context, body UpsertSubjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertSubjectResponse, error)
+
+	// DeleteSubjectWithResponse request
+	DeleteSubjectWithResponse(ctx context.Context, subjectIdOrKey SubjectIdOrKey, reqEditors ...RequestEditorFn) (*DeleteSubjectResponse, error)
+
+	// GetSubjectWithResponse request
+	GetSubjectWithResponse(ctx context.Context, subjectIdOrKey SubjectIdOrKey, reqEditors ...RequestEditorFn) (*GetSubjectResponse, error)
+}
+
+type ListEventTypesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]EventType
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEventTypesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEventTypesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpsertEventTypeResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EventType
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpsertEventTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpsertEventTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteEventTypeResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteEventTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteEventTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEventTypeResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *EventType
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListEventsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]IngestedEvent
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IngestEventsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON429     *TooManyRequestsProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r IngestEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IngestEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFeaturesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Feature
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFeaturesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFeaturesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateFeatureResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Feature
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateFeatureResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateFeatureResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFeatureResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteFeatureResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFeatureResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFeatureResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Feature
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFeatureResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFeatureResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateFeatureResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Feature
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateFeatureResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateFeatureResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFreshnessResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Freshness
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFreshnessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFreshnessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLedgersResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Ledger
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLedgersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLedgersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateLedgerResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Ledger
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON409     *LedgerAlreadyExistsProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateLedgerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateLedgerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckLedgerEntitlementResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *LedgerEntitlement
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckLedgerEntitlementResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckLedgerEntitlementResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLedgerGrantsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]LedgerGrantResponse
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLedgerGrantsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLedgerGrantsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLedgerBalanceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *LedgerBalance
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLedgerBalanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLedgerBalanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLedgerGrantSchedulesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]LedgerGrantSchedule
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLedgerGrantSchedulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLedgerGrantSchedulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateLedgerGrantScheduleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *LedgerGrantSchedule
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateLedgerGrantScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateLedgerGrantScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteLedgerGrantScheduleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteLedgerGrantScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteLedgerGrantScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLedgerGrantScheduleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *LedgerGrantSchedule
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLedgerGrantScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLedgerGrantScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLedgerGrantsByLedgerResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]LedgerGrantResponse
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLedgerGrantsByLedgerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLedgerGrantsByLedgerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateLedgerGrantResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *LedgerGrantResponse
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateLedgerGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateLedgerGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VoidLedgerGrantResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r VoidLedgerGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VoidLedgerGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLedgerGrantResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *LedgerGrantResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLedgerGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLedgerGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLedgerGrantAmendmentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]LedgerGrantAmendment
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLedgerGrantAmendmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLedgerGrantAmendmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AmendLedgerGrantResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *LedgerGrantAmendment
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AmendLedgerGrantResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AmendLedgerGrantResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLedgerHistoryResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]LedgerEntry
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLedgerHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLedgerHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLedgerNotificationRulesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]LedgerNotificationRule
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLedgerNotificationRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLedgerNotificationRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateLedgerNotificationRuleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *LedgerNotificationRule
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateLedgerNotificationRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateLedgerNotificationRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteLedgerNotificationRuleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteLedgerNotificationRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteLedgerNotificationRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLedgerNotificationRuleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *LedgerNotificationRule
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLedgerNotificationRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLedgerNotificationRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLedgerNotificationDeliveriesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]LedgerNotificationDelivery
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLedgerNotificationDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLedgerNotificationDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateLedgerOverageLimitsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Ledger
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateLedgerOverageLimitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateLedgerOverageLimitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetLedgerResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *LedgerReset
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetLedgerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetLedgerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteLedgerUsagePeriodResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Ledger
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteLedgerUsagePeriodResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteLedgerUsagePeriodResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateLedgerUsagePeriodResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Ledger
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateLedgerUsagePeriodResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateLedgerUsagePeriodResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMetersResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Meter
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMetersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMetersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMeterResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Meter
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMeterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMeterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMeterResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMeterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMeterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMeterResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Meter
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMeterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMeterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryMeterResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *MeterQueryResult
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryMeterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryMeterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMeterSubjectsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]string
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMeterSubjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMeterSubjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryPortalMeterResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *MeterQueryResult
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryPortalMeterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryPortalMeterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPortalTokensResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]PortalToken
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPortalTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPortalTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePortalTokenResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *PortalToken
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePortalTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePortalTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InvalidatePortalTokensResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
 	ApplicationproblemJSONDefault *UnexpectedProblemResponse
 }
 
-// Status returns HTTPResponse.Status
-func (r ListPortalTokensResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// Status returns HTTPResponse.Status
+func (r InvalidatePortalTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InvalidatePortalTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSubjectAliasesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]SubjectAlias
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSubjectAliasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSubjectAliasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpsertSubjectAliasResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SubjectAlias
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpsertSubjectAliasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpsertSubjectAliasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSubjectAliasResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSubjectAliasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSubjectAliasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSubjectAliasResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SubjectAlias
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSubjectAliasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSubjectAliasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSubjectsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Subject
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSubjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSubjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpsertSubjectResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Subject
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpsertSubjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpsertSubjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSubjectResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON400     *BadRequestProblemResponse
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSON501     *NotImplementedProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSubjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSubjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSubjectResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Subject
+	ApplicationproblemJSON401     *UnauthorizedProblemResponse
+	ApplicationproblemJSON404     *NotFoundProblemResponse
+	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSubjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSubjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListEventTypesWithResponse request returning *ListEventTypesResponse
+func (c *ClientWithResponses) ListEventTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListEventTypesResponse, error) {
+	rsp, err := c.ListEventTypes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListEventTypesResponse(rsp)
+}
+
+// UpsertEventTypeWithBodyWithResponse request with arbitrary body returning *UpsertEventTypeResponse
+func (c *ClientWithResponses) UpsertEventTypeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertEventTypeResponse, error) {
+	rsp, err := c.UpsertEventTypeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertEventTypeResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpsertEventTypeWithResponse(ctx context.Context, body UpsertEventTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertEventTypeResponse, error) {
+	rsp, err := c.UpsertEventType(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertEventTypeResponse(rsp)
+}
+
+// DeleteEventTypeWithResponse request returning *DeleteEventTypeResponse
+func (c *ClientWithResponses) DeleteEventTypeWithResponse(ctx context.Context, eventType EventTypeName, reqEditors ...RequestEditorFn) (*DeleteEventTypeResponse, error) {
+	rsp, err := c.DeleteEventType(ctx, eventType, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteEventTypeResponse(rsp)
+}
+
+// GetEventTypeWithResponse request returning *GetEventTypeResponse
+func (c *ClientWithResponses) GetEventTypeWithResponse(ctx context.Context, eventType EventTypeName, reqEditors ...RequestEditorFn) (*GetEventTypeResponse, error) {
+	rsp, err := c.GetEventType(ctx, eventType, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventTypeResponse(rsp)
+}
+
+// ListEventsWithResponse request returning *ListEventsResponse
+func (c *ClientWithResponses) ListEventsWithResponse(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*ListEventsResponse, error) {
+	rsp, err := c.ListEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListEventsResponse(rsp)
+}
+
+// IngestEventsWithBodyWithResponse request with arbitrary body returning *IngestEventsResponse
+func (c *ClientWithResponses) IngestEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IngestEventsResponse, error) {
+	rsp, err := c.IngestEventsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIngestEventsResponse(rsp)
+}
+
+func (c *ClientWithResponses) IngestEventsWithApplicationCloudeventsPlusJSONBodyWithResponse(ctx context.Context, body IngestEventsApplicationCloudeventsPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*IngestEventsResponse, error) {
+	rsp, err := c.IngestEventsWithApplicationCloudeventsPlusJSONBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIngestEventsResponse(rsp)
+}
+
+func (c *ClientWithResponses) IngestEventsWithApplicationCloudeventsBatchPlusJSONBodyWithResponse(ctx context.Context, body IngestEventsApplicationCloudeventsBatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*IngestEventsResponse, error) {
+	rsp, err := c.IngestEventsWithApplicationCloudeventsBatchPlusJSONBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIngestEventsResponse(rsp)
+}
+
+// ListFeaturesWithResponse request returning *ListFeaturesResponse
+func (c *ClientWithResponses) ListFeaturesWithResponse(ctx context.Context, params *ListFeaturesParams, reqEditors ...RequestEditorFn) (*ListFeaturesResponse, error) {
+	rsp, err := c.ListFeatures(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFeaturesResponse(rsp)
+}
+
+// CreateFeatureWithBodyWithResponse request with arbitrary body returning *CreateFeatureResponse
+func (c *ClientWithResponses) CreateFeatureWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFeatureResponse, error) {
+	rsp, err := c.CreateFeatureWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFeatureResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateFeatureWithResponse(ctx context.Context, body CreateFeatureJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFeatureResponse, error) {
+	rsp, err := c.CreateFeature(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFeatureResponse(rsp)
+}
+
+// DeleteFeatureWithResponse request returning *DeleteFeatureResponse
+func (c *ClientWithResponses) DeleteFeatureWithResponse(ctx context.Context, featureID FeatureID, reqEditors ...RequestEditorFn) (*DeleteFeatureResponse, error) {
+	rsp, err := c.DeleteFeature(ctx, featureID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFeatureResponse(rsp)
+}
+
+// GetFeatureWithResponse request returning *GetFeatureResponse
+func (c *ClientWithResponses) GetFeatureWithResponse(ctx context.Context, featureID FeatureID, reqEditors ...RequestEditorFn) (*GetFeatureResponse, error) {
+	rsp, err := c.GetFeature(ctx, featureID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFeatureResponse(rsp)
+}
+
+// UpdateFeatureWithBodyWithResponse request with arbitrary body returning *UpdateFeatureResponse
+func (c *ClientWithResponses) UpdateFeatureWithBodyWithResponse(ctx context.Context, featureID FeatureID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFeatureResponse, error) {
+	rsp, err := c.UpdateFeatureWithBody(ctx, featureID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFeatureResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateFeatureWithResponse(ctx context.Context, featureID FeatureID, body UpdateFeatureJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFeatureResponse, error) {
+	rsp, err := c.UpdateFeature(ctx, featureID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFeatureResponse(rsp)
+}
+
+// GetFreshnessWithResponse request returning *GetFreshnessResponse
+func (c *ClientWithResponses) GetFreshnessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetFreshnessResponse, error) {
+	rsp, err := c.GetFreshness(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFreshnessResponse(rsp)
+}
+
+// ListLedgersWithResponse request returning *ListLedgersResponse
+func (c *ClientWithResponses) ListLedgersWithResponse(ctx context.Context, params *ListLedgersParams, reqEditors ...RequestEditorFn) (*ListLedgersResponse, error) {
+	rsp, err := c.ListLedgers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLedgersResponse(rsp)
+}
+
+// CreateLedgerWithBodyWithResponse request with arbitrary body returning *CreateLedgerResponse
+func (c *ClientWithResponses) CreateLedgerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLedgerResponse, error) {
+	rsp, err := c.CreateLedgerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLedgerResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateLedgerWithResponse(ctx context.Context, body CreateLedgerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLedgerResponse, error) {
+	rsp, err := c.CreateLedger(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLedgerResponse(rsp)
+}
+
+// CheckLedgerEntitlementWithResponse request returning *CheckLedgerEntitlementResponse
+func (c *ClientWithResponses) CheckLedgerEntitlementWithResponse(ctx context.Context, params *CheckLedgerEntitlementParams, reqEditors ...RequestEditorFn) (*CheckLedgerEntitlementResponse, error) {
+	rsp, err := c.CheckLedgerEntitlement(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckLedgerEntitlementResponse(rsp)
+}
+
+// ListLedgerGrantsWithResponse request returning *ListLedgerGrantsResponse
+func (c *ClientWithResponses) ListLedgerGrantsWithResponse(ctx context.Context, params *ListLedgerGrantsParams, reqEditors ...RequestEditorFn) (*ListLedgerGrantsResponse, error) {
+	rsp, err := c.ListLedgerGrants(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLedgerGrantsResponse(rsp)
+}
+
+// GetLedgerBalanceWithResponse request returning *GetLedgerBalanceResponse
+func (c *ClientWithResponses) GetLedgerBalanceWithResponse(ctx context.Context, ledgerID LedgerID, params *GetLedgerBalanceParams, reqEditors ...RequestEditorFn) (*GetLedgerBalanceResponse, error) {
+	rsp, err := c.GetLedgerBalance(ctx, ledgerID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLedgerBalanceResponse(rsp)
+}
+
+// ListLedgerGrantSchedulesWithResponse request returning *ListLedgerGrantSchedulesResponse
+func (c *ClientWithResponses) ListLedgerGrantSchedulesWithResponse(ctx context.Context, ledgerID LedgerID, reqEditors ...RequestEditorFn) (*ListLedgerGrantSchedulesResponse, error) {
+	rsp, err := c.ListLedgerGrantSchedules(ctx, ledgerID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLedgerGrantSchedulesResponse(rsp)
+}
+
+// CreateLedgerGrantScheduleWithBodyWithResponse request with arbitrary body returning *CreateLedgerGrantScheduleResponse
+func (c *ClientWithResponses) CreateLedgerGrantScheduleWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLedgerGrantScheduleResponse, error) {
+	rsp, err := c.CreateLedgerGrantScheduleWithBody(ctx, ledgerID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLedgerGrantScheduleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateLedgerGrantScheduleWithResponse(ctx context.Context, ledgerID LedgerID, body CreateLedgerGrantScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLedgerGrantScheduleResponse, error) {
+	rsp, err := c.CreateLedgerGrantSchedule(ctx, ledgerID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLedgerGrantScheduleResponse(rsp)
+}
+
+// DeleteLedgerGrantScheduleWithResponse request returning *DeleteLedgerGrantScheduleResponse
+func (c *ClientWithResponses) DeleteLedgerGrantScheduleWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID, reqEditors ...RequestEditorFn) (*DeleteLedgerGrantScheduleResponse, error) {
+	rsp, err := c.DeleteLedgerGrantSchedule(ctx, ledgerID, ledgerGrantScheduleID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteLedgerGrantScheduleResponse(rsp)
+}
+
+// GetLedgerGrantScheduleWithResponse request returning *GetLedgerGrantScheduleResponse
+func (c *ClientWithResponses) GetLedgerGrantScheduleWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantScheduleID LedgerGrantScheduleID, reqEditors ...RequestEditorFn) (*GetLedgerGrantScheduleResponse, error) {
+	rsp, err := c.GetLedgerGrantSchedule(ctx, ledgerID, ledgerGrantScheduleID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLedgerGrantScheduleResponse(rsp)
+}
+
+// ListLedgerGrantsByLedgerWithResponse request returning *ListLedgerGrantsByLedgerResponse
+func (c *ClientWithResponses) ListLedgerGrantsByLedgerWithResponse(ctx context.Context, ledgerID LedgerID, params *ListLedgerGrantsByLedgerParams, reqEditors ...RequestEditorFn) (*ListLedgerGrantsByLedgerResponse, error) {
+	rsp, err := c.ListLedgerGrantsByLedger(ctx, ledgerID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLedgerGrantsByLedgerResponse(rsp)
+}
+
+// CreateLedgerGrantWithBodyWithResponse request with arbitrary body returning *CreateLedgerGrantResponse
+func (c *ClientWithResponses) CreateLedgerGrantWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLedgerGrantResponse, error) {
+	rsp, err := c.CreateLedgerGrantWithBody(ctx, ledgerID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLedgerGrantResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, body CreateLedgerGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLedgerGrantResponse, error) {
+	rsp, err := c.CreateLedgerGrant(ctx, ledgerID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLedgerGrantResponse(rsp)
+}
+
+// VoidLedgerGrantWithResponse request returning *VoidLedgerGrantResponse
+func (c *ClientWithResponses) VoidLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*VoidLedgerGrantResponse, error) {
+	rsp, err := c.VoidLedgerGrant(ctx, ledgerID, ledgerGrantID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVoidLedgerGrantResponse(rsp)
+}
+
+// GetLedgerGrantWithResponse request returning *GetLedgerGrantResponse
+func (c *ClientWithResponses) GetLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*GetLedgerGrantResponse, error) {
+	rsp, err := c.GetLedgerGrant(ctx, ledgerID, ledgerGrantID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLedgerGrantResponse(rsp)
+}
+
+// ListLedgerGrantAmendmentsWithResponse request returning *ListLedgerGrantAmendmentsResponse
+func (c *ClientWithResponses) ListLedgerGrantAmendmentsWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*ListLedgerGrantAmendmentsResponse, error) {
+	rsp, err := c.ListLedgerGrantAmendments(ctx, ledgerID, ledgerGrantID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLedgerGrantAmendmentsResponse(rsp)
+}
+
+// AmendLedgerGrantWithBodyWithResponse request with arbitrary body returning *AmendLedgerGrantResponse
+func (c *ClientWithResponses) AmendLedgerGrantWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AmendLedgerGrantResponse, error) {
+	rsp, err := c.AmendLedgerGrantWithBody(ctx, ledgerID, ledgerGrantID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAmendLedgerGrantResponse(rsp)
+}
+
+func (c *ClientWithResponses) AmendLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, body AmendLedgerGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*AmendLedgerGrantResponse, error) {
+	rsp, err := c.AmendLedgerGrant(ctx, ledgerID, ledgerGrantID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAmendLedgerGrantResponse(rsp)
+}
+
+// GetLedgerHistoryWithResponse request returning *GetLedgerHistoryResponse
+func (c *ClientWithResponses) GetLedgerHistoryWithResponse(ctx context.Context, ledgerID LedgerID, params *GetLedgerHistoryParams, reqEditors ...RequestEditorFn) (*GetLedgerHistoryResponse, error) {
+	rsp, err := c.GetLedgerHistory(ctx, ledgerID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLedgerHistoryResponse(rsp)
+}
+
+// ListLedgerNotificationRulesWithResponse request returning *ListLedgerNotificationRulesResponse
+func (c *ClientWithResponses) ListLedgerNotificationRulesWithResponse(ctx context.Context, ledgerID LedgerID, reqEditors ...RequestEditorFn) (*ListLedgerNotificationRulesResponse, error) {
+	rsp, err := c.ListLedgerNotificationRules(ctx, ledgerID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLedgerNotificationRulesResponse(rsp)
+}
+
+// CreateLedgerNotificationRuleWithBodyWithResponse request with arbitrary body returning *CreateLedgerNotificationRuleResponse
+func (c *ClientWithResponses) CreateLedgerNotificationRuleWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLedgerNotificationRuleResponse, error) {
+	rsp, err := c.CreateLedgerNotificationRuleWithBody(ctx, ledgerID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLedgerNotificationRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateLedgerNotificationRuleWithResponse(ctx context.Context, ledgerID LedgerID, body CreateLedgerNotificationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLedgerNotificationRuleResponse, error) {
+	rsp, err := c.CreateLedgerNotificationRule(ctx, ledgerID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLedgerNotificationRuleResponse(rsp)
+}
+
+// DeleteLedgerNotificationRuleWithResponse request returning *DeleteLedgerNotificationRuleResponse
+func (c *ClientWithResponses) DeleteLedgerNotificationRuleWithResponse(ctx context.Context, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, reqEditors ...RequestEditorFn) (*DeleteLedgerNotificationRuleResponse, error) {
+	rsp, err := c.DeleteLedgerNotificationRule(ctx, ledgerID, ledgerNotificationRuleID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteLedgerNotificationRuleResponse(rsp)
+}
+
+// GetLedgerNotificationRuleWithResponse request returning *GetLedgerNotificationRuleResponse
+func (c *ClientWithResponses) GetLedgerNotificationRuleWithResponse(ctx context.Context, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, reqEditors ...RequestEditorFn) (*GetLedgerNotificationRuleResponse, error) {
+	rsp, err := c.GetLedgerNotificationRule(ctx, ledgerID, ledgerNotificationRuleID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLedgerNotificationRuleResponse(rsp)
+}
+
+// ListLedgerNotificationDeliveriesWithResponse request returning *ListLedgerNotificationDeliveriesResponse
+func (c *ClientWithResponses) ListLedgerNotificationDeliveriesWithResponse(ctx context.Context, ledgerID LedgerID, ledgerNotificationRuleID LedgerNotificationRuleID, params *ListLedgerNotificationDeliveriesParams, reqEditors ...RequestEditorFn) (*ListLedgerNotificationDeliveriesResponse, error) {
+	rsp, err := c.ListLedgerNotificationDeliveries(ctx, ledgerID, ledgerNotificationRuleID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLedgerNotificationDeliveriesResponse(rsp)
+}
+
+// UpdateLedgerOverageLimitsWithBodyWithResponse request with arbitrary body returning *UpdateLedgerOverageLimitsResponse
+func (c *ClientWithResponses) UpdateLedgerOverageLimitsWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLedgerOverageLimitsResponse, error) {
+	rsp, err := c.UpdateLedgerOverageLimitsWithBody(ctx, ledgerID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLedgerOverageLimitsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateLedgerOverageLimitsWithResponse(ctx context.Context, ledgerID LedgerID, body UpdateLedgerOverageLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLedgerOverageLimitsResponse, error) {
+	rsp, err := c.UpdateLedgerOverageLimits(ctx, ledgerID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLedgerOverageLimitsResponse(rsp)
+}
+
+// ResetLedgerWithBodyWithResponse request with arbitrary body returning *ResetLedgerResponse
+func (c *ClientWithResponses) ResetLedgerWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetLedgerResponse, error) {
+	rsp, err := c.ResetLedgerWithBody(ctx, ledgerID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetLedgerResponse(rsp)
+}
+
+func (c *ClientWithResponses) ResetLedgerWithResponse(ctx context.Context, ledgerID LedgerID, body ResetLedgerJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetLedgerResponse, error) {
+	rsp, err := c.ResetLedger(ctx, ledgerID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetLedgerResponse(rsp)
+}
+
+// DeleteLedgerUsagePeriodWithResponse request returning *DeleteLedgerUsagePeriodResponse
+func (c *ClientWithResponses) DeleteLedgerUsagePeriodWithResponse(ctx context.Context, ledgerID LedgerID, reqEditors ...RequestEditorFn) (*DeleteLedgerUsagePeriodResponse, error) {
+	rsp, err := c.DeleteLedgerUsagePeriod(ctx, ledgerID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteLedgerUsagePeriodResponse(rsp)
+}
+
+// UpdateLedgerUsagePeriodWithBodyWithResponse request with arbitrary body returning *UpdateLedgerUsagePeriodResponse
+func (c *ClientWithResponses) UpdateLedgerUsagePeriodWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLedgerUsagePeriodResponse, error) {
+	rsp, err := c.UpdateLedgerUsagePeriodWithBody(ctx, ledgerID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLedgerUsagePeriodResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateLedgerUsagePeriodWithResponse(ctx context.Context, ledgerID LedgerID, body UpdateLedgerUsagePeriodJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLedgerUsagePeriodResponse, error) {
+	rsp, err := c.UpdateLedgerUsagePeriod(ctx, ledgerID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLedgerUsagePeriodResponse(rsp)
+}
+
+// ListMetersWithResponse request returning *ListMetersResponse
+func (c *ClientWithResponses) ListMetersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMetersResponse, error) {
+	rsp, err := c.ListMeters(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMetersResponse(rsp)
+}
+
+// CreateMeterWithBodyWithResponse request with arbitrary body returning *CreateMeterResponse
+func (c *ClientWithResponses) CreateMeterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMeterResponse, error) {
+	rsp, err := c.CreateMeterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMeterResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateMeterWithResponse(ctx context.Context, body CreateMeterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMeterResponse, error) {
+	rsp, err := c.CreateMeter(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMeterResponse(rsp)
+}
+
+// DeleteMeterWithResponse request returning *DeleteMeterResponse
+func (c *ClientWithResponses) DeleteMeterWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteMeterResponse, error) {
+	rsp, err := c.DeleteMeter(ctx, meterIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMeterResponse(rsp)
+}
+
+// GetMeterWithResponse request returning *GetMeterResponse
+func (c *ClientWithResponses) GetMeterWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*GetMeterResponse, error) {
+	rsp, err := c.GetMeter(ctx, meterIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMeterResponse(rsp)
+}
+
+// QueryMeterWithResponse request returning *QueryMeterResponse
+func (c *ClientWithResponses) QueryMeterWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, params *QueryMeterParams, reqEditors ...RequestEditorFn) (*QueryMeterResponse, error) {
+	rsp, err := c.QueryMeter(ctx, meterIdOrSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryMeterResponse(rsp)
+}
+
+// ListMeterSubjectsWithResponse request returning *ListMeterSubjectsResponse
+func (c *ClientWithResponses) ListMeterSubjectsWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*ListMeterSubjectsResponse, error) {
+	rsp, err := c.ListMeterSubjects(ctx, meterIdOrSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMeterSubjectsResponse(rsp)
+}
+
+// QueryPortalMeterWithResponse request returning *QueryPortalMeterResponse
+func (c *ClientWithResponses) QueryPortalMeterWithResponse(ctx context.Context, meterSlug string, params *QueryPortalMeterParams, reqEditors ...RequestEditorFn) (*QueryPortalMeterResponse, error) {
+	rsp, err := c.QueryPortalMeter(ctx, meterSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryPortalMeterResponse(rsp)
+}
+
+// ListPortalTokensWithResponse request returning *ListPortalTokensResponse
+func (c *ClientWithResponses) ListPortalTokensWithResponse(ctx context.Context, params *ListPortalTokensParams, reqEditors ...RequestEditorFn) (*ListPortalTokensResponse, error) {
+	rsp, err := c.ListPortalTokens(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPortalTokensResponse(rsp)
+}
+
+// CreatePortalTokenWithBodyWithResponse request with arbitrary body returning *CreatePortalTokenResponse
+func (c *ClientWithResponses) CreatePortalTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePortalTokenResponse, error) {
+	rsp, err := c.CreatePortalTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePortalTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePortalTokenWithResponse(ctx context.Context, body CreatePortalTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePortalTokenResponse, error) {
+	rsp, err := c.CreatePortalToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePortalTokenResponse(rsp)
+}
+
+// InvalidatePortalTokensWithBodyWithResponse request with arbitrary body returning *InvalidatePortalTokensResponse
+func (c *ClientWithResponses) InvalidatePortalTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InvalidatePortalTokensResponse, error) {
+	rsp, err := c.InvalidatePortalTokensWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvalidatePortalTokensResponse(rsp)
+}
+
+func (c *ClientWithResponses) InvalidatePortalTokensWithResponse(ctx context.Context, body InvalidatePortalTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*InvalidatePortalTokensResponse, error) {
+	rsp, err := c.InvalidatePortalTokens(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInvalidatePortalTokensResponse(rsp)
+}
+
+// ListSubjectAliasesWithResponse request returning *ListSubjectAliasesResponse
+func (c *ClientWithResponses) ListSubjectAliasesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSubjectAliasesResponse, error) {
+	rsp, err := c.ListSubjectAliases(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSubjectAliasesResponse(rsp)
+}
+
+// UpsertSubjectAliasWithBodyWithResponse request with arbitrary body returning *UpsertSubjectAliasResponse
+func (c *ClientWithResponses) UpsertSubjectAliasWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertSubjectAliasResponse, error) {
+	rsp, err := c.UpsertSubjectAliasWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertSubjectAliasResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpsertSubjectAliasWithResponse(ctx context.Context, body UpsertSubjectAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertSubjectAliasResponse, error) {
+	rsp, err := c.UpsertSubjectAlias(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertSubjectAliasResponse(rsp)
+}
+
+// DeleteSubjectAliasWithResponse request returning *DeleteSubjectAliasResponse
+func (c *ClientWithResponses) DeleteSubjectAliasWithResponse(ctx context.Context, subjectAliasID SubjectAliasID, reqEditors ...RequestEditorFn) (*DeleteSubjectAliasResponse, error) {
+	rsp, err := c.DeleteSubjectAlias(ctx, subjectAliasID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSubjectAliasResponse(rsp)
+}
+
+// GetSubjectAliasWithResponse request returning *GetSubjectAliasResponse
+func (c *ClientWithResponses) GetSubjectAliasWithResponse(ctx context.Context, subjectAliasID SubjectAliasID, reqEditors ...RequestEditorFn) (*GetSubjectAliasResponse, error) {
+	rsp, err := c.GetSubjectAlias(ctx, subjectAliasID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSubjectAliasResponse(rsp)
+}
+
+// ListSubjectsWithResponse request returning *ListSubjectsResponse
+func (c *ClientWithResponses) ListSubjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSubjectsResponse, error) {
+	rsp, err := c.ListSubjects(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSubjectsResponse(rsp)
+}
+
+// UpsertSubjectWithBodyWithResponse request with arbitrary body returning *UpsertSubjectResponse
+func (c *ClientWithResponses) UpsertSubjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertSubjectResponse, error) {
+	rsp, err := c.UpsertSubjectWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertSubjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpsertSubjectWithResponse(ctx context.Context, body UpsertSubjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertSubjectResponse, error) {
+	rsp, err := c.UpsertSubject(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertSubjectResponse(rsp)
+}
+
+// DeleteSubjectWithResponse request returning *DeleteSubjectResponse
+func (c *ClientWithResponses) DeleteSubjectWithResponse(ctx context.Context, subjectIdOrKey SubjectIdOrKey, reqEditors ...RequestEditorFn) (*DeleteSubjectResponse, error) {
+	rsp, err := c.DeleteSubject(ctx, subjectIdOrKey, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSubjectResponse(rsp)
+}
+
+// GetSubjectWithResponse request returning *GetSubjectResponse
+func (c *ClientWithResponses) GetSubjectWithResponse(ctx context.Context, subjectIdOrKey SubjectIdOrKey, reqEditors ...RequestEditorFn) (*GetSubjectResponse, error) {
+	rsp, err := c.GetSubject(ctx, subjectIdOrKey, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSubjectResponse(rsp)
+}
+
+// ParseListEventTypesResponse parses an HTTP response from a ListEventTypesWithResponse call
+func ParseListEventTypesResponse(rsp *http.Response) (*ListEventTypesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListEventTypesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []EventType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest NotImplementedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON501 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpsertEventTypeResponse parses an HTTP response from a UpsertEventTypeWithResponse call
+func ParseUpsertEventTypeResponse(rsp *http.Response) (*UpsertEventTypeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpsertEventTypeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListPortalTokensResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest NotImplementedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON501 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return 0
-}
 
-type CreatePortalTokenResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *PortalToken
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreatePortalTokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteEventTypeResponse parses an HTTP response from a DeleteEventTypeWithResponse call
+func ParseDeleteEventTypeResponse(rsp *http.Response) (*DeleteEventTypeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreatePortalTokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteEventTypeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type InvalidatePortalTokensResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON501     *NotImplementedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest NotImplementedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON501 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r InvalidatePortalTokensResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetEventTypeResponse parses an HTTP response from a GetEventTypeWithResponse call
+func ParseGetEventTypeResponse(rsp *http.Response) (*GetEventTypeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &GetEventTypeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest NotImplementedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON501 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r InvalidatePortalTokensResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseListEventsResponse parses an HTTP response from a ListEventsWithResponse call
+func ParseListEventsResponse(rsp *http.Response) (*ListEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &ListEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []IngestedEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-type ListSubjectsResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *[]Subject
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+// ParseIngestEventsResponse parses an HTTP response from a IngestEventsWithResponse call
+func ParseIngestEventsResponse(rsp *http.Response) (*IngestEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &IngestEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListSubjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListFeaturesResponse parses an HTTP response from a ListFeaturesWithResponse call
+func ParseListFeaturesResponse(rsp *http.Response) (*ListFeaturesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListSubjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListFeaturesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpsertSubjectResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *[]Subject
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON501     *NotImplementedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Feature
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpsertSubjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpsertSubjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteSubjectResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	ApplicationproblemJSON400     *BadRequestProblemResponse
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON404     *NotFoundProblemResponse
-	ApplicationproblemJSON501     *NotImplementedProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteSubjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateFeatureResponse parses an HTTP response from a CreateFeatureWithResponse call
+func ParseCreateFeatureResponse(rsp *http.Response) (*CreateFeatureResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteSubjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateFeatureResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetSubjectResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *Subject
-	ApplicationproblemJSON401     *UnauthorizedProblemResponse
-	ApplicationproblemJSON404     *NotFoundProblemResponse
-	ApplicationproblemJSONDefault *UnexpectedProblemResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Feature
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetSubjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSubjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest NotImplementedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON501 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// ListEventsWithResponse request returning *ListEventsResponse
-func (c *ClientWithResponses) ListEventsWithResponse(ctx context.Context, params *ListEventsParams, reqEditors ...RequestEditorFn) (*ListEventsResponse, error) {
-	rsp, err := c.ListEvents(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseListEventsResponse(rsp)
+
+	return response, nil
 }
 
-// IngestEventsWithBodyWithResponse request with arbitrary body returning *IngestEventsResponse
-func (c *ClientWithResponses) IngestEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*IngestEventsResponse, error) {
-	rsp, err := c.IngestEventsWithBody(ctx, contentType, body, reqEditors...)
+// ParseDeleteFeatureResponse parses an HTTP response from a DeleteFeatureWithResponse call
+func ParseDeleteFeatureResponse(rsp *http.Response) (*DeleteFeatureResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseIngestEventsResponse(rsp)
-}
 
-func (c *ClientWithResponses) IngestEventsWithApplicationCloudeventsPlusJSONBodyWithResponse(ctx context.Context, body IngestEventsApplicationCloudeventsPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*IngestEventsResponse, error) {
-	rsp, err := c.IngestEventsWithApplicationCloudeventsPlusJSONBody(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteFeatureResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseIngestEventsResponse(rsp)
-}
 
-func (c *ClientWithResponses) IngestEventsWithApplicationCloudeventsBatchPlusJSONBodyWithResponse(ctx context.Context, body IngestEventsApplicationCloudeventsBatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*IngestEventsResponse, error) {
-	rsp, err := c.IngestEventsWithApplicationCloudeventsBatchPlusJSONBody(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseIngestEventsResponse(rsp)
+
+	return response, nil
 }
 
-// ListFeaturesWithResponse request returning *ListFeaturesResponse
-func (c *ClientWithResponses) ListFeaturesWithResponse(ctx context.Context, params *ListFeaturesParams, reqEditors ...RequestEditorFn) (*ListFeaturesResponse, error) {
-	rsp, err := c.ListFeatures(ctx, params, reqEditors...)
+// ParseGetFeatureResponse parses an HTTP response from a GetFeatureWithResponse call
+func ParseGetFeatureResponse(rsp *http.Response) (*GetFeatureResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListFeaturesResponse(rsp)
-}
 
-// CreateFeatureWithBodyWithResponse request with arbitrary body returning *CreateFeatureResponse
-func (c *ClientWithResponses) CreateFeatureWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFeatureResponse, error) {
-	rsp, err := c.CreateFeatureWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetFeatureResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateFeatureResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateFeatureWithResponse(ctx context.Context, body CreateFeatureJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFeatureResponse, error) {
-	rsp, err := c.CreateFeature(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Feature
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseCreateFeatureResponse(rsp)
-}
 
-// DeleteFeatureWithResponse request returning *DeleteFeatureResponse
-func (c *ClientWithResponses) DeleteFeatureWithResponse(ctx context.Context, featureID FeatureID, reqEditors ...RequestEditorFn) (*DeleteFeatureResponse, error) {
-	rsp, err := c.DeleteFeature(ctx, featureID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteFeatureResponse(rsp)
+	return response, nil
 }
 
-// GetFeatureWithResponse request returning *GetFeatureResponse
-func (c *ClientWithResponses) GetFeatureWithResponse(ctx context.Context, featureID FeatureID, reqEditors ...RequestEditorFn) (*GetFeatureResponse, error) {
-	rsp, err := c.GetFeature(ctx, featureID, reqEditors...)
+// ParseUpdateFeatureResponse parses an HTTP response from a UpdateFeatureWithResponse call
+func ParseUpdateFeatureResponse(rsp *http.Response) (*UpdateFeatureResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetFeatureResponse(rsp)
-}
 
-// ListLedgersWithResponse request returning *ListLedgersResponse
-func (c *ClientWithResponses) ListLedgersWithResponse(ctx context.Context, params *ListLedgersParams, reqEditors ...RequestEditorFn) (*ListLedgersResponse, error) {
-	rsp, err := c.ListLedgers(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateFeatureResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListLedgersResponse(rsp)
-}
 
-// CreateLedgerWithBodyWithResponse request with arbitrary body returning *CreateLedgerResponse
-func (c *ClientWithResponses) CreateLedgerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLedgerResponse, error) {
-	rsp, err := c.CreateLedgerWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateLedgerResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Feature
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-func (c *ClientWithResponses) CreateLedgerWithResponse(ctx context.Context, body CreateLedgerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLedgerResponse, error) {
-	rsp, err := c.CreateLedger(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateLedgerResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-// ListLedgerGrantsWithResponse request returning *ListLedgerGrantsResponse
-func (c *ClientWithResponses) ListLedgerGrantsWithResponse(ctx context.Context, params *ListLedgerGrantsParams, reqEditors ...RequestEditorFn) (*ListLedgerGrantsResponse, error) {
-	rsp, err := c.ListLedgerGrants(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListLedgerGrantsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// GetLedgerBalanceWithResponse request returning *GetLedgerBalanceResponse
-func (c *ClientWithResponses) GetLedgerBalanceWithResponse(ctx context.Context, ledgerID LedgerID, params *GetLedgerBalanceParams, reqEditors ...RequestEditorFn) (*GetLedgerBalanceResponse, error) {
-	rsp, err := c.GetLedgerBalance(ctx, ledgerID, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetLedgerBalanceResponse(rsp)
+
+	return response, nil
 }
 
-// ListLedgerGrantsByLedgerWithResponse request returning *ListLedgerGrantsByLedgerResponse
-func (c *ClientWithResponses) ListLedgerGrantsByLedgerWithResponse(ctx context.Context, ledgerID LedgerID, params *ListLedgerGrantsByLedgerParams, reqEditors ...RequestEditorFn) (*ListLedgerGrantsByLedgerResponse, error) {
-	rsp, err := c.ListLedgerGrantsByLedger(ctx, ledgerID, params, reqEditors...)
+// ParseGetFreshnessResponse parses an HTTP response from a GetFreshnessWithResponse call
+func ParseGetFreshnessResponse(rsp *http.Response) (*GetFreshnessResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListLedgerGrantsByLedgerResponse(rsp)
-}
 
-// CreateLedgerGrantWithBodyWithResponse request with arbitrary body returning *CreateLedgerGrantResponse
-func (c *ClientWithResponses) CreateLedgerGrantWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLedgerGrantResponse, error) {
-	rsp, err := c.CreateLedgerGrantWithBody(ctx, ledgerID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetFreshnessResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateLedgerGrantResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, body CreateLedgerGrantJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLedgerGrantResponse, error) {
-	rsp, err := c.CreateLedgerGrant(ctx, ledgerID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Freshness
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseCreateLedgerGrantResponse(rsp)
+
+	return response, nil
 }
 
-// VoidLedgerGrantWithResponse request returning *VoidLedgerGrantResponse
-func (c *ClientWithResponses) VoidLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*VoidLedgerGrantResponse, error) {
-	rsp, err := c.VoidLedgerGrant(ctx, ledgerID, ledgerGrantID, reqEditors...)
+// ParseListLedgersResponse parses an HTTP response from a ListLedgersWithResponse call
+func ParseListLedgersResponse(rsp *http.Response) (*ListLedgersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseVoidLedgerGrantResponse(rsp)
-}
 
-// GetLedgerGrantWithResponse request returning *GetLedgerGrantResponse
-func (c *ClientWithResponses) GetLedgerGrantWithResponse(ctx context.Context, ledgerID LedgerID, ledgerGrantID LedgerGrantID, reqEditors ...RequestEditorFn) (*GetLedgerGrantResponse, error) {
-	rsp, err := c.GetLedgerGrant(ctx, ledgerID, ledgerGrantID, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListLedgersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetLedgerGrantResponse(rsp)
-}
 
-// GetLedgerHistoryWithResponse request returning *GetLedgerHistoryResponse
-func (c *ClientWithResponses) GetLedgerHistoryWithResponse(ctx context.Context, ledgerID LedgerID, params *GetLedgerHistoryParams, reqEditors ...RequestEditorFn) (*GetLedgerHistoryResponse, error) {
-	rsp, err := c.GetLedgerHistory(ctx, ledgerID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Ledger
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseGetLedgerHistoryResponse(rsp)
+
+	return response, nil
 }
 
-// ResetLedgerWithBodyWithResponse request with arbitrary body returning *ResetLedgerResponse
-func (c *ClientWithResponses) ResetLedgerWithBodyWithResponse(ctx context.Context, ledgerID LedgerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResetLedgerResponse, error) {
-	rsp, err := c.ResetLedgerWithBody(ctx, ledgerID, contentType, body, reqEditors...)
+// ParseCreateLedgerResponse parses an HTTP response from a CreateLedgerWithResponse call
+func ParseCreateLedgerResponse(rsp *http.Response) (*CreateLedgerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseResetLedgerResponse(rsp)
-}
 
-func (c *ClientWithResponses) ResetLedgerWithResponse(ctx context.Context, ledgerID LedgerID, body ResetLedgerJSONRequestBody, reqEditors ...RequestEditorFn) (*ResetLedgerResponse, error) {
-	rsp, err := c.ResetLedger(ctx, ledgerID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateLedgerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseResetLedgerResponse(rsp)
-}
 
-// ListMetersWithResponse request returning *ListMetersResponse
-func (c *ClientWithResponses) ListMetersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMetersResponse, error) {
-	rsp, err := c.ListMeters(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Ledger
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest LedgerAlreadyExistsProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseListMetersResponse(rsp)
-}
 
-// CreateMeterWithBodyWithResponse request with arbitrary body returning *CreateMeterResponse
-func (c *ClientWithResponses) CreateMeterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMeterResponse, error) {
-	rsp, err := c.CreateMeterWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateMeterResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateMeterWithResponse(ctx context.Context, body CreateMeterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMeterResponse, error) {
-	rsp, err := c.CreateMeter(ctx, body, reqEditors...)
+// ParseCheckLedgerEntitlementResponse parses an HTTP response from a CheckLedgerEntitlementWithResponse call
+func ParseCheckLedgerEntitlementResponse(rsp *http.Response) (*CheckLedgerEntitlementResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateMeterResponse(rsp)
-}
 
-// DeleteMeterWithResponse request returning *DeleteMeterResponse
-func (c *ClientWithResponses) DeleteMeterWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*DeleteMeterResponse, error) {
-	rsp, err := c.DeleteMeter(ctx, meterIdOrSlug, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CheckLedgerEntitlementResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteMeterResponse(rsp)
-}
 
-// GetMeterWithResponse request returning *GetMeterResponse
-func (c *ClientWithResponses) GetMeterWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*GetMeterResponse, error) {
-	rsp, err := c.GetMeter(ctx, meterIdOrSlug, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetMeterResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LedgerEntitlement
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// QueryMeterWithResponse request returning *QueryMeterResponse
-func (c *ClientWithResponses) QueryMeterWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, params *QueryMeterParams, reqEditors ...RequestEditorFn) (*QueryMeterResponse, error) {
-	rsp, err := c.QueryMeter(ctx, meterIdOrSlug, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseQueryMeterResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-// ListMeterSubjectsWithResponse request returning *ListMeterSubjectsResponse
-func (c *ClientWithResponses) ListMeterSubjectsWithResponse(ctx context.Context, meterIdOrSlug MeterIdOrSlug, reqEditors ...RequestEditorFn) (*ListMeterSubjectsResponse, error) {
-	rsp, err := c.ListMeterSubjects(ctx, meterIdOrSlug, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListMeterSubjectsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
 
-// QueryPortalMeterWithResponse request returning *QueryPortalMeterResponse
-func (c *ClientWithResponses) QueryPortalMeterWithResponse(ctx context.Context, meterSlug string, params *QueryPortalMeterParams, reqEditors ...RequestEditorFn) (*QueryPortalMeterResponse, error) {
-	rsp, err := c.QueryPortalMeter(ctx, meterSlug, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseQueryPortalMeterResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest NotImplementedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON501 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// ListPortalTokensWithResponse request returning *ListPortalTokensResponse
-func (c *ClientWithResponses) ListPortalTokensWithResponse(ctx context.Context, params *ListPortalTokensParams, reqEditors ...RequestEditorFn) (*ListPortalTokensResponse, error) {
-	rsp, err := c.ListPortalTokens(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseListPortalTokensResponse(rsp)
+
+	return response, nil
 }
 
-// CreatePortalTokenWithBodyWithResponse request with arbitrary body returning *CreatePortalTokenResponse
-func (c *ClientWithResponses) CreatePortalTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePortalTokenResponse, error) {
-	rsp, err := c.CreatePortalTokenWithBody(ctx, contentType, body, reqEditors...)
+// ParseListLedgerGrantsResponse parses an HTTP response from a ListLedgerGrantsWithResponse call
+func ParseListLedgerGrantsResponse(rsp *http.Response) (*ListLedgerGrantsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreatePortalTokenResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreatePortalTokenWithResponse(ctx context.Context, body CreatePortalTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePortalTokenResponse, error) {
-	rsp, err := c.CreatePortalToken(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListLedgerGrantsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreatePortalTokenResponse(rsp)
-}
 
-// InvalidatePortalTokensWithBodyWithResponse request with arbitrary body returning *InvalidatePortalTokensResponse
-func (c *ClientWithResponses) InvalidatePortalTokensWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InvalidatePortalTokensResponse, error) {
-	rsp, err := c.InvalidatePortalTokensWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []LedgerGrantResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseInvalidatePortalTokensResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) InvalidatePortalTokensWithResponse(ctx context.Context, body InvalidatePortalTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*InvalidatePortalTokensResponse, error) {
-	rsp, err := c.InvalidatePortalTokens(ctx, body, reqEditors...)
+// ParseGetLedgerBalanceResponse parses an HTTP response from a GetLedgerBalanceWithResponse call
+func ParseGetLedgerBalanceResponse(rsp *http.Response) (*GetLedgerBalanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseInvalidatePortalTokensResponse(rsp)
-}
 
-// ListSubjectsWithResponse request returning *ListSubjectsResponse
-func (c *ClientWithResponses) ListSubjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSubjectsResponse, error) {
-	rsp, err := c.ListSubjects(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetLedgerBalanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListSubjectsResponse(rsp)
-}
 
-// UpsertSubjectWithBodyWithResponse request with arbitrary body returning *UpsertSubjectResponse
-func (c *ClientWithResponses) UpsertSubjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertSubjectResponse, error) {
-	rsp, err := c.UpsertSubjectWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LedgerBalance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseUpsertSubjectResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpsertSubjectWithResponse(ctx context.Context, body UpsertSubjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertSubjectResponse, error) {
-	rsp, err := c.UpsertSubject(ctx, body, reqEditors...)
+// ParseListLedgerGrantSchedulesResponse parses an HTTP response from a ListLedgerGrantSchedulesWithResponse call
+func ParseListLedgerGrantSchedulesResponse(rsp *http.Response) (*ListLedgerGrantSchedulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpsertSubjectResponse(rsp)
-}
 
-// DeleteSubjectWithResponse request returning *DeleteSubjectResponse
-func (c *ClientWithResponses) DeleteSubjectWithResponse(ctx context.Context, subjectIdOrKey SubjectIdOrKey, reqEditors ...RequestEditorFn) (*DeleteSubjectResponse, error) {
-	rsp, err := c.DeleteSubject(ctx, subjectIdOrKey, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListLedgerGrantSchedulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteSubjectResponse(rsp)
-}
 
-// GetSubjectWithResponse request returning *GetSubjectResponse
-func (c *ClientWithResponses) GetSubjectWithResponse(ctx context.Context, subjectIdOrKey SubjectIdOrKey, reqEditors ...RequestEditorFn) (*GetSubjectResponse, error) {
-	rsp, err := c.GetSubject(ctx, subjectIdOrKey, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []LedgerGrantSchedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseGetSubjectResponse(rsp)
+
+	return response, nil
 }
 
-// ParseListEventsResponse parses an HTTP response from a ListEventsWithResponse call
-func ParseListEventsResponse(rsp *http.Response) (*ListEventsResponse, error) {
+// ParseCreateLedgerGrantScheduleResponse parses an HTTP response from a CreateLedgerGrantScheduleWithResponse call
+func ParseCreateLedgerGrantScheduleResponse(rsp *http.Response) (*CreateLedgerGrantScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListEventsResponse{
+	response := &CreateLedgerGrantScheduleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []IngestedEvent
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest LedgerGrantSchedule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequestProblemResponse
@@ -4345,6 +7966,13 @@ func ParseListEventsResponse(rsp *http.Response) (*ListEventsResponse, error) {
 		}
 		response.ApplicationproblemJSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest UnexpectedProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4357,26 +7985,66 @@ func ParseListEventsResponse(rsp *http.Response) (*ListEventsResponse, error) {
 	return response, nil
 }
 
-// ParseIngestEventsResponse parses an HTTP response from a IngestEventsWithResponse call
-func ParseIngestEventsResponse(rsp *http.Response) (*IngestEventsResponse, error) {
+// ParseDeleteLedgerGrantScheduleResponse parses an HTTP response from a DeleteLedgerGrantScheduleWithResponse call
+func ParseDeleteLedgerGrantScheduleResponse(rsp *http.Response) (*DeleteLedgerGrantScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &IngestEventsResponse{
+	response := &DeleteLedgerGrantScheduleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestProblemResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationproblemJSON400 = &dest
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLedgerGrantScheduleResponse parses an HTTP response from a GetLedgerGrantScheduleWithResponse call
+func ParseGetLedgerGrantScheduleResponse(rsp *http.Response) (*GetLedgerGrantScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLedgerGrantScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LedgerGrantSchedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest UnauthorizedProblemResponse
@@ -4385,6 +8053,13 @@ func ParseIngestEventsResponse(rsp *http.Response) (*IngestEventsResponse, error
 		}
 		response.ApplicationproblemJSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest UnexpectedProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4397,27 +8072,34 @@ func ParseIngestEventsResponse(rsp *http.Response) (*IngestEventsResponse, error
 	return response, nil
 }
 
-// ParseListFeaturesResponse parses an HTTP response from a ListFeaturesWithResponse call
-func ParseListFeaturesResponse(rsp *http.Response) (*ListFeaturesResponse, error) {
+// ParseListLedgerGrantsByLedgerResponse parses an HTTP response from a ListLedgerGrantsByLedgerWithResponse call
+func ParseListLedgerGrantsByLedgerResponse(rsp *http.Response) (*ListLedgerGrantsByLedgerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListFeaturesResponse{
+	response := &ListLedgerGrantsByLedgerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Feature
+		var dest []LedgerGrantResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest UnauthorizedProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4437,22 +8119,22 @@ func ParseListFeaturesResponse(rsp *http.Response) (*ListFeaturesResponse, error
 	return response, nil
 }
 
-// ParseCreateFeatureResponse parses an HTTP response from a CreateFeatureWithResponse call
-func ParseCreateFeatureResponse(rsp *http.Response) (*CreateFeatureResponse, error) {
+// ParseCreateLedgerGrantResponse parses an HTTP response from a CreateLedgerGrantWithResponse call
+func ParseCreateLedgerGrantResponse(rsp *http.Response) (*CreateLedgerGrantResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateFeatureResponse{
+	response := &CreateLedgerGrantResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Feature
+		var dest LedgerGrantResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4472,13 +8154,6 @@ func ParseCreateFeatureResponse(rsp *http.Response) (*CreateFeatureResponse, err
 		}
 		response.ApplicationproblemJSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
-		var dest NotImplementedProblemResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationproblemJSON501 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest UnexpectedProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4491,15 +8166,15 @@ func ParseCreateFeatureResponse(rsp *http.Response) (*CreateFeatureResponse, err
 	return response, nil
 }
 
-// ParseDeleteFeatureResponse parses an HTTP response from a DeleteFeatureWithResponse call
-func ParseDeleteFeatureResponse(rsp *http.Response) (*DeleteFeatureResponse, error) {
+// ParseVoidLedgerGrantResponse parses an HTTP response from a VoidLedgerGrantWithResponse call
+func ParseVoidLedgerGrantResponse(rsp *http.Response) (*VoidLedgerGrantResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteFeatureResponse{
+	response := &VoidLedgerGrantResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4531,22 +8206,22 @@ func ParseDeleteFeatureResponse(rsp *http.Response) (*DeleteFeatureResponse, err
 	return response, nil
 }
 
-// ParseGetFeatureResponse parses an HTTP response from a GetFeatureWithResponse call
-func ParseGetFeatureResponse(rsp *http.Response) (*GetFeatureResponse, error) {
+// ParseGetLedgerGrantResponse parses an HTTP response from a GetLedgerGrantWithResponse call
+func ParseGetLedgerGrantResponse(rsp *http.Response) (*GetLedgerGrantResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFeatureResponse{
+	response := &GetLedgerGrantResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Feature
+		var dest LedgerGrantResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4578,40 +8253,40 @@ func ParseGetFeatureResponse(rsp *http.Response) (*GetFeatureResponse, error) {
 	return response, nil
 }
 
-// ParseListLedgersResponse parses an HTTP response from a ListLedgersWithResponse call
-func ParseListLedgersResponse(rsp *http.Response) (*ListLedgersResponse, error) {
+// ParseListLedgerGrantAmendmentsResponse parses an HTTP response from a ListLedgerGrantAmendmentsWithResponse call
+func ParseListLedgerGrantAmendmentsResponse(rsp *http.Response) (*ListLedgerGrantAmendmentsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListLedgersResponse{
+	response := &ListLedgerGrantAmendmentsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Ledger
+		var dest []LedgerGrantAmendment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestProblemResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationproblemJSON400 = &dest
+		response.ApplicationproblemJSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest UnauthorizedProblemResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationproblemJSON401 = &dest
+		response.ApplicationproblemJSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest UnexpectedProblemResponse
@@ -4625,22 +8300,22 @@ func ParseListLedgersResponse(rsp *http.Response) (*ListLedgersResponse, error)
 	return response, nil
 }
 
-// ParseCreateLedgerResponse parses an HTTP response from a CreateLedgerWithResponse call
-func ParseCreateLedgerResponse(rsp *http.Response) (*CreateLedgerResponse, error) {
+// ParseAmendLedgerGrantResponse parses an HTTP response from a AmendLedgerGrantWithResponse call
+func ParseAmendLedgerGrantResponse(rsp *http.Response) (*AmendLedgerGrantResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateLedgerResponse{
+	response := &AmendLedgerGrantResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Ledger
+		var dest LedgerGrantAmendment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4660,12 +8335,12 @@ func ParseCreateLedgerResponse(rsp *http.Response) (*CreateLedgerResponse, error
 		}
 		response.ApplicationproblemJSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest LedgerAlreadyExistsProblemResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationproblemJSON409 = &dest
+		response.ApplicationproblemJSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest UnexpectedProblemResponse
@@ -4679,33 +8354,73 @@ func ParseCreateLedgerResponse(rsp *http.Response) (*CreateLedgerResponse, error
 	return response, nil
 }
 
-// ParseListLedgerGrantsResponse parses an HTTP response from a ListLedgerGrantsWithResponse call
-func ParseListLedgerGrantsResponse(rsp *http.Response) (*ListLedgerGrantsResponse, error) {
+// ParseGetLedgerHistoryResponse parses an HTTP response from a GetLedgerHistoryWithResponse call
+func ParseGetLedgerHistoryResponse(rsp *http.Response) (*GetLedgerHistoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListLedgerGrantsResponse{
+	response := &GetLedgerHistoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []LedgerGrantResponse
+		var dest []LedgerEntry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestProblemResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationproblemJSON400 = &dest
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLedgerNotificationRulesResponse parses an HTTP response from a ListLedgerNotificationRulesWithResponse call
+func ParseListLedgerNotificationRulesResponse(rsp *http.Response) (*ListLedgerNotificationRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLedgerNotificationRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []LedgerNotificationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest UnauthorizedProblemResponse
@@ -4726,26 +8441,33 @@ func ParseListLedgerGrantsResponse(rsp *http.Response) (*ListLedgerGrantsRespons
 	return response, nil
 }
 
-// ParseGetLedgerBalanceResponse parses an HTTP response from a GetLedgerBalanceWithResponse call
-func ParseGetLedgerBalanceResponse(rsp *http.Response) (*GetLedgerBalanceResponse, error) {
+// ParseCreateLedgerNotificationRuleResponse parses an HTTP response from a CreateLedgerNotificationRuleWithResponse call
+func ParseCreateLedgerNotificationRuleResponse(rsp *http.Response) (*CreateLedgerNotificationRuleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLedgerBalanceResponse{
+	response := &CreateLedgerNotificationRuleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LedgerBalance
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest LedgerNotificationRule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest UnauthorizedProblemResponse
@@ -4773,33 +8495,66 @@ func ParseGetLedgerBalanceResponse(rsp *http.Response) (*GetLedgerBalanceRespons
 	return response, nil
 }
 
-// ParseListLedgerGrantsByLedgerResponse parses an HTTP response from a ListLedgerGrantsByLedgerWithResponse call
-func ParseListLedgerGrantsByLedgerResponse(rsp *http.Response) (*ListLedgerGrantsByLedgerResponse, error) {
+// ParseDeleteLedgerNotificationRuleResponse parses an HTTP response from a DeleteLedgerNotificationRuleWithResponse call
+func ParseDeleteLedgerNotificationRuleResponse(rsp *http.Response) (*DeleteLedgerNotificationRuleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListLedgerGrantsByLedgerResponse{
+	response := &DeleteLedgerNotificationRuleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []LedgerGrantResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.ApplicationproblemJSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestProblemResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationproblemJSON400 = &dest
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLedgerNotificationRuleResponse parses an HTTP response from a GetLedgerNotificationRuleWithResponse call
+func ParseGetLedgerNotificationRuleResponse(rsp *http.Response) (*GetLedgerNotificationRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLedgerNotificationRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LedgerNotificationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest UnauthorizedProblemResponse
@@ -4808,6 +8563,13 @@ func ParseListLedgerGrantsByLedgerResponse(rsp *http.Response) (*ListLedgerGrant
 		}
 		response.ApplicationproblemJSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest UnexpectedProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4819,41 +8581,41 @@ func ParseListLedgerGrantsByLedgerResponse(rsp *http.Response) (*ListLedgerGrant
 
 	return response, nil
 }
-
-// ParseCreateLedgerGrantResponse parses an HTTP response from a CreateLedgerGrantWithResponse call
-func ParseCreateLedgerGrantResponse(rsp *http.Response) (*CreateLedgerGrantResponse, error) {
+
+// ParseListLedgerNotificationDeliveriesResponse parses an HTTP response from a ListLedgerNotificationDeliveriesWithResponse call
+func ParseListLedgerNotificationDeliveriesResponse(rsp *http.Response) (*ListLedgerNotificationDeliveriesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateLedgerGrantResponse{
+	response := &ListLedgerNotificationDeliveriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest LedgerGrantResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []LedgerNotificationDelivery
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestProblemResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationproblemJSON400 = &dest
+		response.ApplicationproblemJSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest UnauthorizedProblemResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationproblemJSON401 = &dest
+		response.ApplicationproblemJSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest UnexpectedProblemResponse
@@ -4867,20 +8629,34 @@ func ParseCreateLedgerGrantResponse(rsp *http.Response) (*CreateLedgerGrantRespo
 	return response, nil
 }
 
-// ParseVoidLedgerGrantResponse parses an HTTP response from a VoidLedgerGrantWithResponse call
-func ParseVoidLedgerGrantResponse(rsp *http.Response) (*VoidLedgerGrantResponse, error) {
+// ParseUpdateLedgerOverageLimitsResponse parses an HTTP response from a UpdateLedgerOverageLimitsWithResponse call
+func ParseUpdateLedgerOverageLimitsResponse(rsp *http.Response) (*UpdateLedgerOverageLimitsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &VoidLedgerGrantResponse{
+	response := &UpdateLedgerOverageLimitsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ledger
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest UnauthorizedProblemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4907,26 +8683,33 @@ func ParseVoidLedgerGrantResponse(rsp *http.Response) (*VoidLedgerGrantResponse,
 	return response, nil
 }
 
-// ParseGetLedgerGrantResponse parses an HTTP response from a GetLedgerGrantWithResponse call
-func ParseGetLedgerGrantResponse(rsp *http.Response) (*GetLedgerGrantResponse, error) {
+// ParseResetLedgerResponse parses an HTTP response from a ResetLedgerWithResponse call
+func ParseResetLedgerResponse(rsp *http.Response) (*ResetLedgerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLedgerGrantResponse{
+	response := &ResetLedgerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LedgerGrantResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest LedgerReset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest UnauthorizedProblemResponse
@@ -4954,22 +8737,22 @@ func ParseGetLedgerGrantResponse(rsp *http.Response) (*GetLedgerGrantResponse, e
 	return response, nil
 }
 
-// ParseGetLedgerHistoryResponse parses an HTTP response from a GetLedgerHistoryWithResponse call
-func ParseGetLedgerHistoryResponse(rsp *http.Response) (*GetLedgerHistoryResponse, error) {
+// ParseDeleteLedgerUsagePeriodResponse parses an HTTP response from a DeleteLedgerUsagePeriodWithResponse call
+func ParseDeleteLedgerUsagePeriodResponse(rsp *http.Response) (*DeleteLedgerUsagePeriodResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLedgerHistoryResponse{
+	response := &DeleteLedgerUsagePeriodResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []LedgerEntry
+		var dest Ledger
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5001,26 +8784,26 @@ func ParseGetLedgerHistoryResponse(rsp *http.Response) (*GetLedgerHistoryRespons
 	return response, nil
 }
 
-// ParseResetLedgerResponse parses an HTTP response from a ResetLedgerWithResponse call
-func ParseResetLedgerResponse(rsp *http.Response) (*ResetLedgerResponse, error) {
+// ParseUpdateLedgerUsagePeriodResponse parses an HTTP response from a UpdateLedgerUsagePeriodWithResponse call
+func ParseUpdateLedgerUsagePeriodResponse(rsp *http.Response) (*UpdateLedgerUsagePeriodResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ResetLedgerResponse{
+	response := &UpdateLedgerUsagePeriodResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest LedgerReset
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Ledger
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequestProblemResponse
@@ -5517,6 +9300,208 @@ func ParseInvalidatePortalTokensResponse(rsp *http.Response) (*InvalidatePortalT
 	return response, nil
 }
 
+// ParseListSubjectAliasesResponse parses an HTTP response from a ListSubjectAliasesWithResponse call
+func ParseListSubjectAliasesResponse(rsp *http.Response) (*ListSubjectAliasesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSubjectAliasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SubjectAlias
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest NotImplementedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON501 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpsertSubjectAliasResponse parses an HTTP response from a UpsertSubjectAliasWithResponse call
+func ParseUpsertSubjectAliasResponse(rsp *http.Response) (*UpsertSubjectAliasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpsertSubjectAliasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SubjectAlias
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest NotImplementedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON501 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSubjectAliasResponse parses an HTTP response from a DeleteSubjectAliasWithResponse call
+func ParseDeleteSubjectAliasResponse(rsp *http.Response) (*DeleteSubjectAliasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSubjectAliasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest NotImplementedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON501 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSubjectAliasResponse parses an HTTP response from a GetSubjectAliasWithResponse call
+func ParseGetSubjectAliasResponse(rsp *http.Response) (*GetSubjectAliasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSubjectAliasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SubjectAlias
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest UnauthorizedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest NotImplementedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON501 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest UnexpectedProblemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseListSubjectsResponse parses an HTTP response from a ListSubjectsWithResponse call
 func ParseListSubjectsResponse(rsp *http.Response) (*ListSubjectsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -5715,154 +9700,275 @@ func ParseGetSubjectResponse(rsp *http.Response) (*GetSubjectResponse, error) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x963LbOJbwq6D4bdUkPZQsyXYSu2pqSnFsR534El+SdMf+0hAJSehQBEOAthWXf+xb",
-	"7PPtk3yFGwmSIEXJcuIvk62uncgkgYODcz8HB7eOR6YRCVHIqLN960QwhlPEUCx+jRBkSYwGr/gPH1Ev",
-	"xhHDJHS2nT5IQvw1QeD87eAVwD4KGR5hFIMRiQEE6su24zqYvx5BNnFcJ4RT5Gwb47pOjL4mOEa+s83i",
-	"BLkO9SZoCvmE6AZOo4C/3+n2T/5cP3y1++bs9P3Gycne3rtnW/ube/33juuwWcTfoSzG4dhxnZvWmLTU",
-	"H70Y+Zi194z50sctPI1IzOSq2cTZdsaYTZJh2yPTNRKhUOABk+zfazhkKA5hsCbHde7u7lwnQP4Yxfsx",
-	"DFktoko4kh+CMf+yAlH5sb8PsrLZHghVy2CpFj+NUeMllJEpilvYb4aLt9n4D4WM0AsSH70n2KdltKin",
-	"4IpgH6CQxRhRgEPAJgjEiEYkpBmPfU1QPMtwg82RTXz4aASTgDnbIxhQ5Gb4kYhTGBgSEiAYOhmo7/j4",
-	"b/EUszKgh8l0iGJARimUjIAYsSQOK8ALxEBWuLqdTscAq8t/TeENniZT/XCKQ/UzBZgjeYziIsBHoxFF",
-	"TSGmX3BUAS+R41gBLkOrwetYwRNUMfCP4tMgGTdnBr7r4tMKbsgPW8cS/xWjkbPt/J+1TPqvyad0LR2A",
-	"QyqQsIcDxqUQSaKXM/65DT+j3EvmZND3MV8ZDI5jEqGYYSRIPc9/d24BC6eYIxTIccX6x3xwMJxRcI3Z",
-	"BKAb6DEwhcybtC/Ci/CcwjHaBn/9OwfKJz7N5b9wGCXsIul0es/yj6fER8Hlv8YRa238dcGpNd3KW0c8",
-	"5BzOnzrGXkYJ4xCr32T4N/LEHyibCUnjIxQdpX81sPi2UgTK5zgcAxj66VrBNAkY5oigiRiP5teqJeC/",
-	"Ot3XH5+9f7O5s7H14uV6/+UfW8cn3c6zrePjwqqc6jerWDWTgtmu/mDpaaD0VCKmDqPz8Phv9cd/pRqi",
-	"K2ml9PfeRZVAU6/mkIQZmtppXf0BxjGcGZwWk2l5HacMxgz4kKEWw1PEFcDJ3g5YX1/f4nwxhax9EQpV",
-	"QfEValdCOOKj2zVjr9Nbb3W6rU73rNPZFv/96biOHJ3Ts568pDdT2A35UFBiIxASBmiEPC7LfAABxeE4",
-	"QACOxzEaQ4bANQ4CMERKZSBf8DuC3kRvl2AKsfprHPrkun0R/qUe/QUwBZDrQxRfIYN1rmCQ1KBjbJFV",
-	"KUY+Kd5Xy710F97LM1JGxW7or2AfGZm3i72ld/GDwO4p/obmb6Sb7WTC+WjefnL9xRVajNiMK17+O6OK",
-	"iLN9xcaLrapGyHUGdFMtZ6yzsPYzPEV/ktCy/rMJkjTFCY4Dz6fXCxE7+o2ECEAKfDTCfNXKVBv0D/uA",
-	"jwv4wOAVZHAIKQJPJoxF22tr19fXbQxD2CbxeI0P1OID0aecHEo45wOen+2ICcV8GtcJRf48HKWLs9ox",
-	"zvnZTk5V9Kcoxh5cO0TXn/8g8Rcr3aiN4nbDGzRbxLZXX1aYM4Vx72/iC8WhzWbByi+hf4K+Joiy45gM",
-	"AzQ9UU/5Q4+EDIVCr8AoCrAH+YLWIvnmP/+mfHW3prngIwYxtxcmCPooBjtyhNbZLEJgAilIQnQTIY8h",
-	"XxHSRW7om2lw4fCtYZAl1Nne4LYkw0ys7CX0gQI2W1kSh9sKIKFet4fQb8XqrbumzKAWLxGU3zxz1jvX",
-	"kSq9H8QI+rPdG0wZXQnmPBKOAuwxHI53QxZLK9NXxsr7g85pZ+fgz99P3/XW97cO3nw8eXf83BF2NPQh",
-	"E+vjexyhYzibopAN+KcR/rxxFPe/TN5ezfAEk61oszvZwngvfOlkZJsRWqsrjVC1h8o9R2KRuU3ZyjZF",
-	"vQQlQrKXq7ZHvdB4Z6rxbdss+TbYTSc5JGyPJKG/WuoWZpkQSyM+eA43GxluDgkDe+qFKnyEhLXkIKsg",
-	"1mxGufYBB53TA1oxBlQAS+AAZ5MYmNjsdPOYGOReq8OHOeCqsDLIj3kewoRNSIy/rRozU0y5SQBIDHB4",
-	"BQPsA0a+oDBHJAZqTEhq8JKYr60CKeeFAc9TybxafBgSH8UxiXMk0jHxkL63q96rxoV+dUWYKEB4l44q",
-	"dOROjCBDKoSqVUGlW69iSkULQDOMsNT1DxIDbrJjjxtRIxQjTisAAq3D2xfhHrcGJVq3wc7xees1SWLq",
-	"gjNOU9QF/eMB2IFBQF2AmCetnygXZYCxN8FX3GiwmLPcljJBU++6ADNpVnEGV6aVCkHAkFEOubB423nX",
-	"Wi2eC+ujMJhVRdRUAEg5TNJLpfeIlBxF8iMZHMr8HxnooG1wTtEoCQAeZSEkQD0SiUUPYyKMFTaBIbie",
-	"QJZihMXQ+0Lb9UERWxREzGCPbZ2lADA+VXEDKCUehpwWRYiH2/4+8mIEKaIa+cOZFfmOkDP0MyMMBmXb",
-	"T5uVNoBMO15nLgqD9weK5qwmcGaZfpLTmDi4tGBIMpXU1zaLWdkVMYq4W8vFNvclSIRCFQEE6TvThAoa",
-	"hZTicah5SPplF6E2sS2cYVpPjSnPoIOFLa5ytKwqcpNSCVcl2hFkE0z1ogVDMiJJVBPGiMRynfUbpGct",
-	"7UtNEGvlIaw8CYiciyFb89jYl8uDMUrXjUPJFGAIAxgKAap9RM+McJXF4ZQkYQXG5TM+vMxJgR0YcsqK",
-	"CMUMXwmBHXL/nv87FPHzApuI4HcWbiDJMDBiDfITvvGeWLvfrwBE+NKcGQUc4BpSoL4osuUicasKsWzQ",
-	"9miEPL64KrjSF0QQ5z6wWPgqwjGUc906MAiORs72pybOgaCO3fTzYxFGce4uXec6xgxlK75z6xK6fH3K",
-	"T9cCWaR2UzEt96IspF2uV2A4a5fCzI3zkHfuIxBGEYzVpzbUyKcKCd8TMVGMSYzZLJ8hc20gqje1JlNM",
-	"rKSH0KcTPJ6gOHuTixRh0XLzBseU64lj/VDYainv+8jDUxgovqdt8IEPGJBr7grKvwEc+sI2Dsd6Jikq",
-	"uYTKG3MuH9qAt8tnmxIu4eIxR7SwRvLv9NoX4YcJEiE1DneMAEVXKIaBVgDwCuIADgOUhhsp1+xKHsrQ",
-	"GZ1RhqaAooCLSFPK8PXwnwJ0ytK5RVAWeMIEuRZTq+nohMOQTpPCGqArFLjG0F5AKB+RC25GQcbrudhd",
-	"ugMDGTXkM4q9vCZ6xgm80mE0DwZ6RoyosJSMcbmsobkFi5kSaspVQcGGcE0ByIl0I/fa29ysT726TkyC",
-	"gFxJo6ah7DrRn6Rc2fjTM/76neskkb+gPgkgZUB99oBKpWB6iKeuVsJurhDG1D45fWCzH3evtD/a3Avb",
-	"CUjiiw8pOFW2gqSW30+PDsGpdCRzUlVL5JzJ32JJPCRcVkmDeNvp9tZt2VERwNv0up0R9FGr622h1ob/",
-	"zGu96D3fbHmbPW/92fP1rr/ucaeYJLEnMCc9wpYyo7lRc4ViKpfQbXccM3JXiPbiaXH7utviv3an0/0z",
-	"gzCKyTSSQj+nYOoVkNxgi1XAUQoiOAsI9Ns1vlIF4mzKiEOiog6aJQqbKR8C/lQLfP6RSnqBA+4VQF+I",
-	"K0ZEnqnX2Xim80wcypAz8adcRENEMi5NXig9FQLgLQrH3PLtuk6YBELkVlpVHCoz15BzwXVGQApi+ZqU",
-	"S2IxcgEUMNI2GTCJ8eJwYH/u/GInczvYlHzzsJTm1tQ9Z36x4zeMu3rXE+xx/1dR1wRGEQpRnryKvGLi",
-	"pxWjEYpR6KEG0Jk8Zk16yYeazkxBQnOCREKdopLrG5oHWXLwPICq/MJX4tdQk4tyDBVYckqVctOozD2L",
-	"YuInHorBkzQV5YPhDMjteZqHNC9b5kAsRU8Jd3iKKIPTiINxrUwXQDwvicXWZNtq49f19fWtdqViKkg2",
-	"q3JakEPskiaPcy1vJEJjFAjDgXvifGUxHuNQGoDZKvNrULJ3nqYUSFdsk6dQV2vRhn68ZGqpMJt68R6n",
-	"cPEhXaP+l9aYrF311sQfBKQqGtrcVbMGUe/c24ICauoXaw9tRZ5xI1l5gqBPwmBWVfhbHz+rdXrmeuZN",
-	"7TsTL6uy8ObSadk+u/yxAfCSNaHo7qWMFTWnWk3mZUIdZkOVt8OISVVTRNNQkQj1VoQrRNHJCmYp7Kle",
-	"nJ7cssF3rrNQ/Wa7LlJNQqQ2o5CZ4Rz25DzEXPTBIJiBcznuW3SDPTKOYTThfmAwA6fcy+aOb2pRxE8L",
-	"/Lf/bPPP55ub/b0P/Tevd7u9wz86O++29l47LheADMV8yv/7qdPa6r/cebW7t//69zcHh8fvTk7P3n/4",
-	"+Mefl7e9Z3f/ZZEWt9Urm8IbrYCerRf1kTkrbH3rtLYu//nk39uf0x9Pf7NMd2kRD4NwjChD/jJeUT8E",
-	"WH2ulJoIBBCdUxE5TGndiPRYwcBHespFXKUFfCP/x/lG2cplRrKU+Je1WlIpFl2pFC918mVXf1uayqQp",
-	"nUdGSoUv5nLLr2w+dJZ9WUSHq6+W190qg/EIVbc6arFCzb2ksqzIfiWxCmbZ1Nz3Td7U1OUsFD/IV+y4",
-	"1SVRKgKc1UQdvvr9ZHO9t/ti/+zl+9Od3sc3m682nMZlTU9ULLldPdhTs6yJUSbYXftc2eCug0PKpDUg",
-	"ihVU+dl2QDwYrP1+cBR4jL55/6LV4f/XrSql2lHrzXYWDknCtocBDL+UBYwVPfPDhiYuynp7kkxh2OKL",
-	"FsoU3UQBDKXwT7NrwtfB1HTjFP+oKo28rh8Sf5blaGWoLSXZMvemqCwDd34yAKlXL4MkuBA/0TA2hK3Z",
-	"bhXCLmVvXe2mTeq9Pjs7BvIF4BEfgTEKUSx8xuHM8BmFHZwec2mM3Y2ceYdDtt5zjHj15taWEa+W1ZSl",
-	"iLWivzK+IaATEjO3SBU0mU5hPCvAJTzjPHqtFZvz3G1RK+qRkEEccl+B77ptr6unra0Jnbed9oC1xFG6",
-	"1SkLLZJCry0afSgJ/bLKT3mZ+ShZHbIlXz7KuU4WKlc+kkqwKddBpd7TIwMNnCwNaek8geuIrEU1BGeT",
-	"NCOlc3kqHpNbVyNgjNxKDUDctT5B1mN1HBjheXPNzeoti3vZOY+8eqUQm7QjwFSi9XxYJMMiUdREw1Je",
-	"SAurK8wtxJ8vXzPCaW/WqGYEDEZA5RWGwbJxgfuUMoiVPljG/t4WuLkTq4qgydM1c8tEVfFIZUDb4shI",
-	"ZD6UO9M8ISzIWyaEbbHkVJPJVImReFXkPYdxzhQgOm22f9I/PHNc5/2RGORk93SX/xR//nx+2t/fzSfS",
-	"9PulFVpE7jIVQKkqvV+wThaNrDCIZg+e1ZUulU/NZXUN6pCY0Nq5/gkWseVVSy1UGlG8DAL8BYFuD0xJ",
-	"yCbFytduz2Y++klWttVkIv2+nEtMpOZRhPX66PzEcZ1X/T8c1/mwu/vGcZ2Do8Oz147r/LHbP3Eu5ymL",
-	"FCRX4aCatPOks1QoJFe7WCY+gQFE+/N3gsuBOjJcbbHfPaS0Fbj7iefqzhhnmaQdvGrfQy1dEVxdBJ/W",
-	"sPG3LAXwdsPyHzQVHzCcTUm8ZEG8TV4LcA3EzJUjJ0blkaV+FujKJO5cjfBY8Yi1MBre9CtMngPpWhpm",
-	"jx42F5PKzJQFC5r0Iqx6TJ9EbuBy5THyUN5VGWQr+aaYF8ljRkBC0fZF2AJ/newe9AeHg8P9z/2Do/PD",
-	"s79AC+jxQIymEIeiIYLAdlt8cnQy2B8c9t/av2hJQpUu8igJVGlgNoIhaIuTO65TGDyvwYsPm3fTyaHo",
-	"QTejehNUyd8sUqgXJgrH3qBYSq4CM4rEVT1FEuLUmTGsZnkYIYdWi+0j/2TDF//oEE45330qruNcJt/S",
-	"BVZ4nG91sJj7m3N5e26NtzRviRrP8N3AgAEPhkogKrSMEluy+0cF8AXMDxu/z9cpNhNGcuNWT/cH/InN",
-	"oZV5KpFqzxGEqiwTh/MpmJBrvtGigYyon83aF8jSj0KqTz9WTTnOD5xS5mIgDzvKzDT/mo9zlkusuWnf",
-	"CSNZ+F/tXLcJ/gemSlupSDEXE6uiyk8FO2byfX06yWiGsO0cDA7Pz3bL0fPcWuq1k8By33i/eP6sjH/j",
-	"tybNtG9R+UgVOFfYmlugZKDztrIQUyuZdDeb1R/l9qUqoJQNU9qxqsN5XLr5Iux/DEXToihGlGISioZT",
-	"6IbF0NPnCMy+JRSMYjI1itd8yGAbvEEzmqYTlDTgtOuRkGLKgJASMIgmMExE8wbxNAl9FFOPxAh4E8hn",
-	"RDGtKFWtocWSE2GTVY26VzUsTJhrN9PaAoxS6L4SJFl/d18kfofyhxLrF9duobQiocnWEyl55csf/kFl",
-	"6a2SF7P2RXhmvqQ+JjE4PT9wQf/9vgsOBoeuQNFB/yMwRAuVMjhUrdZEyw+xDimIPRUqhDHVlU/pqbI9",
-	"EoPzw8G7893PO9zaMod182BLiLIUm5yiDfgQpW8zBGgUchjxOCRxOXJlSNXSNlznGuUs0GomdxRRNmoz",
-	"pbEp5XKzzNG1gmlpW+rE++na6Mt4TQ6Xqdl+XmFYAsEmjjM738L6yk6UCnRHmdLmXjuu03+/77hce/H/",
-	"3/+YNyfll3XGt4mMfg65q8aL6DF4gqg4lmV1fsQzGbGSdolozNO2HbT4dGszDgqVRMUynaqKH0HkMmol",
-	"qWg39Kt7RClCY5CjxG7A3l26soVYpYHLyNwJ6s0SndDJ2nA9eow0SqsZpEKubRm1kWr8tqqoOVlZY7AV",
-	"STqxs7Y4ZB41FlUek2uj5WkDXnrMBFMk+AY2Z10Ss+H6Kgzr5VKZEvHymFHNgYm5xrxC7q01wJ4F0AzE",
-	"r5qi5U7dPkhduFxdfipzMQto85Q5Vqq9qpItfRkBkdkKW0GEtUWlHA1Q0alStmmUR1myQNI1TOsT6jPA",
-	"qxB9VuhQ2kLyh8BWTOnLbpyMWMXiMYkZDIR/bNsj7qlw1wSI49WBDDsU4xVBQK6Rf6AbkYgYW85Ducx1",
-	"Yqham8zg+Ea/6DSjU8WGqkyxxrOrrFOWK3bI9LNc2udBP3y1fhx9+NDr9z7EL6Zbf4++odfB/scXN9Od",
-	"j9f77dnm143TVv/D173k2de/R3DvW+fbu68bu996L05oOHt//fto9HHz683BFbEEQspIuq1oryOqA3Rn",
-	"R+Ei5htYyr756ZFuNbK5J2X0VzcVneJwIB92C+aC60jvVj1WrR1y5ccP1SBDU8Jtgx5HucTfcoK7YRJv",
-	"ZcGETAs2bCiZ0qs1kiyCW5hKWkk7oXLHl+8VJnmGfSiSXzTIW1dItFSNcx+oz8ArUTVIVf0reHKytwOe",
-	"v+g8f9q+CPvpeCDj0ELVbb7qEUzhTMQPZJF40aPSBc+1Bbir67RZcGR+lRj/KjH+VWL88CXGylA+FV9p",
-	"8bRSQ9loL79Qy0PtNokQaFUH5ITKwCgSRxgKIkySJ5PWq+l69orqPPdmrQfqOj6mUQBnh7LJ8o5Sb+BQ",
-	"nimbb7l9QbPy6XijGHeSDGlEZEltt7e+sflMcnCMI6RnEw+9hH7OhIHlhEdp+WU7otfIsJnri9rwt6wV",
-	"Nb8hhrkB5izFvWjY0mJV5s8X2by7sekzvwJbTmRQtJ025ocnSsRTANOko7l4K8gfvmybrVPXCd+IKav7",
-	"CADF35AZ3lYhTtes58tFsdMXGgSyP5gN7lcm2zhmkZfEmM1E8x/JdqK7xw4hXzDqJ3zUW1sfIXHw/BoN",
-	"uasMPPG27uKe/lJ93D9/pjIPZZzsivAbJAKfYjDD2dVTDhGMUbynGYxE8KuIp9hAsTrDusO9MJDEYNn0",
-	"E8aidPKlp+UYaDzV/CX+fc3KE9lWpjuBtuS5YJA1xpgDxZ3wymQdxSviWeypV8RLpihkOluSxIH6mm6v",
-	"ZWTUxmTN5wMIc3JEbD4zCg+MKgyBsFCeApDH9rP2nvJQs8ozZh9y9AofmoIZSWTTyzGiTBVnuDL2q8KR",
-	"YkyZg5zCkI8fI4ke2r4IW63WRfjbUYRilZZMe7397//8N3gioHsKQiLXLTrLyRRw2k8OhwZkYvvbv4nI",
-	"UIA9pEpnFbn3I+hNEOiJ49gZAtU1D1A8FRc9qE/p2tvBzu7h6W6r1+60J2waGCajk8OH4zq5897tDn+V",
-	"bwuMsLPtrLc77XV5nn8idncNRnjtqis7l8iAs7WEClNWSANLHOEQQBkyi2GoO+4SjUchxvm3sheQmDi7",
-	"PrCihDh7ZS278ubObfbyGRGvVt7kJSG/39VjdTePzbl47LJwvUSv06lpH67bhttun1myscD/hz3YRP+I",
-	"BdfKLQfjAHjCzMVudXt+13/xvNXZgn5rY+h5Lbj53G9tDtc3N3sbW+vI7z30YntVi22aMMx3tCjfMVS+",
-	"+kHUraQswL0LHw2T8RiH4zYfYEPSom3SlGbXqu9DESN0549Qd7OAAFox2vxxqvrxyytnhH9cIbo43qEM",
-	"dyvJdMmdC2LrrSwRTbNOayQGQ1ERaCKTqyFZtlLT/cwmHeXwqXxULvJL4s9qBIPRfeqfZSHRoKHGnVs1",
-	"Xkus7Z/1oudn7/r488uZpmKmsXjJyist/JGaZsqGU1QOCnFJk3GMGKWoC+NGVwxFwKviNaoGrxplmm+g",
-	"V+TUyoankmvzV0vdlfT4huVWvsTzEKWjJAhmqQT6mUXtwDS/bTL2zk0NTnW+co7Jqd+qsiv12fqFLcvS",
-	"hbUNDMzynbFlU/Mo9lEsr9ZAgV91Uyx/qXCvYHbFmqyhV0EC8SPL3JmN7myn/KquB9b3lmQIrb8TuK/v",
-	"RKm7Frh0SOu+1u0i3RAWMXmyRT9qI2WUEXPKOaFw86ZCQj3ZvYlQjIX7HTytMVnk4Uujj6CNfXINJxtb",
-	"HotZG/amlnblkd4pQ9Qx8XYDqdtdGagpWZWhU4/S0+uPR4JvNhmj/pqzh6Bmue16RxuTs0U7rN2m5/Dv",
-	"JJ0HiFlb0PK/G40zhzPwBc1sdC9fzeh+Mb2RtQWwSLuNyuYvQALur0wCbcjJ5u689XK/h9hztQOL7rlr",
-	"V/77iDXYyn3EHmQfO99TqohGiT8vXRg7uYwgkDbXHCtR1OmpTnS6/Ep9WGU4vlXjlugmP7ww9vRYufKL",
-	"9AqlZS77th5HzN1kRUCgV6bO9pOR9eqq8uXS9WsQgW0NvEzqi3tS8BQHMDaqaK6wj3zA0A2bs8RT+ekZ",
-	"KRiLhZpRdMPAKIbjKZLH4iniRmnNlVw/jQ2fkYJpyOdaXaar/i4mdNbosKkFLcq6uVPPiVSz1s8eNKwT",
-	"Kyuy0cU9kcKjK5SKmu3AbHZ72rf04cx2TSV2c11JJUbAMLWMv6vBXg+evrBI4fHxkOpGZ2v+GA36IT6c",
-	"3U6tpHgP5b0mew/W6/DshkYwTQKGowA10+Hy9rIlU4vi/tO3uoPMg+ocFYp5T7BPne8p54vNvxoLfbN9",
-	"1s8v7esI8D7Ef6sbFN2tGW3WKr0elu+4BkuXdlY4QvlupsuFQxULFI7GEBxKG0D1PZFVFbqP6TZIS9sA",
-	"DtNrafQ1UuCV3CyRAwjJteo0ajGoVHGcJeF0ryM1D+nd5XFefd2+xlVWLvJz+3vzaXhFDNVEseSkmCqq",
-	"TeGpNrOKCublLDW57sFav7SLXbs8wtjqw2iZ3KpX5URA1buLhM1ou9Sc8R5EffnwLki+f6Td3lfNy35M",
-	"7sDKCVXaQPYa/A+g9ybEuVI9oP8iMDwnYcAFZaFDLBjOwOBVGxzDmGFxZxKJgUzei8t3taRKO7zJTpjt",
-	"i/C9+Ie+O5aE/1iiI2aeQ/mI9+dPhYgFtEjTtMZbE28KDz+tSSNoxaSUFWQ3zHulpU2iJpBEWGfj31di",
-	"u4tSz8Ob0A1FZ4qvnz5jAgHF4TjQnYRXJCYnmDIiO/zX+p/qvYLtLsepo8zXavzHaSLXB/3Fma7UzRXF",
-	"7JmzKweY4+pWeraqj0PeHlm9p1ta0m7o32tBC/ju5BF67gs4MfLiiybOS86h14zy83vzOYlw/4CwIZNi",
-	"3bPX7uGIzrDUyINm9pMgy28oJkWnXidRYejrhs8zAEVHWm3EiSxoXb/vvIQTUNzb/38gV8nsfWwhWd1S",
-	"QbfZky2Af4B/VAlhgakUgI8oY/PImPKk0Hg6Y4lleFJTcl0MTb7TtgbJDuT3KzzY9GPaKDfoSLSSRssL",
-	"dQRcJKCmN+lRx7+mmlo0nSryqY5x/dafc8byt4G6Nb984tXVLZdgVr5p3P9v9P0e4QDxwS7CNGxhdK63",
-	"hc90H9WHEOlq7+2hLnmedeWhrh/ex/x7MaB7303Y+VUH3Izj//d//hsodpoqbimxfUkTrd2K/9WXp9dG",
-	"8ebKhoswrQyWbCPCK+Ja/SAZV5cHa+ZezNTLAd4wkiYBzpcH38/eeMTEoDajkhhqqoEt22cLRDzQxnV+",
-	"CdAVClBJ82YY7xG6vctLLFlbVGlRvzO6UnCnTFBA2bYWr62EnN2H67PQ5FWz5/NCn5zhKfqThM0/k+Vc",
-	"uh3XYl/tK1Zr+lX6/r2FxX9sZ/cFpIjZRJ8zIEM3bM2jVxVOpJrxs+g27KofKPRdhTBX4Nfl+HQFri5C",
-	"27Lcwh+74o8a1Z+7rrE9rjje7nZ7paG6+aEkanrzh+p1SkP1bEOt54fq5YaSR9LdDUt8tSSWxS078haZ",
-	"nzgtbYjf5aS7PjxRHzJJj1jI4GR6uUVFAOVUD/pDTBdbHKYgUjL6qu9K3DRakZ2fWQmpPWC0IgV1DrnI",
-	"Dlx5qlnGHig0K2uDswmmAIV+JCoxMQVRMgywF8wAuokIFe4PI+l3tMKWkA3GKiyKJW5oEokg0VYuzQOl",
-	"a26Y7ioYoosfAfqpDJfvbIL8Ur+/1O93U7+qi6SQNaVOh58uOcnbGz1+uuTkXVTfqtthUYvLr61iWbfs",
-	"qRDCDSJJQiNYO0lWnlAxVjP3qGnWpC43dNarLs3HcxXQ22wv3ruut2m0ruM/vmPnukY5D/NOiwVsifxe",
-	"/ArLNojECdTl8GZjo7l1x5W3jNgyJubuLpo3WfTWErtn3NjpzBGiPQ2Ta7i6UDZmdcp5Dpg7/zFFxoW+",
-	"vo31wRoOr2CAfSizC0tmIC/CQToMrVARRui6+hxXNkxBcyzFLILcsiEBDAJbe0PzeVGUZpdwcRQa74rV",
-	"1I+W5xBZ12oMODdcXZ6x8hKyukXo6qDs5FEKQYWQuMuJiXzLedt9tg0WXXeTzH2WUm5cXuhSXhYKgxEI",
-	"CcC+QYrcoEjP+7piXjWhvqooYxO/vVwzvOPcQnLj/dLX8/W1QRZztbYh7ObGqpravWm4psLSNeJXD28m",
-	"ZhH+ZcNNj1CTpVaZJdyUYvceZTIX4XlEUcyoIUGAPvGftqGghnoajMyDK8AniIb/YADdYMpcgFkqHfRh",
-	"ptIn4lWaezdKT9eoToZZq/W06Yzou2IlNbmE07ShyrLFN6smM/MWTEZAIsBcvS24arDVqwreX7K4GZdK",
-	"IgRZWx8Lm1pk8Nqt+tfAP4rfoNnKKls04aUWZm3nu4x5Fks05IFvWN2iCSxf3/IzVPU+/iqbWvp0lzYH",
-	"9hFrTHH7iD0cua3OjU4lZrWE/MnPnHGyMTa2QqYVArlp0NYeyjVvCFJRXHG/ni34KS7+K96G0u09b3fa",
-	"nXZ3+8WLFy8sZ7ZE2/qaS2jkcz6zWo3lhJRIqlEQo0AYI2mnchyOxQGS9I4EdSuNbG7dvgg/vUUwDsGU",
-	"xOjySeUFOGtjxPhYLZELQf6aGGWNXKH4CqPrp4JpVORWtcm2HuQqgymvCA3H8k4bEQTmUKoq8KXhU+xn",
-	"BVBlPRsCqIqsc7nMxmBNSYgY/obWfEgnQwJjXwVuWj66QgEXM61xgn2UA1C5QA0BNHyaJZGlR8gBkXJM",
-	"QzCQcVJjCQSZn1fQVc1RkLvLu/8XAAD//1EynL7i1wAA",
+	"H4sIAAAAAAAC/+y9+3LbtrYw/ioY/vbMaXsoWXbitPHMnjOK4yTejZ3Ul6QX5xfDJCRhhwJYALStZvzH",
+	"9xbf850n+QZXgiQoUbLseKc50zk7FklgYWFhYd3X5yih05wSRASPdj5HOWRwigRi6i90iYg4meXoEE6R",
+	"/CFFPGE4F5iSaCc6mSCwm9Ei3ZPvcSBmOQJ0BMQEAfUp70dxhOWrORSTKI6IGqccN4ojhv4sMENptCNY",
+	"geKIJxM0hWr2azjNM/l+zug0F1EcyRminYgLhsk4urmJoxGComBo/3kTvCEoCP6zQOD09f5zgFNEBB5h",
+	"xMCIMgCB+bIFxHLcbiAONodHvz86fL7388nxu8dHRy9e/PLk6cvtF8N3DbDj6Lo3pj3zY8JQikX/hTef",
+	"e9zD05wyoTdGTKKdaIzFpLjoJ3S6QXNE1FZhWv57AxOBGIHZhh43upFIylA6Ruwlg0TMRVQDR/pDMJZf",
+	"tiCqOvb9IKuc7S5RdZxMUFpkaHWUAW6GWIw7b7J7xGFl1jvC5Srom4uvzihKCi7oFLEeTrvh5HU5/l0h",
+	"gyRZkaJ3FKe8iRbzFFxSnAJEBMOIA0wUQ2WI55TwkpT+LBCblbjB/sg+PlI0gkUmop0RzDiKS/xoxBkM",
+	"XFCaIUiiEtRDKjcmgRK2o1WPAfEGAWzRSQhMeT+HITjxHZHAL3LfXuMpFk18HhbTC8TkHWp3X1DAkCgY",
+	"adn2TA0U3O/NwWDgbfem/GsKr/G0mNqHU0zMnw5NEvIxYlEN4DejEUddIeafcN4CL9XjBAFuQmvBGwTB",
+	"U6jeT9+w46wYdydOeZrUpy20WB12HgH+g6FRtBP9fxulELWhn/INN4CEVCFh71qd0H0i386QCEhU5hVw",
+	"hUlKr7hEa8HhGIEUCgjEBAowhTPABc4yMMWcGzkrBueCngPMgaIHlMpdkOsUeIr8ITAHdnZQEIGz/lkb",
+	"ZaEGuPO4SoCNqPFe4EzIK44W+bOZ/DA01ajykj8NTFMscQOzt4zmiAmMFN+sCYJxDY/HWIIM9Lhq08dy",
+	"cHAx4+AKiwlA1zCRuBTJpH9GzsipRNEOOP+fCih/yGk+/BOTvBBnxWCw9aT6eEpTlH345zgXvcfnCpGO",
+	"fj9H6qHkFfJp5BFwXojoxv1NL/6NEvUDFzP5ZZQilL9xv3pYfN16n+rnmIwBJKlbK5gWmcASEbxQ4/Hq",
+	"Wu11+s/B5qtfn7z7eXv38dOfnj0aPvvt6dujzcGTp2/f1lYVtb/Zxp/KK7Xc1S98FXsoPdaImYfRRXj8",
+	"H/PjP524salppfH7VutZM69WkIQFmoZp3fwAGYMz76QxOm2u41hAJuTZRz3FCzABRy92waNHj57KczGF",
+	"on9GlNzB8SVq5wYjOXr48t0abD3qDTZ7g82TwWBH/fd7FEd6dEnPdvKgAqfm8fhDTSIaSREC8BwlkoGn",
+	"AAKOyThDAI7HDI2hkMwyy8AFMvckStV5RzCZ2O1Sh0KtXvPV/hk5N48Uz4SAIY7YJfKOziXMijnoGAd4",
+	"lcPIH+bsm+V+iJfeyxMauBtIuoZ9FHTRLm6tvIvvFXaP8V9o8UbG5U7q62nBfiqbAhGYITGzNoaSKnJ5",
+	"7Fs2Xm1VO0KuSqC7Xu3eOmtrP8FT9DslLbYSRVOS4CTwcnq7ELWjf1GCAOQgRSMsV23k/v3h4RDIcYEc",
+	"GDyHAl5AjsB3EyHynY2Nq6urPoYE9ikbb8iBenIg/r0khwbO5YCnJ7tqQjWfxXXBUboIR25xQTkgOj3Z",
+	"rVwVwyliOIEbh+jq42+UfQrSjdmoYYYhX8GG4/ZZft8iztWmuGOFwszWL6dby/VlhtX3l/lDSpg/o9ky",
+	"WpmFbi6m7Li3V7IVtFZxVfzvGUyP0J8F4uItoxcZmh6Zp/JhQolARCEI5nlm9LGNXL/53//mlFTmlusW",
+	"EGfRTjRBMEUM7OoRetK2CCaQg4Kg6xwlAqXm9J1Vhr6eZmeRpGcBRcGjncdS6xBYqJU9gykwwJYrKxjZ",
+	"MQCpTd25gGmPmbduunIQs3iNoOrm+bPexJGWg4YZQzCd7V1jLvhaMJdQMspwIjAZ7xHBtGieGgnv3cHg",
+	"eLB78Pu/jn/ZevTy6cHPvx798vbHSGlcUOoR8m25xzl6C2dTRMS+/DTHHx+/YcNPk9eXMzzB9Gm+vTl5",
+	"ivEL8iwqybYktN6mltzNHhqTAVKLrGzK03JTzEtQI6R8uW17zAudd6Yd36HN0m+DPTfJIRUvaEHS9VK3",
+	"YgaKl4/k4BXcPC5xc0gFeGFeaMMHoaKnB1kHsZYz6rXvS9AlPaA1Y8CY4xUOcDmJh4ntwWYVE/uV1+bh",
+	"wx9wXVjZr455QukBJDNzsPl6kSPZN89hggCDAmkTAEDXCUKpvPQZEmwG4EggBjYrB2vLO1gnlAIJomU+",
+	"8w6VvfTXgazQvJqbqwvjSALfG0rg5xmfOEooSZXx6QpiAS7QiDKkl47JuB9SP0tTkoTqlMBCTCjDf62b",
+	"dqWdRiqSlAFMLmGGUyDoJ0Qqx9gjXh+SOZtQ+K+tYydOawOeurtzvfjw7mTEGGWVQzzw8eDe2zPvtePC",
+	"vromTNQgvHGjKqLcZQgKZFx29rJutVYZC1ldRrMsTSmg9g/KgNREcSJ1gxFi2pIHgZWy+mfkBWXAoHUH",
+	"7L497b2iBeMxOJE0xWMwfLsPdmGW8RggkWihPq8YzyBLJvgSpUEtTUxQBTTzbgywAAkkkgFfIO3eQmlf",
+	"X32SutUvHNDqCJAhcKG1uJReEW1zlG/IqzvHesXyJenyQGl8RqqOs8aA0rQsf8AM0CQpGEMkQRxcTXCG",
+	"2mDvn5E33rtyumSCkk8oBXAMMeGi8uXVBBENIlTj8EIu/2qCk4myvl4gkEGBpE4KzYsjmGUgLdDOGfGh",
+	"0t/OBw5As6ocpcqaC/DIDIr0oJaZYQGuoLcjZ0Sqt/58cnNmSJTzIlKftiCVifWb/aqVzxAsQzB9Q7JZ",
+	"m6fIGOCN7UYbzPgtjLZvcv2RNs6Xphhtc+V9cMrRqMgMfsxbPKG5WtgFozC1m3IlLeV21YLB5JM02Q0T",
+	"gS8dNhRpQTPMtOACTOCl3iYudXMzrSRQ+09lgE+xPJhWPddahRrJAhwDeolYBvNcngv7LVTX0b8VV+nP",
+	"txWHjMMKzLCf48QhQ8FXpzPOaYKhQKm2fEuaSVHCEOSI21N7MdNWmBpgkbqn+EdBBcya2p1VHEMA+eYN",
+	"G2lRG3y4b3hWaOSCYPGW4QQtYuOn7sWbG19j/UMD52PuQwCvmpVrOT6kSRt9g6GcIa7EObnzUkM3PiTg",
+	"3lE0dIEkxvGYWM6tjVxnxKreAX7sa1Wdz45HPUtrYg0sSIKFY6S8krybivSm8omv3rXSpzwO5i0gJtJZ",
+	"pRFXcI0sdVAtSY4o07hqkoak1LfK4tcN1FPvgzqRWKgbtDHHK7F2n0SVDFVkhidVVLH5UqMHMuTwhok+",
+	"zuACZpAo0cEa/RLfZdEUBKa0IC07pp/J4XX0D9iFRFJ3TjlWbJQyQNAYqn8TJYjXDrhy4Zb2Y1pcZJ7x",
+	"WH8i9zNRa0+HLYAo46hkIwoOdQWaL2rzLeWIaLncSiozt+osDNT+8RvweGvzR2BfA6LEGeYgRYROMYGa",
+	"X8S1p1gKQlh4/l1sTLVIMgizxZgAWI5fl6Qu9JQJ5cLyWT0UHZU3nOb4ajKQSyZpry07bDmbetV86FhZ",
+	"7W03UTnefBZ/evw8dILRaITUXdy25+4Ftft98JbRSykfmuvWWq0ThDN9BBx/Kc354LspJoVA39+GTAJs",
+	"N8cMalA/RzDL3oyinT+6cCGF5z33ueVHH+LoimGBSmKcH9V4MkHWvGt3S9nG3d2vj0nz5o8lkUFS23Ja",
+	"lIJSok+4YisXM/mufRSX8rBPg9qm4CblC6hhCaP6TfwAbsUcMvNpaBP0U4PupbfgFojJGaYMi1k12CcO",
+	"gWjetPtiOLl/4id4PEGsfFNusVLoUQpGmHHJj97ah3JF5QWQogRPYWaYP++D93LAjF4hZn8DmKTKNEDG",
+	"diZ9X8prqqrLxnJoD95NOduUymuOjSWilWBffWerf0beGyXHKJscSbkkswQJLyHO4EWGnBNRyfbmUtR8",
+	"mc+4QFPAUSbvSf+qkeuRfyrQuXBzK1crSJQEfaWmNtPxiYTBTeNgzdAlymJv6CSjXI4ob2/BQclVKh45",
+	"twP7hg/LS17u5RW1MyqdRft5EpjZGTHiStD3xpVcjVcWrGYquH+5Kgr2blgHQOVe98LItra350eRxRGj",
+	"WSbly26ymhZ+7CfuVHb+VEWVSyExT5cUKjLIBTCf3aFkUZM/1dPYSmLVq7Fy24SUFxV3v6ThyQ/YPzZC",
+	"oqaQfx2/OQTHCqVV7dRy4YqW2hMFu6BRbHTEaCfa3HoUinNSXqXtZHMwginqbSZPUe9x+iTp/bT143Yv",
+	"2d5KHj358dFm+iiJ4ojTgiUKW9oI1jM6nJRmLxHjegmb/UHku5NqLkg8rW/Z5o76rz8YbP5eQmiyCm7q",
+	"UvH8S0dvapOiVCAeyOEsozDtz1HvWxAXuoAkJMbQao9BbTP1w0rmhfzIhK+AAynHwVSxKEFVxMjW4PET",
+	"GzEioSTy4P5RMeIq4+0Hn/4bT9Whf43IWKo8m3FEikyx2VZxWkLlRw1UrI7WTa2Zr37NGFvkYvQCOBC0",
+	"7x+6guHl4cDpwvnVTlZ2sCv5VmFpzG2pe8H8asevhRS+tdEREkNdE5jniKAqedXPio+fHkMjpIyDHaDz",
+	"z1gwfEU/tHTmMxJeYSQaaodKecfwKsj6BC8CqM2g8Fz9dWHJRb9WSTxyiotBZeVZzmhaJIiB71x8hJJ3",
+	"9fZ8X4W0ylsWQKxZTwN3eIq4gNNcguFssqXdttzW0HmVEV791suoxtmCF9KSJyTMaao4t/xGI5ShDHox",
+	"x5ThMSZa6CtXWV3DnIwu/3ZUSDfHpkqhsb05Oxpw9KHWF2ZX800iKVx9yDd4+qk3phuXWxvqBwXpnktj",
+	"W9L3Y8+zQmNawaxieBazToFvZNmZ370rO2Tf6WpZ8aC5L/NKBZqm2dX7u3p45UB1wF6/PgCaoIKm5EXR",
+	"5G03uodcDwC1Qco+YqPHq3d9dQfsHf95US5DRSbUH4UkvpYfVsjMrOGw7UB2l6RrRPRFxWmz5V14giKo",
+	"PS8hdS2mXTe0gtB4irtbjIIO5pv484rn23k213O4OwlVRwimlGSztgDOW5iKFjKXpl/ynb42+FzJxtmv",
+	"wg5IK1RQpv2MaAYE/GSNlbE14Fr/pP3qCjEECLpEDCQTSMYW9y4mfB4hGBKoLCK6aUWBCSPvfmh9yljX",
+	"iV14pTcZ24cvGx7R4KoG7c+0P6X7uTXfBY7qRTlUczs8v037mejqTplSggRkM+X1WkRfB5WXS09gi93Z",
+	"Ohh80pFkn8jPtBQ9Lo2L9rzoIXVcGm+sqtOiCr4cUA2AVkJmjXTtHlpwSmwFKLqkourhneO+bzAcFVbg",
+	"DgIxnAbIXBwA9SGmJCT4LXSwlAzATCU1/Jkaea03w3ojRE5KgBvsNkgGWMfE6G9WiLuoEYCP1m4O4yAJ",
+	"rN99/IIhPiGIB+63V/SqTPlU+rKg8mR4SaEGaWUYKQ56i+0opzKGqznR6ZwsU+3ul0Si4shMCgyCLMOo",
+	"9F/mkAlFFnL6w3J7CZXi/ZghztVFxZDEGUrBDIk51Lr1aGf76VLUWgLQ4vKxUEgHu6DyM6uo5YiV8FdF",
+	"bSBojpMlLv23dpxyW0OZYj5lepCH1IalkqT780KAKEHmHqztvhTvvjslWMpSMMtm4FSP+xpd44SOGcwn",
+	"0kORzcAxZUK5ZJzdi31fE/5ePtn+/cft7eGL98OfX+1tbh3+Ntj95emLV5ImoZD0H+1E//8fg97T4bPd",
+	"53svXr76188Hh29/OTo+eff+199+//B568nNPwJb/Ll9ZVN4bc0kTx7VrSb+rLD316D39MN/f/c/Ox/d",
+	"H9//EJjuQxOAaJ+MERcoXcV2PyQAm8+NuqXsANQGzqngYm2DU3GrNTM0slMuY9BfwoKffjkLfrlyHSrc",
+	"yJnQuYHadNO4LS1e5h3KPfttYyqfpmyANzKGpuW0V/1V6ACXAWrLKJDmq9UVRxOg9QD1Rg3ZetVGgq7F",
+	"EeJIzMWLvS3RdRmmnAKGPFnEoE0moEFz0eoM1TD2tu7AENJVzWqJbyyY8RKHFKT7DY2bk5G1lEmvmqsV",
+	"tyfDmdCKMhvu8Pm/jrYfbe399PLk2bvj3a1ff95+/jjqnND2nQnS6LcP9r2f0Ca4UNzKDArKweMIEy60",
+	"HqmSIEzi4U5GE5ht/OvgTZYI/vO7n3oD+X+bbUl0u2a95c7CC1qInYsMkk9N/hhEz2J/vI+LptgxKaaQ",
+	"9OSilSyArvMMEn13udhF5VDA3PeVWFFRU0BVVLmg6ayMwtX2W0eyTebjUNkE7vRoHzjXmfZE4pqT0sLY",
+	"EbZuu1XzbTZgtrsZYk6vTk7eAv0CSGiKwBgRxJRj5mLmOWaUBcWVwumM3ccVjRkT8Wgr8gJBtp8+9QJB",
+	"1MvNUBBDf018Q8AnlIm4ThW8mE4hm9Xg0k6ACnqDubqLfFoqS1i6CGUOCIBq10N73T7t3GzgRdsZNl1r",
+	"HLmtdkdomQDluenCd8Whn7VZuJ6V1q0yA/1W+qXwbGZS14RZUmgXpDKNzFE/q4qlHGdkdaxasSWVK/OJ",
+	"0CuyVhVTHzVRhq93k+e8aNE2C5ipgdGOG0w6ySdl+KnZuAC/eVEJMDbT8eXt2maGpnobR8ps1w7BSRl0",
+	"asMVjfu5QmGdgPHCx+YAJM3jSjoMAyMfGylwroh6W3Paw8gUaZGPpSptCOpezMJzc05qgSHh7fCFq/n8",
+	"uX4o6iRaQjPPLmy5pRKkdIZ4qNSPe1jhmtrZUXEPLOFlYGgKMVElrfRrcqfkLWvEAxfrrUtJyhdF1Tkx",
+	"DsaNh0zrzSQPx4cWKp12Qlvyx2JCpVUwfGnZvNJK3bB3Gq2wIB7feR10VuntwrsnkA+TJGjLfT9BYmIi",
+	"7i1JKCau3rcM0O2jXIy75gyI8nW3o6w8hGhUN6W2JX22V3U9Ka8WOvKBbBjrfeAJXUWZb6BtecfVBZpR",
+	"kpZRwCg1KTo1QELkbBPm0KiFmi1i/QnL0o0FR+2scC7DGqzKBVtoszv/K7mbXxu7pNbY84/ZzagiqsYF",
+	"ukm0PqO8K9HVVcBpsY4g+Xz1BDrlW+uUQAf2R8DE2l5kkrGe1PPGQulYJt2k8kc9Bc1LJYsD+WdU8pUr",
+	"zFd1Oi+bLWcg10uLu8PeMcHsNvlTarMDyTu3Y0+3NnX6RLgug2feKYnW6h6t8a0Bi7FG4l3ZjbvnhKiT",
+	"7XJCiMluDlKEfxxK2gQ5xMp5EKv7y2YRuQvFiERdCbYn6y6vEHpQFlczu1DlwhrW+eKmwcPOZxfz//Jo",
+	"eHgSxdG7N2qQo73jPfmn+vnj6fHw5V4UR8ODvcPnB3uHJ9WMAPttY288ZWo4RSQNS7juETdBUQqrArEp",
+	"t8UUJCtQcp6u9lFNCY0BFCBDUueixN3s+ntrAaxEW3Xn2wRd2b2vps2FZWg9C7dCtK4Ooc2G1OPWouR1",
+	"AqTUFOsYU3cF1Ahla83J09AivMuh3Nxeg8zcPQZFwYZSs31lJEoMjOVcibaEXt1RInCX5YYTgREfzqEi",
+	"L/vO9xxpcgrC8mg1fjhu6+xx4mHXNfFY1+1xiyvNUeN677NOqsn+8/56Q02Xt8iEycUOVaGTGDCUZzAx",
+	"pujgO42wJl7kUjg+wcknJOQMvZYUs5tOIniNmd+VFF4xwK2SXO9M3LcLvwzh9FbxguHowHlVAQKNAewb",
+	"1s6rbslKf56gSb31mkONEdXLIMOfENjcAlNKxKRhltsKuXXSoqyI0GUi+76eS01k5jGCyas3p0dRHD0f",
+	"/hbF0fu9vZ+jODp4c3gi439+2xseRR8WKasOpNjgoF00qpLOShEWlYotTeJbcFXUkrQ7XBNrsmrdgoEH",
+	"gXtozDuYCaTaHaVzHAouqMPTC01BNY8rr1DIYSEGZA281pp8Tjc2lfJUOTgp/piKfGEvyH+V1j5IZlPa",
+	"sNZ2rPUWUkcUuN6+LWRzR14lgEBRI2ArBUif7AiPzREOVsyC18MWEf5Ae6Q984sdthLKUkrQSxYYsIs4",
+	"sck9DR/uh+6XqsPInd6pFZDDrgGLeQmAxFnBZS3DHjg/2jsY7h/uH778ODx4c3p4cg56wI7naUPOcNoD",
+	"52+O9l/uHw5fh7/oaULV4syoyEypjqrp1dwD9cmjOKoNXlVK6w+7N0KroOhON8O2XGs7BGXlS8V0nCpM",
+	"CYCAIalNSoTb12Id2KhRoEVEeZ9mMwCzjF5VHO9ushVNmXSkuysYbri6nLRszS/Hke8rO7Va5Wnl2k4r",
+	"GiJRBcO61tudVBK6rQBQ9jf8W6pxa3WsE3QttKLVqSZaJQxVb4agmm7uMMj0NsWnKjR9y5JCyEasLTqe",
+	"R+Wb/yGliCq87oHUJCq5YQX3Swg67ta707u1XcAxJ2SWG7FGWbelZLJfr515US3lSMqCh9Z2VXJiBVRV",
+	"ZFEDV6US/VNIFpEfydbKXH5ZW4fOyCwlaL9F5XOUyTybFm9bap7qe7/SgdPk6FMAwRW6mFD6KSgKCIFU",
+	"MYXg8MQV3XcT2Q/AFKaoebSbB7hy/a+rMmOXLI4mLl1eB06rwCx3lcqTOtRoWOuq5Lguz6S5GSrNxzkK",
+	"JbcweyHlAyzACErDeEshXfn+sQoz3aVpy8HxA4rNNLahj1U169M2xZKtcDiwf8+vind5/90F3pnreLsq",
+	"ZPNitfUzi1B7jjRrert3+Hz/8KVkTpXTLD3SeqEojQFlgCHBVEs8Ac4raDjXetjx6e7u3t7zved2KHPk",
+	"zQamriI42Lq+9jdaf/5iuP9673kDDAZleoiOSzPHvsoBDfxRHDkAojjSo1UZo/98ftmRNW1qwbLwfljM",
+	"IJLmFBPhV5Lidu3KuFK/hSdC5HxnY8P8ou4uMxovL65lY8GVYYXZRsReD025AsvqvBhxx7G7XcnBu+Su",
+	"buYms20pp6oq5wFubijhbYsqmgWbzaTl5giGx+MyuftW8ZDmPF7Jwj6toup2JwW3ovitykJMWNq8YNuq",
+	"p3o+5B1185BWeDpXGVT0GBuGZBz4jmFwRNKyJOr+89uprOu4M9bA28uIu0CpuM3QJ2LCEJ/QrCplbHXb",
+	"kg6BN8zqDLUDseaQG8vlnw1fDw939z4+23v95n0UV//++HbvaHfv8MT7fe/XV8PT45P6FdD2WQf2aCB1",
+	"XNJoLX7DYRexWEYm2iO1PKNcrnbcbbjkUdA8eFjnflwzxBmAVUZZC9p2XsKEUc4RBxA4WjQRhvZtyz5M",
+	"fYZWBhms9q1kEjlfltVeMPPrSg5eaQ6OxG2q1jWvg/syD3YMB1fNyIQphVmiUEcS+TtUYgzzchP8lgBf",
+	"xsjXwPAXsPN5vE3iha8XhApXbjd8M5AjliAjGPrpVXSaQyMZgiPDoqQN4bzC2c5VNe7zILc714e5oTKF",
+	"LoZAEUHvkrCMP7AWViCvDZXslVcpVGKFqakltsW9n9xsC88pq1iz1nI9Ld7XVktQcO1a86ptWa9yTmWL",
+	"KZTRK/WNI5uK46tlezuNY+nL57AoPLy7SuXQh9Qf2Uvj+CL3dHdjZ5h535O9cxlNUAOqwNkzv3EgY1co",
+	"zS9g8ikGOcOXUCB1xDNMPvVUfjeAacqQvnBNlTTlkUPp/aiQRhySC11e1jm6S1Ptm3qzqVobuEYyjJVg",
+	"+sA+k8m5oSxDSGbOLVnJUZUWC6iHNEcMM6BamJDUf0HNY95LablrNkUnJK/YbjvzfEdLsvImXRr3iIUD",
+	"5Ig5OaOhU32edyvubA5C/ie15pbqdGZurXFaCIzgYlffTdmcs+pusYhV4rkrEm3J831ti6VwJBYHqXQM",
+	"gKZmPC/lC+z7vTaND2JUmGTLE9d20IY/jzJK2T03QrqFWKnWu045LqQeLl+rT2/6XZHUabVrXbcgQ999",
+	"2Uj9P/XT/its0hOfMTfk5bKbUhfHoT+N/bTelhgsdYXJRygFJpRK12JkLuldF7Hndh79yAB3IStwQdeE",
+	"WtRrFpS64E0cHSARbsWoMAxUPdgqcLpTBBphgjiYSNGK6uLcirvD8ZihMRSu8nWVU9rHepbj04OoUSRp",
+	"X/drBrbqJyrrvpd1s8e6yKVfVu0fff0vR33/6AvjE+aqGF+9BJ061ebimOn3bYPM8jjLGLf9w9OTvWah",
+	"nspaFhR+RQKxoff+SjXZtQM02NUTnBpsLWw4gPwy+m2NVWxgmtvNfsfy5d6+tF3O5TCNHWuL2Zde21RV",
+	"GHoLxQSg65whrmtICwrQtWAwsb3ATFFXtbncpTR55eT74Gc0K/OWDPOUtJtQwrHKcVIBXfkEkmKKGE7U",
+	"04KkiPGEqsxzKGdEjLe0nplDiw1RAKed6kS6ZLgAFcwt4bhQOudzS1U2qgS1gqT7adwWifdQKLJx9Otr",
+	"D1BandDUCCV5VQtF/hdXlAYMv5gZBu5eMh9TBo5PD2IwfPcyBgf7h7FC0cHwV+CxFq55sI0DYAACvQ7N",
+	"iE0XwRwybstzu/agsjj36eH+L6d7H3dlhKY/bAxEE6Kympeeog/kEI1vSwRYFEoY8ZjQgKHB46qNbfDZ",
+	"7HwG+r58s5EpL8k3rnBjn8tVZlkgmqhDy/sHRgO8jWiSfxpv6OEUwI0LIGwA83FcxgYHjr6xN+gLdNeE",
+	"3/p7HcXR8J10Cx/sH8r/P/y17g0+WBCw6yNjWEHuuvHyS4HY7AhxFd0W9h3KZ34H8T/lJ/1Q47Q/PoeE",
+	"g1rN1XpB07baqIrIdSKOpqI9kvqi/FZFlDeEJiAT/ku+vC/lSsk02vUBQRdOMF8sWVPhaN10X8/IvWIa",
+	"U8x52dXky5b3sjGkbriHv/mdSmR5p4JehapjaRJaW6SGoC2Dba0w2FqYutrZUBZZFTUBqYXRK6vDd2Mb",
+	"D5lg6me7g3g9L2664/padIhyE5YpBKYRrzskzun1tlBvMcj9HEyPLG15HuLXTdF6pz7fSZ8WvbrqVP5i",
+	"lhBc3OFY70Vd73Myp+xT2UVDlfNQlo5w5Y6l2p0Djm2hsrL8X/+MHJtKISNrgh6HOrrncAZUYRHhCpBA",
+	"prsgNDq6N9rCdE/eaXaIX7jMFQOH5tVXURPbWirN8VdJr9bTtRc/CRr5qlSzfmNfoHfDsq0kFL126CWx",
+	"lJC158audNpQFGc7gjRKpa5PLqKjUWvlTP2sktDi4tQVaA1y2XpcLUX85HEUii92q6zwyFAZ4+a3i8NP",
+	"NRI2V+WvJXAOO3FtE30oQpJHW7GAodo/Y1wNOayMrFb9So8GuOT0egDd2tzLhpAuWpNn3CxVFsXrFf6C",
+	"0KlYwi8HW20PFSIVvMHtoUzATBlDQ3skzVLSDgVUP/xM25jrxmntMVa3qOzfwsv2kMYc9aGSTNG2Nl2B",
+	"IHXp3l5FgjZBBKeLzHit7Tv0iiM6/aiX9nF/SJ4/epu/f7813HrPfpo+/ffoL/Qqe/nrT9fT3V+vXvZn",
+	"238+Pu4N3//5onjy579H8MVfg79++fPx3l9bPx1xMnt39a/R6NftP68PLmnA6t1EUsOnnGvBVKVNak9B",
+	"qu2BXi13m9DDnV/NjOzvSRP9Tn9qJhVisq8fbtYUJnVX/lkg81iwAq0nK6ZbZqsOEVoc41MpXLGa6Nqx",
+	"CMXaLMdzA3PLKmpVdhM8oTrGWnoyMNe0wpAoGNEZF2qvpOO3wlruiOSXdYC29kSWXEkKeCc47GITxkur",
+	"ks7TQgnFdbGwevjk0+Ec6dOJzVAP5FU9lZPVq5v2B5vdRM38hM6TNO34wFYbKHIgaFmH1tovdUgaRiGR",
+	"dDWJdLiMNFpuxh1Ioqs0SRkC8xl4rtoOcNNAA3wne5H/+NPgRxlFMHTjgZIaam07qm0TlLlOegV0l5m6",
+	"ndR2TJnbwaNsZTIoW5kEGz/MbcvQuD++9Sj51qPkW4+Se+hRYmxCOuXUsqe12oSOKoUB6sFJPgmNa8VW",
+	"lCvYi0kJdsCEJJm0ZeL6RRl08EtJs3dUt3Il5SpvURrdGi6QuEKIAHFFvSVwHSL9fPibjDreU5ldKZzZ",
+	"cB9/9RpLOmZZFm8rP7hC6BMw3lr5bzlA4CNV6q38ShW0sZ81PtFpJ6S8zc0b6rAiVtax64FzWTuuHHeG",
+	"IGsdthpFPbcSXbkx9skCzV/vQGzJqWt0miPt9csKx6XYvEw7SmdZVwEB4QARCAquwwSQ6h1Wu/or/XB8",
+	"70SjXknlzblOijhKMc8zOJNROrLdmZH/gfq7i2r7Cc0aeoNfImZSXPCc6kIvsrTm9hN98zGcIzubepgU",
+	"/GN5iQZco43lf16xcstCd0UIf6uqmQsnq2yAP0t9LxaOtF79UG3sErrh4rpAeiKPosO0sdiD1SCeGpg+",
+	"HS3EW43lyGWHlEFz7ocZhnzlww/l12AKc+51qlFp3DIiH+iOsKZPPySUyDbAc/uA2ZeOuzj1jF1c1cgS",
+	"guGLQgQT9RdsdNekw+qi7yvh8BbB1hWA15s8Z3v9BrfH7PoE6ZmdIi4T5Ybu3wDpHHH9tkpfRdNcxacN",
+	"ifnS6uroGibCvirgJ8RBzlCCUi3TSX0B+t/4QzeogU0X5HW3UxzkjrrFBLVMUPBWUuuaItUktS9TDqpM",
+	"o26czAWiiz3kmr+sSWyx4EgwTwkWyobSzfhUtjupZS5jP9/C9A0A1Pp2vZTl8rC/7Ow+1lBAhsCFNh+m",
+	"MvHIUE/TO9vw9pYrwRwgrDpAQTDKoHA9KhAzC2Se4U5gxPiy3uI6yrC/BtOuyRt4ecvdsp1i/MpDIed0",
+	"WwsYBWSwyKaPnthDvDFNQvVE4lpNl1oVR6GjWm7DGA6lAY87xFuVwnN5+/EGdSJpCSE48Y2TFvFFLnda",
+	"ZVPo1IaymZAH3RKdD0v7YyOkq8YE3LZ101bKg7l+ZeVUMUCT3mcNKssJL3qISmRIDEYYZSl3JmJ5YDM0",
+	"EqAg8zqJsGQiW9IFLEb6iTyTBTGvNeM46oWYA06Yzn00CLoCI5wpF9bcLhpHkoJQqo3ipdddmxt0VXYR",
+	"rDgZrCtz65aWiL3UUWQvNPDr6aWAWJl7YbASA2jkC2vWZmhKL20xYv1SI5eyEqNGw8U7YVt9FgkMgRp7",
+	"7ZvvsmZ40HrQ4cCZ46AJ+w4O3ftKDGWN0r0gcW2+Ahz/hfywcBMaHPul/aumE/tChwBwD5Y1Wg+l+Cdt",
+	"LFjMZLXKqaa33YwW6S6lnzAaFmLSXLx6AQzf7svUcXnsQKLeVs3a5f7YvzSNRB8/cp2/Ua4V5vhnpKJo",
+	"1WBe3ICd8gJBhtgLe6BoDv9UwXkhUIJxBZEufq8g0IOV00+EyN3kK08rMdB5qsVL/PeVaE4UWpm8UOWO",
+	"9oxicImYwe4CKG6Ug1tT+nOaBGSF5zQppogIm2Wgiga4dH1HRn1MN1I5gHLYjGgo/ACRAy97USGM6M5/",
+	"Wkxw5l/p+NBCjuQe5YcSvSocgYMZLVStX5UpY/TfWAcS63H0mDp3ZwqJHJ8hjR5lCO31zsgPb3LETDqP",
+	"Ek8kc/rf//t/wHcKuu/lNVimfurUqUuIM51wRTzI1Pb3f1AMLcMJMl00DLkPc5hMENjqDyoI3NnYuLq6",
+	"6kP1tE/ZeMN8yjde7+/uHR7v9bb6g/5ETDPPKRNV8BHFkd3snWizP+gP5KtyW2COo53oUX/QfxSplKyJ",
+	"2t0NmOONy80NhTLFM9TP42CqN+bCSz3kgKEx5gIxacPHugX58O1+H+x579jIEivjNVJopWyRyWGMLEHt",
+	"JuynZs49mybElZ6mK20qMLcGg0jF+RFhiuiZECf5/ca/uQ5z05Kd/FcnAdBNFxAAG5erwgkd+Wjpy+8e",
+	"Dzbb5nEr2DglsBATyvBfKK23pL+Jo+0uYxxSsS/vC3kog6O4itSLoUHXOUpCoygzgHLpBcggiiMBdRiW",
+	"Qh1XFXpzygMkdJpzxARXMocboQ9045jaz7qep2n0hIUhJWxKC5xMTNaKRT4v8+Tc4VXDSCK7hBlWJgIA",
+	"x9JrqNUXvePxGTEDSFJUSdK2z7N+QY0wheyTImM1lKx8or8x5ULVTMoJqTvIe1+qD7yCGpof5Ywm8tLT",
+	"M01DtK+RteclyRkX5TOazpai+47kfhOUHavJxoUCqh/5qpCJ1brV0VwZxJLZGNhQak7gYDHNP4Op0ZYC",
+	"J+crPsOatLytDR3imzh0PWx8dmmbN/qAZ0gEJODn6vf6Sd+rHVVV/eAKsrQsCdQ8q/Lwa1oIHRM9kX9M",
+	"csigDltsrSZRvrLhlqPcLzL9q0bIj1tCxzXR6fWna+P6jwePF49xSMULqbD+51CcIYf5FBeHBY+XyCdV",
+	"ZSaUxBSghZdI3CEh3D9HG8lN/kZa80mrSh5dONkCGbdaJ4D7VtApAkwavvpn5LmUPkwrNMW4VOAa5l5M",
+	"LC/rAMgxFCM7l1KLISIJ7/lcsZcvTcVK6XnB6FR13evy8glVr9aqebiWBi7LxizMavHq21KJ18WyYu8A",
+	"lL1QBgPPrqH+cgFo+q85rU1ufQ79vGPXEME6sFuSLbXdSTur/VyYvHDVQKLtZHMwginqbSZPUe9x+iTp",
+	"/bT143Yv2d5KHj358dFm+iiJSo9gZNTYHtFefp6jpKKrzUsfwNO6UXFzR/3XHww2f2+mh97Ey69V+uXL",
+	"kWgh/MU+3dxKN9OffuwNnsK09/giSXpw+8e0t33xaHt76/HTRyjduuvFbrUttmvG9r45166/xVLanfag",
+	"puiiGI9l2Y2HI2TejYpX44LLqHka0Z4+Rxm4UNWnfGRK043VtJTFRI/rYnS9KnJV7qiHd/yxXSv6YeOH",
+	"aOfzTVzhEMqYo4H47xVu7eimfbyeWuR/z+dBXznrib9+htOV33TmM6WaHTgozq5prB6G3EEtbN4/QV4I",
+	"PaA6BEROLQdteY2bwdtGUfPAVGcY0saRPfaPrP7w2CpszlSSSDgyTiW8KloEk8ogF5hANgPm/AJJPTsG",
+	"LS6MyeaDc65tiucJ6v1wDiYIpojxM/Kd3/pRPvQI4TxWv+DU/EPTjvlDiWLmd00n598ra7Goo1zWJub1",
+	"cmVnRC3a1lEx6cOQA8xjcO6zi+ve1dVVTzoCewXLEJHO/PTcq/VCLpURA2Ci4sO8XYjPCCQzQFW4Q2gq",
+	"BTBDOWXC/KTNVVJl0hKfLWU933QT0HiPi0Taq2R30pm7Gh7QHfh46+niMU4oPYBkZiDh93GV7vsuiQW6",
+	"iV81t107qVaXbeoNtsjv0pqDLo+pKlOoWrJdFAjvmzc6Y7upSrxhKWLa5YyytEV9oPKlZ7OwAmHKlxrH",
+	"qfqjDE4Mp4SXruKmcJJkRYqADVMoEdoCGtYfmOCFNAxiS6zCrbWXTheN2fJlRNpy0Q9aCB2VxOxODlGu",
+	"r6kcFny3d50jhuUfMPt+jkjqXAzVcIPq8dEvWWzejb29ModrkB+WCbyuGZreu9jdN9cGqiOrJnTmkQv+",
+	"+2ZxX0DNetvtjnYm58DtsPHZ9VrpZIB3VHQxA5/QrN2EXtL9cveGA6ej8dzSzkOznN+VzXvZPZ9jBV+8",
+	"lS+RuJN9HNwnV3lYZu87MVgvTxS51AtDOQ9STImtOFONrYSVTlQ6ZlNZocPBgLIcrfqXedWPmTQNIuoB",
+	"kMQPF75AI8rkBFoxUZUoEeQF853yZi6pvenBgOwZIh+RM8IpmGBV/CmxTVy47TqhYeqDGohllHoJm4k2",
+	"ySHXKrWGy0UUj/wR+mdE4zA1xY8bgqHWfuUkE2gCVm38IiRaG4N63jmyRSVE99Znc/1ySTCEuE0u0WHB",
+	"tcpvKjog7Sil3Cs/sfkhD0hdfWg8SW//reQTv7pb6w0mexG4erYqxsZUWvPr0JbhNZB59a8hn5Fkwiih",
+	"Bc9mcfWbepXc80rhsHNbK1d/U1bKvUCqWK406Lfcpm5Zd0nDbpIAFXvFgB2Ov2bvgySTIrDmBdYTbYpY",
+	"YDzRyXcMwXTmQhPNh232lNdm3AbLrg6vbCB2rEotFGNPbLUvlMlkAbU/2PFDRcXqr+QEmV2Znl32Uwx3",
+	"N6+ns8xfg7qxLfDa0w1Vgc8pziDzStroMg4CXYsFSzzWn57Qmg2lukQ5EBgxOJ7aiodIXslllcyAiecr",
+	"MW15eYWefQunIcPWvViWNPEvY1hS5YRtEKU9Wl+7r3QeW1mT6UoJ9vqSrhbo0/O0m7PMFt6lNctSSVha",
+	"NFxJKMePoet7tWPNB89AZPH4kGTEDi4Nvbahpr29a3xPbg1rTg2R4irCo/6Sb6Dyg9aLfHeCEtXX2aT9",
+	"2ptQRV4rL5WurGD1EqnVjVG1T63KYrRZ9KXWpp2gHhBSsEwR0+qg7SNTa/3sbl0/RT0G2m0guaDXTIwW",
+	"wpvumdVw5b2ayLyPNLZF8RXWlaBKqAAMjTK5Rqv0TmGqsmq19mlroukQlTKFcQqvARcwQwRxfkYMhGqi",
+	"GHAkPaQwS4pM3zTnfXBsb/tG/0el+RLqI5jMnHXhjDhd2eV0UPpJ51NqrVo1lihVajuewWWQfcl91vTt",
+	"0dEiOcwvSyAoSOQgiyWvKjOKQ6Eb9u1QL/ubOASGb76fB0ap3HcDZJlm13dqymtuTiictXxcOyOWTO2B",
+	"+OZEWMR1FecTNRalUrY9lJYurttwYs205mtT+h3FdKZFJnCeoW7alK4UsWJsqzLevbaN0u9U+je+4ncU",
+	"pzy6T4lbIcinpa7it2HXJiv9a5e75xHgbYj/s+3Df7NhLqi5Rq2aUABL9b9S06lhW9J7baSAFeM1zBGo",
+	"lYan2HBb009Xp8IaGPkOcPUHpKlc1rF99OjRU6DLE/TB81p1hLO2oG9TwSB0Sd2mpPzd31kW56GTpE+Q",
+	"8z64HN+v2yG1mIbXdKDUme25MqOL7XVMlXl0grRXobQKZ7siXLt4jt3cqx+5+74LLMzL3AU1dPX/A3h5",
+	"Ce26zCawlX6k/tQkHzA0r2EOMOeFqnqlm0M3Ky4zV4E0PiOqTYicxlRHMlVTgSmLRUfeALziolReDj2X",
+	"LGuGsgwUJFN61gTN1OO6C9NrGN5u9KlSzi2Jff3GoyB5h400tZ37QgFRHQF+WQX24cVIPbgLyJ3VLif0",
+	"bu4h+6iyu11DrGpgX8zA/vM+OKRgKg+tkVK9U27rJJoPXL95a0M2r8kvPqFctMdsrfecd1WLajjqGPdV",
+	"OxZ/l/CvKm2sIQqMmybq1ZMyKm11mvzmqRwPkGAGX5hL/w1iziCQcQ5ZnXbWylAXyPMV84Qphr68AM+f",
+	"zZxX665J929oNnqAEsvdqByVVa9P4dDHi5JutN0Q2B+eoN4AcUF8oEbAlxfSvb1vMfOo1/4O9N6FONd6",
+	"D1Tk6QVytGSUpfiiITQytGoeC2XSIWVAZx8WHKWOU7ng30sqo5D6Z+Sd+ocZ5YqS/1KPTR9M63IyNq3/",
+	"4s7mBclsSsOhu3LE259Pg4glbpGugvVrH28GD1+tIKNoxaeUNUvUWiZZTpK+Lwn6HiXnxazT4etvJjff",
+	"LZvcgFNE0uniIkW6z69913Wo1Gn1QrWNSxEzBkSZxWEy/J1x05UpcqWJdKM5mAGB2JTH5RQo1T8pg4TL",
+	"LzfzBKNhOgjxw3Kh/0HHZ1lh3K1yecN9ubtf7/HyLP/Qp4fbSeIK6V4n7Ng0S8mxJkdgAr9sKyP/9Lgo",
+	"r2ap98qRqx+ngrcfJd967wbQQOlsp1ktlsxUFGPoimEhkKyCUx6XYMZT3T/gprEjK0B1AWQtIgBVKUO1",
+	"Pm5KU+bYh46xAuRLXn536ojwzmtYtykRKyhgKKEs/WL6zVxgX5an6pvzYR4P0txiOVly/tVuDvXCmBHv",
+	"8De0sXlC5ysz/sO0fs1PmVBt9lxoiiqmWAao6AEWhKe0RqNI1t0xhPI20SmNJe2R9FYLWiLehj7AaJsl",
+	"RKI9ItiskyRUCcKxB+Xrj8CpcITbh9N7PIlQ4Sp09Vi3yBv/G8BWDLk59AY5+s+JuqmDvYz83sTbA4+9",
+	"aQK8xvAb6V1TE8xUcIxsCjOh9JNubdiqQeomxl43qvrjhFHOVUUfMWGIy7JsMpVfUSlkCCDZV1pl9uju",
+	"wrJJn8wa9iHCkqKJbbMoBfeEEt1lCVyghE6RKpWgO4vJauSm7cdIaNXaez3JEGRKYtaKgV0lQwnClwrO",
+	"8yZlqUTWc6CqC799c3ziSs1xPCZ+1QT5t26GkjBUBtKrnvA7Z0T+cf5rzzUD6b3X0/eO8Zgo9NkieVK3",
+	"OL/c/Oe5qcBZRiNM0DUw9ejAq4Phbu/41XBr+wmgozNy/jk0+H560w8+OMFTxAWc5jf9z7Jo3o2scvwC",
+	"qs7eKcrwJWIYWXOCYNiuFF1rQsYwU1Uh6GgUmzc44Fap43Bqi++FrXO+v6Jxjh9oGFST34T1j8ZR/bJ+",
+	"li5gHzZA/hYS1d1h09zwu3TeNMUE+7S+011jo5rwG9eOauGimcEMZHTsgirlO5iryCfFeXNEVB5djXPo",
+	"Ag7mx7Ctwg+SWiMj6KoJNVHW0aPTPDF/l2ipBrWszb0TOEdLxUw9WPoZPAiW/nfyAzVI6Ytw342SHy5W",
+	"5eqMNsCXYzClKrI8QdIOjhkX3TW75yUsX+ZcrGLR+kLqpMHVbGWVstz4r9wz1LLqNZ02eokYHKOeal2i",
+	"9jEvRKjCnmlCp7yq+hugv/GvkD54Y54pb5D8h6paR5V0Ii8YmTFv3DC6RB40CliZsa+Hba8fp4nJTPRa",
+	"w/1AFZoqkC3aTBOdng/5XsvIzS8SYirIPcQiIQ+zkNz8s7KmA8wQ15de2AR1JB9zj6LKcC9lav8LMVqP",
+	"QbZ59LqSfk/XvzTVHb06HoBJ48klYtWOpqGDq6C4dbjynR5VBWKI9J8jAXHGXaUUnXX2BcwMrRDWHAUG",
+	"wG/Hs+14KkQGj8SazqS6+Xra5DrPPnDktZlX3xgzrc8m4kZMBMgokX+ofQawEHQKhbbsLtL/VRnFtxqu",
+	"L+eBWPMV9PUaAubRxTLOiZBEd2yvhbYJtHGqJDxDbkLnAZv3LyRGIPPdAXqP4jPC9NVjiyHZ6GOGBKP6",
+	"NpFllSmzg2BUCRLS4ywSA9dI0Xd1s/ggttF1bQ++CYBfjQB46/PrXTWWtOcZOvQ7/aDJ4kB/v8ZmknA8",
+	"ZmgMLU85PYjqhDPcB6rbmUZEFEeuxWzZTSyOVBX2ZzO/Y9o/+vpfzj7wj74wHelVd7TB5ssn27//uL09",
+	"fPF++POrvc2tw98Gu788ffEqiiOeFeNox/RZ+yiogHIg6Q1Fb5lEjJjpEdULURxdYZLSq2P8l5zpYP/w",
+	"9GSve5MzhddlLBl2kx60Q3xqqcXSqSGfdn/3D0NbJ0Z6c51LVHc46/9wRn7YN3HZOSKm9xgwncliM5+u",
+	"A2jqUZrw6op+ISvXIznYGXH+IfVpuxdU78/d8Hiz92GOqeBav3PSncCv/QDGt92E3W+9ebqd+P/9v/8H",
+	"mOM0NaelcewbN9HGZ/W/++kbdpwV47m+0IW84Yw4d6k+NsodBSgDkpbaNRt7uJeT/SqAd/RIaoCrXsiv",
+	"spu4JAazGa3EMKdDT2D7Qi7FO9q4wTcGukYGqh5UHJsP0Cm5OsfS5TRbJWrdDGBqcGA6ZzRla/XaWsg5",
+	"vrve9l1efV/S0HKfyGC33ynp/pmuYGpqLS/51Utz1Lp+tez7e9eqdMU+se1T1sBmdD/oPz6H+EStN3St",
+	"l3Rrz2bFC6KdzS179vdI6ofBb1XC4A17EJCJtlh5uUqVP9AaSy/owgluwX/UMTpCXPIHeXQFuhYbCb9s",
+	"UT/NjB9VzbnY/IFIGhuExQq/scRnrHB1RkLLims/bqofLao/bsbe9sSqB3m8uXVGgl/VULO1eKitQWOo",
+	"rdBQj6pDbVWG0n3D48eBxIY5nXS+5lIPHuNe7V4wNLTA2GLfMh40rYa2m16O7aBfROgJWXBqLKWkr8i3",
+	"fSxqotNq5yjb/qyF1O7QzuFAXUAuOWUCZlWqWUWSSCjhxRQxoAeUxnbMASJprsoWYw7y4iLDSTZTAeFc",
+	"KU6Cuu94ixTyVg3XIovUuwwVBP9ZIIBTRFQiAHNOTkfJKgMrh2JSJmC5NXfMM6uJsMt3LvqqRJ6VhZe7",
+	"9Lp9u36/Xb9r4KsoKRiWeukfnyPNiE7k2R8WYhLt/PFBkrwy94SefWhe35o1Nm5x/XWQLRtluI0Jd7BB",
+	"qRuhxpy1+t/azsFbzcIOeYfF9EL3yakMrfPoRcGIS4SVV8DWdlu7FBVOFO6mtrUdl4dU/jGF13haTKOd",
+	"zcEgjqaYmL8ctWIikHb73UswqIevZWSJ6l58M+h2sOEp1FXwFjpGC7MXg8eh3+Jr8Xd3WY+Lb5bTYaEH",
+	"VtZQIFckiQ9tmnFnpbNCiGEHjr/i5fw467ucF4C5+7cp3OdvxjL3wQYmlzDDKdR+iRV9l2dk3w3DW64I",
+	"z+jd3vSkHKZ2c6x0WBS5lUPKeOrm4qrP66zUmZI+SxR676rVzB+tekLUB/6ACw3dzRndoV5mETaEtWzT",
+	"4SBoYRI3FTaRa5O6SSPB6UqLbqjJa1pKQ2Oyv1A9fIAp7I8AoQCnHimqfvS2TWGs5jUTXpkm0OUx6VTO",
+	"J+Ahe1tZSGW8b/f14vvaI4uFt7bH7GxTPphhyFE3kxUwL7cJtcZUNTRD3odc6E+5gpHJregrJ5TQLnoE",
+	"4myM7YLdac4RExzA6jB9YDuaQqJ/Kfvtcl0YAhJKZKSxd72ZJvVlg/qRQOwKspSXhRs0lGk5HUMACsHw",
+	"RSHKerSN0WNd08HVkfMYmcqLNtFB5/YF8/wcoGuBCFdF7nQspfLYygJyGl0KAIY4zS51kJHLapzQfCcM",
+	"jF8YzqIHC46y0RlRpTHci6pLOOYtw9CRaVhqts4fV6MpHPIqt6xyQu4mnKl6CMNCcYVq5O4VCrr7lYoX",
+	"AXpcAVJD+O0e6hK6KjFV3eMwe2m/gTY+c293uhZJqJKVKZBg2ItrCmPZzCeEcnnCMAtypZZYodoBWs4J",
+	"U11Tx5ihKhk+tAoGD5QGDUl0oME5UUghcgrXNrhjqvhSPO9hVSN4oKTWoJSuvO72VmbnHJ0vgt+r8H0L",
+	"5+4DtBs5G2jAudtBTu6wkQ1RuhSiPdm5vJf2q82/U4q4bM6ArjHXGfFWF7fVoRqfqFd55d3c9YewGTpO",
+	"8s4ZvVSFjkcYZSlfKFreQqpcN5nVmtjflYy5brDNq98kzqVOaVXq7MyDnaApY2N+RrO1RaBbwnP23E9o",
+	"tlCsXFV2MMAvKVFWZcmvIQ3v4UfDz6XPeGVxwBdCFlFcKa/eAbmtXVSdxyG/8mpZkmy8jW3habWwCRci",
+	"EQ6c2KX0E0aVmAlV/TQUapDRRMVbFSyLdqKJEPnOxsbm1o/9QX/Q39z56aeffgqUJk/kNJWv+M7GBs0R",
+	"0SFh+vnNB7eaQCFwFcLGAUMZNOY9rbar0rckBSm6KMZj+ZdOjlXWOSmb/PEaQUZUw9gP3zXnxnQjpQnf",
+	"GCMhx+qpyCOU6nILqpLRJUZX35+RMk5Cmw+im7gTmErowmSsW0SokAsJpcnWXBk+c/yCAJoYw44AmmTI",
+	"SuRgZ7CmlCCB/0IbKeSTCwpZatykvRRdokyymd64wCmqAGgcDh0B9HSaFZFlR6gA4U5MRzCQl1G9AoL8",
+	"z1voak7K9s2Hm/83AJnQMt1AiwEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
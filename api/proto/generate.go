@@ -0,0 +1,2 @@
+//go:generate sh -c "protoc -I . -I $(go list -m -f '{{.Dir}}' github.com/cloudevents/sdk-go/binding/format/protobuf/v2)/pb --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative openmeter/ingest/v1/ingest.proto"
+package proto
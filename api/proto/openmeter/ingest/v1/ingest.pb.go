@@ -0,0 +1,434 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v4.25.3
+// source: openmeter/ingest/v1/ingest.proto
+
+package ingestv1
+
+import (
+	pb "github.com/cloudevents/sdk-go/binding/format/protobuf/v2/pb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EventAck_Status int32
+
+const (
+	EventAck_STATUS_UNSPECIFIED EventAck_Status = 0
+	// The event was accepted by the collector.
+	EventAck_STATUS_ACCEPTED EventAck_Status = 1
+	// The event is not a valid CloudEvent and must not be retried.
+	EventAck_STATUS_INVALID EventAck_Status = 2
+	// The event could not be forwarded to the collector and may be retried.
+	EventAck_STATUS_FAILED EventAck_Status = 3
+	// The event was rejected by a rate limit and may be retried after retry_after.
+	EventAck_STATUS_RATE_LIMITED EventAck_Status = 4
+)
+
+// Enum value maps for EventAck_Status.
+var (
+	EventAck_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_ACCEPTED",
+		2: "STATUS_INVALID",
+		3: "STATUS_FAILED",
+		4: "STATUS_RATE_LIMITED",
+	}
+	EventAck_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED":  0,
+		"STATUS_ACCEPTED":     1,
+		"STATUS_INVALID":      2,
+		"STATUS_FAILED":       3,
+		"STATUS_RATE_LIMITED": 4,
+	}
+)
+
+func (x EventAck_Status) Enum() *EventAck_Status {
+	p := new(EventAck_Status)
+	*p = x
+	return p
+}
+
+func (x EventAck_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventAck_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_openmeter_ingest_v1_ingest_proto_enumTypes[0].Descriptor()
+}
+
+func (EventAck_Status) Type() protoreflect.EnumType {
+	return &file_openmeter_ingest_v1_ingest_proto_enumTypes[0]
+}
+
+func (x EventAck_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventAck_Status.Descriptor instead.
+func (EventAck_Status) EnumDescriptor() ([]byte, []int) {
+	return file_openmeter_ingest_v1_ingest_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type IngestEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sequence number chosen by the client, echoed back in the response.
+	Sequence uint64           `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Events   []*pb.CloudEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *IngestEventsRequest) Reset() {
+	*x = IngestEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openmeter_ingest_v1_ingest_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IngestEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestEventsRequest) ProtoMessage() {}
+
+func (x *IngestEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_openmeter_ingest_v1_ingest_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestEventsRequest.ProtoReflect.Descriptor instead.
+func (*IngestEventsRequest) Descriptor() ([]byte, []int) {
+	return file_openmeter_ingest_v1_ingest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IngestEventsRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *IngestEventsRequest) GetEvents() []*pb.CloudEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type IngestEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sequence number of the request this response acknowledges.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Acknowledgements in the same order as the events in the request.
+	Acks []*EventAck `protobuf:"bytes,2,rep,name=acks,proto3" json:"acks,omitempty"`
+}
+
+func (x *IngestEventsResponse) Reset() {
+	*x = IngestEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openmeter_ingest_v1_ingest_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IngestEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestEventsResponse) ProtoMessage() {}
+
+func (x *IngestEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_openmeter_ingest_v1_ingest_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestEventsResponse.ProtoReflect.Descriptor instead.
+func (*IngestEventsResponse) Descriptor() ([]byte, []int) {
+	return file_openmeter_ingest_v1_ingest_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IngestEventsResponse) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *IngestEventsResponse) GetAcks() []*EventAck {
+	if x != nil {
+		return x.Acks
+	}
+	return nil
+}
+
+type EventAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the acknowledged event.
+	Id     string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status EventAck_Status `protobuf:"varint,2,opt,name=status,proto3,enum=openmeter.ingest.v1.EventAck_Status" json:"status,omitempty"`
+	// Error message if the event was not accepted.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Time to wait before retrying if the event was rate limited.
+	RetryAfter *durationpb.Duration `protobuf:"bytes,4,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+}
+
+func (x *EventAck) Reset() {
+	*x = EventAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_openmeter_ingest_v1_ingest_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAck) ProtoMessage() {}
+
+func (x *EventAck) ProtoReflect() protoreflect.Message {
+	mi := &file_openmeter_ingest_v1_ingest_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventAck.ProtoReflect.Descriptor instead.
+func (*EventAck) Descriptor() ([]byte, []int) {
+	return file_openmeter_ingest_v1_ingest_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventAck) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventAck) GetStatus() EventAck_Status {
+	if x != nil {
+		return x.Status
+	}
+	return EventAck_STATUS_UNSPECIFIED
+}
+
+func (x *EventAck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EventAck) GetRetryAfter() *durationpb.Duration {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return nil
+}
+
+var File_openmeter_ingest_v1_ingest_proto protoreflect.FileDescriptor
+
+var file_openmeter_ingest_v1_ingest_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x67, 0x65,
+	0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x13, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x69, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x10, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x68, 0x0a, 0x13, 0x49, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69,
+	0x6f, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x65, 0x0a, 0x14, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x61, 0x63, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x41, 0x63, 0x6b, 0x52, 0x04, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xa1, 0x02, 0x0a, 0x08, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x41, 0x63, 0x6b, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x75, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x04, 0x32, 0xe2,
+	0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x63, 0x0a, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x69, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x11, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x30, 0x01, 0x42, 0x49, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x2f, 0x6f, 0x70,
+	0x65, 0x6e, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x67, 0x65,
+	0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_openmeter_ingest_v1_ingest_proto_rawDescOnce sync.Once
+	file_openmeter_ingest_v1_ingest_proto_rawDescData = file_openmeter_ingest_v1_ingest_proto_rawDesc
+)
+
+func file_openmeter_ingest_v1_ingest_proto_rawDescGZIP() []byte {
+	file_openmeter_ingest_v1_ingest_proto_rawDescOnce.Do(func() {
+		file_openmeter_ingest_v1_ingest_proto_rawDescData = protoimpl.X.CompressGZIP(file_openmeter_ingest_v1_ingest_proto_rawDescData)
+	})
+	return file_openmeter_ingest_v1_ingest_proto_rawDescData
+}
+
+var file_openmeter_ingest_v1_ingest_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_openmeter_ingest_v1_ingest_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_openmeter_ingest_v1_ingest_proto_goTypes = []interface{}{
+	(EventAck_Status)(0),         // 0: openmeter.ingest.v1.EventAck.Status
+	(*IngestEventsRequest)(nil),  // 1: openmeter.ingest.v1.IngestEventsRequest
+	(*IngestEventsResponse)(nil), // 2: openmeter.ingest.v1.IngestEventsResponse
+	(*EventAck)(nil),             // 3: openmeter.ingest.v1.EventAck
+	(*pb.CloudEvent)(nil),        // 4: io.cloudevents.v1.CloudEvent
+	(*durationpb.Duration)(nil),  // 5: google.protobuf.Duration
+}
+var file_openmeter_ingest_v1_ingest_proto_depIdxs = []int32{
+	4, // 0: openmeter.ingest.v1.IngestEventsRequest.events:type_name -> io.cloudevents.v1.CloudEvent
+	3, // 1: openmeter.ingest.v1.IngestEventsResponse.acks:type_name -> openmeter.ingest.v1.EventAck
+	0, // 2: openmeter.ingest.v1.EventAck.status:type_name -> openmeter.ingest.v1.EventAck.Status
+	5, // 3: openmeter.ingest.v1.EventAck.retry_after:type_name -> google.protobuf.Duration
+	1, // 4: openmeter.ingest.v1.IngestService.IngestEvents:input_type -> openmeter.ingest.v1.IngestEventsRequest
+	1, // 5: openmeter.ingest.v1.IngestService.IngestEventStream:input_type -> openmeter.ingest.v1.IngestEventsRequest
+	2, // 6: openmeter.ingest.v1.IngestService.IngestEvents:output_type -> openmeter.ingest.v1.IngestEventsResponse
+	2, // 7: openmeter.ingest.v1.IngestService.IngestEventStream:output_type -> openmeter.ingest.v1.IngestEventsResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_openmeter_ingest_v1_ingest_proto_init() }
+func file_openmeter_ingest_v1_ingest_proto_init() {
+	if File_openmeter_ingest_v1_ingest_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_openmeter_ingest_v1_ingest_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IngestEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openmeter_ingest_v1_ingest_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IngestEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_openmeter_ingest_v1_ingest_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_openmeter_ingest_v1_ingest_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_openmeter_ingest_v1_ingest_proto_goTypes,
+		DependencyIndexes: file_openmeter_ingest_v1_ingest_proto_depIdxs,
+		EnumInfos:         file_openmeter_ingest_v1_ingest_proto_enumTypes,
+		MessageInfos:      file_openmeter_ingest_v1_ingest_proto_msgTypes,
+	}.Build()
+	File_openmeter_ingest_v1_ingest_proto = out.File
+	file_openmeter_ingest_v1_ingest_proto_rawDesc = nil
+	file_openmeter_ingest_v1_ingest_proto_goTypes = nil
+	file_openmeter_ingest_v1_ingest_proto_depIdxs = nil
+}
@@ -0,0 +1,185 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.3
+// source: openmeter/ingest/v1/ingest.proto
+
+package ingestv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	IngestService_IngestEvents_FullMethodName      = "/openmeter.ingest.v1.IngestService/IngestEvents"
+	IngestService_IngestEventStream_FullMethodName = "/openmeter.ingest.v1.IngestService/IngestEventStream"
+)
+
+// IngestServiceClient is the client API for IngestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IngestServiceClient interface {
+	// IngestEvents ingests a batch of events.
+	IngestEvents(ctx context.Context, in *IngestEventsRequest, opts ...grpc.CallOption) (*IngestEventsResponse, error)
+	// IngestEventStream ingests batches of events sent over a long-lived stream.
+	// Every request is acknowledged with a response carrying the same sequence number.
+	IngestEventStream(ctx context.Context, opts ...grpc.CallOption) (IngestService_IngestEventStreamClient, error)
+}
+
+type ingestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIngestServiceClient(cc grpc.ClientConnInterface) IngestServiceClient {
+	return &ingestServiceClient{cc}
+}
+
+func (c *ingestServiceClient) IngestEvents(ctx context.Context, in *IngestEventsRequest, opts ...grpc.CallOption) (*IngestEventsResponse, error) {
+	out := new(IngestEventsResponse)
+	err := c.cc.Invoke(ctx, IngestService_IngestEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestServiceClient) IngestEventStream(ctx context.Context, opts ...grpc.CallOption) (IngestService_IngestEventStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &IngestService_ServiceDesc.Streams[0], IngestService_IngestEventStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ingestServiceIngestEventStreamClient{stream}
+	return x, nil
+}
+
+type IngestService_IngestEventStreamClient interface {
+	Send(*IngestEventsRequest) error
+	Recv() (*IngestEventsResponse, error)
+	grpc.ClientStream
+}
+
+type ingestServiceIngestEventStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *ingestServiceIngestEventStreamClient) Send(m *IngestEventsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *ingestServiceIngestEventStreamClient) Recv() (*IngestEventsResponse, error) {
+	m := new(IngestEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// IngestServiceServer is the server API for IngestService service.
+// All implementations must embed UnimplementedIngestServiceServer
+// for forward compatibility
+type IngestServiceServer interface {
+	// IngestEvents ingests a batch of events.
+	IngestEvents(context.Context, *IngestEventsRequest) (*IngestEventsResponse, error)
+	// IngestEventStream ingests batches of events sent over a long-lived stream.
+	// Every request is acknowledged with a response carrying the same sequence number.
+	IngestEventStream(IngestService_IngestEventStreamServer) error
+	mustEmbedUnimplementedIngestServiceServer()
+}
+
+// UnimplementedIngestServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedIngestServiceServer struct {
+}
+
+func (UnimplementedIngestServiceServer) IngestEvents(context.Context, *IngestEventsRequest) (*IngestEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IngestEvents not implemented")
+}
+func (UnimplementedIngestServiceServer) IngestEventStream(IngestService_IngestEventStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method IngestEventStream not implemented")
+}
+func (UnimplementedIngestServiceServer) mustEmbedUnimplementedIngestServiceServer() {}
+
+// UnsafeIngestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IngestServiceServer will
+// result in compilation errors.
+type UnsafeIngestServiceServer interface {
+	mustEmbedUnimplementedIngestServiceServer()
+}
+
+func RegisterIngestServiceServer(s grpc.ServiceRegistrar, srv IngestServiceServer) {
+	s.RegisterService(&IngestService_ServiceDesc, srv)
+}
+
+func _IngestService_IngestEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IngestEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestServiceServer).IngestEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestService_IngestEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestServiceServer).IngestEvents(ctx, req.(*IngestEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestService_IngestEventStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IngestServiceServer).IngestEventStream(&ingestServiceIngestEventStreamServer{stream})
+}
+
+type IngestService_IngestEventStreamServer interface {
+	Send(*IngestEventsResponse) error
+	Recv() (*IngestEventsRequest, error)
+	grpc.ServerStream
+}
+
+type ingestServiceIngestEventStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *ingestServiceIngestEventStreamServer) Send(m *IngestEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *ingestServiceIngestEventStreamServer) Recv() (*IngestEventsRequest, error) {
+	m := new(IngestEventsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// IngestService_ServiceDesc is the grpc.ServiceDesc for IngestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IngestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openmeter.ingest.v1.IngestService",
+	HandlerType: (*IngestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IngestEvents",
+			Handler:    _IngestService_IngestEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "IngestEventStream",
+			Handler:       _IngestService_IngestEventStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "openmeter/ingest/v1/ingest.proto",
+}
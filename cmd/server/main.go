@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"runtime"
@@ -14,6 +15,7 @@ import (
 	"time"
 
 	health "github.com/AppsFlyer/go-sundheit"
+	"github.com/AppsFlyer/go-sundheit/checks"
 	healthhttp "github.com/AppsFlyer/go-sundheit/http"
 	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
@@ -25,6 +27,7 @@ import (
 	slogmulti "github.com/samber/slog-multi"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/metric"
@@ -32,12 +35,22 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.20.0"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
 
+	ingestv1 "github.com/openmeterio/openmeter/api/proto/openmeter/ingest/v1"
 	"github.com/openmeterio/openmeter/config"
 	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/balancesnapshot"
+	"github.com/openmeterio/openmeter/internal/credit/entitlement"
+	"github.com/openmeterio/openmeter/internal/credit/grantschedule"
 	nope_credit "github.com/openmeterio/openmeter/internal/credit/nope_connector"
+	"github.com/openmeterio/openmeter/internal/credit/notification"
 	postgres_credit "github.com/openmeterio/openmeter/internal/credit/postgres_connector"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/migrate"
+	"github.com/openmeterio/openmeter/internal/credit/usageperiod"
+	"github.com/openmeterio/openmeter/internal/eventtype"
+	postgres_eventtype "github.com/openmeterio/openmeter/internal/eventtype/postgres_connector"
 	"github.com/openmeterio/openmeter/internal/ingest"
 	"github.com/openmeterio/openmeter/internal/ingest/ingestdriver"
 	"github.com/openmeterio/openmeter/internal/ingest/kafkaingest"
@@ -50,6 +63,8 @@ import (
 	"github.com/openmeterio/openmeter/internal/server/router"
 	"github.com/openmeterio/openmeter/internal/streaming"
 	"github.com/openmeterio/openmeter/internal/streaming/clickhouse_connector"
+	"github.com/openmeterio/openmeter/internal/subject"
+	postgres_subject "github.com/openmeterio/openmeter/internal/subject/postgres_connector"
 	"github.com/openmeterio/openmeter/pkg/contextx"
 	"github.com/openmeterio/openmeter/pkg/errorsx"
 	"github.com/openmeterio/openmeter/pkg/framework/operation"
@@ -58,6 +73,7 @@ import (
 	kafkametrics "github.com/openmeterio/openmeter/pkg/kafka/metrics"
 	"github.com/openmeterio/openmeter/pkg/models"
 	"github.com/openmeterio/openmeter/pkg/slicesx"
+	"github.com/openmeterio/openmeter/pkg/spool"
 )
 
 const (
@@ -188,6 +204,7 @@ func main() {
 		"address":             conf.Address,
 		"telemetry.address":   conf.Telemetry.Address,
 		"ingest.kafka.broker": conf.Ingest.Kafka.Broker,
+		"ingest.grpc.address": conf.Ingest.GRPC.Address,
 	})
 
 	var group run.Group
@@ -202,6 +219,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	err = gosundheit.RegisterCheck(healthChecker, gosundheit.NewClickHouseCheck("clickhouse", clickHouseClient))
+	if err != nil {
+		logger.Error("failed to register clickhouse health check", "error", err)
+		os.Exit(1)
+	}
+
 	// Initialize Kafka Ingest
 	ingestCollector, kafkaIngestNamespaceHandler, err := initKafkaIngest(
 		ctx,
@@ -210,6 +233,7 @@ func main() {
 		metricMeter,
 		serializer.NewJSONSerializer(),
 		&group,
+		healthChecker,
 	)
 	if err != nil {
 		logger.Error("failed to initialize kafka ingest", "error", err)
@@ -250,6 +274,32 @@ func main() {
 	// 	os.Exit(1)
 	// }
 
+	// Initialize Postgres
+	var creditDbClient *db.Client
+	if conf.Postgres.URL != "" {
+		database, err := postgres_credit.Open(conf.Postgres.URL)
+		if err != nil {
+			logger.Error("failed to open postgres connection", "error", err)
+			os.Exit(1)
+		}
+
+		err = gosundheit.RegisterCheck(healthChecker, gosundheit.NewPingCheck("postgres", database.PingContext))
+		if err != nil {
+			logger.Error("failed to register postgres health check", "error", err)
+			os.Exit(1)
+		}
+
+		creditDbClient = postgres_credit.NewClient(database)
+
+		// TODO: use versioned migrations
+		// https://entgo.io/docs/versioned-migrations
+		// Indexes removed from the schema are dropped, eg. credit entries can have several children since grant amendments
+		if err := creditDbClient.Schema.Create(context.Background(), migrate.WithDropIndex(true)); err != nil {
+			logger.Error("failed to migrate database", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	// Initialize deduplication
 	if conf.Dedupe.Enabled {
 		deduplicator, err := conf.Dedupe.NewDeduplicator()
@@ -258,12 +308,56 @@ func main() {
 			os.Exit(1)
 		}
 
+		// Deduplicators backed by an external store are checked for readiness
+		if pinger, ok := deduplicator.(checks.Pinger); ok {
+			err = gosundheit.RegisterCheck(healthChecker, gosundheit.NewPingCheck("dedupe", pinger.PingContext))
+			if err != nil {
+				logger.Error("failed to register dedupe health check", "error", err)
+				os.Exit(1)
+			}
+		}
+
 		ingestCollector = ingest.DeduplicatingCollector{
 			Collector:    ingestCollector,
 			Deduplicator: deduplicator,
 		}
 	}
 
+	// Initialize rate limiting
+	// Rate limits are applied before deduplication, so rejected events are not recorded as seen.
+	if conf.Ingest.RateLimit.Enabled {
+		limiter, err := conf.Ingest.RateLimit.NewLimiter(conf.Dedupe)
+		if err != nil {
+			logger.Error("failed to initialize rate limiter", "error", err)
+			os.Exit(1)
+		}
+
+		ingestCollector, err = ingest.NewRateLimitingCollector(
+			ingestCollector,
+			limiter,
+			conf.Ingest.RateLimit.Limits(),
+			metricMeter,
+		)
+		if err != nil {
+			logger.Error("failed to initialize rate limiting", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	// Initialize subject aliasing
+	// Aliases are resolved first, so rate limits apply to the canonical subject.
+	var subjectAliasConnector subject.AliasConnector
+	var subjectAliasResolver *subject.AliasResolver
+	if conf.Ingest.SubjectAlias.Enabled {
+		subjectAliasConnector = postgres_subject.NewPostgresConnector(creditDbClient)
+		subjectAliasResolver = subject.NewAliasResolver(subjectAliasConnector, conf.Ingest.SubjectAlias.CacheTTL)
+
+		ingestCollector = ingest.SubjectAliasingCollector{
+			Collector: ingestCollector,
+			Resolver:  subjectAliasResolver,
+		}
+	}
+
 	// Initialize HTTP Ingest handler
 	ingestService := ingest.Service{
 		Collector: ingestCollector,
@@ -286,23 +380,6 @@ func main() {
 		}
 	}
 
-	// Initialize Postgres
-	var creditDbClient *db.Client
-	if conf.Postgres.URL != "" {
-		creditDbClient, err = postgres_credit.Open(conf.Postgres.URL)
-		if err != nil {
-			logger.Error("failed to open postgres connection", "error", err)
-			os.Exit(1)
-		}
-
-		// TODO: use versioned migrations
-		// https://entgo.io/docs/versioned-migrations
-		if err := creditDbClient.Schema.Create(context.Background()); err != nil {
-			logger.Error("failed to migrate database", "error", err)
-			os.Exit(1)
-		}
-	}
-
 	// Initialize Credit
 	var creditConnector credit.Connector
 	if conf.Entitlements.Enabled {
@@ -325,6 +402,23 @@ func main() {
 		logger.Info("entitlements support disabled")
 	}
 
+	// Initialize the cache entitlement checks are served from
+	entitlementCache, err := entitlement.NewCache(creditConnector, logger, entitlement.CacheConfig{
+		MaxStaleness:    conf.Entitlements.Checks.MaxStaleness,
+		RefreshInterval: conf.Entitlements.Checks.RefreshInterval,
+		IdleTimeout:     conf.Entitlements.Checks.IdleTimeout,
+	})
+	if err != nil {
+		logger.Error("failed to initialize entitlement cache", "error", err)
+		os.Exit(1)
+	}
+
+	// Initialize event types
+	var eventTypeConnector eventtype.Connector
+	if creditDbClient != nil {
+		eventTypeConnector = postgres_eventtype.NewPostgresConnector(creditDbClient)
+	}
+
 	s, err := server.NewServer(&server.Config{
 		RouterConfig: router.Config{
 			CreditConnector:     creditConnector,
@@ -335,6 +429,13 @@ func main() {
 			PortalTokenStrategy: portalTokenStrategy,
 			PortalCORSEnabled:   conf.Portal.CORS.Enabled,
 			ErrorHandler:        errorsx.NewAppHandler(errorsx.NewSlogHandler(logger)),
+
+			SubjectAliasConnector: subjectAliasConnector,
+			SubjectAliasCache:     subjectAliasResolver,
+
+			EventTypeConnector: eventTypeConnector,
+
+			EntitlementCache: entitlementCache,
 		},
 		RouterHook: func(r chi.Router) {
 			r.Use(func(h http.Handler) http.Handler {
@@ -410,6 +511,96 @@ func main() {
 		)
 	}
 
+	// Set up gRPC ingest server
+	if conf.Ingest.GRPC.Enabled {
+		server := grpc.NewServer(
+			grpc.StatsHandler(otelgrpc.NewServerHandler(
+				otelgrpc.WithMeterProvider(otelMeterProvider),
+				otelgrpc.WithTracerProvider(otelTracerProvider),
+			)),
+		)
+		defer server.Stop()
+
+		ingestv1.RegisterIngestServiceServer(server, ingestdriver.NewIngestServiceServer(
+			ingestService.IngestEvents,
+			namespacedriver.StaticNamespaceDecoder(namespaceManager.GetDefaultNamespace()),
+		))
+
+		listener, err := net.Listen("tcp", conf.Ingest.GRPC.Address)
+		if err != nil {
+			logger.Error("failed to listen for grpc ingest", "error", err)
+			os.Exit(1)
+		}
+
+		group.Add(
+			func() error { return server.Serve(listener) },
+			func(err error) { server.GracefulStop() },
+		)
+	}
+
+	// Issue the grants of recurring grant schedules
+	if conf.Entitlements.Enabled {
+		worker, err := grantschedule.NewWorker(creditConnector, logger, grantschedule.WorkerConfig{
+			Interval: conf.Entitlements.GrantSchedules.Interval,
+		})
+		if err != nil {
+			logger.Error("failed to initialize grant schedule worker", "error", err)
+			os.Exit(1)
+		}
+
+		group.Add(worker.Run(ctx))
+	}
+
+	// Reset ledgers at the boundaries of their usage periods
+	if conf.Entitlements.Enabled {
+		worker, err := usageperiod.NewWorker(creditConnector, logger, usageperiod.WorkerConfig{
+			Interval: conf.Entitlements.UsagePeriods.Interval,
+		})
+		if err != nil {
+			logger.Error("failed to initialize usage period worker", "error", err)
+			os.Exit(1)
+		}
+
+		group.Add(worker.Run(ctx))
+	}
+
+	// Notify webhooks about the balances of ledgers
+	if conf.Entitlements.Enabled && conf.Entitlements.Notifications.Enabled {
+		worker, err := notification.NewWorker(creditConnector, logger, notification.WorkerConfig{
+			Interval:             conf.Entitlements.Notifications.Interval,
+			SigningSecret:        conf.Entitlements.Notifications.SigningSecret,
+			Timeout:              conf.Entitlements.Notifications.Timeout,
+			MaxAttempts:          conf.Entitlements.Notifications.MaxAttempts,
+			RetryInitialInterval: conf.Entitlements.Notifications.Retry.InitialInterval,
+			RetryMaxInterval:     conf.Entitlements.Notifications.Retry.MaxInterval,
+		})
+		if err != nil {
+			logger.Error("failed to initialize notification worker", "error", err)
+			os.Exit(1)
+		}
+
+		group.Add(worker.Run(ctx))
+	}
+
+	// Snapshot the balances of ledgers
+	if conf.Entitlements.Enabled {
+		worker, err := balancesnapshot.NewWorker(creditConnector, logger, balancesnapshot.WorkerConfig{
+			Interval: conf.Entitlements.BalanceSnapshots.Interval,
+			Delay:    conf.Entitlements.BalanceSnapshots.Delay,
+		})
+		if err != nil {
+			logger.Error("failed to initialize balance snapshot worker", "error", err)
+			os.Exit(1)
+		}
+
+		group.Add(worker.Run(ctx))
+	}
+
+	// Refresh the balances entitlement checks are served from
+	if conf.Entitlements.Enabled {
+		group.Add(entitlementCache.Run(ctx))
+	}
+
 	// Setup signal handler
 	group.Add(run.SignalHandler(ctx, syscall.SIGINT, syscall.SIGTERM))
 
@@ -421,10 +612,15 @@ func main() {
 	}
 }
 
-func initKafkaIngest(ctx context.Context, config config.Configuration, logger *slog.Logger, metricMeter metric.Meter, serializer serializer.Serializer, group *run.Group) (*kafkaingest.Collector, *kafkaingest.NamespaceHandler, error) {
+func initKafkaIngest(ctx context.Context, config config.Configuration, logger *slog.Logger, metricMeter metric.Meter, serializer serializer.Serializer, group *run.Group, healthChecker health.Health) (*kafkaingest.Collector, *kafkaingest.NamespaceHandler, error) {
 	// Initialize Kafka Admin Client
 	kafkaConfig := config.Ingest.Kafka.CreateKafkaConfig()
 
+	// Failed deliveries are spooled with their headers
+	if config.Ingest.Spool.Enabled {
+		kafkaConfig["go.delivery.report.fields"] = "key,value,headers"
+	}
+
 	// Initialize Kafka Producer
 	producer, err := kafka.NewProducer(&kafkaConfig)
 	if err != nil {
@@ -442,6 +638,11 @@ func initKafkaIngest(ctx context.Context, config config.Configuration, logger *s
 
 	go pkgkafka.ConsumeLogChannel(producer, logger.WithGroup("kafka").WithGroup("producer"))
 
+	err = gosundheit.RegisterCheck(healthChecker, gosundheit.NewKafkaCheck("kafka-producer", producer))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to register kafka producer health check: %w", err)
+	}
+
 	slog.Debug("connected to Kafka")
 
 	collector, err := kafkaingest.NewCollector(
@@ -454,6 +655,37 @@ func initKafkaIngest(ctx context.Context, config config.Configuration, logger *s
 		return nil, nil, fmt.Errorf("init kafka ingest: %w", err)
 	}
 
+	// Initialize spool
+	if config.Ingest.Spool.Enabled {
+		s, err := spool.Open(config.Ingest.Spool.Directory, spool.Options{
+			MaxSegmentSize: config.Ingest.Spool.MaxSegmentSize,
+			Sync:           config.Ingest.Spool.Sync,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("init kafka ingest spool: %w", err)
+		}
+
+		spooler, err := kafkaingest.NewSpooler(
+			producer,
+			s,
+			logger.WithGroup("spool"),
+			kafkaingest.SpoolerConfig{
+				ReplayBatchSize: config.Ingest.Spool.ReplayBatchSize,
+				ReplayInterval:  config.Ingest.Spool.ReplayInterval,
+			},
+			metricMeter,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("init kafka ingest spool: %w", err)
+		}
+
+		group.Add(spooler.Run(ctx))
+
+		collector.Spooler = spooler
+
+		logger.Info("kafka ingest spool enabled", "directory", config.Ingest.Spool.Directory, "depth", s.Len())
+	}
+
 	kafkaAdminClient, err := kafka.NewAdminClientFromProducer(producer)
 	if err != nil {
 		return nil, nil, err
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	health "github.com/AppsFlyer/go-sundheit"
+	"github.com/AppsFlyer/go-sundheit/checks"
 	healthhttp "github.com/AppsFlyer/go-sundheit/http"
 	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
@@ -30,9 +31,14 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/openmeterio/openmeter/config"
+	postgres_credit "github.com/openmeterio/openmeter/internal/credit/postgres_connector"
 	"github.com/openmeterio/openmeter/internal/dedupe"
+	"github.com/openmeterio/openmeter/internal/eventtype"
+	postgres_eventtype "github.com/openmeterio/openmeter/internal/eventtype/postgres_connector"
 	"github.com/openmeterio/openmeter/internal/meter"
 	"github.com/openmeterio/openmeter/internal/sink"
+	"github.com/openmeterio/openmeter/internal/sink/admin"
+	"github.com/openmeterio/openmeter/internal/sink/archive"
 	"github.com/openmeterio/openmeter/pkg/gosundheit"
 	"github.com/openmeterio/openmeter/pkg/models"
 	"github.com/openmeterio/openmeter/pkg/slicesx"
@@ -156,8 +162,41 @@ func main() {
 		return *meter
 	}))
 
+	// Initialize event type repository
+	// Event types registered via the API are read from Postgres, next to the ones in the configuration
+	var eventTypes []eventtype.EventType
+	for _, c := range conf.EventTypes {
+		eventType, err := c.EventType(conf.Namespace.Default)
+		if err != nil {
+			logger.Error("failed to initialize event type", "type", c.Type, "error", err)
+			os.Exit(1)
+		}
+
+		eventTypes = append(eventTypes, eventType)
+	}
+
+	var eventTypeRepository eventtype.Repository = eventtype.NewInMemoryRepository(eventTypes)
+	if conf.Postgres.URL != "" {
+		database, err := postgres_credit.Open(conf.Postgres.URL)
+		if err != nil {
+			logger.Error("failed to open postgres connection", "error", err)
+			os.Exit(1)
+		}
+
+		err = gosundheit.RegisterCheck(healthChecker, gosundheit.NewPingCheck("postgres", database.PingContext))
+		if err != nil {
+			logger.Error("failed to register postgres health check", "error", err)
+			os.Exit(1)
+		}
+
+		dbClient := postgres_credit.NewClient(database)
+		defer dbClient.Close()
+
+		eventTypeRepository = eventtype.NewCompositeRepository(eventTypeRepository, postgres_eventtype.NewPostgresConnector(dbClient))
+	}
+
 	// Initialize sink worker
-	sink, err := initSink(conf, logger, metricMeter, tracer, meterRepository)
+	sink, err := initSink(conf, logger, metricMeter, tracer, meterRepository, eventTypeRepository, healthChecker)
 	if err != nil {
 		logger.Error("failed to initialize sink worker", "error", err)
 		os.Exit(1)
@@ -179,6 +218,20 @@ func main() {
 		)
 	}
 
+	// Set up admin server
+	if conf.Sink.Admin.Enabled {
+		server := &http.Server{
+			Addr:    conf.Sink.Admin.Address,
+			Handler: admin.NewHandler(sink, logger),
+		}
+		defer server.Close()
+
+		group.Add(
+			func() error { return server.ListenAndServe() },
+			func(err error) { _ = server.Shutdown(ctx) },
+		)
+	}
+
 	// Starting sink worker
 	{
 		defer sink.Close()
@@ -230,27 +283,51 @@ func initClickHouseClient(config config.Configuration) (clickhouse.Conn, error)
 	return clickHouseClient, nil
 }
 
-func initSink(config config.Configuration, logger *slog.Logger, metricMeter metric.Meter, tracer trace.Tracer, meterRepository meter.Repository) (*sink.Sink, error) {
+func initSink(config config.Configuration, logger *slog.Logger, metricMeter metric.Meter, tracer trace.Tracer, meterRepository meter.Repository, eventTypeRepository eventtype.Repository, healthChecker health.Health) (*sink.Sink, error) {
 	clickhouseClient, err := initClickHouseClient(config)
 	if err != nil {
 		return nil, fmt.Errorf("init clickhouse client: %w", err)
 	}
 
+	err = gosundheit.RegisterCheck(healthChecker, gosundheit.NewClickHouseCheck("clickhouse", clickhouseClient))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register clickhouse health check: %w", err)
+	}
+
 	var deduplicator dedupe.Deduplicator
 	if config.Sink.Dedupe.Enabled {
 		deduplicator, err = config.Sink.Dedupe.NewDeduplicator()
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize deduplicator: %w", err)
 		}
+
+		// Deduplicators backed by an external store are checked for readiness
+		if pinger, ok := deduplicator.(checks.Pinger); ok {
+			err = gosundheit.RegisterCheck(healthChecker, gosundheit.NewPingCheck("dedupe", pinger.PingContext))
+			if err != nil {
+				return nil, fmt.Errorf("failed to register dedupe health check: %w", err)
+			}
+		}
 	}
 
-	storage := sink.NewClickhouseStorage(
+	clickhouseStorage := sink.NewClickhouseStorage(
 		sink.ClickHouseStorageConfig{
-			ClickHouse: clickhouseClient,
-			Database:   config.Aggregation.ClickHouse.Database,
+			ClickHouse:      clickhouseClient,
+			Database:        config.Aggregation.ClickHouse.Database,
+			AsyncInsert:     config.Sink.AsyncInsert.Enabled,
+			AsyncInsertWait: config.Sink.AsyncInsert.Wait,
 		},
 	)
 
+	// Archive events in the same flush, before ClickHouse as retried flushes overwrite the same archive files
+	var archiveStorage sink.Storage
+	if config.Sink.Archive.Enabled {
+		archiveStorage, err = initArchiveStorage(config.Sink.Archive)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize archive storage: %w", err)
+		}
+	}
+
 	consumerKafkaConfig := config.Ingest.Kafka.CreateKafkaConfig()
 	_ = consumerKafkaConfig.SetKey("group.id", config.Sink.GroupId)
 	_ = consumerKafkaConfig.SetKey("session.timeout.ms", 6000)
@@ -267,18 +344,66 @@ func initSink(config config.Configuration, logger *slog.Logger, metricMeter metr
 		return nil, fmt.Errorf("failed to initialize kafka consumer: %s", err)
 	}
 
+	err = gosundheit.RegisterCheck(healthChecker, gosundheit.NewKafkaCheck("kafka-consumer", consumer))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register kafka consumer health check: %w", err)
+	}
+
 	sinkConfig := sink.SinkConfig{
 		Logger:           logger,
 		Tracer:           tracer,
 		MetricMeter:      metricMeter,
 		MeterRepository:  meterRepository,
-		Storage:          storage,
+		Storage:          clickhouseStorage,
+		ArchiveStorage:   archiveStorage,
+		ProgressStorage:  clickhouseStorage,
 		Deduplicator:     deduplicator,
 		Consumer:         consumer,
 		MinCommitCount:   config.Sink.MinCommitCount,
 		MaxCommitWait:    config.Sink.MaxCommitWait,
 		NamespaceRefetch: config.Sink.NamespaceRefetch,
+
+		NamespaceRetryInitialInterval: config.Sink.NamespaceRetry.InitialInterval,
+		NamespaceRetryMaxInterval:     config.Sink.NamespaceRetry.MaxInterval,
+
+		AdaptiveBatching: sink.AdaptiveBatchingConfig{
+			Enabled:       config.Sink.AdaptiveBatching.Enabled,
+			MinBatchSize:  config.Sink.AdaptiveBatching.MinBatchSize,
+			MaxBatchSize:  config.Sink.AdaptiveBatching.MaxBatchSize,
+			TargetLatency: config.Sink.AdaptiveBatching.TargetLatency,
+			MaxThrottle:   config.Sink.AdaptiveBatching.MaxThrottle,
+		},
+
+		EventTypeRepository: eventTypeRepository,
 	}
 
 	return sink.NewSink(sinkConfig)
 }
+
+func initArchiveStorage(config config.ArchiveSinkConfiguration) (*archive.Storage, error) {
+	var bucket archive.Bucket
+	var err error
+
+	switch config.Driver {
+	case "local":
+		bucket, err = archive.NewLocalBucket(config.Local.Directory)
+	case "s3":
+		bucket, err = archive.NewS3Bucket(archive.S3BucketConfig{
+			Endpoint:        config.S3.Endpoint,
+			Region:          config.S3.Region,
+			Bucket:          config.S3.Bucket,
+			Prefix:          config.S3.Prefix,
+			AccessKeyID:     config.S3.AccessKeyID,
+			SecretAccessKey: config.S3.SecretAccessKey,
+			UsePathStyle:    config.S3.UsePathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("invalid archive driver: %s", config.Driver)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("init archive bucket: %w", err)
+	}
+
+	return archive.NewStorage(bucket)
+}
@@ -23,6 +23,7 @@ type Configuration struct {
 	Aggregation  AggregationConfiguration
 	Entitlements EntitlementsConfiguration
 	Dedupe       DedupeConfiguration
+	EventTypes   []EventTypeConfiguration
 	Ingest       IngestConfiguration
 	Meters       []*models.Meter
 	Namespace    NamespaceConfiguration
@@ -61,10 +62,24 @@ func (c Configuration) Validate() error {
 		return fmt.Errorf("dedupe: %w", err)
 	}
 
+	if c.Ingest.RateLimit.Enabled && c.Ingest.RateLimit.Driver == "redis" {
+		if _, ok := c.Dedupe.DedupeDriverConfiguration.(DedupeDriverRedisConfiguration); !ok {
+			return errors.New("ingest: rate limit: redis driver requires dedupe redis driver configuration")
+		}
+	}
+
+	if c.Ingest.SubjectAlias.Enabled && c.Postgres.URL == "" {
+		return errors.New("ingest: subject alias: postgres is required")
+	}
+
 	if err := c.Portal.Validate(); err != nil {
 		return fmt.Errorf("portal: %w", err)
 	}
 
+	if err := c.Entitlements.Validate(); err != nil {
+		return fmt.Errorf("entitlements: %w", err)
+	}
+
 	for _, m := range c.Meters {
 		// Namespace is not configurable on per meter level
 		m.Namespace = c.Namespace.Default
@@ -79,6 +94,12 @@ func (c Configuration) Validate() error {
 		}
 	}
 
+	for _, e := range c.EventTypes {
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("event types: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -108,4 +129,5 @@ func Configure(v *viper.Viper, flags *pflag.FlagSet) {
 	ConfigureSink(v)
 	ConfigureDedupe(v)
 	ConfigurePortal(v)
+	ConfigureEntitlements(v)
 }
@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/mitchellh/mapstructure"
@@ -169,10 +170,51 @@ type DedupeDriverRedisConfiguration struct {
 		Enabled    bool
 		MasterName string
 	}
+	// Cluster connects to a Redis Cluster, address is a comma separated list of seed nodes
+	Cluster struct {
+		Enabled bool
+	}
 	TLS struct {
 		Enabled            bool
 		InsecureSkipVerify bool
 	}
+	Probabilistic DedupeDriverRedisProbabilisticConfiguration
+}
+
+// DedupeDriverRedisProbabilisticConfiguration bounds the memory used by deduplication with Bloom or Cuckoo filters (requires RedisBloom).
+type DedupeDriverRedisProbabilisticConfiguration struct {
+	Enabled    bool
+	Filter     string
+	ErrorRate  float64
+	Capacity   int64
+	BucketSize time.Duration
+}
+
+// Validate validates the configuration.
+func (c DedupeDriverRedisProbabilisticConfiguration) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch redisdedupe.Filter(c.Filter) {
+	case redisdedupe.FilterBloom, redisdedupe.FilterCuckoo:
+	default:
+		return fmt.Errorf("invalid filter: %s", c.Filter)
+	}
+
+	if c.ErrorRate <= 0 || c.ErrorRate >= 1 {
+		return errors.New("error rate must be between 0 and 1")
+	}
+
+	if c.Capacity <= 0 {
+		return errors.New("capacity must be greater than 0")
+	}
+
+	if c.BucketSize <= 0 {
+		return errors.New("bucket size must be greater than 0")
+	}
+
+	return nil
 }
 
 func (DedupeDriverRedisConfiguration) DriverName() string {
@@ -180,6 +222,32 @@ func (DedupeDriverRedisConfiguration) DriverName() string {
 }
 
 func (c DedupeDriverRedisConfiguration) NewDeduplicator() (dedupe.Deduplicator, error) {
+	redisClient, err := c.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: close redis client when shutting down
+	if c.Probabilistic.Enabled {
+		return &redisdedupe.ProbabilisticDeduplicator{
+			Redis:      redisClient,
+			Expiration: c.Expiration,
+			Filter:     redisdedupe.Filter(c.Probabilistic.Filter),
+			ErrorRate:  c.Probabilistic.ErrorRate,
+			Capacity:   c.Probabilistic.Capacity,
+			BucketSize: c.Probabilistic.BucketSize,
+		}, nil
+	}
+
+	return redisdedupe.Deduplicator{
+		Redis:      redisClient,
+		Expiration: c.Expiration,
+		HashTag:    c.Cluster.Enabled,
+	}, nil
+}
+
+// NewClient returns a new Redis client. It is also used by other components sharing the dedupe Redis (eg. ingest rate limiting).
+func (c DedupeDriverRedisConfiguration) NewClient() (redis.UniversalClient, error) {
 	var tlsConfig *tls.Config
 
 	if c.TLS.Enabled {
@@ -188,9 +256,16 @@ func (c DedupeDriverRedisConfiguration) NewDeduplicator() (dedupe.Deduplicator,
 		}
 	}
 
-	var redisClient *redis.Client
+	var redisClient redis.UniversalClient
 
-	if c.Sentinel.Enabled {
+	if c.Cluster.Enabled {
+		redisClient = redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:     strings.Split(c.Address, ","),
+			Username:  c.Username,
+			Password:  c.Password,
+			TLSConfig: tlsConfig,
+		})
+	} else if c.Sentinel.Enabled {
 		redisClient = redis.NewFailoverClient(&redis.FailoverOptions{
 			MasterName:    c.Sentinel.MasterName,
 			SentinelAddrs: []string{c.Address},
@@ -221,12 +296,7 @@ func (c DedupeDriverRedisConfiguration) NewDeduplicator() (dedupe.Deduplicator,
 		return nil, err
 	}
 
-	// TODO: close redis client when shutting down
-	// TODO: register health check for redis
-	return redisdedupe.Deduplicator{
-		Redis:      redisClient,
-		Expiration: c.Expiration,
-	}, nil
+	return redisClient, nil
 }
 
 func (c DedupeDriverRedisConfiguration) Validate() error {
@@ -240,6 +310,31 @@ func (c DedupeDriverRedisConfiguration) Validate() error {
 		}
 	}
 
+	if c.Cluster.Enabled {
+		if c.Sentinel.Enabled {
+			return errors.New("cluster: cannot be used with sentinel")
+		}
+
+		if c.Database != 0 {
+			return errors.New("cluster: only database 0 is supported")
+		}
+	}
+
+	if err := c.Probabilistic.Validate(); err != nil {
+		return fmt.Errorf("probabilistic: %w", err)
+	}
+
+	// Every filter within the expiration is checked, so each filter needs a fraction of the error rate
+	if c.Probabilistic.Enabled && redisdedupe.Filter(c.Probabilistic.Filter) == redisdedupe.FilterCuckoo {
+		if rate := redisdedupe.FilterErrorRate(c.Probabilistic.ErrorRate, c.Expiration, c.Probabilistic.BucketSize); rate < redisdedupe.MinCuckooErrorRate {
+			return fmt.Errorf(
+				"probabilistic: error rate of cuckoo filters is too low: %g per filter, at least %g is required (increase the error rate or the bucket size)",
+				rate,
+				redisdedupe.MinCuckooErrorRate,
+			)
+		}
+	}
+
 	return nil
 }
 
@@ -259,6 +354,12 @@ func ConfigureDedupe(v *viper.Viper) {
 	v.SetDefault("dedupe.config.expiration", "24h")
 	v.SetDefault("dedupe.config.sentinel.enabled", false)
 	v.SetDefault("dedupe.config.sentinel.masterName", "")
+	v.SetDefault("dedupe.config.cluster.enabled", false)
+	v.SetDefault("dedupe.config.probabilistic.enabled", false)
+	v.SetDefault("dedupe.config.probabilistic.filter", "bloom")
+	v.SetDefault("dedupe.config.probabilistic.errorRate", 0.001)
+	v.SetDefault("dedupe.config.probabilistic.capacity", 1000000)
+	v.SetDefault("dedupe.config.probabilistic.bucketSize", "1h")
 	v.SetDefault("dedupe.config.tls.enabled", false)
 	v.SetDefault("dedupe.config.tls.insecureSkipVerify", false)
 }
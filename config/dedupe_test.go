@@ -0,0 +1,64 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDedupeDriverRedisConfiguration_Validate(t *testing.T) {
+	newConfig := func(filter string, errorRate float64, bucketSize time.Duration) DedupeDriverRedisConfiguration {
+		return DedupeDriverRedisConfiguration{
+			Address:    "127.0.0.1:6379",
+			Expiration: 24 * time.Hour,
+			Probabilistic: DedupeDriverRedisProbabilisticConfiguration{
+				Enabled:    true,
+				Filter:     filter,
+				ErrorRate:  errorRate,
+				Capacity:   1000,
+				BucketSize: bucketSize,
+			},
+		}
+	}
+
+	tests := []struct {
+		Name string
+
+		Config      DedupeDriverRedisConfiguration
+		ExpectError bool
+	}{
+		{
+			Name:   "Bloom",
+			Config: newConfig("bloom", 0.001, time.Hour),
+		},
+		{
+			Name:   "Cuckoo",
+			Config: newConfig("cuckoo", 0.05, 8*time.Hour),
+		},
+		{
+			// 25 filters share the error rate
+			Name:        "CuckooErrorRateTooLow",
+			Config:      newConfig("cuckoo", 0.05, time.Hour),
+			ExpectError: true,
+		},
+		{
+			Name:        "CuckooErrorRateUnreachable",
+			Config:      newConfig("cuckoo", 0.001, 24*time.Hour),
+			ExpectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Config.Validate()
+			if test.ExpectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,10 +1,223 @@
 package config
 
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
 type EntitlementsConfiguration struct {
 	Enabled bool
+
+	// GrantSchedules configures issuing the grants of recurring grant schedules
+	GrantSchedules GrantSchedulesConfiguration
+
+	// UsagePeriods configures resetting ledgers at the boundaries of their usage periods
+	UsagePeriods UsagePeriodsConfiguration
+
+	// Notifications configures evaluating notification rules and delivering webhooks
+	Notifications NotificationsConfiguration
+
+	// Checks configures the cache entitlement checks are served from
+	Checks EntitlementChecksConfiguration
+
+	// BalanceSnapshots configures snapshotting ledger balances
+	BalanceSnapshots BalanceSnapshotsConfiguration
 }
 
 // Validate validates the configuration.
 func (c EntitlementsConfiguration) Validate() error {
+	if err := c.GrantSchedules.Validate(); err != nil {
+		return fmt.Errorf("grant schedules: %w", err)
+	}
+
+	if err := c.UsagePeriods.Validate(); err != nil {
+		return fmt.Errorf("usage periods: %w", err)
+	}
+
+	if err := c.Notifications.Validate(); err != nil {
+		return fmt.Errorf("notifications: %w", err)
+	}
+
+	if err := c.Checks.Validate(); err != nil {
+		return fmt.Errorf("checks: %w", err)
+	}
+
+	if err := c.BalanceSnapshots.Validate(); err != nil {
+		return fmt.Errorf("balance snapshots: %w", err)
+	}
+
+	return nil
+}
+
+type GrantSchedulesConfiguration struct {
+	// Interval is the time between two runs issuing due grants
+	Interval time.Duration
+}
+
+// Validate validates the configuration.
+func (c GrantSchedulesConfiguration) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+
 	return nil
 }
+
+type UsagePeriodsConfiguration struct {
+	// Interval is the time between two runs resetting due ledgers
+	Interval time.Duration
+}
+
+// Validate validates the configuration.
+func (c UsagePeriodsConfiguration) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+
+	return nil
+}
+
+// NotificationsConfiguration configures notifying webhooks when the balance of a ledger crosses a threshold.
+type NotificationsConfiguration struct {
+	Enabled bool
+
+	// Interval is the time between two runs evaluating rules and delivering events
+	Interval time.Duration
+
+	// SigningSecret is the secret the webhook requests are signed with
+	SigningSecret string
+
+	// Timeout is the timeout of a webhook request
+	Timeout time.Duration
+
+	// MaxAttempts is the number of attempts after which a delivery fails
+	MaxAttempts int
+
+	// Retry configures retrying failed deliveries
+	Retry NotificationsRetryConfiguration
+}
+
+// Validate validates the configuration.
+func (c NotificationsConfiguration) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+
+	if c.SigningSecret == "" {
+		return errors.New("signing secret is required")
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("timeout must be positive")
+	}
+
+	if c.MaxAttempts < 1 {
+		return errors.New("max attempts must be at least 1")
+	}
+
+	if err := c.Retry.Validate(); err != nil {
+		return fmt.Errorf("retry: %w", err)
+	}
+
+	return nil
+}
+
+type NotificationsRetryConfiguration struct {
+	// InitialInterval is the time to wait before the first retry, it doubles after every failure
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+}
+
+// Validate validates the configuration.
+func (c NotificationsRetryConfiguration) Validate() error {
+	if c.InitialInterval <= 0 {
+		return errors.New("initial interval must be positive")
+	}
+
+	if c.MaxInterval < c.InitialInterval {
+		return errors.New("max interval must not be less than initial interval")
+	}
+
+	return nil
+}
+
+// EntitlementChecksConfiguration configures the cache of ledger balances entitlement checks are served from.
+type EntitlementChecksConfiguration struct {
+	// MaxStaleness is the maximum age of the balance a check is served from
+	MaxStaleness time.Duration
+
+	// RefreshInterval is the time between two runs recalculating cached balances before they get too stale
+	RefreshInterval time.Duration
+
+	// IdleTimeout is the time after which the balance of a subject not checked is evicted from the cache
+	IdleTimeout time.Duration
+}
+
+// Validate validates the configuration.
+func (c EntitlementChecksConfiguration) Validate() error {
+	if c.MaxStaleness <= 0 {
+		return errors.New("max staleness must be positive")
+	}
+
+	if c.RefreshInterval <= 0 {
+		return errors.New("refresh interval must be positive")
+	}
+
+	if c.RefreshInterval >= c.MaxStaleness {
+		return errors.New("refresh interval must be less than max staleness")
+	}
+
+	if c.IdleTimeout <= 0 {
+		return errors.New("idle timeout must be positive")
+	}
+
+	return nil
+}
+
+// BalanceSnapshotsConfiguration configures snapshotting ledger balances, balances are calculated from the last snapshot.
+type BalanceSnapshotsConfiguration struct {
+	// Interval is the time between two runs snapshotting the balances of ledgers
+	Interval time.Duration
+
+	// Delay is the minimum age of a snapshot, usage arriving later than that is not included in the balance
+	Delay time.Duration
+}
+
+// Validate validates the configuration.
+func (c BalanceSnapshotsConfiguration) Validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+
+	if c.Delay < 0 {
+		return errors.New("delay must not be negative")
+	}
+
+	return nil
+}
+
+// ConfigureEntitlements configures some defaults in the Viper instance.
+func ConfigureEntitlements(v *viper.Viper) {
+	v.SetDefault("entitlements.enabled", false)
+	v.SetDefault("entitlements.grantSchedules.interval", "1m")
+	v.SetDefault("entitlements.usagePeriods.interval", "1m")
+	v.SetDefault("entitlements.notifications.enabled", false)
+	v.SetDefault("entitlements.notifications.interval", "1m")
+	v.SetDefault("entitlements.notifications.signingSecret", "")
+	v.SetDefault("entitlements.notifications.timeout", "10s")
+	v.SetDefault("entitlements.notifications.maxAttempts", 10)
+	v.SetDefault("entitlements.notifications.retry.initialInterval", "1m")
+	v.SetDefault("entitlements.notifications.retry.maxInterval", "6h")
+	v.SetDefault("entitlements.checks.maxStaleness", "1m")
+	v.SetDefault("entitlements.checks.refreshInterval", "15s")
+	v.SetDefault("entitlements.checks.idleTimeout", "10m")
+	v.SetDefault("entitlements.balanceSnapshots.interval", "10m")
+	v.SetDefault("entitlements.balanceSnapshots.delay", "1h")
+}
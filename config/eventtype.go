@@ -0,0 +1,49 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/openmeterio/openmeter/internal/eventtype"
+)
+
+// EventTypeConfiguration defines an event type with the JSON Schema of its event data.
+type EventTypeConfiguration struct {
+	Type        string
+	Description string
+	// Schema is a JSON document, as configuration keys are case-insensitive and would break JSON Schema keywords like minLength
+	Schema string
+}
+
+// Validate validates the configuration.
+func (c EventTypeConfiguration) Validate() error {
+	if c.Schema == "" {
+		return errors.New("schema is required")
+	}
+
+	eventType, err := c.EventType("")
+	if err != nil {
+		return err
+	}
+
+	return eventType.Validate()
+}
+
+// EventType returns the event type of a namespace.
+func (c EventTypeConfiguration) EventType(namespace string) (eventtype.EventType, error) {
+	eventType := eventtype.EventType{
+		Namespace: namespace,
+		Type:      c.Type,
+	}
+
+	if c.Description != "" {
+		eventType.Description = &c.Description
+	}
+
+	if err := json.Unmarshal([]byte(c.Schema), &eventType.Schema); err != nil {
+		return eventtype.EventType{}, fmt.Errorf("invalid schema of event type %s: %w", c.Type, err)
+	}
+
+	return eventType, nil
+}
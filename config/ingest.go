@@ -9,11 +9,20 @@ import (
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/spf13/viper"
 
+	"github.com/openmeterio/openmeter/internal/ingest"
+	"github.com/openmeterio/openmeter/internal/ratelimit"
+	"github.com/openmeterio/openmeter/internal/ratelimit/memoryratelimit"
+	"github.com/openmeterio/openmeter/internal/ratelimit/redisratelimit"
 	pkgkafka "github.com/openmeterio/openmeter/pkg/kafka"
 )
 
 type IngestConfiguration struct {
-	Kafka KafkaIngestConfiguration
+	Kafka     KafkaIngestConfiguration
+	GRPC      GRPCIngestConfiguration
+	RateLimit RateLimitIngestConfiguration
+	Spool     SpoolIngestConfiguration
+
+	SubjectAlias SubjectAliasIngestConfiguration
 }
 
 // Validate validates the configuration.
@@ -22,6 +31,41 @@ func (c IngestConfiguration) Validate() error {
 		return fmt.Errorf("kafka: %w", err)
 	}
 
+	if err := c.GRPC.Validate(); err != nil {
+		return fmt.Errorf("grpc: %w", err)
+	}
+
+	if err := c.RateLimit.Validate(); err != nil {
+		return fmt.Errorf("rate limit: %w", err)
+	}
+
+	if err := c.Spool.Validate(); err != nil {
+		return fmt.Errorf("spool: %w", err)
+	}
+
+	if err := c.SubjectAlias.Validate(); err != nil {
+		return fmt.Errorf("subject alias: %w", err)
+	}
+
+	return nil
+}
+
+// GRPCIngestConfiguration configures the gRPC ingest service accepting CloudEvents in protobuf format.
+type GRPCIngestConfiguration struct {
+	Enabled bool
+	Address string
+}
+
+// Validate validates the configuration.
+func (c GRPCIngestConfiguration) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Address == "" {
+		return errors.New("address is required")
+	}
+
 	return nil
 }
 
@@ -124,6 +168,172 @@ func (c KafkaIngestConfiguration) Validate() error {
 	return nil
 }
 
+// RateLimitIngestConfiguration configures token bucket rate limits applied to ingested events.
+type RateLimitIngestConfiguration struct {
+	Enabled bool
+	// Driver is either memory (limits are enforced per instance) or redis (limits are shared using the dedupe Redis configuration).
+	Driver string
+	// Size is the maximum number of buckets kept by the memory driver, it should be larger than the number of
+	// namespaces, subjects and sources limited at once. Once it is reached new buckets start empty instead of with a burst.
+	Size int
+
+	Namespace RateLimit
+	Subject   RateLimit
+	Source    RateLimit
+}
+
+// RateLimit configures a token bucket. Leave it empty to disable the limit.
+type RateLimit struct {
+	// Rate is the number of events allowed per second.
+	Rate float64
+	// Burst is the number of events allowed at once.
+	Burst int
+}
+
+func (c RateLimit) limit() ratelimit.Limit {
+	return ratelimit.Limit{
+		Rate:  c.Rate,
+		Burst: c.Burst,
+	}
+}
+
+// Validate validates the configuration.
+func (c RateLimit) Validate() error {
+	if c.Rate < 0 {
+		return errors.New("rate must be positive")
+	}
+
+	if c.Burst < 0 {
+		return errors.New("burst must be positive")
+	}
+
+	if c.Rate > 0 && c.Burst == 0 {
+		return errors.New("burst is required")
+	}
+
+	return nil
+}
+
+// Limits returns the rate limits applied by the ingest collector.
+func (c RateLimitIngestConfiguration) Limits() ingest.RateLimits {
+	return ingest.RateLimits{
+		Namespace: c.Namespace.limit(),
+		Subject:   c.Subject.limit(),
+		Source:    c.Source.limit(),
+	}
+}
+
+// NewLimiter returns a new rate limiter for the configured driver.
+func (c RateLimitIngestConfiguration) NewLimiter(dedupe DedupeConfiguration) (ratelimit.Limiter, error) {
+	switch c.Driver {
+	case "memory":
+		return memoryratelimit.NewLimiter(c.Size)
+	case "redis":
+		redisConfig, ok := dedupe.DedupeDriverConfiguration.(DedupeDriverRedisConfiguration)
+		if !ok {
+			return nil, errors.New("rate limit: redis driver requires dedupe redis driver configuration")
+		}
+
+		redisClient, err := redisConfig.NewClient()
+		if err != nil {
+			return nil, fmt.Errorf("rate limit: %w", err)
+		}
+
+		return redisratelimit.Limiter{
+			Redis: redisClient,
+		}, nil
+	default:
+		return nil, fmt.Errorf("rate limit: unknown driver: %s", c.Driver)
+	}
+}
+
+// Validate validates the configuration.
+func (c RateLimitIngestConfiguration) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch c.Driver {
+	case "memory", "redis":
+	default:
+		return fmt.Errorf("unknown driver: %s", c.Driver)
+	}
+
+	if err := c.Namespace.Validate(); err != nil {
+		return fmt.Errorf("namespace: %w", err)
+	}
+
+	if err := c.Subject.Validate(); err != nil {
+		return fmt.Errorf("subject: %w", err)
+	}
+
+	if err := c.Source.Validate(); err != nil {
+		return fmt.Errorf("source: %w", err)
+	}
+
+	return nil
+}
+
+// SpoolIngestConfiguration configures the on-disk spool holding events while Kafka is unavailable.
+type SpoolIngestConfiguration struct {
+	Enabled bool
+	// Directory holds the spool files. It should be on a persistent volume to survive restarts.
+	Directory string
+	// MaxSegmentSize is the size of spool files in bytes.
+	MaxSegmentSize int64
+	// Sync flushes every spooled event to disk (slower, but survives machine crashes).
+	Sync bool
+	// ReplayBatchSize is the number of spooled events produced at once when Kafka recovers.
+	ReplayBatchSize int
+	// ReplayInterval is the time between attempts to replay spooled events.
+	ReplayInterval time.Duration
+}
+
+// Validate validates the configuration.
+func (c SpoolIngestConfiguration) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Directory == "" {
+		return errors.New("directory is required")
+	}
+
+	if c.MaxSegmentSize <= 0 {
+		return errors.New("max segment size must be greater than zero")
+	}
+
+	if c.ReplayBatchSize <= 0 {
+		return errors.New("replay batch size must be greater than zero")
+	}
+
+	if c.ReplayInterval <= 0 {
+		return errors.New("replay interval must be greater than zero")
+	}
+
+	return nil
+}
+
+// SubjectAliasIngestConfiguration configures replacing aliased subjects with their canonical subject at ingestion.
+type SubjectAliasIngestConfiguration struct {
+	Enabled bool
+	// CacheTTL is the time aliases are cached for. Changes made through other instances are applied after at most CacheTTL.
+	CacheTTL time.Duration
+}
+
+// Validate validates the configuration.
+func (c SubjectAliasIngestConfiguration) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.CacheTTL <= 0 {
+		return errors.New("cache ttl must be greater than zero")
+	}
+
+	return nil
+}
+
 // Configure configures some defaults in the Viper instance.
 func ConfigureIngest(v *viper.Viper) {
 	v.SetDefault("ingest.kafka.broker", "127.0.0.1:29092")
@@ -133,4 +343,17 @@ func ConfigureIngest(v *viper.Viper) {
 	v.SetDefault("ingest.kafka.saslPassword", "")
 	v.SetDefault("ingest.kafka.partitions", 1)
 	v.SetDefault("ingest.kafka.eventsTopicTemplate", "om_%s_events")
+	v.SetDefault("ingest.grpc.enabled", false)
+	v.SetDefault("ingest.grpc.address", "127.0.0.1:9090")
+	v.SetDefault("ingest.rateLimit.enabled", false)
+	v.SetDefault("ingest.rateLimit.driver", "memory")
+	v.SetDefault("ingest.rateLimit.size", 10000)
+	v.SetDefault("ingest.spool.enabled", false)
+	v.SetDefault("ingest.spool.directory", "/var/lib/openmeter/spool")
+	v.SetDefault("ingest.spool.maxSegmentSize", 64*1024*1024)
+	v.SetDefault("ingest.spool.sync", false)
+	v.SetDefault("ingest.spool.replayBatchSize", 1000)
+	v.SetDefault("ingest.spool.replayInterval", 5*time.Second)
+	v.SetDefault("ingest.subjectAlias.enabled", false)
+	v.SetDefault("ingest.subjectAlias.cacheTTL", 30*time.Second)
 }
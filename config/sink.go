@@ -2,6 +2,7 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/spf13/viper"
@@ -13,6 +14,11 @@ type SinkConfiguration struct {
 	MinCommitCount   int
 	MaxCommitWait    time.Duration
 	NamespaceRefetch time.Duration
+	NamespaceRetry   NamespaceRetryConfiguration
+	AdaptiveBatching AdaptiveBatchingConfiguration
+	Archive          ArchiveSinkConfiguration
+	Admin            SinkAdminConfiguration
+	AsyncInsert      AsyncInsertConfiguration
 }
 
 func (c SinkConfiguration) Validate() error {
@@ -28,6 +34,182 @@ func (c SinkConfiguration) Validate() error {
 		return errors.New("NamespaceRefetch must be greater than 0")
 	}
 
+	if err := c.NamespaceRetry.Validate(); err != nil {
+		return fmt.Errorf("namespace retry: %w", err)
+	}
+
+	if err := c.AdaptiveBatching.Validate(); err != nil {
+		return fmt.Errorf("adaptive batching: %w", err)
+	}
+
+	if err := c.Archive.Validate(); err != nil {
+		return fmt.Errorf("archive: %w", err)
+	}
+
+	if err := c.Admin.Validate(); err != nil {
+		return fmt.Errorf("admin: %w", err)
+	}
+
+	if err := c.AsyncInsert.Validate(); err != nil {
+		return fmt.Errorf("async insert: %w", err)
+	}
+
+	return nil
+}
+
+// AsyncInsertConfiguration configures ClickHouse async inserts for the events inserted by the sink.
+// ClickHouse buffers async inserts server side and writes them in larger parts.
+type AsyncInsertConfiguration struct {
+	Enabled bool
+	// Wait makes inserts wait until ClickHouse writes the buffered events.
+	// Without waiting, events acknowledged but not written yet are lost if ClickHouse fails.
+	Wait bool
+	// Replicated confirms the events table is a Replicated*MergeTree table.
+	// Retried flushes are deduplicated by tokens ClickHouse only honors for async inserts into replicated tables,
+	// on the MergeTree table created by OpenMeter retried flushes would insert events twice.
+	Replicated bool
+}
+
+// Validate validates the configuration.
+func (c AsyncInsertConfiguration) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if !c.Replicated {
+		return errors.New("requires a replicated events table to deduplicate retried flushes, set replicated if the events table is replicated")
+	}
+
+	return nil
+}
+
+// SinkAdminConfiguration configures the admin API of the sink worker to inspect and control consumption at runtime.
+// The API is not authenticated, so it should only listen on a private address.
+type SinkAdminConfiguration struct {
+	Enabled bool
+	Address string
+}
+
+// Validate validates the configuration.
+func (c SinkAdminConfiguration) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Address == "" {
+		return errors.New("address is required")
+	}
+
+	return nil
+}
+
+// AdaptiveBatchingConfiguration configures adjusting the batch size to ClickHouse insert latency and errors.
+// When enabled, MinCommitCount is the initial batch size.
+type AdaptiveBatchingConfiguration struct {
+	Enabled      bool
+	MinBatchSize int
+	MaxBatchSize int
+	// TargetLatency is the insert latency the batch size is adjusted to
+	TargetLatency time.Duration
+	// MaxThrottle is the maximum time consumption is paused while ClickHouse is degraded
+	MaxThrottle time.Duration
+}
+
+// Validate validates the configuration.
+func (c AdaptiveBatchingConfiguration) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.MinBatchSize < 1 {
+		return errors.New("MinBatchSize must be greater than 0")
+	}
+
+	if c.MaxBatchSize < c.MinBatchSize {
+		return errors.New("MaxBatchSize must not be less than MinBatchSize")
+	}
+
+	if c.TargetLatency < 1 {
+		return errors.New("TargetLatency must be greater than 0")
+	}
+
+	if c.MaxThrottle < 1 {
+		return errors.New("MaxThrottle must be greater than 0")
+	}
+
+	return nil
+}
+
+// NamespaceRetryConfiguration configures retrying the flush of a namespace that failed to persist.
+// The partitions of the namespace are paused until the flush succeeds, other namespaces keep flushing.
+type NamespaceRetryConfiguration struct {
+	// InitialInterval is the time to wait before the first retry, it doubles after every failure
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+}
+
+// Validate validates the configuration.
+func (c NamespaceRetryConfiguration) Validate() error {
+	if c.InitialInterval < 1 {
+		return errors.New("InitialInterval must be greater than 0")
+	}
+
+	if c.MaxInterval < c.InitialInterval {
+		return errors.New("MaxInterval must not be less than InitialInterval")
+	}
+
+	return nil
+}
+
+// ArchiveSinkConfiguration configures archiving raw events to Parquet files next to ClickHouse.
+type ArchiveSinkConfiguration struct {
+	Enabled bool
+	// Driver is the archive destination: local or s3
+	Driver string
+	Local  ArchiveLocalConfiguration
+	S3     ArchiveS3Configuration
+}
+
+// ArchiveLocalConfiguration configures archiving to a local directory.
+type ArchiveLocalConfiguration struct {
+	Directory string
+}
+
+// ArchiveS3Configuration configures archiving to an S3-compatible bucket.
+type ArchiveS3Configuration struct {
+	// Endpoint is the URL of an S3-compatible endpoint, the AWS endpoint of the region is used when empty.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+	UsePathStyle    bool
+}
+
+// Validate validates the configuration.
+func (c ArchiveSinkConfiguration) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch c.Driver {
+	case "local":
+		if c.Local.Directory == "" {
+			return errors.New("local: directory is required")
+		}
+	case "s3":
+		if c.S3.Region == "" {
+			return errors.New("s3: region is required")
+		}
+
+		if c.S3.Bucket == "" {
+			return errors.New("s3: bucket is required")
+		}
+	default:
+		return fmt.Errorf("invalid driver: %s", c.Driver)
+	}
+
 	return nil
 }
 
@@ -48,6 +230,12 @@ func ConfigureSink(v *viper.Viper) {
 	v.SetDefault("sink.dedupe.config.expiration", "24h")
 	v.SetDefault("sink.dedupe.config.sentinel.enabled", false)
 	v.SetDefault("sink.dedupe.config.sentinel.masterName", "")
+	v.SetDefault("sink.dedupe.config.cluster.enabled", false)
+	v.SetDefault("sink.dedupe.config.probabilistic.enabled", false)
+	v.SetDefault("sink.dedupe.config.probabilistic.filter", "bloom")
+	v.SetDefault("sink.dedupe.config.probabilistic.errorRate", 0.001)
+	v.SetDefault("sink.dedupe.config.probabilistic.capacity", 1000000)
+	v.SetDefault("sink.dedupe.config.probabilistic.bucketSize", "1h")
 	v.SetDefault("sink.dedupe.config.tls.enabled", false)
 	v.SetDefault("sink.dedupe.config.tls.insecureSkipVerify", false)
 
@@ -56,4 +244,34 @@ func ConfigureSink(v *viper.Viper) {
 	v.SetDefault("sink.minCommitCount", 500)
 	v.SetDefault("sink.maxCommitWait", "5s")
 	v.SetDefault("sink.namespaceRefetch", "15s")
+	v.SetDefault("sink.namespaceRetry.initialInterval", "1s")
+	v.SetDefault("sink.namespaceRetry.maxInterval", "1m")
+
+	// Sink Adaptive Batching
+	v.SetDefault("sink.adaptiveBatching.enabled", false)
+	v.SetDefault("sink.adaptiveBatching.minBatchSize", 100)
+	v.SetDefault("sink.adaptiveBatching.maxBatchSize", 10000)
+	v.SetDefault("sink.adaptiveBatching.targetLatency", "1s")
+	v.SetDefault("sink.adaptiveBatching.maxThrottle", "30s")
+
+	// Sink ClickHouse async inserts
+	v.SetDefault("sink.asyncInsert.enabled", false)
+	v.SetDefault("sink.asyncInsert.wait", true)
+	v.SetDefault("sink.asyncInsert.replicated", false)
+
+	// Sink Admin API
+	v.SetDefault("sink.admin.enabled", false)
+	v.SetDefault("sink.admin.address", "127.0.0.1:10001")
+
+	// Sink Archive
+	v.SetDefault("sink.archive.enabled", false)
+	v.SetDefault("sink.archive.driver", "local")
+	v.SetDefault("sink.archive.local.directory", "/var/lib/openmeter/archive")
+	v.SetDefault("sink.archive.s3.endpoint", "")
+	v.SetDefault("sink.archive.s3.region", "")
+	v.SetDefault("sink.archive.s3.bucket", "")
+	v.SetDefault("sink.archive.s3.prefix", "")
+	v.SetDefault("sink.archive.s3.accessKeyId", "")
+	v.SetDefault("sink.archive.s3.secretAccessKey", "")
+	v.SetDefault("sink.archive.s3.usePathStyle", false)
 }
@@ -0,0 +1,15 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsyncInsertConfiguration_Validate(t *testing.T) {
+	assert.NoError(t, AsyncInsertConfiguration{}.Validate())
+	assert.NoError(t, AsyncInsertConfiguration{Enabled: true, Wait: true, Replicated: true}.Validate())
+
+	// Retried flushes are not deduplicated by async inserts into tables that are not replicated
+	assert.Error(t, AsyncInsertConfiguration{Enabled: true, Wait: true}.Validate())
+}
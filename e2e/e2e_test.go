@@ -599,7 +599,7 @@ func TestCredit(t *testing.T) {
 		// Create grant
 		resp, err := client.CreateLedgerGrantWithResponse(context.Background(), ledgerID, api.CreateLedgerGrantRequest{
 			Type:        api.LedgerGrantTypeUsage,
-			FeatureID:   *featureId,
+			FeatureID:   featureId,
 			Amount:      100,
 			Priority:    &priority,
 			EffectiveAt: effectiveAt,
@@ -623,7 +623,7 @@ func TestCredit(t *testing.T) {
 			Id:          resp.JSON201.Id,
 			ExpiresAt:   resp.JSON201.ExpiresAt,
 			Type:        api.LedgerGrantTypeUsage,
-			FeatureID:   *featureId,
+			FeatureID:   featureId,
 			Amount:      100,
 			LedgerID:    string(ledgerID),
 			Priority:    &priority,
@@ -697,7 +697,7 @@ func TestCredit(t *testing.T) {
 						Count:    1,
 					},
 					ExpiresAt: grant.ExpiresAt,
-					FeatureID: *feature.Id,
+					FeatureID: feature.Id,
 					ParentId:  grant.ParentId,
 					Metadata:  grant.Metadata,
 					Priority:  grant.Priority,
@@ -765,7 +765,7 @@ func TestCredit(t *testing.T) {
 				Id:          grants[0].Id,
 				ParentId:    parentGrant.Id,
 				Type:        api.LedgerGrantTypeUsage,
-				FeatureID:   *featureId,
+				FeatureID:   featureId,
 				Amount:      99,
 				Priority:    &priority,
 				EffectiveAt: effectiveAt,
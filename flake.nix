@@ -72,6 +72,11 @@
               pkg-config
               # confluent-platform
 
+              # Protobuf code generation
+              protobuf
+              protoc-gen-go
+              protoc-gen-go-grpc
+
               # golangci-lint
               goreleaser
               air
@@ -2,6 +2,7 @@ package credit
 
 import (
 	"net/http"
+	"time"
 )
 
 // Balance of a subject in a credit.
@@ -11,6 +12,12 @@ type Balance struct {
 	Subject         string            `json:"subject"`
 	FeatureBalances []FeatureBalance  `json:"featureBalances"`
 	GrantBalances   []GrantBalance    `json:"grantBalances"`
+	// Overage is the usage beyond the granted amount allowed by the overage limits of the ledger
+	Overage float64 `json:"overage"`
+	// CompleteUntil is the time usage data is complete until, usage after it may still be missing from the balance
+	CompleteUntil *time.Time `json:"completeUntil,omitempty"`
+	// CurrentPeriod is the usage period of the ledger the balance is calculated in if the ledger has a usage period
+	CurrentPeriod *Period `json:"currentPeriod,omitempty"`
 }
 
 // Render implements the chi renderer interface.
@@ -25,6 +32,47 @@ type GrantBalance struct {
 
 type FeatureBalance struct {
 	Feature
+	// Balance is the remaining balance of the grants of the feature not denominated in a currency
 	Balance float64 `json:"balance"`
-	Usage   float64 `json:"usage"`
+	// Usage is the usage burned down from grants
+	Usage float64 `json:"usage"`
+	// MonetaryUsage is the usage paid with grants denominated in the currency of the unit price of the feature, it is part of the usage
+	MonetaryUsage *MonetaryUsage `json:"monetaryUsage,omitempty"`
+	// Overage is the usage beyond the granted amount allowed by the overage limits of the ledger
+	Overage float64 `json:"overage"`
+}
+
+// MonetaryUsage is the usage of a feature paid with grants denominated in a currency since the last reset.
+type MonetaryUsage struct {
+	// Units is the usage paid for, the tiers of the unit price graduate on it.
+	Units float64 `json:"units"`
+	// Amount is the cost of the usage.
+	Amount float64 `json:"amount"`
+}
+
+// BalanceSnapshot is the state of the balance calculation of a ledger at a time.
+// Balances are calculated from the last snapshot before the cutline instead of from the last reset.
+type BalanceSnapshot struct {
+	Namespace string   `json:"-"`
+	LedgerID  LedgerID `json:"ledgerID"`
+
+	// At is the time the snapshot is taken at, it is the effective or expiration time of a grant.
+	At time.Time `json:"at"`
+
+	// GrantBalances are the remaining amounts of the grants effective at the time of the snapshot.
+	GrantBalances map[GrantID]float64 `json:"grantBalances"`
+
+	// UncoveredUsage is the usage of features not burned down by grants, the overage is limited from it.
+	UncoveredUsage map[FeatureID]float64 `json:"uncoveredUsage"`
+
+	// Features are the features of the namespace burning down grants without feature.
+	Features []FeatureID `json:"features"`
+
+	// OverageFeatures are the features the uncovered usage is tracked for.
+	OverageFeatures []FeatureID `json:"overageFeatures"`
+
+	// MonetaryUsage is the usage of features paid with grants denominated in a currency.
+	MonetaryUsage map[FeatureID]MonetaryUsage `json:"monetaryUsage,omitempty"`
+
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
 }
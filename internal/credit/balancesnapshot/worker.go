@@ -0,0 +1,90 @@
+// Package balancesnapshot snapshots the balances of ledgers so balances are calculated from the last snapshot.
+package balancesnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+)
+
+// WorkerConfig configures a {Worker}.
+type WorkerConfig struct {
+	// Interval is the time between two runs snapshotting balances.
+	Interval time.Duration
+
+	// Delay is the minimum age of a snapshot, usage arriving later than that is not included in the balance.
+	Delay time.Duration
+}
+
+// Worker snapshots the balances of ledgers.
+//
+// Snapshotting is idempotent, several workers (eg. one per server replica) can run at the same time.
+type Worker struct {
+	Connector credit.Connector
+	Logger    *slog.Logger
+	Config    WorkerConfig
+}
+
+// NewWorker returns a new {Worker}.
+func NewWorker(connector credit.Connector, logger *slog.Logger, config WorkerConfig) (*Worker, error) {
+	if connector == nil {
+		return nil, fmt.Errorf("connector is required")
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+
+	if config.Interval <= 0 {
+		return nil, fmt.Errorf("interval must be positive")
+	}
+
+	if config.Delay < 0 {
+		return nil, fmt.Errorf("delay must not be negative")
+	}
+
+	return &Worker{
+		Connector: connector,
+		Logger:    logger,
+		Config:    config,
+	}, nil
+}
+
+// Run snapshots balances periodically. It is meant to be added to a [run.Group].
+func (w *Worker) Run(ctx context.Context) (execute func() error, interrupt func(error)) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return func() error {
+			ticker := time.NewTicker(w.Config.Interval)
+			defer ticker.Stop()
+
+			for {
+				w.snapshot(ctx)
+
+				select {
+				case <-ticker.C:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		},
+		func(error) {
+			cancel()
+		}
+}
+
+func (w *Worker) snapshot(ctx context.Context) {
+	snapshots, err := w.Connector.SnapshotBalances(ctx, time.Now().Add(-w.Config.Delay))
+	if err != nil && !errors.Is(err, context.Canceled) {
+		// Ledgers failing to snapshot are retried on the next run
+		w.Logger.WarnContext(ctx, "snapshotting balances failed", "error", err)
+	}
+
+	if len(snapshots) > 0 {
+		w.Logger.InfoContext(ctx, "snapshotted balances", "count", len(snapshots))
+	}
+}
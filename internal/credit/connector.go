@@ -15,6 +15,22 @@ type ListGrantsParams struct {
 	Limit             int
 }
 
+type ListGrantSchedulesParams struct {
+	Namespace string
+	LedgerIDs []LedgerID
+}
+
+type ListNotificationRulesParams struct {
+	Namespace string
+	LedgerIDs []LedgerID
+}
+
+type ListNotificationDeliveriesParams struct {
+	Namespace string
+	RuleIDs   []NotificationRuleID
+	Limit     int
+}
+
 type FeatureOrderBy string
 
 const (
@@ -57,21 +73,45 @@ type Connector interface {
 	// Ledger
 	CreateLedger(ctx context.Context, ledger Ledger) (Ledger, error)
 	ListLedgers(ctx context.Context, params ListLedgersParams) ([]Ledger, error)
+	UpdateOverageLimits(ctx context.Context, ledgerID NamespacedLedgerID, limits OverageLimits) (Ledger, error)
+	UpdateUsagePeriod(ctx context.Context, ledgerID NamespacedLedgerID, usagePeriod *Recurrence) (Ledger, error)
 
 	// Grant
 	CreateGrant(ctx context.Context, grant Grant) (Grant, error)
 	VoidGrant(ctx context.Context, grant Grant) (Grant, error)
 	ListGrants(ctx context.Context, params ListGrantsParams) ([]Grant, error)
 	GetGrant(ctx context.Context, grantID NamespacedGrantID) (Grant, error)
+	AmendGrant(ctx context.Context, amendment GrantAmendment) (GrantAmendment, error)
+	ListGrantAmendments(ctx context.Context, grantID NamespacedGrantID) ([]GrantAmendment, error)
+
+	// Grant schedule
+	CreateGrantSchedule(ctx context.Context, schedule GrantSchedule) (GrantSchedule, error)
+	ListGrantSchedules(ctx context.Context, params ListGrantSchedulesParams) ([]GrantSchedule, error)
+	GetGrantSchedule(ctx context.Context, scheduleID NamespacedGrantScheduleID) (GrantSchedule, error)
+	DeleteGrantSchedule(ctx context.Context, scheduleID NamespacedGrantScheduleID) error
+	IssueScheduledGrants(ctx context.Context, until time.Time) ([]Grant, error)
+
+	// Notification
+	CreateNotificationRule(ctx context.Context, rule NotificationRule) (NotificationRule, error)
+	ListNotificationRules(ctx context.Context, params ListNotificationRulesParams) ([]NotificationRule, error)
+	GetNotificationRule(ctx context.Context, ruleID NamespacedNotificationRuleID) (NotificationRule, error)
+	DeleteNotificationRule(ctx context.Context, ruleID NamespacedNotificationRuleID) error
+	EvaluateNotificationRules(ctx context.Context, at time.Time) ([]NotificationDelivery, error)
+	ListNotificationDeliveries(ctx context.Context, params ListNotificationDeliveriesParams) ([]NotificationDelivery, error)
+	ClaimNotificationDeliveries(ctx context.Context, until time.Time, lease time.Duration, limit int) ([]NotificationDelivery, error)
+	UpdateNotificationDelivery(ctx context.Context, delivery NotificationDelivery) (NotificationDelivery, error)
 
 	// Credit
 	GetBalance(ctx context.Context, ledgerID NamespacedLedgerID, cutline time.Time) (Balance, error)
 	GetHistory(ctx context.Context, ledgerID NamespacedLedgerID, from time.Time, to time.Time, pagination Pagination) (LedgerEntryList, error)
 	GetHighWatermark(ctx context.Context, ledgerID NamespacedLedgerID) (HighWatermark, error)
 	Reset(ctx context.Context, reset Reset) (Reset, []Grant, error)
+	ResetUsagePeriods(ctx context.Context, until time.Time) ([]Reset, error)
+	SnapshotBalances(ctx context.Context, until time.Time) ([]BalanceSnapshot, error)
 
 	// Feature
 	CreateFeature(ctx context.Context, feature Feature) (Feature, error)
+	UpdateFeature(ctx context.Context, featureID NamespacedFeatureID, update FeatureUpdate) (Feature, error)
 	DeleteFeature(ctx context.Context, featureID NamespacedFeatureID) error
 	ListFeatures(ctx context.Context, params ListFeaturesParams) ([]Feature, error)
 	GetFeature(ctx context.Context, featureID NamespacedFeatureID) (Feature, error)
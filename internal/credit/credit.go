@@ -56,9 +56,10 @@ type EntryType string
 
 // Defines values for EntryType.
 const (
-	EntryTypeGrant     EntryType = "GRANT"
-	EntryTypeVoidGrant EntryType = "VOID_GRANT"
-	EntryTypeReset     EntryType = "RESET"
+	EntryTypeGrant      EntryType = "GRANT"
+	EntryTypeVoidGrant  EntryType = "VOID_GRANT"
+	EntryTypeReset      EntryType = "RESET"
+	EntryTypeAmendGrant EntryType = "AMEND_GRANT"
 )
 
 func (EntryType) Values() (kinds []string) {
@@ -66,6 +67,7 @@ func (EntryType) Values() (kinds []string) {
 		EntryTypeGrant,
 		EntryTypeVoidGrant,
 		EntryTypeReset,
+		EntryTypeAmendGrant,
 	} {
 		kinds = append(kinds, string(s))
 	}
@@ -128,6 +130,9 @@ type Grant struct {
 	// Parent ID is the readonly identifies of the grant's parent if any.
 	ParentID *GrantID `json:"parentID,omitempty"`
 
+	// ScheduleID is the readonly identifier of the grant schedule that issued the grant if any.
+	ScheduleID *GrantScheduleID `json:"scheduleID,omitempty"`
+
 	// Subject The subject to grant the amount to.
 	LedgerID LedgerID `json:"ledgerID"`
 
@@ -140,6 +145,10 @@ type Grant struct {
 	// Amount The amount to grant. Can be positive or negative number.
 	Amount float64 `json:"amount"`
 
+	// Currency The ISO 4217 currency the amount is denominated in, the amount is in units of usage if not set.
+	// Grants in a currency are burned down by the cost of the usage of features with a unit price in the currency.
+	Currency *string `json:"currency,omitempty"`
+
 	// Priority is a positive decimal numbers. With lower numbers indicating higher importance;
 	// for example, a priority of 1 is more urgent than a priority of 2.
 	// When there are several credit grants available for a single invoice, the system selects the credit with the highest priority.
@@ -167,7 +176,12 @@ type Grant struct {
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 }
 
+// ExpirationDate returns the expiration time of the grant, amendments can change it from the expiration period.
 func (c Grant) ExpirationDate() time.Time {
+	if !c.ExpiresAt.IsZero() {
+		return c.ExpiresAt
+	}
+
 	return c.Expiration.GetExpiration(c.EffectiveAt)
 }
 
@@ -79,26 +79,46 @@ func mapBalanceToAPI(balance credit.Balance, highwatermark credit.HighWatermark)
 		grantBalances = append(grantBalances, mapGrantBalanceToAPI(grantBalance))
 	}
 
+	var currentPeriod *api.Period
+	if balance.CurrentPeriod != nil {
+		currentPeriod = &api.Period{
+			From: balance.CurrentPeriod.From,
+			To:   balance.CurrentPeriod.To,
+		}
+	}
+
 	return api.LedgerBalance{
+		CompleteUntil:   balance.CompleteUntil,
+		CurrentPeriod:   currentPeriod,
 		FeatureBalances: featureBalances,
 		GrantBalances:   grantBalances,
 		LastReset:       &highwatermark.Time,
 		Metadata:        &balance.Metadata,
+		Overage:         balance.Overage,
 		Subject:         balance.Subject,
 	}
 }
 
 func mapFeatureBalanceToAPI(featureBalance credit.FeatureBalance) api.FeatureBalance {
+	var filterVersions *[]credit.FeatureFilterVersion
+	if len(featureBalance.MeterGroupByFilterVersions) > 0 {
+		filterVersions = &featureBalance.MeterGroupByFilterVersions
+	}
+
 	return api.FeatureBalance{
-		Archived:            featureBalance.Archived,
-		CreatedAt:           featureBalance.CreatedAt,
-		Id:                  convert.ToStringLike[credit.FeatureID, string](featureBalance.ID),
-		MeterGroupByFilters: featureBalance.MeterGroupByFilters,
-		MeterSlug:           featureBalance.MeterSlug,
-		Name:                featureBalance.Name,
-		UpdatedAt:           featureBalance.UpdatedAt,
-		Usage:               featureBalance.Usage,
-		Balance:             featureBalance.Balance,
+		Archived:                   featureBalance.Archived,
+		CreatedAt:                  featureBalance.CreatedAt,
+		Id:                         convert.ToStringLike[credit.FeatureID, string](featureBalance.ID),
+		MeterGroupByFilters:        featureBalance.MeterGroupByFilters,
+		MeterGroupByFilterVersions: filterVersions,
+		MonetaryUsage:              featureBalance.MonetaryUsage,
+		UnitPrice:                  featureBalance.UnitPrice,
+		MeterSlug:                  featureBalance.MeterSlug,
+		Name:                       featureBalance.Name,
+		Overage:                    featureBalance.Overage,
+		UpdatedAt:                  featureBalance.UpdatedAt,
+		Usage:                      featureBalance.Usage,
+		Balance:                    featureBalance.Balance,
 	}
 }
 
@@ -107,6 +127,7 @@ func mapGrantBalanceToAPI(grantBalance credit.GrantBalance) api.LedgerGrantBalan
 
 	return api.LedgerGrantBalance{
 		Amount:      grantBalance.Amount,
+		Currency:    grantBalance.Currency,
 		Balance:     grantBalance.Balance,
 		CreatedAt:   grantBalance.CreatedAt,
 		EffectiveAt: grantBalance.EffectiveAt,
@@ -115,7 +136,7 @@ func mapGrantBalanceToAPI(grantBalance credit.GrantBalance) api.LedgerGrantBalan
 			Duration: api.LedgerGrantExpirationPeriodDuration(grantBalance.Expiration.Duration),
 		},
 		ExpiresAt: &grantBalance.ExpiresAt,
-		FeatureID: string(*grantBalance.FeatureID),
+		FeatureID: (*string)(grantBalance.FeatureID),
 		Id:        convert.ToStringLike[credit.GrantID, string](grantBalance.ID),
 		Metadata:  &grantBalance.Metadata,
 		ParentId:  convert.ToStringLike[credit.GrantID, string](grantBalance.ParentID),
@@ -0,0 +1,61 @@
+package creditdriver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/openmeterio/openmeter/api"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/pkg/framework/commonhttp"
+	"github.com/openmeterio/openmeter/pkg/framework/transport/httptransport"
+)
+
+type CheckLedgerEntitlementRequest struct {
+	Namespace string
+	Subject   string
+	FeatureID credit.FeatureID
+}
+
+type CheckLedgerEntitlementHandler httptransport.HandlerWithArgs[CheckLedgerEntitlementRequest, credit.Entitlement, api.CheckLedgerEntitlementParams]
+
+func (b *builder) CheckLedgerEntitlement() CheckLedgerEntitlementHandler {
+	return httptransport.NewHandlerWithArgs[CheckLedgerEntitlementRequest, credit.Entitlement, api.CheckLedgerEntitlementParams](
+		func(ctx context.Context, r *http.Request, params api.CheckLedgerEntitlementParams) (CheckLedgerEntitlementRequest, error) {
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return CheckLedgerEntitlementRequest{}, err
+			}
+
+			if params.Subject == "" {
+				return CheckLedgerEntitlementRequest{}, commonhttp.NewHTTPError(http.StatusBadRequest, errors.New("subject is required"))
+			}
+
+			if params.FeatureID == "" {
+				return CheckLedgerEntitlementRequest{}, commonhttp.NewHTTPError(http.StatusBadRequest, errors.New("featureID is required"))
+			}
+
+			return CheckLedgerEntitlementRequest{
+				Namespace: ns,
+				Subject:   params.Subject,
+				FeatureID: credit.FeatureID(params.FeatureID),
+			}, nil
+		},
+		func(ctx context.Context, request CheckLedgerEntitlementRequest) (credit.Entitlement, error) {
+			// Features are not looked up to keep checks off the database, unknown features have no balance
+			return b.EntitlementCache.Check(ctx, request.Namespace, request.Subject, request.FeatureID)
+		},
+		commonhttp.JSONResponseEncoder[credit.Entitlement],
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("checkLedgerEntitlement"),
+			httptransport.WithErrorEncoder(func(ctx context.Context, err error, w http.ResponseWriter) bool {
+				if _, ok := err.(*credit.LockErrNotObtainedError); ok {
+					commonhttp.NewHTTPError(http.StatusConflict, err).EncodeError(ctx, w)
+					return true
+				}
+				return false
+			}),
+		)...,
+	)
+}
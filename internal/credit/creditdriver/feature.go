@@ -78,6 +78,12 @@ func (b *builder) CreateFeature() CreateFeatureHandler {
 			if err := validateMeterAggregation(meter); err != nil {
 				return featureIn, commonhttp.NewHTTPError(http.StatusBadRequest, err)
 			}
+
+			if featureIn.UnitPrice != nil {
+				if err := featureIn.UnitPrice.Validate(); err != nil {
+					return featureIn, commonhttp.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid unit price: %w", err))
+				}
+			}
 			return featureIn, nil
 		},
 		b.CreditConnector.CreateFeature,
@@ -98,6 +104,68 @@ func (b *builder) CreateFeature() CreateFeatureHandler {
 					models.NewStatusProblem(ctx, err, http.StatusConflict).Respond(w)
 					return true
 				}
+				if _, ok := err.(*credit.FeatureFiltersOverlapError); ok {
+					models.NewStatusProblem(ctx, err, http.StatusConflict).Respond(w)
+					return true
+				}
+				return false
+			}),
+		)...,
+	)
+}
+
+type UpdateFeatureRequest struct {
+	FeatureID credit.NamespacedFeatureID
+	Update    credit.FeatureUpdate
+}
+
+type UpdateFeatureHandler httptransport.HandlerWithArgs[UpdateFeatureRequest, credit.Feature, api.FeatureID]
+
+func (b *builder) UpdateFeature() UpdateFeatureHandler {
+	return httptransport.NewHandlerWithArgs(
+		func(ctx context.Context, r *http.Request, featureID api.FeatureID) (UpdateFeatureRequest, error) {
+			request := UpdateFeatureRequest{}
+			if err := commonhttp.JSONRequestBodyDecoder(r, &request.Update); err != nil {
+				return request, err
+			}
+
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return request, err
+			}
+
+			request.FeatureID = credit.NewNamespacedFeatureID(ns, featureID)
+
+			return request, nil
+		},
+		func(ctx context.Context, request UpdateFeatureRequest) (credit.Feature, error) {
+			return b.CreditConnector.UpdateFeature(ctx, request.FeatureID, request.Update)
+		},
+		commonhttp.JSONResponseEncoder[credit.Feature],
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("updateFeature"),
+			httptransport.WithErrorEncoder(func(ctx context.Context, err error, w http.ResponseWriter) bool {
+				if _, ok := err.(*credit.FeatureNotFoundError); ok {
+					models.NewStatusProblem(ctx, err, http.StatusNotFound).Respond(w)
+					return true
+				}
+				if _, ok := err.(*credit.FeatureInvalidFiltersError); ok {
+					models.NewStatusProblem(ctx, err, http.StatusBadRequest).Respond(w)
+					return true
+				}
+				if _, ok := err.(*credit.InvalidFeatureUpdateError); ok {
+					models.NewStatusProblem(ctx, err, http.StatusBadRequest).Respond(w)
+					return true
+				}
+				if _, ok := err.(*credit.FeatureWithNameAlreadyExistsError); ok {
+					models.NewStatusProblem(ctx, err, http.StatusConflict).Respond(w)
+					return true
+				}
+				if _, ok := err.(*credit.FeatureFiltersOverlapError); ok {
+					models.NewStatusProblem(ctx, err, http.StatusConflict).Respond(w)
+					return true
+				}
 				return false
 			}),
 		)...,
@@ -112,29 +112,62 @@ func (b *builder) CreateLedgerGrant() CreateLedgerGrantHandler {
 				return grant, err
 			}
 
-			feature, err := b.CreditConnector.GetFeature(ctx, credit.NewNamespacedFeatureID(ns, *grant.FeatureID))
-			if err != nil {
-				if _, ok := err.(*credit.FeatureNotFoundError); ok {
+			// Grants without feature can be used by any feature
+			if grant.FeatureID != nil {
+				feature, err := b.CreditConnector.GetFeature(ctx, credit.NewNamespacedFeatureID(ns, *grant.FeatureID))
+				if err != nil {
+					if _, ok := err.(*credit.FeatureNotFoundError); ok {
+						return grant, commonhttp.NewHTTPError(
+							http.StatusBadRequest,
+							fmt.Errorf("feature not found: %s", *grant.FeatureID),
+						)
+					}
+					return grant, err
+				}
+
+				if feature.Archived != nil && *feature.Archived {
 					return grant, commonhttp.NewHTTPError(
 						http.StatusBadRequest,
-						fmt.Errorf("feature not found: %s", *grant.FeatureID),
+						fmt.Errorf("feature is archived: %s", *grant.FeatureID),
+					)
+				}
+
+				// Grants in a currency pay for the usage of the feature at its unit price
+				if grant.Currency != nil && (feature.UnitPrice == nil || feature.UnitPrice.Currency != *grant.Currency) {
+					return grant, commonhttp.NewHTTPError(
+						http.StatusBadRequest,
+						fmt.Errorf("feature %s has no unit price in %s", *grant.FeatureID, *grant.Currency),
 					)
 				}
-				return grant, err
 			}
 
-			if feature.Archived != nil && *feature.Archived {
-				return grant, commonhttp.NewHTTPError(
-					http.StatusBadRequest,
-					fmt.Errorf("feature is archived: %s", *grant.FeatureID),
-				)
+			if grant.Currency != nil {
+				if err := credit.ValidateCurrency(*grant.Currency); err != nil {
+					return grant, commonhttp.NewHTTPError(http.StatusBadRequest, err)
+				}
+
+				// Grants in a currency pay for usage, they can't charge for it
+				if grant.Amount <= 0 {
+					return grant, commonhttp.NewHTTPError(
+						http.StatusBadRequest,
+						fmt.Errorf("amount must be positive for grants in a currency: %g", grant.Amount),
+					)
+				}
 			}
 
 			grant.LedgerID = arg
 			grant.Namespace = ns
 			return grant, nil
 		},
-		b.CreditConnector.CreateGrant,
+		func(ctx context.Context, grantIn credit.Grant) (credit.Grant, error) {
+			grant, err := b.CreditConnector.CreateGrant(ctx, grantIn)
+			if err != nil {
+				return grant, err
+			}
+
+			b.invalidateLedger(credit.NewNamespacedLedgerID(grant.Namespace, grant.LedgerID))
+			return grant, nil
+		},
 		commonhttp.JSONResponseEncoderWithStatus[credit.Grant](http.StatusCreated),
 		httptransport.AppendOptions(
 			b.Options,
@@ -210,7 +243,15 @@ func (b *builder) VoidLedgerGrant() VoidLedgerGrantHandler {
 
 			return grant, nil
 		},
-		b.CreditConnector.VoidGrant,
+		func(ctx context.Context, grantIn credit.Grant) (credit.Grant, error) {
+			grant, err := b.CreditConnector.VoidGrant(ctx, grantIn)
+			if err != nil {
+				return grant, err
+			}
+
+			b.invalidateLedger(credit.NewNamespacedLedgerID(grantIn.Namespace, grantIn.LedgerID))
+			return grant, nil
+		},
 		commonhttp.EmptyResponseEncoder[credit.Grant](http.StatusNoContent),
 		httptransport.AppendOptions(
 			b.Options,
@@ -281,22 +322,24 @@ func (b *builder) GetLedgerGrant() GetLedgerGrantHandler {
 func mapGrantToAPI(grant credit.Grant) api.LedgerGrantResponse {
 	return api.LedgerGrantResponse{
 		Amount:      grant.Amount,
+		Currency:    grant.Currency,
 		CreatedAt:   grant.CreatedAt,
 		EffectiveAt: grant.EffectiveAt,
 		Expiration: &api.LedgerGrantExpirationPeriod{
 			Count:    int(grant.Expiration.Count),
 			Duration: api.LedgerGrantExpirationPeriodDuration(grant.Expiration.Duration),
 		},
-		ExpiresAt: &grant.ExpiresAt,
-		FeatureID: string(defaultx.WithDefault(grant.FeatureID, credit.FeatureID(""))),
-		Id:        (*string)(grant.ID),
-		Metadata:  &grant.Metadata,
-		ParentId:  (*string)(grant.ParentID),
-		Priority:  convert.ToPointer(int(grant.Priority)),
-		Rollover:  grant.Rollover,
-		Type:      api.LedgerGrantType(grant.Type),
-		UpdatedAt: grant.UpdatedAt,
-		Void:      &grant.Void,
+		ExpiresAt:  &grant.ExpiresAt,
+		FeatureID:  (*string)(grant.FeatureID),
+		Id:         (*string)(grant.ID),
+		Metadata:   &grant.Metadata,
+		ParentId:   (*string)(grant.ParentID),
+		Priority:   convert.ToPointer(int(grant.Priority)),
+		Rollover:   grant.Rollover,
+		ScheduleId: (*string)(grant.ScheduleID),
+		Type:       api.LedgerGrantType(grant.Type),
+		UpdatedAt:  grant.UpdatedAt,
+		Void:       &grant.Void,
 	}
 }
 
@@ -0,0 +1,148 @@
+package creditdriver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/pkg/framework/commonhttp"
+	"github.com/openmeterio/openmeter/pkg/framework/transport/httptransport"
+)
+
+type ListLedgerGrantAmendmentsHandler httptransport.HandlerWithArgs[credit.NamespacedGrantID, []credit.GrantAmendment, GrantPathParams]
+
+func (b *builder) ListLedgerGrantAmendments() ListLedgerGrantAmendmentsHandler {
+	return httptransport.NewHandlerWithArgs[credit.NamespacedGrantID, []credit.GrantAmendment, GrantPathParams](
+		func(ctx context.Context, r *http.Request, in GrantPathParams) (credit.NamespacedGrantID, error) {
+			grant, err := b.resolveGrant(ctx, in)
+			if err != nil {
+				return credit.NamespacedGrantID{}, err
+			}
+
+			return credit.NewNamespacedGrantID(grant.Namespace, *grant.ID), nil
+		},
+		b.CreditConnector.ListGrantAmendments,
+		commonhttp.JSONResponseEncoder[[]credit.GrantAmendment],
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("listLedgerGrantAmendments"),
+			httptransport.WithErrorEncoder(encodeGrantNotFoundError),
+		)...,
+	)
+}
+
+type AmendLedgerGrantHandler httptransport.HandlerWithArgs[credit.GrantAmendment, credit.GrantAmendment, GrantPathParams]
+
+func (b *builder) AmendLedgerGrant() AmendLedgerGrantHandler {
+	return httptransport.NewHandlerWithArgs[credit.GrantAmendment, credit.GrantAmendment, GrantPathParams](
+		func(ctx context.Context, r *http.Request, in GrantPathParams) (credit.GrantAmendment, error) {
+			amendment := credit.GrantAmendment{}
+			if err := commonhttp.JSONRequestBodyDecoder(r, &amendment); err != nil {
+				return amendment, err
+			}
+
+			grant, err := b.resolveGrant(ctx, in)
+			if err != nil {
+				return amendment, err
+			}
+
+			if grant.Void {
+				return amendment, commonhttp.NewHTTPError(
+					http.StatusBadRequest,
+					fmt.Errorf("grant is voided: %s", in.GrantID),
+				)
+			}
+
+			if amendment.EffectiveAt.IsZero() {
+				amendment.EffectiveAt = time.Now()
+			}
+
+			if err := amendment.Validate(); err != nil {
+				return amendment, commonhttp.NewHTTPError(http.StatusBadRequest, err)
+			}
+
+			amendment.Namespace = grant.Namespace
+			amendment.LedgerID = grant.LedgerID
+			amendment.GrantID = *grant.ID
+			return amendment, nil
+		},
+		func(ctx context.Context, amendmentIn credit.GrantAmendment) (credit.GrantAmendment, error) {
+			amendment, err := b.CreditConnector.AmendGrant(ctx, amendmentIn)
+			if err != nil {
+				return amendment, err
+			}
+
+			b.invalidateLedger(credit.NewNamespacedLedgerID(amendment.Namespace, amendment.LedgerID))
+			return amendment, nil
+		},
+		commonhttp.JSONResponseEncoderWithStatus[credit.GrantAmendment](http.StatusCreated),
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("amendLedgerGrant"),
+			httptransport.WithErrorEncoder(func(ctx context.Context, err error, w http.ResponseWriter) bool {
+				if encodeGrantNotFoundError(ctx, err, w) {
+					return true
+				}
+
+				if _, ok := err.(*credit.InvalidGrantAmendmentError); ok {
+					commonhttp.NewHTTPError(
+						http.StatusBadRequest,
+						err,
+					).EncodeError(ctx, w)
+					return true
+				}
+
+				if _, ok := err.(*credit.HighWatermarBeforeError); ok {
+					commonhttp.NewHTTPError(
+						http.StatusBadRequest,
+						err,
+					).EncodeError(ctx, w)
+					return true
+				}
+
+				if _, ok := err.(*credit.LockErrNotObtainedError); ok {
+					commonhttp.NewHTTPError(
+						http.StatusConflict,
+						fmt.Errorf("credit is currently locked, try again: %w", err),
+					).EncodeError(ctx, w)
+					return true
+				}
+				return false
+			}),
+		)...,
+	)
+}
+
+// resolveGrant returns the grant if it belongs to the ledger of the path.
+func (b *builder) resolveGrant(ctx context.Context, in GrantPathParams) (credit.Grant, error) {
+	ns, err := b.resolveNamespace(ctx)
+	if err != nil {
+		return credit.Grant{}, err
+	}
+
+	grant, err := b.CreditConnector.GetGrant(ctx, credit.NewNamespacedGrantID(ns, in.GrantID))
+	if err != nil {
+		return credit.Grant{}, err
+	}
+
+	if grant.LedgerID != in.LedgerID {
+		return credit.Grant{}, &credit.GrantNotFoundError{GrantID: in.GrantID}
+	}
+
+	// Void entries are returned for voided grants, they are identified by the ID of the grant
+	if grant.Void && grant.ParentID != nil {
+		grant.ID = grant.ParentID
+	}
+
+	return grant, nil
+}
+
+func encodeGrantNotFoundError(ctx context.Context, err error, w http.ResponseWriter) bool {
+	if _, ok := err.(*credit.GrantNotFoundError); ok {
+		commonhttp.NewHTTPError(http.StatusNotFound, err).EncodeError(ctx, w)
+		return true
+	}
+	return false
+}
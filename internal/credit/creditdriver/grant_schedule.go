@@ -0,0 +1,165 @@
+package creditdriver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openmeterio/openmeter/api"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/pkg/framework/commonhttp"
+	"github.com/openmeterio/openmeter/pkg/framework/operation"
+	"github.com/openmeterio/openmeter/pkg/framework/transport/httptransport"
+)
+
+type GrantSchedulePathParams struct {
+	LedgerID   api.LedgerID
+	ScheduleID api.LedgerGrantScheduleID
+}
+
+type ListLedgerGrantSchedulesHandler httptransport.HandlerWithArgs[credit.ListGrantSchedulesParams, []credit.GrantSchedule, api.LedgerID]
+
+func (b *builder) ListLedgerGrantSchedules() ListLedgerGrantSchedulesHandler {
+	return httptransport.NewHandlerWithArgs[credit.ListGrantSchedulesParams, []credit.GrantSchedule, api.LedgerID](
+		func(ctx context.Context, r *http.Request, ledgerID api.LedgerID) (credit.ListGrantSchedulesParams, error) {
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return credit.ListGrantSchedulesParams{}, err
+			}
+
+			return credit.ListGrantSchedulesParams{
+				Namespace: ns,
+				LedgerIDs: []credit.LedgerID{ledgerID},
+			}, nil
+		},
+		b.CreditConnector.ListGrantSchedules,
+		commonhttp.JSONResponseEncoder,
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("listLedgerGrantSchedules"),
+		)...,
+	)
+}
+
+type CreateLedgerGrantScheduleHandler httptransport.HandlerWithArgs[credit.GrantSchedule, credit.GrantSchedule, api.LedgerID]
+
+func (b *builder) CreateLedgerGrantSchedule() CreateLedgerGrantScheduleHandler {
+	return httptransport.NewHandlerWithArgs[credit.GrantSchedule, credit.GrantSchedule, api.LedgerID](
+		func(ctx context.Context, r *http.Request, ledgerID api.LedgerID) (credit.GrantSchedule, error) {
+			schedule := credit.GrantSchedule{}
+			if err := commonhttp.JSONRequestBodyDecoder(r, &schedule); err != nil {
+				return schedule, err
+			}
+
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return schedule, err
+			}
+
+			if err := schedule.Validate(); err != nil {
+				return schedule, commonhttp.NewHTTPError(http.StatusBadRequest, err)
+			}
+
+			if schedule.FeatureID != nil {
+				feature, err := b.CreditConnector.GetFeature(ctx, credit.NewNamespacedFeatureID(ns, *schedule.FeatureID))
+				if err != nil {
+					if _, ok := err.(*credit.FeatureNotFoundError); ok {
+						return schedule, commonhttp.NewHTTPError(
+							http.StatusBadRequest,
+							fmt.Errorf("feature not found: %s", *schedule.FeatureID),
+						)
+					}
+					return schedule, err
+				}
+
+				if feature.Archived != nil && *feature.Archived {
+					return schedule, commonhttp.NewHTTPError(
+						http.StatusBadRequest,
+						fmt.Errorf("feature is archived: %s", *schedule.FeatureID),
+					)
+				}
+			}
+
+			if schedule.Priority == 0 {
+				schedule.Priority = 1
+			}
+
+			schedule.LedgerID = ledgerID
+			schedule.Namespace = ns
+			return schedule, nil
+		},
+		b.CreditConnector.CreateGrantSchedule,
+		commonhttp.JSONResponseEncoderWithStatus[credit.GrantSchedule](http.StatusCreated),
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("createLedgerGrantSchedule"),
+			httptransport.WithErrorEncoder(encodeLedgerNotFoundError),
+		)...,
+	)
+}
+
+type GetLedgerGrantScheduleHandler httptransport.HandlerWithArgs[credit.NamespacedGrantScheduleID, credit.GrantSchedule, GrantSchedulePathParams]
+
+func (b *builder) GetLedgerGrantSchedule() GetLedgerGrantScheduleHandler {
+	return httptransport.NewHandlerWithArgs[credit.NamespacedGrantScheduleID, credit.GrantSchedule, GrantSchedulePathParams](
+		func(ctx context.Context, r *http.Request, in GrantSchedulePathParams) (credit.NamespacedGrantScheduleID, error) {
+			return b.resolveGrantScheduleID(ctx, in)
+		},
+		b.CreditConnector.GetGrantSchedule,
+		commonhttp.JSONResponseEncoder[credit.GrantSchedule],
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("getLedgerGrantSchedule"),
+			httptransport.WithErrorEncoder(encodeGrantScheduleNotFoundError),
+		)...,
+	)
+}
+
+type DeleteLedgerGrantScheduleHandler httptransport.HandlerWithArgs[credit.NamespacedGrantScheduleID, any, GrantSchedulePathParams]
+
+func (b *builder) DeleteLedgerGrantSchedule() DeleteLedgerGrantScheduleHandler {
+	return httptransport.NewHandlerWithArgs(
+		func(ctx context.Context, r *http.Request, in GrantSchedulePathParams) (credit.NamespacedGrantScheduleID, error) {
+			return b.resolveGrantScheduleID(ctx, in)
+		},
+		operation.AsNoResponseOperation(b.CreditConnector.DeleteGrantSchedule),
+		func(ctx context.Context, w http.ResponseWriter, response any) error {
+			w.WriteHeader(http.StatusNoContent)
+			return nil
+		},
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("deleteLedgerGrantSchedule"),
+			httptransport.WithErrorEncoder(encodeGrantScheduleNotFoundError),
+		)...,
+	)
+}
+
+// resolveGrantScheduleID returns the ID of the grant schedule if it belongs to the ledger of the path.
+func (b *builder) resolveGrantScheduleID(ctx context.Context, in GrantSchedulePathParams) (credit.NamespacedGrantScheduleID, error) {
+	ns, err := b.resolveNamespace(ctx)
+	if err != nil {
+		return credit.NamespacedGrantScheduleID{}, err
+	}
+
+	id := credit.NewNamespacedGrantScheduleID(ns, in.ScheduleID)
+
+	schedule, err := b.CreditConnector.GetGrantSchedule(ctx, id)
+	if err != nil {
+		return id, err
+	}
+
+	if schedule.LedgerID != in.LedgerID {
+		return id, &credit.GrantScheduleNotFoundError{ID: in.ScheduleID}
+	}
+
+	return id, nil
+}
+
+func encodeGrantScheduleNotFoundError(ctx context.Context, err error, w http.ResponseWriter) bool {
+	if _, ok := err.(*credit.GrantScheduleNotFoundError); ok {
+		commonhttp.NewHTTPError(http.StatusNotFound, err).EncodeError(ctx, w)
+		return true
+	}
+	return false
+}
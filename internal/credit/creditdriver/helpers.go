@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/openmeterio/openmeter/internal/credit"
 	"github.com/openmeterio/openmeter/pkg/framework/commonhttp"
 )
 
@@ -16,3 +17,15 @@ func (h *builder) resolveNamespace(ctx context.Context) (string, error) {
 
 	return ns, nil
 }
+
+func (h *builder) invalidateLedger(ledgerID credit.NamespacedLedgerID) {
+	if h.EntitlementCache != nil {
+		h.EntitlementCache.InvalidateLedger(ledgerID)
+	}
+}
+
+func (h *builder) invalidateSubject(namespace string, subject string) {
+	if h.EntitlementCache != nil {
+		h.EntitlementCache.InvalidateSubject(namespace, subject)
+	}
+}
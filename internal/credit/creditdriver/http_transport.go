@@ -1,22 +1,35 @@
 package creditdriver
 
 import (
+	"context"
+
 	"github.com/openmeterio/openmeter/internal/credit"
 	"github.com/openmeterio/openmeter/internal/meter"
 	"github.com/openmeterio/openmeter/internal/namespace/namespacedriver"
 	"github.com/openmeterio/openmeter/pkg/framework/transport/httptransport"
 )
 
+// EntitlementCache serves entitlement checks, it is notified when the balance of a ledger changes.
+type EntitlementCache interface {
+	Check(ctx context.Context, namespace string, subject string, featureID credit.FeatureID) (credit.Entitlement, error)
+	InvalidateLedger(ledgerID credit.NamespacedLedgerID)
+	InvalidateSubject(namespace string, subject string)
+}
+
 type Handlers struct {
 	GetFeature    GetFeatureHandler
 	ListFeatures  ListFeaturesHandler
 	CreateFeature CreateFeatureHandler
+	UpdateFeature UpdateFeatureHandler
 	DeleteFeature DeleteFeatureHandler
 
 	// Ledger
-	CreateLedger     CreateLedgerHandler
-	ListLedgers      ListLedgersHandler
-	GetLedgerHistory GetLedgerHistoryHandler
+	CreateLedger              CreateLedgerHandler
+	ListLedgers               ListLedgersHandler
+	GetLedgerHistory          GetLedgerHistoryHandler
+	UpdateLedgerOverageLimits UpdateLedgerOverageLimitsHandler
+	UpdateLedgerUsagePeriod   UpdateLedgerUsagePeriodHandler
+	DeleteLedgerUsagePeriod   DeleteLedgerUsagePeriodHandler
 
 	// Reset
 	ResetLedger ResetLedgerHandler
@@ -28,18 +41,41 @@ type Handlers struct {
 	VoidLedgerGrant          VoidLedgerGrantHandler
 	GetLedgerGrant           GetLedgerGrantHandler
 
+	// Grant amendment
+	ListLedgerGrantAmendments ListLedgerGrantAmendmentsHandler
+	AmendLedgerGrant          AmendLedgerGrantHandler
+
+	// Grant schedule
+	ListLedgerGrantSchedules  ListLedgerGrantSchedulesHandler
+	CreateLedgerGrantSchedule CreateLedgerGrantScheduleHandler
+	GetLedgerGrantSchedule    GetLedgerGrantScheduleHandler
+	DeleteLedgerGrantSchedule DeleteLedgerGrantScheduleHandler
+
+	// Notification
+	ListLedgerNotificationRules      ListLedgerNotificationRulesHandler
+	CreateLedgerNotificationRule     CreateLedgerNotificationRuleHandler
+	GetLedgerNotificationRule        GetLedgerNotificationRuleHandler
+	DeleteLedgerNotificationRule     DeleteLedgerNotificationRuleHandler
+	ListLedgerNotificationDeliveries ListLedgerNotificationDeliveriesHandler
+
 	// Balances
 	GetLedgerBalance GetLedgerBalanceHandler
+
+	// Entitlements
+	CheckLedgerEntitlement CheckLedgerEntitlementHandler
 }
 
+// New returns the credit handlers. The entitlement cache is optional, entitlement checks are not implemented without it.
 func New(
 	creditConnector credit.Connector,
+	entitlementCache EntitlementCache,
 	meterRepository meter.Repository,
 	namespaceDecoder namespacedriver.NamespaceDecoder,
 	options ...httptransport.HandlerOption,
 ) Handlers {
 	builder := &builder{
 		CreditConnector:  creditConnector,
+		EntitlementCache: entitlementCache,
 		MeterRepository:  meterRepository,
 		NamespaceDecoder: namespaceDecoder,
 		Options:          options,
@@ -49,12 +85,16 @@ func New(
 		GetFeature:    builder.GetFeature(),
 		ListFeatures:  builder.ListFeatures(),
 		CreateFeature: builder.CreateFeature(),
+		UpdateFeature: builder.UpdateFeature(),
 		DeleteFeature: builder.DeleteFeature(),
 
 		// Ledgers
-		CreateLedger:     builder.CreateLedger(),
-		ListLedgers:      builder.ListLedgers(),
-		GetLedgerHistory: builder.GetLedgerHistory(),
+		CreateLedger:              builder.CreateLedger(),
+		ListLedgers:               builder.ListLedgers(),
+		GetLedgerHistory:          builder.GetLedgerHistory(),
+		UpdateLedgerOverageLimits: builder.UpdateLedgerOverageLimits(),
+		UpdateLedgerUsagePeriod:   builder.UpdateLedgerUsagePeriod(),
+		DeleteLedgerUsagePeriod:   builder.DeleteLedgerUsagePeriod(),
 
 		// Reset
 		ResetLedger: builder.ResetLedger(),
@@ -66,13 +106,34 @@ func New(
 		VoidLedgerGrant:          builder.VoidLedgerGrant(),
 		GetLedgerGrant:           builder.GetLedgerGrant(),
 
+		// Grant amendments
+		ListLedgerGrantAmendments: builder.ListLedgerGrantAmendments(),
+		AmendLedgerGrant:          builder.AmendLedgerGrant(),
+
+		// Grant schedules
+		ListLedgerGrantSchedules:  builder.ListLedgerGrantSchedules(),
+		CreateLedgerGrantSchedule: builder.CreateLedgerGrantSchedule(),
+		GetLedgerGrantSchedule:    builder.GetLedgerGrantSchedule(),
+		DeleteLedgerGrantSchedule: builder.DeleteLedgerGrantSchedule(),
+
+		// Notifications
+		ListLedgerNotificationRules:      builder.ListLedgerNotificationRules(),
+		CreateLedgerNotificationRule:     builder.CreateLedgerNotificationRule(),
+		GetLedgerNotificationRule:        builder.GetLedgerNotificationRule(),
+		DeleteLedgerNotificationRule:     builder.DeleteLedgerNotificationRule(),
+		ListLedgerNotificationDeliveries: builder.ListLedgerNotificationDeliveries(),
+
 		// Balances
 		GetLedgerBalance: builder.GetLedgerBalance(),
+
+		// Entitlements
+		CheckLedgerEntitlement: builder.CheckLedgerEntitlement(),
 	}
 }
 
 type builder struct {
 	CreditConnector  credit.Connector
+	EntitlementCache EntitlementCache
 	MeterRepository  meter.Repository
 	NamespaceDecoder namespacedriver.NamespaceDecoder
 	Options          []httptransport.HandlerOption
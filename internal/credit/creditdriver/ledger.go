@@ -3,6 +3,7 @@ package creditdriver
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -37,9 +38,29 @@ func (b *builder) CreateLedger() CreateLedgerHandler {
 					errors.New("subject must be non-empty when creating a new ledger"),
 				)
 			}
+
+			if ledgerIn.OverageLimits != nil {
+				if err := b.validateOverageLimits(ctx, ns, *ledgerIn.OverageLimits); err != nil {
+					return ledgerIn, err
+				}
+			}
+
+			if ledgerIn.UsagePeriod != nil {
+				if err := ledgerIn.UsagePeriod.Validate(); err != nil {
+					return ledgerIn, commonhttp.NewHTTPError(http.StatusBadRequest, err)
+				}
+			}
 			return ledgerIn, nil
 		},
-		b.CreditConnector.CreateLedger,
+		func(ctx context.Context, ledgerIn credit.Ledger) (credit.Ledger, error) {
+			ledger, err := b.CreditConnector.CreateLedger(ctx, ledgerIn)
+			if err != nil {
+				return ledger, err
+			}
+
+			b.invalidateSubject(ledger.Namespace, ledger.Subject)
+			return ledger, nil
+		},
 		commonhttp.JSONResponseEncoderWithStatus[credit.Ledger](http.StatusCreated),
 		httptransport.AppendOptions(
 			b.Options,
@@ -59,6 +80,154 @@ func (b *builder) CreateLedger() CreateLedgerHandler {
 	)
 }
 
+type UpdateLedgerOverageLimitsRequest struct {
+	LedgerID credit.NamespacedLedgerID
+	Limits   credit.OverageLimits
+}
+
+type UpdateLedgerOverageLimitsHandler httptransport.HandlerWithArgs[UpdateLedgerOverageLimitsRequest, credit.Ledger, api.LedgerID]
+
+func (b *builder) UpdateLedgerOverageLimits() UpdateLedgerOverageLimitsHandler {
+	return httptransport.NewHandlerWithArgs[UpdateLedgerOverageLimitsRequest, credit.Ledger, api.LedgerID](
+		func(ctx context.Context, r *http.Request, ledgerID api.LedgerID) (UpdateLedgerOverageLimitsRequest, error) {
+			req := UpdateLedgerOverageLimitsRequest{}
+			if err := commonhttp.JSONRequestBodyDecoder(r, &req.Limits); err != nil {
+				return req, err
+			}
+
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return req, err
+			}
+
+			if err := b.validateOverageLimits(ctx, ns, req.Limits); err != nil {
+				return req, err
+			}
+
+			req.LedgerID = credit.NewNamespacedLedgerID(ns, ledgerID)
+			return req, nil
+		},
+		func(ctx context.Context, req UpdateLedgerOverageLimitsRequest) (credit.Ledger, error) {
+			ledger, err := b.CreditConnector.UpdateOverageLimits(ctx, req.LedgerID, req.Limits)
+			if err != nil {
+				return ledger, err
+			}
+
+			b.invalidateLedger(req.LedgerID)
+			return ledger, nil
+		},
+		commonhttp.JSONResponseEncoder,
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("updateLedgerOverageLimits"),
+			httptransport.WithErrorEncoder(encodeLedgerNotFoundError),
+		)...,
+	)
+}
+
+type UpdateLedgerUsagePeriodRequest struct {
+	LedgerID    credit.NamespacedLedgerID
+	UsagePeriod *credit.Recurrence
+}
+
+type UpdateLedgerUsagePeriodHandler httptransport.HandlerWithArgs[UpdateLedgerUsagePeriodRequest, credit.Ledger, api.LedgerID]
+
+func (b *builder) UpdateLedgerUsagePeriod() UpdateLedgerUsagePeriodHandler {
+	return httptransport.NewHandlerWithArgs[UpdateLedgerUsagePeriodRequest, credit.Ledger, api.LedgerID](
+		func(ctx context.Context, r *http.Request, ledgerID api.LedgerID) (UpdateLedgerUsagePeriodRequest, error) {
+			req := UpdateLedgerUsagePeriodRequest{
+				UsagePeriod: &credit.Recurrence{},
+			}
+			if err := commonhttp.JSONRequestBodyDecoder(r, req.UsagePeriod); err != nil {
+				return req, err
+			}
+
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return req, err
+			}
+
+			if err := req.UsagePeriod.Validate(); err != nil {
+				return req, commonhttp.NewHTTPError(http.StatusBadRequest, err)
+			}
+
+			req.LedgerID = credit.NewNamespacedLedgerID(ns, ledgerID)
+			return req, nil
+		},
+		b.updateLedgerUsagePeriod,
+		commonhttp.JSONResponseEncoder,
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("updateLedgerUsagePeriod"),
+			httptransport.WithErrorEncoder(encodeLedgerNotFoundError),
+		)...,
+	)
+}
+
+type DeleteLedgerUsagePeriodHandler httptransport.HandlerWithArgs[UpdateLedgerUsagePeriodRequest, credit.Ledger, api.LedgerID]
+
+func (b *builder) DeleteLedgerUsagePeriod() DeleteLedgerUsagePeriodHandler {
+	return httptransport.NewHandlerWithArgs[UpdateLedgerUsagePeriodRequest, credit.Ledger, api.LedgerID](
+		func(ctx context.Context, r *http.Request, ledgerID api.LedgerID) (UpdateLedgerUsagePeriodRequest, error) {
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return UpdateLedgerUsagePeriodRequest{}, err
+			}
+
+			return UpdateLedgerUsagePeriodRequest{
+				LedgerID: credit.NewNamespacedLedgerID(ns, ledgerID),
+			}, nil
+		},
+		b.updateLedgerUsagePeriod,
+		commonhttp.JSONResponseEncoder,
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("deleteLedgerUsagePeriod"),
+			httptransport.WithErrorEncoder(encodeLedgerNotFoundError),
+		)...,
+	)
+}
+
+func (b *builder) updateLedgerUsagePeriod(ctx context.Context, req UpdateLedgerUsagePeriodRequest) (credit.Ledger, error) {
+	ledger, err := b.CreditConnector.UpdateUsagePeriod(ctx, req.LedgerID, req.UsagePeriod)
+	if err != nil {
+		return ledger, err
+	}
+
+	b.invalidateLedger(req.LedgerID)
+	return ledger, nil
+}
+
+func encodeLedgerNotFoundError(ctx context.Context, err error, w http.ResponseWriter) bool {
+	if _, ok := err.(*credit.LedgerNotFoundError); ok {
+		commonhttp.NewHTTPError(http.StatusNotFound, err).EncodeError(ctx, w)
+		return true
+	}
+	return false
+}
+
+// validateOverageLimits checks the limits and that the features they refer to exist.
+func (b *builder) validateOverageLimits(ctx context.Context, ns string, limits credit.OverageLimits) error {
+	if err := limits.Validate(); err != nil {
+		return commonhttp.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	for featureID := range limits.Features {
+		_, err := b.CreditConnector.GetFeature(ctx, credit.NewNamespacedFeatureID(ns, featureID))
+		if err != nil {
+			if _, ok := err.(*credit.FeatureNotFoundError); ok {
+				return commonhttp.NewHTTPError(
+					http.StatusBadRequest,
+					fmt.Errorf("feature not found: %s", featureID),
+				)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 type ListLedgersHandler httptransport.HandlerWithArgs[credit.ListLedgersParams, []credit.Ledger, api.ListLedgersParams]
 
 func (b *builder) ListLedgers() ListLedgersHandler {
@@ -150,6 +319,8 @@ func mapLedgerEntry(entry credit.LedgerEntry) api.LedgerEntry {
 		Time:      entry.Time,
 		FeatureID: string(defaultx.WithDefault(entry.FeatureID, credit.FeatureID(""))),
 		Amount:    defaultx.WithDefault(entry.Amount, 0),
+		Currency:  entry.Currency,
+		Units:     entry.Units,
 		Period:    period,
 	}
 }
@@ -0,0 +1,186 @@
+package creditdriver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openmeterio/openmeter/api"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/pkg/defaultx"
+	"github.com/openmeterio/openmeter/pkg/framework/commonhttp"
+	"github.com/openmeterio/openmeter/pkg/framework/operation"
+	"github.com/openmeterio/openmeter/pkg/framework/transport/httptransport"
+)
+
+type NotificationRulePathParams struct {
+	LedgerID api.LedgerID
+	RuleID   api.LedgerNotificationRuleID
+}
+
+type ListLedgerNotificationRulesHandler httptransport.HandlerWithArgs[credit.ListNotificationRulesParams, []credit.NotificationRule, api.LedgerID]
+
+func (b *builder) ListLedgerNotificationRules() ListLedgerNotificationRulesHandler {
+	return httptransport.NewHandlerWithArgs[credit.ListNotificationRulesParams, []credit.NotificationRule, api.LedgerID](
+		func(ctx context.Context, r *http.Request, ledgerID api.LedgerID) (credit.ListNotificationRulesParams, error) {
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return credit.ListNotificationRulesParams{}, err
+			}
+
+			return credit.ListNotificationRulesParams{
+				Namespace: ns,
+				LedgerIDs: []credit.LedgerID{ledgerID},
+			}, nil
+		},
+		b.CreditConnector.ListNotificationRules,
+		commonhttp.JSONResponseEncoder,
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("listLedgerNotificationRules"),
+		)...,
+	)
+}
+
+type CreateLedgerNotificationRuleHandler httptransport.HandlerWithArgs[credit.NotificationRule, credit.NotificationRule, api.LedgerID]
+
+func (b *builder) CreateLedgerNotificationRule() CreateLedgerNotificationRuleHandler {
+	return httptransport.NewHandlerWithArgs[credit.NotificationRule, credit.NotificationRule, api.LedgerID](
+		func(ctx context.Context, r *http.Request, ledgerID api.LedgerID) (credit.NotificationRule, error) {
+			rule := credit.NotificationRule{}
+			if err := commonhttp.JSONRequestBodyDecoder(r, &rule); err != nil {
+				return rule, err
+			}
+
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return rule, err
+			}
+
+			if err := rule.Validate(); err != nil {
+				return rule, commonhttp.NewHTTPError(http.StatusBadRequest, err)
+			}
+
+			if rule.FeatureID != nil {
+				_, err := b.CreditConnector.GetFeature(ctx, credit.NewNamespacedFeatureID(ns, *rule.FeatureID))
+				if err != nil {
+					if _, ok := err.(*credit.FeatureNotFoundError); ok {
+						return rule, commonhttp.NewHTTPError(
+							http.StatusBadRequest,
+							fmt.Errorf("feature not found: %s", *rule.FeatureID),
+						)
+					}
+					return rule, err
+				}
+			}
+
+			rule.LedgerID = ledgerID
+			rule.Namespace = ns
+			return rule, nil
+		},
+		b.CreditConnector.CreateNotificationRule,
+		commonhttp.JSONResponseEncoderWithStatus[credit.NotificationRule](http.StatusCreated),
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("createLedgerNotificationRule"),
+			httptransport.WithErrorEncoder(encodeLedgerNotFoundError),
+		)...,
+	)
+}
+
+type GetLedgerNotificationRuleHandler httptransport.HandlerWithArgs[credit.NamespacedNotificationRuleID, credit.NotificationRule, NotificationRulePathParams]
+
+func (b *builder) GetLedgerNotificationRule() GetLedgerNotificationRuleHandler {
+	return httptransport.NewHandlerWithArgs[credit.NamespacedNotificationRuleID, credit.NotificationRule, NotificationRulePathParams](
+		func(ctx context.Context, r *http.Request, in NotificationRulePathParams) (credit.NamespacedNotificationRuleID, error) {
+			return b.resolveNotificationRuleID(ctx, in)
+		},
+		b.CreditConnector.GetNotificationRule,
+		commonhttp.JSONResponseEncoder[credit.NotificationRule],
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("getLedgerNotificationRule"),
+			httptransport.WithErrorEncoder(encodeNotificationRuleNotFoundError),
+		)...,
+	)
+}
+
+type DeleteLedgerNotificationRuleHandler httptransport.HandlerWithArgs[credit.NamespacedNotificationRuleID, any, NotificationRulePathParams]
+
+func (b *builder) DeleteLedgerNotificationRule() DeleteLedgerNotificationRuleHandler {
+	return httptransport.NewHandlerWithArgs(
+		func(ctx context.Context, r *http.Request, in NotificationRulePathParams) (credit.NamespacedNotificationRuleID, error) {
+			return b.resolveNotificationRuleID(ctx, in)
+		},
+		operation.AsNoResponseOperation(b.CreditConnector.DeleteNotificationRule),
+		func(ctx context.Context, w http.ResponseWriter, response any) error {
+			w.WriteHeader(http.StatusNoContent)
+			return nil
+		},
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("deleteLedgerNotificationRule"),
+			httptransport.WithErrorEncoder(encodeNotificationRuleNotFoundError),
+		)...,
+	)
+}
+
+type ListLedgerNotificationDeliveriesRequest struct {
+	NotificationRulePathParams
+	api.ListLedgerNotificationDeliveriesParams
+}
+
+type ListLedgerNotificationDeliveriesHandler httptransport.HandlerWithArgs[credit.ListNotificationDeliveriesParams, []credit.NotificationDelivery, ListLedgerNotificationDeliveriesRequest]
+
+func (b *builder) ListLedgerNotificationDeliveries() ListLedgerNotificationDeliveriesHandler {
+	return httptransport.NewHandlerWithArgs[credit.ListNotificationDeliveriesParams, []credit.NotificationDelivery, ListLedgerNotificationDeliveriesRequest](
+		func(ctx context.Context, r *http.Request, in ListLedgerNotificationDeliveriesRequest) (credit.ListNotificationDeliveriesParams, error) {
+			ruleID, err := b.resolveNotificationRuleID(ctx, in.NotificationRulePathParams)
+			if err != nil {
+				return credit.ListNotificationDeliveriesParams{}, err
+			}
+
+			return credit.ListNotificationDeliveriesParams{
+				Namespace: ruleID.Namespace,
+				RuleIDs:   []credit.NotificationRuleID{ruleID.ID},
+				Limit:     defaultx.WithDefault(in.Limit, DefaultLedgerQueryLimit),
+			}, nil
+		},
+		b.CreditConnector.ListNotificationDeliveries,
+		commonhttp.JSONResponseEncoder,
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("listLedgerNotificationDeliveries"),
+			httptransport.WithErrorEncoder(encodeNotificationRuleNotFoundError),
+		)...,
+	)
+}
+
+// resolveNotificationRuleID returns the ID of the notification rule if it belongs to the ledger of the path.
+func (b *builder) resolveNotificationRuleID(ctx context.Context, in NotificationRulePathParams) (credit.NamespacedNotificationRuleID, error) {
+	ns, err := b.resolveNamespace(ctx)
+	if err != nil {
+		return credit.NamespacedNotificationRuleID{}, err
+	}
+
+	id := credit.NewNamespacedNotificationRuleID(ns, in.RuleID)
+
+	rule, err := b.CreditConnector.GetNotificationRule(ctx, id)
+	if err != nil {
+		return id, err
+	}
+
+	if rule.LedgerID != in.LedgerID {
+		return id, &credit.NotificationRuleNotFoundError{ID: in.RuleID}
+	}
+
+	return id, nil
+}
+
+func encodeNotificationRuleNotFoundError(ctx context.Context, err error, w http.ResponseWriter) bool {
+	if _, ok := err.(*credit.NotificationRuleNotFoundError); ok {
+		commonhttp.NewHTTPError(http.StatusNotFound, err).EncodeError(ctx, w)
+		return true
+	}
+	return false
+}
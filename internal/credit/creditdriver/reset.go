@@ -42,7 +42,12 @@ func (b *builder) ResetLedger() ResetLedgerHandler {
 		},
 		func(ctx context.Context, request credit.Reset) (credit.Reset, error) {
 			reset, _, err := b.CreditConnector.Reset(ctx, request)
-			return reset, err
+			if err != nil {
+				return reset, err
+			}
+
+			b.invalidateLedger(credit.NewNamespacedLedgerID(request.Namespace, request.LedgerID))
+			return reset, nil
 		},
 		commonhttp.JSONResponseEncoderWithStatus[credit.Reset](http.StatusCreated),
 		httptransport.AppendOptions(
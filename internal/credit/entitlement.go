@@ -0,0 +1,102 @@
+package credit
+
+import (
+	"math"
+	"time"
+)
+
+// Entitlement is the access of a subject to a feature derived from the balance of the ledger of the subject.
+type Entitlement struct {
+	Subject   string    `json:"subject"`
+	FeatureID FeatureID `json:"featureID"`
+
+	// LedgerID is the ledger of the subject, subjects without a ledger have no access to any feature.
+	LedgerID *LedgerID `json:"ledgerID,omitempty"`
+
+	// HasAccess is true if the feature has balance or overage left.
+	HasAccess bool `json:"hasAccess"`
+
+	// Balance is the remaining balance usable by the feature, including the balance of grants without feature.
+	Balance float64 `json:"balance"`
+
+	// Overage is the usage of the feature beyond the granted amount.
+	Overage float64 `json:"overage"`
+
+	// OverageLeft is the overage the feature may still use within the overage limits of the ledger.
+	OverageLeft float64 `json:"overageLeft"`
+
+	// CalculatedAt is the time the balance the entitlement is derived from was calculated.
+	CalculatedAt time.Time `json:"calculatedAt"`
+}
+
+// NewEntitlement derives the entitlement of a ledger to a feature from the balance of the ledger.
+// The ledger is nil for subjects without a ledger.
+func NewEntitlement(subject string, featureID FeatureID, ledger *Ledger, balance Balance, calculatedAt time.Time) Entitlement {
+	entitlement := Entitlement{
+		Subject:      subject,
+		FeatureID:    featureID,
+		CalculatedAt: calculatedAt,
+	}
+
+	if ledger == nil {
+		return entitlement
+	}
+
+	entitlement.LedgerID = &ledger.ID
+
+	var feature *FeatureBalance
+	for _, featureBalance := range balance.FeatureBalances {
+		if featureBalance.ID != nil && *featureBalance.ID == featureID {
+			entitlement.Overage = featureBalance.Overage
+			feature = &featureBalance
+		}
+	}
+
+	// The balance of grants in a currency is converted to the usage it pays for following the usage already paid for
+	var paid float64
+	if feature != nil && feature.MonetaryUsage != nil {
+		paid = feature.MonetaryUsage.Units
+	}
+
+	// Grants without feature are burned down by the usage of any feature
+	for _, grantBalance := range balance.GrantBalances {
+		if grantBalance.FeatureID != nil && *grantBalance.FeatureID != featureID {
+			continue
+		}
+
+		if grantBalance.Currency == nil {
+			entitlement.Balance += grantBalance.Balance
+			continue
+		}
+
+		// Grants in a currency only pay for features priced in the currency
+		if feature == nil || feature.UnitPrice == nil || feature.UnitPrice.Currency != *grantBalance.Currency {
+			continue
+		}
+
+		units := feature.UnitPrice.Units(paid, grantBalance.Balance)
+		entitlement.Balance += units
+		paid += units
+	}
+
+	// The overage of a feature is limited by both the limit of the feature and the limit of the ledger
+	if limits := ledger.OverageLimits; limits != nil {
+		left := math.Inf(1)
+
+		if limit, ok := limits.Features[featureID]; ok {
+			left = limit - entitlement.Overage
+		}
+
+		if limits.Limit != nil {
+			left = min(left, *limits.Limit-balance.Overage)
+		}
+
+		if !math.IsInf(left, 1) {
+			entitlement.OverageLeft = max(left, 0)
+		}
+	}
+
+	entitlement.HasAccess = entitlement.Balance > 0 || entitlement.OverageLeft > 0
+
+	return entitlement
+}
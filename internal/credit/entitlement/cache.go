@@ -0,0 +1,364 @@
+// Package entitlement serves entitlement checks from cached ledger balances.
+package entitlement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+)
+
+// CacheConfig configures a {Cache}.
+type CacheConfig struct {
+	// MaxStaleness is the maximum age of the balance an entitlement check is served from.
+	// Older balances are recalculated by the check.
+	MaxStaleness time.Duration
+
+	// RefreshInterval is the time between two runs recalculating the cached balances before they get too stale.
+	RefreshInterval time.Duration
+
+	// IdleTimeout is the time after which the balance of a subject not checked is evicted instead of recalculated.
+	IdleTimeout time.Duration
+}
+
+// Validate validates the configuration.
+func (c CacheConfig) Validate() error {
+	if c.MaxStaleness <= 0 {
+		return errors.New("max staleness must be positive")
+	}
+
+	if c.RefreshInterval <= 0 {
+		return errors.New("refresh interval must be positive")
+	}
+
+	if c.RefreshInterval >= c.MaxStaleness {
+		return errors.New("refresh interval must be less than max staleness")
+	}
+
+	if c.IdleTimeout <= 0 {
+		return errors.New("idle timeout must be positive")
+	}
+
+	return nil
+}
+
+type cacheKey struct {
+	Namespace string
+	Subject   string
+}
+
+type cacheEntry struct {
+	// ledger is nil for subjects without a ledger
+	ledger       *credit.Ledger
+	balance      credit.Balance
+	calculatedAt time.Time
+
+	// checkedAt is the unix nano time of the last check served from the entry
+	checkedAt atomic.Int64
+}
+
+// Cache serves entitlement checks from the balances of ledgers calculated by the credit connector.
+//
+// Checks are served from memory while the cached balance is younger than MaxStaleness, balances of subjects checked
+// recently are recalculated in the background so checks rarely wait for a calculation.
+// Changes made through this instance are applied immediately when the cache is invalidated,
+// changes made on other instances or by workers are picked up with a delay of at most MaxStaleness.
+type Cache struct {
+	connector credit.Connector
+	logger    *slog.Logger
+	config    CacheConfig
+
+	mu      sync.RWMutex
+	entries map[cacheKey]*cacheEntry
+
+	// version is incremented on invalidation, the versions of the last invalidation of subjects and ledgers
+	// are recorded so balances of them calculated before are not cached
+	version             uint64
+	subjectInvalidation map[cacheKey]uint64
+	ledgerInvalidation  map[credit.NamespacedLedgerID]uint64
+	// calculating counts the calculations in progress by the version they started at,
+	// invalidations before the oldest one are forgotten
+	calculating map[uint64]int
+
+	calculations singleflight.Group
+}
+
+// NewCache returns a new {Cache}.
+func NewCache(connector credit.Connector, logger *slog.Logger, config CacheConfig) (*Cache, error) {
+	if connector == nil {
+		return nil, fmt.Errorf("connector is required")
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Cache{
+		connector: connector,
+		logger:    logger,
+		config:    config,
+		entries:   make(map[cacheKey]*cacheEntry),
+
+		subjectInvalidation: make(map[cacheKey]uint64),
+		ledgerInvalidation:  make(map[credit.NamespacedLedgerID]uint64),
+		calculating:         make(map[uint64]int),
+	}, nil
+}
+
+// Check returns the entitlement of a subject to a feature.
+func (c *Cache) Check(ctx context.Context, namespace string, subject string, featureID credit.FeatureID) (credit.Entitlement, error) {
+	key := cacheKey{Namespace: namespace, Subject: subject}
+
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if !ok || time.Since(entry.calculatedAt) > c.config.MaxStaleness {
+		var err error
+
+		entry, err = c.calculate(ctx, key)
+		if err != nil {
+			return credit.Entitlement{}, err
+		}
+	}
+
+	entry.checkedAt.Store(time.Now().UnixNano())
+
+	return credit.NewEntitlement(subject, featureID, entry.ledger, entry.balance, entry.calculatedAt), nil
+}
+
+// InvalidateLedger drops the cached balance of a ledger.
+func (c *Cache) InvalidateLedger(ledgerID credit.NamespacedLedgerID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+	c.ledgerInvalidation[ledgerID] = c.version
+
+	for key, entry := range c.entries {
+		if key.Namespace == ledgerID.Namespace && entry.ledger != nil && entry.ledger.ID == ledgerID.ID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// InvalidateSubject drops the cached balance of a subject.
+func (c *Cache) InvalidateSubject(namespace string, subject string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := cacheKey{Namespace: namespace, Subject: subject}
+
+	c.version++
+	c.subjectInvalidation[key] = c.version
+
+	delete(c.entries, key)
+}
+
+// Run recalculates the cached balances periodically. It is meant to be added to a [run.Group].
+func (c *Cache) Run(ctx context.Context) (execute func() error, interrupt func(error)) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return func() error {
+			ticker := time.NewTicker(c.config.RefreshInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ticker.C:
+					c.refresh(ctx)
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		},
+		func(error) {
+			cancel()
+		}
+}
+
+// refresh evicts idle entries and recalculates the entries that would exceed MaxStaleness before the next run.
+func (c *Cache) refresh(ctx context.Context) {
+	c.forgetInvalidations()
+
+	c.mu.RLock()
+	entries := make(map[cacheKey]*cacheEntry, len(c.entries))
+	for key, entry := range c.entries {
+		entries[key] = entry
+	}
+	c.mu.RUnlock()
+
+	now := time.Now()
+
+	for key, entry := range entries {
+		if now.Sub(time.Unix(0, entry.checkedAt.Load())) > c.config.IdleTimeout {
+			c.evict(key, entry)
+			continue
+		}
+
+		if now.Sub(entry.calculatedAt) < c.config.MaxStaleness-c.config.RefreshInterval {
+			continue
+		}
+
+		if _, err := c.calculate(ctx, key); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+
+			// Checks recalculate the balance if it is not refreshed in time
+			c.logger.WarnContext(ctx, "refreshing entitlement balance failed", "namespace", key.Namespace, "subject", key.Subject, "error", err)
+		}
+	}
+}
+
+func (c *Cache) evict(key cacheKey, entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The entry may have been replaced meanwhile
+	if c.entries[key] == entry {
+		delete(c.entries, key)
+	}
+}
+
+// calculate calculates the balance of a subject and caches it.
+// Concurrent calculations of the same subject are deduplicated.
+func (c *Cache) calculate(ctx context.Context, key cacheKey) (*cacheEntry, error) {
+	c.mu.RLock()
+	version := c.version
+	c.mu.RUnlock()
+
+	// Checks after an invalidation do not join calculations started before
+	flightKey := fmt.Sprintf("%s/%s/%d", key.Namespace, key.Subject, version)
+
+	v, err, _ := c.calculations.Do(flightKey, func() (interface{}, error) {
+		version := c.startCalculation()
+		defer c.endCalculation(version)
+
+		entry, err := c.calculateEntry(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if c.invalidatedSince(key, entry.ledger, version) {
+			return entry, nil
+		}
+
+		if previous, ok := c.entries[key]; ok {
+			// A calculation started later finished first
+			if previous.calculatedAt.After(entry.calculatedAt) {
+				return entry, nil
+			}
+
+			entry.checkedAt.Store(previous.checkedAt.Load())
+		}
+
+		c.entries[key] = entry
+
+		return entry, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*cacheEntry), nil
+}
+
+// startCalculation registers a calculation in progress and returns the version it started at.
+func (c *Cache) startCalculation() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calculating[c.version]++
+
+	return c.version
+}
+
+func (c *Cache) endCalculation(version uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calculating[version]--
+	if c.calculating[version] == 0 {
+		delete(c.calculating, version)
+	}
+}
+
+// invalidatedSince tells whether the subject or its ledger was invalidated after the given version.
+// The caller must hold the lock.
+func (c *Cache) invalidatedSince(key cacheKey, ledger *credit.Ledger, version uint64) bool {
+	if c.subjectInvalidation[key] > version {
+		return true
+	}
+
+	return ledger != nil && c.ledgerInvalidation[credit.NewNamespacedLedgerID(key.Namespace, ledger.ID)] > version
+}
+
+// forgetInvalidations drops the invalidations no calculation in progress started before.
+func (c *Cache) forgetInvalidations() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	oldest := c.version
+	for version := range c.calculating {
+		oldest = min(oldest, version)
+	}
+
+	for key, version := range c.subjectInvalidation {
+		if version <= oldest {
+			delete(c.subjectInvalidation, key)
+		}
+	}
+
+	for ledgerID, version := range c.ledgerInvalidation {
+		if version <= oldest {
+			delete(c.ledgerInvalidation, ledgerID)
+		}
+	}
+}
+
+func (c *Cache) calculateEntry(ctx context.Context, key cacheKey) (*cacheEntry, error) {
+	// The balance is at least as fresh as the time the calculation started
+	entry := &cacheEntry{
+		calculatedAt: time.Now(),
+	}
+
+	ledgers, err := c.connector.ListLedgers(ctx, credit.ListLedgersParams{
+		Namespace: key.Namespace,
+		Subjects:  []string{key.Subject},
+		Limit:     1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ledgers: %w", err)
+	}
+
+	if len(ledgers) == 0 {
+		return entry, nil
+	}
+
+	ledger := ledgers[0]
+
+	balance, err := c.connector.GetBalance(ctx, credit.NewNamespacedLedgerID(key.Namespace, ledger.ID), entry.calculatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: %w", err)
+	}
+
+	entry.ledger = &ledger
+	entry.balance = balance
+
+	return entry, nil
+}
@@ -0,0 +1,187 @@
+package entitlement
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	credit_mock "github.com/openmeterio/openmeter/internal/credit/mock_connector"
+)
+
+func TestCacheCheck(t *testing.T) {
+	ctx := context.Background()
+	featureID := credit.FeatureID("feature-1")
+	ledger := credit.Ledger{Namespace: "default", ID: "ledger-1", Subject: "subject-1"}
+	ledgerID := credit.NewNamespacedLedgerID("default", ledger.ID)
+
+	balance := func(amount float64) credit.Balance {
+		return credit.Balance{
+			LedgerID: ledger.ID,
+			Subject:  ledger.Subject,
+			GrantBalances: []credit.GrantBalance{
+				{Grant: credit.Grant{FeatureID: &featureID, Amount: 100}, Balance: amount},
+			},
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	connector := credit_mock.NewMockConnector(ctrl)
+
+	cache, err := NewCache(connector, slog.Default(), CacheConfig{
+		MaxStaleness:    time.Minute,
+		RefreshInterval: 10 * time.Second,
+		IdleTimeout:     10 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	connector.EXPECT().ListLedgers(gomock.Any(), gomock.Any()).Return([]credit.Ledger{ledger}, nil).Times(2)
+	gomock.InOrder(
+		connector.EXPECT().GetBalance(gomock.Any(), ledgerID, gomock.Any()).Return(balance(10), nil),
+		connector.EXPECT().GetBalance(gomock.Any(), ledgerID, gomock.Any()).Return(balance(0), nil),
+	)
+
+	// The balance is calculated on the first check and served from the cache after
+	for i := 0; i < 3; i++ {
+		entitlement, err := cache.Check(ctx, "default", "subject-1", featureID)
+		require.NoError(t, err)
+		assert.True(t, entitlement.HasAccess)
+		assert.Equal(t, 10.0, entitlement.Balance)
+		assert.Equal(t, &ledger.ID, entitlement.LedgerID)
+	}
+
+	// The balance is recalculated after invalidation
+	cache.InvalidateLedger(ledgerID)
+
+	entitlement, err := cache.Check(ctx, "default", "subject-1", featureID)
+	require.NoError(t, err)
+	assert.False(t, entitlement.HasAccess)
+	assert.Equal(t, 0.0, entitlement.Balance)
+}
+
+func TestCacheCheckWithoutLedger(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	connector := credit_mock.NewMockConnector(ctrl)
+
+	cache, err := NewCache(connector, slog.Default(), CacheConfig{
+		MaxStaleness:    time.Minute,
+		RefreshInterval: 10 * time.Second,
+		IdleTimeout:     10 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	connector.EXPECT().ListLedgers(gomock.Any(), credit.ListLedgersParams{
+		Namespace: "default",
+		Subjects:  []string{"subject-1"},
+		Limit:     1,
+	}).Return([]credit.Ledger{}, nil).Times(2)
+
+	entitlement, err := cache.Check(ctx, "default", "subject-1", "feature-1")
+	require.NoError(t, err)
+	assert.False(t, entitlement.HasAccess)
+	assert.Nil(t, entitlement.LedgerID)
+
+	_, err = cache.Check(ctx, "default", "subject-1", "feature-1")
+	require.NoError(t, err)
+
+	// Creating the ledger of the subject invalidates the subject
+	cache.InvalidateSubject("default", "subject-1")
+
+	_, err = cache.Check(ctx, "default", "subject-1", "feature-1")
+	require.NoError(t, err)
+}
+
+func TestCacheRefresh(t *testing.T) {
+	ctx := context.Background()
+	ledger := credit.Ledger{Namespace: "default", ID: "ledger-1", Subject: "subject-1"}
+
+	ctrl := gomock.NewController(t)
+	connector := credit_mock.NewMockConnector(ctrl)
+
+	cache, err := NewCache(connector, slog.Default(), CacheConfig{
+		MaxStaleness:    time.Minute,
+		RefreshInterval: 10 * time.Second,
+		IdleTimeout:     10 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	connector.EXPECT().ListLedgers(gomock.Any(), gomock.Any()).Return([]credit.Ledger{ledger}, nil).Times(2)
+	connector.EXPECT().GetBalance(gomock.Any(), gomock.Any(), gomock.Any()).Return(credit.Balance{}, nil).Times(2)
+
+	_, err = cache.Check(ctx, "default", "subject-1", "feature-1")
+	require.NoError(t, err)
+
+	key := cacheKey{Namespace: "default", Subject: "subject-1"}
+
+	// Fresh balances are not recalculated
+	cache.refresh(ctx)
+
+	// Balances about to exceed the max staleness are recalculated
+	entry := cache.entries[key]
+	entry.calculatedAt = time.Now().Add(-55 * time.Second)
+	cache.refresh(ctx)
+	assert.NotSame(t, entry, cache.entries[key])
+
+	// Idle balances are evicted
+	cache.entries[key].checkedAt.Store(time.Now().Add(-time.Hour).UnixNano())
+	cache.refresh(ctx)
+	assert.Empty(t, cache.entries)
+}
+
+func TestCacheInvalidationDuringCalculation(t *testing.T) {
+	ctx := context.Background()
+	ledger := credit.Ledger{Namespace: "default", ID: "ledger-1", Subject: "subject-1"}
+	ledgerID := credit.NewNamespacedLedgerID("default", ledger.ID)
+
+	ctrl := gomock.NewController(t)
+	connector := credit_mock.NewMockConnector(ctrl)
+
+	cache, err := NewCache(connector, slog.Default(), CacheConfig{
+		MaxStaleness:    time.Minute,
+		RefreshInterval: 10 * time.Second,
+		IdleTimeout:     10 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	key := cacheKey{Namespace: "default", Subject: "subject-1"}
+
+	connector.EXPECT().ListLedgers(gomock.Any(), gomock.Any()).Return([]credit.Ledger{ledger}, nil).Times(2)
+	gomock.InOrder(
+		// Invalidating other subjects and ledgers keeps the balance calculated meanwhile
+		connector.EXPECT().GetBalance(gomock.Any(), ledgerID, gomock.Any()).DoAndReturn(func(context.Context, credit.NamespacedLedgerID, time.Time) (credit.Balance, error) {
+			cache.InvalidateLedger(credit.NewNamespacedLedgerID("default", "ledger-2"))
+			cache.InvalidateSubject("default", "subject-2")
+			cache.InvalidateLedger(credit.NewNamespacedLedgerID("other", ledger.ID))
+
+			return credit.Balance{}, nil
+		}),
+		// Invalidating the ledger drops the balance calculated meanwhile
+		connector.EXPECT().GetBalance(gomock.Any(), ledgerID, gomock.Any()).DoAndReturn(func(context.Context, credit.NamespacedLedgerID, time.Time) (credit.Balance, error) {
+			cache.InvalidateLedger(ledgerID)
+
+			return credit.Balance{}, nil
+		}),
+	)
+
+	_, err = cache.Check(ctx, "default", "subject-1", "feature-1")
+	require.NoError(t, err)
+	assert.Contains(t, cache.entries, key)
+
+	cache.entries[key].calculatedAt = time.Now().Add(-time.Hour)
+
+	_, err = cache.Check(ctx, "default", "subject-1", "feature-1")
+	require.NoError(t, err)
+	assert.NotContains(t, cache.entries, key)
+
+	// Invalidations are forgotten once no calculation started before them
+	cache.forgetInvalidations()
+	assert.Empty(t, cache.subjectInvalidation)
+	assert.Empty(t, cache.ledgerInvalidation)
+}
@@ -0,0 +1,179 @@
+package credit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openmeterio/openmeter/pkg/convert"
+)
+
+func TestNewEntitlement(t *testing.T) {
+	featureID := FeatureID("feature-1")
+	otherFeatureID := FeatureID("feature-2")
+	now := time.Now()
+
+	grantBalance := func(featureID *FeatureID, balance float64) GrantBalance {
+		return GrantBalance{
+			Grant:   Grant{FeatureID: featureID, Amount: 100},
+			Balance: balance,
+		}
+	}
+
+	tt := []struct {
+		name     string
+		ledger   *Ledger
+		balance  Balance
+		expected Entitlement
+	}{
+		{
+			name: "WithoutLedger",
+			expected: Entitlement{
+				Subject:      "subject-1",
+				FeatureID:    featureID,
+				CalculatedAt: now,
+			},
+		},
+		{
+			name:   "FeatureAndFeaturelessGrants",
+			ledger: &Ledger{ID: "ledger-1"},
+			balance: Balance{
+				GrantBalances: []GrantBalance{
+					grantBalance(&featureID, 10),
+					grantBalance(&otherFeatureID, 20),
+					grantBalance(nil, 5),
+				},
+			},
+			expected: Entitlement{
+				Subject:      "subject-1",
+				FeatureID:    featureID,
+				LedgerID:     convert.ToPointer(LedgerID("ledger-1")),
+				HasAccess:    true,
+				Balance:      15,
+				CalculatedAt: now,
+			},
+		},
+		{
+			name:   "MonetaryGrants",
+			ledger: &Ledger{ID: "ledger-1"},
+			balance: Balance{
+				FeatureBalances: []FeatureBalance{
+					{
+						Feature: Feature{
+							ID: &featureID,
+							UnitPrice: &UnitPrice{Currency: "USD", Tiers: []PriceTier{
+								{UpTo: convert.ToPointer(10.0), UnitAmount: 1},
+								{UnitAmount: 0.5},
+							}},
+						},
+						MonetaryUsage: &MonetaryUsage{Units: 8, Amount: 8},
+					},
+				},
+				GrantBalances: []GrantBalance{
+					{Grant: Grant{FeatureID: &featureID, Amount: 10, Currency: convert.ToPointer("USD")}, Balance: 2},
+					{Grant: Grant{Amount: 10, Currency: convert.ToPointer("USD")}, Balance: 1},
+					{Grant: Grant{Amount: 10, Currency: convert.ToPointer("EUR")}, Balance: 10},
+					grantBalance(nil, 5),
+				},
+			},
+			expected: Entitlement{
+				Subject:   "subject-1",
+				FeatureID: featureID,
+				LedgerID:  convert.ToPointer(LedgerID("ledger-1")),
+				HasAccess: true,
+				// 2 units of the first tier, 2 units of the second tier and 5 units
+				Balance:      9,
+				CalculatedAt: now,
+			},
+		},
+		{
+			name:   "Exhausted",
+			ledger: &Ledger{ID: "ledger-1"},
+			balance: Balance{
+				GrantBalances: []GrantBalance{
+					grantBalance(&featureID, 0),
+					grantBalance(&otherFeatureID, 20),
+				},
+			},
+			expected: Entitlement{
+				Subject:      "subject-1",
+				FeatureID:    featureID,
+				LedgerID:     convert.ToPointer(LedgerID("ledger-1")),
+				CalculatedAt: now,
+			},
+		},
+		{
+			name: "OverageLeft",
+			ledger: &Ledger{
+				ID: "ledger-1",
+				OverageLimits: &OverageLimits{
+					Limit:    convert.ToPointer(50.0),
+					Features: map[FeatureID]float64{featureID: 30},
+				},
+			},
+			balance: Balance{
+				GrantBalances: []GrantBalance{
+					grantBalance(&featureID, 0),
+				},
+				FeatureBalances: []FeatureBalance{
+					{Feature: Feature{ID: &featureID}, Overage: 10},
+					{Feature: Feature{ID: &otherFeatureID}, Overage: 25},
+				},
+				Overage: 35,
+			},
+			expected: Entitlement{
+				Subject:      "subject-1",
+				FeatureID:    featureID,
+				LedgerID:     convert.ToPointer(LedgerID("ledger-1")),
+				HasAccess:    true,
+				Overage:      10,
+				OverageLeft:  15,
+				CalculatedAt: now,
+			},
+		},
+		{
+			name: "OverageLimitReached",
+			ledger: &Ledger{
+				ID: "ledger-1",
+				OverageLimits: &OverageLimits{
+					Features: map[FeatureID]float64{featureID: 10},
+				},
+			},
+			balance: Balance{
+				FeatureBalances: []FeatureBalance{
+					{Feature: Feature{ID: &featureID}, Overage: 10},
+				},
+				Overage: 10,
+			},
+			expected: Entitlement{
+				Subject:      "subject-1",
+				FeatureID:    featureID,
+				LedgerID:     convert.ToPointer(LedgerID("ledger-1")),
+				Overage:      10,
+				CalculatedAt: now,
+			},
+		},
+		{
+			name: "NoOverageLimitForFeature",
+			ledger: &Ledger{
+				ID: "ledger-1",
+				OverageLimits: &OverageLimits{
+					Features: map[FeatureID]float64{otherFeatureID: 10},
+				},
+			},
+			expected: Entitlement{
+				Subject:      "subject-1",
+				FeatureID:    featureID,
+				LedgerID:     convert.ToPointer(LedgerID("ledger-1")),
+				CalculatedAt: now,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NewEntitlement("subject-1", featureID, tc.ledger, tc.balance, now))
+		})
+	}
+}
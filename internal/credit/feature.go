@@ -48,6 +48,16 @@ func (e *FeatureWithNameAlreadyExistsError) Error() string {
 	return fmt.Sprintf("feature %s with name %s already exists", e.ID, e.Name)
 }
 
+// FeatureFiltersOverlapError is returned when the usage of a feature would overlap the usage of an active feature without being the same.
+type FeatureFiltersOverlapError struct {
+	Filters map[string]string
+	ID      FeatureID
+}
+
+func (e *FeatureFiltersOverlapError) Error() string {
+	return fmt.Sprintf("filters %v overlap the filters of feature %s", e.Filters, e.ID)
+}
+
 // Feature is a feature or service offered to a customer.
 // For example: CPU-Hours, Tokens, API Calls, etc.
 type Feature struct {
@@ -61,11 +71,79 @@ type Feature struct {
 	MeterSlug string `json:"meterSlug,omitempty"`
 
 	// MeterGroupByFilters Optional meter group by filters. Useful if the meter scope is broader than what feature tracks.
+	// When the filters are updated they are the latest filters, see MeterGroupByFilterVersions for the filters in effect over time.
+	// Active features of a meter either have the same filters or filters that do not overlap (differ in the value of a group by),
+	// otherwise grants without feature would burn down the usage matched by both features twice.
 	MeterGroupByFilters *map[string]string `json:"meterGroupByFilters,omitempty"`
 
+	// UnitPrice Optional price of a unit of usage, grants in its currency are burned down by the cost of the usage.
+	// The price cannot be changed once the feature is created.
+	UnitPrice *UnitPrice `json:"unitPrice,omitempty"`
+
 	// Read-only fields
+
+	// MeterGroupByFilterVersions The versions of the filters in the order they take effect, empty if the filters were never updated.
+	MeterGroupByFilterVersions []FeatureFilterVersion `json:"meterGroupByFilterVersions,omitempty"`
+
+	// Archived Archived features cannot be granted, existing grants of the feature are burned down until they expire or are voided
+	// and grant schedules of the feature skip their occurrences while the feature is archived.
+	// Occurrences are checked against the feature when they are issued, which may be later than they fall due:
+	// occurrences issued while the feature is archived are skipped even if they fell due before it was archived,
+	// and occurrences not yet issued when the feature is unarchived are issued.
 	Archived *bool `json:"archived,omitempty"`
 
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 }
+
+// FiltersAt returns the meter group by filters in effect at the given time.
+func (f Feature) FiltersAt(t time.Time) *map[string]string {
+	filters := f.MeterGroupByFilters
+	for _, version := range f.MeterGroupByFilterVersions {
+		if version.EffectiveAt.After(t) {
+			break
+		}
+
+		filters = nil
+		if len(version.MeterGroupByFilters) > 0 {
+			filters = &version.MeterGroupByFilters
+		}
+	}
+
+	return filters
+}
+
+// FeatureFilterVersion is a version of the meter group by filters of a feature.
+// Filters are versioned so the usage before a change is still queried with the filters in effect back then.
+type FeatureFilterVersion struct {
+	// EffectiveAt The time the filters apply from.
+	EffectiveAt time.Time `json:"effectiveAt"`
+
+	// MeterGroupByFilters The filters, empty if the usage of the feature is not filtered.
+	MeterGroupByFilters map[string]string `json:"meterGroupByFilters,omitempty"`
+}
+
+// FeatureUpdate updates a feature, unset fields are left unchanged.
+type FeatureUpdate struct {
+	// Name The new name of the feature, it must be unique among the active features.
+	Name *string `json:"name,omitempty"`
+
+	// MeterGroupByFilters The new meter group by filters, an empty map removes the filters.
+	MeterGroupByFilters *map[string]string `json:"meterGroupByFilters,omitempty"`
+
+	// EffectiveAt The time the new filters apply from, defaults to now. It must not be in the past.
+	EffectiveAt *time.Time `json:"effectiveAt,omitempty"`
+
+	// Archived Archives or unarchives the feature.
+	Archived *bool `json:"archived,omitempty"`
+}
+
+// InvalidFeatureUpdateError is returned when a feature cannot be updated.
+type InvalidFeatureUpdateError struct {
+	ID     FeatureID
+	Reason string
+}
+
+func (e *InvalidFeatureUpdateError) Error() string {
+	return fmt.Sprintf("feature %s cannot be updated: %s", e.ID, e.Reason)
+}
@@ -0,0 +1,55 @@
+package credit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeatureFiltersAt(t *testing.T) {
+	changedAt := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	removedAt := changedAt.AddDate(0, 1, 0)
+
+	feature := Feature{
+		MeterGroupByFilters: &map[string]string{"model": "gpt-4o"},
+		MeterGroupByFilterVersions: []FeatureFilterVersion{
+			{MeterGroupByFilters: map[string]string{"model": "gpt-4"}},
+			{EffectiveAt: changedAt, MeterGroupByFilters: map[string]string{"model": "gpt-4o"}},
+			{EffectiveAt: removedAt},
+		},
+	}
+
+	tt := []struct {
+		name     string
+		at       time.Time
+		expected *map[string]string
+	}{
+		{
+			name:     "BeforeChange",
+			at:       changedAt.Add(-time.Minute),
+			expected: &map[string]string{"model": "gpt-4"},
+		},
+		{
+			name:     "AtChange",
+			at:       changedAt,
+			expected: &map[string]string{"model": "gpt-4o"},
+		},
+		{
+			name:     "AfterRemoval",
+			at:       removedAt.Add(time.Minute),
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, feature.FiltersAt(tc.at))
+		})
+	}
+
+	t.Run("NoVersions", func(t *testing.T) {
+		feature := Feature{MeterGroupByFilters: &map[string]string{"model": "gpt-4"}}
+		assert.Equal(t, feature.MeterGroupByFilters, feature.FiltersAt(changedAt))
+	})
+}
@@ -0,0 +1,80 @@
+package credit
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+)
+
+// InvalidGrantAmendmentError is returned when an amendment cannot be applied to the current state of the grant.
+type InvalidGrantAmendmentError struct {
+	GrantID GrantID
+	Reason  string
+}
+
+func (e *InvalidGrantAmendmentError) Error() string {
+	return fmt.Sprintf("grant %s cannot be amended: %s", e.GrantID, e.Reason)
+}
+
+// GrantAmendment changes the terms of a grant from its effective time on.
+// Amendments are recorded as new ledger entries, the grant is used with its original terms before the amendment.
+type GrantAmendment struct {
+	Namespace string `json:"-"`
+	// ID is the readonly identifies of an amendment.
+	ID *GrantID `json:"id,omitempty"`
+
+	// GrantID The amended grant.
+	GrantID GrantID `json:"grantID"`
+
+	// LedgerID The ledger of the amended grant.
+	LedgerID LedgerID `json:"ledgerID"`
+
+	// EffectiveAt The time the amended terms apply from.
+	EffectiveAt time.Time `json:"effectiveAt"`
+
+	// Amount The new amount of the grant, the remaining balance changes by the difference but does not go negative.
+	Amount *float64 `json:"amount,omitempty"`
+
+	// ExpiresAt The new expiration time of the grant.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Metadata The new metadata of the grant, replaces the metadata of the grant.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+}
+
+// Validate validates the amendment.
+func (a GrantAmendment) Validate() error {
+	if a.Amount == nil && a.ExpiresAt == nil && a.Metadata == nil {
+		return errors.New("amendment must change the amount, the expiration or the metadata")
+	}
+
+	if a.Amount != nil && *a.Amount <= 0 {
+		return errors.New("amount must be positive")
+	}
+
+	if a.ExpiresAt != nil && !a.ExpiresAt.After(a.EffectiveAt) {
+		return errors.New("expiration must be after the effective time")
+	}
+
+	return nil
+}
+
+// Apply returns the grant with the amended terms.
+func (a GrantAmendment) Apply(grant Grant) Grant {
+	if a.Amount != nil {
+		grant.Amount = *a.Amount
+	}
+
+	if a.ExpiresAt != nil {
+		grant.ExpiresAt = *a.ExpiresAt
+	}
+
+	if a.Metadata != nil {
+		grant.Metadata = maps.Clone(a.Metadata)
+	}
+
+	return grant
+}
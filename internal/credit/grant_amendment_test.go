@@ -0,0 +1,95 @@
+package credit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openmeterio/openmeter/pkg/convert"
+)
+
+func TestGrantAmendmentValidate(t *testing.T) {
+	effectiveAt := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name      string
+		amendment GrantAmendment
+		valid     bool
+	}{
+		{
+			name:      "Amount",
+			amendment: GrantAmendment{EffectiveAt: effectiveAt, Amount: convert.ToPointer(200.0)},
+			valid:     true,
+		},
+		{
+			name:      "ExpiresAt",
+			amendment: GrantAmendment{EffectiveAt: effectiveAt, ExpiresAt: convert.ToPointer(effectiveAt.AddDate(0, 1, 0))},
+			valid:     true,
+		},
+		{
+			name:      "EmptyMetadata",
+			amendment: GrantAmendment{EffectiveAt: effectiveAt, Metadata: map[string]string{}},
+			valid:     true,
+		},
+		{
+			name:      "NoChange",
+			amendment: GrantAmendment{EffectiveAt: effectiveAt},
+			valid:     false,
+		},
+		{
+			name:      "ZeroAmount",
+			amendment: GrantAmendment{EffectiveAt: effectiveAt, Amount: convert.ToPointer(0.0)},
+			valid:     false,
+		},
+		{
+			name:      "ExpiresAtEffectiveAt",
+			amendment: GrantAmendment{EffectiveAt: effectiveAt, ExpiresAt: convert.ToPointer(effectiveAt)},
+			valid:     false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.amendment.Validate()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGrantAmendmentApply(t *testing.T) {
+	effectiveAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	grant := Grant{
+		Amount:      100,
+		EffectiveAt: effectiveAt,
+		Expiration: ExpirationPeriod{
+			Duration: ExpirationPeriodDurationMonth,
+			Count:    1,
+		},
+		ExpiresAt: effectiveAt.AddDate(0, 1, 0),
+		Metadata:  map[string]string{"source": "stripe"},
+	}
+
+	amended := GrantAmendment{
+		ExpiresAt: convert.ToPointer(effectiveAt.AddDate(0, 2, 0)),
+	}.Apply(grant)
+	assert.Equal(t, 100.0, amended.Amount)
+	assert.Equal(t, effectiveAt.AddDate(0, 2, 0), amended.ExpirationDate())
+	assert.Equal(t, map[string]string{"source": "stripe"}, amended.Metadata)
+
+	amended = GrantAmendment{
+		Amount:   convert.ToPointer(150.0),
+		Metadata: map[string]string{"source": "support"},
+	}.Apply(amended)
+	assert.Equal(t, 150.0, amended.Amount)
+	assert.Equal(t, effectiveAt.AddDate(0, 2, 0), amended.ExpirationDate())
+	assert.Equal(t, map[string]string{"source": "support"}, amended.Metadata)
+
+	// The grant is not changed
+	assert.Equal(t, 100.0, grant.Amount)
+	assert.Equal(t, effectiveAt.AddDate(0, 1, 0), grant.ExpirationDate())
+}
@@ -0,0 +1,102 @@
+package credit
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// GrantScheduleID is the unique identifier for a grant schedule.
+type GrantScheduleID string
+
+type NamespacedGrantScheduleID struct {
+	Namespace string
+	ID        GrantScheduleID
+}
+
+func NewNamespacedGrantScheduleID(namespace string, id GrantScheduleID) NamespacedGrantScheduleID {
+	return NamespacedGrantScheduleID{
+		Namespace: namespace,
+		ID:        id,
+	}
+}
+
+type GrantScheduleNotFoundError struct {
+	ID GrantScheduleID
+}
+
+func (e *GrantScheduleNotFoundError) Error() string {
+	return fmt.Sprintf("grant schedule not found: %s", e.ID)
+}
+
+// GrantSchedule issues a grant to a ledger on a recurring schedule.
+// Grants are issued by a background worker, each grant is issued once even if the worker runs late.
+type GrantSchedule struct {
+	Namespace string `json:"-"`
+	// ID is the readonly identifies of a grant schedule.
+	ID *GrantScheduleID `json:"id,omitempty"`
+
+	// LedgerID The ledger to issue grants to.
+	LedgerID LedgerID `json:"ledgerID"`
+
+	// Type The type of the issued grants.
+	Type GrantType `json:"type"`
+
+	// FeatureID The feature ID of the issued grants.
+	FeatureID *FeatureID `json:"featureID,omitempty"`
+
+	// Amount The amount of each issued grant.
+	Amount float64 `json:"amount"`
+
+	// Priority The priority of the issued grants.
+	Priority uint8 `json:"priority"`
+
+	// Expiration The expiration configuration of the issued grants.
+	Expiration ExpirationPeriod `json:"expiration"`
+
+	// Rollover The rollover configuration of the issued grants.
+	Rollover *GrantRollover `json:"rollover,omitempty"`
+
+	// Metadata The metadata of the issued grants.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Recurrence The schedule of the issued grants.
+	Recurrence Recurrence `json:"recurrence"`
+
+	// NextGrantAt is the readonly effective time of the next grant to issue.
+	NextGrantAt time.Time `json:"nextGrantAt"`
+
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Validate validates the grant schedule.
+func (s GrantSchedule) Validate() error {
+	if err := s.Recurrence.Validate(); err != nil {
+		return err
+	}
+
+	if s.Amount <= 0 {
+		return errors.New("amount must be positive")
+	}
+
+	return nil
+}
+
+// Grant returns the grant issued at the given time.
+func (s GrantSchedule) Grant(effectiveAt time.Time) Grant {
+	return Grant{
+		Namespace:   s.Namespace,
+		LedgerID:    s.LedgerID,
+		ScheduleID:  s.ID,
+		Type:        s.Type,
+		FeatureID:   s.FeatureID,
+		Amount:      s.Amount,
+		Priority:    s.Priority,
+		EffectiveAt: effectiveAt,
+		Expiration:  s.Expiration,
+		ExpiresAt:   s.Expiration.GetExpiration(effectiveAt),
+		Rollover:    s.Rollover,
+		Metadata:    s.Metadata,
+	}
+}
@@ -0,0 +1,84 @@
+// Package grantschedule issues the grants of recurring grant schedules.
+package grantschedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+)
+
+// WorkerConfig configures a {Worker}.
+type WorkerConfig struct {
+	// Interval is the time between two runs issuing due grants.
+	Interval time.Duration
+}
+
+// Worker issues the grants of grant schedules as they become due.
+//
+// Issuing is idempotent, several workers (eg. one per server replica) can run at the same time
+// and a worker catches up with the occurrences it missed while it was not running.
+type Worker struct {
+	Connector credit.Connector
+	Logger    *slog.Logger
+	Config    WorkerConfig
+}
+
+// NewWorker returns a new {Worker}.
+func NewWorker(connector credit.Connector, logger *slog.Logger, config WorkerConfig) (*Worker, error) {
+	if connector == nil {
+		return nil, fmt.Errorf("connector is required")
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+
+	if config.Interval <= 0 {
+		return nil, fmt.Errorf("interval must be positive")
+	}
+
+	return &Worker{
+		Connector: connector,
+		Logger:    logger,
+		Config:    config,
+	}, nil
+}
+
+// Run issues due grants periodically. It is meant to be added to a [run.Group].
+func (w *Worker) Run(ctx context.Context) (execute func() error, interrupt func(error)) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return func() error {
+			ticker := time.NewTicker(w.Config.Interval)
+			defer ticker.Stop()
+
+			for {
+				w.issue(ctx)
+
+				select {
+				case <-ticker.C:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		},
+		func(error) {
+			cancel()
+		}
+}
+
+func (w *Worker) issue(ctx context.Context) {
+	grants, err := w.Connector.IssueScheduledGrants(ctx, time.Now())
+	if err != nil && !errors.Is(err, context.Canceled) {
+		// Schedules failing to issue are retried on the next run
+		w.Logger.WarnContext(ctx, "issuing scheduled grants failed", "error", err)
+	}
+
+	if len(grants) > 0 {
+		w.Logger.InfoContext(ctx, "issued scheduled grants", "count", len(grants))
+	}
+}
@@ -2,6 +2,8 @@ package credit
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"slices"
 	"sort"
 	"time"
@@ -30,10 +32,43 @@ type Ledger struct {
 
 	Metadata map[string]string `json:"metadata,omitempty"`
 
+	// OverageLimits allow usage beyond the granted amount, no overage is allowed if nil
+	OverageLimits *OverageLimits `json:"overageLimits,omitempty"`
+
+	// UsagePeriod is the recurring period the ledger is reset at the boundaries of, the ledger is only reset manually if nil
+	UsagePeriod *Recurrence `json:"usagePeriod,omitempty"`
+
+	// NextResetAt is the readonly time of the next automatic reset of the usage period
+	NextResetAt *time.Time `json:"nextResetAt,omitempty"`
+
 	// CreatedAt is the time the ledger was created
 	CreatedAt time.Time `json:"createdAt"`
 }
 
+// OverageLimits allow usage beyond the granted amount of a ledger, for example to bill it to postpaid customers.
+// The overage of a feature is limited by both the limit of the feature and the limit of the ledger if set.
+type OverageLimits struct {
+	// Limit is the overage allowed across the features of the ledger
+	Limit *float64 `json:"limit,omitempty"`
+	// Features is the overage allowed per feature
+	Features map[FeatureID]float64 `json:"features,omitempty"`
+}
+
+// Validate validates the overage limits.
+func (l OverageLimits) Validate() error {
+	if l.Limit != nil && *l.Limit < 0 {
+		return errors.New("limit must not be negative")
+	}
+
+	for featureID, limit := range l.Features {
+		if limit < 0 {
+			return fmt.Errorf("limit of feature %s must not be negative", featureID)
+		}
+	}
+
+	return nil
+}
+
 type LedgerEntryType string
 
 // Used to sort ledger entries by type.
@@ -42,6 +77,7 @@ var entryTypeWeight = map[LedgerEntryType]int{
 	LedgerEntryTypeReset:      2,
 	LedgerEntryTypeGrant:      3,
 	LedgerEntryTypeVoid:       4,
+	LedgerEntryTypeAmendment:  5,
 }
 
 // Defines values for LedgerEntryType.
@@ -50,6 +86,7 @@ const (
 	LedgerEntryTypeVoid       LedgerEntryType = "VOID"
 	LedgerEntryTypeReset      LedgerEntryType = "RESET"
 	LedgerEntryTypeGrantUsage LedgerEntryType = "GRANT_USAGE"
+	LedgerEntryTypeAmendment  LedgerEntryType = "AMENDMENT"
 )
 
 func (LedgerEntryType) Values() (kinds []string) {
@@ -58,6 +95,7 @@ func (LedgerEntryType) Values() (kinds []string) {
 		LedgerEntryTypeVoid,
 		LedgerEntryTypeReset,
 		LedgerEntryTypeGrantUsage,
+		LedgerEntryTypeAmendment,
 	} {
 		kinds = append(kinds, string(s))
 	}
@@ -65,12 +103,15 @@ func (LedgerEntryType) Values() (kinds []string) {
 }
 
 // LedgerEntry is a credit ledger entry.
+// The amount is in the currency of the grant if the grant is denominated in a currency, the units of usage otherwise.
 type LedgerEntry struct {
 	ID        *GrantID        `json:"id,omitempty"`
 	Type      LedgerEntryType `json:"type"`
 	Time      time.Time       `json:"time"`
 	FeatureID *FeatureID      `json:"featureId,omitempty"`
 	Amount    *float64        `json:"amount,omitempty"`
+	Currency  *string         `json:"currency,omitempty"`
+	Units     *float64        `json:"units,omitempty"`
 	Period    *Period         `json:"period,omitempty"`
 }
 
@@ -147,6 +188,7 @@ func (c *LedgerEntryList) AddGrant(grant Grant) {
 		Time:      grant.EffectiveAt,
 		FeatureID: grant.FeatureID,
 		Amount:    &grant.Amount,
+		Currency:  grant.Currency,
 	})
 }
 
@@ -157,6 +199,19 @@ func (c *LedgerEntryList) AddVoidGrant(grant Grant) {
 		Time:      grant.EffectiveAt,
 		FeatureID: grant.FeatureID,
 		Amount:    &grant.Amount,
+		Currency:  grant.Currency,
+	})
+}
+
+// AddGrantAmendment adds the amendment of a grant, the amount is the change of the granted amount.
+func (c *LedgerEntryList) AddGrantAmendment(grant Grant, amendment GrantAmendment, amount float64) {
+	c.list = append(c.list, LedgerEntry{
+		ID:        grant.ID,
+		Type:      LedgerEntryTypeAmendment,
+		Time:      amendment.EffectiveAt,
+		FeatureID: grant.FeatureID,
+		Amount:    &amount,
+		Currency:  grant.Currency,
 	})
 }
 
@@ -169,6 +224,15 @@ func (c *LedgerEntryList) AddReset(reset Reset) {
 }
 
 func (c *LedgerEntryList) AddGrantUsage(grantBalance GrantBalance, from time.Time, to time.Time, amount float64) {
+	c.addGrantUsage(grantBalance, from, to, amount, nil)
+}
+
+// AddMonetaryGrantUsage adds the usage of a grant denominated in a currency, the amount is the cost of the units of usage.
+func (c *LedgerEntryList) AddMonetaryGrantUsage(grantBalance GrantBalance, from time.Time, to time.Time, amount float64, units float64) {
+	c.addGrantUsage(grantBalance, from, to, amount, &units)
+}
+
+func (c *LedgerEntryList) addGrantUsage(grantBalance GrantBalance, from time.Time, to time.Time, amount float64, units *float64) {
 	now := time.Now()
 	if to.After(now) {
 		to = now
@@ -180,6 +244,8 @@ func (c *LedgerEntryList) AddGrantUsage(grantBalance GrantBalance, from time.Tim
 		Time:      to,
 		FeatureID: grantBalance.FeatureID,
 		Amount:    &amount,
+		Currency:  grantBalance.Currency,
+		Units:     units,
 		Period: &Period{
 			From: from,
 			To:   to,
@@ -36,6 +36,36 @@ func (m *MockConnector) EXPECT() *MockConnectorMockRecorder {
 	return m.recorder
 }
 
+// AmendGrant mocks base method.
+func (m *MockConnector) AmendGrant(arg0 context.Context, arg1 credit.GrantAmendment) (credit.GrantAmendment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AmendGrant", arg0, arg1)
+	ret0, _ := ret[0].(credit.GrantAmendment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AmendGrant indicates an expected call of AmendGrant.
+func (mr *MockConnectorMockRecorder) AmendGrant(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AmendGrant", reflect.TypeOf((*MockConnector)(nil).AmendGrant), arg0, arg1)
+}
+
+// ClaimNotificationDeliveries mocks base method.
+func (m *MockConnector) ClaimNotificationDeliveries(arg0 context.Context, arg1 time.Time, arg2 time.Duration, arg3 int) ([]credit.NotificationDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNotificationDeliveries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]credit.NotificationDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNotificationDeliveries indicates an expected call of ClaimNotificationDeliveries.
+func (mr *MockConnectorMockRecorder) ClaimNotificationDeliveries(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNotificationDeliveries", reflect.TypeOf((*MockConnector)(nil).ClaimNotificationDeliveries), arg0, arg1, arg2, arg3)
+}
+
 // CreateFeature mocks base method.
 func (m *MockConnector) CreateFeature(arg0 context.Context, arg1 credit.Feature) (credit.Feature, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +96,21 @@ func (mr *MockConnectorMockRecorder) CreateGrant(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGrant", reflect.TypeOf((*MockConnector)(nil).CreateGrant), arg0, arg1)
 }
 
+// CreateGrantSchedule mocks base method.
+func (m *MockConnector) CreateGrantSchedule(arg0 context.Context, arg1 credit.GrantSchedule) (credit.GrantSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGrantSchedule", arg0, arg1)
+	ret0, _ := ret[0].(credit.GrantSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGrantSchedule indicates an expected call of CreateGrantSchedule.
+func (mr *MockConnectorMockRecorder) CreateGrantSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGrantSchedule", reflect.TypeOf((*MockConnector)(nil).CreateGrantSchedule), arg0, arg1)
+}
+
 // CreateLedger mocks base method.
 func (m *MockConnector) CreateLedger(arg0 context.Context, arg1 credit.Ledger) (credit.Ledger, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +126,21 @@ func (mr *MockConnectorMockRecorder) CreateLedger(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLedger", reflect.TypeOf((*MockConnector)(nil).CreateLedger), arg0, arg1)
 }
 
+// CreateNotificationRule mocks base method.
+func (m *MockConnector) CreateNotificationRule(arg0 context.Context, arg1 credit.NotificationRule) (credit.NotificationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotificationRule", arg0, arg1)
+	ret0, _ := ret[0].(credit.NotificationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNotificationRule indicates an expected call of CreateNotificationRule.
+func (mr *MockConnectorMockRecorder) CreateNotificationRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotificationRule", reflect.TypeOf((*MockConnector)(nil).CreateNotificationRule), arg0, arg1)
+}
+
 // DeleteFeature mocks base method.
 func (m *MockConnector) DeleteFeature(arg0 context.Context, arg1 credit.NamespacedFeatureID) error {
 	m.ctrl.T.Helper()
@@ -95,6 +155,49 @@ func (mr *MockConnectorMockRecorder) DeleteFeature(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeature", reflect.TypeOf((*MockConnector)(nil).DeleteFeature), arg0, arg1)
 }
 
+// DeleteGrantSchedule mocks base method.
+func (m *MockConnector) DeleteGrantSchedule(arg0 context.Context, arg1 credit.NamespacedGrantScheduleID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGrantSchedule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGrantSchedule indicates an expected call of DeleteGrantSchedule.
+func (mr *MockConnectorMockRecorder) DeleteGrantSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGrantSchedule", reflect.TypeOf((*MockConnector)(nil).DeleteGrantSchedule), arg0, arg1)
+}
+
+// DeleteNotificationRule mocks base method.
+func (m *MockConnector) DeleteNotificationRule(arg0 context.Context, arg1 credit.NamespacedNotificationRuleID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotificationRule indicates an expected call of DeleteNotificationRule.
+func (mr *MockConnectorMockRecorder) DeleteNotificationRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationRule", reflect.TypeOf((*MockConnector)(nil).DeleteNotificationRule), arg0, arg1)
+}
+
+// EvaluateNotificationRules mocks base method.
+func (m *MockConnector) EvaluateNotificationRules(arg0 context.Context, arg1 time.Time) ([]credit.NotificationDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateNotificationRules", arg0, arg1)
+	ret0, _ := ret[0].([]credit.NotificationDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateNotificationRules indicates an expected call of EvaluateNotificationRules.
+func (mr *MockConnectorMockRecorder) EvaluateNotificationRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateNotificationRules", reflect.TypeOf((*MockConnector)(nil).EvaluateNotificationRules), arg0, arg1)
+}
+
 // GetBalance mocks base method.
 func (m *MockConnector) GetBalance(arg0 context.Context, arg1 credit.NamespacedLedgerID, arg2 time.Time) (credit.Balance, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +243,21 @@ func (mr *MockConnectorMockRecorder) GetGrant(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrant", reflect.TypeOf((*MockConnector)(nil).GetGrant), arg0, arg1)
 }
 
+// GetGrantSchedule mocks base method.
+func (m *MockConnector) GetGrantSchedule(arg0 context.Context, arg1 credit.NamespacedGrantScheduleID) (credit.GrantSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrantSchedule", arg0, arg1)
+	ret0, _ := ret[0].(credit.GrantSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrantSchedule indicates an expected call of GetGrantSchedule.
+func (mr *MockConnectorMockRecorder) GetGrantSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrantSchedule", reflect.TypeOf((*MockConnector)(nil).GetGrantSchedule), arg0, arg1)
+}
+
 // GetHighWatermark mocks base method.
 func (m *MockConnector) GetHighWatermark(arg0 context.Context, arg1 credit.NamespacedLedgerID) (credit.HighWatermark, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +288,36 @@ func (mr *MockConnectorMockRecorder) GetHistory(arg0, arg1, arg2, arg3, arg4 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockConnector)(nil).GetHistory), arg0, arg1, arg2, arg3, arg4)
 }
 
+// GetNotificationRule mocks base method.
+func (m *MockConnector) GetNotificationRule(arg0 context.Context, arg1 credit.NamespacedNotificationRuleID) (credit.NotificationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationRule", arg0, arg1)
+	ret0, _ := ret[0].(credit.NotificationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationRule indicates an expected call of GetNotificationRule.
+func (mr *MockConnectorMockRecorder) GetNotificationRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationRule", reflect.TypeOf((*MockConnector)(nil).GetNotificationRule), arg0, arg1)
+}
+
+// IssueScheduledGrants mocks base method.
+func (m *MockConnector) IssueScheduledGrants(arg0 context.Context, arg1 time.Time) ([]credit.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueScheduledGrants", arg0, arg1)
+	ret0, _ := ret[0].([]credit.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueScheduledGrants indicates an expected call of IssueScheduledGrants.
+func (mr *MockConnectorMockRecorder) IssueScheduledGrants(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueScheduledGrants", reflect.TypeOf((*MockConnector)(nil).IssueScheduledGrants), arg0, arg1)
+}
+
 // ListFeatures mocks base method.
 func (m *MockConnector) ListFeatures(arg0 context.Context, arg1 credit.ListFeaturesParams) ([]credit.Feature, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +333,36 @@ func (mr *MockConnectorMockRecorder) ListFeatures(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeatures", reflect.TypeOf((*MockConnector)(nil).ListFeatures), arg0, arg1)
 }
 
+// ListGrantAmendments mocks base method.
+func (m *MockConnector) ListGrantAmendments(arg0 context.Context, arg1 credit.NamespacedGrantID) ([]credit.GrantAmendment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGrantAmendments", arg0, arg1)
+	ret0, _ := ret[0].([]credit.GrantAmendment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGrantAmendments indicates an expected call of ListGrantAmendments.
+func (mr *MockConnectorMockRecorder) ListGrantAmendments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGrantAmendments", reflect.TypeOf((*MockConnector)(nil).ListGrantAmendments), arg0, arg1)
+}
+
+// ListGrantSchedules mocks base method.
+func (m *MockConnector) ListGrantSchedules(arg0 context.Context, arg1 credit.ListGrantSchedulesParams) ([]credit.GrantSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGrantSchedules", arg0, arg1)
+	ret0, _ := ret[0].([]credit.GrantSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGrantSchedules indicates an expected call of ListGrantSchedules.
+func (mr *MockConnectorMockRecorder) ListGrantSchedules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGrantSchedules", reflect.TypeOf((*MockConnector)(nil).ListGrantSchedules), arg0, arg1)
+}
+
 // ListGrants mocks base method.
 func (m *MockConnector) ListGrants(arg0 context.Context, arg1 credit.ListGrantsParams) ([]credit.Grant, error) {
 	m.ctrl.T.Helper()
@@ -215,6 +393,36 @@ func (mr *MockConnectorMockRecorder) ListLedgers(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLedgers", reflect.TypeOf((*MockConnector)(nil).ListLedgers), arg0, arg1)
 }
 
+// ListNotificationDeliveries mocks base method.
+func (m *MockConnector) ListNotificationDeliveries(arg0 context.Context, arg1 credit.ListNotificationDeliveriesParams) ([]credit.NotificationDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationDeliveries", arg0, arg1)
+	ret0, _ := ret[0].([]credit.NotificationDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationDeliveries indicates an expected call of ListNotificationDeliveries.
+func (mr *MockConnectorMockRecorder) ListNotificationDeliveries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationDeliveries", reflect.TypeOf((*MockConnector)(nil).ListNotificationDeliveries), arg0, arg1)
+}
+
+// ListNotificationRules mocks base method.
+func (m *MockConnector) ListNotificationRules(arg0 context.Context, arg1 credit.ListNotificationRulesParams) ([]credit.NotificationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotificationRules", arg0, arg1)
+	ret0, _ := ret[0].([]credit.NotificationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotificationRules indicates an expected call of ListNotificationRules.
+func (mr *MockConnectorMockRecorder) ListNotificationRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotificationRules", reflect.TypeOf((*MockConnector)(nil).ListNotificationRules), arg0, arg1)
+}
+
 // Reset mocks base method.
 func (m *MockConnector) Reset(arg0 context.Context, arg1 credit.Reset) (credit.Reset, []credit.Grant, error) {
 	m.ctrl.T.Helper()
@@ -231,6 +439,96 @@ func (mr *MockConnectorMockRecorder) Reset(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockConnector)(nil).Reset), arg0, arg1)
 }
 
+// ResetUsagePeriods mocks base method.
+func (m *MockConnector) ResetUsagePeriods(arg0 context.Context, arg1 time.Time) ([]credit.Reset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetUsagePeriods", arg0, arg1)
+	ret0, _ := ret[0].([]credit.Reset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetUsagePeriods indicates an expected call of ResetUsagePeriods.
+func (mr *MockConnectorMockRecorder) ResetUsagePeriods(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetUsagePeriods", reflect.TypeOf((*MockConnector)(nil).ResetUsagePeriods), arg0, arg1)
+}
+
+// SnapshotBalances mocks base method.
+func (m *MockConnector) SnapshotBalances(arg0 context.Context, arg1 time.Time) ([]credit.BalanceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotBalances", arg0, arg1)
+	ret0, _ := ret[0].([]credit.BalanceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SnapshotBalances indicates an expected call of SnapshotBalances.
+func (mr *MockConnectorMockRecorder) SnapshotBalances(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotBalances", reflect.TypeOf((*MockConnector)(nil).SnapshotBalances), arg0, arg1)
+}
+
+// UpdateFeature mocks base method.
+func (m *MockConnector) UpdateFeature(arg0 context.Context, arg1 credit.NamespacedFeatureID, arg2 credit.FeatureUpdate) (credit.Feature, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFeature", arg0, arg1, arg2)
+	ret0, _ := ret[0].(credit.Feature)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFeature indicates an expected call of UpdateFeature.
+func (mr *MockConnectorMockRecorder) UpdateFeature(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFeature", reflect.TypeOf((*MockConnector)(nil).UpdateFeature), arg0, arg1, arg2)
+}
+
+// UpdateNotificationDelivery mocks base method.
+func (m *MockConnector) UpdateNotificationDelivery(arg0 context.Context, arg1 credit.NotificationDelivery) (credit.NotificationDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationDelivery", arg0, arg1)
+	ret0, _ := ret[0].(credit.NotificationDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNotificationDelivery indicates an expected call of UpdateNotificationDelivery.
+func (mr *MockConnectorMockRecorder) UpdateNotificationDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationDelivery", reflect.TypeOf((*MockConnector)(nil).UpdateNotificationDelivery), arg0, arg1)
+}
+
+// UpdateOverageLimits mocks base method.
+func (m *MockConnector) UpdateOverageLimits(arg0 context.Context, arg1 credit.NamespacedLedgerID, arg2 credit.OverageLimits) (credit.Ledger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOverageLimits", arg0, arg1, arg2)
+	ret0, _ := ret[0].(credit.Ledger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOverageLimits indicates an expected call of UpdateOverageLimits.
+func (mr *MockConnectorMockRecorder) UpdateOverageLimits(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOverageLimits", reflect.TypeOf((*MockConnector)(nil).UpdateOverageLimits), arg0, arg1, arg2)
+}
+
+// UpdateUsagePeriod mocks base method.
+func (m *MockConnector) UpdateUsagePeriod(arg0 context.Context, arg1 credit.NamespacedLedgerID, arg2 *credit.Recurrence) (credit.Ledger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUsagePeriod", arg0, arg1, arg2)
+	ret0, _ := ret[0].(credit.Ledger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUsagePeriod indicates an expected call of UpdateUsagePeriod.
+func (mr *MockConnectorMockRecorder) UpdateUsagePeriod(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsagePeriod", reflect.TypeOf((*MockConnector)(nil).UpdateUsagePeriod), arg0, arg1, arg2)
+}
+
 // VoidGrant mocks base method.
 func (m *MockConnector) VoidGrant(arg0 context.Context, arg1 credit.Grant) (credit.Grant, error) {
 	m.ctrl.T.Helper()
@@ -26,6 +26,12 @@ func (c *Connector) CreateLedger(ctx context.Context, ledger credit.Ledger) (cre
 func (c *Connector) ListLedgers(ctx context.Context, params credit.ListLedgersParams) ([]credit.Ledger, error) {
 	return nil, fmt.Errorf("not implemented")
 }
+func (c *Connector) UpdateOverageLimits(ctx context.Context, ledgerID credit.NamespacedLedgerID, limits credit.OverageLimits) (credit.Ledger, error) {
+	return credit.Ledger{}, fmt.Errorf("not implemented")
+}
+func (c *Connector) UpdateUsagePeriod(ctx context.Context, ledgerID credit.NamespacedLedgerID, usagePeriod *credit.Recurrence) (credit.Ledger, error) {
+	return credit.Ledger{}, fmt.Errorf("not implemented")
+}
 
 // Grant
 func (c *Connector) CreateGrant(ctx context.Context, grant credit.Grant) (credit.Grant, error) {
@@ -40,6 +46,55 @@ func (c *Connector) ListGrants(ctx context.Context, params credit.ListGrantsPara
 func (c *Connector) GetGrant(ctx context.Context, grantID credit.NamespacedGrantID) (credit.Grant, error) {
 	return credit.Grant{}, fmt.Errorf("not implemented")
 }
+func (c *Connector) AmendGrant(ctx context.Context, amendment credit.GrantAmendment) (credit.GrantAmendment, error) {
+	return credit.GrantAmendment{}, fmt.Errorf("not implemented")
+}
+func (c *Connector) ListGrantAmendments(ctx context.Context, grantID credit.NamespacedGrantID) ([]credit.GrantAmendment, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// Grant schedule
+func (c *Connector) CreateGrantSchedule(ctx context.Context, schedule credit.GrantSchedule) (credit.GrantSchedule, error) {
+	return credit.GrantSchedule{}, fmt.Errorf("not implemented")
+}
+func (c *Connector) ListGrantSchedules(ctx context.Context, params credit.ListGrantSchedulesParams) ([]credit.GrantSchedule, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (c *Connector) GetGrantSchedule(ctx context.Context, scheduleID credit.NamespacedGrantScheduleID) (credit.GrantSchedule, error) {
+	return credit.GrantSchedule{}, fmt.Errorf("not implemented")
+}
+func (c *Connector) DeleteGrantSchedule(ctx context.Context, scheduleID credit.NamespacedGrantScheduleID) error {
+	return fmt.Errorf("not implemented")
+}
+func (c *Connector) IssueScheduledGrants(ctx context.Context, until time.Time) ([]credit.Grant, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// Notification
+func (c *Connector) CreateNotificationRule(ctx context.Context, rule credit.NotificationRule) (credit.NotificationRule, error) {
+	return credit.NotificationRule{}, fmt.Errorf("not implemented")
+}
+func (c *Connector) ListNotificationRules(ctx context.Context, params credit.ListNotificationRulesParams) ([]credit.NotificationRule, error) {
+	return []credit.NotificationRule{}, fmt.Errorf("not implemented")
+}
+func (c *Connector) GetNotificationRule(ctx context.Context, ruleID credit.NamespacedNotificationRuleID) (credit.NotificationRule, error) {
+	return credit.NotificationRule{}, fmt.Errorf("not implemented")
+}
+func (c *Connector) DeleteNotificationRule(ctx context.Context, ruleID credit.NamespacedNotificationRuleID) error {
+	return fmt.Errorf("not implemented")
+}
+func (c *Connector) EvaluateNotificationRules(ctx context.Context, at time.Time) ([]credit.NotificationDelivery, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (c *Connector) ListNotificationDeliveries(ctx context.Context, params credit.ListNotificationDeliveriesParams) ([]credit.NotificationDelivery, error) {
+	return []credit.NotificationDelivery{}, fmt.Errorf("not implemented")
+}
+func (c *Connector) ClaimNotificationDeliveries(ctx context.Context, until time.Time, lease time.Duration, limit int) ([]credit.NotificationDelivery, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (c *Connector) UpdateNotificationDelivery(ctx context.Context, delivery credit.NotificationDelivery) (credit.NotificationDelivery, error) {
+	return credit.NotificationDelivery{}, fmt.Errorf("not implemented")
+}
 
 // Credit
 func (c *Connector) GetBalance(ctx context.Context, ledgerID credit.NamespacedLedgerID, cutline time.Time) (credit.Balance, error) {
@@ -54,11 +109,21 @@ func (c *Connector) GetHighWatermark(ctx context.Context, ledgerID credit.Namesp
 func (c *Connector) Reset(ctx context.Context, reset credit.Reset) (credit.Reset, []credit.Grant, error) {
 	return credit.Reset{}, []credit.Grant{}, fmt.Errorf("not implemented")
 }
+func (c *Connector) ResetUsagePeriods(ctx context.Context, until time.Time) ([]credit.Reset, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (c *Connector) SnapshotBalances(ctx context.Context, until time.Time) ([]credit.BalanceSnapshot, error) {
+	return nil, fmt.Errorf("not implemented")
+}
 
 // Feature
 func (c *Connector) CreateFeature(ctx context.Context, feature credit.Feature) (credit.Feature, error) {
 	return credit.Feature{}, fmt.Errorf("not implemented")
 }
+func (c *Connector) UpdateFeature(ctx context.Context, featureID credit.NamespacedFeatureID, update credit.FeatureUpdate) (credit.Feature, error) {
+	return credit.Feature{}, fmt.Errorf("not implemented")
+}
 func (c *Connector) DeleteFeature(ctx context.Context, featureID credit.NamespacedFeatureID) error {
 	return fmt.Errorf("not implemented")
 }
@@ -0,0 +1,262 @@
+package credit
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// NotificationRuleID is the unique identifier for a notification rule.
+type NotificationRuleID string
+
+type NamespacedNotificationRuleID struct {
+	Namespace string
+	ID        NotificationRuleID
+}
+
+func NewNamespacedNotificationRuleID(namespace string, id NotificationRuleID) NamespacedNotificationRuleID {
+	return NamespacedNotificationRuleID{
+		Namespace: namespace,
+		ID:        id,
+	}
+}
+
+type NotificationRuleNotFoundError struct {
+	ID NotificationRuleID
+}
+
+func (e *NotificationRuleNotFoundError) Error() string {
+	return fmt.Sprintf("notification rule not found: %s", e.ID)
+}
+
+// NotificationDeliveryLeaseLostError is returned when a claimed delivery is updated after it was claimed again.
+type NotificationDeliveryLeaseLostError struct {
+	ID string
+}
+
+func (e *NotificationDeliveryLeaseLostError) Error() string {
+	return fmt.Sprintf("lease of notification delivery lost: %s", e.ID)
+}
+
+type NotificationRuleType string
+
+// Defines values for NotificationRuleType.
+const (
+	// NotificationRuleTypeBalanceBelow notifies when the balance drops below an absolute amount
+	NotificationRuleTypeBalanceBelow NotificationRuleType = "BALANCE_BELOW"
+	// NotificationRuleTypeBalanceBelowPercent notifies when the balance drops below a percent of the granted amount
+	NotificationRuleTypeBalanceBelowPercent NotificationRuleType = "BALANCE_BELOW_PERCENT"
+	// NotificationRuleTypeBalanceExhausted notifies when no balance is left
+	NotificationRuleTypeBalanceExhausted NotificationRuleType = "BALANCE_EXHAUSTED"
+)
+
+// Values returns all known values for NotificationRuleType.
+func (NotificationRuleType) Values() (kinds []string) {
+	for _, s := range []NotificationRuleType{
+		NotificationRuleTypeBalanceBelow,
+		NotificationRuleTypeBalanceBelowPercent,
+		NotificationRuleTypeBalanceExhausted,
+	} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// NotificationRule notifies a webhook when the balance of a ledger, or of a feature of the ledger, crosses a threshold.
+// A rule notifies once when its condition becomes met and again only after the condition cleared, eg. after a top-up.
+type NotificationRule struct {
+	Namespace string `json:"-"`
+	// ID is the readonly identifies of a notification rule.
+	ID *NotificationRuleID `json:"id,omitempty"`
+
+	// LedgerID The ledger to watch.
+	LedgerID LedgerID `json:"ledgerID"`
+
+	// FeatureID The feature to watch the balance of, the balance of all grants is watched if not set.
+	FeatureID *FeatureID `json:"featureID,omitempty"`
+
+	// Type The condition of the rule.
+	Type NotificationRuleType `json:"type"`
+
+	// Threshold The amount or percent the balance is compared to, not used by BALANCE_EXHAUSTED rules.
+	Threshold *float64 `json:"threshold,omitempty"`
+
+	// URL The webhook endpoint notified.
+	URL string `json:"url"`
+
+	// Triggered is the readonly state of the rule, true while the condition is met.
+	Triggered bool `json:"triggered"`
+
+	// TriggeredAt is the readonly time the rule was last triggered.
+	TriggeredAt *time.Time `json:"triggeredAt,omitempty"`
+
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Validate validates the notification rule.
+func (r NotificationRule) Validate() error {
+	switch r.Type {
+	case NotificationRuleTypeBalanceBelow:
+		if r.Threshold == nil || *r.Threshold < 0 {
+			return errors.New("threshold must be a non-negative amount")
+		}
+	case NotificationRuleTypeBalanceBelowPercent:
+		if r.Threshold == nil || *r.Threshold <= 0 || *r.Threshold > 100 {
+			return errors.New("threshold must be a percent between 0 and 100")
+		}
+	case NotificationRuleTypeBalanceExhausted:
+		if r.Threshold != nil {
+			return errors.New("threshold is not supported by exhausted rules")
+		}
+	default:
+		return fmt.Errorf("invalid notification rule type: %s", r.Type)
+	}
+
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("url must be an absolute http(s) url")
+	}
+
+	// Webhooks must not reach the internal network of the server (names are checked again when they are resolved)
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errors.New("url must not point to an internal address")
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil && IsInternalAddress(addr) {
+		return errors.New("url must not point to an internal address")
+	}
+
+	return nil
+}
+
+// IsInternalAddress reports whether the address is loopback, private, link-local or unspecified,
+// webhooks are not delivered to such addresses.
+func IsInternalAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	return addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsUnspecified()
+}
+
+// Evaluate returns the balance watched by the rule and whether the condition of the rule is met.
+// Rules do not apply to ledgers without grants to watch.
+func (r NotificationRule) Evaluate(balance Balance) (NotificationBalance, bool) {
+	watched := NotificationBalance{}
+	hasGrants := false
+
+	for _, grantBalance := range balance.GrantBalances {
+		if r.FeatureID != nil && (grantBalance.FeatureID == nil || *grantBalance.FeatureID != *r.FeatureID) {
+			continue
+		}
+
+		hasGrants = true
+		watched.Balance += grantBalance.Balance
+		watched.Granted += grantBalance.Amount
+	}
+
+	if !hasGrants {
+		return watched, false
+	}
+
+	switch r.Type {
+	case NotificationRuleTypeBalanceBelow:
+		return watched, watched.Balance < *r.Threshold
+	case NotificationRuleTypeBalanceBelowPercent:
+		return watched, watched.Granted > 0 && watched.Balance/watched.Granted*100 < *r.Threshold
+	case NotificationRuleTypeBalanceExhausted:
+		return watched, watched.Balance <= 0
+	default:
+		return watched, false
+	}
+}
+
+// NotificationBalance is the balance watched by a notification rule.
+type NotificationBalance struct {
+	// Balance The remaining balance of the watched grants.
+	Balance float64 `json:"balance"`
+
+	// Granted The total amount of the watched grants.
+	Granted float64 `json:"granted"`
+}
+
+// NotificationEvent is the payload of the webhook sent when a notification rule is triggered.
+type NotificationEvent struct {
+	// ID The unique identifier of the event, retries of a delivery send the same ID.
+	ID string `json:"id"`
+
+	// Time The time the rule was triggered.
+	Time time.Time `json:"time"`
+
+	RuleID    NotificationRuleID   `json:"ruleID"`
+	Type      NotificationRuleType `json:"type"`
+	Threshold *float64             `json:"threshold,omitempty"`
+	LedgerID  LedgerID             `json:"ledgerID"`
+	Subject   string               `json:"subject"`
+	FeatureID *FeatureID           `json:"featureID,omitempty"`
+
+	NotificationBalance
+}
+
+type NotificationDeliveryStatus string
+
+// Defines values for NotificationDeliveryStatus.
+const (
+	NotificationDeliveryStatusPending   NotificationDeliveryStatus = "PENDING"
+	NotificationDeliveryStatusSucceeded NotificationDeliveryStatus = "SUCCEEDED"
+	NotificationDeliveryStatusFailed    NotificationDeliveryStatus = "FAILED"
+)
+
+// Values returns all known values for NotificationDeliveryStatus.
+func (NotificationDeliveryStatus) Values() (kinds []string) {
+	for _, s := range []NotificationDeliveryStatus{
+		NotificationDeliveryStatusPending,
+		NotificationDeliveryStatusSucceeded,
+		NotificationDeliveryStatusFailed,
+	} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// NotificationDelivery is the delivery of a notification event to a webhook, retried until it succeeds or runs out of attempts.
+type NotificationDelivery struct {
+	Namespace string             `json:"-"`
+	ID        string             `json:"id"`
+	RuleID    NotificationRuleID `json:"ruleID"`
+	LedgerID  LedgerID           `json:"ledgerID"`
+
+	// URL The webhook endpoint the event is delivered to.
+	URL   string            `json:"url"`
+	Event NotificationEvent `json:"event"`
+
+	Status NotificationDeliveryStatus `json:"status"`
+
+	// Attempts The number of delivery attempts made.
+	Attempts int `json:"attempts"`
+
+	// NextAttemptAt The time of the next attempt if the delivery is pending.
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+
+	// LeasedUntil The end of the lease of a claimed delivery, a claimed delivery is only updated while the lease is held.
+	LeasedUntil *time.Time `json:"-"`
+
+	LastAttemptAt  *time.Time `json:"lastAttemptAt,omitempty"`
+	LastStatusCode *int       `json:"lastStatusCode,omitempty"`
+	LastError      *string    `json:"lastError,omitempty"`
+
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
@@ -0,0 +1,112 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+)
+
+// Headers of the webhook requests.
+const (
+	// HeaderWebhookID is the ID of the event, retries of a delivery send the same ID
+	HeaderWebhookID = "X-OpenMeter-Webhook-Id"
+	// HeaderWebhookTimestamp is the unix time the request was signed at
+	HeaderWebhookTimestamp = "X-OpenMeter-Webhook-Timestamp"
+	// HeaderWebhookSignature is the signature of the request, see Sign
+	HeaderWebhookSignature = "X-OpenMeter-Webhook-Signature"
+)
+
+const signatureVersion = "v1"
+
+// Sign returns the signature of a webhook request:
+// the hex encoded HMAC-SHA256 of "{id}.{timestamp}.{payload}" keyed with the signing secret, prefixed with the version.
+func Sign(secret string, id string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = fmt.Fprintf(mac, "%s.%d.", id, timestamp)
+	_, _ = mac.Write(payload)
+
+	return signatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a webhook request, it is meant to be used by receivers.
+func Verify(secret string, id string, timestamp int64, payload []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, id, timestamp, payload)), []byte(signature))
+}
+
+// newWebhookClient returns an HTTP client refusing to connect to internal addresses,
+// the addresses are checked when they are dialed, so names resolving to internal addresses are refused as well.
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+
+			if credit.IsInternalAddress(addrPort.Addr()) {
+				return fmt.Errorf("webhook address is internal: %s", addrPort.Addr())
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	// Webhooks are sent directly, so the addresses dialed are the addresses of the webhooks
+	transport.Proxy = nil
+
+	return &http.Client{Transport: transport}
+}
+
+// send posts the event of the delivery to its webhook and returns the status code of the response if any.
+func (w *Worker) send(ctx context.Context, delivery credit.NotificationDelivery) (int, error) {
+	payload, err := json.Marshal(delivery.Event)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, w.Config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookID, delivery.Event.ID)
+	req.Header.Set(HeaderWebhookTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderWebhookSignature, Sign(w.Config.SigningSecret, delivery.Event.ID, timestamp, payload))
+
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Drain the body to reuse the connection
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,199 @@
+// Package notification evaluates the notification rules of ledgers and delivers the triggered events to webhooks.
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/pkg/convert"
+)
+
+// deliveryBatchSize is the number of deliveries claimed at once.
+const deliveryBatchSize = 10
+
+// WorkerConfig configures a {Worker}.
+type WorkerConfig struct {
+	// Interval is the time between two runs evaluating rules and delivering events.
+	Interval time.Duration
+
+	// SigningSecret is the secret the webhook requests are signed with.
+	SigningSecret string
+
+	// Timeout is the timeout of a webhook request.
+	Timeout time.Duration
+
+	// MaxAttempts is the number of attempts after which a delivery fails.
+	MaxAttempts int
+
+	// RetryInitialInterval is the time to wait before the first retry, it doubles after every failure.
+	RetryInitialInterval time.Duration
+	RetryMaxInterval     time.Duration
+}
+
+// Worker evaluates notification rules and delivers the triggered events to webhooks.
+//
+// Several workers (eg. one per server replica) can run at the same time: a rule triggers once
+// and a delivery is claimed by a single worker at a time. Deliveries are at least once,
+// receivers can deduplicate events by their ID.
+type Worker struct {
+	Connector credit.Connector
+	Logger    *slog.Logger
+	Config    WorkerConfig
+	// Client sends the webhook requests, it refuses to connect to internal addresses by default.
+	Client *http.Client
+}
+
+// NewWorker returns a new {Worker}.
+func NewWorker(connector credit.Connector, logger *slog.Logger, config WorkerConfig) (*Worker, error) {
+	if connector == nil {
+		return nil, fmt.Errorf("connector is required")
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+
+	if config.Interval <= 0 {
+		return nil, fmt.Errorf("interval must be positive")
+	}
+
+	if config.SigningSecret == "" {
+		return nil, fmt.Errorf("signing secret is required")
+	}
+
+	if config.Timeout <= 0 {
+		return nil, fmt.Errorf("timeout must be positive")
+	}
+
+	if config.MaxAttempts < 1 {
+		return nil, fmt.Errorf("max attempts must be at least 1")
+	}
+
+	if config.RetryInitialInterval <= 0 || config.RetryMaxInterval < config.RetryInitialInterval {
+		return nil, fmt.Errorf("invalid retry intervals")
+	}
+
+	return &Worker{
+		Connector: connector,
+		Logger:    logger,
+		Config:    config,
+		Client:    newWebhookClient(),
+	}, nil
+}
+
+// Run evaluates rules and delivers events periodically. It is meant to be added to a [run.Group].
+func (w *Worker) Run(ctx context.Context) (execute func() error, interrupt func(error)) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return func() error {
+			ticker := time.NewTicker(w.Config.Interval)
+			defer ticker.Stop()
+
+			for {
+				w.evaluate(ctx)
+				w.deliver(ctx)
+
+				select {
+				case <-ticker.C:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		},
+		func(error) {
+			cancel()
+		}
+}
+
+func (w *Worker) evaluate(ctx context.Context) {
+	deliveries, err := w.Connector.EvaluateNotificationRules(ctx, time.Now())
+	if err != nil && !errors.Is(err, context.Canceled) {
+		// Rules failing to evaluate are retried on the next run
+		w.Logger.WarnContext(ctx, "evaluating notification rules failed", "error", err)
+	}
+
+	if len(deliveries) > 0 {
+		w.Logger.InfoContext(ctx, "notification rules triggered", "count", len(deliveries))
+	}
+}
+
+func (w *Worker) deliver(ctx context.Context) {
+	// Deliveries are sent one by one, the lease covers sending the whole batch
+	lease := time.Duration(deliveryBatchSize+1) * w.Config.Timeout
+
+	for ctx.Err() == nil {
+		deliveries, err := w.Connector.ClaimNotificationDeliveries(ctx, time.Now(), lease, deliveryBatchSize)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				w.Logger.WarnContext(ctx, "claiming notification deliveries failed", "error", err)
+			}
+			return
+		}
+
+		for _, delivery := range deliveries {
+			if err := w.attempt(ctx, delivery); err != nil && !errors.Is(err, context.Canceled) {
+				// The delivery is claimed again when its lease expires
+				w.Logger.WarnContext(ctx, "recording notification delivery failed", "delivery", delivery.ID, "error", err)
+			}
+		}
+
+		if len(deliveries) < deliveryBatchSize {
+			return
+		}
+	}
+}
+
+// attempt sends a delivery and records the outcome of the attempt.
+func (w *Worker) attempt(ctx context.Context, delivery credit.NotificationDelivery) error {
+	now := time.Now()
+
+	statusCode, err := w.send(ctx, delivery)
+	if ctx.Err() != nil {
+		// Shutting down, the attempt does not count
+		return ctx.Err()
+	}
+
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.LastStatusCode = nil
+	if statusCode > 0 {
+		delivery.LastStatusCode = &statusCode
+	}
+
+	switch {
+	case err == nil:
+		delivery.Status = credit.NotificationDeliveryStatusSucceeded
+		delivery.NextAttemptAt = nil
+		delivery.LastError = nil
+	case delivery.Attempts >= w.Config.MaxAttempts:
+		delivery.Status = credit.NotificationDeliveryStatusFailed
+		delivery.NextAttemptAt = nil
+		delivery.LastError = convert.ToPointer(err.Error())
+	default:
+		delivery.Status = credit.NotificationDeliveryStatusPending
+		delivery.NextAttemptAt = convert.ToPointer(now.Add(w.retryInterval(delivery.Attempts)))
+		delivery.LastError = convert.ToPointer(err.Error())
+	}
+
+	_, err = w.Connector.UpdateNotificationDelivery(ctx, delivery)
+
+	return err
+}
+
+// retryInterval returns the time to wait after the given number of failed attempts.
+func (w *Worker) retryInterval(attempts int) time.Duration {
+	interval := w.Config.RetryInitialInterval
+	for i := 1; i < attempts && interval < w.Config.RetryMaxInterval; i++ {
+		interval *= 2
+	}
+	if interval > w.Config.RetryMaxInterval {
+		interval = w.Config.RetryMaxInterval
+	}
+
+	return interval
+}
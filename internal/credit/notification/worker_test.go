@@ -0,0 +1,167 @@
+package notification
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	credit_mock "github.com/openmeterio/openmeter/internal/credit/mock_connector"
+)
+
+func TestSign(t *testing.T) {
+	payload := []byte(`{"id":"01HXVNDJR532E8GTBVSC2XK5D4"}`)
+	signature := Sign("secret", "01HXVNDJR532E8GTBVSC2XK5D4", 1700000000, payload)
+
+	assert.Regexp(t, "^v1=[0-9a-f]{64}$", signature)
+	assert.True(t, Verify("secret", "01HXVNDJR532E8GTBVSC2XK5D4", 1700000000, payload, signature))
+	assert.False(t, Verify("other", "01HXVNDJR532E8GTBVSC2XK5D4", 1700000000, payload, signature))
+	assert.False(t, Verify("secret", "01HXVNDJR532E8GTBVSC2XK5D4", 1700000001, payload, signature))
+	assert.False(t, Verify("secret", "01HXVNDJR532E8GTBVSC2XK5D4", 1700000000, []byte(`{}`), signature))
+}
+
+func TestWorkerAttempt(t *testing.T) {
+	statusCode := http.StatusInternalServerError
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		timestamp, err := strconv.ParseInt(r.Header.Get(HeaderWebhookTimestamp), 10, 64)
+		require.NoError(t, err)
+
+		assert.Equal(t, "event-1", r.Header.Get(HeaderWebhookID))
+		assert.True(t, Verify("secret", "event-1", timestamp, payload, r.Header.Get(HeaderWebhookSignature)))
+
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	ctrl := gomock.NewController(t)
+	connector := credit_mock.NewMockConnector(ctrl)
+
+	worker, err := NewWorker(connector, slog.Default(), WorkerConfig{
+		Interval:             time.Minute,
+		SigningSecret:        "secret",
+		Timeout:              time.Second,
+		MaxAttempts:          3,
+		RetryInitialInterval: time.Minute,
+		RetryMaxInterval:     time.Hour,
+	})
+	require.NoError(t, err)
+
+	// The test server listens on a loopback address
+	worker.Client = server.Client()
+
+	delivery := credit.NotificationDelivery{
+		ID:     "event-1",
+		URL:    server.URL,
+		Status: credit.NotificationDeliveryStatusPending,
+		Event: credit.NotificationEvent{
+			ID:   "event-1",
+			Type: credit.NotificationRuleTypeBalanceExhausted,
+		},
+	}
+
+	var recorded credit.NotificationDelivery
+	connector.EXPECT().UpdateNotificationDelivery(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, delivery credit.NotificationDelivery) (credit.NotificationDelivery, error) {
+			recorded = delivery
+			return delivery, nil
+		}).
+		Times(3)
+
+	// Failed attempt is retried with backoff
+	start := time.Now()
+	require.NoError(t, worker.attempt(context.Background(), delivery))
+	assert.Equal(t, credit.NotificationDeliveryStatusPending, recorded.Status)
+	assert.Equal(t, 1, recorded.Attempts)
+	assert.Equal(t, http.StatusInternalServerError, *recorded.LastStatusCode)
+	assert.NotNil(t, recorded.LastError)
+	assert.WithinDuration(t, start.Add(time.Minute), *recorded.NextAttemptAt, 5*time.Second)
+
+	// Successful attempt completes the delivery
+	statusCode = http.StatusNoContent
+	require.NoError(t, worker.attempt(context.Background(), recorded))
+	assert.Equal(t, credit.NotificationDeliveryStatusSucceeded, recorded.Status)
+	assert.Equal(t, 2, recorded.Attempts)
+	assert.Nil(t, recorded.NextAttemptAt)
+	assert.Nil(t, recorded.LastError)
+
+	// Last attempt failing fails the delivery
+	statusCode = http.StatusBadGateway
+	delivery.Attempts = 2
+	require.NoError(t, worker.attempt(context.Background(), delivery))
+	assert.Equal(t, credit.NotificationDeliveryStatusFailed, recorded.Status)
+	assert.Equal(t, 3, recorded.Attempts)
+	assert.Nil(t, recorded.NextAttemptAt)
+}
+
+func TestWorkerAttemptInternalAddress(t *testing.T) {
+	var called bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	ctrl := gomock.NewController(t)
+	connector := credit_mock.NewMockConnector(ctrl)
+
+	worker, err := NewWorker(connector, slog.Default(), WorkerConfig{
+		Interval:             time.Minute,
+		SigningSecret:        "secret",
+		Timeout:              time.Second,
+		MaxAttempts:          3,
+		RetryInitialInterval: time.Minute,
+		RetryMaxInterval:     time.Hour,
+	})
+	require.NoError(t, err)
+
+	var recorded credit.NotificationDelivery
+	connector.EXPECT().UpdateNotificationDelivery(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, delivery credit.NotificationDelivery) (credit.NotificationDelivery, error) {
+			recorded = delivery
+			return delivery, nil
+		})
+
+	// Webhooks are not delivered to internal addresses even if the rule passed validation (eg. a name resolving to one)
+	require.NoError(t, worker.attempt(context.Background(), credit.NotificationDelivery{
+		ID:     "event-1",
+		URL:    server.URL,
+		Status: credit.NotificationDeliveryStatusPending,
+		Event: credit.NotificationEvent{
+			ID:   "event-1",
+			Type: credit.NotificationRuleTypeBalanceExhausted,
+		},
+	}))
+	assert.False(t, called)
+	assert.Equal(t, credit.NotificationDeliveryStatusPending, recorded.Status)
+	require.NotNil(t, recorded.LastError)
+	assert.Contains(t, *recorded.LastError, "webhook address is internal")
+}
+
+func TestWorkerRetryInterval(t *testing.T) {
+	worker := &Worker{
+		Config: WorkerConfig{
+			RetryInitialInterval: time.Minute,
+			RetryMaxInterval:     5 * time.Minute,
+		},
+	}
+
+	assert.Equal(t, time.Minute, worker.retryInterval(1))
+	assert.Equal(t, 2*time.Minute, worker.retryInterval(2))
+	assert.Equal(t, 4*time.Minute, worker.retryInterval(3))
+	assert.Equal(t, 5*time.Minute, worker.retryInterval(4))
+	assert.Equal(t, 5*time.Minute, worker.retryInterval(10))
+}
@@ -0,0 +1,149 @@
+package credit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openmeterio/openmeter/pkg/convert"
+)
+
+func TestNotificationRuleValidate(t *testing.T) {
+	tt := []struct {
+		name  string
+		rule  NotificationRule
+		valid bool
+	}{
+		{
+			name:  "BalanceBelow",
+			rule:  NotificationRule{Type: NotificationRuleTypeBalanceBelow, Threshold: convert.ToPointer(10.0), URL: "https://example.com/webhook"},
+			valid: true,
+		},
+		{
+			name:  "BalanceBelowWithoutThreshold",
+			rule:  NotificationRule{Type: NotificationRuleTypeBalanceBelow, URL: "https://example.com/webhook"},
+			valid: false,
+		},
+		{
+			name:  "BalanceBelowPercentOver100",
+			rule:  NotificationRule{Type: NotificationRuleTypeBalanceBelowPercent, Threshold: convert.ToPointer(120.0), URL: "https://example.com/webhook"},
+			valid: false,
+		},
+		{
+			name:  "BalanceExhaustedWithThreshold",
+			rule:  NotificationRule{Type: NotificationRuleTypeBalanceExhausted, Threshold: convert.ToPointer(10.0), URL: "https://example.com/webhook"},
+			valid: false,
+		},
+		{
+			name:  "RelativeURL",
+			rule:  NotificationRule{Type: NotificationRuleTypeBalanceExhausted, URL: "/webhook"},
+			valid: false,
+		},
+		{
+			name:  "LoopbackURL",
+			rule:  NotificationRule{Type: NotificationRuleTypeBalanceExhausted, URL: "http://127.0.0.1:8080/webhook"},
+			valid: false,
+		},
+		{
+			name:  "LocalhostURL",
+			rule:  NotificationRule{Type: NotificationRuleTypeBalanceExhausted, URL: "http://localhost/webhook"},
+			valid: false,
+		},
+		{
+			name:  "PrivateURL",
+			rule:  NotificationRule{Type: NotificationRuleTypeBalanceExhausted, URL: "https://10.0.0.1/webhook"},
+			valid: false,
+		},
+		{
+			name:  "LinkLocalURL",
+			rule:  NotificationRule{Type: NotificationRuleTypeBalanceExhausted, URL: "http://169.254.169.254/latest/meta-data"},
+			valid: false,
+		},
+		{
+			name:  "MappedLoopbackURL",
+			rule:  NotificationRule{Type: NotificationRuleTypeBalanceExhausted, URL: "http://[::ffff:127.0.0.1]/webhook"},
+			valid: false,
+		},
+		{
+			name:  "InvalidType",
+			rule:  NotificationRule{Type: "BALANCE_ABOVE", URL: "https://example.com/webhook"},
+			valid: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rule.Validate()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationRuleEvaluate(t *testing.T) {
+	featureID1 := FeatureID("feature-1")
+	featureID2 := FeatureID("feature-2")
+	featureID3 := FeatureID("feature-3")
+	balance := Balance{
+		GrantBalances: []GrantBalance{
+			{Grant: Grant{FeatureID: &featureID1, Amount: 100}, Balance: 20},
+			{Grant: Grant{FeatureID: &featureID1, Amount: 100}, Balance: 5},
+			{Grant: Grant{FeatureID: &featureID2, Amount: 50}, Balance: 0},
+		},
+	}
+
+	tt := []struct {
+		name     string
+		rule     NotificationRule
+		watched  NotificationBalance
+		expected bool
+	}{
+		{
+			name:     "LedgerBalanceBelow",
+			rule:     NotificationRule{Type: NotificationRuleTypeBalanceBelow, Threshold: convert.ToPointer(30.0)},
+			watched:  NotificationBalance{Balance: 25, Granted: 250},
+			expected: true,
+		},
+		{
+			name:     "FeatureBalanceNotBelow",
+			rule:     NotificationRule{FeatureID: &featureID1, Type: NotificationRuleTypeBalanceBelow, Threshold: convert.ToPointer(25.0)},
+			watched:  NotificationBalance{Balance: 25, Granted: 200},
+			expected: false,
+		},
+		{
+			name:     "FeatureBalanceBelowPercent",
+			rule:     NotificationRule{FeatureID: &featureID1, Type: NotificationRuleTypeBalanceBelowPercent, Threshold: convert.ToPointer(20.0)},
+			watched:  NotificationBalance{Balance: 25, Granted: 200},
+			expected: true,
+		},
+		{
+			name:     "FeatureBalanceExhausted",
+			rule:     NotificationRule{FeatureID: &featureID2, Type: NotificationRuleTypeBalanceExhausted},
+			watched:  NotificationBalance{Balance: 0, Granted: 50},
+			expected: true,
+		},
+		{
+			name:     "LedgerBalanceNotExhausted",
+			rule:     NotificationRule{Type: NotificationRuleTypeBalanceExhausted},
+			watched:  NotificationBalance{Balance: 25, Granted: 250},
+			expected: false,
+		},
+		{
+			name:     "FeatureWithoutGrants",
+			rule:     NotificationRule{FeatureID: &featureID3, Type: NotificationRuleTypeBalanceExhausted},
+			watched:  NotificationBalance{},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			watched, met := tc.rule.Evaluate(balance)
+			assert.Equal(t, tc.watched, watched)
+			assert.Equal(t, tc.expected, met)
+		})
+	}
+}
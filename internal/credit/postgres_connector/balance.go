@@ -1,8 +1,10 @@
 package postgres_connector
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"maps"
 	"slices"
 	"sort"
 	"strings"
@@ -10,7 +12,10 @@ import (
 
 	"github.com/openmeterio/openmeter/internal/credit"
 	"github.com/openmeterio/openmeter/internal/streaming"
+	"github.com/openmeterio/openmeter/pkg/convert"
+	"github.com/openmeterio/openmeter/pkg/defaultx"
 	"github.com/openmeterio/openmeter/pkg/models"
+	"github.com/openmeterio/openmeter/pkg/slicesx"
 )
 
 type balanceQueryPeriod struct {
@@ -37,21 +42,61 @@ func (a *PostgresConnector) GetBalance(
 		}
 	}
 
-	balance, _, err := a.getBalance(ctx, ledgerID, hw.Time, cutline)
-	return balance, err
+	// Start from the last snapshot instead of recalculating the usage since the last reset
+	snapshot, err := a.getLatestBalanceSnapshot(ctx, ledgerID, hw.Time, cutline)
+	if err != nil {
+		return credit.Balance{}, err
+	}
+
+	balance, _, _, err := a.calculateBalance(ctx, ledgerID, hw.Time, cutline, balanceSnapshots{From: snapshot})
+	if err != nil {
+		return balance, err
+	}
+
+	// Freshness is informational, the balance is still calculated from the usage stored so far
+	freshness, err := a.streamingConnector.GetFreshness(ctx, ledgerID.Namespace)
+	if err != nil {
+		a.logger.Warn("failed to get usage data freshness", "namespace", ledgerID.Namespace, "error", err)
+	} else {
+		balance.CompleteUntil = freshness.CompleteUntil
+	}
+
+	return balance, nil
 }
 
+// getBalance calculates the balance and the ledger entries from the given time without snapshots.
 func (a *PostgresConnector) getBalance(
 	ctx context.Context,
 	ledgerID credit.NamespacedLedgerID,
 	from time.Time,
 	to time.Time,
 ) (credit.Balance, credit.LedgerEntryList, error) {
+	balance, ledgerEntries, _, err := a.calculateBalance(ctx, ledgerID, from, to, balanceSnapshots{})
+	return balance, ledgerEntries, err
+}
+
+// balanceSnapshots configures the snapshots of a balance calculation.
+type balanceSnapshots struct {
+	// From is the snapshot to start the calculation from if it is still valid.
+	// Ledger entries before the snapshot are not returned when the calculation starts from a snapshot.
+	From *credit.BalanceSnapshot
+
+	// At is the time to take a snapshot at, it must be the effective or expiration time of a grant.
+	At *time.Time
+}
+
+func (a *PostgresConnector) calculateBalance(
+	ctx context.Context,
+	ledgerID credit.NamespacedLedgerID,
+	from time.Time,
+	to time.Time,
+	snapshots balanceSnapshots,
+) (credit.Balance, credit.LedgerEntryList, *credit.BalanceSnapshot, error) {
 	ledgerEntries := credit.NewLedgerEntryList()
 
 	ledger, err := a.getLedger(ctx, ledgerID)
 	if err != nil {
-		return credit.Balance{}, ledgerEntries, err
+		return credit.Balance{}, ledgerEntries, nil, err
 	}
 
 	grants, err := a.ListGrants(ctx, credit.ListGrantsParams{
@@ -62,13 +107,13 @@ func (a *PostgresConnector) getBalance(
 		IncludeVoid: true,
 	})
 	if err != nil {
-		return credit.Balance{}, ledgerEntries, fmt.Errorf("list grants: %w", err)
+		return credit.Balance{}, ledgerEntries, nil, fmt.Errorf("list grants: %w", err)
 	}
 
 	for _, grant := range grants {
 		effectiveAtMinute := grant.EffectiveAt.Truncate(a.config.WindowSize)
 		if ok := grant.EffectiveAt.Equal(effectiveAtMinute); !ok {
-			return credit.Balance{}, ledgerEntries, fmt.Errorf("grant effectiveAt is not truncated")
+			return credit.Balance{}, ledgerEntries, nil, fmt.Errorf("grant effectiveAt is not truncated")
 		}
 	}
 
@@ -87,13 +132,83 @@ func (a *PostgresConnector) getBalance(
 			if _, ok := features[featureID]; !ok {
 				feature, err := a.GetFeature(ctx, credit.NewNamespacedFeatureID(ledgerID.Namespace, featureID))
 				if err != nil {
-					return credit.Balance{}, ledgerEntries, fmt.Errorf("get feature: %w", err)
+					return credit.Balance{}, ledgerEntries, nil, fmt.Errorf("get feature: %w", err)
 				}
 				features[featureID] = feature
 			}
 		}
 	}
 
+	overageLimits := mapOverageLimitsFromDB(ledger.OverageLimit, ledger.FeatureOverageLimits)
+	hasFeaturelessGrants := slices.ContainsFunc(grants, func(grant credit.Grant) bool { return !grant.Void && grant.FeatureID == nil })
+
+	// Grants without feature and the overage limit of the ledger apply to the usage of any feature
+	var namespaceFeatures []credit.Feature
+	if hasFeaturelessGrants || (overageLimits != nil && overageLimits.Limit != nil) {
+		list, err := a.ListFeatures(ctx, credit.ListFeaturesParams{
+			Namespace:       ledgerID.Namespace,
+			IncludeArchived: true,
+		})
+		if err != nil {
+			return credit.Balance{}, ledgerEntries, nil, fmt.Errorf("list features: %w", err)
+		}
+
+		for _, feature := range list {
+			if feature.ID == nil {
+				continue
+			}
+
+			namespaceFeatures = append(namespaceFeatures, feature)
+
+			if _, ok := features[*feature.ID]; !ok {
+				features[*feature.ID] = feature
+			}
+		}
+
+		// Features are burned down in the order they were created (ULIDs are sortable)
+		sort.Slice(namespaceFeatures, func(i, j int) bool {
+			return *namespaceFeatures[i].ID < *namespaceFeatures[j].ID
+		})
+	}
+
+	// Grants without feature are burned down by the usage of any feature
+	var featurelessFeatures []credit.Feature
+	if hasFeaturelessGrants {
+		featurelessFeatures = namespaceFeatures
+	}
+
+	// Usage left after burning down grants is overage for features with an overage limit
+	var overageFeatures []credit.Feature
+	if overageLimits != nil {
+		if overageLimits.Limit != nil {
+			overageFeatures = namespaceFeatures
+		} else {
+			for featureID := range overageLimits.Features {
+				feature, ok := features[featureID]
+				if !ok {
+					feature, err = a.GetFeature(ctx, credit.NewNamespacedFeatureID(ledgerID.Namespace, featureID))
+					// Limits of features that do not exist (anymore) have no usage
+					if _, ok := err.(*credit.FeatureNotFoundError); ok {
+						a.logger.Warn("feature of overage limit not found", "namespace", ledgerID.Namespace, "ledger", ledgerID.ID, "feature", featureID)
+
+						continue
+					}
+					if err != nil {
+						return credit.Balance{}, ledgerEntries, nil, fmt.Errorf("get feature: %w", err)
+					}
+
+					features[featureID] = feature
+				}
+
+				overageFeatures = append(overageFeatures, feature)
+			}
+
+			sort.Slice(overageFeatures, func(i, j int) bool {
+				return *overageFeatures[i].ID < *overageFeatures[j].ID
+			})
+		}
+	}
+
 	// Get meters for features
 	// TODO: after we use Ent we can fetch the two together
 	meters := map[string]models.Meter{}
@@ -102,7 +217,7 @@ func (a *PostgresConnector) getBalance(
 		if _, ok := meters[meterSlug]; !ok {
 			meter, err := a.meterRepository.GetMeterByIDOrSlug(ctx, ledgerID.Namespace, meterSlug)
 			if err != nil {
-				return credit.Balance{}, ledgerEntries, fmt.Errorf("get meter: %w", err)
+				return credit.Balance{}, ledgerEntries, nil, fmt.Errorf("get meter: %w", err)
 			}
 			meters[meterSlug] = meter
 		}
@@ -112,8 +227,6 @@ func (a *PostgresConnector) getBalance(
 	// We break down the time range into periods where grants are effective or expire.
 	// We need to do this to burn down grants in the correct order.
 
-	// Find pivot dates first (effective and expiration dates in range)
-	dates := []time.Time{from}
 	grantBalances := []credit.GrantBalance{}
 	for _, grant := range grants {
 		if grant.Void {
@@ -124,15 +237,71 @@ func (a *PostgresConnector) getBalance(
 			Grant:   grant,
 			Balance: grant.Amount,
 		})
+	}
 
-		expiresAt := grant.ExpiresAt
+	// Amendments change the terms of grants from their effective time
+	amendments, err := a.listGrantAmendments(ctx, ledgerID.Namespace, slicesx.Map(grantBalances, func(grantBalance credit.GrantBalance) credit.GrantID {
+		return *grantBalance.ID
+	}), &to)
+	if err != nil {
+		return credit.Balance{}, ledgerEntries, nil, err
+	}
+
+	// Continue from the snapshot if it was taken on the same grants and features,
+	// the periods before the snapshot are not recalculated
+	start := from
+	var startSnapshot *credit.BalanceSnapshot
+	if snapshots.From != nil && isValidBalanceSnapshot(*snapshots.From, from, to, grantBalances, namespaceFeatures, overageFeatures) {
+		startSnapshot = snapshots.From
+		start = startSnapshot.At
+
+		for i := range grantBalances {
+			if balance, ok := startSnapshot.GrantBalances[*grantBalances[i].ID]; ok {
+				grantBalances[i].Balance = balance
+			}
 
-		if (grant.EffectiveAt.After(from) || grant.EffectiveAt.Equal(from)) && (grant.EffectiveAt.Before(to)) {
-			dates = append(dates, grant.EffectiveAt)
+			// The snapshotted balance includes the amendments before the snapshot, only their terms are applied
+			for _, amendment := range amendments[*grantBalances[i].ID] {
+				if amendment.EffectiveAt.Before(start) {
+					grantBalances[i].Grant = amendment.Apply(grantBalances[i].Grant)
+				}
+			}
 		}
-		if (expiresAt.After(from) || expiresAt.Equal(from)) && (expiresAt.Before(to)) {
+	}
+
+	// Find pivot dates first (effective and expiration dates in range)
+	dates := []time.Time{start}
+	for _, grantBalance := range grantBalances {
+		effectiveAt := grantBalance.EffectiveAt
+		expiresAt := grantBalance.ExpiresAt
+
+		if (effectiveAt.After(start) || effectiveAt.Equal(start)) && (effectiveAt.Before(to)) {
+			dates = append(dates, effectiveAt)
+		}
+		if (expiresAt.After(start) || expiresAt.Equal(start)) && (expiresAt.Before(to)) {
 			dates = append(dates, expiresAt)
 		}
+
+		// Amendments apply from the start of a period, the amended expiration is a pivot too
+		for _, amendment := range amendments[*grantBalance.ID] {
+			if amendment.EffectiveAt.Before(start) {
+				continue
+			}
+
+			dates = append(dates, amendment.EffectiveAt)
+			if amendment.ExpiresAt != nil && !amendment.ExpiresAt.Before(start) && amendment.ExpiresAt.Before(to) {
+				dates = append(dates, *amendment.ExpiresAt)
+			}
+		}
+	}
+
+	// Usage is queried with the filters in effect, filter changes split the periods too
+	for _, feature := range features {
+		for _, version := range feature.MeterGroupByFilterVersions {
+			if version.EffectiveAt.After(start) && version.EffectiveAt.Before(to) {
+				dates = append(dates, version.EffectiveAt)
+			}
+		}
 	}
 	sort.Slice(dates, func(i, j int) bool {
 		return dates[i].Before(dates[j])
@@ -154,33 +323,12 @@ func (a *PostgresConnector) getBalance(
 	}
 
 	// The correct order to burn down grants is:
-	// 1. Grants with higher priority are burned down first
-	// 2. Grants with feature has higher priority
-	// 3. Grants with earlier expiration date are burned down first
-
+	// 1. Grants with feature are burned down first, grants without feature burn down the usage left
+	// 2. Grants with higher priority are burned down first
 	// 3. Grants with earlier expiration date are burned down first
-	sort.Slice(grantBalances, func(i, j int) bool {
-		return grantBalances[i].ExpirationDate().Unix() < grantBalances[j].ExpirationDate().Unix()
-	})
-
-	// 2. Order grant balances by feature
-	// grants with feature are applied first
-	sort.Slice(grantBalances, func(i, j int) bool {
-		a := 1
-		b := 1
-		if grantBalances[i].FeatureID != nil {
-			a = 0
-		}
-		if grantBalances[j].FeatureID != nil {
-			b = 0
-		}
-
-		return a < b
-	})
-
-	// 1. Order grant balances by priority
-	sort.Slice(grantBalances, func(i, j int) bool {
-		return grantBalances[i].Priority < grantBalances[j].Priority
+	// 4. Grants effective earlier are burned down first
+	sort.SliceStable(grantBalances, func(i, j int) bool {
+		return compareGrantBalances(grantBalances[i], grantBalances[j]) < 0
 	})
 
 	firstGrantsOfFeature := map[credit.FeatureID]credit.GrantBalance{}
@@ -205,58 +353,60 @@ func (a *PostgresConnector) getBalance(
 		}
 	}
 
+	// Usage of features not burned down by grants
+	uncoveredUsage := map[credit.FeatureID]float64{}
+	// Usage of features paid with grants in a currency
+	monetaryUsage := map[credit.FeatureID]credit.MonetaryUsage{}
+	if startSnapshot != nil {
+		maps.Copy(uncoveredUsage, startSnapshot.UncoveredUsage)
+		maps.Copy(monetaryUsage, startSnapshot.MonetaryUsage)
+	}
+
+	var snapshot *credit.BalanceSnapshot
+
 	// Query usage for each period
 	for periodIndex, period := range periods {
-		queryCache := map[string]float64{}
-		carryOverAmount := map[string]float64{}
-
+		// Apply the amendments effective at the start of the period
+		amended := false
 		for i := range grantBalances {
-			var feature *credit.Feature
 			grantBalance := &grantBalances[i]
 
-			// Skip grants that does not apply to this period
-			expiresAt := grantBalance.ExpirationDate()
-			if expiresAt.Before(period.From) {
-				continue
-			}
-			if grantBalance.EffectiveAt.After(period.To) {
-				continue
-			}
-
-			// We want to attribute already present usage on the ledger to the first grant of a feature
+			for _, amendment := range amendments[*grantBalance.ID] {
+				if !amendment.EffectiveAt.Equal(period.From) {
+					continue
+				}
 
-			isFirstPeriod := periodIndex == 0
-			isFirstGrantOfFeature := false
-			if grantBalance.FeatureID != nil {
-				featureId := *grantBalance.FeatureID
-				if _, ok := firstGrantsOfFeature[featureId]; ok {
-					if firstGrantsOfFeature[featureId].Grant.ID == grantBalance.Grant.ID {
-						isFirstGrantOfFeature = true
-					}
+				// The remaining balance changes by the change of the granted amount
+				amount := 0.0
+				if amendment.Amount != nil {
+					amount = *amendment.Amount - grantBalance.Amount
+					grantBalance.Balance = max(grantBalance.Balance+amount, 0)
 				}
-			}
 
-			if !isFirstGrantOfFeature && !isFirstPeriod && grantBalance.EffectiveAt.Equal(period.To) {
-				continue
+				grantBalance.Grant = amendment.Apply(grantBalance.Grant)
+				ledgerEntries.AddGrantAmendment(grantBalance.Grant, amendment, amount)
+				amended = true
 			}
+		}
 
-			// Grants without feature are not implemented yet
-			if grantBalance.FeatureID == nil {
-				return credit.Balance{}, ledgerEntries, fmt.Errorf("not implemented: grants without feature")
-			}
+		// Amended expirations change the order grants are burned down in
+		if amended {
+			sort.SliceStable(grantBalances, func(i, j int) bool {
+				return compareGrantBalances(grantBalances[i], grantBalances[j]) < 0
+			})
+		}
 
-			// Get feature
-			if _, ok := features[*grantBalance.FeatureID]; !ok {
-				return credit.Balance{}, ledgerEntries, fmt.Errorf("feature not found: %s", *grantBalance.FeatureID)
-			}
-			p := features[*grantBalance.FeatureID]
-			feature = &p
+		queryCache := map[string]float64{}
+		// Usage of a feature in the period not burned down by grants yet
+		carryOverAmount := map[string]float64{}
 
+		// usageKey queries the usage of the feature in the period and returns the key of its carry over and of its usage query
+		usageKey := func(feature credit.Feature) (string, string, error) {
 			// Get meter
-			if _, ok := meters[feature.MeterSlug]; !ok {
-				return credit.Balance{}, ledgerEntries, fmt.Errorf("meter not found: %s", feature.MeterSlug)
+			meter, ok := meters[feature.MeterSlug]
+			if !ok {
+				return "", "", fmt.Errorf("meter not found: %s", feature.MeterSlug)
 			}
-			meter := meters[feature.MeterSlug]
 
 			// Usage query params for the meter
 			queryParams := &streaming.QueryParams{
@@ -267,25 +417,23 @@ func (a *PostgresConnector) getBalance(
 			queryParams.To = &period.To
 			queryParams.Aggregation = meter.Aggregation
 
-			if feature.MeterGroupByFilters != nil {
+			if filters := feature.FiltersAt(period.From); filters != nil {
 				queryParams.FilterGroupBy = map[string][]string{}
-				for k, v := range *feature.MeterGroupByFilters {
+				for k, v := range *filters {
 					queryParams.FilterGroupBy[k] = []string{v}
 				}
 			}
 
-			var amount float64 = 0
-
 			// Query cache helps to minimize the number of usage queries between different features with the same meter and group by filters
 			queryCacheKey := queryKeyByParams(meter.Slug, queryParams.FilterGroupBy)
 			if _, ok := queryCache[queryCacheKey]; !ok {
 				// Query usage
 				rows, err := a.streamingConnector.QueryMeter(ctx, ledgerID.Namespace, meter.Slug, queryParams)
 				if err != nil {
-					return credit.Balance{}, ledgerEntries, fmt.Errorf("query meter: %w", err)
+					return "", "", fmt.Errorf("query meter: %w", err)
 				}
 				if len(rows) > 1 {
-					return credit.Balance{}, ledgerEntries, fmt.Errorf("unexpected number of usage rows")
+					return "", "", fmt.Errorf("unexpected number of usage rows")
 				}
 
 				// Get usage amount
@@ -296,46 +444,190 @@ func (a *PostgresConnector) getBalance(
 				}
 			}
 
-			// Add carry over from previous grant if any, otherwise use the full amount from the query
+			// Carry over from previous grants if any, otherwise the full amount from the query
 			carryOverKey := fmt.Sprintf("%s-%s", queryCacheKey, *feature.ID)
-			if val, ok := carryOverAmount[carryOverKey]; ok {
-				amount += val
-			} else {
-				carryOverAmount[carryOverKey] = 0
-				amount += queryCache[queryCacheKey]
+			if _, ok := carryOverAmount[carryOverKey]; !ok {
+				carryOverAmount[carryOverKey] = queryCache[queryCacheKey]
 			}
 
-			// Nothing to do if amount is 0
-			if amount == 0 {
+			return carryOverKey, queryCacheKey, nil
+		}
+
+		for i := range grantBalances {
+			grantBalance := &grantBalances[i]
+
+			// Skip grants that does not apply to this period
+			expiresAt := grantBalance.ExpirationDate()
+			if expiresAt.Before(period.From) {
 				continue
 			}
+			if grantBalance.EffectiveAt.After(period.To) {
+				continue
+			}
+
+			// We want to attribute already present usage on the ledger to the first grant of a feature
+
+			// The periods continued from a snapshot are not the first
+			isFirstPeriod := periodIndex == 0 && startSnapshot == nil
+			isFirstGrantOfFeature := false
+			if grantBalance.FeatureID != nil {
+				featureId := *grantBalance.FeatureID
+				if _, ok := firstGrantsOfFeature[featureId]; ok {
+					if firstGrantsOfFeature[featureId].Grant.ID == grantBalance.Grant.ID {
+						isFirstGrantOfFeature = true
+					}
+				}
+			}
+
+			if !isFirstGrantOfFeature && !isFirstPeriod && grantBalance.EffectiveAt.Equal(period.To) {
+				continue
+			}
+
+			// Grants with feature burn down the usage of the feature,
+			// grants without feature burn down the usage left by grants with feature of any feature
+			grantFeatures := featurelessFeatures
+			if grantBalance.FeatureID != nil {
+				feature, ok := features[*grantBalance.FeatureID]
+				if !ok {
+					return credit.Balance{}, ledgerEntries, nil, fmt.Errorf("feature not found: %s", *grantBalance.FeatureID)
+				}
+
+				grantFeatures = []credit.Feature{feature}
+			}
+
+			// Features with the same usage query share their usage: grants without feature burn it down once
+			type usageGroup struct {
+				feature credit.Feature
+				amount  float64
+				keys    []string
+			}
+
+			var usageGroups []*usageGroup
+			usageGroupsByQuery := map[string]*usageGroup{}
+
+			for _, feature := range grantFeatures {
+				// Grants in a currency only pay for the usage of features priced in the currency
+				if grantBalance.Currency != nil && !isPricedIn(feature, *grantBalance.Currency) {
+					continue
+				}
+
+				carryOverKey, queryKey, err := usageKey(feature)
+				if err != nil {
+					return credit.Balance{}, ledgerEntries, nil, err
+				}
 
-			ledgerTime := period.To
-			if ledgerTime.After(time.Now()) {
-				ledgerTime = time.Now()
+				group, ok := usageGroupsByQuery[queryKey]
+				if !ok {
+					group = &usageGroup{feature: feature, amount: carryOverAmount[carryOverKey]}
+					usageGroupsByQuery[queryKey] = group
+					usageGroups = append(usageGroups, group)
+				}
+
+				// Only the usage left by the grants of every feature is burned down,
+				// usage covered by the grant of a feature is not paid again
+				if amount := carryOverAmount[carryOverKey]; amount < group.amount {
+					group.feature = feature
+					group.amount = amount
+				}
+
+				group.keys = append(group.keys, carryOverKey)
 			}
-			ledgerAmount := -amount
 
-			// Burn down the grant and apply to the balance
-			if amount > grantBalance.Balance {
-				amount -= grantBalance.Balance
-				ledgerAmount = amount * -1
-				grantBalance.Balance = 0
-			} else {
-				grantBalance.Balance -= amount
-				amount = 0
+			for _, group := range usageGroups {
+				feature := group.feature
+				amount := group.amount
+
+				// Nothing to do if amount is 0
+				if amount == 0 {
+					continue
+				}
+
+				ledgerTime := period.To
+				if ledgerTime.After(time.Now()) {
+					ledgerTime = time.Now()
+				}
+
+				var burned float64
+
+				// Grants in a currency are burned down by the cost of the usage,
+				// the tiers of the price graduate on the usage paid so far
+				if grantBalance.Currency != nil {
+					// Grants without balance left (or with a negative amount) pay for nothing
+					if grantBalance.Balance <= 0 {
+						continue
+					}
+
+					paid := monetaryUsage[*feature.ID]
+
+					units := amount
+					cost := feature.UnitPrice.Cost(paid.Units, units)
+					if cost > grantBalance.Balance {
+						units = min(feature.UnitPrice.Units(paid.Units, grantBalance.Balance), amount)
+						cost = grantBalance.Balance
+					}
+
+					if units == 0 {
+						continue
+					}
+
+					grantBalance.Balance -= cost
+					paid.Units += units
+					paid.Amount += cost
+					monetaryUsage[*feature.ID] = paid
+
+					ledgerEntries.AddMonetaryGrantUsage(*grantBalance, period.From, ledgerTime, -cost, -units)
+
+					burned = units
+				} else {
+					ledgerAmount := -amount
+					left := 0.0
+
+					// Burn down the grant and apply to the balance
+					if amount > grantBalance.Balance {
+						left = amount - grantBalance.Balance
+						ledgerAmount = left * -1
+						grantBalance.Balance = 0
+					} else {
+						grantBalance.Balance -= amount
+					}
+
+					ledgerEntries.AddGrantUsage(*grantBalance, period.From, ledgerTime, ledgerAmount)
+
+					burned = amount - left
+				}
+
+				// No feature of the group has more usage left than the group
+				for _, key := range group.keys {
+					carryOverAmount[key] = min(carryOverAmount[key], amount-burned)
+				}
 			}
+		}
 
-			ledgerEntries.AddGrantUsage(*grantBalance, period.From, ledgerTime, ledgerAmount)
+		// Usage not burned down by any grant, usage shared by features with the same meter and filters is counted once
+		countedUsage := map[string]float64{}
+		for _, feature := range overageFeatures {
+			carryOverKey, queryKey, err := usageKey(feature)
+			if err != nil {
+				return credit.Balance{}, ledgerEntries, nil, err
+			}
+
+			amount := carryOverAmount[carryOverKey]
+			uncoveredUsage[*feature.ID] += max(amount-countedUsage[queryKey], 0)
+			countedUsage[queryKey] = max(countedUsage[queryKey], amount)
+		}
 
-			carryOverAmount[carryOverKey] += amount
+		if snapshots.At != nil && period.To.Equal(*snapshots.At) {
+			snapshot = newBalanceSnapshot(ledgerID, *snapshots.At, grantBalances, uncoveredUsage, monetaryUsage, namespaceFeatures, overageFeatures)
 		}
 	}
 
+	overage := calculateOverage(overageLimits, overageFeatures, uncoveredUsage)
+
 	// Aggregate grant balances by feature
 	featureBalancesMap := map[credit.FeatureID]credit.FeatureBalance{}
 	for _, grantBalance := range grantBalances {
-		if grantBalance.FeatureID == nil {
+		// The balance of grants in a currency is not in units of the feature
+		if grantBalance.FeatureID == nil || grantBalance.Currency != nil {
 			continue
 		}
 		featureId := *grantBalance.FeatureID
@@ -354,19 +646,146 @@ func (a *PostgresConnector) getBalance(
 		}
 	}
 
+	// Report the usage paid with grants in a currency, features are reported even without usage to price their balance
+	for _, grantBalance := range grantBalances {
+		if grantBalance.Currency == nil {
+			continue
+		}
+
+		payableFeatures := namespaceFeatures
+		if grantBalance.FeatureID != nil {
+			payableFeatures = []credit.Feature{features[*grantBalance.FeatureID]}
+		}
+
+		for _, feature := range payableFeatures {
+			if !isPricedIn(feature, *grantBalance.Currency) {
+				continue
+			}
+
+			featureId := *feature.ID
+			featureBalance, ok := featureBalancesMap[featureId]
+			if !ok {
+				featureBalance = credit.FeatureBalance{
+					Feature: feature,
+				}
+			}
+
+			// Reported once per feature
+			if featureBalance.MonetaryUsage == nil {
+				featureBalance.Usage += monetaryUsage[featureId].Units
+				featureBalance.MonetaryUsage = convert.ToPointer(monetaryUsage[featureId])
+			}
+
+			featureBalancesMap[featureId] = featureBalance
+		}
+	}
+
+	// Report the overage of features, features with an overage limit are reported even without grants
+	var totalOverage float64
+	for _, feature := range overageFeatures {
+		featureId := *feature.ID
+		totalOverage += overage[featureId]
+
+		featureBalance, ok := featureBalancesMap[featureId]
+		if !ok {
+			if _, hasLimit := overageLimits.Features[featureId]; !hasLimit && overage[featureId] == 0 {
+				continue
+			}
+
+			featureBalance = credit.FeatureBalance{
+				Feature: feature,
+			}
+		}
+
+		featureBalance.Overage = overage[featureId]
+		featureBalancesMap[featureId] = featureBalance
+	}
+
 	// Convert map to slice
 	featureBalances := []credit.FeatureBalance{}
 	for _, featureBalance := range featureBalancesMap {
 		featureBalances = append(featureBalances, featureBalance)
 	}
 
-	return credit.Balance{
+	balance := credit.Balance{
 		LedgerID:        ledgerID.ID,
 		Subject:         ledger.Subject,
 		Metadata:        ledger.Metadata,
 		FeatureBalances: featureBalances,
 		GrantBalances:   grantBalances,
-	}, ledgerEntries, nil
+		Overage:         totalOverage,
+	}
+
+	if usagePeriod := mapUsagePeriodFromDB(ledger); usagePeriod != nil {
+		if period, ok := usagePeriod.PeriodAt(to); ok {
+			balance.CurrentPeriod = &period
+		}
+	}
+
+	return balance, ledgerEntries, snapshot, nil
+}
+
+// isPricedIn returns whether the feature has a unit price in the currency.
+func isPricedIn(feature credit.Feature, currency string) bool {
+	return feature.UnitPrice != nil && feature.UnitPrice.Currency == currency
+}
+
+// calculateOverage limits the usage not burned down by grants to the overage limits.
+// The limit of the ledger is used up by the features in the given order.
+func calculateOverage(limits *credit.OverageLimits, features []credit.Feature, uncoveredUsage map[credit.FeatureID]float64) map[credit.FeatureID]float64 {
+	overage := map[credit.FeatureID]float64{}
+	if limits == nil {
+		return overage
+	}
+
+	var ledgerLimitLeft *float64
+	if limits.Limit != nil {
+		ledgerLimitLeft = convert.ToPointer(*limits.Limit)
+	}
+
+	for _, feature := range features {
+		featureId := *feature.ID
+		amount := uncoveredUsage[featureId]
+
+		if featureLimit, ok := limits.Features[featureId]; ok {
+			amount = min(amount, featureLimit)
+		}
+
+		if ledgerLimitLeft != nil {
+			amount = min(amount, *ledgerLimitLeft)
+			*ledgerLimitLeft -= amount
+		}
+
+		overage[featureId] = amount
+	}
+
+	return overage
+}
+
+// compareGrantBalances compares grant balances by the order they are burned down.
+func compareGrantBalances(a, b credit.GrantBalance) int {
+	// Grants without feature are burned down last
+	if (a.FeatureID == nil) != (b.FeatureID == nil) {
+		if a.FeatureID == nil {
+			return 1
+		}
+
+		return -1
+	}
+
+	if a.Priority != b.Priority {
+		return cmp.Compare(a.Priority, b.Priority)
+	}
+
+	if c := a.ExpirationDate().Compare(b.ExpirationDate()); c != 0 {
+		return c
+	}
+
+	if c := a.EffectiveAt.Compare(b.EffectiveAt); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(defaultx.WithDefault(a.ID, ""), defaultx.WithDefault(b.ID, ""))
 }
 
 // removeDuplicateTimes removes duplicate dates from the slice
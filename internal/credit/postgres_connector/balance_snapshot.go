@@ -0,0 +1,284 @@
+package postgres_connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	db_balancesnapshot "github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/balancesnapshot"
+	db_credit "github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/creditentry"
+	"github.com/openmeterio/openmeter/pkg/convert"
+	"github.com/openmeterio/openmeter/pkg/slicesx"
+)
+
+// SnapshotBalances takes a snapshot of the balance of every ledger at the last effective or expiration time of its grants
+// before the given time, unless the ledger already has a snapshot at that time or changes while the balance is calculated.
+// Snapshots are only taken up to the time the usage data of the namespace is complete until, as usage arriving late
+// would not be included in the snapshot.
+func (c *PostgresConnector) SnapshotBalances(ctx context.Context, until time.Time) ([]credit.BalanceSnapshot, error) {
+	ledgerEntities, err := c.db.Ledger.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ledgers: %w", err)
+	}
+
+	snapshots := []credit.BalanceSnapshot{}
+	namespaceUntil := map[string]time.Time{}
+	var errs []error
+
+	for _, ledgerEntity := range ledgerEntities {
+		ledgerUntil, ok := namespaceUntil[ledgerEntity.Namespace]
+		if !ok {
+			ledgerUntil, err = c.completeUntil(ctx, ledgerEntity.Namespace, until)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			namespaceUntil[ledgerEntity.Namespace] = ledgerUntil
+		}
+
+		snapshot, err := c.snapshotBalance(ctx, ledgerEntity, ledgerUntil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to snapshot balance of ledger %s: %w", ledgerEntity.ID, err))
+			continue
+		}
+
+		if snapshot != nil {
+			snapshots = append(snapshots, *snapshot)
+		}
+	}
+
+	return snapshots, errors.Join(errs...)
+}
+
+// snapshotBalance takes a snapshot of the balance of the ledger at the last pivot of the balance calculation before the given time.
+// The snapshot is taken at a pivot so continuing from it splits the usage into the same periods as calculating from the last reset.
+func (c *PostgresConnector) snapshotBalance(ctx context.Context, ledgerEntity *db.Ledger, until time.Time) (*credit.BalanceSnapshot, error) {
+	ledgerID := credit.NewNamespacedLedgerID(ledgerEntity.Namespace, credit.LedgerID(ledgerEntity.ID))
+	from := ledgerEntity.Highwatermark
+
+	if !until.After(from) {
+		return nil, nil
+	}
+
+	// Mutations of the ledger add entries, the snapshot is only saved if no entry is added while it is calculated
+	entries, err := countLedgerEntries(ctx, c.db.CreditEntry, ledgerID)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := c.ListGrants(ctx, credit.ListGrantsParams{
+		Namespace: ledgerID.Namespace,
+		LedgerIDs: []credit.LedgerID{ledgerID.ID},
+		From:      &from,
+		To:        &until,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grants: %w", err)
+	}
+
+	// Grants effective at the end of the calculation are not listed, so the pivot must be before it
+	var at time.Time
+	for _, grant := range grants {
+		for _, pivot := range []time.Time{grant.EffectiveAt, grant.ExpiresAt} {
+			if pivot.After(from) && pivot.Before(until) && pivot.After(at) {
+				at = pivot
+			}
+		}
+	}
+
+	if at.IsZero() {
+		return nil, nil
+	}
+
+	latest, err := c.getLatestBalanceSnapshot(ctx, ledgerID, from, until)
+	if err != nil {
+		return nil, err
+	}
+
+	if latest != nil && !latest.At.Before(at) {
+		return nil, nil
+	}
+
+	_, _, snapshot, err := c.calculateBalance(ctx, ledgerID, from, until, balanceSnapshots{From: latest, At: &at})
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot == nil {
+		return nil, fmt.Errorf("no period of the balance calculation ends at %s", at)
+	}
+
+	// The balance is calculated without the ledger lock, the ledger is locked to save the snapshot only if it is still valid
+	return mutationTransaction(ctx, c, ledgerID, func(tx *db.Tx, ledgerEntity *db.Ledger) (*credit.BalanceSnapshot, error) {
+		currentEntries, err := countLedgerEntries(ctx, tx.CreditEntry, ledgerID)
+		if err != nil {
+			return nil, err
+		}
+
+		// The ledger changed since the calculation, the next run takes the snapshot
+		if currentEntries != entries || !ledgerEntity.Highwatermark.Equal(from) {
+			return nil, nil
+		}
+
+		entity, err := tx.BalanceSnapshot.Create().
+			SetNamespace(snapshot.Namespace).
+			SetLedgerID(string(snapshot.LedgerID)).
+			SetAt(snapshot.At).
+			SetGrantBalances(snapshot.GrantBalances).
+			SetUncoveredUsage(snapshot.UncoveredUsage).
+			SetMonetaryUsage(snapshot.MonetaryUsage).
+			SetFeatures(snapshot.Features).
+			SetOverageFeatures(snapshot.OverageFeatures).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create balance snapshot: %w", err)
+		}
+
+		// Snapshots before the last reset are never used again
+		_, err = tx.BalanceSnapshot.Delete().
+			Where(db_balancesnapshot.Namespace(ledgerID.Namespace)).
+			Where(db_balancesnapshot.LedgerID(string(ledgerID.ID))).
+			Where(db_balancesnapshot.AtLTE(from)).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete balance snapshots before the last reset: %w", err)
+		}
+
+		return convert.ToPointer(mapBalanceSnapshotEntity(entity)), nil
+	})
+}
+
+// countLedgerEntries returns the number of grants, voids, amendments and resets of the ledger.
+func countLedgerEntries(ctx context.Context, client *db.CreditEntryClient, ledgerID credit.NamespacedLedgerID) (int, error) {
+	count, err := client.Query().
+		Where(db_credit.Namespace(ledgerID.Namespace)).
+		Where(db_credit.LedgerID(string(ledgerID.ID))).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count ledger entries: %w", err)
+	}
+
+	return count, nil
+}
+
+// getLatestBalanceSnapshot returns the last snapshot of the ledger between the given times, nil if there is none.
+func (c *PostgresConnector) getLatestBalanceSnapshot(ctx context.Context, ledgerID credit.NamespacedLedgerID, from time.Time, to time.Time) (*credit.BalanceSnapshot, error) {
+	entity, err := c.db.BalanceSnapshot.Query().
+		Where(db_balancesnapshot.Namespace(ledgerID.Namespace)).
+		Where(db_balancesnapshot.LedgerID(string(ledgerID.ID))).
+		Where(db_balancesnapshot.AtGT(from)).
+		Where(db_balancesnapshot.AtLT(to)).
+		Order(db_balancesnapshot.ByAt(sql.OrderDesc())).
+		First(ctx)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get balance snapshot: %w", err)
+	}
+
+	return convert.ToPointer(mapBalanceSnapshotEntity(entity)), nil
+}
+
+// invalidateBalanceSnapshots deletes the snapshots of a ledger changed by a mutation effective at the given time.
+func invalidateBalanceSnapshots(ctx context.Context, tx *db.Tx, ledgerID credit.NamespacedLedgerID, effectiveAt time.Time) error {
+	_, err := tx.BalanceSnapshot.Delete().
+		Where(db_balancesnapshot.Namespace(ledgerID.Namespace)).
+		Where(db_balancesnapshot.LedgerID(string(ledgerID.ID))).
+		Where(db_balancesnapshot.AtGTE(effectiveAt)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to invalidate balance snapshots: %w", err)
+	}
+
+	return nil
+}
+
+// isValidBalanceSnapshot tells whether a balance calculation can continue from the snapshot.
+// The snapshot must be taken on the grants and features the calculation would use up to the time of the snapshot,
+// this holds even if invalidating the snapshot was missed, eg. for grants created in the past or features created since.
+func isValidBalanceSnapshot(snapshot credit.BalanceSnapshot, from time.Time, to time.Time, grantBalances []credit.GrantBalance, namespaceFeatures []credit.Feature, overageFeatures []credit.Feature) bool {
+	if !snapshot.At.After(from) || !snapshot.At.Before(to) {
+		return false
+	}
+
+	grants := 0
+	hasFeaturelessGrants := false
+	for _, grantBalance := range grantBalances {
+		if grantBalance.EffectiveAt.After(snapshot.At) {
+			continue
+		}
+
+		if _, ok := snapshot.GrantBalances[*grantBalance.ID]; !ok {
+			return false
+		}
+
+		grants++
+		hasFeaturelessGrants = hasFeaturelessGrants || grantBalance.FeatureID == nil
+	}
+
+	if grants != len(snapshot.GrantBalances) {
+		return false
+	}
+
+	if !slices.Equal(featureIDs(overageFeatures), snapshot.OverageFeatures) {
+		return false
+	}
+
+	// Grants without feature burn down the usage of every feature of the namespace
+	if hasFeaturelessGrants && !slices.Equal(featureIDs(namespaceFeatures), snapshot.Features) {
+		return false
+	}
+
+	return true
+}
+
+func newBalanceSnapshot(ledgerID credit.NamespacedLedgerID, at time.Time, grantBalances []credit.GrantBalance, uncoveredUsage map[credit.FeatureID]float64, monetaryUsage map[credit.FeatureID]credit.MonetaryUsage, namespaceFeatures []credit.Feature, overageFeatures []credit.Feature) *credit.BalanceSnapshot {
+	snapshot := &credit.BalanceSnapshot{
+		Namespace:       ledgerID.Namespace,
+		LedgerID:        ledgerID.ID,
+		At:              at,
+		GrantBalances:   map[credit.GrantID]float64{},
+		UncoveredUsage:  maps.Clone(uncoveredUsage),
+		MonetaryUsage:   maps.Clone(monetaryUsage),
+		Features:        featureIDs(namespaceFeatures),
+		OverageFeatures: featureIDs(overageFeatures),
+	}
+
+	for _, grantBalance := range grantBalances {
+		if !grantBalance.EffectiveAt.After(at) {
+			snapshot.GrantBalances[*grantBalance.ID] = grantBalance.Balance
+		}
+	}
+
+	return snapshot
+}
+
+func featureIDs(features []credit.Feature) []credit.FeatureID {
+	return slicesx.Map(features, func(feature credit.Feature) credit.FeatureID {
+		return *feature.ID
+	})
+}
+
+func mapBalanceSnapshotEntity(entity *db.BalanceSnapshot) credit.BalanceSnapshot {
+	return credit.BalanceSnapshot{
+		Namespace:       entity.Namespace,
+		LedgerID:        credit.LedgerID(entity.LedgerID),
+		At:              entity.At.In(time.UTC),
+		GrantBalances:   entity.GrantBalances,
+		UncoveredUsage:  entity.UncoveredUsage,
+		Features:        entity.Features,
+		OverageFeatures: entity.OverageFeatures,
+		MonetaryUsage:   entity.MonetaryUsage,
+		CreatedAt:       convert.ToPointer(entity.CreatedAt.In(time.UTC)),
+	}
+}
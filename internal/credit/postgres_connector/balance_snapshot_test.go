@@ -0,0 +1,232 @@
+package postgres_connector
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/testutils"
+	meter_model "github.com/openmeterio/openmeter/internal/meter"
+	"github.com/openmeterio/openmeter/internal/streaming"
+	om_testutils "github.com/openmeterio/openmeter/internal/testutils"
+	"github.com/openmeterio/openmeter/pkg/convert"
+	"github.com/openmeterio/openmeter/pkg/models"
+)
+
+func TestPostgresConnectorBalanceSnapshots(t *testing.T) {
+	windowSize := time.Minute
+	namespace := "default"
+	meter := models.Meter{
+		Namespace:   namespace,
+		ID:          "meter-1",
+		Slug:        "meter-1",
+		Aggregation: models.MeterAggregationSum,
+	}
+	meterRepository := meter_model.NewInMemoryRepository([]models.Meter{meter})
+	featureIn := credit.Feature{
+		Namespace: namespace,
+		MeterSlug: meter.Slug,
+		Name:      "feature-1",
+	}
+
+	// We need to truncate the time to workaround pgx driver timezone issue
+	today := time.Now().In(time.UTC).Truncate(time.Hour * 24)
+	day := time.Hour * 24
+	t0 := today.Add(-3 * day)
+
+	// setup creates two grants with usage before and after the second grant is effective
+	setup := func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, ledger credit.Ledger) (credit.Grant, credit.Grant) {
+		ctx := context.Background()
+		feature := testutils.CreateFeature(t, connector, featureIn)
+
+		grants := make([]credit.Grant, 0, 2)
+		for i, amount := range []float64{100, 50} {
+			grant, err := connector.CreateGrant(ctx, credit.Grant{
+				Namespace:   namespace,
+				LedgerID:    ledger.ID,
+				FeatureID:   feature.ID,
+				Type:        credit.GrantTypeUsage,
+				Amount:      amount,
+				Priority:    uint8(i + 1),
+				EffectiveAt: t0.Add(time.Duration(i) * day),
+				Expiration: credit.ExpirationPeriod{
+					Duration: credit.ExpirationPeriodDurationMonth,
+					Count:    1,
+				},
+			})
+			assert.NoError(t, err)
+			grants = append(grants, grant)
+		}
+
+		streamingConnector.AddSimpleEvent(meter.Slug, 10, t0.Add(time.Hour))
+		streamingConnector.AddSimpleEvent(meter.Slug, 20, t0.Add(day).Add(time.Hour))
+
+		return grants[0], grants[1]
+	}
+
+	countSnapshots := func(t *testing.T, db_client *db.Client) int {
+		count, err := db_client.BalanceSnapshot.Query().Count(context.Background())
+		assert.NoError(t, err)
+		return count
+	}
+
+	tt := []struct {
+		name        string
+		description string
+		test        func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger)
+	}{
+		{
+			name:        "SnapshotBalances",
+			description: "Should snapshot at the last grant pivot and calculate the same balance from the snapshot",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				ledgerID := credit.NewNamespacedLedgerID(namespace, ledger.ID)
+				grant1, grant2 := setup(t, connector, streamingConnector, ledger)
+
+				cutline := today.Add(time.Hour)
+				expected, err := connector.GetBalance(ctx, ledgerID, cutline)
+				assert.NoError(t, err)
+
+				snapshots, err := connector.SnapshotBalances(ctx, today)
+				assert.NoError(t, err)
+				assert.Len(t, snapshots, 1)
+				assert.Equal(t, ledger.ID, snapshots[0].LedgerID)
+				assert.Equal(t, t0.Add(day), snapshots[0].At)
+				assert.Equal(t, map[credit.GrantID]float64{
+					*grant1.ID: 90,
+					*grant2.ID: 50,
+				}, snapshots[0].GrantBalances)
+
+				// Snapshotting again is a no-op
+				snapshots, err = connector.SnapshotBalances(ctx, today)
+				assert.NoError(t, err)
+				assert.Empty(t, snapshots)
+
+				actual, err := connector.GetBalance(ctx, ledgerID, cutline)
+				assert.NoError(t, err)
+				assert.Equal(t, testutils.RemoveTimestampsFromBalance(expected), testutils.RemoveTimestampsFromBalance(actual))
+			},
+		},
+		{
+			name:        "VoidGrantInvalidatesSnapshots",
+			description: "Should delete the snapshots taken after the voided grant was effective",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				_, grant2 := setup(t, connector, streamingConnector, ledger)
+
+				_, err := connector.SnapshotBalances(ctx, today)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, countSnapshots(t, db_client))
+
+				_, err = connector.VoidGrant(ctx, grant2)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, countSnapshots(t, db_client))
+			},
+		},
+		{
+			name:        "ResetInvalidatesSnapshots",
+			description: "Should delete the snapshots of the ledger on reset",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				setup(t, connector, streamingConnector, ledger)
+
+				_, err := connector.SnapshotBalances(ctx, today)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, countSnapshots(t, db_client))
+
+				_, _, err = connector.Reset(ctx, credit.Reset{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					EffectiveAt: today,
+				})
+				assert.NoError(t, err)
+				assert.Equal(t, 0, countSnapshots(t, db_client))
+			},
+		},
+		{
+			name:        "MutationWhileSnapshotting",
+			description: "Should not save a snapshot of a ledger changed while its balance was calculated",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				grant1, _ := setup(t, connector, streamingConnector, ledger)
+
+				// The grant is amended while the usage is queried for the snapshot
+				mutating := &mutatingStreamingConnector{MockStreamingConnector: streamingConnector}
+				mutating.onQuery = func() {
+					_, err := connector.AmendGrant(ctx, credit.GrantAmendment{
+						Namespace:   namespace,
+						LedgerID:    ledger.ID,
+						GrantID:     *grant1.ID,
+						EffectiveAt: t0,
+						Amount:      convert.ToPointer(150.0),
+					})
+					assert.NoError(t, err)
+				}
+
+				snapshotter := NewPostgresConnector(slog.Default(), db_client, mutating, meterRepository, PostgresConnectorConfig{
+					WindowSize: windowSize,
+				})
+
+				snapshots, err := snapshotter.SnapshotBalances(ctx, today)
+				assert.NoError(t, err)
+				assert.Empty(t, snapshots)
+				assert.Equal(t, 0, countSnapshots(t, db_client))
+
+				// The next run snapshots the amended grant
+				snapshots, err = snapshotter.SnapshotBalances(ctx, today)
+				assert.NoError(t, err)
+				if assert.Len(t, snapshots, 1) {
+					assert.Equal(t, 150.0-10, snapshots[0].GrantBalances[*grant1.ID])
+				}
+			},
+		},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			t.Log(tc.description)
+			driver := om_testutils.InitPostgresDB(t)
+			databaseClient := db.NewClient(db.Driver(driver))
+			defer databaseClient.Close()
+
+			old, err := time.Parse(time.RFC3339, "2020-01-01T00:00:00Z")
+			assert.NoError(t, err)
+
+			streamingConnector := testutils.NewMockStreamingConnector(t, testutils.MockStreamingConnectorParams{DefaultHighwatermark: old})
+			connector := NewPostgresConnector(slog.Default(), databaseClient, streamingConnector, meterRepository, PostgresConnectorConfig{
+				WindowSize: windowSize,
+			})
+
+			ledger, err := connector.CreateLedger(context.Background(), credit.Ledger{
+				Namespace: namespace,
+				Subject:   ulid.Make().String(),
+			})
+			assert.NoError(t, err)
+
+			tc.test(t, connector, streamingConnector, databaseClient, ledger)
+		})
+	}
+}
+
+// mutatingStreamingConnector runs a mutation on the first query of the usage.
+type mutatingStreamingConnector struct {
+	*testutils.MockStreamingConnector
+
+	onQuery func()
+}
+
+func (m *mutatingStreamingConnector) QueryMeter(ctx context.Context, namespace string, meterSlug string, params *streaming.QueryParams) ([]models.MeterQueryRow, error) {
+	if onQuery := m.onQuery; onQuery != nil {
+		m.onQuery = nil
+		onQuery()
+	}
+
+	return m.MockStreamingConnector.QueryMeter(ctx, namespace, meterSlug, params)
+}
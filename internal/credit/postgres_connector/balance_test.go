@@ -15,6 +15,7 @@ import (
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/testutils"
 	meter_model "github.com/openmeterio/openmeter/internal/meter"
 	om_tetsutils "github.com/openmeterio/openmeter/internal/testutils"
+	"github.com/openmeterio/openmeter/pkg/convert"
 	"github.com/openmeterio/openmeter/pkg/models"
 )
 
@@ -527,6 +528,553 @@ func TestPostgresConnectorBalances(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:        "GetBalanceWithFeaturelessGrants",
+			description: "Should burn down grants without feature with the usage of any feature after the grants of the feature",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client) {
+				t.Parallel()
+				ledger, err := sharedSetup(streamingConnector, connector)
+				assert.NoError(t, err)
+				ctx := context.Background()
+				feature1 := testutils.CreateFeature(t, connector, featureIn1)
+				_ = testutils.CreateFeature(t, connector, featureIn2)
+				t1, _ := time.ParseInLocation(time.RFC3339, "2024-01-01T00:01:00Z", time.UTC)
+				t2, _ := time.ParseInLocation(time.RFC3339, "2024-01-01T00:02:00Z", time.UTC)
+
+				// Lower priority than the grants without feature, still burned down first
+				featureGrant, err := connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					FeatureID:   feature1.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      5,
+					Priority:    2,
+					EffectiveAt: t1,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationMonth,
+						Count:    1,
+					},
+				})
+				assert.NoError(t, err)
+
+				// Expires earlier, burned down before the other grant without feature
+				featurelessGrant1, err := connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      10,
+					Priority:    1,
+					EffectiveAt: t1,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationMonth,
+						Count:    1,
+					},
+				})
+				assert.NoError(t, err)
+
+				featurelessGrant2, err := connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      100,
+					Priority:    1,
+					EffectiveAt: t1,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationYear,
+						Count:    1,
+					},
+				})
+				assert.NoError(t, err)
+
+				streamingConnector.AddRow(meter1.Slug, models.MeterQueryRow{
+					Value:       10,
+					WindowStart: t1,
+					WindowEnd:   t2,
+					GroupBy:     map[string]*string{},
+				})
+				streamingConnector.AddRow(meter2.Slug, models.MeterQueryRow{
+					Value:       20,
+					WindowStart: t1,
+					WindowEnd:   t2,
+					GroupBy:     map[string]*string{},
+				})
+
+				// Get balance
+				balance, err := connector.GetBalance(ctx, credit.NewNamespacedLedgerID(namespace, ledger.ID), t2)
+				assert.NoError(t, err)
+
+				// FIXME
+				for i := range balance.GrantBalances {
+					balance.GrantBalances[i].Grant.EffectiveAt = t1
+				}
+
+				// Only grants with feature are aggregated by feature
+				assert.ElementsMatch(t,
+					testutils.RemoveTimestampsFromFeatureBalances(
+						[]credit.FeatureBalance{
+							{
+								Feature: feature1,
+								Balance: 0,
+								Usage:   5,
+							},
+						}),
+					testutils.RemoveTimestampsFromFeatureBalances(balance.FeatureBalances),
+				)
+
+				// Feature 1 burns down 5 of its grant, the 25 left of both features burn down
+				// the grant without feature expiring first, then 15 of the other one
+				assert.ElementsMatch(t,
+					testutils.RemoveTimestampsFromGrantBalances(
+						[]credit.GrantBalance{
+							{
+								Grant:   featureGrant,
+								Balance: 0,
+							},
+							{
+								Grant:   featurelessGrant1,
+								Balance: 0,
+							},
+							{
+								Grant:   featurelessGrant2,
+								Balance: 85,
+							},
+						}),
+					testutils.RemoveTimestampsFromGrantBalances(balance.GrantBalances),
+				)
+			},
+		},
+		{
+			name:        "GetBalanceWithFeaturelessGrantsOfFeaturesSharingUsage",
+			description: "Should burn down the usage of features with the same meter and filters once with grants without feature",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client) {
+				t.Parallel()
+				ledger, err := sharedSetup(streamingConnector, connector)
+				assert.NoError(t, err)
+				ctx := context.Background()
+				feature1 := testutils.CreateFeature(t, connector, featureIn1)
+				_ = testutils.CreateFeature(t, connector, credit.Feature{
+					Namespace: namespace,
+					MeterSlug: meter1.Slug,
+					Name:      "feature-1-copy",
+				})
+				t1, _ := time.ParseInLocation(time.RFC3339, "2024-01-01T00:01:00Z", time.UTC)
+				t2, _ := time.ParseInLocation(time.RFC3339, "2024-01-01T00:02:00Z", time.UTC)
+
+				featureGrant, err := connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					FeatureID:   feature1.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      4,
+					Priority:    1,
+					EffectiveAt: t1,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationMonth,
+						Count:    1,
+					},
+				})
+				assert.NoError(t, err)
+
+				featurelessGrant, err := connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      100,
+					Priority:    1,
+					EffectiveAt: t1,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationMonth,
+						Count:    1,
+					},
+				})
+				assert.NoError(t, err)
+
+				streamingConnector.AddRow(meter1.Slug, models.MeterQueryRow{
+					Value:       10,
+					WindowStart: t1,
+					WindowEnd:   t2,
+					GroupBy:     map[string]*string{},
+				})
+
+				// Get balance
+				balance, err := connector.GetBalance(ctx, credit.NewNamespacedLedgerID(namespace, ledger.ID), t2)
+				assert.NoError(t, err)
+
+				// FIXME
+				for i := range balance.GrantBalances {
+					balance.GrantBalances[i].Grant.EffectiveAt = t1
+				}
+
+				// The grant without feature burns down the 6 usage left by the grant of feature 1 once,
+				// the usage covered by the grant of feature 1 is not paid again for the other feature
+				assert.ElementsMatch(t,
+					testutils.RemoveTimestampsFromGrantBalances(
+						[]credit.GrantBalance{
+							{
+								Grant:   featureGrant,
+								Balance: 0,
+							},
+							{
+								Grant:   featurelessGrant,
+								Balance: 94,
+							},
+						}),
+					testutils.RemoveTimestampsFromGrantBalances(balance.GrantBalances),
+				)
+			},
+		},
+		{
+			name:        "GetBalanceWithMonetaryGrants",
+			description: "Should burn down grants in a currency with the cost of the usage of features priced in the currency",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client) {
+				t.Parallel()
+				ledger, err := sharedSetup(streamingConnector, connector)
+				assert.NoError(t, err)
+				ctx := context.Background()
+
+				featureIn1 := featureIn1
+				featureIn1.UnitPrice = &credit.UnitPrice{Currency: "USD", Tiers: []credit.PriceTier{
+					{UpTo: convert.ToPointer(10.0), UnitAmount: 1},
+					{UnitAmount: 0.5},
+				}}
+				featureIn2 := featureIn2
+				featureIn2.UnitPrice = &credit.UnitPrice{Currency: "USD", Amount: 2}
+
+				feature1 := testutils.CreateFeature(t, connector, featureIn1)
+				feature2 := testutils.CreateFeature(t, connector, featureIn2)
+				t1, _ := time.ParseInLocation(time.RFC3339, "2024-01-01T00:01:00Z", time.UTC)
+				t2, _ := time.ParseInLocation(time.RFC3339, "2024-01-01T00:02:00Z", time.UTC)
+
+				featureGrant, err := connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					FeatureID:   feature1.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      8,
+					Currency:    convert.ToPointer("USD"),
+					Priority:    1,
+					EffectiveAt: t1,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationMonth,
+						Count:    1,
+					},
+				})
+				assert.NoError(t, err)
+
+				featurelessGrant, err := connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      100,
+					Currency:    convert.ToPointer("USD"),
+					Priority:    1,
+					EffectiveAt: t1,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationMonth,
+						Count:    1,
+					},
+				})
+				assert.NoError(t, err)
+
+				streamingConnector.AddRow(meter1.Slug, models.MeterQueryRow{
+					Value:       20,
+					WindowStart: t1,
+					WindowEnd:   t2,
+					GroupBy:     map[string]*string{},
+				})
+				streamingConnector.AddRow(meter2.Slug, models.MeterQueryRow{
+					Value:       5,
+					WindowStart: t1,
+					WindowEnd:   t2,
+					GroupBy:     map[string]*string{},
+				})
+
+				balance, err := connector.GetBalance(ctx, credit.NewNamespacedLedgerID(namespace, ledger.ID), t2)
+				assert.NoError(t, err)
+
+				// FIXME
+				for i := range balance.GrantBalances {
+					balance.GrantBalances[i].Grant.EffectiveAt = t1
+				}
+
+				// The grant of feature 1 pays for 8 units of the first tier, the grant without feature pays
+				// 2 units of the first tier and 10 units of the second tier of feature 1 and 5 units of feature 2
+				assert.ElementsMatch(t,
+					testutils.RemoveTimestampsFromGrantBalances(
+						[]credit.GrantBalance{
+							{
+								Grant:   featureGrant,
+								Balance: 0,
+							},
+							{
+								Grant:   featurelessGrant,
+								Balance: 100 - 2 - 5 - 10,
+							},
+						}),
+					testutils.RemoveTimestampsFromGrantBalances(balance.GrantBalances),
+				)
+
+				assert.ElementsMatch(t,
+					testutils.RemoveTimestampsFromFeatureBalances(
+						[]credit.FeatureBalance{
+							{
+								Feature:       feature1,
+								Usage:         20,
+								MonetaryUsage: &credit.MonetaryUsage{Units: 20, Amount: 15},
+							},
+							{
+								Feature:       feature2,
+								Usage:         5,
+								MonetaryUsage: &credit.MonetaryUsage{Units: 5, Amount: 10},
+							},
+						}),
+					testutils.RemoveTimestampsFromFeatureBalances(balance.FeatureBalances),
+				)
+
+				// History reports both the cost and the units of the usage
+				history, err := connector.GetHistory(ctx, credit.NewNamespacedLedgerID(namespace, ledger.ID), t1, t2, credit.Pagination{})
+				assert.NoError(t, err)
+
+				var usage []credit.LedgerEntry
+				for _, entry := range history.GetEntries() {
+					if entry.Type == credit.LedgerEntryTypeGrantUsage && *entry.ID == *featureGrant.ID {
+						usage = append(usage, entry)
+					}
+				}
+				if assert.Len(t, usage, 1) {
+					assert.Equal(t, convert.ToPointer(-8.0), usage[0].Amount)
+					assert.Equal(t, convert.ToPointer(-8.0), usage[0].Units)
+					assert.Equal(t, convert.ToPointer("USD"), usage[0].Currency)
+				}
+			},
+		},
+		{
+			name:        "GetBalanceWithNegativeMonetaryGrants",
+			description: "Should not burn down grants in a currency without balance",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client) {
+				t.Parallel()
+				ledger, err := sharedSetup(streamingConnector, connector)
+				assert.NoError(t, err)
+				ctx := context.Background()
+
+				featureIn1 := featureIn1
+				featureIn1.UnitPrice = &credit.UnitPrice{Currency: "USD", Amount: 1}
+
+				feature1 := testutils.CreateFeature(t, connector, featureIn1)
+				t1, _ := time.ParseInLocation(time.RFC3339, "2024-01-01T00:01:00Z", time.UTC)
+				t2, _ := time.ParseInLocation(time.RFC3339, "2024-01-01T00:02:00Z", time.UTC)
+
+				negativeGrant, err := connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					FeatureID:   feature1.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      -5,
+					Currency:    convert.ToPointer("USD"),
+					Priority:    1,
+					EffectiveAt: t1,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationMonth,
+						Count:    1,
+					},
+				})
+				assert.NoError(t, err)
+
+				featurelessGrant, err := connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      100,
+					Currency:    convert.ToPointer("USD"),
+					Priority:    2,
+					EffectiveAt: t1,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationMonth,
+						Count:    1,
+					},
+				})
+				assert.NoError(t, err)
+
+				streamingConnector.AddRow(meter1.Slug, models.MeterQueryRow{
+					Value:       10,
+					WindowStart: t1,
+					WindowEnd:   t2,
+					GroupBy:     map[string]*string{},
+				})
+
+				balance, err := connector.GetBalance(ctx, credit.NewNamespacedLedgerID(namespace, ledger.ID), t2)
+				assert.NoError(t, err)
+
+				// FIXME
+				for i := range balance.GrantBalances {
+					balance.GrantBalances[i].Grant.EffectiveAt = t1
+				}
+
+				// The negative grant pays for nothing, the grant without feature pays for the actual usage only
+				assert.ElementsMatch(t,
+					testutils.RemoveTimestampsFromGrantBalances(
+						[]credit.GrantBalance{
+							{
+								Grant:   negativeGrant,
+								Balance: -5,
+							},
+							{
+								Grant:   featurelessGrant,
+								Balance: 90,
+							},
+						}),
+					testutils.RemoveTimestampsFromGrantBalances(balance.GrantBalances),
+				)
+			},
+		},
+		{
+			name:        "GetBalanceWithOverageLimits",
+			description: "Should report usage not covered by grants as overage within the overage limits",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client) {
+				t.Parallel()
+				ledger, err := sharedSetup(streamingConnector, connector)
+				assert.NoError(t, err)
+				ctx := context.Background()
+				feature1 := testutils.CreateFeature(t, connector, featureIn1)
+				feature2 := testutils.CreateFeature(t, connector, featureIn2)
+				t1, _ := time.ParseInLocation(time.RFC3339, "2024-01-01T00:01:00Z", time.UTC)
+				t2, _ := time.ParseInLocation(time.RFC3339, "2024-01-01T00:02:00Z", time.UTC)
+
+				_, err = connector.UpdateOverageLimits(ctx, credit.NewNamespacedLedgerID(namespace, ledger.ID), credit.OverageLimits{
+					Limit: convert.ToPointer(30.0),
+					Features: map[credit.FeatureID]float64{
+						*feature1.ID: 3,
+					},
+				})
+				assert.NoError(t, err)
+
+				_, err = connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					FeatureID:   feature1.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      5,
+					Priority:    1,
+					EffectiveAt: t1,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationMonth,
+						Count:    1,
+					},
+				})
+				assert.NoError(t, err)
+
+				streamingConnector.AddRow(meter1.Slug, models.MeterQueryRow{
+					Value:       10,
+					WindowStart: t1,
+					WindowEnd:   t2,
+					GroupBy:     map[string]*string{},
+				})
+				streamingConnector.AddRow(meter2.Slug, models.MeterQueryRow{
+					Value:       20,
+					WindowStart: t1,
+					WindowEnd:   t2,
+					GroupBy:     map[string]*string{},
+				})
+
+				balance, err := connector.GetBalance(ctx, credit.NewNamespacedLedgerID(namespace, ledger.ID), t2)
+				assert.NoError(t, err)
+
+				// Feature 1 is limited by its own limit, feature 2 by the limit of the ledger
+				assert.ElementsMatch(t,
+					testutils.RemoveTimestampsFromFeatureBalances(
+						[]credit.FeatureBalance{
+							{
+								Feature: feature1,
+								Balance: 0,
+								Usage:   5,
+								Overage: 3,
+							},
+							{
+								Feature: feature2,
+								Balance: 0,
+								Usage:   0,
+								Overage: 20,
+							},
+						}),
+					testutils.RemoveTimestampsFromFeatureBalances(balance.FeatureBalances),
+				)
+				assert.Equal(t, 23.0, balance.Overage)
+			},
+		},
+		{
+			name:        "GetBalanceWithOverageLimitsOfFeaturesSharingUsage",
+			description: "Should report usage shared by features with the same meter and filters as overage once and skip limits of missing features",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client) {
+				t.Parallel()
+				ledger, err := sharedSetup(streamingConnector, connector)
+				assert.NoError(t, err)
+				ctx := context.Background()
+				feature1 := testutils.CreateFeature(t, connector, featureIn1)
+				feature1Copy := testutils.CreateFeature(t, connector, credit.Feature{
+					Namespace: namespace,
+					MeterSlug: meter1.Slug,
+					Name:      "feature-1-copy",
+				})
+				t1, _ := time.ParseInLocation(time.RFC3339, "2024-01-01T00:01:00Z", time.UTC)
+				t2, _ := time.ParseInLocation(time.RFC3339, "2024-01-01T00:02:00Z", time.UTC)
+
+				_, err = connector.UpdateOverageLimits(ctx, credit.NewNamespacedLedgerID(namespace, ledger.ID), credit.OverageLimits{
+					Features: map[credit.FeatureID]float64{
+						*feature1.ID:     30,
+						*feature1Copy.ID: 30,
+						// Feature does not exist (anymore)
+						credit.FeatureID(ulid.Make().String()): 30,
+					},
+				})
+				assert.NoError(t, err)
+
+				_, err = connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					FeatureID:   feature1Copy.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      4,
+					Priority:    1,
+					EffectiveAt: t1,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationMonth,
+						Count:    1,
+					},
+				})
+				assert.NoError(t, err)
+
+				streamingConnector.AddRow(meter1.Slug, models.MeterQueryRow{
+					Value:       10,
+					WindowStart: t1,
+					WindowEnd:   t2,
+					GroupBy:     map[string]*string{},
+				})
+
+				balance, err := connector.GetBalance(ctx, credit.NewNamespacedLedgerID(namespace, ledger.ID), t2)
+				assert.NoError(t, err)
+
+				// The 10 usage is overage of the feature created first, only the 4 usage covered for the other feature is not
+				assert.ElementsMatch(t,
+					testutils.RemoveTimestampsFromFeatureBalances(
+						[]credit.FeatureBalance{
+							{
+								Feature: feature1,
+								Balance: 0,
+								Usage:   0,
+								Overage: 10,
+							},
+							{
+								Feature: feature1Copy,
+								Balance: 0,
+								Usage:   4,
+								Overage: 0,
+							},
+						}),
+					testutils.RemoveTimestampsFromFeatureBalances(balance.FeatureBalances),
+				)
+				assert.Equal(t, 10.0, balance.Overage)
+			},
+		},
 		{
 			name:        "Should include usage between ledger creation and first grant",
 			description: `The ledger can exist before the first grant is created so we should account for that usage.`,
@@ -1447,3 +1995,55 @@ func TestPostgresConnectorBalances(t *testing.T) {
 		assert.ErrorContains(t, err, "grant effectiveAt is not truncated")
 	})
 }
+
+func TestCalculateOverage(t *testing.T) {
+	feature1 := credit.Feature{ID: convert.ToPointer(credit.FeatureID("feature-1"))}
+	feature2 := credit.Feature{ID: convert.ToPointer(credit.FeatureID("feature-2"))}
+	uncoveredUsage := map[credit.FeatureID]float64{
+		"feature-1": 10,
+		"feature-2": 20,
+	}
+
+	tt := []struct {
+		name     string
+		limits   *credit.OverageLimits
+		features []credit.Feature
+		expected map[credit.FeatureID]float64
+	}{
+		{
+			name:     "NoLimits",
+			limits:   nil,
+			features: []credit.Feature{feature1, feature2},
+			expected: map[credit.FeatureID]float64{},
+		},
+		{
+			name: "FeatureLimits",
+			limits: &credit.OverageLimits{
+				Features: map[credit.FeatureID]float64{"feature-1": 4, "feature-2": 30},
+			},
+			features: []credit.Feature{feature1, feature2},
+			expected: map[credit.FeatureID]float64{"feature-1": 4, "feature-2": 20},
+		},
+		{
+			name:     "LedgerLimitUsedInFeatureOrder",
+			limits:   &credit.OverageLimits{Limit: convert.ToPointer(15.0)},
+			features: []credit.Feature{feature1, feature2},
+			expected: map[credit.FeatureID]float64{"feature-1": 10, "feature-2": 5},
+		},
+		{
+			name: "LedgerAndFeatureLimits",
+			limits: &credit.OverageLimits{
+				Limit:    convert.ToPointer(15.0),
+				Features: map[credit.FeatureID]float64{"feature-1": 2},
+			},
+			features: []credit.Feature{feature1, feature2},
+			expected: map[credit.FeatureID]float64{"feature-1": 2, "feature-2": 13},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, calculateOverage(tc.limits, tc.features, uncoveredUsage))
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package postgres_connector
 
 import (
+	"database/sql"
+
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/XSAM/otelsql"
@@ -11,16 +13,16 @@ import (
 )
 
 // Open new connection
-func Open(databaseURL string) (*db.Client, error) {
+func Open(databaseURL string) (*sql.DB, error) {
 	// TODO: inject trace and metrics provider
-	database, err := otelsql.Open("pgx", databaseURL, otelsql.WithAttributes(
+	return otelsql.Open("pgx", databaseURL, otelsql.WithAttributes(
 		semconv.DBSystemPostgreSQL,
 	))
-	if err != nil {
-		return nil, err
-	}
+}
 
+// NewClient returns an ent client using the database opened by Open
+func NewClient(database *sql.DB) *db.Client {
 	drv := entsql.OpenDB(dialect.Postgres, database)
 
-	return db.NewClient(db.Driver(drv)), nil
+	return db.NewClient(db.Driver(drv))
 }
@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/balancesnapshot"
+)
+
+// BalanceSnapshot is the model entity for the BalanceSnapshot schema.
+type BalanceSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// LedgerID holds the value of the "ledger_id" field.
+	LedgerID string `json:"ledger_id,omitempty"`
+	// At holds the value of the "at" field.
+	At time.Time `json:"at,omitempty"`
+	// GrantBalances holds the value of the "grant_balances" field.
+	GrantBalances map[credit.GrantID]float64 `json:"grant_balances,omitempty"`
+	// UncoveredUsage holds the value of the "uncovered_usage" field.
+	UncoveredUsage map[credit.FeatureID]float64 `json:"uncovered_usage,omitempty"`
+	// Features holds the value of the "features" field.
+	Features []credit.FeatureID `json:"features,omitempty"`
+	// OverageFeatures holds the value of the "overage_features" field.
+	OverageFeatures []credit.FeatureID `json:"overage_features,omitempty"`
+	// MonetaryUsage holds the value of the "monetary_usage" field.
+	MonetaryUsage map[credit.FeatureID]credit.MonetaryUsage `json:"monetary_usage,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BalanceSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case balancesnapshot.FieldGrantBalances, balancesnapshot.FieldUncoveredUsage, balancesnapshot.FieldFeatures, balancesnapshot.FieldOverageFeatures, balancesnapshot.FieldMonetaryUsage:
+			values[i] = new([]byte)
+		case balancesnapshot.FieldID, balancesnapshot.FieldNamespace, balancesnapshot.FieldLedgerID:
+			values[i] = new(sql.NullString)
+		case balancesnapshot.FieldCreatedAt, balancesnapshot.FieldUpdatedAt, balancesnapshot.FieldAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BalanceSnapshot fields.
+func (bs *BalanceSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case balancesnapshot.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				bs.ID = value.String
+			}
+		case balancesnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				bs.CreatedAt = value.Time
+			}
+		case balancesnapshot.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				bs.UpdatedAt = value.Time
+			}
+		case balancesnapshot.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				bs.Namespace = value.String
+			}
+		case balancesnapshot.FieldLedgerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				bs.LedgerID = value.String
+			}
+		case balancesnapshot.FieldAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field at", values[i])
+			} else if value.Valid {
+				bs.At = value.Time
+			}
+		case balancesnapshot.FieldGrantBalances:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field grant_balances", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &bs.GrantBalances); err != nil {
+					return fmt.Errorf("unmarshal field grant_balances: %w", err)
+				}
+			}
+		case balancesnapshot.FieldUncoveredUsage:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field uncovered_usage", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &bs.UncoveredUsage); err != nil {
+					return fmt.Errorf("unmarshal field uncovered_usage: %w", err)
+				}
+			}
+		case balancesnapshot.FieldFeatures:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field features", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &bs.Features); err != nil {
+					return fmt.Errorf("unmarshal field features: %w", err)
+				}
+			}
+		case balancesnapshot.FieldOverageFeatures:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field overage_features", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &bs.OverageFeatures); err != nil {
+					return fmt.Errorf("unmarshal field overage_features: %w", err)
+				}
+			}
+		case balancesnapshot.FieldMonetaryUsage:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field monetary_usage", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &bs.MonetaryUsage); err != nil {
+					return fmt.Errorf("unmarshal field monetary_usage: %w", err)
+				}
+			}
+		default:
+			bs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BalanceSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (bs *BalanceSnapshot) Value(name string) (ent.Value, error) {
+	return bs.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BalanceSnapshot.
+// Note that you need to call BalanceSnapshot.Unwrap() before calling this method if this BalanceSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bs *BalanceSnapshot) Update() *BalanceSnapshotUpdateOne {
+	return NewBalanceSnapshotClient(bs.config).UpdateOne(bs)
+}
+
+// Unwrap unwraps the BalanceSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bs *BalanceSnapshot) Unwrap() *BalanceSnapshot {
+	_tx, ok := bs.config.driver.(*txDriver)
+	if !ok {
+		panic("db: BalanceSnapshot is not a transactional entity")
+	}
+	bs.config.driver = _tx.drv
+	return bs
+}
+
+// String implements the fmt.Stringer.
+func (bs *BalanceSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("BalanceSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bs.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(bs.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(bs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(bs.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("ledger_id=")
+	builder.WriteString(bs.LedgerID)
+	builder.WriteString(", ")
+	builder.WriteString("at=")
+	builder.WriteString(bs.At.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("grant_balances=")
+	builder.WriteString(fmt.Sprintf("%v", bs.GrantBalances))
+	builder.WriteString(", ")
+	builder.WriteString("uncovered_usage=")
+	builder.WriteString(fmt.Sprintf("%v", bs.UncoveredUsage))
+	builder.WriteString(", ")
+	builder.WriteString("features=")
+	builder.WriteString(fmt.Sprintf("%v", bs.Features))
+	builder.WriteString(", ")
+	builder.WriteString("overage_features=")
+	builder.WriteString(fmt.Sprintf("%v", bs.OverageFeatures))
+	builder.WriteString(", ")
+	builder.WriteString("monetary_usage=")
+	builder.WriteString(fmt.Sprintf("%v", bs.MonetaryUsage))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BalanceSnapshots is a parsable slice of BalanceSnapshot.
+type BalanceSnapshots []*BalanceSnapshot
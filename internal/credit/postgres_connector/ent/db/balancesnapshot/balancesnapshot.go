@@ -0,0 +1,109 @@
+// Code generated by ent, DO NOT EDIT.
+
+package balancesnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the balancesnapshot type in the database.
+	Label = "balance_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldLedgerID holds the string denoting the ledger_id field in the database.
+	FieldLedgerID = "ledger_id"
+	// FieldAt holds the string denoting the at field in the database.
+	FieldAt = "at"
+	// FieldGrantBalances holds the string denoting the grant_balances field in the database.
+	FieldGrantBalances = "grant_balances"
+	// FieldUncoveredUsage holds the string denoting the uncovered_usage field in the database.
+	FieldUncoveredUsage = "uncovered_usage"
+	// FieldFeatures holds the string denoting the features field in the database.
+	FieldFeatures = "features"
+	// FieldOverageFeatures holds the string denoting the overage_features field in the database.
+	FieldOverageFeatures = "overage_features"
+	// FieldMonetaryUsage holds the string denoting the monetary_usage field in the database.
+	FieldMonetaryUsage = "monetary_usage"
+	// Table holds the table name of the balancesnapshot in the database.
+	Table = "balance_snapshots"
+)
+
+// Columns holds all SQL columns for balancesnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldNamespace,
+	FieldLedgerID,
+	FieldAt,
+	FieldGrantBalances,
+	FieldUncoveredUsage,
+	FieldFeatures,
+	FieldOverageFeatures,
+	FieldMonetaryUsage,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	NamespaceValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the BalanceSnapshot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByNamespace orders the results by the namespace field.
+func ByNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespace, opts...).ToFunc()
+}
+
+// ByLedgerID orders the results by the ledger_id field.
+func ByLedgerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLedgerID, opts...).ToFunc()
+}
+
+// ByAt orders the results by the at field.
+func ByAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAt, opts...).ToFunc()
+}
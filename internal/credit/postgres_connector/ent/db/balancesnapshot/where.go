@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package balancesnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEQ(FieldNamespace, v))
+}
+
+// LedgerID applies equality check predicate on the "ledger_id" field. It's identical to LedgerIDEQ.
+func LedgerID(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEQ(FieldLedgerID, v))
+}
+
+// At applies equality check predicate on the "at" field. It's identical to AtEQ.
+func At(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEQ(FieldAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// LedgerIDEQ applies the EQ predicate on the "ledger_id" field.
+func LedgerIDEQ(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEQ(FieldLedgerID, v))
+}
+
+// LedgerIDNEQ applies the NEQ predicate on the "ledger_id" field.
+func LedgerIDNEQ(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNEQ(FieldLedgerID, v))
+}
+
+// LedgerIDIn applies the In predicate on the "ledger_id" field.
+func LedgerIDIn(vs ...string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldIn(FieldLedgerID, vs...))
+}
+
+// LedgerIDNotIn applies the NotIn predicate on the "ledger_id" field.
+func LedgerIDNotIn(vs ...string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNotIn(FieldLedgerID, vs...))
+}
+
+// LedgerIDGT applies the GT predicate on the "ledger_id" field.
+func LedgerIDGT(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldGT(FieldLedgerID, v))
+}
+
+// LedgerIDGTE applies the GTE predicate on the "ledger_id" field.
+func LedgerIDGTE(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldGTE(FieldLedgerID, v))
+}
+
+// LedgerIDLT applies the LT predicate on the "ledger_id" field.
+func LedgerIDLT(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldLT(FieldLedgerID, v))
+}
+
+// LedgerIDLTE applies the LTE predicate on the "ledger_id" field.
+func LedgerIDLTE(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldLTE(FieldLedgerID, v))
+}
+
+// LedgerIDContains applies the Contains predicate on the "ledger_id" field.
+func LedgerIDContains(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldContains(FieldLedgerID, v))
+}
+
+// LedgerIDHasPrefix applies the HasPrefix predicate on the "ledger_id" field.
+func LedgerIDHasPrefix(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldHasPrefix(FieldLedgerID, v))
+}
+
+// LedgerIDHasSuffix applies the HasSuffix predicate on the "ledger_id" field.
+func LedgerIDHasSuffix(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldHasSuffix(FieldLedgerID, v))
+}
+
+// LedgerIDEqualFold applies the EqualFold predicate on the "ledger_id" field.
+func LedgerIDEqualFold(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEqualFold(FieldLedgerID, v))
+}
+
+// LedgerIDContainsFold applies the ContainsFold predicate on the "ledger_id" field.
+func LedgerIDContainsFold(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldContainsFold(FieldLedgerID, v))
+}
+
+// AtEQ applies the EQ predicate on the "at" field.
+func AtEQ(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldEQ(FieldAt, v))
+}
+
+// AtNEQ applies the NEQ predicate on the "at" field.
+func AtNEQ(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNEQ(FieldAt, v))
+}
+
+// AtIn applies the In predicate on the "at" field.
+func AtIn(vs ...time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldIn(FieldAt, vs...))
+}
+
+// AtNotIn applies the NotIn predicate on the "at" field.
+func AtNotIn(vs ...time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNotIn(FieldAt, vs...))
+}
+
+// AtGT applies the GT predicate on the "at" field.
+func AtGT(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldGT(FieldAt, v))
+}
+
+// AtGTE applies the GTE predicate on the "at" field.
+func AtGTE(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldGTE(FieldAt, v))
+}
+
+// AtLT applies the LT predicate on the "at" field.
+func AtLT(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldLT(FieldAt, v))
+}
+
+// AtLTE applies the LTE predicate on the "at" field.
+func AtLTE(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldLTE(FieldAt, v))
+}
+
+// FeaturesIsNil applies the IsNil predicate on the "features" field.
+func FeaturesIsNil() predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldIsNull(FieldFeatures))
+}
+
+// FeaturesNotNil applies the NotNil predicate on the "features" field.
+func FeaturesNotNil() predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNotNull(FieldFeatures))
+}
+
+// OverageFeaturesIsNil applies the IsNil predicate on the "overage_features" field.
+func OverageFeaturesIsNil() predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldIsNull(FieldOverageFeatures))
+}
+
+// OverageFeaturesNotNil applies the NotNil predicate on the "overage_features" field.
+func OverageFeaturesNotNil() predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNotNull(FieldOverageFeatures))
+}
+
+// MonetaryUsageIsNil applies the IsNil predicate on the "monetary_usage" field.
+func MonetaryUsageIsNil() predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldIsNull(FieldMonetaryUsage))
+}
+
+// MonetaryUsageNotNil applies the NotNil predicate on the "monetary_usage" field.
+func MonetaryUsageNotNil() predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.FieldNotNull(FieldMonetaryUsage))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BalanceSnapshot) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BalanceSnapshot) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BalanceSnapshot) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(sql.NotPredicates(p))
+}
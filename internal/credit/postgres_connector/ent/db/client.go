@@ -15,9 +15,15 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/balancesnapshot"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/creditentry"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/eventtype"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/feature"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/grantschedule"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/ledger"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationdelivery"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationrule"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/subjectalias"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,12 +31,24 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// BalanceSnapshot is the client for interacting with the BalanceSnapshot builders.
+	BalanceSnapshot *BalanceSnapshotClient
 	// CreditEntry is the client for interacting with the CreditEntry builders.
 	CreditEntry *CreditEntryClient
+	// EventType is the client for interacting with the EventType builders.
+	EventType *EventTypeClient
 	// Feature is the client for interacting with the Feature builders.
 	Feature *FeatureClient
+	// GrantSchedule is the client for interacting with the GrantSchedule builders.
+	GrantSchedule *GrantScheduleClient
 	// Ledger is the client for interacting with the Ledger builders.
 	Ledger *LedgerClient
+	// NotificationDelivery is the client for interacting with the NotificationDelivery builders.
+	NotificationDelivery *NotificationDeliveryClient
+	// NotificationRule is the client for interacting with the NotificationRule builders.
+	NotificationRule *NotificationRuleClient
+	// SubjectAlias is the client for interacting with the SubjectAlias builders.
+	SubjectAlias *SubjectAliasClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -42,9 +60,15 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.BalanceSnapshot = NewBalanceSnapshotClient(c.config)
 	c.CreditEntry = NewCreditEntryClient(c.config)
+	c.EventType = NewEventTypeClient(c.config)
 	c.Feature = NewFeatureClient(c.config)
+	c.GrantSchedule = NewGrantScheduleClient(c.config)
 	c.Ledger = NewLedgerClient(c.config)
+	c.NotificationDelivery = NewNotificationDeliveryClient(c.config)
+	c.NotificationRule = NewNotificationRuleClient(c.config)
+	c.SubjectAlias = NewSubjectAliasClient(c.config)
 }
 
 type (
@@ -135,11 +159,17 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		CreditEntry: NewCreditEntryClient(cfg),
-		Feature:     NewFeatureClient(cfg),
-		Ledger:      NewLedgerClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		BalanceSnapshot:      NewBalanceSnapshotClient(cfg),
+		CreditEntry:          NewCreditEntryClient(cfg),
+		EventType:            NewEventTypeClient(cfg),
+		Feature:              NewFeatureClient(cfg),
+		GrantSchedule:        NewGrantScheduleClient(cfg),
+		Ledger:               NewLedgerClient(cfg),
+		NotificationDelivery: NewNotificationDeliveryClient(cfg),
+		NotificationRule:     NewNotificationRuleClient(cfg),
+		SubjectAlias:         NewSubjectAliasClient(cfg),
 	}, nil
 }
 
@@ -157,18 +187,24 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		CreditEntry: NewCreditEntryClient(cfg),
-		Feature:     NewFeatureClient(cfg),
-		Ledger:      NewLedgerClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		BalanceSnapshot:      NewBalanceSnapshotClient(cfg),
+		CreditEntry:          NewCreditEntryClient(cfg),
+		EventType:            NewEventTypeClient(cfg),
+		Feature:              NewFeatureClient(cfg),
+		GrantSchedule:        NewGrantScheduleClient(cfg),
+		Ledger:               NewLedgerClient(cfg),
+		NotificationDelivery: NewNotificationDeliveryClient(cfg),
+		NotificationRule:     NewNotificationRuleClient(cfg),
+		SubjectAlias:         NewSubjectAliasClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		CreditEntry.
+//		BalanceSnapshot.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -190,33 +226,184 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.CreditEntry.Use(hooks...)
-	c.Feature.Use(hooks...)
-	c.Ledger.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.BalanceSnapshot, c.CreditEntry, c.EventType, c.Feature, c.GrantSchedule,
+		c.Ledger, c.NotificationDelivery, c.NotificationRule, c.SubjectAlias,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.CreditEntry.Intercept(interceptors...)
-	c.Feature.Intercept(interceptors...)
-	c.Ledger.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.BalanceSnapshot, c.CreditEntry, c.EventType, c.Feature, c.GrantSchedule,
+		c.Ledger, c.NotificationDelivery, c.NotificationRule, c.SubjectAlias,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BalanceSnapshotMutation:
+		return c.BalanceSnapshot.mutate(ctx, m)
 	case *CreditEntryMutation:
 		return c.CreditEntry.mutate(ctx, m)
+	case *EventTypeMutation:
+		return c.EventType.mutate(ctx, m)
 	case *FeatureMutation:
 		return c.Feature.mutate(ctx, m)
+	case *GrantScheduleMutation:
+		return c.GrantSchedule.mutate(ctx, m)
 	case *LedgerMutation:
 		return c.Ledger.mutate(ctx, m)
+	case *NotificationDeliveryMutation:
+		return c.NotificationDelivery.mutate(ctx, m)
+	case *NotificationRuleMutation:
+		return c.NotificationRule.mutate(ctx, m)
+	case *SubjectAliasMutation:
+		return c.SubjectAlias.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("db: unknown mutation type %T", m)
 	}
 }
 
+// BalanceSnapshotClient is a client for the BalanceSnapshot schema.
+type BalanceSnapshotClient struct {
+	config
+}
+
+// NewBalanceSnapshotClient returns a client for the BalanceSnapshot from the given config.
+func NewBalanceSnapshotClient(c config) *BalanceSnapshotClient {
+	return &BalanceSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `balancesnapshot.Hooks(f(g(h())))`.
+func (c *BalanceSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.BalanceSnapshot = append(c.hooks.BalanceSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `balancesnapshot.Intercept(f(g(h())))`.
+func (c *BalanceSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BalanceSnapshot = append(c.inters.BalanceSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a BalanceSnapshot entity.
+func (c *BalanceSnapshotClient) Create() *BalanceSnapshotCreate {
+	mutation := newBalanceSnapshotMutation(c.config, OpCreate)
+	return &BalanceSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BalanceSnapshot entities.
+func (c *BalanceSnapshotClient) CreateBulk(builders ...*BalanceSnapshotCreate) *BalanceSnapshotCreateBulk {
+	return &BalanceSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BalanceSnapshotClient) MapCreateBulk(slice any, setFunc func(*BalanceSnapshotCreate, int)) *BalanceSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BalanceSnapshotCreateBulk{err: fmt.Errorf("calling to BalanceSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BalanceSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BalanceSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BalanceSnapshot.
+func (c *BalanceSnapshotClient) Update() *BalanceSnapshotUpdate {
+	mutation := newBalanceSnapshotMutation(c.config, OpUpdate)
+	return &BalanceSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BalanceSnapshotClient) UpdateOne(bs *BalanceSnapshot) *BalanceSnapshotUpdateOne {
+	mutation := newBalanceSnapshotMutation(c.config, OpUpdateOne, withBalanceSnapshot(bs))
+	return &BalanceSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BalanceSnapshotClient) UpdateOneID(id string) *BalanceSnapshotUpdateOne {
+	mutation := newBalanceSnapshotMutation(c.config, OpUpdateOne, withBalanceSnapshotID(id))
+	return &BalanceSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BalanceSnapshot.
+func (c *BalanceSnapshotClient) Delete() *BalanceSnapshotDelete {
+	mutation := newBalanceSnapshotMutation(c.config, OpDelete)
+	return &BalanceSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BalanceSnapshotClient) DeleteOne(bs *BalanceSnapshot) *BalanceSnapshotDeleteOne {
+	return c.DeleteOneID(bs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BalanceSnapshotClient) DeleteOneID(id string) *BalanceSnapshotDeleteOne {
+	builder := c.Delete().Where(balancesnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BalanceSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for BalanceSnapshot.
+func (c *BalanceSnapshotClient) Query() *BalanceSnapshotQuery {
+	return &BalanceSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBalanceSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BalanceSnapshot entity by its id.
+func (c *BalanceSnapshotClient) Get(ctx context.Context, id string) (*BalanceSnapshot, error) {
+	return c.Query().Where(balancesnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BalanceSnapshotClient) GetX(ctx context.Context, id string) *BalanceSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BalanceSnapshotClient) Hooks() []Hook {
+	return c.hooks.BalanceSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *BalanceSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.BalanceSnapshot
+}
+
+func (c *BalanceSnapshotClient) mutate(ctx context.Context, m *BalanceSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BalanceSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BalanceSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BalanceSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BalanceSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown BalanceSnapshot mutation op: %q", m.Op())
+	}
+}
+
 // CreditEntryClient is a client for the CreditEntry schema.
 type CreditEntryClient struct {
 	config
@@ -333,7 +520,7 @@ func (c *CreditEntryClient) QueryParent(ce *CreditEntry) *CreditEntryQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(creditentry.Table, creditentry.FieldID, id),
 			sqlgraph.To(creditentry.Table, creditentry.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, creditentry.ParentTable, creditentry.ParentColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, creditentry.ParentTable, creditentry.ParentColumn),
 		)
 		fromV = sqlgraph.Neighbors(ce.driver.Dialect(), step)
 		return fromV, nil
@@ -349,7 +536,7 @@ func (c *CreditEntryClient) QueryChildren(ce *CreditEntry) *CreditEntryQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(creditentry.Table, creditentry.FieldID, id),
 			sqlgraph.To(creditentry.Table, creditentry.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, creditentry.ChildrenTable, creditentry.ChildrenColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, creditentry.ChildrenTable, creditentry.ChildrenColumn),
 		)
 		fromV = sqlgraph.Neighbors(ce.driver.Dialect(), step)
 		return fromV, nil
@@ -398,6 +585,139 @@ func (c *CreditEntryClient) mutate(ctx context.Context, m *CreditEntryMutation)
 	}
 }
 
+// EventTypeClient is a client for the EventType schema.
+type EventTypeClient struct {
+	config
+}
+
+// NewEventTypeClient returns a client for the EventType from the given config.
+func NewEventTypeClient(c config) *EventTypeClient {
+	return &EventTypeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `eventtype.Hooks(f(g(h())))`.
+func (c *EventTypeClient) Use(hooks ...Hook) {
+	c.hooks.EventType = append(c.hooks.EventType, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `eventtype.Intercept(f(g(h())))`.
+func (c *EventTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EventType = append(c.inters.EventType, interceptors...)
+}
+
+// Create returns a builder for creating a EventType entity.
+func (c *EventTypeClient) Create() *EventTypeCreate {
+	mutation := newEventTypeMutation(c.config, OpCreate)
+	return &EventTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EventType entities.
+func (c *EventTypeClient) CreateBulk(builders ...*EventTypeCreate) *EventTypeCreateBulk {
+	return &EventTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventTypeClient) MapCreateBulk(slice any, setFunc func(*EventTypeCreate, int)) *EventTypeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventTypeCreateBulk{err: fmt.Errorf("calling to EventTypeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventTypeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EventType.
+func (c *EventTypeClient) Update() *EventTypeUpdate {
+	mutation := newEventTypeMutation(c.config, OpUpdate)
+	return &EventTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventTypeClient) UpdateOne(et *EventType) *EventTypeUpdateOne {
+	mutation := newEventTypeMutation(c.config, OpUpdateOne, withEventType(et))
+	return &EventTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventTypeClient) UpdateOneID(id string) *EventTypeUpdateOne {
+	mutation := newEventTypeMutation(c.config, OpUpdateOne, withEventTypeID(id))
+	return &EventTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EventType.
+func (c *EventTypeClient) Delete() *EventTypeDelete {
+	mutation := newEventTypeMutation(c.config, OpDelete)
+	return &EventTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventTypeClient) DeleteOne(et *EventType) *EventTypeDeleteOne {
+	return c.DeleteOneID(et.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventTypeClient) DeleteOneID(id string) *EventTypeDeleteOne {
+	builder := c.Delete().Where(eventtype.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventTypeDeleteOne{builder}
+}
+
+// Query returns a query builder for EventType.
+func (c *EventTypeClient) Query() *EventTypeQuery {
+	return &EventTypeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEventType},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EventType entity by its id.
+func (c *EventTypeClient) Get(ctx context.Context, id string) (*EventType, error) {
+	return c.Query().Where(eventtype.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventTypeClient) GetX(ctx context.Context, id string) *EventType {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EventTypeClient) Hooks() []Hook {
+	return c.hooks.EventType
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventTypeClient) Interceptors() []Interceptor {
+	return c.inters.EventType
+}
+
+func (c *EventTypeClient) mutate(ctx context.Context, m *EventTypeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown EventType mutation op: %q", m.Op())
+	}
+}
+
 // FeatureClient is a client for the Feature schema.
 type FeatureClient struct {
 	config
@@ -547,6 +867,139 @@ func (c *FeatureClient) mutate(ctx context.Context, m *FeatureMutation) (Value,
 	}
 }
 
+// GrantScheduleClient is a client for the GrantSchedule schema.
+type GrantScheduleClient struct {
+	config
+}
+
+// NewGrantScheduleClient returns a client for the GrantSchedule from the given config.
+func NewGrantScheduleClient(c config) *GrantScheduleClient {
+	return &GrantScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `grantschedule.Hooks(f(g(h())))`.
+func (c *GrantScheduleClient) Use(hooks ...Hook) {
+	c.hooks.GrantSchedule = append(c.hooks.GrantSchedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `grantschedule.Intercept(f(g(h())))`.
+func (c *GrantScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GrantSchedule = append(c.inters.GrantSchedule, interceptors...)
+}
+
+// Create returns a builder for creating a GrantSchedule entity.
+func (c *GrantScheduleClient) Create() *GrantScheduleCreate {
+	mutation := newGrantScheduleMutation(c.config, OpCreate)
+	return &GrantScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GrantSchedule entities.
+func (c *GrantScheduleClient) CreateBulk(builders ...*GrantScheduleCreate) *GrantScheduleCreateBulk {
+	return &GrantScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GrantScheduleClient) MapCreateBulk(slice any, setFunc func(*GrantScheduleCreate, int)) *GrantScheduleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GrantScheduleCreateBulk{err: fmt.Errorf("calling to GrantScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GrantScheduleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GrantScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GrantSchedule.
+func (c *GrantScheduleClient) Update() *GrantScheduleUpdate {
+	mutation := newGrantScheduleMutation(c.config, OpUpdate)
+	return &GrantScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GrantScheduleClient) UpdateOne(gs *GrantSchedule) *GrantScheduleUpdateOne {
+	mutation := newGrantScheduleMutation(c.config, OpUpdateOne, withGrantSchedule(gs))
+	return &GrantScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GrantScheduleClient) UpdateOneID(id string) *GrantScheduleUpdateOne {
+	mutation := newGrantScheduleMutation(c.config, OpUpdateOne, withGrantScheduleID(id))
+	return &GrantScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GrantSchedule.
+func (c *GrantScheduleClient) Delete() *GrantScheduleDelete {
+	mutation := newGrantScheduleMutation(c.config, OpDelete)
+	return &GrantScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GrantScheduleClient) DeleteOne(gs *GrantSchedule) *GrantScheduleDeleteOne {
+	return c.DeleteOneID(gs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GrantScheduleClient) DeleteOneID(id string) *GrantScheduleDeleteOne {
+	builder := c.Delete().Where(grantschedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GrantScheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for GrantSchedule.
+func (c *GrantScheduleClient) Query() *GrantScheduleQuery {
+	return &GrantScheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGrantSchedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GrantSchedule entity by its id.
+func (c *GrantScheduleClient) Get(ctx context.Context, id string) (*GrantSchedule, error) {
+	return c.Query().Where(grantschedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GrantScheduleClient) GetX(ctx context.Context, id string) *GrantSchedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GrantScheduleClient) Hooks() []Hook {
+	return c.hooks.GrantSchedule
+}
+
+// Interceptors returns the client interceptors.
+func (c *GrantScheduleClient) Interceptors() []Interceptor {
+	return c.inters.GrantSchedule
+}
+
+func (c *GrantScheduleClient) mutate(ctx context.Context, m *GrantScheduleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GrantScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GrantScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GrantScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GrantScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown GrantSchedule mutation op: %q", m.Op())
+	}
+}
+
 // LedgerClient is a client for the Ledger schema.
 type LedgerClient struct {
 	config
@@ -680,12 +1133,413 @@ func (c *LedgerClient) mutate(ctx context.Context, m *LedgerMutation) (Value, er
 	}
 }
 
+// NotificationDeliveryClient is a client for the NotificationDelivery schema.
+type NotificationDeliveryClient struct {
+	config
+}
+
+// NewNotificationDeliveryClient returns a client for the NotificationDelivery from the given config.
+func NewNotificationDeliveryClient(c config) *NotificationDeliveryClient {
+	return &NotificationDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationdelivery.Hooks(f(g(h())))`.
+func (c *NotificationDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.NotificationDelivery = append(c.hooks.NotificationDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationdelivery.Intercept(f(g(h())))`.
+func (c *NotificationDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationDelivery = append(c.inters.NotificationDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationDelivery entity.
+func (c *NotificationDeliveryClient) Create() *NotificationDeliveryCreate {
+	mutation := newNotificationDeliveryMutation(c.config, OpCreate)
+	return &NotificationDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationDelivery entities.
+func (c *NotificationDeliveryClient) CreateBulk(builders ...*NotificationDeliveryCreate) *NotificationDeliveryCreateBulk {
+	return &NotificationDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationDeliveryClient) MapCreateBulk(slice any, setFunc func(*NotificationDeliveryCreate, int)) *NotificationDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationDeliveryCreateBulk{err: fmt.Errorf("calling to NotificationDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationDelivery.
+func (c *NotificationDeliveryClient) Update() *NotificationDeliveryUpdate {
+	mutation := newNotificationDeliveryMutation(c.config, OpUpdate)
+	return &NotificationDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationDeliveryClient) UpdateOne(nd *NotificationDelivery) *NotificationDeliveryUpdateOne {
+	mutation := newNotificationDeliveryMutation(c.config, OpUpdateOne, withNotificationDelivery(nd))
+	return &NotificationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationDeliveryClient) UpdateOneID(id string) *NotificationDeliveryUpdateOne {
+	mutation := newNotificationDeliveryMutation(c.config, OpUpdateOne, withNotificationDeliveryID(id))
+	return &NotificationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationDelivery.
+func (c *NotificationDeliveryClient) Delete() *NotificationDeliveryDelete {
+	mutation := newNotificationDeliveryMutation(c.config, OpDelete)
+	return &NotificationDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationDeliveryClient) DeleteOne(nd *NotificationDelivery) *NotificationDeliveryDeleteOne {
+	return c.DeleteOneID(nd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationDeliveryClient) DeleteOneID(id string) *NotificationDeliveryDeleteOne {
+	builder := c.Delete().Where(notificationdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationDelivery.
+func (c *NotificationDeliveryClient) Query() *NotificationDeliveryQuery {
+	return &NotificationDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationDelivery entity by its id.
+func (c *NotificationDeliveryClient) Get(ctx context.Context, id string) (*NotificationDelivery, error) {
+	return c.Query().Where(notificationdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationDeliveryClient) GetX(ctx context.Context, id string) *NotificationDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationDeliveryClient) Hooks() []Hook {
+	return c.hooks.NotificationDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.NotificationDelivery
+}
+
+func (c *NotificationDeliveryClient) mutate(ctx context.Context, m *NotificationDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown NotificationDelivery mutation op: %q", m.Op())
+	}
+}
+
+// NotificationRuleClient is a client for the NotificationRule schema.
+type NotificationRuleClient struct {
+	config
+}
+
+// NewNotificationRuleClient returns a client for the NotificationRule from the given config.
+func NewNotificationRuleClient(c config) *NotificationRuleClient {
+	return &NotificationRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationrule.Hooks(f(g(h())))`.
+func (c *NotificationRuleClient) Use(hooks ...Hook) {
+	c.hooks.NotificationRule = append(c.hooks.NotificationRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationrule.Intercept(f(g(h())))`.
+func (c *NotificationRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationRule = append(c.inters.NotificationRule, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationRule entity.
+func (c *NotificationRuleClient) Create() *NotificationRuleCreate {
+	mutation := newNotificationRuleMutation(c.config, OpCreate)
+	return &NotificationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationRule entities.
+func (c *NotificationRuleClient) CreateBulk(builders ...*NotificationRuleCreate) *NotificationRuleCreateBulk {
+	return &NotificationRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationRuleClient) MapCreateBulk(slice any, setFunc func(*NotificationRuleCreate, int)) *NotificationRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationRuleCreateBulk{err: fmt.Errorf("calling to NotificationRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationRule.
+func (c *NotificationRuleClient) Update() *NotificationRuleUpdate {
+	mutation := newNotificationRuleMutation(c.config, OpUpdate)
+	return &NotificationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationRuleClient) UpdateOne(nr *NotificationRule) *NotificationRuleUpdateOne {
+	mutation := newNotificationRuleMutation(c.config, OpUpdateOne, withNotificationRule(nr))
+	return &NotificationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationRuleClient) UpdateOneID(id string) *NotificationRuleUpdateOne {
+	mutation := newNotificationRuleMutation(c.config, OpUpdateOne, withNotificationRuleID(id))
+	return &NotificationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationRule.
+func (c *NotificationRuleClient) Delete() *NotificationRuleDelete {
+	mutation := newNotificationRuleMutation(c.config, OpDelete)
+	return &NotificationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationRuleClient) DeleteOne(nr *NotificationRule) *NotificationRuleDeleteOne {
+	return c.DeleteOneID(nr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationRuleClient) DeleteOneID(id string) *NotificationRuleDeleteOne {
+	builder := c.Delete().Where(notificationrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationRule.
+func (c *NotificationRuleClient) Query() *NotificationRuleQuery {
+	return &NotificationRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationRule entity by its id.
+func (c *NotificationRuleClient) Get(ctx context.Context, id string) (*NotificationRule, error) {
+	return c.Query().Where(notificationrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationRuleClient) GetX(ctx context.Context, id string) *NotificationRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationRuleClient) Hooks() []Hook {
+	return c.hooks.NotificationRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationRuleClient) Interceptors() []Interceptor {
+	return c.inters.NotificationRule
+}
+
+func (c *NotificationRuleClient) mutate(ctx context.Context, m *NotificationRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown NotificationRule mutation op: %q", m.Op())
+	}
+}
+
+// SubjectAliasClient is a client for the SubjectAlias schema.
+type SubjectAliasClient struct {
+	config
+}
+
+// NewSubjectAliasClient returns a client for the SubjectAlias from the given config.
+func NewSubjectAliasClient(c config) *SubjectAliasClient {
+	return &SubjectAliasClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `subjectalias.Hooks(f(g(h())))`.
+func (c *SubjectAliasClient) Use(hooks ...Hook) {
+	c.hooks.SubjectAlias = append(c.hooks.SubjectAlias, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `subjectalias.Intercept(f(g(h())))`.
+func (c *SubjectAliasClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SubjectAlias = append(c.inters.SubjectAlias, interceptors...)
+}
+
+// Create returns a builder for creating a SubjectAlias entity.
+func (c *SubjectAliasClient) Create() *SubjectAliasCreate {
+	mutation := newSubjectAliasMutation(c.config, OpCreate)
+	return &SubjectAliasCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SubjectAlias entities.
+func (c *SubjectAliasClient) CreateBulk(builders ...*SubjectAliasCreate) *SubjectAliasCreateBulk {
+	return &SubjectAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SubjectAliasClient) MapCreateBulk(slice any, setFunc func(*SubjectAliasCreate, int)) *SubjectAliasCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SubjectAliasCreateBulk{err: fmt.Errorf("calling to SubjectAliasClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SubjectAliasCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SubjectAliasCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SubjectAlias.
+func (c *SubjectAliasClient) Update() *SubjectAliasUpdate {
+	mutation := newSubjectAliasMutation(c.config, OpUpdate)
+	return &SubjectAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SubjectAliasClient) UpdateOne(sa *SubjectAlias) *SubjectAliasUpdateOne {
+	mutation := newSubjectAliasMutation(c.config, OpUpdateOne, withSubjectAlias(sa))
+	return &SubjectAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SubjectAliasClient) UpdateOneID(id string) *SubjectAliasUpdateOne {
+	mutation := newSubjectAliasMutation(c.config, OpUpdateOne, withSubjectAliasID(id))
+	return &SubjectAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SubjectAlias.
+func (c *SubjectAliasClient) Delete() *SubjectAliasDelete {
+	mutation := newSubjectAliasMutation(c.config, OpDelete)
+	return &SubjectAliasDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubjectAliasClient) DeleteOne(sa *SubjectAlias) *SubjectAliasDeleteOne {
+	return c.DeleteOneID(sa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubjectAliasClient) DeleteOneID(id string) *SubjectAliasDeleteOne {
+	builder := c.Delete().Where(subjectalias.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubjectAliasDeleteOne{builder}
+}
+
+// Query returns a query builder for SubjectAlias.
+func (c *SubjectAliasClient) Query() *SubjectAliasQuery {
+	return &SubjectAliasQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubjectAlias},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SubjectAlias entity by its id.
+func (c *SubjectAliasClient) Get(ctx context.Context, id string) (*SubjectAlias, error) {
+	return c.Query().Where(subjectalias.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubjectAliasClient) GetX(ctx context.Context, id string) *SubjectAlias {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SubjectAliasClient) Hooks() []Hook {
+	return c.hooks.SubjectAlias
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubjectAliasClient) Interceptors() []Interceptor {
+	return c.inters.SubjectAlias
+}
+
+func (c *SubjectAliasClient) mutate(ctx context.Context, m *SubjectAliasMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubjectAliasCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubjectAliasUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubjectAliasUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubjectAliasDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown SubjectAlias mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		CreditEntry, Feature, Ledger []ent.Hook
+		BalanceSnapshot, CreditEntry, EventType, Feature, GrantSchedule, Ledger,
+		NotificationDelivery, NotificationRule, SubjectAlias []ent.Hook
 	}
 	inters struct {
-		CreditEntry, Feature, Ledger []ent.Interceptor
+		BalanceSnapshot, CreditEntry, EventType, Feature, GrantSchedule, Ledger,
+		NotificationDelivery, NotificationRule, SubjectAlias []ent.Interceptor
 	}
 )
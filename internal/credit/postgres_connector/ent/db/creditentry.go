@@ -36,6 +36,8 @@ type CreditEntry struct {
 	FeatureID *string `json:"feature_id,omitempty"`
 	// Amount holds the value of the "amount" field.
 	Amount *float64 `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency *string `json:"currency,omitempty"`
 	// Priority holds the value of the "priority" field.
 	Priority uint8 `json:"priority,omitempty"`
 	// EffectiveAt holds the value of the "effective_at" field.
@@ -54,6 +56,8 @@ type CreditEntry struct {
 	Metadata map[string]string `json:"metadata,omitempty"`
 	// ParentID holds the value of the "parent_id" field.
 	ParentID *string `json:"parent_id,omitempty"`
+	// GrantScheduleID holds the value of the "grant_schedule_id" field.
+	GrantScheduleID *string `json:"grant_schedule_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CreditEntryQuery when eager-loading is set.
 	Edges        CreditEntryEdges `json:"edges"`
@@ -65,7 +69,7 @@ type CreditEntryEdges struct {
 	// Parent holds the value of the parent edge.
 	Parent *CreditEntry `json:"parent,omitempty"`
 	// Children holds the value of the children edge.
-	Children *CreditEntry `json:"children,omitempty"`
+	Children []*CreditEntry `json:"children,omitempty"`
 	// Feature holds the value of the feature edge.
 	Feature *Feature `json:"feature,omitempty"`
 	// loadedTypes holds the information for reporting if a
@@ -85,12 +89,10 @@ func (e CreditEntryEdges) ParentOrErr() (*CreditEntry, error) {
 }
 
 // ChildrenOrErr returns the Children value or an error if the edge
-// was not loaded in eager-loading, or loaded but was not found.
-func (e CreditEntryEdges) ChildrenOrErr() (*CreditEntry, error) {
-	if e.Children != nil {
+// was not loaded in eager-loading.
+func (e CreditEntryEdges) ChildrenOrErr() ([]*CreditEntry, error) {
+	if e.loadedTypes[1] {
 		return e.Children, nil
-	} else if e.loadedTypes[1] {
-		return nil, &NotFoundError{label: creditentry.Label}
 	}
 	return nil, &NotLoadedError{edge: "children"}
 }
@@ -117,7 +119,7 @@ func (*CreditEntry) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case creditentry.FieldPriority, creditentry.FieldExpirationPeriodCount:
 			values[i] = new(sql.NullInt64)
-		case creditentry.FieldID, creditentry.FieldNamespace, creditentry.FieldLedgerID, creditentry.FieldEntryType, creditentry.FieldType, creditentry.FieldFeatureID, creditentry.FieldExpirationPeriodDuration, creditentry.FieldRolloverType, creditentry.FieldParentID:
+		case creditentry.FieldID, creditentry.FieldNamespace, creditentry.FieldLedgerID, creditentry.FieldEntryType, creditentry.FieldType, creditentry.FieldFeatureID, creditentry.FieldCurrency, creditentry.FieldExpirationPeriodDuration, creditentry.FieldRolloverType, creditentry.FieldParentID, creditentry.FieldGrantScheduleID:
 			values[i] = new(sql.NullString)
 		case creditentry.FieldCreatedAt, creditentry.FieldUpdatedAt, creditentry.FieldEffectiveAt, creditentry.FieldExpirationAt:
 			values[i] = new(sql.NullTime)
@@ -193,6 +195,13 @@ func (ce *CreditEntry) assignValues(columns []string, values []any) error {
 				ce.Amount = new(float64)
 				*ce.Amount = value.Float64
 			}
+		case creditentry.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				ce.Currency = new(string)
+				*ce.Currency = value.String
+			}
 		case creditentry.FieldPriority:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field priority", values[i])
@@ -255,6 +264,13 @@ func (ce *CreditEntry) assignValues(columns []string, values []any) error {
 				ce.ParentID = new(string)
 				*ce.ParentID = value.String
 			}
+		case creditentry.FieldGrantScheduleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field grant_schedule_id", values[i])
+			} else if value.Valid {
+				ce.GrantScheduleID = new(string)
+				*ce.GrantScheduleID = value.String
+			}
 		default:
 			ce.selectValues.Set(columns[i], values[i])
 		}
@@ -336,6 +352,11 @@ func (ce *CreditEntry) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := ce.Currency; v != nil {
+		builder.WriteString("currency=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("priority=")
 	builder.WriteString(fmt.Sprintf("%v", ce.Priority))
 	builder.WriteString(", ")
@@ -374,6 +395,11 @@ func (ce *CreditEntry) String() string {
 		builder.WriteString("parent_id=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := ce.GrantScheduleID; v != nil {
+		builder.WriteString("grant_schedule_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -32,6 +32,8 @@ const (
 	FieldFeatureID = "feature_id"
 	// FieldAmount holds the string denoting the amount field in the database.
 	FieldAmount = "amount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
 	// FieldPriority holds the string denoting the priority field in the database.
 	FieldPriority = "priority"
 	// FieldEffectiveAt holds the string denoting the effective_at field in the database.
@@ -50,6 +52,8 @@ const (
 	FieldMetadata = "metadata"
 	// FieldParentID holds the string denoting the parent_id field in the database.
 	FieldParentID = "parent_id"
+	// FieldGrantScheduleID holds the string denoting the grant_schedule_id field in the database.
+	FieldGrantScheduleID = "grant_schedule_id"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
@@ -86,6 +90,7 @@ var Columns = []string{
 	FieldType,
 	FieldFeatureID,
 	FieldAmount,
+	FieldCurrency,
 	FieldPriority,
 	FieldEffectiveAt,
 	FieldExpirationPeriodDuration,
@@ -95,6 +100,7 @@ var Columns = []string{
 	FieldRolloverMaxAmount,
 	FieldMetadata,
 	FieldParentID,
+	FieldGrantScheduleID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -127,7 +133,7 @@ var (
 // EntryTypeValidator is a validator for the "entry_type" field enum values. It is called by the builders before save.
 func EntryTypeValidator(et credit.EntryType) error {
 	switch et {
-	case "GRANT", "VOID_GRANT", "RESET":
+	case "GRANT", "VOID_GRANT", "RESET", "AMEND_GRANT":
 		return nil
 	default:
 		return fmt.Errorf("creditentry: invalid enum value for entry_type field: %q", et)
@@ -212,6 +218,11 @@ func ByAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAmount, opts...).ToFunc()
 }
 
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
 // ByPriority orders the results by the priority field.
 func ByPriority(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPriority, opts...).ToFunc()
@@ -252,6 +263,11 @@ func ByParentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParentID, opts...).ToFunc()
 }
 
+// ByGrantScheduleID orders the results by the grant_schedule_id field.
+func ByGrantScheduleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGrantScheduleID, opts...).ToFunc()
+}
+
 // ByParentField orders the results by parent field.
 func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -259,10 +275,17 @@ func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
-// ByChildrenField orders the results by children field.
-func ByChildrenField(field string, opts ...sql.OrderTermOption) OrderOption {
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), sql.OrderByField(field, opts...))
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
 
@@ -276,14 +299,14 @@ func newParentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(Table, FieldID),
-		sqlgraph.Edge(sqlgraph.O2O, true, ParentTable, ParentColumn),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
 	)
 }
 func newChildrenStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(Table, FieldID),
-		sqlgraph.Edge(sqlgraph.O2O, false, ChildrenTable, ChildrenColumn),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
 	)
 }
 func newFeatureStep() *sqlgraph.Step {
@@ -96,6 +96,11 @@ func Amount(v float64) predicate.CreditEntry {
 	return predicate.CreditEntry(sql.FieldEQ(FieldAmount, v))
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldEQ(FieldCurrency, v))
+}
+
 // Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
 func Priority(v uint8) predicate.CreditEntry {
 	return predicate.CreditEntry(sql.FieldEQ(FieldPriority, v))
@@ -126,6 +131,11 @@ func ParentID(v string) predicate.CreditEntry {
 	return predicate.CreditEntry(sql.FieldEQ(FieldParentID, v))
 }
 
+// GrantScheduleID applies equality check predicate on the "grant_schedule_id" field. It's identical to GrantScheduleIDEQ.
+func GrantScheduleID(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldEQ(FieldGrantScheduleID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.CreditEntry {
 	return predicate.CreditEntry(sql.FieldEQ(FieldCreatedAt, v))
@@ -531,6 +541,81 @@ func AmountNotNil() predicate.CreditEntry {
 	return predicate.CreditEntry(sql.FieldNotNull(FieldAmount))
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldContainsFold(FieldCurrency, v))
+}
+
 // PriorityEQ applies the EQ predicate on the "priority" field.
 func PriorityEQ(v uint8) predicate.CreditEntry {
 	return predicate.CreditEntry(sql.FieldEQ(FieldPriority, v))
@@ -926,12 +1011,87 @@ func ParentIDContainsFold(v string) predicate.CreditEntry {
 	return predicate.CreditEntry(sql.FieldContainsFold(FieldParentID, v))
 }
 
+// GrantScheduleIDEQ applies the EQ predicate on the "grant_schedule_id" field.
+func GrantScheduleIDEQ(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldEQ(FieldGrantScheduleID, v))
+}
+
+// GrantScheduleIDNEQ applies the NEQ predicate on the "grant_schedule_id" field.
+func GrantScheduleIDNEQ(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldNEQ(FieldGrantScheduleID, v))
+}
+
+// GrantScheduleIDIn applies the In predicate on the "grant_schedule_id" field.
+func GrantScheduleIDIn(vs ...string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldIn(FieldGrantScheduleID, vs...))
+}
+
+// GrantScheduleIDNotIn applies the NotIn predicate on the "grant_schedule_id" field.
+func GrantScheduleIDNotIn(vs ...string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldNotIn(FieldGrantScheduleID, vs...))
+}
+
+// GrantScheduleIDGT applies the GT predicate on the "grant_schedule_id" field.
+func GrantScheduleIDGT(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldGT(FieldGrantScheduleID, v))
+}
+
+// GrantScheduleIDGTE applies the GTE predicate on the "grant_schedule_id" field.
+func GrantScheduleIDGTE(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldGTE(FieldGrantScheduleID, v))
+}
+
+// GrantScheduleIDLT applies the LT predicate on the "grant_schedule_id" field.
+func GrantScheduleIDLT(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldLT(FieldGrantScheduleID, v))
+}
+
+// GrantScheduleIDLTE applies the LTE predicate on the "grant_schedule_id" field.
+func GrantScheduleIDLTE(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldLTE(FieldGrantScheduleID, v))
+}
+
+// GrantScheduleIDContains applies the Contains predicate on the "grant_schedule_id" field.
+func GrantScheduleIDContains(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldContains(FieldGrantScheduleID, v))
+}
+
+// GrantScheduleIDHasPrefix applies the HasPrefix predicate on the "grant_schedule_id" field.
+func GrantScheduleIDHasPrefix(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldHasPrefix(FieldGrantScheduleID, v))
+}
+
+// GrantScheduleIDHasSuffix applies the HasSuffix predicate on the "grant_schedule_id" field.
+func GrantScheduleIDHasSuffix(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldHasSuffix(FieldGrantScheduleID, v))
+}
+
+// GrantScheduleIDIsNil applies the IsNil predicate on the "grant_schedule_id" field.
+func GrantScheduleIDIsNil() predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldIsNull(FieldGrantScheduleID))
+}
+
+// GrantScheduleIDNotNil applies the NotNil predicate on the "grant_schedule_id" field.
+func GrantScheduleIDNotNil() predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldNotNull(FieldGrantScheduleID))
+}
+
+// GrantScheduleIDEqualFold applies the EqualFold predicate on the "grant_schedule_id" field.
+func GrantScheduleIDEqualFold(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldEqualFold(FieldGrantScheduleID, v))
+}
+
+// GrantScheduleIDContainsFold applies the ContainsFold predicate on the "grant_schedule_id" field.
+func GrantScheduleIDContainsFold(v string) predicate.CreditEntry {
+	return predicate.CreditEntry(sql.FieldContainsFold(FieldGrantScheduleID, v))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.CreditEntry {
 	return predicate.CreditEntry(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, ParentTable, ParentColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -954,7 +1114,7 @@ func HasChildren() predicate.CreditEntry {
 	return predicate.CreditEntry(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, ChildrenTable, ChildrenColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -113,6 +113,20 @@ func (cec *CreditEntryCreate) SetNillableAmount(f *float64) *CreditEntryCreate {
 	return cec
 }
 
+// SetCurrency sets the "currency" field.
+func (cec *CreditEntryCreate) SetCurrency(s string) *CreditEntryCreate {
+	cec.mutation.SetCurrency(s)
+	return cec
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (cec *CreditEntryCreate) SetNillableCurrency(s *string) *CreditEntryCreate {
+	if s != nil {
+		cec.SetCurrency(*s)
+	}
+	return cec
+}
+
 // SetPriority sets the "priority" field.
 func (cec *CreditEntryCreate) SetPriority(u uint8) *CreditEntryCreate {
 	cec.mutation.SetPriority(u)
@@ -231,6 +245,20 @@ func (cec *CreditEntryCreate) SetNillableParentID(s *string) *CreditEntryCreate
 	return cec
 }
 
+// SetGrantScheduleID sets the "grant_schedule_id" field.
+func (cec *CreditEntryCreate) SetGrantScheduleID(s string) *CreditEntryCreate {
+	cec.mutation.SetGrantScheduleID(s)
+	return cec
+}
+
+// SetNillableGrantScheduleID sets the "grant_schedule_id" field if the given value is not nil.
+func (cec *CreditEntryCreate) SetNillableGrantScheduleID(s *string) *CreditEntryCreate {
+	if s != nil {
+		cec.SetGrantScheduleID(*s)
+	}
+	return cec
+}
+
 // SetID sets the "id" field.
 func (cec *CreditEntryCreate) SetID(s string) *CreditEntryCreate {
 	cec.mutation.SetID(s)
@@ -250,23 +278,19 @@ func (cec *CreditEntryCreate) SetParent(c *CreditEntry) *CreditEntryCreate {
 	return cec.SetParentID(c.ID)
 }
 
-// SetChildrenID sets the "children" edge to the CreditEntry entity by ID.
-func (cec *CreditEntryCreate) SetChildrenID(id string) *CreditEntryCreate {
-	cec.mutation.SetChildrenID(id)
+// AddChildIDs adds the "children" edge to the CreditEntry entity by IDs.
+func (cec *CreditEntryCreate) AddChildIDs(ids ...string) *CreditEntryCreate {
+	cec.mutation.AddChildIDs(ids...)
 	return cec
 }
 
-// SetNillableChildrenID sets the "children" edge to the CreditEntry entity by ID if the given value is not nil.
-func (cec *CreditEntryCreate) SetNillableChildrenID(id *string) *CreditEntryCreate {
-	if id != nil {
-		cec = cec.SetChildrenID(*id)
+// AddChildren adds the "children" edges to the CreditEntry entity.
+func (cec *CreditEntryCreate) AddChildren(c ...*CreditEntry) *CreditEntryCreate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
-	return cec
-}
-
-// SetChildren sets the "children" edge to the CreditEntry entity.
-func (cec *CreditEntryCreate) SetChildren(c *CreditEntry) *CreditEntryCreate {
-	return cec.SetChildrenID(c.ID)
+	return cec.AddChildIDs(ids...)
 }
 
 // SetFeature sets the "feature" edge to the Feature entity.
@@ -443,6 +467,10 @@ func (cec *CreditEntryCreate) createSpec() (*CreditEntry, *sqlgraph.CreateSpec)
 		_spec.SetField(creditentry.FieldAmount, field.TypeFloat64, value)
 		_node.Amount = &value
 	}
+	if value, ok := cec.mutation.Currency(); ok {
+		_spec.SetField(creditentry.FieldCurrency, field.TypeString, value)
+		_node.Currency = &value
+	}
 	if value, ok := cec.mutation.Priority(); ok {
 		_spec.SetField(creditentry.FieldPriority, field.TypeUint8, value)
 		_node.Priority = value
@@ -475,9 +503,13 @@ func (cec *CreditEntryCreate) createSpec() (*CreditEntry, *sqlgraph.CreateSpec)
 		_spec.SetField(creditentry.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := cec.mutation.GrantScheduleID(); ok {
+		_spec.SetField(creditentry.FieldGrantScheduleID, field.TypeString, value)
+		_node.GrantScheduleID = &value
+	}
 	if nodes := cec.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   creditentry.ParentTable,
 			Columns: []string{creditentry.ParentColumn},
@@ -494,7 +526,7 @@ func (cec *CreditEntryCreate) createSpec() (*CreditEntry, *sqlgraph.CreateSpec)
 	}
 	if nodes := cec.mutation.ChildrenIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   creditentry.ChildrenTable,
 			Columns: []string{creditentry.ChildrenColumn},
@@ -645,6 +677,9 @@ func (u *CreditEntryUpsertOne) UpdateNewValues() *CreditEntryUpsertOne {
 		if _, exists := u.create.mutation.Amount(); exists {
 			s.SetIgnore(creditentry.FieldAmount)
 		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(creditentry.FieldCurrency)
+		}
 		if _, exists := u.create.mutation.Priority(); exists {
 			s.SetIgnore(creditentry.FieldPriority)
 		}
@@ -669,6 +704,9 @@ func (u *CreditEntryUpsertOne) UpdateNewValues() *CreditEntryUpsertOne {
 		if _, exists := u.create.mutation.ParentID(); exists {
 			s.SetIgnore(creditentry.FieldParentID)
 		}
+		if _, exists := u.create.mutation.GrantScheduleID(); exists {
+			s.SetIgnore(creditentry.FieldGrantScheduleID)
+		}
 	}))
 	return u
 }
@@ -939,6 +977,9 @@ func (u *CreditEntryUpsertBulk) UpdateNewValues() *CreditEntryUpsertBulk {
 			if _, exists := b.mutation.Amount(); exists {
 				s.SetIgnore(creditentry.FieldAmount)
 			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(creditentry.FieldCurrency)
+			}
 			if _, exists := b.mutation.Priority(); exists {
 				s.SetIgnore(creditentry.FieldPriority)
 			}
@@ -963,6 +1004,9 @@ func (u *CreditEntryUpsertBulk) UpdateNewValues() *CreditEntryUpsertBulk {
 			if _, exists := b.mutation.ParentID(); exists {
 				s.SetIgnore(creditentry.FieldParentID)
 			}
+			if _, exists := b.mutation.GrantScheduleID(); exists {
+				s.SetIgnore(creditentry.FieldGrantScheduleID)
+			}
 		}
 	}))
 	return u
@@ -78,7 +78,7 @@ func (ceq *CreditEntryQuery) QueryParent() *CreditEntryQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(creditentry.Table, creditentry.FieldID, selector),
 			sqlgraph.To(creditentry.Table, creditentry.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, creditentry.ParentTable, creditentry.ParentColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, creditentry.ParentTable, creditentry.ParentColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ceq.driver.Dialect(), step)
 		return fromU, nil
@@ -100,7 +100,7 @@ func (ceq *CreditEntryQuery) QueryChildren() *CreditEntryQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(creditentry.Table, creditentry.FieldID, selector),
 			sqlgraph.To(creditentry.Table, creditentry.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, creditentry.ChildrenTable, creditentry.ChildrenColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, creditentry.ChildrenTable, creditentry.ChildrenColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(ceq.driver.Dialect(), step)
 		return fromU, nil
@@ -476,8 +476,9 @@ func (ceq *CreditEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		}
 	}
 	if query := ceq.withChildren; query != nil {
-		if err := ceq.loadChildren(ctx, query, nodes, nil,
-			func(n *CreditEntry, e *CreditEntry) { n.Edges.Children = e }); err != nil {
+		if err := ceq.loadChildren(ctx, query, nodes,
+			func(n *CreditEntry) { n.Edges.Children = []*CreditEntry{} },
+			func(n *CreditEntry, e *CreditEntry) { n.Edges.Children = append(n.Edges.Children, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -528,6 +529,9 @@ func (ceq *CreditEntryQuery) loadChildren(ctx context.Context, query *CreditEntr
 	for i := range nodes {
 		fks = append(fks, nodes[i].ID)
 		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(creditentry.FieldParentID)
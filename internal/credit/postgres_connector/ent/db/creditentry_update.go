@@ -46,23 +46,19 @@ func (ceu *CreditEntryUpdate) ClearMetadata() *CreditEntryUpdate {
 	return ceu
 }
 
-// SetChildrenID sets the "children" edge to the CreditEntry entity by ID.
-func (ceu *CreditEntryUpdate) SetChildrenID(id string) *CreditEntryUpdate {
-	ceu.mutation.SetChildrenID(id)
+// AddChildIDs adds the "children" edge to the CreditEntry entity by IDs.
+func (ceu *CreditEntryUpdate) AddChildIDs(ids ...string) *CreditEntryUpdate {
+	ceu.mutation.AddChildIDs(ids...)
 	return ceu
 }
 
-// SetNillableChildrenID sets the "children" edge to the CreditEntry entity by ID if the given value is not nil.
-func (ceu *CreditEntryUpdate) SetNillableChildrenID(id *string) *CreditEntryUpdate {
-	if id != nil {
-		ceu = ceu.SetChildrenID(*id)
+// AddChildren adds the "children" edges to the CreditEntry entity.
+func (ceu *CreditEntryUpdate) AddChildren(c ...*CreditEntry) *CreditEntryUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
-	return ceu
-}
-
-// SetChildren sets the "children" edge to the CreditEntry entity.
-func (ceu *CreditEntryUpdate) SetChildren(c *CreditEntry) *CreditEntryUpdate {
-	return ceu.SetChildrenID(c.ID)
+	return ceu.AddChildIDs(ids...)
 }
 
 // Mutation returns the CreditEntryMutation object of the builder.
@@ -70,12 +66,27 @@ func (ceu *CreditEntryUpdate) Mutation() *CreditEntryMutation {
 	return ceu.mutation
 }
 
-// ClearChildren clears the "children" edge to the CreditEntry entity.
+// ClearChildren clears all "children" edges to the CreditEntry entity.
 func (ceu *CreditEntryUpdate) ClearChildren() *CreditEntryUpdate {
 	ceu.mutation.ClearChildren()
 	return ceu
 }
 
+// RemoveChildIDs removes the "children" edge to CreditEntry entities by IDs.
+func (ceu *CreditEntryUpdate) RemoveChildIDs(ids ...string) *CreditEntryUpdate {
+	ceu.mutation.RemoveChildIDs(ids...)
+	return ceu
+}
+
+// RemoveChildren removes "children" edges to CreditEntry entities.
+func (ceu *CreditEntryUpdate) RemoveChildren(c ...*CreditEntry) *CreditEntryUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ceu.RemoveChildIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ceu *CreditEntryUpdate) Save(ctx context.Context) (int, error) {
 	ceu.defaults()
@@ -130,6 +141,9 @@ func (ceu *CreditEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ceu.mutation.AmountCleared() {
 		_spec.ClearField(creditentry.FieldAmount, field.TypeFloat64)
 	}
+	if ceu.mutation.CurrencyCleared() {
+		_spec.ClearField(creditentry.FieldCurrency, field.TypeString)
+	}
 	if ceu.mutation.ExpirationPeriodDurationCleared() {
 		_spec.ClearField(creditentry.FieldExpirationPeriodDuration, field.TypeEnum)
 	}
@@ -151,9 +165,12 @@ func (ceu *CreditEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ceu.mutation.MetadataCleared() {
 		_spec.ClearField(creditentry.FieldMetadata, field.TypeJSON)
 	}
+	if ceu.mutation.GrantScheduleIDCleared() {
+		_spec.ClearField(creditentry.FieldGrantScheduleID, field.TypeString)
+	}
 	if ceu.mutation.ChildrenCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   creditentry.ChildrenTable,
 			Columns: []string{creditentry.ChildrenColumn},
@@ -164,9 +181,25 @@ func (ceu *CreditEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
+	if nodes := ceu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !ceu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   creditentry.ChildrenTable,
+			Columns: []string{creditentry.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditentry.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
 	if nodes := ceu.mutation.ChildrenIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   creditentry.ChildrenTable,
 			Columns: []string{creditentry.ChildrenColumn},
@@ -218,23 +251,19 @@ func (ceuo *CreditEntryUpdateOne) ClearMetadata() *CreditEntryUpdateOne {
 	return ceuo
 }
 
-// SetChildrenID sets the "children" edge to the CreditEntry entity by ID.
-func (ceuo *CreditEntryUpdateOne) SetChildrenID(id string) *CreditEntryUpdateOne {
-	ceuo.mutation.SetChildrenID(id)
+// AddChildIDs adds the "children" edge to the CreditEntry entity by IDs.
+func (ceuo *CreditEntryUpdateOne) AddChildIDs(ids ...string) *CreditEntryUpdateOne {
+	ceuo.mutation.AddChildIDs(ids...)
 	return ceuo
 }
 
-// SetNillableChildrenID sets the "children" edge to the CreditEntry entity by ID if the given value is not nil.
-func (ceuo *CreditEntryUpdateOne) SetNillableChildrenID(id *string) *CreditEntryUpdateOne {
-	if id != nil {
-		ceuo = ceuo.SetChildrenID(*id)
+// AddChildren adds the "children" edges to the CreditEntry entity.
+func (ceuo *CreditEntryUpdateOne) AddChildren(c ...*CreditEntry) *CreditEntryUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
-	return ceuo
-}
-
-// SetChildren sets the "children" edge to the CreditEntry entity.
-func (ceuo *CreditEntryUpdateOne) SetChildren(c *CreditEntry) *CreditEntryUpdateOne {
-	return ceuo.SetChildrenID(c.ID)
+	return ceuo.AddChildIDs(ids...)
 }
 
 // Mutation returns the CreditEntryMutation object of the builder.
@@ -242,12 +271,27 @@ func (ceuo *CreditEntryUpdateOne) Mutation() *CreditEntryMutation {
 	return ceuo.mutation
 }
 
-// ClearChildren clears the "children" edge to the CreditEntry entity.
+// ClearChildren clears all "children" edges to the CreditEntry entity.
 func (ceuo *CreditEntryUpdateOne) ClearChildren() *CreditEntryUpdateOne {
 	ceuo.mutation.ClearChildren()
 	return ceuo
 }
 
+// RemoveChildIDs removes the "children" edge to CreditEntry entities by IDs.
+func (ceuo *CreditEntryUpdateOne) RemoveChildIDs(ids ...string) *CreditEntryUpdateOne {
+	ceuo.mutation.RemoveChildIDs(ids...)
+	return ceuo
+}
+
+// RemoveChildren removes "children" edges to CreditEntry entities.
+func (ceuo *CreditEntryUpdateOne) RemoveChildren(c ...*CreditEntry) *CreditEntryUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ceuo.RemoveChildIDs(ids...)
+}
+
 // Where appends a list predicates to the CreditEntryUpdate builder.
 func (ceuo *CreditEntryUpdateOne) Where(ps ...predicate.CreditEntry) *CreditEntryUpdateOne {
 	ceuo.mutation.Where(ps...)
@@ -332,6 +376,9 @@ func (ceuo *CreditEntryUpdateOne) sqlSave(ctx context.Context) (_node *CreditEnt
 	if ceuo.mutation.AmountCleared() {
 		_spec.ClearField(creditentry.FieldAmount, field.TypeFloat64)
 	}
+	if ceuo.mutation.CurrencyCleared() {
+		_spec.ClearField(creditentry.FieldCurrency, field.TypeString)
+	}
 	if ceuo.mutation.ExpirationPeriodDurationCleared() {
 		_spec.ClearField(creditentry.FieldExpirationPeriodDuration, field.TypeEnum)
 	}
@@ -353,9 +400,12 @@ func (ceuo *CreditEntryUpdateOne) sqlSave(ctx context.Context) (_node *CreditEnt
 	if ceuo.mutation.MetadataCleared() {
 		_spec.ClearField(creditentry.FieldMetadata, field.TypeJSON)
 	}
+	if ceuo.mutation.GrantScheduleIDCleared() {
+		_spec.ClearField(creditentry.FieldGrantScheduleID, field.TypeString)
+	}
 	if ceuo.mutation.ChildrenCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   creditentry.ChildrenTable,
 			Columns: []string{creditentry.ChildrenColumn},
@@ -366,9 +416,25 @@ func (ceuo *CreditEntryUpdateOne) sqlSave(ctx context.Context) (_node *CreditEnt
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
+	if nodes := ceuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !ceuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   creditentry.ChildrenTable,
+			Columns: []string{creditentry.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(creditentry.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
 	if nodes := ceuo.mutation.ChildrenIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   creditentry.ChildrenTable,
 			Columns: []string{creditentry.ChildrenColumn},
@@ -12,9 +12,15 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/balancesnapshot"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/creditentry"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/eventtype"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/feature"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/grantschedule"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/ledger"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationdelivery"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationrule"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/subjectalias"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -75,9 +81,15 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			creditentry.Table: creditentry.ValidColumn,
-			feature.Table:     feature.ValidColumn,
-			ledger.Table:      ledger.ValidColumn,
+			balancesnapshot.Table:      balancesnapshot.ValidColumn,
+			creditentry.Table:          creditentry.ValidColumn,
+			eventtype.Table:            eventtype.ValidColumn,
+			feature.Table:              feature.ValidColumn,
+			grantschedule.Table:        grantschedule.ValidColumn,
+			ledger.Table:               ledger.ValidColumn,
+			notificationdelivery.Table: notificationdelivery.ValidColumn,
+			notificationrule.Table:     notificationrule.ValidColumn,
+			subjectalias.Table:         subjectalias.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/eventtype"
+)
+
+// EventType is the model entity for the EventType schema.
+type EventType struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// Schema holds the value of the "schema" field.
+	Schema       map[string]interface{} `json:"schema,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EventType) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case eventtype.FieldSchema:
+			values[i] = new([]byte)
+		case eventtype.FieldID, eventtype.FieldNamespace, eventtype.FieldType, eventtype.FieldDescription:
+			values[i] = new(sql.NullString)
+		case eventtype.FieldCreatedAt, eventtype.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EventType fields.
+func (et *EventType) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case eventtype.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				et.ID = value.String
+			}
+		case eventtype.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				et.CreatedAt = value.Time
+			}
+		case eventtype.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				et.UpdatedAt = value.Time
+			}
+		case eventtype.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				et.Namespace = value.String
+			}
+		case eventtype.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				et.Type = value.String
+			}
+		case eventtype.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				et.Description = new(string)
+				*et.Description = value.String
+			}
+		case eventtype.FieldSchema:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field schema", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &et.Schema); err != nil {
+					return fmt.Errorf("unmarshal field schema: %w", err)
+				}
+			}
+		default:
+			et.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EventType.
+// This includes values selected through modifiers, order, etc.
+func (et *EventType) Value(name string) (ent.Value, error) {
+	return et.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EventType.
+// Note that you need to call EventType.Unwrap() before calling this method if this EventType
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (et *EventType) Update() *EventTypeUpdateOne {
+	return NewEventTypeClient(et.config).UpdateOne(et)
+}
+
+// Unwrap unwraps the EventType entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (et *EventType) Unwrap() *EventType {
+	_tx, ok := et.config.driver.(*txDriver)
+	if !ok {
+		panic("db: EventType is not a transactional entity")
+	}
+	et.config.driver = _tx.drv
+	return et
+}
+
+// String implements the fmt.Stringer.
+func (et *EventType) String() string {
+	var builder strings.Builder
+	builder.WriteString("EventType(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", et.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(et.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(et.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(et.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(et.Type)
+	builder.WriteString(", ")
+	if v := et.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("schema=")
+	builder.WriteString(fmt.Sprintf("%v", et.Schema))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EventTypes is a parsable slice of EventType.
+type EventTypes []*EventType
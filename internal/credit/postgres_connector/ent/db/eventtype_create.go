@@ -0,0 +1,730 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/eventtype"
+)
+
+// EventTypeCreate is the builder for creating a EventType entity.
+type EventTypeCreate struct {
+	config
+	mutation *EventTypeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (etc *EventTypeCreate) SetCreatedAt(t time.Time) *EventTypeCreate {
+	etc.mutation.SetCreatedAt(t)
+	return etc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (etc *EventTypeCreate) SetNillableCreatedAt(t *time.Time) *EventTypeCreate {
+	if t != nil {
+		etc.SetCreatedAt(*t)
+	}
+	return etc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (etc *EventTypeCreate) SetUpdatedAt(t time.Time) *EventTypeCreate {
+	etc.mutation.SetUpdatedAt(t)
+	return etc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (etc *EventTypeCreate) SetNillableUpdatedAt(t *time.Time) *EventTypeCreate {
+	if t != nil {
+		etc.SetUpdatedAt(*t)
+	}
+	return etc
+}
+
+// SetNamespace sets the "namespace" field.
+func (etc *EventTypeCreate) SetNamespace(s string) *EventTypeCreate {
+	etc.mutation.SetNamespace(s)
+	return etc
+}
+
+// SetType sets the "type" field.
+func (etc *EventTypeCreate) SetType(s string) *EventTypeCreate {
+	etc.mutation.SetType(s)
+	return etc
+}
+
+// SetDescription sets the "description" field.
+func (etc *EventTypeCreate) SetDescription(s string) *EventTypeCreate {
+	etc.mutation.SetDescription(s)
+	return etc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (etc *EventTypeCreate) SetNillableDescription(s *string) *EventTypeCreate {
+	if s != nil {
+		etc.SetDescription(*s)
+	}
+	return etc
+}
+
+// SetSchema sets the "schema" field.
+func (etc *EventTypeCreate) SetSchema(m map[string]interface{}) *EventTypeCreate {
+	etc.mutation.SetSchema(m)
+	return etc
+}
+
+// SetID sets the "id" field.
+func (etc *EventTypeCreate) SetID(s string) *EventTypeCreate {
+	etc.mutation.SetID(s)
+	return etc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (etc *EventTypeCreate) SetNillableID(s *string) *EventTypeCreate {
+	if s != nil {
+		etc.SetID(*s)
+	}
+	return etc
+}
+
+// Mutation returns the EventTypeMutation object of the builder.
+func (etc *EventTypeCreate) Mutation() *EventTypeMutation {
+	return etc.mutation
+}
+
+// Save creates the EventType in the database.
+func (etc *EventTypeCreate) Save(ctx context.Context) (*EventType, error) {
+	etc.defaults()
+	return withHooks(ctx, etc.sqlSave, etc.mutation, etc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (etc *EventTypeCreate) SaveX(ctx context.Context) *EventType {
+	v, err := etc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (etc *EventTypeCreate) Exec(ctx context.Context) error {
+	_, err := etc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (etc *EventTypeCreate) ExecX(ctx context.Context) {
+	if err := etc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (etc *EventTypeCreate) defaults() {
+	if _, ok := etc.mutation.CreatedAt(); !ok {
+		v := eventtype.DefaultCreatedAt()
+		etc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := etc.mutation.UpdatedAt(); !ok {
+		v := eventtype.DefaultUpdatedAt()
+		etc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := etc.mutation.ID(); !ok {
+		v := eventtype.DefaultID()
+		etc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (etc *EventTypeCreate) check() error {
+	if _, ok := etc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "EventType.created_at"`)}
+	}
+	if _, ok := etc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "EventType.updated_at"`)}
+	}
+	if _, ok := etc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`db: missing required field "EventType.namespace"`)}
+	}
+	if v, ok := etc.mutation.Namespace(); ok {
+		if err := eventtype.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`db: validator failed for field "EventType.namespace": %w`, err)}
+		}
+	}
+	if _, ok := etc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`db: missing required field "EventType.type"`)}
+	}
+	if v, ok := etc.mutation.GetType(); ok {
+		if err := eventtype.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`db: validator failed for field "EventType.type": %w`, err)}
+		}
+	}
+	if _, ok := etc.mutation.Schema(); !ok {
+		return &ValidationError{Name: "schema", err: errors.New(`db: missing required field "EventType.schema"`)}
+	}
+	return nil
+}
+
+func (etc *EventTypeCreate) sqlSave(ctx context.Context) (*EventType, error) {
+	if err := etc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := etc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, etc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected EventType.ID type: %T", _spec.ID.Value)
+		}
+	}
+	etc.mutation.id = &_node.ID
+	etc.mutation.done = true
+	return _node, nil
+}
+
+func (etc *EventTypeCreate) createSpec() (*EventType, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventType{config: etc.config}
+		_spec = sqlgraph.NewCreateSpec(eventtype.Table, sqlgraph.NewFieldSpec(eventtype.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = etc.conflict
+	if id, ok := etc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := etc.mutation.CreatedAt(); ok {
+		_spec.SetField(eventtype.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := etc.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventtype.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := etc.mutation.Namespace(); ok {
+		_spec.SetField(eventtype.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := etc.mutation.GetType(); ok {
+		_spec.SetField(eventtype.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := etc.mutation.Description(); ok {
+		_spec.SetField(eventtype.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := etc.mutation.Schema(); ok {
+		_spec.SetField(eventtype.FieldSchema, field.TypeJSON, value)
+		_node.Schema = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EventType.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventTypeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (etc *EventTypeCreate) OnConflict(opts ...sql.ConflictOption) *EventTypeUpsertOne {
+	etc.conflict = opts
+	return &EventTypeUpsertOne{
+		create: etc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EventType.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (etc *EventTypeCreate) OnConflictColumns(columns ...string) *EventTypeUpsertOne {
+	etc.conflict = append(etc.conflict, sql.ConflictColumns(columns...))
+	return &EventTypeUpsertOne{
+		create: etc,
+	}
+}
+
+type (
+	// EventTypeUpsertOne is the builder for "upsert"-ing
+	//  one EventType node.
+	EventTypeUpsertOne struct {
+		create *EventTypeCreate
+	}
+
+	// EventTypeUpsert is the "OnConflict" setter.
+	EventTypeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EventTypeUpsert) SetUpdatedAt(v time.Time) *EventTypeUpsert {
+	u.Set(eventtype.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EventTypeUpsert) UpdateUpdatedAt() *EventTypeUpsert {
+	u.SetExcluded(eventtype.FieldUpdatedAt)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *EventTypeUpsert) SetDescription(v string) *EventTypeUpsert {
+	u.Set(eventtype.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventTypeUpsert) UpdateDescription() *EventTypeUpsert {
+	u.SetExcluded(eventtype.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *EventTypeUpsert) ClearDescription() *EventTypeUpsert {
+	u.SetNull(eventtype.FieldDescription)
+	return u
+}
+
+// SetSchema sets the "schema" field.
+func (u *EventTypeUpsert) SetSchema(v map[string]interface{}) *EventTypeUpsert {
+	u.Set(eventtype.FieldSchema, v)
+	return u
+}
+
+// UpdateSchema sets the "schema" field to the value that was provided on create.
+func (u *EventTypeUpsert) UpdateSchema() *EventTypeUpsert {
+	u.SetExcluded(eventtype.FieldSchema)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EventType.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(eventtype.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EventTypeUpsertOne) UpdateNewValues() *EventTypeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(eventtype.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(eventtype.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Namespace(); exists {
+			s.SetIgnore(eventtype.FieldNamespace)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(eventtype.FieldType)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EventType.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EventTypeUpsertOne) Ignore() *EventTypeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventTypeUpsertOne) DoNothing() *EventTypeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventTypeCreate.OnConflict
+// documentation for more info.
+func (u *EventTypeUpsertOne) Update(set func(*EventTypeUpsert)) *EventTypeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventTypeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EventTypeUpsertOne) SetUpdatedAt(v time.Time) *EventTypeUpsertOne {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EventTypeUpsertOne) UpdateUpdatedAt() *EventTypeUpsertOne {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *EventTypeUpsertOne) SetDescription(v string) *EventTypeUpsertOne {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventTypeUpsertOne) UpdateDescription() *EventTypeUpsertOne {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *EventTypeUpsertOne) ClearDescription() *EventTypeUpsertOne {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSchema sets the "schema" field.
+func (u *EventTypeUpsertOne) SetSchema(v map[string]interface{}) *EventTypeUpsertOne {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.SetSchema(v)
+	})
+}
+
+// UpdateSchema sets the "schema" field to the value that was provided on create.
+func (u *EventTypeUpsertOne) UpdateSchema() *EventTypeUpsertOne {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.UpdateSchema()
+	})
+}
+
+// Exec executes the query.
+func (u *EventTypeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for EventTypeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventTypeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EventTypeUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: EventTypeUpsertOne.ID is not supported by MySQL driver. Use EventTypeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EventTypeUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EventTypeCreateBulk is the builder for creating many EventType entities in bulk.
+type EventTypeCreateBulk struct {
+	config
+	err      error
+	builders []*EventTypeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EventType entities in the database.
+func (etcb *EventTypeCreateBulk) Save(ctx context.Context) ([]*EventType, error) {
+	if etcb.err != nil {
+		return nil, etcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(etcb.builders))
+	nodes := make([]*EventType, len(etcb.builders))
+	mutators := make([]Mutator, len(etcb.builders))
+	for i := range etcb.builders {
+		func(i int, root context.Context) {
+			builder := etcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventTypeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, etcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = etcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, etcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, etcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (etcb *EventTypeCreateBulk) SaveX(ctx context.Context) []*EventType {
+	v, err := etcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (etcb *EventTypeCreateBulk) Exec(ctx context.Context) error {
+	_, err := etcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (etcb *EventTypeCreateBulk) ExecX(ctx context.Context) {
+	if err := etcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EventType.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventTypeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (etcb *EventTypeCreateBulk) OnConflict(opts ...sql.ConflictOption) *EventTypeUpsertBulk {
+	etcb.conflict = opts
+	return &EventTypeUpsertBulk{
+		create: etcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EventType.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (etcb *EventTypeCreateBulk) OnConflictColumns(columns ...string) *EventTypeUpsertBulk {
+	etcb.conflict = append(etcb.conflict, sql.ConflictColumns(columns...))
+	return &EventTypeUpsertBulk{
+		create: etcb,
+	}
+}
+
+// EventTypeUpsertBulk is the builder for "upsert"-ing
+// a bulk of EventType nodes.
+type EventTypeUpsertBulk struct {
+	create *EventTypeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EventType.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(eventtype.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EventTypeUpsertBulk) UpdateNewValues() *EventTypeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(eventtype.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(eventtype.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Namespace(); exists {
+				s.SetIgnore(eventtype.FieldNamespace)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(eventtype.FieldType)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EventType.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EventTypeUpsertBulk) Ignore() *EventTypeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventTypeUpsertBulk) DoNothing() *EventTypeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventTypeCreateBulk.OnConflict
+// documentation for more info.
+func (u *EventTypeUpsertBulk) Update(set func(*EventTypeUpsert)) *EventTypeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventTypeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EventTypeUpsertBulk) SetUpdatedAt(v time.Time) *EventTypeUpsertBulk {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EventTypeUpsertBulk) UpdateUpdatedAt() *EventTypeUpsertBulk {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *EventTypeUpsertBulk) SetDescription(v string) *EventTypeUpsertBulk {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventTypeUpsertBulk) UpdateDescription() *EventTypeUpsertBulk {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *EventTypeUpsertBulk) ClearDescription() *EventTypeUpsertBulk {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSchema sets the "schema" field.
+func (u *EventTypeUpsertBulk) SetSchema(v map[string]interface{}) *EventTypeUpsertBulk {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.SetSchema(v)
+	})
+}
+
+// UpdateSchema sets the "schema" field to the value that was provided on create.
+func (u *EventTypeUpsertBulk) UpdateSchema() *EventTypeUpsertBulk {
+	return u.Update(func(s *EventTypeUpsert) {
+		s.UpdateSchema()
+	})
+}
+
+// Exec executes the query.
+func (u *EventTypeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the EventTypeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for EventTypeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventTypeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/eventtype"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+)
+
+// EventTypeUpdate is the builder for updating EventType entities.
+type EventTypeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventTypeMutation
+}
+
+// Where appends a list predicates to the EventTypeUpdate builder.
+func (etu *EventTypeUpdate) Where(ps ...predicate.EventType) *EventTypeUpdate {
+	etu.mutation.Where(ps...)
+	return etu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (etu *EventTypeUpdate) SetUpdatedAt(t time.Time) *EventTypeUpdate {
+	etu.mutation.SetUpdatedAt(t)
+	return etu
+}
+
+// SetDescription sets the "description" field.
+func (etu *EventTypeUpdate) SetDescription(s string) *EventTypeUpdate {
+	etu.mutation.SetDescription(s)
+	return etu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (etu *EventTypeUpdate) SetNillableDescription(s *string) *EventTypeUpdate {
+	if s != nil {
+		etu.SetDescription(*s)
+	}
+	return etu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (etu *EventTypeUpdate) ClearDescription() *EventTypeUpdate {
+	etu.mutation.ClearDescription()
+	return etu
+}
+
+// SetSchema sets the "schema" field.
+func (etu *EventTypeUpdate) SetSchema(m map[string]interface{}) *EventTypeUpdate {
+	etu.mutation.SetSchema(m)
+	return etu
+}
+
+// Mutation returns the EventTypeMutation object of the builder.
+func (etu *EventTypeUpdate) Mutation() *EventTypeMutation {
+	return etu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (etu *EventTypeUpdate) Save(ctx context.Context) (int, error) {
+	etu.defaults()
+	return withHooks(ctx, etu.sqlSave, etu.mutation, etu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (etu *EventTypeUpdate) SaveX(ctx context.Context) int {
+	affected, err := etu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (etu *EventTypeUpdate) Exec(ctx context.Context) error {
+	_, err := etu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (etu *EventTypeUpdate) ExecX(ctx context.Context) {
+	if err := etu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (etu *EventTypeUpdate) defaults() {
+	if _, ok := etu.mutation.UpdatedAt(); !ok {
+		v := eventtype.UpdateDefaultUpdatedAt()
+		etu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (etu *EventTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventtype.Table, eventtype.Columns, sqlgraph.NewFieldSpec(eventtype.FieldID, field.TypeString))
+	if ps := etu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := etu.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventtype.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := etu.mutation.Description(); ok {
+		_spec.SetField(eventtype.FieldDescription, field.TypeString, value)
+	}
+	if etu.mutation.DescriptionCleared() {
+		_spec.ClearField(eventtype.FieldDescription, field.TypeString)
+	}
+	if value, ok := etu.mutation.Schema(); ok {
+		_spec.SetField(eventtype.FieldSchema, field.TypeJSON, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, etu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventtype.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	etu.mutation.done = true
+	return n, nil
+}
+
+// EventTypeUpdateOne is the builder for updating a single EventType entity.
+type EventTypeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventTypeMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (etuo *EventTypeUpdateOne) SetUpdatedAt(t time.Time) *EventTypeUpdateOne {
+	etuo.mutation.SetUpdatedAt(t)
+	return etuo
+}
+
+// SetDescription sets the "description" field.
+func (etuo *EventTypeUpdateOne) SetDescription(s string) *EventTypeUpdateOne {
+	etuo.mutation.SetDescription(s)
+	return etuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (etuo *EventTypeUpdateOne) SetNillableDescription(s *string) *EventTypeUpdateOne {
+	if s != nil {
+		etuo.SetDescription(*s)
+	}
+	return etuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (etuo *EventTypeUpdateOne) ClearDescription() *EventTypeUpdateOne {
+	etuo.mutation.ClearDescription()
+	return etuo
+}
+
+// SetSchema sets the "schema" field.
+func (etuo *EventTypeUpdateOne) SetSchema(m map[string]interface{}) *EventTypeUpdateOne {
+	etuo.mutation.SetSchema(m)
+	return etuo
+}
+
+// Mutation returns the EventTypeMutation object of the builder.
+func (etuo *EventTypeUpdateOne) Mutation() *EventTypeMutation {
+	return etuo.mutation
+}
+
+// Where appends a list predicates to the EventTypeUpdate builder.
+func (etuo *EventTypeUpdateOne) Where(ps ...predicate.EventType) *EventTypeUpdateOne {
+	etuo.mutation.Where(ps...)
+	return etuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (etuo *EventTypeUpdateOne) Select(field string, fields ...string) *EventTypeUpdateOne {
+	etuo.fields = append([]string{field}, fields...)
+	return etuo
+}
+
+// Save executes the query and returns the updated EventType entity.
+func (etuo *EventTypeUpdateOne) Save(ctx context.Context) (*EventType, error) {
+	etuo.defaults()
+	return withHooks(ctx, etuo.sqlSave, etuo.mutation, etuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (etuo *EventTypeUpdateOne) SaveX(ctx context.Context) *EventType {
+	node, err := etuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (etuo *EventTypeUpdateOne) Exec(ctx context.Context) error {
+	_, err := etuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (etuo *EventTypeUpdateOne) ExecX(ctx context.Context) {
+	if err := etuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (etuo *EventTypeUpdateOne) defaults() {
+	if _, ok := etuo.mutation.UpdatedAt(); !ok {
+		v := eventtype.UpdateDefaultUpdatedAt()
+		etuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (etuo *EventTypeUpdateOne) sqlSave(ctx context.Context) (_node *EventType, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventtype.Table, eventtype.Columns, sqlgraph.NewFieldSpec(eventtype.FieldID, field.TypeString))
+	id, ok := etuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "EventType.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := etuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventtype.FieldID)
+		for _, f := range fields {
+			if !eventtype.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != eventtype.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := etuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := etuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventtype.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := etuo.mutation.Description(); ok {
+		_spec.SetField(eventtype.FieldDescription, field.TypeString, value)
+	}
+	if etuo.mutation.DescriptionCleared() {
+		_spec.ClearField(eventtype.FieldDescription, field.TypeString)
+	}
+	if value, ok := etuo.mutation.Schema(); ok {
+		_spec.SetField(eventtype.FieldSchema, field.TypeJSON, value)
+	}
+	_node = &EventType{config: etuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, etuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventtype.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	etuo.mutation.done = true
+	return _node, nil
+}
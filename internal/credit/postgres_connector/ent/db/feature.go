@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/feature"
 )
 
@@ -30,6 +31,10 @@ type Feature struct {
 	MeterSlug string `json:"meter_slug,omitempty"`
 	// MeterGroupByFilters holds the value of the "meter_group_by_filters" field.
 	MeterGroupByFilters map[string]string `json:"meter_group_by_filters,omitempty"`
+	// MeterGroupByFilterVersions holds the value of the "meter_group_by_filter_versions" field.
+	MeterGroupByFilterVersions []credit.FeatureFilterVersion `json:"meter_group_by_filter_versions,omitempty"`
+	// UnitPrice holds the value of the "unit_price" field.
+	UnitPrice *credit.UnitPrice `json:"unit_price,omitempty"`
 	// Archived holds the value of the "archived" field.
 	Archived bool `json:"archived,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -61,7 +66,7 @@ func (*Feature) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case feature.FieldMeterGroupByFilters:
+		case feature.FieldMeterGroupByFilters, feature.FieldMeterGroupByFilterVersions, feature.FieldUnitPrice:
 			values[i] = new([]byte)
 		case feature.FieldArchived:
 			values[i] = new(sql.NullBool)
@@ -128,6 +133,22 @@ func (f *Feature) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field meter_group_by_filters: %w", err)
 				}
 			}
+		case feature.FieldMeterGroupByFilterVersions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field meter_group_by_filter_versions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &f.MeterGroupByFilterVersions); err != nil {
+					return fmt.Errorf("unmarshal field meter_group_by_filter_versions: %w", err)
+				}
+			}
+		case feature.FieldUnitPrice:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &f.UnitPrice); err != nil {
+					return fmt.Errorf("unmarshal field unit_price: %w", err)
+				}
+			}
 		case feature.FieldArchived:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field archived", values[i])
@@ -193,6 +214,12 @@ func (f *Feature) String() string {
 	builder.WriteString("meter_group_by_filters=")
 	builder.WriteString(fmt.Sprintf("%v", f.MeterGroupByFilters))
 	builder.WriteString(", ")
+	builder.WriteString("meter_group_by_filter_versions=")
+	builder.WriteString(fmt.Sprintf("%v", f.MeterGroupByFilterVersions))
+	builder.WriteString(", ")
+	builder.WriteString("unit_price=")
+	builder.WriteString(fmt.Sprintf("%v", f.UnitPrice))
+	builder.WriteString(", ")
 	builder.WriteString("archived=")
 	builder.WriteString(fmt.Sprintf("%v", f.Archived))
 	builder.WriteByte(')')
@@ -26,6 +26,10 @@ const (
 	FieldMeterSlug = "meter_slug"
 	// FieldMeterGroupByFilters holds the string denoting the meter_group_by_filters field in the database.
 	FieldMeterGroupByFilters = "meter_group_by_filters"
+	// FieldMeterGroupByFilterVersions holds the string denoting the meter_group_by_filter_versions field in the database.
+	FieldMeterGroupByFilterVersions = "meter_group_by_filter_versions"
+	// FieldUnitPrice holds the string denoting the unit_price field in the database.
+	FieldUnitPrice = "unit_price"
 	// FieldArchived holds the string denoting the archived field in the database.
 	FieldArchived = "archived"
 	// EdgeCreditGrants holds the string denoting the credit_grants edge name in mutations.
@@ -50,6 +54,8 @@ var Columns = []string{
 	FieldName,
 	FieldMeterSlug,
 	FieldMeterGroupByFilters,
+	FieldMeterGroupByFilterVersions,
+	FieldUnitPrice,
 	FieldArchived,
 }
 
@@ -380,6 +380,26 @@ func MeterGroupByFiltersNotNil() predicate.Feature {
 	return predicate.Feature(sql.FieldNotNull(FieldMeterGroupByFilters))
 }
 
+// MeterGroupByFilterVersionsIsNil applies the IsNil predicate on the "meter_group_by_filter_versions" field.
+func MeterGroupByFilterVersionsIsNil() predicate.Feature {
+	return predicate.Feature(sql.FieldIsNull(FieldMeterGroupByFilterVersions))
+}
+
+// MeterGroupByFilterVersionsNotNil applies the NotNil predicate on the "meter_group_by_filter_versions" field.
+func MeterGroupByFilterVersionsNotNil() predicate.Feature {
+	return predicate.Feature(sql.FieldNotNull(FieldMeterGroupByFilterVersions))
+}
+
+// UnitPriceIsNil applies the IsNil predicate on the "unit_price" field.
+func UnitPriceIsNil() predicate.Feature {
+	return predicate.Feature(sql.FieldIsNull(FieldUnitPrice))
+}
+
+// UnitPriceNotNil applies the NotNil predicate on the "unit_price" field.
+func UnitPriceNotNil() predicate.Feature {
+	return predicate.Feature(sql.FieldNotNull(FieldUnitPrice))
+}
+
 // ArchivedEQ applies the EQ predicate on the "archived" field.
 func ArchivedEQ(v bool) predicate.Feature {
 	return predicate.Feature(sql.FieldEQ(FieldArchived, v))
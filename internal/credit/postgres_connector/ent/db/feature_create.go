@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/creditentry"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/feature"
 )
@@ -76,6 +77,18 @@ func (fc *FeatureCreate) SetMeterGroupByFilters(m map[string]string) *FeatureCre
 	return fc
 }
 
+// SetMeterGroupByFilterVersions sets the "meter_group_by_filter_versions" field.
+func (fc *FeatureCreate) SetMeterGroupByFilterVersions(cfv []credit.FeatureFilterVersion) *FeatureCreate {
+	fc.mutation.SetMeterGroupByFilterVersions(cfv)
+	return fc
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (fc *FeatureCreate) SetUnitPrice(cp *credit.UnitPrice) *FeatureCreate {
+	fc.mutation.SetUnitPrice(cp)
+	return fc
+}
+
 // SetArchived sets the "archived" field.
 func (fc *FeatureCreate) SetArchived(b bool) *FeatureCreate {
 	fc.mutation.SetArchived(b)
@@ -204,6 +217,11 @@ func (fc *FeatureCreate) check() error {
 			return &ValidationError{Name: "meter_slug", err: fmt.Errorf(`db: validator failed for field "Feature.meter_slug": %w`, err)}
 		}
 	}
+	if v, ok := fc.mutation.UnitPrice(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "unit_price", err: fmt.Errorf(`db: validator failed for field "Feature.unit_price": %w`, err)}
+		}
+	}
 	if _, ok := fc.mutation.Archived(); !ok {
 		return &ValidationError{Name: "archived", err: errors.New(`db: missing required field "Feature.archived"`)}
 	}
@@ -267,6 +285,14 @@ func (fc *FeatureCreate) createSpec() (*Feature, *sqlgraph.CreateSpec) {
 		_spec.SetField(feature.FieldMeterGroupByFilters, field.TypeJSON, value)
 		_node.MeterGroupByFilters = value
 	}
+	if value, ok := fc.mutation.MeterGroupByFilterVersions(); ok {
+		_spec.SetField(feature.FieldMeterGroupByFilterVersions, field.TypeJSON, value)
+		_node.MeterGroupByFilterVersions = value
+	}
+	if value, ok := fc.mutation.UnitPrice(); ok {
+		_spec.SetField(feature.FieldUnitPrice, field.TypeJSON, value)
+		_node.UnitPrice = value
+	}
 	if value, ok := fc.mutation.Archived(); ok {
 		_spec.SetField(feature.FieldArchived, field.TypeBool, value)
 		_node.Archived = value
@@ -381,6 +407,24 @@ func (u *FeatureUpsert) ClearMeterGroupByFilters() *FeatureUpsert {
 	return u
 }
 
+// SetMeterGroupByFilterVersions sets the "meter_group_by_filter_versions" field.
+func (u *FeatureUpsert) SetMeterGroupByFilterVersions(v []credit.FeatureFilterVersion) *FeatureUpsert {
+	u.Set(feature.FieldMeterGroupByFilterVersions, v)
+	return u
+}
+
+// UpdateMeterGroupByFilterVersions sets the "meter_group_by_filter_versions" field to the value that was provided on create.
+func (u *FeatureUpsert) UpdateMeterGroupByFilterVersions() *FeatureUpsert {
+	u.SetExcluded(feature.FieldMeterGroupByFilterVersions)
+	return u
+}
+
+// ClearMeterGroupByFilterVersions clears the value of the "meter_group_by_filter_versions" field.
+func (u *FeatureUpsert) ClearMeterGroupByFilterVersions() *FeatureUpsert {
+	u.SetNull(feature.FieldMeterGroupByFilterVersions)
+	return u
+}
+
 // SetArchived sets the "archived" field.
 func (u *FeatureUpsert) SetArchived(v bool) *FeatureUpsert {
 	u.Set(feature.FieldArchived, v)
@@ -419,6 +463,9 @@ func (u *FeatureUpsertOne) UpdateNewValues() *FeatureUpsertOne {
 		if _, exists := u.create.mutation.MeterSlug(); exists {
 			s.SetIgnore(feature.FieldMeterSlug)
 		}
+		if _, exists := u.create.mutation.UnitPrice(); exists {
+			s.SetIgnore(feature.FieldUnitPrice)
+		}
 	}))
 	return u
 }
@@ -499,6 +546,27 @@ func (u *FeatureUpsertOne) ClearMeterGroupByFilters() *FeatureUpsertOne {
 	})
 }
 
+// SetMeterGroupByFilterVersions sets the "meter_group_by_filter_versions" field.
+func (u *FeatureUpsertOne) SetMeterGroupByFilterVersions(v []credit.FeatureFilterVersion) *FeatureUpsertOne {
+	return u.Update(func(s *FeatureUpsert) {
+		s.SetMeterGroupByFilterVersions(v)
+	})
+}
+
+// UpdateMeterGroupByFilterVersions sets the "meter_group_by_filter_versions" field to the value that was provided on create.
+func (u *FeatureUpsertOne) UpdateMeterGroupByFilterVersions() *FeatureUpsertOne {
+	return u.Update(func(s *FeatureUpsert) {
+		s.UpdateMeterGroupByFilterVersions()
+	})
+}
+
+// ClearMeterGroupByFilterVersions clears the value of the "meter_group_by_filter_versions" field.
+func (u *FeatureUpsertOne) ClearMeterGroupByFilterVersions() *FeatureUpsertOne {
+	return u.Update(func(s *FeatureUpsert) {
+		s.ClearMeterGroupByFilterVersions()
+	})
+}
+
 // SetArchived sets the "archived" field.
 func (u *FeatureUpsertOne) SetArchived(v bool) *FeatureUpsertOne {
 	return u.Update(func(s *FeatureUpsert) {
@@ -705,6 +773,9 @@ func (u *FeatureUpsertBulk) UpdateNewValues() *FeatureUpsertBulk {
 			if _, exists := b.mutation.MeterSlug(); exists {
 				s.SetIgnore(feature.FieldMeterSlug)
 			}
+			if _, exists := b.mutation.UnitPrice(); exists {
+				s.SetIgnore(feature.FieldUnitPrice)
+			}
 		}
 	}))
 	return u
@@ -786,6 +857,27 @@ func (u *FeatureUpsertBulk) ClearMeterGroupByFilters() *FeatureUpsertBulk {
 	})
 }
 
+// SetMeterGroupByFilterVersions sets the "meter_group_by_filter_versions" field.
+func (u *FeatureUpsertBulk) SetMeterGroupByFilterVersions(v []credit.FeatureFilterVersion) *FeatureUpsertBulk {
+	return u.Update(func(s *FeatureUpsert) {
+		s.SetMeterGroupByFilterVersions(v)
+	})
+}
+
+// UpdateMeterGroupByFilterVersions sets the "meter_group_by_filter_versions" field to the value that was provided on create.
+func (u *FeatureUpsertBulk) UpdateMeterGroupByFilterVersions() *FeatureUpsertBulk {
+	return u.Update(func(s *FeatureUpsert) {
+		s.UpdateMeterGroupByFilterVersions()
+	})
+}
+
+// ClearMeterGroupByFilterVersions clears the value of the "meter_group_by_filter_versions" field.
+func (u *FeatureUpsertBulk) ClearMeterGroupByFilterVersions() *FeatureUpsertBulk {
+	return u.Update(func(s *FeatureUpsert) {
+		s.ClearMeterGroupByFilterVersions()
+	})
+}
+
 // SetArchived sets the "archived" field.
 func (u *FeatureUpsertBulk) SetArchived(v bool) *FeatureUpsertBulk {
 	return u.Update(func(s *FeatureUpsert) {
@@ -10,7 +10,9 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/creditentry"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/feature"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
@@ -61,6 +63,24 @@ func (fu *FeatureUpdate) ClearMeterGroupByFilters() *FeatureUpdate {
 	return fu
 }
 
+// SetMeterGroupByFilterVersions sets the "meter_group_by_filter_versions" field.
+func (fu *FeatureUpdate) SetMeterGroupByFilterVersions(cfv []credit.FeatureFilterVersion) *FeatureUpdate {
+	fu.mutation.SetMeterGroupByFilterVersions(cfv)
+	return fu
+}
+
+// AppendMeterGroupByFilterVersions appends cfv to the "meter_group_by_filter_versions" field.
+func (fu *FeatureUpdate) AppendMeterGroupByFilterVersions(cfv []credit.FeatureFilterVersion) *FeatureUpdate {
+	fu.mutation.AppendMeterGroupByFilterVersions(cfv)
+	return fu
+}
+
+// ClearMeterGroupByFilterVersions clears the value of the "meter_group_by_filter_versions" field.
+func (fu *FeatureUpdate) ClearMeterGroupByFilterVersions() *FeatureUpdate {
+	fu.mutation.ClearMeterGroupByFilterVersions()
+	return fu
+}
+
 // SetArchived sets the "archived" field.
 func (fu *FeatureUpdate) SetArchived(b bool) *FeatureUpdate {
 	fu.mutation.SetArchived(b)
@@ -186,6 +206,20 @@ func (fu *FeatureUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if fu.mutation.MeterGroupByFiltersCleared() {
 		_spec.ClearField(feature.FieldMeterGroupByFilters, field.TypeJSON)
 	}
+	if value, ok := fu.mutation.MeterGroupByFilterVersions(); ok {
+		_spec.SetField(feature.FieldMeterGroupByFilterVersions, field.TypeJSON, value)
+	}
+	if value, ok := fu.mutation.AppendedMeterGroupByFilterVersions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, feature.FieldMeterGroupByFilterVersions, value)
+		})
+	}
+	if fu.mutation.MeterGroupByFilterVersionsCleared() {
+		_spec.ClearField(feature.FieldMeterGroupByFilterVersions, field.TypeJSON)
+	}
+	if fu.mutation.UnitPriceCleared() {
+		_spec.ClearField(feature.FieldUnitPrice, field.TypeJSON)
+	}
 	if value, ok := fu.mutation.Archived(); ok {
 		_spec.SetField(feature.FieldArchived, field.TypeBool, value)
 	}
@@ -286,6 +320,24 @@ func (fuo *FeatureUpdateOne) ClearMeterGroupByFilters() *FeatureUpdateOne {
 	return fuo
 }
 
+// SetMeterGroupByFilterVersions sets the "meter_group_by_filter_versions" field.
+func (fuo *FeatureUpdateOne) SetMeterGroupByFilterVersions(cfv []credit.FeatureFilterVersion) *FeatureUpdateOne {
+	fuo.mutation.SetMeterGroupByFilterVersions(cfv)
+	return fuo
+}
+
+// AppendMeterGroupByFilterVersions appends cfv to the "meter_group_by_filter_versions" field.
+func (fuo *FeatureUpdateOne) AppendMeterGroupByFilterVersions(cfv []credit.FeatureFilterVersion) *FeatureUpdateOne {
+	fuo.mutation.AppendMeterGroupByFilterVersions(cfv)
+	return fuo
+}
+
+// ClearMeterGroupByFilterVersions clears the value of the "meter_group_by_filter_versions" field.
+func (fuo *FeatureUpdateOne) ClearMeterGroupByFilterVersions() *FeatureUpdateOne {
+	fuo.mutation.ClearMeterGroupByFilterVersions()
+	return fuo
+}
+
 // SetArchived sets the "archived" field.
 func (fuo *FeatureUpdateOne) SetArchived(b bool) *FeatureUpdateOne {
 	fuo.mutation.SetArchived(b)
@@ -441,6 +493,20 @@ func (fuo *FeatureUpdateOne) sqlSave(ctx context.Context) (_node *Feature, err e
 	if fuo.mutation.MeterGroupByFiltersCleared() {
 		_spec.ClearField(feature.FieldMeterGroupByFilters, field.TypeJSON)
 	}
+	if value, ok := fuo.mutation.MeterGroupByFilterVersions(); ok {
+		_spec.SetField(feature.FieldMeterGroupByFilterVersions, field.TypeJSON, value)
+	}
+	if value, ok := fuo.mutation.AppendedMeterGroupByFilterVersions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, feature.FieldMeterGroupByFilterVersions, value)
+		})
+	}
+	if fuo.mutation.MeterGroupByFilterVersionsCleared() {
+		_spec.ClearField(feature.FieldMeterGroupByFilterVersions, field.TypeJSON)
+	}
+	if fuo.mutation.UnitPriceCleared() {
+		_spec.ClearField(feature.FieldUnitPrice, field.TypeJSON)
+	}
 	if value, ok := fuo.mutation.Archived(); ok {
 		_spec.SetField(feature.FieldArchived, field.TypeBool, value)
 	}
@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/grantschedule"
+)
+
+// GrantSchedule is the model entity for the GrantSchedule schema.
+type GrantSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// LedgerID holds the value of the "ledger_id" field.
+	LedgerID string `json:"ledger_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type credit.GrantType `json:"type,omitempty"`
+	// FeatureID holds the value of the "feature_id" field.
+	FeatureID *string `json:"feature_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority uint8 `json:"priority,omitempty"`
+	// ExpirationPeriodDuration holds the value of the "expiration_period_duration" field.
+	ExpirationPeriodDuration credit.ExpirationPeriodDuration `json:"expiration_period_duration,omitempty"`
+	// ExpirationPeriodCount holds the value of the "expiration_period_count" field.
+	ExpirationPeriodCount uint8 `json:"expiration_period_count,omitempty"`
+	// RolloverType holds the value of the "rollover_type" field.
+	RolloverType *credit.GrantRolloverType `json:"rollover_type,omitempty"`
+	// RolloverMaxAmount holds the value of the "rollover_max_amount" field.
+	RolloverMaxAmount *float64 `json:"rollover_max_amount,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// RecurrencePeriod holds the value of the "recurrence_period" field.
+	RecurrencePeriod credit.RecurrencePeriod `json:"recurrence_period,omitempty"`
+	// RecurrenceAnchor holds the value of the "recurrence_anchor" field.
+	RecurrenceAnchor time.Time `json:"recurrence_anchor,omitempty"`
+	// NextGrantIndex holds the value of the "next_grant_index" field.
+	NextGrantIndex int `json:"next_grant_index,omitempty"`
+	// NextGrantAt holds the value of the "next_grant_at" field.
+	NextGrantAt  time.Time `json:"next_grant_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GrantSchedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case grantschedule.FieldMetadata:
+			values[i] = new([]byte)
+		case grantschedule.FieldAmount, grantschedule.FieldRolloverMaxAmount:
+			values[i] = new(sql.NullFloat64)
+		case grantschedule.FieldPriority, grantschedule.FieldExpirationPeriodCount, grantschedule.FieldNextGrantIndex:
+			values[i] = new(sql.NullInt64)
+		case grantschedule.FieldID, grantschedule.FieldNamespace, grantschedule.FieldLedgerID, grantschedule.FieldType, grantschedule.FieldFeatureID, grantschedule.FieldExpirationPeriodDuration, grantschedule.FieldRolloverType, grantschedule.FieldRecurrencePeriod:
+			values[i] = new(sql.NullString)
+		case grantschedule.FieldCreatedAt, grantschedule.FieldUpdatedAt, grantschedule.FieldRecurrenceAnchor, grantschedule.FieldNextGrantAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GrantSchedule fields.
+func (gs *GrantSchedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case grantschedule.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				gs.ID = value.String
+			}
+		case grantschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				gs.CreatedAt = value.Time
+			}
+		case grantschedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				gs.UpdatedAt = value.Time
+			}
+		case grantschedule.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				gs.Namespace = value.String
+			}
+		case grantschedule.FieldLedgerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				gs.LedgerID = value.String
+			}
+		case grantschedule.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				gs.Type = credit.GrantType(value.String)
+			}
+		case grantschedule.FieldFeatureID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feature_id", values[i])
+			} else if value.Valid {
+				gs.FeatureID = new(string)
+				*gs.FeatureID = value.String
+			}
+		case grantschedule.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				gs.Amount = value.Float64
+			}
+		case grantschedule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				gs.Priority = uint8(value.Int64)
+			}
+		case grantschedule.FieldExpirationPeriodDuration:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expiration_period_duration", values[i])
+			} else if value.Valid {
+				gs.ExpirationPeriodDuration = credit.ExpirationPeriodDuration(value.String)
+			}
+		case grantschedule.FieldExpirationPeriodCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expiration_period_count", values[i])
+			} else if value.Valid {
+				gs.ExpirationPeriodCount = uint8(value.Int64)
+			}
+		case grantschedule.FieldRolloverType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rollover_type", values[i])
+			} else if value.Valid {
+				gs.RolloverType = new(credit.GrantRolloverType)
+				*gs.RolloverType = credit.GrantRolloverType(value.String)
+			}
+		case grantschedule.FieldRolloverMaxAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rollover_max_amount", values[i])
+			} else if value.Valid {
+				gs.RolloverMaxAmount = new(float64)
+				*gs.RolloverMaxAmount = value.Float64
+			}
+		case grantschedule.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &gs.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case grantschedule.FieldRecurrencePeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_period", values[i])
+			} else if value.Valid {
+				gs.RecurrencePeriod = credit.RecurrencePeriod(value.String)
+			}
+		case grantschedule.FieldRecurrenceAnchor:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence_anchor", values[i])
+			} else if value.Valid {
+				gs.RecurrenceAnchor = value.Time
+			}
+		case grantschedule.FieldNextGrantIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field next_grant_index", values[i])
+			} else if value.Valid {
+				gs.NextGrantIndex = int(value.Int64)
+			}
+		case grantschedule.FieldNextGrantAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_grant_at", values[i])
+			} else if value.Valid {
+				gs.NextGrantAt = value.Time
+			}
+		default:
+			gs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GrantSchedule.
+// This includes values selected through modifiers, order, etc.
+func (gs *GrantSchedule) Value(name string) (ent.Value, error) {
+	return gs.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GrantSchedule.
+// Note that you need to call GrantSchedule.Unwrap() before calling this method if this GrantSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gs *GrantSchedule) Update() *GrantScheduleUpdateOne {
+	return NewGrantScheduleClient(gs.config).UpdateOne(gs)
+}
+
+// Unwrap unwraps the GrantSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gs *GrantSchedule) Unwrap() *GrantSchedule {
+	_tx, ok := gs.config.driver.(*txDriver)
+	if !ok {
+		panic("db: GrantSchedule is not a transactional entity")
+	}
+	gs.config.driver = _tx.drv
+	return gs
+}
+
+// String implements the fmt.Stringer.
+func (gs *GrantSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("GrantSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gs.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(gs.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(gs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(gs.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("ledger_id=")
+	builder.WriteString(gs.LedgerID)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", gs.Type))
+	builder.WriteString(", ")
+	if v := gs.FeatureID; v != nil {
+		builder.WriteString("feature_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", gs.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", gs.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("expiration_period_duration=")
+	builder.WriteString(fmt.Sprintf("%v", gs.ExpirationPeriodDuration))
+	builder.WriteString(", ")
+	builder.WriteString("expiration_period_count=")
+	builder.WriteString(fmt.Sprintf("%v", gs.ExpirationPeriodCount))
+	builder.WriteString(", ")
+	if v := gs.RolloverType; v != nil {
+		builder.WriteString("rollover_type=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gs.RolloverMaxAmount; v != nil {
+		builder.WriteString("rollover_max_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", gs.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("recurrence_period=")
+	builder.WriteString(fmt.Sprintf("%v", gs.RecurrencePeriod))
+	builder.WriteString(", ")
+	builder.WriteString("recurrence_anchor=")
+	builder.WriteString(gs.RecurrenceAnchor.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("next_grant_index=")
+	builder.WriteString(fmt.Sprintf("%v", gs.NextGrantIndex))
+	builder.WriteString(", ")
+	builder.WriteString("next_grant_at=")
+	builder.WriteString(gs.NextGrantAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GrantSchedules is a parsable slice of GrantSchedule.
+type GrantSchedules []*GrantSchedule
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package grantschedule
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit"
+)
+
+const (
+	// Label holds the string label denoting the grantschedule type in the database.
+	Label = "grant_schedule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldLedgerID holds the string denoting the ledger_id field in the database.
+	FieldLedgerID = "ledger_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldFeatureID holds the string denoting the feature_id field in the database.
+	FieldFeatureID = "feature_id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldExpirationPeriodDuration holds the string denoting the expiration_period_duration field in the database.
+	FieldExpirationPeriodDuration = "expiration_period_duration"
+	// FieldExpirationPeriodCount holds the string denoting the expiration_period_count field in the database.
+	FieldExpirationPeriodCount = "expiration_period_count"
+	// FieldRolloverType holds the string denoting the rollover_type field in the database.
+	FieldRolloverType = "rollover_type"
+	// FieldRolloverMaxAmount holds the string denoting the rollover_max_amount field in the database.
+	FieldRolloverMaxAmount = "rollover_max_amount"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldRecurrencePeriod holds the string denoting the recurrence_period field in the database.
+	FieldRecurrencePeriod = "recurrence_period"
+	// FieldRecurrenceAnchor holds the string denoting the recurrence_anchor field in the database.
+	FieldRecurrenceAnchor = "recurrence_anchor"
+	// FieldNextGrantIndex holds the string denoting the next_grant_index field in the database.
+	FieldNextGrantIndex = "next_grant_index"
+	// FieldNextGrantAt holds the string denoting the next_grant_at field in the database.
+	FieldNextGrantAt = "next_grant_at"
+	// Table holds the table name of the grantschedule in the database.
+	Table = "grant_schedules"
+)
+
+// Columns holds all SQL columns for grantschedule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldNamespace,
+	FieldLedgerID,
+	FieldType,
+	FieldFeatureID,
+	FieldAmount,
+	FieldPriority,
+	FieldExpirationPeriodDuration,
+	FieldExpirationPeriodCount,
+	FieldRolloverType,
+	FieldRolloverMaxAmount,
+	FieldMetadata,
+	FieldRecurrencePeriod,
+	FieldRecurrenceAnchor,
+	FieldNextGrantIndex,
+	FieldNextGrantAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	NamespaceValidator func(string) error
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority uint8
+	// DefaultNextGrantIndex holds the default value on creation for the "next_grant_index" field.
+	DefaultNextGrantIndex int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type credit.GrantType) error {
+	switch _type {
+	case "USAGE":
+		return nil
+	default:
+		return fmt.Errorf("grantschedule: invalid enum value for type field: %q", _type)
+	}
+}
+
+// ExpirationPeriodDurationValidator is a validator for the "expiration_period_duration" field enum values. It is called by the builders before save.
+func ExpirationPeriodDurationValidator(epd credit.ExpirationPeriodDuration) error {
+	switch epd {
+	case "HOUR", "DAY", "WEEK", "MONTH", "YEAR":
+		return nil
+	default:
+		return fmt.Errorf("grantschedule: invalid enum value for expiration_period_duration field: %q", epd)
+	}
+}
+
+// RolloverTypeValidator is a validator for the "rollover_type" field enum values. It is called by the builders before save.
+func RolloverTypeValidator(rt credit.GrantRolloverType) error {
+	switch rt {
+	case "ORIGINAL_AMOUNT", "REMAINING_AMOUNT":
+		return nil
+	default:
+		return fmt.Errorf("grantschedule: invalid enum value for rollover_type field: %q", rt)
+	}
+}
+
+// RecurrencePeriodValidator is a validator for the "recurrence_period" field enum values. It is called by the builders before save.
+func RecurrencePeriodValidator(rp credit.RecurrencePeriod) error {
+	switch rp {
+	case "DAY", "WEEK", "MONTH", "YEAR":
+		return nil
+	default:
+		return fmt.Errorf("grantschedule: invalid enum value for recurrence_period field: %q", rp)
+	}
+}
+
+// OrderOption defines the ordering options for the GrantSchedule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByNamespace orders the results by the namespace field.
+func ByNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespace, opts...).ToFunc()
+}
+
+// ByLedgerID orders the results by the ledger_id field.
+func ByLedgerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLedgerID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByFeatureID orders the results by the feature_id field.
+func ByFeatureID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeatureID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByExpirationPeriodDuration orders the results by the expiration_period_duration field.
+func ByExpirationPeriodDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpirationPeriodDuration, opts...).ToFunc()
+}
+
+// ByExpirationPeriodCount orders the results by the expiration_period_count field.
+func ByExpirationPeriodCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpirationPeriodCount, opts...).ToFunc()
+}
+
+// ByRolloverType orders the results by the rollover_type field.
+func ByRolloverType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRolloverType, opts...).ToFunc()
+}
+
+// ByRolloverMaxAmount orders the results by the rollover_max_amount field.
+func ByRolloverMaxAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRolloverMaxAmount, opts...).ToFunc()
+}
+
+// ByRecurrencePeriod orders the results by the recurrence_period field.
+func ByRecurrencePeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecurrencePeriod, opts...).ToFunc()
+}
+
+// ByRecurrenceAnchor orders the results by the recurrence_anchor field.
+func ByRecurrenceAnchor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecurrenceAnchor, opts...).ToFunc()
+}
+
+// ByNextGrantIndex orders the results by the next_grant_index field.
+func ByNextGrantIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextGrantIndex, opts...).ToFunc()
+}
+
+// ByNextGrantAt orders the results by the next_grant_at field.
+func ByNextGrantAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextGrantAt, opts...).ToFunc()
+}
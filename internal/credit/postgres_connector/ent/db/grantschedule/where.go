@@ -0,0 +1,856 @@
+// Code generated by ent, DO NOT EDIT.
+
+package grantschedule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldNamespace, v))
+}
+
+// LedgerID applies equality check predicate on the "ledger_id" field. It's identical to LedgerIDEQ.
+func LedgerID(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldLedgerID, v))
+}
+
+// FeatureID applies equality check predicate on the "feature_id" field. It's identical to FeatureIDEQ.
+func FeatureID(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldFeatureID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldAmount, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldPriority, v))
+}
+
+// ExpirationPeriodCount applies equality check predicate on the "expiration_period_count" field. It's identical to ExpirationPeriodCountEQ.
+func ExpirationPeriodCount(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldExpirationPeriodCount, v))
+}
+
+// RolloverMaxAmount applies equality check predicate on the "rollover_max_amount" field. It's identical to RolloverMaxAmountEQ.
+func RolloverMaxAmount(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldRolloverMaxAmount, v))
+}
+
+// RecurrenceAnchor applies equality check predicate on the "recurrence_anchor" field. It's identical to RecurrenceAnchorEQ.
+func RecurrenceAnchor(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldRecurrenceAnchor, v))
+}
+
+// NextGrantIndex applies equality check predicate on the "next_grant_index" field. It's identical to NextGrantIndexEQ.
+func NextGrantIndex(v int) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldNextGrantIndex, v))
+}
+
+// NextGrantAt applies equality check predicate on the "next_grant_at" field. It's identical to NextGrantAtEQ.
+func NextGrantAt(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldNextGrantAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// LedgerIDEQ applies the EQ predicate on the "ledger_id" field.
+func LedgerIDEQ(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldLedgerID, v))
+}
+
+// LedgerIDNEQ applies the NEQ predicate on the "ledger_id" field.
+func LedgerIDNEQ(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldLedgerID, v))
+}
+
+// LedgerIDIn applies the In predicate on the "ledger_id" field.
+func LedgerIDIn(vs ...string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIn(FieldLedgerID, vs...))
+}
+
+// LedgerIDNotIn applies the NotIn predicate on the "ledger_id" field.
+func LedgerIDNotIn(vs ...string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldLedgerID, vs...))
+}
+
+// LedgerIDGT applies the GT predicate on the "ledger_id" field.
+func LedgerIDGT(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGT(FieldLedgerID, v))
+}
+
+// LedgerIDGTE applies the GTE predicate on the "ledger_id" field.
+func LedgerIDGTE(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGTE(FieldLedgerID, v))
+}
+
+// LedgerIDLT applies the LT predicate on the "ledger_id" field.
+func LedgerIDLT(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLT(FieldLedgerID, v))
+}
+
+// LedgerIDLTE applies the LTE predicate on the "ledger_id" field.
+func LedgerIDLTE(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLTE(FieldLedgerID, v))
+}
+
+// LedgerIDContains applies the Contains predicate on the "ledger_id" field.
+func LedgerIDContains(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldContains(FieldLedgerID, v))
+}
+
+// LedgerIDHasPrefix applies the HasPrefix predicate on the "ledger_id" field.
+func LedgerIDHasPrefix(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldHasPrefix(FieldLedgerID, v))
+}
+
+// LedgerIDHasSuffix applies the HasSuffix predicate on the "ledger_id" field.
+func LedgerIDHasSuffix(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldHasSuffix(FieldLedgerID, v))
+}
+
+// LedgerIDEqualFold applies the EqualFold predicate on the "ledger_id" field.
+func LedgerIDEqualFold(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEqualFold(FieldLedgerID, v))
+}
+
+// LedgerIDContainsFold applies the ContainsFold predicate on the "ledger_id" field.
+func LedgerIDContainsFold(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldContainsFold(FieldLedgerID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v credit.GrantType) predicate.GrantSchedule {
+	vc := v
+	return predicate.GrantSchedule(sql.FieldEQ(FieldType, vc))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v credit.GrantType) predicate.GrantSchedule {
+	vc := v
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldType, vc))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...credit.GrantType) predicate.GrantSchedule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.GrantSchedule(sql.FieldIn(FieldType, v...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...credit.GrantType) predicate.GrantSchedule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldType, v...))
+}
+
+// FeatureIDEQ applies the EQ predicate on the "feature_id" field.
+func FeatureIDEQ(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldFeatureID, v))
+}
+
+// FeatureIDNEQ applies the NEQ predicate on the "feature_id" field.
+func FeatureIDNEQ(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldFeatureID, v))
+}
+
+// FeatureIDIn applies the In predicate on the "feature_id" field.
+func FeatureIDIn(vs ...string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIn(FieldFeatureID, vs...))
+}
+
+// FeatureIDNotIn applies the NotIn predicate on the "feature_id" field.
+func FeatureIDNotIn(vs ...string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldFeatureID, vs...))
+}
+
+// FeatureIDGT applies the GT predicate on the "feature_id" field.
+func FeatureIDGT(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGT(FieldFeatureID, v))
+}
+
+// FeatureIDGTE applies the GTE predicate on the "feature_id" field.
+func FeatureIDGTE(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGTE(FieldFeatureID, v))
+}
+
+// FeatureIDLT applies the LT predicate on the "feature_id" field.
+func FeatureIDLT(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLT(FieldFeatureID, v))
+}
+
+// FeatureIDLTE applies the LTE predicate on the "feature_id" field.
+func FeatureIDLTE(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLTE(FieldFeatureID, v))
+}
+
+// FeatureIDContains applies the Contains predicate on the "feature_id" field.
+func FeatureIDContains(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldContains(FieldFeatureID, v))
+}
+
+// FeatureIDHasPrefix applies the HasPrefix predicate on the "feature_id" field.
+func FeatureIDHasPrefix(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldHasPrefix(FieldFeatureID, v))
+}
+
+// FeatureIDHasSuffix applies the HasSuffix predicate on the "feature_id" field.
+func FeatureIDHasSuffix(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldHasSuffix(FieldFeatureID, v))
+}
+
+// FeatureIDIsNil applies the IsNil predicate on the "feature_id" field.
+func FeatureIDIsNil() predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIsNull(FieldFeatureID))
+}
+
+// FeatureIDNotNil applies the NotNil predicate on the "feature_id" field.
+func FeatureIDNotNil() predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotNull(FieldFeatureID))
+}
+
+// FeatureIDEqualFold applies the EqualFold predicate on the "feature_id" field.
+func FeatureIDEqualFold(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEqualFold(FieldFeatureID, v))
+}
+
+// FeatureIDContainsFold applies the ContainsFold predicate on the "feature_id" field.
+func FeatureIDContainsFold(v string) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldContainsFold(FieldFeatureID, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLTE(FieldAmount, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLTE(FieldPriority, v))
+}
+
+// ExpirationPeriodDurationEQ applies the EQ predicate on the "expiration_period_duration" field.
+func ExpirationPeriodDurationEQ(v credit.ExpirationPeriodDuration) predicate.GrantSchedule {
+	vc := v
+	return predicate.GrantSchedule(sql.FieldEQ(FieldExpirationPeriodDuration, vc))
+}
+
+// ExpirationPeriodDurationNEQ applies the NEQ predicate on the "expiration_period_duration" field.
+func ExpirationPeriodDurationNEQ(v credit.ExpirationPeriodDuration) predicate.GrantSchedule {
+	vc := v
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldExpirationPeriodDuration, vc))
+}
+
+// ExpirationPeriodDurationIn applies the In predicate on the "expiration_period_duration" field.
+func ExpirationPeriodDurationIn(vs ...credit.ExpirationPeriodDuration) predicate.GrantSchedule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.GrantSchedule(sql.FieldIn(FieldExpirationPeriodDuration, v...))
+}
+
+// ExpirationPeriodDurationNotIn applies the NotIn predicate on the "expiration_period_duration" field.
+func ExpirationPeriodDurationNotIn(vs ...credit.ExpirationPeriodDuration) predicate.GrantSchedule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldExpirationPeriodDuration, v...))
+}
+
+// ExpirationPeriodCountEQ applies the EQ predicate on the "expiration_period_count" field.
+func ExpirationPeriodCountEQ(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldExpirationPeriodCount, v))
+}
+
+// ExpirationPeriodCountNEQ applies the NEQ predicate on the "expiration_period_count" field.
+func ExpirationPeriodCountNEQ(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldExpirationPeriodCount, v))
+}
+
+// ExpirationPeriodCountIn applies the In predicate on the "expiration_period_count" field.
+func ExpirationPeriodCountIn(vs ...uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIn(FieldExpirationPeriodCount, vs...))
+}
+
+// ExpirationPeriodCountNotIn applies the NotIn predicate on the "expiration_period_count" field.
+func ExpirationPeriodCountNotIn(vs ...uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldExpirationPeriodCount, vs...))
+}
+
+// ExpirationPeriodCountGT applies the GT predicate on the "expiration_period_count" field.
+func ExpirationPeriodCountGT(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGT(FieldExpirationPeriodCount, v))
+}
+
+// ExpirationPeriodCountGTE applies the GTE predicate on the "expiration_period_count" field.
+func ExpirationPeriodCountGTE(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGTE(FieldExpirationPeriodCount, v))
+}
+
+// ExpirationPeriodCountLT applies the LT predicate on the "expiration_period_count" field.
+func ExpirationPeriodCountLT(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLT(FieldExpirationPeriodCount, v))
+}
+
+// ExpirationPeriodCountLTE applies the LTE predicate on the "expiration_period_count" field.
+func ExpirationPeriodCountLTE(v uint8) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLTE(FieldExpirationPeriodCount, v))
+}
+
+// RolloverTypeEQ applies the EQ predicate on the "rollover_type" field.
+func RolloverTypeEQ(v credit.GrantRolloverType) predicate.GrantSchedule {
+	vc := v
+	return predicate.GrantSchedule(sql.FieldEQ(FieldRolloverType, vc))
+}
+
+// RolloverTypeNEQ applies the NEQ predicate on the "rollover_type" field.
+func RolloverTypeNEQ(v credit.GrantRolloverType) predicate.GrantSchedule {
+	vc := v
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldRolloverType, vc))
+}
+
+// RolloverTypeIn applies the In predicate on the "rollover_type" field.
+func RolloverTypeIn(vs ...credit.GrantRolloverType) predicate.GrantSchedule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.GrantSchedule(sql.FieldIn(FieldRolloverType, v...))
+}
+
+// RolloverTypeNotIn applies the NotIn predicate on the "rollover_type" field.
+func RolloverTypeNotIn(vs ...credit.GrantRolloverType) predicate.GrantSchedule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldRolloverType, v...))
+}
+
+// RolloverTypeIsNil applies the IsNil predicate on the "rollover_type" field.
+func RolloverTypeIsNil() predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIsNull(FieldRolloverType))
+}
+
+// RolloverTypeNotNil applies the NotNil predicate on the "rollover_type" field.
+func RolloverTypeNotNil() predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotNull(FieldRolloverType))
+}
+
+// RolloverMaxAmountEQ applies the EQ predicate on the "rollover_max_amount" field.
+func RolloverMaxAmountEQ(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldRolloverMaxAmount, v))
+}
+
+// RolloverMaxAmountNEQ applies the NEQ predicate on the "rollover_max_amount" field.
+func RolloverMaxAmountNEQ(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldRolloverMaxAmount, v))
+}
+
+// RolloverMaxAmountIn applies the In predicate on the "rollover_max_amount" field.
+func RolloverMaxAmountIn(vs ...float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIn(FieldRolloverMaxAmount, vs...))
+}
+
+// RolloverMaxAmountNotIn applies the NotIn predicate on the "rollover_max_amount" field.
+func RolloverMaxAmountNotIn(vs ...float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldRolloverMaxAmount, vs...))
+}
+
+// RolloverMaxAmountGT applies the GT predicate on the "rollover_max_amount" field.
+func RolloverMaxAmountGT(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGT(FieldRolloverMaxAmount, v))
+}
+
+// RolloverMaxAmountGTE applies the GTE predicate on the "rollover_max_amount" field.
+func RolloverMaxAmountGTE(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGTE(FieldRolloverMaxAmount, v))
+}
+
+// RolloverMaxAmountLT applies the LT predicate on the "rollover_max_amount" field.
+func RolloverMaxAmountLT(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLT(FieldRolloverMaxAmount, v))
+}
+
+// RolloverMaxAmountLTE applies the LTE predicate on the "rollover_max_amount" field.
+func RolloverMaxAmountLTE(v float64) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLTE(FieldRolloverMaxAmount, v))
+}
+
+// RolloverMaxAmountIsNil applies the IsNil predicate on the "rollover_max_amount" field.
+func RolloverMaxAmountIsNil() predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIsNull(FieldRolloverMaxAmount))
+}
+
+// RolloverMaxAmountNotNil applies the NotNil predicate on the "rollover_max_amount" field.
+func RolloverMaxAmountNotNil() predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotNull(FieldRolloverMaxAmount))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotNull(FieldMetadata))
+}
+
+// RecurrencePeriodEQ applies the EQ predicate on the "recurrence_period" field.
+func RecurrencePeriodEQ(v credit.RecurrencePeriod) predicate.GrantSchedule {
+	vc := v
+	return predicate.GrantSchedule(sql.FieldEQ(FieldRecurrencePeriod, vc))
+}
+
+// RecurrencePeriodNEQ applies the NEQ predicate on the "recurrence_period" field.
+func RecurrencePeriodNEQ(v credit.RecurrencePeriod) predicate.GrantSchedule {
+	vc := v
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldRecurrencePeriod, vc))
+}
+
+// RecurrencePeriodIn applies the In predicate on the "recurrence_period" field.
+func RecurrencePeriodIn(vs ...credit.RecurrencePeriod) predicate.GrantSchedule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.GrantSchedule(sql.FieldIn(FieldRecurrencePeriod, v...))
+}
+
+// RecurrencePeriodNotIn applies the NotIn predicate on the "recurrence_period" field.
+func RecurrencePeriodNotIn(vs ...credit.RecurrencePeriod) predicate.GrantSchedule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldRecurrencePeriod, v...))
+}
+
+// RecurrenceAnchorEQ applies the EQ predicate on the "recurrence_anchor" field.
+func RecurrenceAnchorEQ(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldRecurrenceAnchor, v))
+}
+
+// RecurrenceAnchorNEQ applies the NEQ predicate on the "recurrence_anchor" field.
+func RecurrenceAnchorNEQ(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldRecurrenceAnchor, v))
+}
+
+// RecurrenceAnchorIn applies the In predicate on the "recurrence_anchor" field.
+func RecurrenceAnchorIn(vs ...time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIn(FieldRecurrenceAnchor, vs...))
+}
+
+// RecurrenceAnchorNotIn applies the NotIn predicate on the "recurrence_anchor" field.
+func RecurrenceAnchorNotIn(vs ...time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldRecurrenceAnchor, vs...))
+}
+
+// RecurrenceAnchorGT applies the GT predicate on the "recurrence_anchor" field.
+func RecurrenceAnchorGT(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGT(FieldRecurrenceAnchor, v))
+}
+
+// RecurrenceAnchorGTE applies the GTE predicate on the "recurrence_anchor" field.
+func RecurrenceAnchorGTE(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGTE(FieldRecurrenceAnchor, v))
+}
+
+// RecurrenceAnchorLT applies the LT predicate on the "recurrence_anchor" field.
+func RecurrenceAnchorLT(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLT(FieldRecurrenceAnchor, v))
+}
+
+// RecurrenceAnchorLTE applies the LTE predicate on the "recurrence_anchor" field.
+func RecurrenceAnchorLTE(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLTE(FieldRecurrenceAnchor, v))
+}
+
+// NextGrantIndexEQ applies the EQ predicate on the "next_grant_index" field.
+func NextGrantIndexEQ(v int) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldNextGrantIndex, v))
+}
+
+// NextGrantIndexNEQ applies the NEQ predicate on the "next_grant_index" field.
+func NextGrantIndexNEQ(v int) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldNextGrantIndex, v))
+}
+
+// NextGrantIndexIn applies the In predicate on the "next_grant_index" field.
+func NextGrantIndexIn(vs ...int) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIn(FieldNextGrantIndex, vs...))
+}
+
+// NextGrantIndexNotIn applies the NotIn predicate on the "next_grant_index" field.
+func NextGrantIndexNotIn(vs ...int) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldNextGrantIndex, vs...))
+}
+
+// NextGrantIndexGT applies the GT predicate on the "next_grant_index" field.
+func NextGrantIndexGT(v int) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGT(FieldNextGrantIndex, v))
+}
+
+// NextGrantIndexGTE applies the GTE predicate on the "next_grant_index" field.
+func NextGrantIndexGTE(v int) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGTE(FieldNextGrantIndex, v))
+}
+
+// NextGrantIndexLT applies the LT predicate on the "next_grant_index" field.
+func NextGrantIndexLT(v int) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLT(FieldNextGrantIndex, v))
+}
+
+// NextGrantIndexLTE applies the LTE predicate on the "next_grant_index" field.
+func NextGrantIndexLTE(v int) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLTE(FieldNextGrantIndex, v))
+}
+
+// NextGrantAtEQ applies the EQ predicate on the "next_grant_at" field.
+func NextGrantAtEQ(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldEQ(FieldNextGrantAt, v))
+}
+
+// NextGrantAtNEQ applies the NEQ predicate on the "next_grant_at" field.
+func NextGrantAtNEQ(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNEQ(FieldNextGrantAt, v))
+}
+
+// NextGrantAtIn applies the In predicate on the "next_grant_at" field.
+func NextGrantAtIn(vs ...time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldIn(FieldNextGrantAt, vs...))
+}
+
+// NextGrantAtNotIn applies the NotIn predicate on the "next_grant_at" field.
+func NextGrantAtNotIn(vs ...time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldNotIn(FieldNextGrantAt, vs...))
+}
+
+// NextGrantAtGT applies the GT predicate on the "next_grant_at" field.
+func NextGrantAtGT(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGT(FieldNextGrantAt, v))
+}
+
+// NextGrantAtGTE applies the GTE predicate on the "next_grant_at" field.
+func NextGrantAtGTE(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldGTE(FieldNextGrantAt, v))
+}
+
+// NextGrantAtLT applies the LT predicate on the "next_grant_at" field.
+func NextGrantAtLT(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLT(FieldNextGrantAt, v))
+}
+
+// NextGrantAtLTE applies the LTE predicate on the "next_grant_at" field.
+func NextGrantAtLTE(v time.Time) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.FieldLTE(FieldNextGrantAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GrantSchedule) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GrantSchedule) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GrantSchedule) predicate.GrantSchedule {
+	return predicate.GrantSchedule(sql.NotPredicates(p))
+}
@@ -0,0 +1,1040 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/grantschedule"
+)
+
+// GrantScheduleCreate is the builder for creating a GrantSchedule entity.
+type GrantScheduleCreate struct {
+	config
+	mutation *GrantScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gsc *GrantScheduleCreate) SetCreatedAt(t time.Time) *GrantScheduleCreate {
+	gsc.mutation.SetCreatedAt(t)
+	return gsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gsc *GrantScheduleCreate) SetNillableCreatedAt(t *time.Time) *GrantScheduleCreate {
+	if t != nil {
+		gsc.SetCreatedAt(*t)
+	}
+	return gsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gsc *GrantScheduleCreate) SetUpdatedAt(t time.Time) *GrantScheduleCreate {
+	gsc.mutation.SetUpdatedAt(t)
+	return gsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (gsc *GrantScheduleCreate) SetNillableUpdatedAt(t *time.Time) *GrantScheduleCreate {
+	if t != nil {
+		gsc.SetUpdatedAt(*t)
+	}
+	return gsc
+}
+
+// SetNamespace sets the "namespace" field.
+func (gsc *GrantScheduleCreate) SetNamespace(s string) *GrantScheduleCreate {
+	gsc.mutation.SetNamespace(s)
+	return gsc
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (gsc *GrantScheduleCreate) SetLedgerID(s string) *GrantScheduleCreate {
+	gsc.mutation.SetLedgerID(s)
+	return gsc
+}
+
+// SetType sets the "type" field.
+func (gsc *GrantScheduleCreate) SetType(ct credit.GrantType) *GrantScheduleCreate {
+	gsc.mutation.SetType(ct)
+	return gsc
+}
+
+// SetFeatureID sets the "feature_id" field.
+func (gsc *GrantScheduleCreate) SetFeatureID(s string) *GrantScheduleCreate {
+	gsc.mutation.SetFeatureID(s)
+	return gsc
+}
+
+// SetNillableFeatureID sets the "feature_id" field if the given value is not nil.
+func (gsc *GrantScheduleCreate) SetNillableFeatureID(s *string) *GrantScheduleCreate {
+	if s != nil {
+		gsc.SetFeatureID(*s)
+	}
+	return gsc
+}
+
+// SetAmount sets the "amount" field.
+func (gsc *GrantScheduleCreate) SetAmount(f float64) *GrantScheduleCreate {
+	gsc.mutation.SetAmount(f)
+	return gsc
+}
+
+// SetPriority sets the "priority" field.
+func (gsc *GrantScheduleCreate) SetPriority(u uint8) *GrantScheduleCreate {
+	gsc.mutation.SetPriority(u)
+	return gsc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (gsc *GrantScheduleCreate) SetNillablePriority(u *uint8) *GrantScheduleCreate {
+	if u != nil {
+		gsc.SetPriority(*u)
+	}
+	return gsc
+}
+
+// SetExpirationPeriodDuration sets the "expiration_period_duration" field.
+func (gsc *GrantScheduleCreate) SetExpirationPeriodDuration(cpd credit.ExpirationPeriodDuration) *GrantScheduleCreate {
+	gsc.mutation.SetExpirationPeriodDuration(cpd)
+	return gsc
+}
+
+// SetExpirationPeriodCount sets the "expiration_period_count" field.
+func (gsc *GrantScheduleCreate) SetExpirationPeriodCount(u uint8) *GrantScheduleCreate {
+	gsc.mutation.SetExpirationPeriodCount(u)
+	return gsc
+}
+
+// SetRolloverType sets the "rollover_type" field.
+func (gsc *GrantScheduleCreate) SetRolloverType(crt credit.GrantRolloverType) *GrantScheduleCreate {
+	gsc.mutation.SetRolloverType(crt)
+	return gsc
+}
+
+// SetNillableRolloverType sets the "rollover_type" field if the given value is not nil.
+func (gsc *GrantScheduleCreate) SetNillableRolloverType(crt *credit.GrantRolloverType) *GrantScheduleCreate {
+	if crt != nil {
+		gsc.SetRolloverType(*crt)
+	}
+	return gsc
+}
+
+// SetRolloverMaxAmount sets the "rollover_max_amount" field.
+func (gsc *GrantScheduleCreate) SetRolloverMaxAmount(f float64) *GrantScheduleCreate {
+	gsc.mutation.SetRolloverMaxAmount(f)
+	return gsc
+}
+
+// SetNillableRolloverMaxAmount sets the "rollover_max_amount" field if the given value is not nil.
+func (gsc *GrantScheduleCreate) SetNillableRolloverMaxAmount(f *float64) *GrantScheduleCreate {
+	if f != nil {
+		gsc.SetRolloverMaxAmount(*f)
+	}
+	return gsc
+}
+
+// SetMetadata sets the "metadata" field.
+func (gsc *GrantScheduleCreate) SetMetadata(m map[string]string) *GrantScheduleCreate {
+	gsc.mutation.SetMetadata(m)
+	return gsc
+}
+
+// SetRecurrencePeriod sets the "recurrence_period" field.
+func (gsc *GrantScheduleCreate) SetRecurrencePeriod(cp credit.RecurrencePeriod) *GrantScheduleCreate {
+	gsc.mutation.SetRecurrencePeriod(cp)
+	return gsc
+}
+
+// SetRecurrenceAnchor sets the "recurrence_anchor" field.
+func (gsc *GrantScheduleCreate) SetRecurrenceAnchor(t time.Time) *GrantScheduleCreate {
+	gsc.mutation.SetRecurrenceAnchor(t)
+	return gsc
+}
+
+// SetNextGrantIndex sets the "next_grant_index" field.
+func (gsc *GrantScheduleCreate) SetNextGrantIndex(i int) *GrantScheduleCreate {
+	gsc.mutation.SetNextGrantIndex(i)
+	return gsc
+}
+
+// SetNillableNextGrantIndex sets the "next_grant_index" field if the given value is not nil.
+func (gsc *GrantScheduleCreate) SetNillableNextGrantIndex(i *int) *GrantScheduleCreate {
+	if i != nil {
+		gsc.SetNextGrantIndex(*i)
+	}
+	return gsc
+}
+
+// SetNextGrantAt sets the "next_grant_at" field.
+func (gsc *GrantScheduleCreate) SetNextGrantAt(t time.Time) *GrantScheduleCreate {
+	gsc.mutation.SetNextGrantAt(t)
+	return gsc
+}
+
+// SetID sets the "id" field.
+func (gsc *GrantScheduleCreate) SetID(s string) *GrantScheduleCreate {
+	gsc.mutation.SetID(s)
+	return gsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (gsc *GrantScheduleCreate) SetNillableID(s *string) *GrantScheduleCreate {
+	if s != nil {
+		gsc.SetID(*s)
+	}
+	return gsc
+}
+
+// Mutation returns the GrantScheduleMutation object of the builder.
+func (gsc *GrantScheduleCreate) Mutation() *GrantScheduleMutation {
+	return gsc.mutation
+}
+
+// Save creates the GrantSchedule in the database.
+func (gsc *GrantScheduleCreate) Save(ctx context.Context) (*GrantSchedule, error) {
+	gsc.defaults()
+	return withHooks(ctx, gsc.sqlSave, gsc.mutation, gsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gsc *GrantScheduleCreate) SaveX(ctx context.Context) *GrantSchedule {
+	v, err := gsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gsc *GrantScheduleCreate) Exec(ctx context.Context) error {
+	_, err := gsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gsc *GrantScheduleCreate) ExecX(ctx context.Context) {
+	if err := gsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gsc *GrantScheduleCreate) defaults() {
+	if _, ok := gsc.mutation.CreatedAt(); !ok {
+		v := grantschedule.DefaultCreatedAt()
+		gsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := gsc.mutation.UpdatedAt(); !ok {
+		v := grantschedule.DefaultUpdatedAt()
+		gsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := gsc.mutation.Priority(); !ok {
+		v := grantschedule.DefaultPriority
+		gsc.mutation.SetPriority(v)
+	}
+	if _, ok := gsc.mutation.NextGrantIndex(); !ok {
+		v := grantschedule.DefaultNextGrantIndex
+		gsc.mutation.SetNextGrantIndex(v)
+	}
+	if _, ok := gsc.mutation.ID(); !ok {
+		v := grantschedule.DefaultID()
+		gsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gsc *GrantScheduleCreate) check() error {
+	if _, ok := gsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "GrantSchedule.created_at"`)}
+	}
+	if _, ok := gsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "GrantSchedule.updated_at"`)}
+	}
+	if _, ok := gsc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`db: missing required field "GrantSchedule.namespace"`)}
+	}
+	if v, ok := gsc.mutation.Namespace(); ok {
+		if err := grantschedule.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`db: validator failed for field "GrantSchedule.namespace": %w`, err)}
+		}
+	}
+	if _, ok := gsc.mutation.LedgerID(); !ok {
+		return &ValidationError{Name: "ledger_id", err: errors.New(`db: missing required field "GrantSchedule.ledger_id"`)}
+	}
+	if _, ok := gsc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`db: missing required field "GrantSchedule.type"`)}
+	}
+	if v, ok := gsc.mutation.GetType(); ok {
+		if err := grantschedule.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`db: validator failed for field "GrantSchedule.type": %w`, err)}
+		}
+	}
+	if _, ok := gsc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`db: missing required field "GrantSchedule.amount"`)}
+	}
+	if _, ok := gsc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`db: missing required field "GrantSchedule.priority"`)}
+	}
+	if _, ok := gsc.mutation.ExpirationPeriodDuration(); !ok {
+		return &ValidationError{Name: "expiration_period_duration", err: errors.New(`db: missing required field "GrantSchedule.expiration_period_duration"`)}
+	}
+	if v, ok := gsc.mutation.ExpirationPeriodDuration(); ok {
+		if err := grantschedule.ExpirationPeriodDurationValidator(v); err != nil {
+			return &ValidationError{Name: "expiration_period_duration", err: fmt.Errorf(`db: validator failed for field "GrantSchedule.expiration_period_duration": %w`, err)}
+		}
+	}
+	if _, ok := gsc.mutation.ExpirationPeriodCount(); !ok {
+		return &ValidationError{Name: "expiration_period_count", err: errors.New(`db: missing required field "GrantSchedule.expiration_period_count"`)}
+	}
+	if v, ok := gsc.mutation.RolloverType(); ok {
+		if err := grantschedule.RolloverTypeValidator(v); err != nil {
+			return &ValidationError{Name: "rollover_type", err: fmt.Errorf(`db: validator failed for field "GrantSchedule.rollover_type": %w`, err)}
+		}
+	}
+	if _, ok := gsc.mutation.RecurrencePeriod(); !ok {
+		return &ValidationError{Name: "recurrence_period", err: errors.New(`db: missing required field "GrantSchedule.recurrence_period"`)}
+	}
+	if v, ok := gsc.mutation.RecurrencePeriod(); ok {
+		if err := grantschedule.RecurrencePeriodValidator(v); err != nil {
+			return &ValidationError{Name: "recurrence_period", err: fmt.Errorf(`db: validator failed for field "GrantSchedule.recurrence_period": %w`, err)}
+		}
+	}
+	if _, ok := gsc.mutation.RecurrenceAnchor(); !ok {
+		return &ValidationError{Name: "recurrence_anchor", err: errors.New(`db: missing required field "GrantSchedule.recurrence_anchor"`)}
+	}
+	if _, ok := gsc.mutation.NextGrantIndex(); !ok {
+		return &ValidationError{Name: "next_grant_index", err: errors.New(`db: missing required field "GrantSchedule.next_grant_index"`)}
+	}
+	if _, ok := gsc.mutation.NextGrantAt(); !ok {
+		return &ValidationError{Name: "next_grant_at", err: errors.New(`db: missing required field "GrantSchedule.next_grant_at"`)}
+	}
+	return nil
+}
+
+func (gsc *GrantScheduleCreate) sqlSave(ctx context.Context) (*GrantSchedule, error) {
+	if err := gsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected GrantSchedule.ID type: %T", _spec.ID.Value)
+		}
+	}
+	gsc.mutation.id = &_node.ID
+	gsc.mutation.done = true
+	return _node, nil
+}
+
+func (gsc *GrantScheduleCreate) createSpec() (*GrantSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GrantSchedule{config: gsc.config}
+		_spec = sqlgraph.NewCreateSpec(grantschedule.Table, sqlgraph.NewFieldSpec(grantschedule.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = gsc.conflict
+	if id, ok := gsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := gsc.mutation.CreatedAt(); ok {
+		_spec.SetField(grantschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := gsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(grantschedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := gsc.mutation.Namespace(); ok {
+		_spec.SetField(grantschedule.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := gsc.mutation.LedgerID(); ok {
+		_spec.SetField(grantschedule.FieldLedgerID, field.TypeString, value)
+		_node.LedgerID = value
+	}
+	if value, ok := gsc.mutation.GetType(); ok {
+		_spec.SetField(grantschedule.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := gsc.mutation.FeatureID(); ok {
+		_spec.SetField(grantschedule.FieldFeatureID, field.TypeString, value)
+		_node.FeatureID = &value
+	}
+	if value, ok := gsc.mutation.Amount(); ok {
+		_spec.SetField(grantschedule.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := gsc.mutation.Priority(); ok {
+		_spec.SetField(grantschedule.FieldPriority, field.TypeUint8, value)
+		_node.Priority = value
+	}
+	if value, ok := gsc.mutation.ExpirationPeriodDuration(); ok {
+		_spec.SetField(grantschedule.FieldExpirationPeriodDuration, field.TypeEnum, value)
+		_node.ExpirationPeriodDuration = value
+	}
+	if value, ok := gsc.mutation.ExpirationPeriodCount(); ok {
+		_spec.SetField(grantschedule.FieldExpirationPeriodCount, field.TypeUint8, value)
+		_node.ExpirationPeriodCount = value
+	}
+	if value, ok := gsc.mutation.RolloverType(); ok {
+		_spec.SetField(grantschedule.FieldRolloverType, field.TypeEnum, value)
+		_node.RolloverType = &value
+	}
+	if value, ok := gsc.mutation.RolloverMaxAmount(); ok {
+		_spec.SetField(grantschedule.FieldRolloverMaxAmount, field.TypeFloat64, value)
+		_node.RolloverMaxAmount = &value
+	}
+	if value, ok := gsc.mutation.Metadata(); ok {
+		_spec.SetField(grantschedule.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := gsc.mutation.RecurrencePeriod(); ok {
+		_spec.SetField(grantschedule.FieldRecurrencePeriod, field.TypeEnum, value)
+		_node.RecurrencePeriod = value
+	}
+	if value, ok := gsc.mutation.RecurrenceAnchor(); ok {
+		_spec.SetField(grantschedule.FieldRecurrenceAnchor, field.TypeTime, value)
+		_node.RecurrenceAnchor = value
+	}
+	if value, ok := gsc.mutation.NextGrantIndex(); ok {
+		_spec.SetField(grantschedule.FieldNextGrantIndex, field.TypeInt, value)
+		_node.NextGrantIndex = value
+	}
+	if value, ok := gsc.mutation.NextGrantAt(); ok {
+		_spec.SetField(grantschedule.FieldNextGrantAt, field.TypeTime, value)
+		_node.NextGrantAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GrantSchedule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GrantScheduleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (gsc *GrantScheduleCreate) OnConflict(opts ...sql.ConflictOption) *GrantScheduleUpsertOne {
+	gsc.conflict = opts
+	return &GrantScheduleUpsertOne{
+		create: gsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GrantSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gsc *GrantScheduleCreate) OnConflictColumns(columns ...string) *GrantScheduleUpsertOne {
+	gsc.conflict = append(gsc.conflict, sql.ConflictColumns(columns...))
+	return &GrantScheduleUpsertOne{
+		create: gsc,
+	}
+}
+
+type (
+	// GrantScheduleUpsertOne is the builder for "upsert"-ing
+	//  one GrantSchedule node.
+	GrantScheduleUpsertOne struct {
+		create *GrantScheduleCreate
+	}
+
+	// GrantScheduleUpsert is the "OnConflict" setter.
+	GrantScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GrantScheduleUpsert) SetUpdatedAt(v time.Time) *GrantScheduleUpsert {
+	u.Set(grantschedule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GrantScheduleUpsert) UpdateUpdatedAt() *GrantScheduleUpsert {
+	u.SetExcluded(grantschedule.FieldUpdatedAt)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *GrantScheduleUpsert) SetMetadata(v map[string]string) *GrantScheduleUpsert {
+	u.Set(grantschedule.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *GrantScheduleUpsert) UpdateMetadata() *GrantScheduleUpsert {
+	u.SetExcluded(grantschedule.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *GrantScheduleUpsert) ClearMetadata() *GrantScheduleUpsert {
+	u.SetNull(grantschedule.FieldMetadata)
+	return u
+}
+
+// SetNextGrantIndex sets the "next_grant_index" field.
+func (u *GrantScheduleUpsert) SetNextGrantIndex(v int) *GrantScheduleUpsert {
+	u.Set(grantschedule.FieldNextGrantIndex, v)
+	return u
+}
+
+// UpdateNextGrantIndex sets the "next_grant_index" field to the value that was provided on create.
+func (u *GrantScheduleUpsert) UpdateNextGrantIndex() *GrantScheduleUpsert {
+	u.SetExcluded(grantschedule.FieldNextGrantIndex)
+	return u
+}
+
+// AddNextGrantIndex adds v to the "next_grant_index" field.
+func (u *GrantScheduleUpsert) AddNextGrantIndex(v int) *GrantScheduleUpsert {
+	u.Add(grantschedule.FieldNextGrantIndex, v)
+	return u
+}
+
+// SetNextGrantAt sets the "next_grant_at" field.
+func (u *GrantScheduleUpsert) SetNextGrantAt(v time.Time) *GrantScheduleUpsert {
+	u.Set(grantschedule.FieldNextGrantAt, v)
+	return u
+}
+
+// UpdateNextGrantAt sets the "next_grant_at" field to the value that was provided on create.
+func (u *GrantScheduleUpsert) UpdateNextGrantAt() *GrantScheduleUpsert {
+	u.SetExcluded(grantschedule.FieldNextGrantAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GrantSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(grantschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GrantScheduleUpsertOne) UpdateNewValues() *GrantScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(grantschedule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(grantschedule.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Namespace(); exists {
+			s.SetIgnore(grantschedule.FieldNamespace)
+		}
+		if _, exists := u.create.mutation.LedgerID(); exists {
+			s.SetIgnore(grantschedule.FieldLedgerID)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(grantschedule.FieldType)
+		}
+		if _, exists := u.create.mutation.FeatureID(); exists {
+			s.SetIgnore(grantschedule.FieldFeatureID)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(grantschedule.FieldAmount)
+		}
+		if _, exists := u.create.mutation.Priority(); exists {
+			s.SetIgnore(grantschedule.FieldPriority)
+		}
+		if _, exists := u.create.mutation.ExpirationPeriodDuration(); exists {
+			s.SetIgnore(grantschedule.FieldExpirationPeriodDuration)
+		}
+		if _, exists := u.create.mutation.ExpirationPeriodCount(); exists {
+			s.SetIgnore(grantschedule.FieldExpirationPeriodCount)
+		}
+		if _, exists := u.create.mutation.RolloverType(); exists {
+			s.SetIgnore(grantschedule.FieldRolloverType)
+		}
+		if _, exists := u.create.mutation.RolloverMaxAmount(); exists {
+			s.SetIgnore(grantschedule.FieldRolloverMaxAmount)
+		}
+		if _, exists := u.create.mutation.RecurrencePeriod(); exists {
+			s.SetIgnore(grantschedule.FieldRecurrencePeriod)
+		}
+		if _, exists := u.create.mutation.RecurrenceAnchor(); exists {
+			s.SetIgnore(grantschedule.FieldRecurrenceAnchor)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GrantSchedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GrantScheduleUpsertOne) Ignore() *GrantScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GrantScheduleUpsertOne) DoNothing() *GrantScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GrantScheduleCreate.OnConflict
+// documentation for more info.
+func (u *GrantScheduleUpsertOne) Update(set func(*GrantScheduleUpsert)) *GrantScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GrantScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GrantScheduleUpsertOne) SetUpdatedAt(v time.Time) *GrantScheduleUpsertOne {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GrantScheduleUpsertOne) UpdateUpdatedAt() *GrantScheduleUpsertOne {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *GrantScheduleUpsertOne) SetMetadata(v map[string]string) *GrantScheduleUpsertOne {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *GrantScheduleUpsertOne) UpdateMetadata() *GrantScheduleUpsertOne {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *GrantScheduleUpsertOne) ClearMetadata() *GrantScheduleUpsertOne {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetNextGrantIndex sets the "next_grant_index" field.
+func (u *GrantScheduleUpsertOne) SetNextGrantIndex(v int) *GrantScheduleUpsertOne {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.SetNextGrantIndex(v)
+	})
+}
+
+// AddNextGrantIndex adds v to the "next_grant_index" field.
+func (u *GrantScheduleUpsertOne) AddNextGrantIndex(v int) *GrantScheduleUpsertOne {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.AddNextGrantIndex(v)
+	})
+}
+
+// UpdateNextGrantIndex sets the "next_grant_index" field to the value that was provided on create.
+func (u *GrantScheduleUpsertOne) UpdateNextGrantIndex() *GrantScheduleUpsertOne {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.UpdateNextGrantIndex()
+	})
+}
+
+// SetNextGrantAt sets the "next_grant_at" field.
+func (u *GrantScheduleUpsertOne) SetNextGrantAt(v time.Time) *GrantScheduleUpsertOne {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.SetNextGrantAt(v)
+	})
+}
+
+// UpdateNextGrantAt sets the "next_grant_at" field to the value that was provided on create.
+func (u *GrantScheduleUpsertOne) UpdateNextGrantAt() *GrantScheduleUpsertOne {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.UpdateNextGrantAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GrantScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for GrantScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GrantScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GrantScheduleUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: GrantScheduleUpsertOne.ID is not supported by MySQL driver. Use GrantScheduleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GrantScheduleUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GrantScheduleCreateBulk is the builder for creating many GrantSchedule entities in bulk.
+type GrantScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*GrantScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GrantSchedule entities in the database.
+func (gscb *GrantScheduleCreateBulk) Save(ctx context.Context) ([]*GrantSchedule, error) {
+	if gscb.err != nil {
+		return nil, gscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gscb.builders))
+	nodes := make([]*GrantSchedule, len(gscb.builders))
+	mutators := make([]Mutator, len(gscb.builders))
+	for i := range gscb.builders {
+		func(i int, root context.Context) {
+			builder := gscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GrantScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = gscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gscb *GrantScheduleCreateBulk) SaveX(ctx context.Context) []*GrantSchedule {
+	v, err := gscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gscb *GrantScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := gscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gscb *GrantScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := gscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GrantSchedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GrantScheduleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (gscb *GrantScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *GrantScheduleUpsertBulk {
+	gscb.conflict = opts
+	return &GrantScheduleUpsertBulk{
+		create: gscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GrantSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gscb *GrantScheduleCreateBulk) OnConflictColumns(columns ...string) *GrantScheduleUpsertBulk {
+	gscb.conflict = append(gscb.conflict, sql.ConflictColumns(columns...))
+	return &GrantScheduleUpsertBulk{
+		create: gscb,
+	}
+}
+
+// GrantScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of GrantSchedule nodes.
+type GrantScheduleUpsertBulk struct {
+	create *GrantScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GrantSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(grantschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GrantScheduleUpsertBulk) UpdateNewValues() *GrantScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(grantschedule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(grantschedule.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Namespace(); exists {
+				s.SetIgnore(grantschedule.FieldNamespace)
+			}
+			if _, exists := b.mutation.LedgerID(); exists {
+				s.SetIgnore(grantschedule.FieldLedgerID)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(grantschedule.FieldType)
+			}
+			if _, exists := b.mutation.FeatureID(); exists {
+				s.SetIgnore(grantschedule.FieldFeatureID)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(grantschedule.FieldAmount)
+			}
+			if _, exists := b.mutation.Priority(); exists {
+				s.SetIgnore(grantschedule.FieldPriority)
+			}
+			if _, exists := b.mutation.ExpirationPeriodDuration(); exists {
+				s.SetIgnore(grantschedule.FieldExpirationPeriodDuration)
+			}
+			if _, exists := b.mutation.ExpirationPeriodCount(); exists {
+				s.SetIgnore(grantschedule.FieldExpirationPeriodCount)
+			}
+			if _, exists := b.mutation.RolloverType(); exists {
+				s.SetIgnore(grantschedule.FieldRolloverType)
+			}
+			if _, exists := b.mutation.RolloverMaxAmount(); exists {
+				s.SetIgnore(grantschedule.FieldRolloverMaxAmount)
+			}
+			if _, exists := b.mutation.RecurrencePeriod(); exists {
+				s.SetIgnore(grantschedule.FieldRecurrencePeriod)
+			}
+			if _, exists := b.mutation.RecurrenceAnchor(); exists {
+				s.SetIgnore(grantschedule.FieldRecurrenceAnchor)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GrantSchedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GrantScheduleUpsertBulk) Ignore() *GrantScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GrantScheduleUpsertBulk) DoNothing() *GrantScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GrantScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *GrantScheduleUpsertBulk) Update(set func(*GrantScheduleUpsert)) *GrantScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GrantScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GrantScheduleUpsertBulk) SetUpdatedAt(v time.Time) *GrantScheduleUpsertBulk {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GrantScheduleUpsertBulk) UpdateUpdatedAt() *GrantScheduleUpsertBulk {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *GrantScheduleUpsertBulk) SetMetadata(v map[string]string) *GrantScheduleUpsertBulk {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *GrantScheduleUpsertBulk) UpdateMetadata() *GrantScheduleUpsertBulk {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *GrantScheduleUpsertBulk) ClearMetadata() *GrantScheduleUpsertBulk {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetNextGrantIndex sets the "next_grant_index" field.
+func (u *GrantScheduleUpsertBulk) SetNextGrantIndex(v int) *GrantScheduleUpsertBulk {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.SetNextGrantIndex(v)
+	})
+}
+
+// AddNextGrantIndex adds v to the "next_grant_index" field.
+func (u *GrantScheduleUpsertBulk) AddNextGrantIndex(v int) *GrantScheduleUpsertBulk {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.AddNextGrantIndex(v)
+	})
+}
+
+// UpdateNextGrantIndex sets the "next_grant_index" field to the value that was provided on create.
+func (u *GrantScheduleUpsertBulk) UpdateNextGrantIndex() *GrantScheduleUpsertBulk {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.UpdateNextGrantIndex()
+	})
+}
+
+// SetNextGrantAt sets the "next_grant_at" field.
+func (u *GrantScheduleUpsertBulk) SetNextGrantAt(v time.Time) *GrantScheduleUpsertBulk {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.SetNextGrantAt(v)
+	})
+}
+
+// UpdateNextGrantAt sets the "next_grant_at" field to the value that was provided on create.
+func (u *GrantScheduleUpsertBulk) UpdateNextGrantAt() *GrantScheduleUpsertBulk {
+	return u.Update(func(s *GrantScheduleUpsert) {
+		s.UpdateNextGrantAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GrantScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the GrantScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for GrantScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GrantScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
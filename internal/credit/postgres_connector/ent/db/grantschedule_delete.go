@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/grantschedule"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+)
+
+// GrantScheduleDelete is the builder for deleting a GrantSchedule entity.
+type GrantScheduleDelete struct {
+	config
+	hooks    []Hook
+	mutation *GrantScheduleMutation
+}
+
+// Where appends a list predicates to the GrantScheduleDelete builder.
+func (gsd *GrantScheduleDelete) Where(ps ...predicate.GrantSchedule) *GrantScheduleDelete {
+	gsd.mutation.Where(ps...)
+	return gsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gsd *GrantScheduleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gsd.sqlExec, gsd.mutation, gsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gsd *GrantScheduleDelete) ExecX(ctx context.Context) int {
+	n, err := gsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gsd *GrantScheduleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(grantschedule.Table, sqlgraph.NewFieldSpec(grantschedule.FieldID, field.TypeString))
+	if ps := gsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gsd.mutation.done = true
+	return affected, err
+}
+
+// GrantScheduleDeleteOne is the builder for deleting a single GrantSchedule entity.
+type GrantScheduleDeleteOne struct {
+	gsd *GrantScheduleDelete
+}
+
+// Where appends a list predicates to the GrantScheduleDelete builder.
+func (gsdo *GrantScheduleDeleteOne) Where(ps ...predicate.GrantSchedule) *GrantScheduleDeleteOne {
+	gsdo.gsd.mutation.Where(ps...)
+	return gsdo
+}
+
+// Exec executes the deletion query.
+func (gsdo *GrantScheduleDeleteOne) Exec(ctx context.Context) error {
+	n, err := gsdo.gsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{grantschedule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gsdo *GrantScheduleDeleteOne) ExecX(ctx context.Context) {
+	if err := gsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/grantschedule"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+)
+
+// GrantScheduleQuery is the builder for querying GrantSchedule entities.
+type GrantScheduleQuery struct {
+	config
+	ctx        *QueryContext
+	order      []grantschedule.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GrantSchedule
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GrantScheduleQuery builder.
+func (gsq *GrantScheduleQuery) Where(ps ...predicate.GrantSchedule) *GrantScheduleQuery {
+	gsq.predicates = append(gsq.predicates, ps...)
+	return gsq
+}
+
+// Limit the number of records to be returned by this query.
+func (gsq *GrantScheduleQuery) Limit(limit int) *GrantScheduleQuery {
+	gsq.ctx.Limit = &limit
+	return gsq
+}
+
+// Offset to start from.
+func (gsq *GrantScheduleQuery) Offset(offset int) *GrantScheduleQuery {
+	gsq.ctx.Offset = &offset
+	return gsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gsq *GrantScheduleQuery) Unique(unique bool) *GrantScheduleQuery {
+	gsq.ctx.Unique = &unique
+	return gsq
+}
+
+// Order specifies how the records should be ordered.
+func (gsq *GrantScheduleQuery) Order(o ...grantschedule.OrderOption) *GrantScheduleQuery {
+	gsq.order = append(gsq.order, o...)
+	return gsq
+}
+
+// First returns the first GrantSchedule entity from the query.
+// Returns a *NotFoundError when no GrantSchedule was found.
+func (gsq *GrantScheduleQuery) First(ctx context.Context) (*GrantSchedule, error) {
+	nodes, err := gsq.Limit(1).All(setContextOp(ctx, gsq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{grantschedule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gsq *GrantScheduleQuery) FirstX(ctx context.Context) *GrantSchedule {
+	node, err := gsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GrantSchedule ID from the query.
+// Returns a *NotFoundError when no GrantSchedule ID was found.
+func (gsq *GrantScheduleQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = gsq.Limit(1).IDs(setContextOp(ctx, gsq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{grantschedule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gsq *GrantScheduleQuery) FirstIDX(ctx context.Context) string {
+	id, err := gsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GrantSchedule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GrantSchedule entity is found.
+// Returns a *NotFoundError when no GrantSchedule entities are found.
+func (gsq *GrantScheduleQuery) Only(ctx context.Context) (*GrantSchedule, error) {
+	nodes, err := gsq.Limit(2).All(setContextOp(ctx, gsq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{grantschedule.Label}
+	default:
+		return nil, &NotSingularError{grantschedule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gsq *GrantScheduleQuery) OnlyX(ctx context.Context) *GrantSchedule {
+	node, err := gsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GrantSchedule ID in the query.
+// Returns a *NotSingularError when more than one GrantSchedule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gsq *GrantScheduleQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = gsq.Limit(2).IDs(setContextOp(ctx, gsq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{grantschedule.Label}
+	default:
+		err = &NotSingularError{grantschedule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gsq *GrantScheduleQuery) OnlyIDX(ctx context.Context) string {
+	id, err := gsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GrantSchedules.
+func (gsq *GrantScheduleQuery) All(ctx context.Context) ([]*GrantSchedule, error) {
+	ctx = setContextOp(ctx, gsq.ctx, "All")
+	if err := gsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GrantSchedule, *GrantScheduleQuery]()
+	return withInterceptors[[]*GrantSchedule](ctx, gsq, qr, gsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gsq *GrantScheduleQuery) AllX(ctx context.Context) []*GrantSchedule {
+	nodes, err := gsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GrantSchedule IDs.
+func (gsq *GrantScheduleQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if gsq.ctx.Unique == nil && gsq.path != nil {
+		gsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gsq.ctx, "IDs")
+	if err = gsq.Select(grantschedule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gsq *GrantScheduleQuery) IDsX(ctx context.Context) []string {
+	ids, err := gsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gsq *GrantScheduleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gsq.ctx, "Count")
+	if err := gsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gsq, querierCount[*GrantScheduleQuery](), gsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gsq *GrantScheduleQuery) CountX(ctx context.Context) int {
+	count, err := gsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gsq *GrantScheduleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gsq.ctx, "Exist")
+	switch _, err := gsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gsq *GrantScheduleQuery) ExistX(ctx context.Context) bool {
+	exist, err := gsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GrantScheduleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gsq *GrantScheduleQuery) Clone() *GrantScheduleQuery {
+	if gsq == nil {
+		return nil
+	}
+	return &GrantScheduleQuery{
+		config:     gsq.config,
+		ctx:        gsq.ctx.Clone(),
+		order:      append([]grantschedule.OrderOption{}, gsq.order...),
+		inters:     append([]Interceptor{}, gsq.inters...),
+		predicates: append([]predicate.GrantSchedule{}, gsq.predicates...),
+		// clone intermediate query.
+		sql:  gsq.sql.Clone(),
+		path: gsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GrantSchedule.Query().
+//		GroupBy(grantschedule.FieldCreatedAt).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (gsq *GrantScheduleQuery) GroupBy(field string, fields ...string) *GrantScheduleGroupBy {
+	gsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GrantScheduleGroupBy{build: gsq}
+	grbuild.flds = &gsq.ctx.Fields
+	grbuild.label = grantschedule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.GrantSchedule.Query().
+//		Select(grantschedule.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (gsq *GrantScheduleQuery) Select(fields ...string) *GrantScheduleSelect {
+	gsq.ctx.Fields = append(gsq.ctx.Fields, fields...)
+	sbuild := &GrantScheduleSelect{GrantScheduleQuery: gsq}
+	sbuild.label = grantschedule.Label
+	sbuild.flds, sbuild.scan = &gsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GrantScheduleSelect configured with the given aggregations.
+func (gsq *GrantScheduleQuery) Aggregate(fns ...AggregateFunc) *GrantScheduleSelect {
+	return gsq.Select().Aggregate(fns...)
+}
+
+func (gsq *GrantScheduleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gsq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gsq.ctx.Fields {
+		if !grantschedule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if gsq.path != nil {
+		prev, err := gsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gsq.sql = prev
+	}
+	return nil
+}
+
+func (gsq *GrantScheduleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GrantSchedule, error) {
+	var (
+		nodes = []*GrantSchedule{}
+		_spec = gsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GrantSchedule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GrantSchedule{config: gsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(gsq.modifiers) > 0 {
+		_spec.Modifiers = gsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (gsq *GrantScheduleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gsq.querySpec()
+	if len(gsq.modifiers) > 0 {
+		_spec.Modifiers = gsq.modifiers
+	}
+	_spec.Node.Columns = gsq.ctx.Fields
+	if len(gsq.ctx.Fields) > 0 {
+		_spec.Unique = gsq.ctx.Unique != nil && *gsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gsq.driver, _spec)
+}
+
+func (gsq *GrantScheduleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(grantschedule.Table, grantschedule.Columns, sqlgraph.NewFieldSpec(grantschedule.FieldID, field.TypeString))
+	_spec.From = gsq.sql
+	if unique := gsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, grantschedule.FieldID)
+		for i := range fields {
+			if fields[i] != grantschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := gsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gsq *GrantScheduleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gsq.driver.Dialect())
+	t1 := builder.Table(grantschedule.Table)
+	columns := gsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = grantschedule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gsq.sql != nil {
+		selector = gsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gsq.ctx.Unique != nil && *gsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range gsq.modifiers {
+		m(selector)
+	}
+	for _, p := range gsq.predicates {
+		p(selector)
+	}
+	for _, p := range gsq.order {
+		p(selector)
+	}
+	if offset := gsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (gsq *GrantScheduleQuery) ForUpdate(opts ...sql.LockOption) *GrantScheduleQuery {
+	if gsq.driver.Dialect() == dialect.Postgres {
+		gsq.Unique(false)
+	}
+	gsq.modifiers = append(gsq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return gsq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (gsq *GrantScheduleQuery) ForShare(opts ...sql.LockOption) *GrantScheduleQuery {
+	if gsq.driver.Dialect() == dialect.Postgres {
+		gsq.Unique(false)
+	}
+	gsq.modifiers = append(gsq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return gsq
+}
+
+// GrantScheduleGroupBy is the group-by builder for GrantSchedule entities.
+type GrantScheduleGroupBy struct {
+	selector
+	build *GrantScheduleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gsgb *GrantScheduleGroupBy) Aggregate(fns ...AggregateFunc) *GrantScheduleGroupBy {
+	gsgb.fns = append(gsgb.fns, fns...)
+	return gsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gsgb *GrantScheduleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gsgb.build.ctx, "GroupBy")
+	if err := gsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GrantScheduleQuery, *GrantScheduleGroupBy](ctx, gsgb.build, gsgb, gsgb.build.inters, v)
+}
+
+func (gsgb *GrantScheduleGroupBy) sqlScan(ctx context.Context, root *GrantScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gsgb.fns))
+	for _, fn := range gsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gsgb.flds)+len(gsgb.fns))
+		for _, f := range *gsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GrantScheduleSelect is the builder for selecting fields of GrantSchedule entities.
+type GrantScheduleSelect struct {
+	*GrantScheduleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gss *GrantScheduleSelect) Aggregate(fns ...AggregateFunc) *GrantScheduleSelect {
+	gss.fns = append(gss.fns, fns...)
+	return gss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gss *GrantScheduleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gss.ctx, "Select")
+	if err := gss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GrantScheduleQuery, *GrantScheduleSelect](ctx, gss.GrantScheduleQuery, gss, gss.inters, v)
+}
+
+func (gss *GrantScheduleSelect) sqlScan(ctx context.Context, root *GrantScheduleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gss.fns))
+	for _, fn := range gss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,354 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/grantschedule"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+)
+
+// GrantScheduleUpdate is the builder for updating GrantSchedule entities.
+type GrantScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GrantScheduleMutation
+}
+
+// Where appends a list predicates to the GrantScheduleUpdate builder.
+func (gsu *GrantScheduleUpdate) Where(ps ...predicate.GrantSchedule) *GrantScheduleUpdate {
+	gsu.mutation.Where(ps...)
+	return gsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gsu *GrantScheduleUpdate) SetUpdatedAt(t time.Time) *GrantScheduleUpdate {
+	gsu.mutation.SetUpdatedAt(t)
+	return gsu
+}
+
+// SetMetadata sets the "metadata" field.
+func (gsu *GrantScheduleUpdate) SetMetadata(m map[string]string) *GrantScheduleUpdate {
+	gsu.mutation.SetMetadata(m)
+	return gsu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (gsu *GrantScheduleUpdate) ClearMetadata() *GrantScheduleUpdate {
+	gsu.mutation.ClearMetadata()
+	return gsu
+}
+
+// SetNextGrantIndex sets the "next_grant_index" field.
+func (gsu *GrantScheduleUpdate) SetNextGrantIndex(i int) *GrantScheduleUpdate {
+	gsu.mutation.ResetNextGrantIndex()
+	gsu.mutation.SetNextGrantIndex(i)
+	return gsu
+}
+
+// SetNillableNextGrantIndex sets the "next_grant_index" field if the given value is not nil.
+func (gsu *GrantScheduleUpdate) SetNillableNextGrantIndex(i *int) *GrantScheduleUpdate {
+	if i != nil {
+		gsu.SetNextGrantIndex(*i)
+	}
+	return gsu
+}
+
+// AddNextGrantIndex adds i to the "next_grant_index" field.
+func (gsu *GrantScheduleUpdate) AddNextGrantIndex(i int) *GrantScheduleUpdate {
+	gsu.mutation.AddNextGrantIndex(i)
+	return gsu
+}
+
+// SetNextGrantAt sets the "next_grant_at" field.
+func (gsu *GrantScheduleUpdate) SetNextGrantAt(t time.Time) *GrantScheduleUpdate {
+	gsu.mutation.SetNextGrantAt(t)
+	return gsu
+}
+
+// SetNillableNextGrantAt sets the "next_grant_at" field if the given value is not nil.
+func (gsu *GrantScheduleUpdate) SetNillableNextGrantAt(t *time.Time) *GrantScheduleUpdate {
+	if t != nil {
+		gsu.SetNextGrantAt(*t)
+	}
+	return gsu
+}
+
+// Mutation returns the GrantScheduleMutation object of the builder.
+func (gsu *GrantScheduleUpdate) Mutation() *GrantScheduleMutation {
+	return gsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gsu *GrantScheduleUpdate) Save(ctx context.Context) (int, error) {
+	gsu.defaults()
+	return withHooks(ctx, gsu.sqlSave, gsu.mutation, gsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gsu *GrantScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := gsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gsu *GrantScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := gsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gsu *GrantScheduleUpdate) ExecX(ctx context.Context) {
+	if err := gsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gsu *GrantScheduleUpdate) defaults() {
+	if _, ok := gsu.mutation.UpdatedAt(); !ok {
+		v := grantschedule.UpdateDefaultUpdatedAt()
+		gsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (gsu *GrantScheduleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(grantschedule.Table, grantschedule.Columns, sqlgraph.NewFieldSpec(grantschedule.FieldID, field.TypeString))
+	if ps := gsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(grantschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if gsu.mutation.FeatureIDCleared() {
+		_spec.ClearField(grantschedule.FieldFeatureID, field.TypeString)
+	}
+	if gsu.mutation.RolloverTypeCleared() {
+		_spec.ClearField(grantschedule.FieldRolloverType, field.TypeEnum)
+	}
+	if gsu.mutation.RolloverMaxAmountCleared() {
+		_spec.ClearField(grantschedule.FieldRolloverMaxAmount, field.TypeFloat64)
+	}
+	if value, ok := gsu.mutation.Metadata(); ok {
+		_spec.SetField(grantschedule.FieldMetadata, field.TypeJSON, value)
+	}
+	if gsu.mutation.MetadataCleared() {
+		_spec.ClearField(grantschedule.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := gsu.mutation.NextGrantIndex(); ok {
+		_spec.SetField(grantschedule.FieldNextGrantIndex, field.TypeInt, value)
+	}
+	if value, ok := gsu.mutation.AddedNextGrantIndex(); ok {
+		_spec.AddField(grantschedule.FieldNextGrantIndex, field.TypeInt, value)
+	}
+	if value, ok := gsu.mutation.NextGrantAt(); ok {
+		_spec.SetField(grantschedule.FieldNextGrantAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{grantschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gsu.mutation.done = true
+	return n, nil
+}
+
+// GrantScheduleUpdateOne is the builder for updating a single GrantSchedule entity.
+type GrantScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GrantScheduleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gsuo *GrantScheduleUpdateOne) SetUpdatedAt(t time.Time) *GrantScheduleUpdateOne {
+	gsuo.mutation.SetUpdatedAt(t)
+	return gsuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (gsuo *GrantScheduleUpdateOne) SetMetadata(m map[string]string) *GrantScheduleUpdateOne {
+	gsuo.mutation.SetMetadata(m)
+	return gsuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (gsuo *GrantScheduleUpdateOne) ClearMetadata() *GrantScheduleUpdateOne {
+	gsuo.mutation.ClearMetadata()
+	return gsuo
+}
+
+// SetNextGrantIndex sets the "next_grant_index" field.
+func (gsuo *GrantScheduleUpdateOne) SetNextGrantIndex(i int) *GrantScheduleUpdateOne {
+	gsuo.mutation.ResetNextGrantIndex()
+	gsuo.mutation.SetNextGrantIndex(i)
+	return gsuo
+}
+
+// SetNillableNextGrantIndex sets the "next_grant_index" field if the given value is not nil.
+func (gsuo *GrantScheduleUpdateOne) SetNillableNextGrantIndex(i *int) *GrantScheduleUpdateOne {
+	if i != nil {
+		gsuo.SetNextGrantIndex(*i)
+	}
+	return gsuo
+}
+
+// AddNextGrantIndex adds i to the "next_grant_index" field.
+func (gsuo *GrantScheduleUpdateOne) AddNextGrantIndex(i int) *GrantScheduleUpdateOne {
+	gsuo.mutation.AddNextGrantIndex(i)
+	return gsuo
+}
+
+// SetNextGrantAt sets the "next_grant_at" field.
+func (gsuo *GrantScheduleUpdateOne) SetNextGrantAt(t time.Time) *GrantScheduleUpdateOne {
+	gsuo.mutation.SetNextGrantAt(t)
+	return gsuo
+}
+
+// SetNillableNextGrantAt sets the "next_grant_at" field if the given value is not nil.
+func (gsuo *GrantScheduleUpdateOne) SetNillableNextGrantAt(t *time.Time) *GrantScheduleUpdateOne {
+	if t != nil {
+		gsuo.SetNextGrantAt(*t)
+	}
+	return gsuo
+}
+
+// Mutation returns the GrantScheduleMutation object of the builder.
+func (gsuo *GrantScheduleUpdateOne) Mutation() *GrantScheduleMutation {
+	return gsuo.mutation
+}
+
+// Where appends a list predicates to the GrantScheduleUpdate builder.
+func (gsuo *GrantScheduleUpdateOne) Where(ps ...predicate.GrantSchedule) *GrantScheduleUpdateOne {
+	gsuo.mutation.Where(ps...)
+	return gsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gsuo *GrantScheduleUpdateOne) Select(field string, fields ...string) *GrantScheduleUpdateOne {
+	gsuo.fields = append([]string{field}, fields...)
+	return gsuo
+}
+
+// Save executes the query and returns the updated GrantSchedule entity.
+func (gsuo *GrantScheduleUpdateOne) Save(ctx context.Context) (*GrantSchedule, error) {
+	gsuo.defaults()
+	return withHooks(ctx, gsuo.sqlSave, gsuo.mutation, gsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gsuo *GrantScheduleUpdateOne) SaveX(ctx context.Context) *GrantSchedule {
+	node, err := gsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gsuo *GrantScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := gsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gsuo *GrantScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := gsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gsuo *GrantScheduleUpdateOne) defaults() {
+	if _, ok := gsuo.mutation.UpdatedAt(); !ok {
+		v := grantschedule.UpdateDefaultUpdatedAt()
+		gsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (gsuo *GrantScheduleUpdateOne) sqlSave(ctx context.Context) (_node *GrantSchedule, err error) {
+	_spec := sqlgraph.NewUpdateSpec(grantschedule.Table, grantschedule.Columns, sqlgraph.NewFieldSpec(grantschedule.FieldID, field.TypeString))
+	id, ok := gsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "GrantSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, grantschedule.FieldID)
+		for _, f := range fields {
+			if !grantschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != grantschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(grantschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if gsuo.mutation.FeatureIDCleared() {
+		_spec.ClearField(grantschedule.FieldFeatureID, field.TypeString)
+	}
+	if gsuo.mutation.RolloverTypeCleared() {
+		_spec.ClearField(grantschedule.FieldRolloverType, field.TypeEnum)
+	}
+	if gsuo.mutation.RolloverMaxAmountCleared() {
+		_spec.ClearField(grantschedule.FieldRolloverMaxAmount, field.TypeFloat64)
+	}
+	if value, ok := gsuo.mutation.Metadata(); ok {
+		_spec.SetField(grantschedule.FieldMetadata, field.TypeJSON, value)
+	}
+	if gsuo.mutation.MetadataCleared() {
+		_spec.ClearField(grantschedule.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := gsuo.mutation.NextGrantIndex(); ok {
+		_spec.SetField(grantschedule.FieldNextGrantIndex, field.TypeInt, value)
+	}
+	if value, ok := gsuo.mutation.AddedNextGrantIndex(); ok {
+		_spec.AddField(grantschedule.FieldNextGrantIndex, field.TypeInt, value)
+	}
+	if value, ok := gsuo.mutation.NextGrantAt(); ok {
+		_spec.SetField(grantschedule.FieldNextGrantAt, field.TypeTime, value)
+	}
+	_node = &GrantSchedule{config: gsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{grantschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gsuo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,18 @@ import (
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
 )
 
+// The BalanceSnapshotFunc type is an adapter to allow the use of ordinary
+// function as BalanceSnapshot mutator.
+type BalanceSnapshotFunc func(context.Context, *db.BalanceSnapshotMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BalanceSnapshotFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.BalanceSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.BalanceSnapshotMutation", m)
+}
+
 // The CreditEntryFunc type is an adapter to allow the use of ordinary
 // function as CreditEntry mutator.
 type CreditEntryFunc func(context.Context, *db.CreditEntryMutation) (db.Value, error)
@@ -21,6 +33,18 @@ func (f CreditEntryFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.CreditEntryMutation", m)
 }
 
+// The EventTypeFunc type is an adapter to allow the use of ordinary
+// function as EventType mutator.
+type EventTypeFunc func(context.Context, *db.EventTypeMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EventTypeFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.EventTypeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.EventTypeMutation", m)
+}
+
 // The FeatureFunc type is an adapter to allow the use of ordinary
 // function as Feature mutator.
 type FeatureFunc func(context.Context, *db.FeatureMutation) (db.Value, error)
@@ -33,6 +57,18 @@ func (f FeatureFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.FeatureMutation", m)
 }
 
+// The GrantScheduleFunc type is an adapter to allow the use of ordinary
+// function as GrantSchedule mutator.
+type GrantScheduleFunc func(context.Context, *db.GrantScheduleMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GrantScheduleFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.GrantScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.GrantScheduleMutation", m)
+}
+
 // The LedgerFunc type is an adapter to allow the use of ordinary
 // function as Ledger mutator.
 type LedgerFunc func(context.Context, *db.LedgerMutation) (db.Value, error)
@@ -45,6 +81,42 @@ func (f LedgerFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.LedgerMutation", m)
 }
 
+// The NotificationDeliveryFunc type is an adapter to allow the use of ordinary
+// function as NotificationDelivery mutator.
+type NotificationDeliveryFunc func(context.Context, *db.NotificationDeliveryMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationDeliveryFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.NotificationDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.NotificationDeliveryMutation", m)
+}
+
+// The NotificationRuleFunc type is an adapter to allow the use of ordinary
+// function as NotificationRule mutator.
+type NotificationRuleFunc func(context.Context, *db.NotificationRuleMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationRuleFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.NotificationRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.NotificationRuleMutation", m)
+}
+
+// The SubjectAliasFunc type is an adapter to allow the use of ordinary
+// function as SubjectAlias mutator.
+type SubjectAliasFunc func(context.Context, *db.SubjectAliasMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SubjectAliasFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.SubjectAliasMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SubjectAliasMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, db.Mutation) bool
 
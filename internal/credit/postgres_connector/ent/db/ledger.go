@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/ledger"
 )
 
@@ -28,6 +29,18 @@ type Ledger struct {
 	Subject string `json:"subject,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]string `json:"metadata,omitempty"`
+	// OverageLimit holds the value of the "overage_limit" field.
+	OverageLimit *float64 `json:"overage_limit,omitempty"`
+	// FeatureOverageLimits holds the value of the "feature_overage_limits" field.
+	FeatureOverageLimits map[string]float64 `json:"feature_overage_limits,omitempty"`
+	// UsagePeriod holds the value of the "usage_period" field.
+	UsagePeriod *credit.RecurrencePeriod `json:"usage_period,omitempty"`
+	// UsagePeriodAnchor holds the value of the "usage_period_anchor" field.
+	UsagePeriodAnchor *time.Time `json:"usage_period_anchor,omitempty"`
+	// NextResetIndex holds the value of the "next_reset_index" field.
+	NextResetIndex int `json:"next_reset_index,omitempty"`
+	// NextResetAt holds the value of the "next_reset_at" field.
+	NextResetAt *time.Time `json:"next_reset_at,omitempty"`
 	// Highwatermark holds the value of the "highwatermark" field.
 	Highwatermark time.Time `json:"highwatermark,omitempty"`
 	selectValues  sql.SelectValues
@@ -38,11 +51,15 @@ func (*Ledger) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case ledger.FieldMetadata:
+		case ledger.FieldMetadata, ledger.FieldFeatureOverageLimits:
 			values[i] = new([]byte)
-		case ledger.FieldID, ledger.FieldNamespace, ledger.FieldSubject:
+		case ledger.FieldOverageLimit:
+			values[i] = new(sql.NullFloat64)
+		case ledger.FieldNextResetIndex:
+			values[i] = new(sql.NullInt64)
+		case ledger.FieldID, ledger.FieldNamespace, ledger.FieldSubject, ledger.FieldUsagePeriod:
 			values[i] = new(sql.NullString)
-		case ledger.FieldCreatedAt, ledger.FieldUpdatedAt, ledger.FieldHighwatermark:
+		case ledger.FieldCreatedAt, ledger.FieldUpdatedAt, ledger.FieldUsagePeriodAnchor, ledger.FieldNextResetAt, ledger.FieldHighwatermark:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -97,6 +114,48 @@ func (l *Ledger) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case ledger.FieldOverageLimit:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field overage_limit", values[i])
+			} else if value.Valid {
+				l.OverageLimit = new(float64)
+				*l.OverageLimit = value.Float64
+			}
+		case ledger.FieldFeatureOverageLimits:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field feature_overage_limits", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &l.FeatureOverageLimits); err != nil {
+					return fmt.Errorf("unmarshal field feature_overage_limits: %w", err)
+				}
+			}
+		case ledger.FieldUsagePeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field usage_period", values[i])
+			} else if value.Valid {
+				l.UsagePeriod = new(credit.RecurrencePeriod)
+				*l.UsagePeriod = credit.RecurrencePeriod(value.String)
+			}
+		case ledger.FieldUsagePeriodAnchor:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field usage_period_anchor", values[i])
+			} else if value.Valid {
+				l.UsagePeriodAnchor = new(time.Time)
+				*l.UsagePeriodAnchor = value.Time
+			}
+		case ledger.FieldNextResetIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field next_reset_index", values[i])
+			} else if value.Valid {
+				l.NextResetIndex = int(value.Int64)
+			}
+		case ledger.FieldNextResetAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_reset_at", values[i])
+			} else if value.Valid {
+				l.NextResetAt = new(time.Time)
+				*l.NextResetAt = value.Time
+			}
 		case ledger.FieldHighwatermark:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field highwatermark", values[i])
@@ -154,6 +213,32 @@ func (l *Ledger) String() string {
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", l.Metadata))
 	builder.WriteString(", ")
+	if v := l.OverageLimit; v != nil {
+		builder.WriteString("overage_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("feature_overage_limits=")
+	builder.WriteString(fmt.Sprintf("%v", l.FeatureOverageLimits))
+	builder.WriteString(", ")
+	if v := l.UsagePeriod; v != nil {
+		builder.WriteString("usage_period=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := l.UsagePeriodAnchor; v != nil {
+		builder.WriteString("usage_period_anchor=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("next_reset_index=")
+	builder.WriteString(fmt.Sprintf("%v", l.NextResetIndex))
+	builder.WriteString(", ")
+	if v := l.NextResetAt; v != nil {
+		builder.WriteString("next_reset_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("highwatermark=")
 	builder.WriteString(l.Highwatermark.Format(time.ANSIC))
 	builder.WriteByte(')')
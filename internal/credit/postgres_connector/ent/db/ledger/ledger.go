@@ -3,9 +3,11 @@
 package ledger
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit"
 )
 
 const (
@@ -23,6 +25,18 @@ const (
 	FieldSubject = "subject"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
+	// FieldOverageLimit holds the string denoting the overage_limit field in the database.
+	FieldOverageLimit = "overage_limit"
+	// FieldFeatureOverageLimits holds the string denoting the feature_overage_limits field in the database.
+	FieldFeatureOverageLimits = "feature_overage_limits"
+	// FieldUsagePeriod holds the string denoting the usage_period field in the database.
+	FieldUsagePeriod = "usage_period"
+	// FieldUsagePeriodAnchor holds the string denoting the usage_period_anchor field in the database.
+	FieldUsagePeriodAnchor = "usage_period_anchor"
+	// FieldNextResetIndex holds the string denoting the next_reset_index field in the database.
+	FieldNextResetIndex = "next_reset_index"
+	// FieldNextResetAt holds the string denoting the next_reset_at field in the database.
+	FieldNextResetAt = "next_reset_at"
 	// FieldHighwatermark holds the string denoting the highwatermark field in the database.
 	FieldHighwatermark = "highwatermark"
 	// Table holds the table name of the ledger in the database.
@@ -37,6 +51,12 @@ var Columns = []string{
 	FieldNamespace,
 	FieldSubject,
 	FieldMetadata,
+	FieldOverageLimit,
+	FieldFeatureOverageLimits,
+	FieldUsagePeriod,
+	FieldUsagePeriodAnchor,
+	FieldNextResetIndex,
+	FieldNextResetAt,
 	FieldHighwatermark,
 }
 
@@ -61,12 +81,24 @@ var (
 	NamespaceValidator func(string) error
 	// SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
 	SubjectValidator func(string) error
+	// DefaultNextResetIndex holds the default value on creation for the "next_reset_index" field.
+	DefaultNextResetIndex int
 	// DefaultHighwatermark holds the default value on creation for the "highwatermark" field.
 	DefaultHighwatermark func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
 
+// UsagePeriodValidator is a validator for the "usage_period" field enum values. It is called by the builders before save.
+func UsagePeriodValidator(up credit.RecurrencePeriod) error {
+	switch up {
+	case "DAY", "WEEK", "MONTH", "YEAR":
+		return nil
+	default:
+		return fmt.Errorf("ledger: invalid enum value for usage_period field: %q", up)
+	}
+}
+
 // OrderOption defines the ordering options for the Ledger queries.
 type OrderOption func(*sql.Selector)
 
@@ -95,6 +127,31 @@ func BySubject(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSubject, opts...).ToFunc()
 }
 
+// ByOverageLimit orders the results by the overage_limit field.
+func ByOverageLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverageLimit, opts...).ToFunc()
+}
+
+// ByUsagePeriod orders the results by the usage_period field.
+func ByUsagePeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsagePeriod, opts...).ToFunc()
+}
+
+// ByUsagePeriodAnchor orders the results by the usage_period_anchor field.
+func ByUsagePeriodAnchor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsagePeriodAnchor, opts...).ToFunc()
+}
+
+// ByNextResetIndex orders the results by the next_reset_index field.
+func ByNextResetIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextResetIndex, opts...).ToFunc()
+}
+
+// ByNextResetAt orders the results by the next_reset_at field.
+func ByNextResetAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextResetAt, opts...).ToFunc()
+}
+
 // ByHighwatermark orders the results by the highwatermark field.
 func ByHighwatermark(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHighwatermark, opts...).ToFunc()
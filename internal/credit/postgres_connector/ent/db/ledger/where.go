@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
 )
 
@@ -84,6 +85,26 @@ func Subject(v string) predicate.Ledger {
 	return predicate.Ledger(sql.FieldEQ(FieldSubject, v))
 }
 
+// OverageLimit applies equality check predicate on the "overage_limit" field. It's identical to OverageLimitEQ.
+func OverageLimit(v float64) predicate.Ledger {
+	return predicate.Ledger(sql.FieldEQ(FieldOverageLimit, v))
+}
+
+// UsagePeriodAnchor applies equality check predicate on the "usage_period_anchor" field. It's identical to UsagePeriodAnchorEQ.
+func UsagePeriodAnchor(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldEQ(FieldUsagePeriodAnchor, v))
+}
+
+// NextResetIndex applies equality check predicate on the "next_reset_index" field. It's identical to NextResetIndexEQ.
+func NextResetIndex(v int) predicate.Ledger {
+	return predicate.Ledger(sql.FieldEQ(FieldNextResetIndex, v))
+}
+
+// NextResetAt applies equality check predicate on the "next_reset_at" field. It's identical to NextResetAtEQ.
+func NextResetAt(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldEQ(FieldNextResetAt, v))
+}
+
 // Highwatermark applies equality check predicate on the "highwatermark" field. It's identical to HighwatermarkEQ.
 func Highwatermark(v time.Time) predicate.Ledger {
 	return predicate.Ledger(sql.FieldEQ(FieldHighwatermark, v))
@@ -309,6 +330,246 @@ func MetadataNotNil() predicate.Ledger {
 	return predicate.Ledger(sql.FieldNotNull(FieldMetadata))
 }
 
+// OverageLimitEQ applies the EQ predicate on the "overage_limit" field.
+func OverageLimitEQ(v float64) predicate.Ledger {
+	return predicate.Ledger(sql.FieldEQ(FieldOverageLimit, v))
+}
+
+// OverageLimitNEQ applies the NEQ predicate on the "overage_limit" field.
+func OverageLimitNEQ(v float64) predicate.Ledger {
+	return predicate.Ledger(sql.FieldNEQ(FieldOverageLimit, v))
+}
+
+// OverageLimitIn applies the In predicate on the "overage_limit" field.
+func OverageLimitIn(vs ...float64) predicate.Ledger {
+	return predicate.Ledger(sql.FieldIn(FieldOverageLimit, vs...))
+}
+
+// OverageLimitNotIn applies the NotIn predicate on the "overage_limit" field.
+func OverageLimitNotIn(vs ...float64) predicate.Ledger {
+	return predicate.Ledger(sql.FieldNotIn(FieldOverageLimit, vs...))
+}
+
+// OverageLimitGT applies the GT predicate on the "overage_limit" field.
+func OverageLimitGT(v float64) predicate.Ledger {
+	return predicate.Ledger(sql.FieldGT(FieldOverageLimit, v))
+}
+
+// OverageLimitGTE applies the GTE predicate on the "overage_limit" field.
+func OverageLimitGTE(v float64) predicate.Ledger {
+	return predicate.Ledger(sql.FieldGTE(FieldOverageLimit, v))
+}
+
+// OverageLimitLT applies the LT predicate on the "overage_limit" field.
+func OverageLimitLT(v float64) predicate.Ledger {
+	return predicate.Ledger(sql.FieldLT(FieldOverageLimit, v))
+}
+
+// OverageLimitLTE applies the LTE predicate on the "overage_limit" field.
+func OverageLimitLTE(v float64) predicate.Ledger {
+	return predicate.Ledger(sql.FieldLTE(FieldOverageLimit, v))
+}
+
+// OverageLimitIsNil applies the IsNil predicate on the "overage_limit" field.
+func OverageLimitIsNil() predicate.Ledger {
+	return predicate.Ledger(sql.FieldIsNull(FieldOverageLimit))
+}
+
+// OverageLimitNotNil applies the NotNil predicate on the "overage_limit" field.
+func OverageLimitNotNil() predicate.Ledger {
+	return predicate.Ledger(sql.FieldNotNull(FieldOverageLimit))
+}
+
+// FeatureOverageLimitsIsNil applies the IsNil predicate on the "feature_overage_limits" field.
+func FeatureOverageLimitsIsNil() predicate.Ledger {
+	return predicate.Ledger(sql.FieldIsNull(FieldFeatureOverageLimits))
+}
+
+// FeatureOverageLimitsNotNil applies the NotNil predicate on the "feature_overage_limits" field.
+func FeatureOverageLimitsNotNil() predicate.Ledger {
+	return predicate.Ledger(sql.FieldNotNull(FieldFeatureOverageLimits))
+}
+
+// UsagePeriodEQ applies the EQ predicate on the "usage_period" field.
+func UsagePeriodEQ(v credit.RecurrencePeriod) predicate.Ledger {
+	vc := v
+	return predicate.Ledger(sql.FieldEQ(FieldUsagePeriod, vc))
+}
+
+// UsagePeriodNEQ applies the NEQ predicate on the "usage_period" field.
+func UsagePeriodNEQ(v credit.RecurrencePeriod) predicate.Ledger {
+	vc := v
+	return predicate.Ledger(sql.FieldNEQ(FieldUsagePeriod, vc))
+}
+
+// UsagePeriodIn applies the In predicate on the "usage_period" field.
+func UsagePeriodIn(vs ...credit.RecurrencePeriod) predicate.Ledger {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Ledger(sql.FieldIn(FieldUsagePeriod, v...))
+}
+
+// UsagePeriodNotIn applies the NotIn predicate on the "usage_period" field.
+func UsagePeriodNotIn(vs ...credit.RecurrencePeriod) predicate.Ledger {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Ledger(sql.FieldNotIn(FieldUsagePeriod, v...))
+}
+
+// UsagePeriodIsNil applies the IsNil predicate on the "usage_period" field.
+func UsagePeriodIsNil() predicate.Ledger {
+	return predicate.Ledger(sql.FieldIsNull(FieldUsagePeriod))
+}
+
+// UsagePeriodNotNil applies the NotNil predicate on the "usage_period" field.
+func UsagePeriodNotNil() predicate.Ledger {
+	return predicate.Ledger(sql.FieldNotNull(FieldUsagePeriod))
+}
+
+// UsagePeriodAnchorEQ applies the EQ predicate on the "usage_period_anchor" field.
+func UsagePeriodAnchorEQ(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldEQ(FieldUsagePeriodAnchor, v))
+}
+
+// UsagePeriodAnchorNEQ applies the NEQ predicate on the "usage_period_anchor" field.
+func UsagePeriodAnchorNEQ(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldNEQ(FieldUsagePeriodAnchor, v))
+}
+
+// UsagePeriodAnchorIn applies the In predicate on the "usage_period_anchor" field.
+func UsagePeriodAnchorIn(vs ...time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldIn(FieldUsagePeriodAnchor, vs...))
+}
+
+// UsagePeriodAnchorNotIn applies the NotIn predicate on the "usage_period_anchor" field.
+func UsagePeriodAnchorNotIn(vs ...time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldNotIn(FieldUsagePeriodAnchor, vs...))
+}
+
+// UsagePeriodAnchorGT applies the GT predicate on the "usage_period_anchor" field.
+func UsagePeriodAnchorGT(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldGT(FieldUsagePeriodAnchor, v))
+}
+
+// UsagePeriodAnchorGTE applies the GTE predicate on the "usage_period_anchor" field.
+func UsagePeriodAnchorGTE(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldGTE(FieldUsagePeriodAnchor, v))
+}
+
+// UsagePeriodAnchorLT applies the LT predicate on the "usage_period_anchor" field.
+func UsagePeriodAnchorLT(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldLT(FieldUsagePeriodAnchor, v))
+}
+
+// UsagePeriodAnchorLTE applies the LTE predicate on the "usage_period_anchor" field.
+func UsagePeriodAnchorLTE(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldLTE(FieldUsagePeriodAnchor, v))
+}
+
+// UsagePeriodAnchorIsNil applies the IsNil predicate on the "usage_period_anchor" field.
+func UsagePeriodAnchorIsNil() predicate.Ledger {
+	return predicate.Ledger(sql.FieldIsNull(FieldUsagePeriodAnchor))
+}
+
+// UsagePeriodAnchorNotNil applies the NotNil predicate on the "usage_period_anchor" field.
+func UsagePeriodAnchorNotNil() predicate.Ledger {
+	return predicate.Ledger(sql.FieldNotNull(FieldUsagePeriodAnchor))
+}
+
+// NextResetIndexEQ applies the EQ predicate on the "next_reset_index" field.
+func NextResetIndexEQ(v int) predicate.Ledger {
+	return predicate.Ledger(sql.FieldEQ(FieldNextResetIndex, v))
+}
+
+// NextResetIndexNEQ applies the NEQ predicate on the "next_reset_index" field.
+func NextResetIndexNEQ(v int) predicate.Ledger {
+	return predicate.Ledger(sql.FieldNEQ(FieldNextResetIndex, v))
+}
+
+// NextResetIndexIn applies the In predicate on the "next_reset_index" field.
+func NextResetIndexIn(vs ...int) predicate.Ledger {
+	return predicate.Ledger(sql.FieldIn(FieldNextResetIndex, vs...))
+}
+
+// NextResetIndexNotIn applies the NotIn predicate on the "next_reset_index" field.
+func NextResetIndexNotIn(vs ...int) predicate.Ledger {
+	return predicate.Ledger(sql.FieldNotIn(FieldNextResetIndex, vs...))
+}
+
+// NextResetIndexGT applies the GT predicate on the "next_reset_index" field.
+func NextResetIndexGT(v int) predicate.Ledger {
+	return predicate.Ledger(sql.FieldGT(FieldNextResetIndex, v))
+}
+
+// NextResetIndexGTE applies the GTE predicate on the "next_reset_index" field.
+func NextResetIndexGTE(v int) predicate.Ledger {
+	return predicate.Ledger(sql.FieldGTE(FieldNextResetIndex, v))
+}
+
+// NextResetIndexLT applies the LT predicate on the "next_reset_index" field.
+func NextResetIndexLT(v int) predicate.Ledger {
+	return predicate.Ledger(sql.FieldLT(FieldNextResetIndex, v))
+}
+
+// NextResetIndexLTE applies the LTE predicate on the "next_reset_index" field.
+func NextResetIndexLTE(v int) predicate.Ledger {
+	return predicate.Ledger(sql.FieldLTE(FieldNextResetIndex, v))
+}
+
+// NextResetAtEQ applies the EQ predicate on the "next_reset_at" field.
+func NextResetAtEQ(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldEQ(FieldNextResetAt, v))
+}
+
+// NextResetAtNEQ applies the NEQ predicate on the "next_reset_at" field.
+func NextResetAtNEQ(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldNEQ(FieldNextResetAt, v))
+}
+
+// NextResetAtIn applies the In predicate on the "next_reset_at" field.
+func NextResetAtIn(vs ...time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldIn(FieldNextResetAt, vs...))
+}
+
+// NextResetAtNotIn applies the NotIn predicate on the "next_reset_at" field.
+func NextResetAtNotIn(vs ...time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldNotIn(FieldNextResetAt, vs...))
+}
+
+// NextResetAtGT applies the GT predicate on the "next_reset_at" field.
+func NextResetAtGT(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldGT(FieldNextResetAt, v))
+}
+
+// NextResetAtGTE applies the GTE predicate on the "next_reset_at" field.
+func NextResetAtGTE(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldGTE(FieldNextResetAt, v))
+}
+
+// NextResetAtLT applies the LT predicate on the "next_reset_at" field.
+func NextResetAtLT(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldLT(FieldNextResetAt, v))
+}
+
+// NextResetAtLTE applies the LTE predicate on the "next_reset_at" field.
+func NextResetAtLTE(v time.Time) predicate.Ledger {
+	return predicate.Ledger(sql.FieldLTE(FieldNextResetAt, v))
+}
+
+// NextResetAtIsNil applies the IsNil predicate on the "next_reset_at" field.
+func NextResetAtIsNil() predicate.Ledger {
+	return predicate.Ledger(sql.FieldIsNull(FieldNextResetAt))
+}
+
+// NextResetAtNotNil applies the NotNil predicate on the "next_reset_at" field.
+func NextResetAtNotNil() predicate.Ledger {
+	return predicate.Ledger(sql.FieldNotNull(FieldNextResetAt))
+}
+
 // HighwatermarkEQ applies the EQ predicate on the "highwatermark" field.
 func HighwatermarkEQ(v time.Time) predicate.Ledger {
 	return predicate.Ledger(sql.FieldEQ(FieldHighwatermark, v))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/ledger"
 )
 
@@ -69,6 +70,82 @@ func (lc *LedgerCreate) SetMetadata(m map[string]string) *LedgerCreate {
 	return lc
 }
 
+// SetOverageLimit sets the "overage_limit" field.
+func (lc *LedgerCreate) SetOverageLimit(f float64) *LedgerCreate {
+	lc.mutation.SetOverageLimit(f)
+	return lc
+}
+
+// SetNillableOverageLimit sets the "overage_limit" field if the given value is not nil.
+func (lc *LedgerCreate) SetNillableOverageLimit(f *float64) *LedgerCreate {
+	if f != nil {
+		lc.SetOverageLimit(*f)
+	}
+	return lc
+}
+
+// SetFeatureOverageLimits sets the "feature_overage_limits" field.
+func (lc *LedgerCreate) SetFeatureOverageLimits(m map[string]float64) *LedgerCreate {
+	lc.mutation.SetFeatureOverageLimits(m)
+	return lc
+}
+
+// SetUsagePeriod sets the "usage_period" field.
+func (lc *LedgerCreate) SetUsagePeriod(cp credit.RecurrencePeriod) *LedgerCreate {
+	lc.mutation.SetUsagePeriod(cp)
+	return lc
+}
+
+// SetNillableUsagePeriod sets the "usage_period" field if the given value is not nil.
+func (lc *LedgerCreate) SetNillableUsagePeriod(cp *credit.RecurrencePeriod) *LedgerCreate {
+	if cp != nil {
+		lc.SetUsagePeriod(*cp)
+	}
+	return lc
+}
+
+// SetUsagePeriodAnchor sets the "usage_period_anchor" field.
+func (lc *LedgerCreate) SetUsagePeriodAnchor(t time.Time) *LedgerCreate {
+	lc.mutation.SetUsagePeriodAnchor(t)
+	return lc
+}
+
+// SetNillableUsagePeriodAnchor sets the "usage_period_anchor" field if the given value is not nil.
+func (lc *LedgerCreate) SetNillableUsagePeriodAnchor(t *time.Time) *LedgerCreate {
+	if t != nil {
+		lc.SetUsagePeriodAnchor(*t)
+	}
+	return lc
+}
+
+// SetNextResetIndex sets the "next_reset_index" field.
+func (lc *LedgerCreate) SetNextResetIndex(i int) *LedgerCreate {
+	lc.mutation.SetNextResetIndex(i)
+	return lc
+}
+
+// SetNillableNextResetIndex sets the "next_reset_index" field if the given value is not nil.
+func (lc *LedgerCreate) SetNillableNextResetIndex(i *int) *LedgerCreate {
+	if i != nil {
+		lc.SetNextResetIndex(*i)
+	}
+	return lc
+}
+
+// SetNextResetAt sets the "next_reset_at" field.
+func (lc *LedgerCreate) SetNextResetAt(t time.Time) *LedgerCreate {
+	lc.mutation.SetNextResetAt(t)
+	return lc
+}
+
+// SetNillableNextResetAt sets the "next_reset_at" field if the given value is not nil.
+func (lc *LedgerCreate) SetNillableNextResetAt(t *time.Time) *LedgerCreate {
+	if t != nil {
+		lc.SetNextResetAt(*t)
+	}
+	return lc
+}
+
 // SetHighwatermark sets the "highwatermark" field.
 func (lc *LedgerCreate) SetHighwatermark(t time.Time) *LedgerCreate {
 	lc.mutation.SetHighwatermark(t)
@@ -140,6 +217,10 @@ func (lc *LedgerCreate) defaults() {
 		v := ledger.DefaultUpdatedAt()
 		lc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := lc.mutation.NextResetIndex(); !ok {
+		v := ledger.DefaultNextResetIndex
+		lc.mutation.SetNextResetIndex(v)
+	}
 	if _, ok := lc.mutation.Highwatermark(); !ok {
 		v := ledger.DefaultHighwatermark()
 		lc.mutation.SetHighwatermark(v)
@@ -174,6 +255,14 @@ func (lc *LedgerCreate) check() error {
 			return &ValidationError{Name: "subject", err: fmt.Errorf(`db: validator failed for field "Ledger.subject": %w`, err)}
 		}
 	}
+	if v, ok := lc.mutation.UsagePeriod(); ok {
+		if err := ledger.UsagePeriodValidator(v); err != nil {
+			return &ValidationError{Name: "usage_period", err: fmt.Errorf(`db: validator failed for field "Ledger.usage_period": %w`, err)}
+		}
+	}
+	if _, ok := lc.mutation.NextResetIndex(); !ok {
+		return &ValidationError{Name: "next_reset_index", err: errors.New(`db: missing required field "Ledger.next_reset_index"`)}
+	}
 	if _, ok := lc.mutation.Highwatermark(); !ok {
 		return &ValidationError{Name: "highwatermark", err: errors.New(`db: missing required field "Ledger.highwatermark"`)}
 	}
@@ -233,6 +322,30 @@ func (lc *LedgerCreate) createSpec() (*Ledger, *sqlgraph.CreateSpec) {
 		_spec.SetField(ledger.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := lc.mutation.OverageLimit(); ok {
+		_spec.SetField(ledger.FieldOverageLimit, field.TypeFloat64, value)
+		_node.OverageLimit = &value
+	}
+	if value, ok := lc.mutation.FeatureOverageLimits(); ok {
+		_spec.SetField(ledger.FieldFeatureOverageLimits, field.TypeJSON, value)
+		_node.FeatureOverageLimits = value
+	}
+	if value, ok := lc.mutation.UsagePeriod(); ok {
+		_spec.SetField(ledger.FieldUsagePeriod, field.TypeEnum, value)
+		_node.UsagePeriod = &value
+	}
+	if value, ok := lc.mutation.UsagePeriodAnchor(); ok {
+		_spec.SetField(ledger.FieldUsagePeriodAnchor, field.TypeTime, value)
+		_node.UsagePeriodAnchor = &value
+	}
+	if value, ok := lc.mutation.NextResetIndex(); ok {
+		_spec.SetField(ledger.FieldNextResetIndex, field.TypeInt, value)
+		_node.NextResetIndex = value
+	}
+	if value, ok := lc.mutation.NextResetAt(); ok {
+		_spec.SetField(ledger.FieldNextResetAt, field.TypeTime, value)
+		_node.NextResetAt = &value
+	}
 	if value, ok := lc.mutation.Highwatermark(); ok {
 		_spec.SetField(ledger.FieldHighwatermark, field.TypeTime, value)
 		_node.Highwatermark = value
@@ -319,6 +432,120 @@ func (u *LedgerUpsert) ClearMetadata() *LedgerUpsert {
 	return u
 }
 
+// SetOverageLimit sets the "overage_limit" field.
+func (u *LedgerUpsert) SetOverageLimit(v float64) *LedgerUpsert {
+	u.Set(ledger.FieldOverageLimit, v)
+	return u
+}
+
+// UpdateOverageLimit sets the "overage_limit" field to the value that was provided on create.
+func (u *LedgerUpsert) UpdateOverageLimit() *LedgerUpsert {
+	u.SetExcluded(ledger.FieldOverageLimit)
+	return u
+}
+
+// AddOverageLimit adds v to the "overage_limit" field.
+func (u *LedgerUpsert) AddOverageLimit(v float64) *LedgerUpsert {
+	u.Add(ledger.FieldOverageLimit, v)
+	return u
+}
+
+// ClearOverageLimit clears the value of the "overage_limit" field.
+func (u *LedgerUpsert) ClearOverageLimit() *LedgerUpsert {
+	u.SetNull(ledger.FieldOverageLimit)
+	return u
+}
+
+// SetFeatureOverageLimits sets the "feature_overage_limits" field.
+func (u *LedgerUpsert) SetFeatureOverageLimits(v map[string]float64) *LedgerUpsert {
+	u.Set(ledger.FieldFeatureOverageLimits, v)
+	return u
+}
+
+// UpdateFeatureOverageLimits sets the "feature_overage_limits" field to the value that was provided on create.
+func (u *LedgerUpsert) UpdateFeatureOverageLimits() *LedgerUpsert {
+	u.SetExcluded(ledger.FieldFeatureOverageLimits)
+	return u
+}
+
+// ClearFeatureOverageLimits clears the value of the "feature_overage_limits" field.
+func (u *LedgerUpsert) ClearFeatureOverageLimits() *LedgerUpsert {
+	u.SetNull(ledger.FieldFeatureOverageLimits)
+	return u
+}
+
+// SetUsagePeriod sets the "usage_period" field.
+func (u *LedgerUpsert) SetUsagePeriod(v credit.RecurrencePeriod) *LedgerUpsert {
+	u.Set(ledger.FieldUsagePeriod, v)
+	return u
+}
+
+// UpdateUsagePeriod sets the "usage_period" field to the value that was provided on create.
+func (u *LedgerUpsert) UpdateUsagePeriod() *LedgerUpsert {
+	u.SetExcluded(ledger.FieldUsagePeriod)
+	return u
+}
+
+// ClearUsagePeriod clears the value of the "usage_period" field.
+func (u *LedgerUpsert) ClearUsagePeriod() *LedgerUpsert {
+	u.SetNull(ledger.FieldUsagePeriod)
+	return u
+}
+
+// SetUsagePeriodAnchor sets the "usage_period_anchor" field.
+func (u *LedgerUpsert) SetUsagePeriodAnchor(v time.Time) *LedgerUpsert {
+	u.Set(ledger.FieldUsagePeriodAnchor, v)
+	return u
+}
+
+// UpdateUsagePeriodAnchor sets the "usage_period_anchor" field to the value that was provided on create.
+func (u *LedgerUpsert) UpdateUsagePeriodAnchor() *LedgerUpsert {
+	u.SetExcluded(ledger.FieldUsagePeriodAnchor)
+	return u
+}
+
+// ClearUsagePeriodAnchor clears the value of the "usage_period_anchor" field.
+func (u *LedgerUpsert) ClearUsagePeriodAnchor() *LedgerUpsert {
+	u.SetNull(ledger.FieldUsagePeriodAnchor)
+	return u
+}
+
+// SetNextResetIndex sets the "next_reset_index" field.
+func (u *LedgerUpsert) SetNextResetIndex(v int) *LedgerUpsert {
+	u.Set(ledger.FieldNextResetIndex, v)
+	return u
+}
+
+// UpdateNextResetIndex sets the "next_reset_index" field to the value that was provided on create.
+func (u *LedgerUpsert) UpdateNextResetIndex() *LedgerUpsert {
+	u.SetExcluded(ledger.FieldNextResetIndex)
+	return u
+}
+
+// AddNextResetIndex adds v to the "next_reset_index" field.
+func (u *LedgerUpsert) AddNextResetIndex(v int) *LedgerUpsert {
+	u.Add(ledger.FieldNextResetIndex, v)
+	return u
+}
+
+// SetNextResetAt sets the "next_reset_at" field.
+func (u *LedgerUpsert) SetNextResetAt(v time.Time) *LedgerUpsert {
+	u.Set(ledger.FieldNextResetAt, v)
+	return u
+}
+
+// UpdateNextResetAt sets the "next_reset_at" field to the value that was provided on create.
+func (u *LedgerUpsert) UpdateNextResetAt() *LedgerUpsert {
+	u.SetExcluded(ledger.FieldNextResetAt)
+	return u
+}
+
+// ClearNextResetAt clears the value of the "next_reset_at" field.
+func (u *LedgerUpsert) ClearNextResetAt() *LedgerUpsert {
+	u.SetNull(ledger.FieldNextResetAt)
+	return u
+}
+
 // SetHighwatermark sets the "highwatermark" field.
 func (u *LedgerUpsert) SetHighwatermark(v time.Time) *LedgerUpsert {
 	u.Set(ledger.FieldHighwatermark, v)
@@ -423,6 +650,139 @@ func (u *LedgerUpsertOne) ClearMetadata() *LedgerUpsertOne {
 	})
 }
 
+// SetOverageLimit sets the "overage_limit" field.
+func (u *LedgerUpsertOne) SetOverageLimit(v float64) *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetOverageLimit(v)
+	})
+}
+
+// AddOverageLimit adds v to the "overage_limit" field.
+func (u *LedgerUpsertOne) AddOverageLimit(v float64) *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.AddOverageLimit(v)
+	})
+}
+
+// UpdateOverageLimit sets the "overage_limit" field to the value that was provided on create.
+func (u *LedgerUpsertOne) UpdateOverageLimit() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateOverageLimit()
+	})
+}
+
+// ClearOverageLimit clears the value of the "overage_limit" field.
+func (u *LedgerUpsertOne) ClearOverageLimit() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.ClearOverageLimit()
+	})
+}
+
+// SetFeatureOverageLimits sets the "feature_overage_limits" field.
+func (u *LedgerUpsertOne) SetFeatureOverageLimits(v map[string]float64) *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetFeatureOverageLimits(v)
+	})
+}
+
+// UpdateFeatureOverageLimits sets the "feature_overage_limits" field to the value that was provided on create.
+func (u *LedgerUpsertOne) UpdateFeatureOverageLimits() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateFeatureOverageLimits()
+	})
+}
+
+// ClearFeatureOverageLimits clears the value of the "feature_overage_limits" field.
+func (u *LedgerUpsertOne) ClearFeatureOverageLimits() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.ClearFeatureOverageLimits()
+	})
+}
+
+// SetUsagePeriod sets the "usage_period" field.
+func (u *LedgerUpsertOne) SetUsagePeriod(v credit.RecurrencePeriod) *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetUsagePeriod(v)
+	})
+}
+
+// UpdateUsagePeriod sets the "usage_period" field to the value that was provided on create.
+func (u *LedgerUpsertOne) UpdateUsagePeriod() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateUsagePeriod()
+	})
+}
+
+// ClearUsagePeriod clears the value of the "usage_period" field.
+func (u *LedgerUpsertOne) ClearUsagePeriod() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.ClearUsagePeriod()
+	})
+}
+
+// SetUsagePeriodAnchor sets the "usage_period_anchor" field.
+func (u *LedgerUpsertOne) SetUsagePeriodAnchor(v time.Time) *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetUsagePeriodAnchor(v)
+	})
+}
+
+// UpdateUsagePeriodAnchor sets the "usage_period_anchor" field to the value that was provided on create.
+func (u *LedgerUpsertOne) UpdateUsagePeriodAnchor() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateUsagePeriodAnchor()
+	})
+}
+
+// ClearUsagePeriodAnchor clears the value of the "usage_period_anchor" field.
+func (u *LedgerUpsertOne) ClearUsagePeriodAnchor() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.ClearUsagePeriodAnchor()
+	})
+}
+
+// SetNextResetIndex sets the "next_reset_index" field.
+func (u *LedgerUpsertOne) SetNextResetIndex(v int) *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetNextResetIndex(v)
+	})
+}
+
+// AddNextResetIndex adds v to the "next_reset_index" field.
+func (u *LedgerUpsertOne) AddNextResetIndex(v int) *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.AddNextResetIndex(v)
+	})
+}
+
+// UpdateNextResetIndex sets the "next_reset_index" field to the value that was provided on create.
+func (u *LedgerUpsertOne) UpdateNextResetIndex() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateNextResetIndex()
+	})
+}
+
+// SetNextResetAt sets the "next_reset_at" field.
+func (u *LedgerUpsertOne) SetNextResetAt(v time.Time) *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetNextResetAt(v)
+	})
+}
+
+// UpdateNextResetAt sets the "next_reset_at" field to the value that was provided on create.
+func (u *LedgerUpsertOne) UpdateNextResetAt() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateNextResetAt()
+	})
+}
+
+// ClearNextResetAt clears the value of the "next_reset_at" field.
+func (u *LedgerUpsertOne) ClearNextResetAt() *LedgerUpsertOne {
+	return u.Update(func(s *LedgerUpsert) {
+		s.ClearNextResetAt()
+	})
+}
+
 // SetHighwatermark sets the "highwatermark" field.
 func (u *LedgerUpsertOne) SetHighwatermark(v time.Time) *LedgerUpsertOne {
 	return u.Update(func(s *LedgerUpsert) {
@@ -696,6 +1056,139 @@ func (u *LedgerUpsertBulk) ClearMetadata() *LedgerUpsertBulk {
 	})
 }
 
+// SetOverageLimit sets the "overage_limit" field.
+func (u *LedgerUpsertBulk) SetOverageLimit(v float64) *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetOverageLimit(v)
+	})
+}
+
+// AddOverageLimit adds v to the "overage_limit" field.
+func (u *LedgerUpsertBulk) AddOverageLimit(v float64) *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.AddOverageLimit(v)
+	})
+}
+
+// UpdateOverageLimit sets the "overage_limit" field to the value that was provided on create.
+func (u *LedgerUpsertBulk) UpdateOverageLimit() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateOverageLimit()
+	})
+}
+
+// ClearOverageLimit clears the value of the "overage_limit" field.
+func (u *LedgerUpsertBulk) ClearOverageLimit() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.ClearOverageLimit()
+	})
+}
+
+// SetFeatureOverageLimits sets the "feature_overage_limits" field.
+func (u *LedgerUpsertBulk) SetFeatureOverageLimits(v map[string]float64) *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetFeatureOverageLimits(v)
+	})
+}
+
+// UpdateFeatureOverageLimits sets the "feature_overage_limits" field to the value that was provided on create.
+func (u *LedgerUpsertBulk) UpdateFeatureOverageLimits() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateFeatureOverageLimits()
+	})
+}
+
+// ClearFeatureOverageLimits clears the value of the "feature_overage_limits" field.
+func (u *LedgerUpsertBulk) ClearFeatureOverageLimits() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.ClearFeatureOverageLimits()
+	})
+}
+
+// SetUsagePeriod sets the "usage_period" field.
+func (u *LedgerUpsertBulk) SetUsagePeriod(v credit.RecurrencePeriod) *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetUsagePeriod(v)
+	})
+}
+
+// UpdateUsagePeriod sets the "usage_period" field to the value that was provided on create.
+func (u *LedgerUpsertBulk) UpdateUsagePeriod() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateUsagePeriod()
+	})
+}
+
+// ClearUsagePeriod clears the value of the "usage_period" field.
+func (u *LedgerUpsertBulk) ClearUsagePeriod() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.ClearUsagePeriod()
+	})
+}
+
+// SetUsagePeriodAnchor sets the "usage_period_anchor" field.
+func (u *LedgerUpsertBulk) SetUsagePeriodAnchor(v time.Time) *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetUsagePeriodAnchor(v)
+	})
+}
+
+// UpdateUsagePeriodAnchor sets the "usage_period_anchor" field to the value that was provided on create.
+func (u *LedgerUpsertBulk) UpdateUsagePeriodAnchor() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateUsagePeriodAnchor()
+	})
+}
+
+// ClearUsagePeriodAnchor clears the value of the "usage_period_anchor" field.
+func (u *LedgerUpsertBulk) ClearUsagePeriodAnchor() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.ClearUsagePeriodAnchor()
+	})
+}
+
+// SetNextResetIndex sets the "next_reset_index" field.
+func (u *LedgerUpsertBulk) SetNextResetIndex(v int) *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetNextResetIndex(v)
+	})
+}
+
+// AddNextResetIndex adds v to the "next_reset_index" field.
+func (u *LedgerUpsertBulk) AddNextResetIndex(v int) *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.AddNextResetIndex(v)
+	})
+}
+
+// UpdateNextResetIndex sets the "next_reset_index" field to the value that was provided on create.
+func (u *LedgerUpsertBulk) UpdateNextResetIndex() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateNextResetIndex()
+	})
+}
+
+// SetNextResetAt sets the "next_reset_at" field.
+func (u *LedgerUpsertBulk) SetNextResetAt(v time.Time) *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.SetNextResetAt(v)
+	})
+}
+
+// UpdateNextResetAt sets the "next_reset_at" field to the value that was provided on create.
+func (u *LedgerUpsertBulk) UpdateNextResetAt() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.UpdateNextResetAt()
+	})
+}
+
+// ClearNextResetAt clears the value of the "next_reset_at" field.
+func (u *LedgerUpsertBulk) ClearNextResetAt() *LedgerUpsertBulk {
+	return u.Update(func(s *LedgerUpsert) {
+		s.ClearNextResetAt()
+	})
+}
+
 // SetHighwatermark sets the "highwatermark" field.
 func (u *LedgerUpsertBulk) SetHighwatermark(v time.Time) *LedgerUpsertBulk {
 	return u.Update(func(s *LedgerUpsert) {
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/ledger"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
 )
@@ -46,6 +47,126 @@ func (lu *LedgerUpdate) ClearMetadata() *LedgerUpdate {
 	return lu
 }
 
+// SetOverageLimit sets the "overage_limit" field.
+func (lu *LedgerUpdate) SetOverageLimit(f float64) *LedgerUpdate {
+	lu.mutation.ResetOverageLimit()
+	lu.mutation.SetOverageLimit(f)
+	return lu
+}
+
+// SetNillableOverageLimit sets the "overage_limit" field if the given value is not nil.
+func (lu *LedgerUpdate) SetNillableOverageLimit(f *float64) *LedgerUpdate {
+	if f != nil {
+		lu.SetOverageLimit(*f)
+	}
+	return lu
+}
+
+// AddOverageLimit adds f to the "overage_limit" field.
+func (lu *LedgerUpdate) AddOverageLimit(f float64) *LedgerUpdate {
+	lu.mutation.AddOverageLimit(f)
+	return lu
+}
+
+// ClearOverageLimit clears the value of the "overage_limit" field.
+func (lu *LedgerUpdate) ClearOverageLimit() *LedgerUpdate {
+	lu.mutation.ClearOverageLimit()
+	return lu
+}
+
+// SetFeatureOverageLimits sets the "feature_overage_limits" field.
+func (lu *LedgerUpdate) SetFeatureOverageLimits(m map[string]float64) *LedgerUpdate {
+	lu.mutation.SetFeatureOverageLimits(m)
+	return lu
+}
+
+// ClearFeatureOverageLimits clears the value of the "feature_overage_limits" field.
+func (lu *LedgerUpdate) ClearFeatureOverageLimits() *LedgerUpdate {
+	lu.mutation.ClearFeatureOverageLimits()
+	return lu
+}
+
+// SetUsagePeriod sets the "usage_period" field.
+func (lu *LedgerUpdate) SetUsagePeriod(cp credit.RecurrencePeriod) *LedgerUpdate {
+	lu.mutation.SetUsagePeriod(cp)
+	return lu
+}
+
+// SetNillableUsagePeriod sets the "usage_period" field if the given value is not nil.
+func (lu *LedgerUpdate) SetNillableUsagePeriod(cp *credit.RecurrencePeriod) *LedgerUpdate {
+	if cp != nil {
+		lu.SetUsagePeriod(*cp)
+	}
+	return lu
+}
+
+// ClearUsagePeriod clears the value of the "usage_period" field.
+func (lu *LedgerUpdate) ClearUsagePeriod() *LedgerUpdate {
+	lu.mutation.ClearUsagePeriod()
+	return lu
+}
+
+// SetUsagePeriodAnchor sets the "usage_period_anchor" field.
+func (lu *LedgerUpdate) SetUsagePeriodAnchor(t time.Time) *LedgerUpdate {
+	lu.mutation.SetUsagePeriodAnchor(t)
+	return lu
+}
+
+// SetNillableUsagePeriodAnchor sets the "usage_period_anchor" field if the given value is not nil.
+func (lu *LedgerUpdate) SetNillableUsagePeriodAnchor(t *time.Time) *LedgerUpdate {
+	if t != nil {
+		lu.SetUsagePeriodAnchor(*t)
+	}
+	return lu
+}
+
+// ClearUsagePeriodAnchor clears the value of the "usage_period_anchor" field.
+func (lu *LedgerUpdate) ClearUsagePeriodAnchor() *LedgerUpdate {
+	lu.mutation.ClearUsagePeriodAnchor()
+	return lu
+}
+
+// SetNextResetIndex sets the "next_reset_index" field.
+func (lu *LedgerUpdate) SetNextResetIndex(i int) *LedgerUpdate {
+	lu.mutation.ResetNextResetIndex()
+	lu.mutation.SetNextResetIndex(i)
+	return lu
+}
+
+// SetNillableNextResetIndex sets the "next_reset_index" field if the given value is not nil.
+func (lu *LedgerUpdate) SetNillableNextResetIndex(i *int) *LedgerUpdate {
+	if i != nil {
+		lu.SetNextResetIndex(*i)
+	}
+	return lu
+}
+
+// AddNextResetIndex adds i to the "next_reset_index" field.
+func (lu *LedgerUpdate) AddNextResetIndex(i int) *LedgerUpdate {
+	lu.mutation.AddNextResetIndex(i)
+	return lu
+}
+
+// SetNextResetAt sets the "next_reset_at" field.
+func (lu *LedgerUpdate) SetNextResetAt(t time.Time) *LedgerUpdate {
+	lu.mutation.SetNextResetAt(t)
+	return lu
+}
+
+// SetNillableNextResetAt sets the "next_reset_at" field if the given value is not nil.
+func (lu *LedgerUpdate) SetNillableNextResetAt(t *time.Time) *LedgerUpdate {
+	if t != nil {
+		lu.SetNextResetAt(*t)
+	}
+	return lu
+}
+
+// ClearNextResetAt clears the value of the "next_reset_at" field.
+func (lu *LedgerUpdate) ClearNextResetAt() *LedgerUpdate {
+	lu.mutation.ClearNextResetAt()
+	return lu
+}
+
 // SetHighwatermark sets the "highwatermark" field.
 func (lu *LedgerUpdate) SetHighwatermark(t time.Time) *LedgerUpdate {
 	lu.mutation.SetHighwatermark(t)
@@ -101,7 +222,20 @@ func (lu *LedgerUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (lu *LedgerUpdate) check() error {
+	if v, ok := lu.mutation.UsagePeriod(); ok {
+		if err := ledger.UsagePeriodValidator(v); err != nil {
+			return &ValidationError{Name: "usage_period", err: fmt.Errorf(`db: validator failed for field "Ledger.usage_period": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (lu *LedgerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(ledger.Table, ledger.Columns, sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeString))
 	if ps := lu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -119,6 +253,45 @@ func (lu *LedgerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if lu.mutation.MetadataCleared() {
 		_spec.ClearField(ledger.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := lu.mutation.OverageLimit(); ok {
+		_spec.SetField(ledger.FieldOverageLimit, field.TypeFloat64, value)
+	}
+	if value, ok := lu.mutation.AddedOverageLimit(); ok {
+		_spec.AddField(ledger.FieldOverageLimit, field.TypeFloat64, value)
+	}
+	if lu.mutation.OverageLimitCleared() {
+		_spec.ClearField(ledger.FieldOverageLimit, field.TypeFloat64)
+	}
+	if value, ok := lu.mutation.FeatureOverageLimits(); ok {
+		_spec.SetField(ledger.FieldFeatureOverageLimits, field.TypeJSON, value)
+	}
+	if lu.mutation.FeatureOverageLimitsCleared() {
+		_spec.ClearField(ledger.FieldFeatureOverageLimits, field.TypeJSON)
+	}
+	if value, ok := lu.mutation.UsagePeriod(); ok {
+		_spec.SetField(ledger.FieldUsagePeriod, field.TypeEnum, value)
+	}
+	if lu.mutation.UsagePeriodCleared() {
+		_spec.ClearField(ledger.FieldUsagePeriod, field.TypeEnum)
+	}
+	if value, ok := lu.mutation.UsagePeriodAnchor(); ok {
+		_spec.SetField(ledger.FieldUsagePeriodAnchor, field.TypeTime, value)
+	}
+	if lu.mutation.UsagePeriodAnchorCleared() {
+		_spec.ClearField(ledger.FieldUsagePeriodAnchor, field.TypeTime)
+	}
+	if value, ok := lu.mutation.NextResetIndex(); ok {
+		_spec.SetField(ledger.FieldNextResetIndex, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.AddedNextResetIndex(); ok {
+		_spec.AddField(ledger.FieldNextResetIndex, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.NextResetAt(); ok {
+		_spec.SetField(ledger.FieldNextResetAt, field.TypeTime, value)
+	}
+	if lu.mutation.NextResetAtCleared() {
+		_spec.ClearField(ledger.FieldNextResetAt, field.TypeTime)
+	}
 	if value, ok := lu.mutation.Highwatermark(); ok {
 		_spec.SetField(ledger.FieldHighwatermark, field.TypeTime, value)
 	}
@@ -160,6 +333,126 @@ func (luo *LedgerUpdateOne) ClearMetadata() *LedgerUpdateOne {
 	return luo
 }
 
+// SetOverageLimit sets the "overage_limit" field.
+func (luo *LedgerUpdateOne) SetOverageLimit(f float64) *LedgerUpdateOne {
+	luo.mutation.ResetOverageLimit()
+	luo.mutation.SetOverageLimit(f)
+	return luo
+}
+
+// SetNillableOverageLimit sets the "overage_limit" field if the given value is not nil.
+func (luo *LedgerUpdateOne) SetNillableOverageLimit(f *float64) *LedgerUpdateOne {
+	if f != nil {
+		luo.SetOverageLimit(*f)
+	}
+	return luo
+}
+
+// AddOverageLimit adds f to the "overage_limit" field.
+func (luo *LedgerUpdateOne) AddOverageLimit(f float64) *LedgerUpdateOne {
+	luo.mutation.AddOverageLimit(f)
+	return luo
+}
+
+// ClearOverageLimit clears the value of the "overage_limit" field.
+func (luo *LedgerUpdateOne) ClearOverageLimit() *LedgerUpdateOne {
+	luo.mutation.ClearOverageLimit()
+	return luo
+}
+
+// SetFeatureOverageLimits sets the "feature_overage_limits" field.
+func (luo *LedgerUpdateOne) SetFeatureOverageLimits(m map[string]float64) *LedgerUpdateOne {
+	luo.mutation.SetFeatureOverageLimits(m)
+	return luo
+}
+
+// ClearFeatureOverageLimits clears the value of the "feature_overage_limits" field.
+func (luo *LedgerUpdateOne) ClearFeatureOverageLimits() *LedgerUpdateOne {
+	luo.mutation.ClearFeatureOverageLimits()
+	return luo
+}
+
+// SetUsagePeriod sets the "usage_period" field.
+func (luo *LedgerUpdateOne) SetUsagePeriod(cp credit.RecurrencePeriod) *LedgerUpdateOne {
+	luo.mutation.SetUsagePeriod(cp)
+	return luo
+}
+
+// SetNillableUsagePeriod sets the "usage_period" field if the given value is not nil.
+func (luo *LedgerUpdateOne) SetNillableUsagePeriod(cp *credit.RecurrencePeriod) *LedgerUpdateOne {
+	if cp != nil {
+		luo.SetUsagePeriod(*cp)
+	}
+	return luo
+}
+
+// ClearUsagePeriod clears the value of the "usage_period" field.
+func (luo *LedgerUpdateOne) ClearUsagePeriod() *LedgerUpdateOne {
+	luo.mutation.ClearUsagePeriod()
+	return luo
+}
+
+// SetUsagePeriodAnchor sets the "usage_period_anchor" field.
+func (luo *LedgerUpdateOne) SetUsagePeriodAnchor(t time.Time) *LedgerUpdateOne {
+	luo.mutation.SetUsagePeriodAnchor(t)
+	return luo
+}
+
+// SetNillableUsagePeriodAnchor sets the "usage_period_anchor" field if the given value is not nil.
+func (luo *LedgerUpdateOne) SetNillableUsagePeriodAnchor(t *time.Time) *LedgerUpdateOne {
+	if t != nil {
+		luo.SetUsagePeriodAnchor(*t)
+	}
+	return luo
+}
+
+// ClearUsagePeriodAnchor clears the value of the "usage_period_anchor" field.
+func (luo *LedgerUpdateOne) ClearUsagePeriodAnchor() *LedgerUpdateOne {
+	luo.mutation.ClearUsagePeriodAnchor()
+	return luo
+}
+
+// SetNextResetIndex sets the "next_reset_index" field.
+func (luo *LedgerUpdateOne) SetNextResetIndex(i int) *LedgerUpdateOne {
+	luo.mutation.ResetNextResetIndex()
+	luo.mutation.SetNextResetIndex(i)
+	return luo
+}
+
+// SetNillableNextResetIndex sets the "next_reset_index" field if the given value is not nil.
+func (luo *LedgerUpdateOne) SetNillableNextResetIndex(i *int) *LedgerUpdateOne {
+	if i != nil {
+		luo.SetNextResetIndex(*i)
+	}
+	return luo
+}
+
+// AddNextResetIndex adds i to the "next_reset_index" field.
+func (luo *LedgerUpdateOne) AddNextResetIndex(i int) *LedgerUpdateOne {
+	luo.mutation.AddNextResetIndex(i)
+	return luo
+}
+
+// SetNextResetAt sets the "next_reset_at" field.
+func (luo *LedgerUpdateOne) SetNextResetAt(t time.Time) *LedgerUpdateOne {
+	luo.mutation.SetNextResetAt(t)
+	return luo
+}
+
+// SetNillableNextResetAt sets the "next_reset_at" field if the given value is not nil.
+func (luo *LedgerUpdateOne) SetNillableNextResetAt(t *time.Time) *LedgerUpdateOne {
+	if t != nil {
+		luo.SetNextResetAt(*t)
+	}
+	return luo
+}
+
+// ClearNextResetAt clears the value of the "next_reset_at" field.
+func (luo *LedgerUpdateOne) ClearNextResetAt() *LedgerUpdateOne {
+	luo.mutation.ClearNextResetAt()
+	return luo
+}
+
 // SetHighwatermark sets the "highwatermark" field.
 func (luo *LedgerUpdateOne) SetHighwatermark(t time.Time) *LedgerUpdateOne {
 	luo.mutation.SetHighwatermark(t)
@@ -228,7 +521,20 @@ func (luo *LedgerUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (luo *LedgerUpdateOne) check() error {
+	if v, ok := luo.mutation.UsagePeriod(); ok {
+		if err := ledger.UsagePeriodValidator(v); err != nil {
+			return &ValidationError{Name: "usage_period", err: fmt.Errorf(`db: validator failed for field "Ledger.usage_period": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (luo *LedgerUpdateOne) sqlSave(ctx context.Context) (_node *Ledger, err error) {
+	if err := luo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(ledger.Table, ledger.Columns, sqlgraph.NewFieldSpec(ledger.FieldID, field.TypeString))
 	id, ok := luo.mutation.ID()
 	if !ok {
@@ -263,6 +569,45 @@ func (luo *LedgerUpdateOne) sqlSave(ctx context.Context) (_node *Ledger, err err
 	if luo.mutation.MetadataCleared() {
 		_spec.ClearField(ledger.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := luo.mutation.OverageLimit(); ok {
+		_spec.SetField(ledger.FieldOverageLimit, field.TypeFloat64, value)
+	}
+	if value, ok := luo.mutation.AddedOverageLimit(); ok {
+		_spec.AddField(ledger.FieldOverageLimit, field.TypeFloat64, value)
+	}
+	if luo.mutation.OverageLimitCleared() {
+		_spec.ClearField(ledger.FieldOverageLimit, field.TypeFloat64)
+	}
+	if value, ok := luo.mutation.FeatureOverageLimits(); ok {
+		_spec.SetField(ledger.FieldFeatureOverageLimits, field.TypeJSON, value)
+	}
+	if luo.mutation.FeatureOverageLimitsCleared() {
+		_spec.ClearField(ledger.FieldFeatureOverageLimits, field.TypeJSON)
+	}
+	if value, ok := luo.mutation.UsagePeriod(); ok {
+		_spec.SetField(ledger.FieldUsagePeriod, field.TypeEnum, value)
+	}
+	if luo.mutation.UsagePeriodCleared() {
+		_spec.ClearField(ledger.FieldUsagePeriod, field.TypeEnum)
+	}
+	if value, ok := luo.mutation.UsagePeriodAnchor(); ok {
+		_spec.SetField(ledger.FieldUsagePeriodAnchor, field.TypeTime, value)
+	}
+	if luo.mutation.UsagePeriodAnchorCleared() {
+		_spec.ClearField(ledger.FieldUsagePeriodAnchor, field.TypeTime)
+	}
+	if value, ok := luo.mutation.NextResetIndex(); ok {
+		_spec.SetField(ledger.FieldNextResetIndex, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.AddedNextResetIndex(); ok {
+		_spec.AddField(ledger.FieldNextResetIndex, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.NextResetAt(); ok {
+		_spec.SetField(ledger.FieldNextResetAt, field.TypeTime, value)
+	}
+	if luo.mutation.NextResetAtCleared() {
+		_spec.ClearField(ledger.FieldNextResetAt, field.TypeTime)
+	}
 	if value, ok := luo.mutation.Highwatermark(); ok {
 		_spec.SetField(ledger.FieldHighwatermark, field.TypeTime, value)
 	}
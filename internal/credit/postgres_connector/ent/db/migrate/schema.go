@@ -8,6 +8,33 @@ import (
 )
 
 var (
+	// BalanceSnapshotsColumns holds the columns for the "balance_snapshots" table.
+	BalanceSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "ledger_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "at", Type: field.TypeTime},
+		{Name: "grant_balances", Type: field.TypeJSON},
+		{Name: "uncovered_usage", Type: field.TypeJSON},
+		{Name: "features", Type: field.TypeJSON, Nullable: true},
+		{Name: "overage_features", Type: field.TypeJSON, Nullable: true},
+		{Name: "monetary_usage", Type: field.TypeJSON, Nullable: true},
+	}
+	// BalanceSnapshotsTable holds the schema information for the "balance_snapshots" table.
+	BalanceSnapshotsTable = &schema.Table{
+		Name:       "balance_snapshots",
+		Columns:    BalanceSnapshotsColumns,
+		PrimaryKey: []*schema.Column{BalanceSnapshotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "balancesnapshot_namespace_ledger_id_at",
+				Unique:  false,
+				Columns: []*schema.Column{BalanceSnapshotsColumns[3], BalanceSnapshotsColumns[4], BalanceSnapshotsColumns[5]},
+			},
+		},
+	}
 	// CreditEntriesColumns holds the columns for the "credit_entries" table.
 	CreditEntriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "char(26)"}},
@@ -15,9 +42,10 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "namespace", Type: field.TypeString},
 		{Name: "ledger_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "char(26)"}},
-		{Name: "entry_type", Type: field.TypeEnum, Enums: []string{"GRANT", "VOID_GRANT", "RESET"}},
+		{Name: "entry_type", Type: field.TypeEnum, Enums: []string{"GRANT", "VOID_GRANT", "RESET", "AMEND_GRANT"}},
 		{Name: "type", Type: field.TypeEnum, Nullable: true, Enums: []string{"USAGE"}},
 		{Name: "amount", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric"}},
+		{Name: "currency", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "char(3)"}},
 		{Name: "priority", Type: field.TypeUint8, Default: 1},
 		{Name: "effective_at", Type: field.TypeTime},
 		{Name: "expiration_period_duration", Type: field.TypeEnum, Nullable: true, Enums: []string{"HOUR", "DAY", "WEEK", "MONTH", "YEAR"}},
@@ -26,7 +54,8 @@ var (
 		{Name: "rollover_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"ORIGINAL_AMOUNT", "REMAINING_AMOUNT"}},
 		{Name: "rollover_max_amount", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric"}},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
-		{Name: "parent_id", Type: field.TypeString, Unique: true, Nullable: true, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "grant_schedule_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "char(26)"}},
 		{Name: "feature_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "char(26)"}},
 	}
 	// CreditEntriesTable holds the schema information for the "credit_entries" table.
@@ -37,13 +66,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "credit_entries_credit_entries_children",
-				Columns:    []*schema.Column{CreditEntriesColumns[16]},
+				Columns:    []*schema.Column{CreditEntriesColumns[18]},
 				RefColumns: []*schema.Column{CreditEntriesColumns[0]},
 				OnDelete:   schema.Restrict,
 			},
 			{
 				Symbol:     "credit_entries_features_credit_grants",
-				Columns:    []*schema.Column{CreditEntriesColumns[17]},
+				Columns:    []*schema.Column{CreditEntriesColumns[19]},
 				RefColumns: []*schema.Column{FeaturesColumns[0]},
 				OnDelete:   schema.Restrict,
 			},
@@ -54,6 +83,39 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{CreditEntriesColumns[3], CreditEntriesColumns[4]},
 			},
+			{
+				Name:    "creditentry_grant_schedule_id_effective_at",
+				Unique:  true,
+				Columns: []*schema.Column{CreditEntriesColumns[17], CreditEntriesColumns[10]},
+			},
+		},
+	}
+	// EventTypesColumns holds the columns for the "event_types" table.
+	EventTypesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "schema", Type: field.TypeJSON},
+	}
+	// EventTypesTable holds the schema information for the "event_types" table.
+	EventTypesTable = &schema.Table{
+		Name:       "event_types",
+		Columns:    EventTypesColumns,
+		PrimaryKey: []*schema.Column{EventTypesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventtype_namespace_id",
+				Unique:  false,
+				Columns: []*schema.Column{EventTypesColumns[3], EventTypesColumns[0]},
+			},
+			{
+				Name:    "eventtype_namespace_type",
+				Unique:  true,
+				Columns: []*schema.Column{EventTypesColumns[3], EventTypesColumns[4]},
+			},
 		},
 	}
 	// FeaturesColumns holds the columns for the "features" table.
@@ -65,6 +127,8 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "meter_slug", Type: field.TypeString},
 		{Name: "meter_group_by_filters", Type: field.TypeJSON, Nullable: true},
+		{Name: "meter_group_by_filter_versions", Type: field.TypeJSON, Nullable: true},
+		{Name: "unit_price", Type: field.TypeJSON, Nullable: true},
 		{Name: "archived", Type: field.TypeBool, Default: false},
 	}
 	// FeaturesTable holds the schema information for the "features" table.
@@ -80,6 +144,45 @@ var (
 			},
 		},
 	}
+	// GrantSchedulesColumns holds the columns for the "grant_schedules" table.
+	GrantSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "ledger_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"USAGE"}},
+		{Name: "feature_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric"}},
+		{Name: "priority", Type: field.TypeUint8, Default: 1},
+		{Name: "expiration_period_duration", Type: field.TypeEnum, Enums: []string{"HOUR", "DAY", "WEEK", "MONTH", "YEAR"}},
+		{Name: "expiration_period_count", Type: field.TypeUint8},
+		{Name: "rollover_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"ORIGINAL_AMOUNT", "REMAINING_AMOUNT"}},
+		{Name: "rollover_max_amount", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric"}},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "recurrence_period", Type: field.TypeEnum, Enums: []string{"DAY", "WEEK", "MONTH", "YEAR"}},
+		{Name: "recurrence_anchor", Type: field.TypeTime},
+		{Name: "next_grant_index", Type: field.TypeInt, Default: 0},
+		{Name: "next_grant_at", Type: field.TypeTime},
+	}
+	// GrantSchedulesTable holds the schema information for the "grant_schedules" table.
+	GrantSchedulesTable = &schema.Table{
+		Name:       "grant_schedules",
+		Columns:    GrantSchedulesColumns,
+		PrimaryKey: []*schema.Column{GrantSchedulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "grantschedule_namespace_ledger_id",
+				Unique:  false,
+				Columns: []*schema.Column{GrantSchedulesColumns[3], GrantSchedulesColumns[4]},
+			},
+			{
+				Name:    "grantschedule_next_grant_at",
+				Unique:  false,
+				Columns: []*schema.Column{GrantSchedulesColumns[17]},
+			},
+		},
+	}
 	// LedgersColumns holds the columns for the "ledgers" table.
 	LedgersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "char(26)"}},
@@ -88,6 +191,12 @@ var (
 		{Name: "namespace", Type: field.TypeString},
 		{Name: "subject", Type: field.TypeString},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "overage_limit", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric"}},
+		{Name: "feature_overage_limits", Type: field.TypeJSON, Nullable: true},
+		{Name: "usage_period", Type: field.TypeEnum, Nullable: true, Enums: []string{"DAY", "WEEK", "MONTH", "YEAR"}},
+		{Name: "usage_period_anchor", Type: field.TypeTime, Nullable: true},
+		{Name: "next_reset_index", Type: field.TypeInt, Default: 0},
+		{Name: "next_reset_at", Type: field.TypeTime, Nullable: true},
 		{Name: "highwatermark", Type: field.TypeTime},
 	}
 	// LedgersTable holds the schema information for the "ledgers" table.
@@ -101,13 +210,114 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{LedgersColumns[3], LedgersColumns[4]},
 			},
+			{
+				Name:    "ledger_next_reset_at",
+				Unique:  false,
+				Columns: []*schema.Column{LedgersColumns[11]},
+			},
+		},
+	}
+	// NotificationDeliveriesColumns holds the columns for the "notification_deliveries" table.
+	NotificationDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "rule_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "ledger_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "url", Type: field.TypeString},
+		{Name: "event", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "SUCCEEDED", "FAILED"}},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// NotificationDeliveriesTable holds the schema information for the "notification_deliveries" table.
+	NotificationDeliveriesTable = &schema.Table{
+		Name:       "notification_deliveries",
+		Columns:    NotificationDeliveriesColumns,
+		PrimaryKey: []*schema.Column{NotificationDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationdelivery_namespace_rule_id",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[3], NotificationDeliveriesColumns[4]},
+			},
+			{
+				Name:    "notificationdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[8], NotificationDeliveriesColumns[10]},
+			},
+		},
+	}
+	// NotificationRulesColumns holds the columns for the "notification_rules" table.
+	NotificationRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "ledger_id", Type: field.TypeString, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "feature_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"BALANCE_BELOW", "BALANCE_BELOW_PERCENT", "BALANCE_EXHAUSTED"}},
+		{Name: "threshold", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric"}},
+		{Name: "url", Type: field.TypeString},
+		{Name: "triggered", Type: field.TypeBool, Default: false},
+		{Name: "triggered_at", Type: field.TypeTime, Nullable: true},
+	}
+	// NotificationRulesTable holds the schema information for the "notification_rules" table.
+	NotificationRulesTable = &schema.Table{
+		Name:       "notification_rules",
+		Columns:    NotificationRulesColumns,
+		PrimaryKey: []*schema.Column{NotificationRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationrule_namespace_ledger_id",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationRulesColumns[3], NotificationRulesColumns[4]},
+			},
+		},
+	}
+	// SubjectAliasColumns holds the columns for the "subject_alias" table.
+	SubjectAliasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"postgres": "char(26)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "source", Type: field.TypeString, Default: ""},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "canonical_subject", Type: field.TypeString},
+	}
+	// SubjectAliasTable holds the schema information for the "subject_alias" table.
+	SubjectAliasTable = &schema.Table{
+		Name:       "subject_alias",
+		Columns:    SubjectAliasColumns,
+		PrimaryKey: []*schema.Column{SubjectAliasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subjectalias_namespace_id",
+				Unique:  false,
+				Columns: []*schema.Column{SubjectAliasColumns[3], SubjectAliasColumns[0]},
+			},
+			{
+				Name:    "subjectalias_namespace_source_subject",
+				Unique:  true,
+				Columns: []*schema.Column{SubjectAliasColumns[3], SubjectAliasColumns[4], SubjectAliasColumns[5]},
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BalanceSnapshotsTable,
 		CreditEntriesTable,
+		EventTypesTable,
 		FeaturesTable,
+		GrantSchedulesTable,
 		LedgersTable,
+		NotificationDeliveriesTable,
+		NotificationRulesTable,
+		SubjectAliasTable,
 	}
 )
 
@@ -12,10 +12,16 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/balancesnapshot"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/creditentry"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/eventtype"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/feature"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/grantschedule"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/ledger"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationdelivery"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationrule"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/subjectalias"
 )
 
 const (
@@ -27,59 +33,52 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCreditEntry = "CreditEntry"
-	TypeFeature     = "Feature"
-	TypeLedger      = "Ledger"
+	TypeBalanceSnapshot      = "BalanceSnapshot"
+	TypeCreditEntry          = "CreditEntry"
+	TypeEventType            = "EventType"
+	TypeFeature              = "Feature"
+	TypeGrantSchedule        = "GrantSchedule"
+	TypeLedger               = "Ledger"
+	TypeNotificationDelivery = "NotificationDelivery"
+	TypeNotificationRule     = "NotificationRule"
+	TypeSubjectAlias         = "SubjectAlias"
 )
 
-// CreditEntryMutation represents an operation that mutates the CreditEntry nodes in the graph.
-type CreditEntryMutation struct {
+// BalanceSnapshotMutation represents an operation that mutates the BalanceSnapshot nodes in the graph.
+type BalanceSnapshotMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *string
-	created_at                 *time.Time
-	updated_at                 *time.Time
-	namespace                  *string
-	ledger_id                  *string
-	entry_type                 *credit.EntryType
-	_type                      *credit.GrantType
-	amount                     *float64
-	addamount                  *float64
-	priority                   *uint8
-	addpriority                *int8
-	effective_at               *time.Time
-	expiration_period_duration *credit.ExpirationPeriodDuration
-	expiration_period_count    *uint8
-	addexpiration_period_count *int8
-	expiration_at              *time.Time
-	rollover_type              *credit.GrantRolloverType
-	rollover_max_amount        *float64
-	addrollover_max_amount     *float64
-	metadata                   *map[string]string
-	clearedFields              map[string]struct{}
-	parent                     *string
-	clearedparent              bool
-	children                   *string
-	clearedchildren            bool
-	feature                    *string
-	clearedfeature             bool
-	done                       bool
-	oldValue                   func(context.Context) (*CreditEntry, error)
-	predicates                 []predicate.CreditEntry
+	op                     Op
+	typ                    string
+	id                     *string
+	created_at             *time.Time
+	updated_at             *time.Time
+	namespace              *string
+	ledger_id              *string
+	at                     *time.Time
+	grant_balances         *map[credit.GrantID]float64
+	uncovered_usage        *map[credit.FeatureID]float64
+	features               *[]credit.FeatureID
+	appendfeatures         []credit.FeatureID
+	overage_features       *[]credit.FeatureID
+	appendoverage_features []credit.FeatureID
+	monetary_usage         *map[credit.FeatureID]credit.MonetaryUsage
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*BalanceSnapshot, error)
+	predicates             []predicate.BalanceSnapshot
 }
 
-var _ ent.Mutation = (*CreditEntryMutation)(nil)
+var _ ent.Mutation = (*BalanceSnapshotMutation)(nil)
 
-// creditentryOption allows management of the mutation configuration using functional options.
-type creditentryOption func(*CreditEntryMutation)
+// balancesnapshotOption allows management of the mutation configuration using functional options.
+type balancesnapshotOption func(*BalanceSnapshotMutation)
 
-// newCreditEntryMutation creates new mutation for the CreditEntry entity.
-func newCreditEntryMutation(c config, op Op, opts ...creditentryOption) *CreditEntryMutation {
-	m := &CreditEntryMutation{
+// newBalanceSnapshotMutation creates new mutation for the BalanceSnapshot entity.
+func newBalanceSnapshotMutation(c config, op Op, opts ...balancesnapshotOption) *BalanceSnapshotMutation {
+	m := &BalanceSnapshotMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCreditEntry,
+		typ:           TypeBalanceSnapshot,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -88,20 +87,20 @@ func newCreditEntryMutation(c config, op Op, opts ...creditentryOption) *CreditE
 	return m
 }
 
-// withCreditEntryID sets the ID field of the mutation.
-func withCreditEntryID(id string) creditentryOption {
-	return func(m *CreditEntryMutation) {
+// withBalanceSnapshotID sets the ID field of the mutation.
+func withBalanceSnapshotID(id string) balancesnapshotOption {
+	return func(m *BalanceSnapshotMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CreditEntry
+			value *BalanceSnapshot
 		)
-		m.oldValue = func(ctx context.Context) (*CreditEntry, error) {
+		m.oldValue = func(ctx context.Context) (*BalanceSnapshot, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CreditEntry.Get(ctx, id)
+					value, err = m.Client().BalanceSnapshot.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -110,10 +109,10 @@ func withCreditEntryID(id string) creditentryOption {
 	}
 }
 
-// withCreditEntry sets the old CreditEntry of the mutation.
-func withCreditEntry(node *CreditEntry) creditentryOption {
-	return func(m *CreditEntryMutation) {
-		m.oldValue = func(context.Context) (*CreditEntry, error) {
+// withBalanceSnapshot sets the old BalanceSnapshot of the mutation.
+func withBalanceSnapshot(node *BalanceSnapshot) balancesnapshotOption {
+	return func(m *BalanceSnapshotMutation) {
+		m.oldValue = func(context.Context) (*BalanceSnapshot, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -122,7 +121,7 @@ func withCreditEntry(node *CreditEntry) creditentryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CreditEntryMutation) Client() *Client {
+func (m BalanceSnapshotMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -130,7 +129,7 @@ func (m CreditEntryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CreditEntryMutation) Tx() (*Tx, error) {
+func (m BalanceSnapshotMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -140,14 +139,14 @@ func (m CreditEntryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of CreditEntry entities.
-func (m *CreditEntryMutation) SetID(id string) {
+// operation is only accepted on creation of BalanceSnapshot entities.
+func (m *BalanceSnapshotMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CreditEntryMutation) ID() (id string, exists bool) {
+func (m *BalanceSnapshotMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -158,7 +157,7 @@ func (m *CreditEntryMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CreditEntryMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *BalanceSnapshotMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -167,19 +166,19 @@ func (m *CreditEntryMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CreditEntry.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BalanceSnapshot.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *CreditEntryMutation) SetCreatedAt(t time.Time) {
+func (m *BalanceSnapshotMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CreditEntryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *BalanceSnapshotMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -187,10 +186,10 @@ func (m *CreditEntryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BalanceSnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -205,17 +204,17 @@ func (m *CreditEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CreditEntryMutation) ResetCreatedAt() {
+func (m *BalanceSnapshotMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *CreditEntryMutation) SetUpdatedAt(t time.Time) {
+func (m *BalanceSnapshotMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CreditEntryMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *BalanceSnapshotMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -223,10 +222,10 @@ func (m *CreditEntryMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BalanceSnapshotMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -241,17 +240,17 @@ func (m *CreditEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CreditEntryMutation) ResetUpdatedAt() {
+func (m *BalanceSnapshotMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetNamespace sets the "namespace" field.
-func (m *CreditEntryMutation) SetNamespace(s string) {
+func (m *BalanceSnapshotMutation) SetNamespace(s string) {
 	m.namespace = &s
 }
 
 // Namespace returns the value of the "namespace" field in the mutation.
-func (m *CreditEntryMutation) Namespace() (r string, exists bool) {
+func (m *BalanceSnapshotMutation) Namespace() (r string, exists bool) {
 	v := m.namespace
 	if v == nil {
 		return
@@ -259,10 +258,10 @@ func (m *CreditEntryMutation) Namespace() (r string, exists bool) {
 	return *v, true
 }
 
-// OldNamespace returns the old "namespace" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldNamespace returns the old "namespace" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldNamespace(ctx context.Context) (v string, err error) {
+func (m *BalanceSnapshotMutation) OldNamespace(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
 	}
@@ -277,17 +276,17 @@ func (m *CreditEntryMutation) OldNamespace(ctx context.Context) (v string, err e
 }
 
 // ResetNamespace resets all changes to the "namespace" field.
-func (m *CreditEntryMutation) ResetNamespace() {
+func (m *BalanceSnapshotMutation) ResetNamespace() {
 	m.namespace = nil
 }
 
 // SetLedgerID sets the "ledger_id" field.
-func (m *CreditEntryMutation) SetLedgerID(s string) {
+func (m *BalanceSnapshotMutation) SetLedgerID(s string) {
 	m.ledger_id = &s
 }
 
 // LedgerID returns the value of the "ledger_id" field in the mutation.
-func (m *CreditEntryMutation) LedgerID() (r string, exists bool) {
+func (m *BalanceSnapshotMutation) LedgerID() (r string, exists bool) {
 	v := m.ledger_id
 	if v == nil {
 		return
@@ -295,10 +294,10 @@ func (m *CreditEntryMutation) LedgerID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldLedgerID returns the old "ledger_id" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldLedgerID returns the old "ledger_id" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldLedgerID(ctx context.Context) (v string, err error) {
+func (m *BalanceSnapshotMutation) OldLedgerID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldLedgerID is only allowed on UpdateOne operations")
 	}
@@ -313,793 +312,7324 @@ func (m *CreditEntryMutation) OldLedgerID(ctx context.Context) (v string, err er
 }
 
 // ResetLedgerID resets all changes to the "ledger_id" field.
-func (m *CreditEntryMutation) ResetLedgerID() {
+func (m *BalanceSnapshotMutation) ResetLedgerID() {
 	m.ledger_id = nil
 }
 
-// SetEntryType sets the "entry_type" field.
-func (m *CreditEntryMutation) SetEntryType(ct credit.EntryType) {
-	m.entry_type = &ct
+// SetAt sets the "at" field.
+func (m *BalanceSnapshotMutation) SetAt(t time.Time) {
+	m.at = &t
 }
 
-// EntryType returns the value of the "entry_type" field in the mutation.
-func (m *CreditEntryMutation) EntryType() (r credit.EntryType, exists bool) {
-	v := m.entry_type
+// At returns the value of the "at" field in the mutation.
+func (m *BalanceSnapshotMutation) At() (r time.Time, exists bool) {
+	v := m.at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEntryType returns the old "entry_type" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldAt returns the old "at" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldEntryType(ctx context.Context) (v credit.EntryType, err error) {
+func (m *BalanceSnapshotMutation) OldAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEntryType is only allowed on UpdateOne operations")
+		return v, errors.New("OldAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEntryType requires an ID field in the mutation")
+		return v, errors.New("OldAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEntryType: %w", err)
+		return v, fmt.Errorf("querying old value for OldAt: %w", err)
 	}
-	return oldValue.EntryType, nil
+	return oldValue.At, nil
 }
 
-// ResetEntryType resets all changes to the "entry_type" field.
-func (m *CreditEntryMutation) ResetEntryType() {
-	m.entry_type = nil
+// ResetAt resets all changes to the "at" field.
+func (m *BalanceSnapshotMutation) ResetAt() {
+	m.at = nil
 }
 
-// SetType sets the "type" field.
-func (m *CreditEntryMutation) SetType(ct credit.GrantType) {
-	m._type = &ct
+// SetGrantBalances sets the "grant_balances" field.
+func (m *BalanceSnapshotMutation) SetGrantBalances(mi map[credit.GrantID]float64) {
+	m.grant_balances = &mi
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *CreditEntryMutation) GetType() (r credit.GrantType, exists bool) {
-	v := m._type
+// GrantBalances returns the value of the "grant_balances" field in the mutation.
+func (m *BalanceSnapshotMutation) GrantBalances() (r map[credit.GrantID]float64, exists bool) {
+	v := m.grant_balances
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldGrantBalances returns the old "grant_balances" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldType(ctx context.Context) (v *credit.GrantType, err error) {
+func (m *BalanceSnapshotMutation) OldGrantBalances(ctx context.Context) (v map[credit.GrantID]float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldGrantBalances is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldGrantBalances requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldGrantBalances: %w", err)
 	}
-	return oldValue.Type, nil
-}
-
-// ClearType clears the value of the "type" field.
-func (m *CreditEntryMutation) ClearType() {
-	m._type = nil
-	m.clearedFields[creditentry.FieldType] = struct{}{}
-}
-
-// TypeCleared returns if the "type" field was cleared in this mutation.
-func (m *CreditEntryMutation) TypeCleared() bool {
-	_, ok := m.clearedFields[creditentry.FieldType]
-	return ok
+	return oldValue.GrantBalances, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *CreditEntryMutation) ResetType() {
-	m._type = nil
-	delete(m.clearedFields, creditentry.FieldType)
+// ResetGrantBalances resets all changes to the "grant_balances" field.
+func (m *BalanceSnapshotMutation) ResetGrantBalances() {
+	m.grant_balances = nil
 }
 
-// SetFeatureID sets the "feature_id" field.
-func (m *CreditEntryMutation) SetFeatureID(s string) {
-	m.feature = &s
+// SetUncoveredUsage sets the "uncovered_usage" field.
+func (m *BalanceSnapshotMutation) SetUncoveredUsage(mi map[credit.FeatureID]float64) {
+	m.uncovered_usage = &mi
 }
 
-// FeatureID returns the value of the "feature_id" field in the mutation.
-func (m *CreditEntryMutation) FeatureID() (r string, exists bool) {
-	v := m.feature
+// UncoveredUsage returns the value of the "uncovered_usage" field in the mutation.
+func (m *BalanceSnapshotMutation) UncoveredUsage() (r map[credit.FeatureID]float64, exists bool) {
+	v := m.uncovered_usage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFeatureID returns the old "feature_id" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldUncoveredUsage returns the old "uncovered_usage" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldFeatureID(ctx context.Context) (v *string, err error) {
+func (m *BalanceSnapshotMutation) OldUncoveredUsage(ctx context.Context) (v map[credit.FeatureID]float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFeatureID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUncoveredUsage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFeatureID requires an ID field in the mutation")
+		return v, errors.New("OldUncoveredUsage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFeatureID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUncoveredUsage: %w", err)
 	}
-	return oldValue.FeatureID, nil
-}
-
-// ClearFeatureID clears the value of the "feature_id" field.
-func (m *CreditEntryMutation) ClearFeatureID() {
-	m.feature = nil
-	m.clearedFields[creditentry.FieldFeatureID] = struct{}{}
-}
-
-// FeatureIDCleared returns if the "feature_id" field was cleared in this mutation.
-func (m *CreditEntryMutation) FeatureIDCleared() bool {
-	_, ok := m.clearedFields[creditentry.FieldFeatureID]
-	return ok
+	return oldValue.UncoveredUsage, nil
 }
 
-// ResetFeatureID resets all changes to the "feature_id" field.
-func (m *CreditEntryMutation) ResetFeatureID() {
-	m.feature = nil
-	delete(m.clearedFields, creditentry.FieldFeatureID)
+// ResetUncoveredUsage resets all changes to the "uncovered_usage" field.
+func (m *BalanceSnapshotMutation) ResetUncoveredUsage() {
+	m.uncovered_usage = nil
 }
 
-// SetAmount sets the "amount" field.
-func (m *CreditEntryMutation) SetAmount(f float64) {
-	m.amount = &f
-	m.addamount = nil
+// SetFeatures sets the "features" field.
+func (m *BalanceSnapshotMutation) SetFeatures(ci []credit.FeatureID) {
+	m.features = &ci
+	m.appendfeatures = nil
 }
 
-// Amount returns the value of the "amount" field in the mutation.
-func (m *CreditEntryMutation) Amount() (r float64, exists bool) {
-	v := m.amount
+// Features returns the value of the "features" field in the mutation.
+func (m *BalanceSnapshotMutation) Features() (r []credit.FeatureID, exists bool) {
+	v := m.features
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAmount returns the old "amount" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldFeatures returns the old "features" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldAmount(ctx context.Context) (v *float64, err error) {
+func (m *BalanceSnapshotMutation) OldFeatures(ctx context.Context) (v []credit.FeatureID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldFeatures is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmount requires an ID field in the mutation")
+		return v, errors.New("OldFeatures requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldFeatures: %w", err)
 	}
-	return oldValue.Amount, nil
+	return oldValue.Features, nil
 }
 
-// AddAmount adds f to the "amount" field.
-func (m *CreditEntryMutation) AddAmount(f float64) {
-	if m.addamount != nil {
-		*m.addamount += f
-	} else {
-		m.addamount = &f
-	}
+// AppendFeatures adds ci to the "features" field.
+func (m *BalanceSnapshotMutation) AppendFeatures(ci []credit.FeatureID) {
+	m.appendfeatures = append(m.appendfeatures, ci...)
 }
 
-// AddedAmount returns the value that was added to the "amount" field in this mutation.
-func (m *CreditEntryMutation) AddedAmount() (r float64, exists bool) {
-	v := m.addamount
-	if v == nil {
-		return
+// AppendedFeatures returns the list of values that were appended to the "features" field in this mutation.
+func (m *BalanceSnapshotMutation) AppendedFeatures() ([]credit.FeatureID, bool) {
+	if len(m.appendfeatures) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendfeatures, true
 }
 
-// ClearAmount clears the value of the "amount" field.
-func (m *CreditEntryMutation) ClearAmount() {
-	m.amount = nil
-	m.addamount = nil
-	m.clearedFields[creditentry.FieldAmount] = struct{}{}
+// ClearFeatures clears the value of the "features" field.
+func (m *BalanceSnapshotMutation) ClearFeatures() {
+	m.features = nil
+	m.appendfeatures = nil
+	m.clearedFields[balancesnapshot.FieldFeatures] = struct{}{}
 }
 
-// AmountCleared returns if the "amount" field was cleared in this mutation.
-func (m *CreditEntryMutation) AmountCleared() bool {
-	_, ok := m.clearedFields[creditentry.FieldAmount]
+// FeaturesCleared returns if the "features" field was cleared in this mutation.
+func (m *BalanceSnapshotMutation) FeaturesCleared() bool {
+	_, ok := m.clearedFields[balancesnapshot.FieldFeatures]
 	return ok
 }
 
-// ResetAmount resets all changes to the "amount" field.
-func (m *CreditEntryMutation) ResetAmount() {
-	m.amount = nil
-	m.addamount = nil
-	delete(m.clearedFields, creditentry.FieldAmount)
+// ResetFeatures resets all changes to the "features" field.
+func (m *BalanceSnapshotMutation) ResetFeatures() {
+	m.features = nil
+	m.appendfeatures = nil
+	delete(m.clearedFields, balancesnapshot.FieldFeatures)
 }
 
-// SetPriority sets the "priority" field.
-func (m *CreditEntryMutation) SetPriority(u uint8) {
-	m.priority = &u
-	m.addpriority = nil
+// SetOverageFeatures sets the "overage_features" field.
+func (m *BalanceSnapshotMutation) SetOverageFeatures(ci []credit.FeatureID) {
+	m.overage_features = &ci
+	m.appendoverage_features = nil
 }
 
-// Priority returns the value of the "priority" field in the mutation.
-func (m *CreditEntryMutation) Priority() (r uint8, exists bool) {
-	v := m.priority
+// OverageFeatures returns the value of the "overage_features" field in the mutation.
+func (m *BalanceSnapshotMutation) OverageFeatures() (r []credit.FeatureID, exists bool) {
+	v := m.overage_features
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriority returns the old "priority" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldOverageFeatures returns the old "overage_features" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldPriority(ctx context.Context) (v uint8, err error) {
+func (m *BalanceSnapshotMutation) OldOverageFeatures(ctx context.Context) (v []credit.FeatureID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+		return v, errors.New("OldOverageFeatures is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriority requires an ID field in the mutation")
+		return v, errors.New("OldOverageFeatures requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+		return v, fmt.Errorf("querying old value for OldOverageFeatures: %w", err)
 	}
-	return oldValue.Priority, nil
+	return oldValue.OverageFeatures, nil
 }
 
-// AddPriority adds u to the "priority" field.
-func (m *CreditEntryMutation) AddPriority(u int8) {
-	if m.addpriority != nil {
-		*m.addpriority += u
-	} else {
-		m.addpriority = &u
-	}
+// AppendOverageFeatures adds ci to the "overage_features" field.
+func (m *BalanceSnapshotMutation) AppendOverageFeatures(ci []credit.FeatureID) {
+	m.appendoverage_features = append(m.appendoverage_features, ci...)
 }
 
-// AddedPriority returns the value that was added to the "priority" field in this mutation.
-func (m *CreditEntryMutation) AddedPriority() (r int8, exists bool) {
-	v := m.addpriority
-	if v == nil {
-		return
+// AppendedOverageFeatures returns the list of values that were appended to the "overage_features" field in this mutation.
+func (m *BalanceSnapshotMutation) AppendedOverageFeatures() ([]credit.FeatureID, bool) {
+	if len(m.appendoverage_features) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendoverage_features, true
 }
 
-// ResetPriority resets all changes to the "priority" field.
-func (m *CreditEntryMutation) ResetPriority() {
-	m.priority = nil
-	m.addpriority = nil
+// ClearOverageFeatures clears the value of the "overage_features" field.
+func (m *BalanceSnapshotMutation) ClearOverageFeatures() {
+	m.overage_features = nil
+	m.appendoverage_features = nil
+	m.clearedFields[balancesnapshot.FieldOverageFeatures] = struct{}{}
 }
 
-// SetEffectiveAt sets the "effective_at" field.
-func (m *CreditEntryMutation) SetEffectiveAt(t time.Time) {
-	m.effective_at = &t
+// OverageFeaturesCleared returns if the "overage_features" field was cleared in this mutation.
+func (m *BalanceSnapshotMutation) OverageFeaturesCleared() bool {
+	_, ok := m.clearedFields[balancesnapshot.FieldOverageFeatures]
+	return ok
 }
 
-// EffectiveAt returns the value of the "effective_at" field in the mutation.
-func (m *CreditEntryMutation) EffectiveAt() (r time.Time, exists bool) {
-	v := m.effective_at
+// ResetOverageFeatures resets all changes to the "overage_features" field.
+func (m *BalanceSnapshotMutation) ResetOverageFeatures() {
+	m.overage_features = nil
+	m.appendoverage_features = nil
+	delete(m.clearedFields, balancesnapshot.FieldOverageFeatures)
+}
+
+// SetMonetaryUsage sets the "monetary_usage" field.
+func (m *BalanceSnapshotMutation) SetMonetaryUsage(miu map[credit.FeatureID]credit.MonetaryUsage) {
+	m.monetary_usage = &miu
+}
+
+// MonetaryUsage returns the value of the "monetary_usage" field in the mutation.
+func (m *BalanceSnapshotMutation) MonetaryUsage() (r map[credit.FeatureID]credit.MonetaryUsage, exists bool) {
+	v := m.monetary_usage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEffectiveAt returns the old "effective_at" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldMonetaryUsage returns the old "monetary_usage" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldEffectiveAt(ctx context.Context) (v time.Time, err error) {
+func (m *BalanceSnapshotMutation) OldMonetaryUsage(ctx context.Context) (v map[credit.FeatureID]credit.MonetaryUsage, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEffectiveAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMonetaryUsage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEffectiveAt requires an ID field in the mutation")
+		return v, errors.New("OldMonetaryUsage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEffectiveAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMonetaryUsage: %w", err)
 	}
-	return oldValue.EffectiveAt, nil
+	return oldValue.MonetaryUsage, nil
 }
 
-// ResetEffectiveAt resets all changes to the "effective_at" field.
-func (m *CreditEntryMutation) ResetEffectiveAt() {
-	m.effective_at = nil
+// ClearMonetaryUsage clears the value of the "monetary_usage" field.
+func (m *BalanceSnapshotMutation) ClearMonetaryUsage() {
+	m.monetary_usage = nil
+	m.clearedFields[balancesnapshot.FieldMonetaryUsage] = struct{}{}
 }
 
-// SetExpirationPeriodDuration sets the "expiration_period_duration" field.
+// MonetaryUsageCleared returns if the "monetary_usage" field was cleared in this mutation.
+func (m *BalanceSnapshotMutation) MonetaryUsageCleared() bool {
+	_, ok := m.clearedFields[balancesnapshot.FieldMonetaryUsage]
+	return ok
+}
+
+// ResetMonetaryUsage resets all changes to the "monetary_usage" field.
+func (m *BalanceSnapshotMutation) ResetMonetaryUsage() {
+	m.monetary_usage = nil
+	delete(m.clearedFields, balancesnapshot.FieldMonetaryUsage)
+}
+
+// Where appends a list predicates to the BalanceSnapshotMutation builder.
+func (m *BalanceSnapshotMutation) Where(ps ...predicate.BalanceSnapshot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BalanceSnapshotMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BalanceSnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BalanceSnapshot, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BalanceSnapshotMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BalanceSnapshotMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BalanceSnapshot).
+func (m *BalanceSnapshotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BalanceSnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, balancesnapshot.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, balancesnapshot.FieldUpdatedAt)
+	}
+	if m.namespace != nil {
+		fields = append(fields, balancesnapshot.FieldNamespace)
+	}
+	if m.ledger_id != nil {
+		fields = append(fields, balancesnapshot.FieldLedgerID)
+	}
+	if m.at != nil {
+		fields = append(fields, balancesnapshot.FieldAt)
+	}
+	if m.grant_balances != nil {
+		fields = append(fields, balancesnapshot.FieldGrantBalances)
+	}
+	if m.uncovered_usage != nil {
+		fields = append(fields, balancesnapshot.FieldUncoveredUsage)
+	}
+	if m.features != nil {
+		fields = append(fields, balancesnapshot.FieldFeatures)
+	}
+	if m.overage_features != nil {
+		fields = append(fields, balancesnapshot.FieldOverageFeatures)
+	}
+	if m.monetary_usage != nil {
+		fields = append(fields, balancesnapshot.FieldMonetaryUsage)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BalanceSnapshotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case balancesnapshot.FieldCreatedAt:
+		return m.CreatedAt()
+	case balancesnapshot.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case balancesnapshot.FieldNamespace:
+		return m.Namespace()
+	case balancesnapshot.FieldLedgerID:
+		return m.LedgerID()
+	case balancesnapshot.FieldAt:
+		return m.At()
+	case balancesnapshot.FieldGrantBalances:
+		return m.GrantBalances()
+	case balancesnapshot.FieldUncoveredUsage:
+		return m.UncoveredUsage()
+	case balancesnapshot.FieldFeatures:
+		return m.Features()
+	case balancesnapshot.FieldOverageFeatures:
+		return m.OverageFeatures()
+	case balancesnapshot.FieldMonetaryUsage:
+		return m.MonetaryUsage()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BalanceSnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case balancesnapshot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case balancesnapshot.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case balancesnapshot.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case balancesnapshot.FieldLedgerID:
+		return m.OldLedgerID(ctx)
+	case balancesnapshot.FieldAt:
+		return m.OldAt(ctx)
+	case balancesnapshot.FieldGrantBalances:
+		return m.OldGrantBalances(ctx)
+	case balancesnapshot.FieldUncoveredUsage:
+		return m.OldUncoveredUsage(ctx)
+	case balancesnapshot.FieldFeatures:
+		return m.OldFeatures(ctx)
+	case balancesnapshot.FieldOverageFeatures:
+		return m.OldOverageFeatures(ctx)
+	case balancesnapshot.FieldMonetaryUsage:
+		return m.OldMonetaryUsage(ctx)
+	}
+	return nil, fmt.Errorf("unknown BalanceSnapshot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BalanceSnapshotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case balancesnapshot.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case balancesnapshot.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case balancesnapshot.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case balancesnapshot.FieldLedgerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLedgerID(v)
+		return nil
+	case balancesnapshot.FieldAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAt(v)
+		return nil
+	case balancesnapshot.FieldGrantBalances:
+		v, ok := value.(map[credit.GrantID]float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantBalances(v)
+		return nil
+	case balancesnapshot.FieldUncoveredUsage:
+		v, ok := value.(map[credit.FeatureID]float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUncoveredUsage(v)
+		return nil
+	case balancesnapshot.FieldFeatures:
+		v, ok := value.([]credit.FeatureID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatures(v)
+		return nil
+	case balancesnapshot.FieldOverageFeatures:
+		v, ok := value.([]credit.FeatureID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOverageFeatures(v)
+		return nil
+	case balancesnapshot.FieldMonetaryUsage:
+		v, ok := value.(map[credit.FeatureID]credit.MonetaryUsage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonetaryUsage(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceSnapshot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BalanceSnapshotMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BalanceSnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BalanceSnapshotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BalanceSnapshot numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BalanceSnapshotMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(balancesnapshot.FieldFeatures) {
+		fields = append(fields, balancesnapshot.FieldFeatures)
+	}
+	if m.FieldCleared(balancesnapshot.FieldOverageFeatures) {
+		fields = append(fields, balancesnapshot.FieldOverageFeatures)
+	}
+	if m.FieldCleared(balancesnapshot.FieldMonetaryUsage) {
+		fields = append(fields, balancesnapshot.FieldMonetaryUsage)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BalanceSnapshotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BalanceSnapshotMutation) ClearField(name string) error {
+	switch name {
+	case balancesnapshot.FieldFeatures:
+		m.ClearFeatures()
+		return nil
+	case balancesnapshot.FieldOverageFeatures:
+		m.ClearOverageFeatures()
+		return nil
+	case balancesnapshot.FieldMonetaryUsage:
+		m.ClearMonetaryUsage()
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceSnapshot nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BalanceSnapshotMutation) ResetField(name string) error {
+	switch name {
+	case balancesnapshot.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case balancesnapshot.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case balancesnapshot.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case balancesnapshot.FieldLedgerID:
+		m.ResetLedgerID()
+		return nil
+	case balancesnapshot.FieldAt:
+		m.ResetAt()
+		return nil
+	case balancesnapshot.FieldGrantBalances:
+		m.ResetGrantBalances()
+		return nil
+	case balancesnapshot.FieldUncoveredUsage:
+		m.ResetUncoveredUsage()
+		return nil
+	case balancesnapshot.FieldFeatures:
+		m.ResetFeatures()
+		return nil
+	case balancesnapshot.FieldOverageFeatures:
+		m.ResetOverageFeatures()
+		return nil
+	case balancesnapshot.FieldMonetaryUsage:
+		m.ResetMonetaryUsage()
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceSnapshot field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BalanceSnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BalanceSnapshotMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BalanceSnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BalanceSnapshotMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BalanceSnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BalanceSnapshotMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BalanceSnapshotMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BalanceSnapshot unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BalanceSnapshotMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BalanceSnapshot edge %s", name)
+}
+
+// CreditEntryMutation represents an operation that mutates the CreditEntry nodes in the graph.
+type CreditEntryMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *string
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	namespace                  *string
+	ledger_id                  *string
+	entry_type                 *credit.EntryType
+	_type                      *credit.GrantType
+	amount                     *float64
+	addamount                  *float64
+	currency                   *string
+	priority                   *uint8
+	addpriority                *int8
+	effective_at               *time.Time
+	expiration_period_duration *credit.ExpirationPeriodDuration
+	expiration_period_count    *uint8
+	addexpiration_period_count *int8
+	expiration_at              *time.Time
+	rollover_type              *credit.GrantRolloverType
+	rollover_max_amount        *float64
+	addrollover_max_amount     *float64
+	metadata                   *map[string]string
+	grant_schedule_id          *string
+	clearedFields              map[string]struct{}
+	parent                     *string
+	clearedparent              bool
+	children                   map[string]struct{}
+	removedchildren            map[string]struct{}
+	clearedchildren            bool
+	feature                    *string
+	clearedfeature             bool
+	done                       bool
+	oldValue                   func(context.Context) (*CreditEntry, error)
+	predicates                 []predicate.CreditEntry
+}
+
+var _ ent.Mutation = (*CreditEntryMutation)(nil)
+
+// creditentryOption allows management of the mutation configuration using functional options.
+type creditentryOption func(*CreditEntryMutation)
+
+// newCreditEntryMutation creates new mutation for the CreditEntry entity.
+func newCreditEntryMutation(c config, op Op, opts ...creditentryOption) *CreditEntryMutation {
+	m := &CreditEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCreditEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCreditEntryID sets the ID field of the mutation.
+func withCreditEntryID(id string) creditentryOption {
+	return func(m *CreditEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CreditEntry
+		)
+		m.oldValue = func(ctx context.Context) (*CreditEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CreditEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCreditEntry sets the old CreditEntry of the mutation.
+func withCreditEntry(node *CreditEntry) creditentryOption {
+	return func(m *CreditEntryMutation) {
+		m.oldValue = func(context.Context) (*CreditEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CreditEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CreditEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CreditEntry entities.
+func (m *CreditEntryMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CreditEntryMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CreditEntryMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CreditEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CreditEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CreditEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CreditEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CreditEntryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CreditEntryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CreditEntryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *CreditEntryMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *CreditEntryMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *CreditEntryMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (m *CreditEntryMutation) SetLedgerID(s string) {
+	m.ledger_id = &s
+}
+
+// LedgerID returns the value of the "ledger_id" field in the mutation.
+func (m *CreditEntryMutation) LedgerID() (r string, exists bool) {
+	v := m.ledger_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLedgerID returns the old "ledger_id" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldLedgerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLedgerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLedgerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLedgerID: %w", err)
+	}
+	return oldValue.LedgerID, nil
+}
+
+// ResetLedgerID resets all changes to the "ledger_id" field.
+func (m *CreditEntryMutation) ResetLedgerID() {
+	m.ledger_id = nil
+}
+
+// SetEntryType sets the "entry_type" field.
+func (m *CreditEntryMutation) SetEntryType(ct credit.EntryType) {
+	m.entry_type = &ct
+}
+
+// EntryType returns the value of the "entry_type" field in the mutation.
+func (m *CreditEntryMutation) EntryType() (r credit.EntryType, exists bool) {
+	v := m.entry_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntryType returns the old "entry_type" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldEntryType(ctx context.Context) (v credit.EntryType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntryType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntryType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntryType: %w", err)
+	}
+	return oldValue.EntryType, nil
+}
+
+// ResetEntryType resets all changes to the "entry_type" field.
+func (m *CreditEntryMutation) ResetEntryType() {
+	m.entry_type = nil
+}
+
+// SetType sets the "type" field.
+func (m *CreditEntryMutation) SetType(ct credit.GrantType) {
+	m._type = &ct
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *CreditEntryMutation) GetType() (r credit.GrantType, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldType(ctx context.Context) (v *credit.GrantType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ClearType clears the value of the "type" field.
+func (m *CreditEntryMutation) ClearType() {
+	m._type = nil
+	m.clearedFields[creditentry.FieldType] = struct{}{}
+}
+
+// TypeCleared returns if the "type" field was cleared in this mutation.
+func (m *CreditEntryMutation) TypeCleared() bool {
+	_, ok := m.clearedFields[creditentry.FieldType]
+	return ok
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *CreditEntryMutation) ResetType() {
+	m._type = nil
+	delete(m.clearedFields, creditentry.FieldType)
+}
+
+// SetFeatureID sets the "feature_id" field.
+func (m *CreditEntryMutation) SetFeatureID(s string) {
+	m.feature = &s
+}
+
+// FeatureID returns the value of the "feature_id" field in the mutation.
+func (m *CreditEntryMutation) FeatureID() (r string, exists bool) {
+	v := m.feature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatureID returns the old "feature_id" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldFeatureID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatureID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatureID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatureID: %w", err)
+	}
+	return oldValue.FeatureID, nil
+}
+
+// ClearFeatureID clears the value of the "feature_id" field.
+func (m *CreditEntryMutation) ClearFeatureID() {
+	m.feature = nil
+	m.clearedFields[creditentry.FieldFeatureID] = struct{}{}
+}
+
+// FeatureIDCleared returns if the "feature_id" field was cleared in this mutation.
+func (m *CreditEntryMutation) FeatureIDCleared() bool {
+	_, ok := m.clearedFields[creditentry.FieldFeatureID]
+	return ok
+}
+
+// ResetFeatureID resets all changes to the "feature_id" field.
+func (m *CreditEntryMutation) ResetFeatureID() {
+	m.feature = nil
+	delete(m.clearedFields, creditentry.FieldFeatureID)
+}
+
+// SetAmount sets the "amount" field.
+func (m *CreditEntryMutation) SetAmount(f float64) {
+	m.amount = &f
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *CreditEntryMutation) Amount() (r float64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldAmount(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds f to the "amount" field.
+func (m *CreditEntryMutation) AddAmount(f float64) {
+	if m.addamount != nil {
+		*m.addamount += f
+	} else {
+		m.addamount = &f
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *CreditEntryMutation) AddedAmount() (r float64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (m *CreditEntryMutation) ClearAmount() {
+	m.amount = nil
+	m.addamount = nil
+	m.clearedFields[creditentry.FieldAmount] = struct{}{}
+}
+
+// AmountCleared returns if the "amount" field was cleared in this mutation.
+func (m *CreditEntryMutation) AmountCleared() bool {
+	_, ok := m.clearedFields[creditentry.FieldAmount]
+	return ok
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *CreditEntryMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+	delete(m.clearedFields, creditentry.FieldAmount)
+}
+
+// SetCurrency sets the "currency" field.
+func (m *CreditEntryMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *CreditEntryMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldCurrency(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (m *CreditEntryMutation) ClearCurrency() {
+	m.currency = nil
+	m.clearedFields[creditentry.FieldCurrency] = struct{}{}
+}
+
+// CurrencyCleared returns if the "currency" field was cleared in this mutation.
+func (m *CreditEntryMutation) CurrencyCleared() bool {
+	_, ok := m.clearedFields[creditentry.FieldCurrency]
+	return ok
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *CreditEntryMutation) ResetCurrency() {
+	m.currency = nil
+	delete(m.clearedFields, creditentry.FieldCurrency)
+}
+
+// SetPriority sets the "priority" field.
+func (m *CreditEntryMutation) SetPriority(u uint8) {
+	m.priority = &u
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *CreditEntryMutation) Priority() (r uint8, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldPriority(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds u to the "priority" field.
+func (m *CreditEntryMutation) AddPriority(u int8) {
+	if m.addpriority != nil {
+		*m.addpriority += u
+	} else {
+		m.addpriority = &u
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *CreditEntryMutation) AddedPriority() (r int8, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *CreditEntryMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetEffectiveAt sets the "effective_at" field.
+func (m *CreditEntryMutation) SetEffectiveAt(t time.Time) {
+	m.effective_at = &t
+}
+
+// EffectiveAt returns the value of the "effective_at" field in the mutation.
+func (m *CreditEntryMutation) EffectiveAt() (r time.Time, exists bool) {
+	v := m.effective_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEffectiveAt returns the old "effective_at" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldEffectiveAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEffectiveAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEffectiveAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEffectiveAt: %w", err)
+	}
+	return oldValue.EffectiveAt, nil
+}
+
+// ResetEffectiveAt resets all changes to the "effective_at" field.
+func (m *CreditEntryMutation) ResetEffectiveAt() {
+	m.effective_at = nil
+}
+
+// SetExpirationPeriodDuration sets the "expiration_period_duration" field.
 func (m *CreditEntryMutation) SetExpirationPeriodDuration(cpd credit.ExpirationPeriodDuration) {
 	m.expiration_period_duration = &cpd
 }
 
-// ExpirationPeriodDuration returns the value of the "expiration_period_duration" field in the mutation.
-func (m *CreditEntryMutation) ExpirationPeriodDuration() (r credit.ExpirationPeriodDuration, exists bool) {
-	v := m.expiration_period_duration
+// ExpirationPeriodDuration returns the value of the "expiration_period_duration" field in the mutation.
+func (m *CreditEntryMutation) ExpirationPeriodDuration() (r credit.ExpirationPeriodDuration, exists bool) {
+	v := m.expiration_period_duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpirationPeriodDuration returns the old "expiration_period_duration" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldExpirationPeriodDuration(ctx context.Context) (v *credit.ExpirationPeriodDuration, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpirationPeriodDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpirationPeriodDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpirationPeriodDuration: %w", err)
+	}
+	return oldValue.ExpirationPeriodDuration, nil
+}
+
+// ClearExpirationPeriodDuration clears the value of the "expiration_period_duration" field.
+func (m *CreditEntryMutation) ClearExpirationPeriodDuration() {
+	m.expiration_period_duration = nil
+	m.clearedFields[creditentry.FieldExpirationPeriodDuration] = struct{}{}
+}
+
+// ExpirationPeriodDurationCleared returns if the "expiration_period_duration" field was cleared in this mutation.
+func (m *CreditEntryMutation) ExpirationPeriodDurationCleared() bool {
+	_, ok := m.clearedFields[creditentry.FieldExpirationPeriodDuration]
+	return ok
+}
+
+// ResetExpirationPeriodDuration resets all changes to the "expiration_period_duration" field.
+func (m *CreditEntryMutation) ResetExpirationPeriodDuration() {
+	m.expiration_period_duration = nil
+	delete(m.clearedFields, creditentry.FieldExpirationPeriodDuration)
+}
+
+// SetExpirationPeriodCount sets the "expiration_period_count" field.
+func (m *CreditEntryMutation) SetExpirationPeriodCount(u uint8) {
+	m.expiration_period_count = &u
+	m.addexpiration_period_count = nil
+}
+
+// ExpirationPeriodCount returns the value of the "expiration_period_count" field in the mutation.
+func (m *CreditEntryMutation) ExpirationPeriodCount() (r uint8, exists bool) {
+	v := m.expiration_period_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpirationPeriodCount returns the old "expiration_period_count" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldExpirationPeriodCount(ctx context.Context) (v *uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpirationPeriodCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpirationPeriodCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpirationPeriodCount: %w", err)
+	}
+	return oldValue.ExpirationPeriodCount, nil
+}
+
+// AddExpirationPeriodCount adds u to the "expiration_period_count" field.
+func (m *CreditEntryMutation) AddExpirationPeriodCount(u int8) {
+	if m.addexpiration_period_count != nil {
+		*m.addexpiration_period_count += u
+	} else {
+		m.addexpiration_period_count = &u
+	}
+}
+
+// AddedExpirationPeriodCount returns the value that was added to the "expiration_period_count" field in this mutation.
+func (m *CreditEntryMutation) AddedExpirationPeriodCount() (r int8, exists bool) {
+	v := m.addexpiration_period_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearExpirationPeriodCount clears the value of the "expiration_period_count" field.
+func (m *CreditEntryMutation) ClearExpirationPeriodCount() {
+	m.expiration_period_count = nil
+	m.addexpiration_period_count = nil
+	m.clearedFields[creditentry.FieldExpirationPeriodCount] = struct{}{}
+}
+
+// ExpirationPeriodCountCleared returns if the "expiration_period_count" field was cleared in this mutation.
+func (m *CreditEntryMutation) ExpirationPeriodCountCleared() bool {
+	_, ok := m.clearedFields[creditentry.FieldExpirationPeriodCount]
+	return ok
+}
+
+// ResetExpirationPeriodCount resets all changes to the "expiration_period_count" field.
+func (m *CreditEntryMutation) ResetExpirationPeriodCount() {
+	m.expiration_period_count = nil
+	m.addexpiration_period_count = nil
+	delete(m.clearedFields, creditentry.FieldExpirationPeriodCount)
+}
+
+// SetExpirationAt sets the "expiration_at" field.
+func (m *CreditEntryMutation) SetExpirationAt(t time.Time) {
+	m.expiration_at = &t
+}
+
+// ExpirationAt returns the value of the "expiration_at" field in the mutation.
+func (m *CreditEntryMutation) ExpirationAt() (r time.Time, exists bool) {
+	v := m.expiration_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpirationAt returns the old "expiration_at" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldExpirationAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpirationAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpirationAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpirationAt: %w", err)
+	}
+	return oldValue.ExpirationAt, nil
+}
+
+// ClearExpirationAt clears the value of the "expiration_at" field.
+func (m *CreditEntryMutation) ClearExpirationAt() {
+	m.expiration_at = nil
+	m.clearedFields[creditentry.FieldExpirationAt] = struct{}{}
+}
+
+// ExpirationAtCleared returns if the "expiration_at" field was cleared in this mutation.
+func (m *CreditEntryMutation) ExpirationAtCleared() bool {
+	_, ok := m.clearedFields[creditentry.FieldExpirationAt]
+	return ok
+}
+
+// ResetExpirationAt resets all changes to the "expiration_at" field.
+func (m *CreditEntryMutation) ResetExpirationAt() {
+	m.expiration_at = nil
+	delete(m.clearedFields, creditentry.FieldExpirationAt)
+}
+
+// SetRolloverType sets the "rollover_type" field.
+func (m *CreditEntryMutation) SetRolloverType(crt credit.GrantRolloverType) {
+	m.rollover_type = &crt
+}
+
+// RolloverType returns the value of the "rollover_type" field in the mutation.
+func (m *CreditEntryMutation) RolloverType() (r credit.GrantRolloverType, exists bool) {
+	v := m.rollover_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRolloverType returns the old "rollover_type" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldRolloverType(ctx context.Context) (v *credit.GrantRolloverType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRolloverType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRolloverType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRolloverType: %w", err)
+	}
+	return oldValue.RolloverType, nil
+}
+
+// ClearRolloverType clears the value of the "rollover_type" field.
+func (m *CreditEntryMutation) ClearRolloverType() {
+	m.rollover_type = nil
+	m.clearedFields[creditentry.FieldRolloverType] = struct{}{}
+}
+
+// RolloverTypeCleared returns if the "rollover_type" field was cleared in this mutation.
+func (m *CreditEntryMutation) RolloverTypeCleared() bool {
+	_, ok := m.clearedFields[creditentry.FieldRolloverType]
+	return ok
+}
+
+// ResetRolloverType resets all changes to the "rollover_type" field.
+func (m *CreditEntryMutation) ResetRolloverType() {
+	m.rollover_type = nil
+	delete(m.clearedFields, creditentry.FieldRolloverType)
+}
+
+// SetRolloverMaxAmount sets the "rollover_max_amount" field.
+func (m *CreditEntryMutation) SetRolloverMaxAmount(f float64) {
+	m.rollover_max_amount = &f
+	m.addrollover_max_amount = nil
+}
+
+// RolloverMaxAmount returns the value of the "rollover_max_amount" field in the mutation.
+func (m *CreditEntryMutation) RolloverMaxAmount() (r float64, exists bool) {
+	v := m.rollover_max_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRolloverMaxAmount returns the old "rollover_max_amount" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldRolloverMaxAmount(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRolloverMaxAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRolloverMaxAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRolloverMaxAmount: %w", err)
+	}
+	return oldValue.RolloverMaxAmount, nil
+}
+
+// AddRolloverMaxAmount adds f to the "rollover_max_amount" field.
+func (m *CreditEntryMutation) AddRolloverMaxAmount(f float64) {
+	if m.addrollover_max_amount != nil {
+		*m.addrollover_max_amount += f
+	} else {
+		m.addrollover_max_amount = &f
+	}
+}
+
+// AddedRolloverMaxAmount returns the value that was added to the "rollover_max_amount" field in this mutation.
+func (m *CreditEntryMutation) AddedRolloverMaxAmount() (r float64, exists bool) {
+	v := m.addrollover_max_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRolloverMaxAmount clears the value of the "rollover_max_amount" field.
+func (m *CreditEntryMutation) ClearRolloverMaxAmount() {
+	m.rollover_max_amount = nil
+	m.addrollover_max_amount = nil
+	m.clearedFields[creditentry.FieldRolloverMaxAmount] = struct{}{}
+}
+
+// RolloverMaxAmountCleared returns if the "rollover_max_amount" field was cleared in this mutation.
+func (m *CreditEntryMutation) RolloverMaxAmountCleared() bool {
+	_, ok := m.clearedFields[creditentry.FieldRolloverMaxAmount]
+	return ok
+}
+
+// ResetRolloverMaxAmount resets all changes to the "rollover_max_amount" field.
+func (m *CreditEntryMutation) ResetRolloverMaxAmount() {
+	m.rollover_max_amount = nil
+	m.addrollover_max_amount = nil
+	delete(m.clearedFields, creditentry.FieldRolloverMaxAmount)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *CreditEntryMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *CreditEntryMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *CreditEntryMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[creditentry.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *CreditEntryMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[creditentry.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *CreditEntryMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, creditentry.FieldMetadata)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *CreditEntryMutation) SetParentID(s string) {
+	m.parent = &s
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *CreditEntryMutation) ParentID() (r string, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldParentID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *CreditEntryMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[creditentry.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *CreditEntryMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[creditentry.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *CreditEntryMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, creditentry.FieldParentID)
+}
+
+// SetGrantScheduleID sets the "grant_schedule_id" field.
+func (m *CreditEntryMutation) SetGrantScheduleID(s string) {
+	m.grant_schedule_id = &s
+}
+
+// GrantScheduleID returns the value of the "grant_schedule_id" field in the mutation.
+func (m *CreditEntryMutation) GrantScheduleID() (r string, exists bool) {
+	v := m.grant_schedule_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrantScheduleID returns the old "grant_schedule_id" field's value of the CreditEntry entity.
+// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CreditEntryMutation) OldGrantScheduleID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantScheduleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantScheduleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantScheduleID: %w", err)
+	}
+	return oldValue.GrantScheduleID, nil
+}
+
+// ClearGrantScheduleID clears the value of the "grant_schedule_id" field.
+func (m *CreditEntryMutation) ClearGrantScheduleID() {
+	m.grant_schedule_id = nil
+	m.clearedFields[creditentry.FieldGrantScheduleID] = struct{}{}
+}
+
+// GrantScheduleIDCleared returns if the "grant_schedule_id" field was cleared in this mutation.
+func (m *CreditEntryMutation) GrantScheduleIDCleared() bool {
+	_, ok := m.clearedFields[creditentry.FieldGrantScheduleID]
+	return ok
+}
+
+// ResetGrantScheduleID resets all changes to the "grant_schedule_id" field.
+func (m *CreditEntryMutation) ResetGrantScheduleID() {
+	m.grant_schedule_id = nil
+	delete(m.clearedFields, creditentry.FieldGrantScheduleID)
+}
+
+// ClearParent clears the "parent" edge to the CreditEntry entity.
+func (m *CreditEntryMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[creditentry.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the CreditEntry entity was cleared.
+func (m *CreditEntryMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *CreditEntryMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *CreditEntryMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the CreditEntry entity by ids.
+func (m *CreditEntryMutation) AddChildIDs(ids ...string) {
+	if m.children == nil {
+		m.children = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the CreditEntry entity.
+func (m *CreditEntryMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the CreditEntry entity was cleared.
+func (m *CreditEntryMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the CreditEntry entity by IDs.
+func (m *CreditEntryMutation) RemoveChildIDs(ids ...string) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the CreditEntry entity.
+func (m *CreditEntryMutation) RemovedChildrenIDs() (ids []string) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *CreditEntryMutation) ChildrenIDs() (ids []string) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *CreditEntryMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// ClearFeature clears the "feature" edge to the Feature entity.
+func (m *CreditEntryMutation) ClearFeature() {
+	m.clearedfeature = true
+	m.clearedFields[creditentry.FieldFeatureID] = struct{}{}
+}
+
+// FeatureCleared reports if the "feature" edge to the Feature entity was cleared.
+func (m *CreditEntryMutation) FeatureCleared() bool {
+	return m.FeatureIDCleared() || m.clearedfeature
+}
+
+// FeatureIDs returns the "feature" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FeatureID instead. It exists only for internal usage by the builders.
+func (m *CreditEntryMutation) FeatureIDs() (ids []string) {
+	if id := m.feature; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFeature resets all changes to the "feature" edge.
+func (m *CreditEntryMutation) ResetFeature() {
+	m.feature = nil
+	m.clearedfeature = false
+}
+
+// Where appends a list predicates to the CreditEntryMutation builder.
+func (m *CreditEntryMutation) Where(ps ...predicate.CreditEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CreditEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CreditEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CreditEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CreditEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CreditEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CreditEntry).
+func (m *CreditEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CreditEntryMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.created_at != nil {
+		fields = append(fields, creditentry.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, creditentry.FieldUpdatedAt)
+	}
+	if m.namespace != nil {
+		fields = append(fields, creditentry.FieldNamespace)
+	}
+	if m.ledger_id != nil {
+		fields = append(fields, creditentry.FieldLedgerID)
+	}
+	if m.entry_type != nil {
+		fields = append(fields, creditentry.FieldEntryType)
+	}
+	if m._type != nil {
+		fields = append(fields, creditentry.FieldType)
+	}
+	if m.feature != nil {
+		fields = append(fields, creditentry.FieldFeatureID)
+	}
+	if m.amount != nil {
+		fields = append(fields, creditentry.FieldAmount)
+	}
+	if m.currency != nil {
+		fields = append(fields, creditentry.FieldCurrency)
+	}
+	if m.priority != nil {
+		fields = append(fields, creditentry.FieldPriority)
+	}
+	if m.effective_at != nil {
+		fields = append(fields, creditentry.FieldEffectiveAt)
+	}
+	if m.expiration_period_duration != nil {
+		fields = append(fields, creditentry.FieldExpirationPeriodDuration)
+	}
+	if m.expiration_period_count != nil {
+		fields = append(fields, creditentry.FieldExpirationPeriodCount)
+	}
+	if m.expiration_at != nil {
+		fields = append(fields, creditentry.FieldExpirationAt)
+	}
+	if m.rollover_type != nil {
+		fields = append(fields, creditentry.FieldRolloverType)
+	}
+	if m.rollover_max_amount != nil {
+		fields = append(fields, creditentry.FieldRolloverMaxAmount)
+	}
+	if m.metadata != nil {
+		fields = append(fields, creditentry.FieldMetadata)
+	}
+	if m.parent != nil {
+		fields = append(fields, creditentry.FieldParentID)
+	}
+	if m.grant_schedule_id != nil {
+		fields = append(fields, creditentry.FieldGrantScheduleID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CreditEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case creditentry.FieldCreatedAt:
+		return m.CreatedAt()
+	case creditentry.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case creditentry.FieldNamespace:
+		return m.Namespace()
+	case creditentry.FieldLedgerID:
+		return m.LedgerID()
+	case creditentry.FieldEntryType:
+		return m.EntryType()
+	case creditentry.FieldType:
+		return m.GetType()
+	case creditentry.FieldFeatureID:
+		return m.FeatureID()
+	case creditentry.FieldAmount:
+		return m.Amount()
+	case creditentry.FieldCurrency:
+		return m.Currency()
+	case creditentry.FieldPriority:
+		return m.Priority()
+	case creditentry.FieldEffectiveAt:
+		return m.EffectiveAt()
+	case creditentry.FieldExpirationPeriodDuration:
+		return m.ExpirationPeriodDuration()
+	case creditentry.FieldExpirationPeriodCount:
+		return m.ExpirationPeriodCount()
+	case creditentry.FieldExpirationAt:
+		return m.ExpirationAt()
+	case creditentry.FieldRolloverType:
+		return m.RolloverType()
+	case creditentry.FieldRolloverMaxAmount:
+		return m.RolloverMaxAmount()
+	case creditentry.FieldMetadata:
+		return m.Metadata()
+	case creditentry.FieldParentID:
+		return m.ParentID()
+	case creditentry.FieldGrantScheduleID:
+		return m.GrantScheduleID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CreditEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case creditentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case creditentry.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case creditentry.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case creditentry.FieldLedgerID:
+		return m.OldLedgerID(ctx)
+	case creditentry.FieldEntryType:
+		return m.OldEntryType(ctx)
+	case creditentry.FieldType:
+		return m.OldType(ctx)
+	case creditentry.FieldFeatureID:
+		return m.OldFeatureID(ctx)
+	case creditentry.FieldAmount:
+		return m.OldAmount(ctx)
+	case creditentry.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case creditentry.FieldPriority:
+		return m.OldPriority(ctx)
+	case creditentry.FieldEffectiveAt:
+		return m.OldEffectiveAt(ctx)
+	case creditentry.FieldExpirationPeriodDuration:
+		return m.OldExpirationPeriodDuration(ctx)
+	case creditentry.FieldExpirationPeriodCount:
+		return m.OldExpirationPeriodCount(ctx)
+	case creditentry.FieldExpirationAt:
+		return m.OldExpirationAt(ctx)
+	case creditentry.FieldRolloverType:
+		return m.OldRolloverType(ctx)
+	case creditentry.FieldRolloverMaxAmount:
+		return m.OldRolloverMaxAmount(ctx)
+	case creditentry.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case creditentry.FieldParentID:
+		return m.OldParentID(ctx)
+	case creditentry.FieldGrantScheduleID:
+		return m.OldGrantScheduleID(ctx)
+	}
+	return nil, fmt.Errorf("unknown CreditEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CreditEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case creditentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case creditentry.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case creditentry.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case creditentry.FieldLedgerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLedgerID(v)
+		return nil
+	case creditentry.FieldEntryType:
+		v, ok := value.(credit.EntryType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntryType(v)
+		return nil
+	case creditentry.FieldType:
+		v, ok := value.(credit.GrantType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case creditentry.FieldFeatureID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatureID(v)
+		return nil
+	case creditentry.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case creditentry.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case creditentry.FieldPriority:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case creditentry.FieldEffectiveAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEffectiveAt(v)
+		return nil
+	case creditentry.FieldExpirationPeriodDuration:
+		v, ok := value.(credit.ExpirationPeriodDuration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpirationPeriodDuration(v)
+		return nil
+	case creditentry.FieldExpirationPeriodCount:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpirationPeriodCount(v)
+		return nil
+	case creditentry.FieldExpirationAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpirationAt(v)
+		return nil
+	case creditentry.FieldRolloverType:
+		v, ok := value.(credit.GrantRolloverType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRolloverType(v)
+		return nil
+	case creditentry.FieldRolloverMaxAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRolloverMaxAmount(v)
+		return nil
+	case creditentry.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case creditentry.FieldParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case creditentry.FieldGrantScheduleID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantScheduleID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CreditEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CreditEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, creditentry.FieldAmount)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, creditentry.FieldPriority)
+	}
+	if m.addexpiration_period_count != nil {
+		fields = append(fields, creditentry.FieldExpirationPeriodCount)
+	}
+	if m.addrollover_max_amount != nil {
+		fields = append(fields, creditentry.FieldRolloverMaxAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CreditEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case creditentry.FieldAmount:
+		return m.AddedAmount()
+	case creditentry.FieldPriority:
+		return m.AddedPriority()
+	case creditentry.FieldExpirationPeriodCount:
+		return m.AddedExpirationPeriodCount()
+	case creditentry.FieldRolloverMaxAmount:
+		return m.AddedRolloverMaxAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CreditEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case creditentry.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case creditentry.FieldPriority:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case creditentry.FieldExpirationPeriodCount:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpirationPeriodCount(v)
+		return nil
+	case creditentry.FieldRolloverMaxAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRolloverMaxAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CreditEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CreditEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(creditentry.FieldType) {
+		fields = append(fields, creditentry.FieldType)
+	}
+	if m.FieldCleared(creditentry.FieldFeatureID) {
+		fields = append(fields, creditentry.FieldFeatureID)
+	}
+	if m.FieldCleared(creditentry.FieldAmount) {
+		fields = append(fields, creditentry.FieldAmount)
+	}
+	if m.FieldCleared(creditentry.FieldCurrency) {
+		fields = append(fields, creditentry.FieldCurrency)
+	}
+	if m.FieldCleared(creditentry.FieldExpirationPeriodDuration) {
+		fields = append(fields, creditentry.FieldExpirationPeriodDuration)
+	}
+	if m.FieldCleared(creditentry.FieldExpirationPeriodCount) {
+		fields = append(fields, creditentry.FieldExpirationPeriodCount)
+	}
+	if m.FieldCleared(creditentry.FieldExpirationAt) {
+		fields = append(fields, creditentry.FieldExpirationAt)
+	}
+	if m.FieldCleared(creditentry.FieldRolloverType) {
+		fields = append(fields, creditentry.FieldRolloverType)
+	}
+	if m.FieldCleared(creditentry.FieldRolloverMaxAmount) {
+		fields = append(fields, creditentry.FieldRolloverMaxAmount)
+	}
+	if m.FieldCleared(creditentry.FieldMetadata) {
+		fields = append(fields, creditentry.FieldMetadata)
+	}
+	if m.FieldCleared(creditentry.FieldParentID) {
+		fields = append(fields, creditentry.FieldParentID)
+	}
+	if m.FieldCleared(creditentry.FieldGrantScheduleID) {
+		fields = append(fields, creditentry.FieldGrantScheduleID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CreditEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CreditEntryMutation) ClearField(name string) error {
+	switch name {
+	case creditentry.FieldType:
+		m.ClearType()
+		return nil
+	case creditentry.FieldFeatureID:
+		m.ClearFeatureID()
+		return nil
+	case creditentry.FieldAmount:
+		m.ClearAmount()
+		return nil
+	case creditentry.FieldCurrency:
+		m.ClearCurrency()
+		return nil
+	case creditentry.FieldExpirationPeriodDuration:
+		m.ClearExpirationPeriodDuration()
+		return nil
+	case creditentry.FieldExpirationPeriodCount:
+		m.ClearExpirationPeriodCount()
+		return nil
+	case creditentry.FieldExpirationAt:
+		m.ClearExpirationAt()
+		return nil
+	case creditentry.FieldRolloverType:
+		m.ClearRolloverType()
+		return nil
+	case creditentry.FieldRolloverMaxAmount:
+		m.ClearRolloverMaxAmount()
+		return nil
+	case creditentry.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case creditentry.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case creditentry.FieldGrantScheduleID:
+		m.ClearGrantScheduleID()
+		return nil
+	}
+	return fmt.Errorf("unknown CreditEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CreditEntryMutation) ResetField(name string) error {
+	switch name {
+	case creditentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case creditentry.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case creditentry.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case creditentry.FieldLedgerID:
+		m.ResetLedgerID()
+		return nil
+	case creditentry.FieldEntryType:
+		m.ResetEntryType()
+		return nil
+	case creditentry.FieldType:
+		m.ResetType()
+		return nil
+	case creditentry.FieldFeatureID:
+		m.ResetFeatureID()
+		return nil
+	case creditentry.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case creditentry.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case creditentry.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case creditentry.FieldEffectiveAt:
+		m.ResetEffectiveAt()
+		return nil
+	case creditentry.FieldExpirationPeriodDuration:
+		m.ResetExpirationPeriodDuration()
+		return nil
+	case creditentry.FieldExpirationPeriodCount:
+		m.ResetExpirationPeriodCount()
+		return nil
+	case creditentry.FieldExpirationAt:
+		m.ResetExpirationAt()
+		return nil
+	case creditentry.FieldRolloverType:
+		m.ResetRolloverType()
+		return nil
+	case creditentry.FieldRolloverMaxAmount:
+		m.ResetRolloverMaxAmount()
+		return nil
+	case creditentry.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case creditentry.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case creditentry.FieldGrantScheduleID:
+		m.ResetGrantScheduleID()
+		return nil
+	}
+	return fmt.Errorf("unknown CreditEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CreditEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.parent != nil {
+		edges = append(edges, creditentry.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, creditentry.EdgeChildren)
+	}
+	if m.feature != nil {
+		edges = append(edges, creditentry.EdgeFeature)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CreditEntryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case creditentry.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case creditentry.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case creditentry.EdgeFeature:
+		if id := m.feature; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CreditEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedchildren != nil {
+		edges = append(edges, creditentry.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CreditEntryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case creditentry.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CreditEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedparent {
+		edges = append(edges, creditentry.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, creditentry.EdgeChildren)
+	}
+	if m.clearedfeature {
+		edges = append(edges, creditentry.EdgeFeature)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CreditEntryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case creditentry.EdgeParent:
+		return m.clearedparent
+	case creditentry.EdgeChildren:
+		return m.clearedchildren
+	case creditentry.EdgeFeature:
+		return m.clearedfeature
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CreditEntryMutation) ClearEdge(name string) error {
+	switch name {
+	case creditentry.EdgeParent:
+		m.ClearParent()
+		return nil
+	case creditentry.EdgeFeature:
+		m.ClearFeature()
+		return nil
+	}
+	return fmt.Errorf("unknown CreditEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CreditEntryMutation) ResetEdge(name string) error {
+	switch name {
+	case creditentry.EdgeParent:
+		m.ResetParent()
+		return nil
+	case creditentry.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case creditentry.EdgeFeature:
+		m.ResetFeature()
+		return nil
+	}
+	return fmt.Errorf("unknown CreditEntry edge %s", name)
+}
+
+// EventTypeMutation represents an operation that mutates the EventType nodes in the graph.
+type EventTypeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	namespace     *string
+	_type         *string
+	description   *string
+	schema        *map[string]interface{}
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EventType, error)
+	predicates    []predicate.EventType
+}
+
+var _ ent.Mutation = (*EventTypeMutation)(nil)
+
+// eventtypeOption allows management of the mutation configuration using functional options.
+type eventtypeOption func(*EventTypeMutation)
+
+// newEventTypeMutation creates new mutation for the EventType entity.
+func newEventTypeMutation(c config, op Op, opts ...eventtypeOption) *EventTypeMutation {
+	m := &EventTypeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEventType,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventTypeID sets the ID field of the mutation.
+func withEventTypeID(id string) eventtypeOption {
+	return func(m *EventTypeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EventType
+		)
+		m.oldValue = func(ctx context.Context) (*EventType, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EventType.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEventType sets the old EventType of the mutation.
+func withEventType(node *EventType) eventtypeOption {
+	return func(m *EventTypeMutation) {
+		m.oldValue = func(context.Context) (*EventType, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventTypeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventTypeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EventType entities.
+func (m *EventTypeMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventTypeMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventTypeMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EventType.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EventTypeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EventTypeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EventType entity.
+// If the EventType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventTypeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EventTypeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EventTypeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EventTypeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EventType entity.
+// If the EventType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventTypeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EventTypeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *EventTypeMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *EventTypeMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the EventType entity.
+// If the EventType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventTypeMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *EventTypeMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetType sets the "type" field.
+func (m *EventTypeMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *EventTypeMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the EventType entity.
+// If the EventType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventTypeMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *EventTypeMutation) ResetType() {
+	m._type = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *EventTypeMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *EventTypeMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the EventType entity.
+// If the EventType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventTypeMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *EventTypeMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[eventtype.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *EventTypeMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[eventtype.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *EventTypeMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, eventtype.FieldDescription)
+}
+
+// SetSchema sets the "schema" field.
+func (m *EventTypeMutation) SetSchema(value map[string]interface{}) {
+	m.schema = &value
+}
+
+// Schema returns the value of the "schema" field in the mutation.
+func (m *EventTypeMutation) Schema() (r map[string]interface{}, exists bool) {
+	v := m.schema
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchema returns the old "schema" field's value of the EventType entity.
+// If the EventType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventTypeMutation) OldSchema(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchema is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchema requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchema: %w", err)
+	}
+	return oldValue.Schema, nil
+}
+
+// ResetSchema resets all changes to the "schema" field.
+func (m *EventTypeMutation) ResetSchema() {
+	m.schema = nil
+}
+
+// Where appends a list predicates to the EventTypeMutation builder.
+func (m *EventTypeMutation) Where(ps ...predicate.EventType) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventTypeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventTypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EventType, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventTypeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventTypeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EventType).
+func (m *EventTypeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventTypeMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, eventtype.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, eventtype.FieldUpdatedAt)
+	}
+	if m.namespace != nil {
+		fields = append(fields, eventtype.FieldNamespace)
+	}
+	if m._type != nil {
+		fields = append(fields, eventtype.FieldType)
+	}
+	if m.description != nil {
+		fields = append(fields, eventtype.FieldDescription)
+	}
+	if m.schema != nil {
+		fields = append(fields, eventtype.FieldSchema)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventTypeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case eventtype.FieldCreatedAt:
+		return m.CreatedAt()
+	case eventtype.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case eventtype.FieldNamespace:
+		return m.Namespace()
+	case eventtype.FieldType:
+		return m.GetType()
+	case eventtype.FieldDescription:
+		return m.Description()
+	case eventtype.FieldSchema:
+		return m.Schema()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventTypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case eventtype.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case eventtype.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case eventtype.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case eventtype.FieldType:
+		return m.OldType(ctx)
+	case eventtype.FieldDescription:
+		return m.OldDescription(ctx)
+	case eventtype.FieldSchema:
+		return m.OldSchema(ctx)
+	}
+	return nil, fmt.Errorf("unknown EventType field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventTypeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case eventtype.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case eventtype.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case eventtype.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case eventtype.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case eventtype.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case eventtype.FieldSchema:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchema(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventType field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventTypeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventTypeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventTypeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EventType numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventTypeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(eventtype.FieldDescription) {
+		fields = append(fields, eventtype.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventTypeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventTypeMutation) ClearField(name string) error {
+	switch name {
+	case eventtype.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown EventType nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventTypeMutation) ResetField(name string) error {
+	switch name {
+	case eventtype.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case eventtype.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case eventtype.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case eventtype.FieldType:
+		m.ResetType()
+		return nil
+	case eventtype.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case eventtype.FieldSchema:
+		m.ResetSchema()
+		return nil
+	}
+	return fmt.Errorf("unknown EventType field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventTypeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventTypeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventTypeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventTypeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventTypeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventTypeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventTypeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EventType unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventTypeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EventType edge %s", name)
+}
+
+// FeatureMutation represents an operation that mutates the Feature nodes in the graph.
+type FeatureMutation struct {
+	config
+	op                                   Op
+	typ                                  string
+	id                                   *string
+	created_at                           *time.Time
+	updated_at                           *time.Time
+	namespace                            *string
+	name                                 *string
+	meter_slug                           *string
+	meter_group_by_filters               *map[string]string
+	meter_group_by_filter_versions       *[]credit.FeatureFilterVersion
+	appendmeter_group_by_filter_versions []credit.FeatureFilterVersion
+	unit_price                           **credit.UnitPrice
+	archived                             *bool
+	clearedFields                        map[string]struct{}
+	credit_grants                        map[string]struct{}
+	removedcredit_grants                 map[string]struct{}
+	clearedcredit_grants                 bool
+	done                                 bool
+	oldValue                             func(context.Context) (*Feature, error)
+	predicates                           []predicate.Feature
+}
+
+var _ ent.Mutation = (*FeatureMutation)(nil)
+
+// featureOption allows management of the mutation configuration using functional options.
+type featureOption func(*FeatureMutation)
+
+// newFeatureMutation creates new mutation for the Feature entity.
+func newFeatureMutation(c config, op Op, opts ...featureOption) *FeatureMutation {
+	m := &FeatureMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFeature,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFeatureID sets the ID field of the mutation.
+func withFeatureID(id string) featureOption {
+	return func(m *FeatureMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Feature
+		)
+		m.oldValue = func(ctx context.Context) (*Feature, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Feature.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFeature sets the old Feature of the mutation.
+func withFeature(node *Feature) featureOption {
+	return func(m *FeatureMutation) {
+		m.oldValue = func(context.Context) (*Feature, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FeatureMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FeatureMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Feature entities.
+func (m *FeatureMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FeatureMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FeatureMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Feature.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FeatureMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FeatureMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Feature entity.
+// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FeatureMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FeatureMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FeatureMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Feature entity.
+// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FeatureMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *FeatureMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *FeatureMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the Feature entity.
+// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *FeatureMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *FeatureMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FeatureMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Feature entity.
+// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FeatureMutation) ResetName() {
+	m.name = nil
+}
+
+// SetMeterSlug sets the "meter_slug" field.
+func (m *FeatureMutation) SetMeterSlug(s string) {
+	m.meter_slug = &s
+}
+
+// MeterSlug returns the value of the "meter_slug" field in the mutation.
+func (m *FeatureMutation) MeterSlug() (r string, exists bool) {
+	v := m.meter_slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMeterSlug returns the old "meter_slug" field's value of the Feature entity.
+// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureMutation) OldMeterSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMeterSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMeterSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMeterSlug: %w", err)
+	}
+	return oldValue.MeterSlug, nil
+}
+
+// ResetMeterSlug resets all changes to the "meter_slug" field.
+func (m *FeatureMutation) ResetMeterSlug() {
+	m.meter_slug = nil
+}
+
+// SetMeterGroupByFilters sets the "meter_group_by_filters" field.
+func (m *FeatureMutation) SetMeterGroupByFilters(value map[string]string) {
+	m.meter_group_by_filters = &value
+}
+
+// MeterGroupByFilters returns the value of the "meter_group_by_filters" field in the mutation.
+func (m *FeatureMutation) MeterGroupByFilters() (r map[string]string, exists bool) {
+	v := m.meter_group_by_filters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMeterGroupByFilters returns the old "meter_group_by_filters" field's value of the Feature entity.
+// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureMutation) OldMeterGroupByFilters(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMeterGroupByFilters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMeterGroupByFilters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMeterGroupByFilters: %w", err)
+	}
+	return oldValue.MeterGroupByFilters, nil
+}
+
+// ClearMeterGroupByFilters clears the value of the "meter_group_by_filters" field.
+func (m *FeatureMutation) ClearMeterGroupByFilters() {
+	m.meter_group_by_filters = nil
+	m.clearedFields[feature.FieldMeterGroupByFilters] = struct{}{}
+}
+
+// MeterGroupByFiltersCleared returns if the "meter_group_by_filters" field was cleared in this mutation.
+func (m *FeatureMutation) MeterGroupByFiltersCleared() bool {
+	_, ok := m.clearedFields[feature.FieldMeterGroupByFilters]
+	return ok
+}
+
+// ResetMeterGroupByFilters resets all changes to the "meter_group_by_filters" field.
+func (m *FeatureMutation) ResetMeterGroupByFilters() {
+	m.meter_group_by_filters = nil
+	delete(m.clearedFields, feature.FieldMeterGroupByFilters)
+}
+
+// SetMeterGroupByFilterVersions sets the "meter_group_by_filter_versions" field.
+func (m *FeatureMutation) SetMeterGroupByFilterVersions(cfv []credit.FeatureFilterVersion) {
+	m.meter_group_by_filter_versions = &cfv
+	m.appendmeter_group_by_filter_versions = nil
+}
+
+// MeterGroupByFilterVersions returns the value of the "meter_group_by_filter_versions" field in the mutation.
+func (m *FeatureMutation) MeterGroupByFilterVersions() (r []credit.FeatureFilterVersion, exists bool) {
+	v := m.meter_group_by_filter_versions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMeterGroupByFilterVersions returns the old "meter_group_by_filter_versions" field's value of the Feature entity.
+// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureMutation) OldMeterGroupByFilterVersions(ctx context.Context) (v []credit.FeatureFilterVersion, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMeterGroupByFilterVersions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMeterGroupByFilterVersions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMeterGroupByFilterVersions: %w", err)
+	}
+	return oldValue.MeterGroupByFilterVersions, nil
+}
+
+// AppendMeterGroupByFilterVersions adds cfv to the "meter_group_by_filter_versions" field.
+func (m *FeatureMutation) AppendMeterGroupByFilterVersions(cfv []credit.FeatureFilterVersion) {
+	m.appendmeter_group_by_filter_versions = append(m.appendmeter_group_by_filter_versions, cfv...)
+}
+
+// AppendedMeterGroupByFilterVersions returns the list of values that were appended to the "meter_group_by_filter_versions" field in this mutation.
+func (m *FeatureMutation) AppendedMeterGroupByFilterVersions() ([]credit.FeatureFilterVersion, bool) {
+	if len(m.appendmeter_group_by_filter_versions) == 0 {
+		return nil, false
+	}
+	return m.appendmeter_group_by_filter_versions, true
+}
+
+// ClearMeterGroupByFilterVersions clears the value of the "meter_group_by_filter_versions" field.
+func (m *FeatureMutation) ClearMeterGroupByFilterVersions() {
+	m.meter_group_by_filter_versions = nil
+	m.appendmeter_group_by_filter_versions = nil
+	m.clearedFields[feature.FieldMeterGroupByFilterVersions] = struct{}{}
+}
+
+// MeterGroupByFilterVersionsCleared returns if the "meter_group_by_filter_versions" field was cleared in this mutation.
+func (m *FeatureMutation) MeterGroupByFilterVersionsCleared() bool {
+	_, ok := m.clearedFields[feature.FieldMeterGroupByFilterVersions]
+	return ok
+}
+
+// ResetMeterGroupByFilterVersions resets all changes to the "meter_group_by_filter_versions" field.
+func (m *FeatureMutation) ResetMeterGroupByFilterVersions() {
+	m.meter_group_by_filter_versions = nil
+	m.appendmeter_group_by_filter_versions = nil
+	delete(m.clearedFields, feature.FieldMeterGroupByFilterVersions)
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (m *FeatureMutation) SetUnitPrice(cp *credit.UnitPrice) {
+	m.unit_price = &cp
+}
+
+// UnitPrice returns the value of the "unit_price" field in the mutation.
+func (m *FeatureMutation) UnitPrice() (r *credit.UnitPrice, exists bool) {
+	v := m.unit_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnitPrice returns the old "unit_price" field's value of the Feature entity.
+// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureMutation) OldUnitPrice(ctx context.Context) (v *credit.UnitPrice, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnitPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnitPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnitPrice: %w", err)
+	}
+	return oldValue.UnitPrice, nil
+}
+
+// ClearUnitPrice clears the value of the "unit_price" field.
+func (m *FeatureMutation) ClearUnitPrice() {
+	m.unit_price = nil
+	m.clearedFields[feature.FieldUnitPrice] = struct{}{}
+}
+
+// UnitPriceCleared returns if the "unit_price" field was cleared in this mutation.
+func (m *FeatureMutation) UnitPriceCleared() bool {
+	_, ok := m.clearedFields[feature.FieldUnitPrice]
+	return ok
+}
+
+// ResetUnitPrice resets all changes to the "unit_price" field.
+func (m *FeatureMutation) ResetUnitPrice() {
+	m.unit_price = nil
+	delete(m.clearedFields, feature.FieldUnitPrice)
+}
+
+// SetArchived sets the "archived" field.
+func (m *FeatureMutation) SetArchived(b bool) {
+	m.archived = &b
+}
+
+// Archived returns the value of the "archived" field in the mutation.
+func (m *FeatureMutation) Archived() (r bool, exists bool) {
+	v := m.archived
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchived returns the old "archived" field's value of the Feature entity.
+// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeatureMutation) OldArchived(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+	}
+	return oldValue.Archived, nil
+}
+
+// ResetArchived resets all changes to the "archived" field.
+func (m *FeatureMutation) ResetArchived() {
+	m.archived = nil
+}
+
+// AddCreditGrantIDs adds the "credit_grants" edge to the CreditEntry entity by ids.
+func (m *FeatureMutation) AddCreditGrantIDs(ids ...string) {
+	if m.credit_grants == nil {
+		m.credit_grants = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.credit_grants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCreditGrants clears the "credit_grants" edge to the CreditEntry entity.
+func (m *FeatureMutation) ClearCreditGrants() {
+	m.clearedcredit_grants = true
+}
+
+// CreditGrantsCleared reports if the "credit_grants" edge to the CreditEntry entity was cleared.
+func (m *FeatureMutation) CreditGrantsCleared() bool {
+	return m.clearedcredit_grants
+}
+
+// RemoveCreditGrantIDs removes the "credit_grants" edge to the CreditEntry entity by IDs.
+func (m *FeatureMutation) RemoveCreditGrantIDs(ids ...string) {
+	if m.removedcredit_grants == nil {
+		m.removedcredit_grants = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.credit_grants, ids[i])
+		m.removedcredit_grants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCreditGrants returns the removed IDs of the "credit_grants" edge to the CreditEntry entity.
+func (m *FeatureMutation) RemovedCreditGrantsIDs() (ids []string) {
+	for id := range m.removedcredit_grants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CreditGrantsIDs returns the "credit_grants" edge IDs in the mutation.
+func (m *FeatureMutation) CreditGrantsIDs() (ids []string) {
+	for id := range m.credit_grants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCreditGrants resets all changes to the "credit_grants" edge.
+func (m *FeatureMutation) ResetCreditGrants() {
+	m.credit_grants = nil
+	m.clearedcredit_grants = false
+	m.removedcredit_grants = nil
+}
+
+// Where appends a list predicates to the FeatureMutation builder.
+func (m *FeatureMutation) Where(ps ...predicate.Feature) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FeatureMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FeatureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Feature, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FeatureMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FeatureMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Feature).
+func (m *FeatureMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FeatureMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, feature.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, feature.FieldUpdatedAt)
+	}
+	if m.namespace != nil {
+		fields = append(fields, feature.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, feature.FieldName)
+	}
+	if m.meter_slug != nil {
+		fields = append(fields, feature.FieldMeterSlug)
+	}
+	if m.meter_group_by_filters != nil {
+		fields = append(fields, feature.FieldMeterGroupByFilters)
+	}
+	if m.meter_group_by_filter_versions != nil {
+		fields = append(fields, feature.FieldMeterGroupByFilterVersions)
+	}
+	if m.unit_price != nil {
+		fields = append(fields, feature.FieldUnitPrice)
+	}
+	if m.archived != nil {
+		fields = append(fields, feature.FieldArchived)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FeatureMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case feature.FieldCreatedAt:
+		return m.CreatedAt()
+	case feature.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case feature.FieldNamespace:
+		return m.Namespace()
+	case feature.FieldName:
+		return m.Name()
+	case feature.FieldMeterSlug:
+		return m.MeterSlug()
+	case feature.FieldMeterGroupByFilters:
+		return m.MeterGroupByFilters()
+	case feature.FieldMeterGroupByFilterVersions:
+		return m.MeterGroupByFilterVersions()
+	case feature.FieldUnitPrice:
+		return m.UnitPrice()
+	case feature.FieldArchived:
+		return m.Archived()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FeatureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case feature.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case feature.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case feature.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case feature.FieldName:
+		return m.OldName(ctx)
+	case feature.FieldMeterSlug:
+		return m.OldMeterSlug(ctx)
+	case feature.FieldMeterGroupByFilters:
+		return m.OldMeterGroupByFilters(ctx)
+	case feature.FieldMeterGroupByFilterVersions:
+		return m.OldMeterGroupByFilterVersions(ctx)
+	case feature.FieldUnitPrice:
+		return m.OldUnitPrice(ctx)
+	case feature.FieldArchived:
+		return m.OldArchived(ctx)
+	}
+	return nil, fmt.Errorf("unknown Feature field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeatureMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case feature.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case feature.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case feature.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case feature.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case feature.FieldMeterSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeterSlug(v)
+		return nil
+	case feature.FieldMeterGroupByFilters:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeterGroupByFilters(v)
+		return nil
+	case feature.FieldMeterGroupByFilterVersions:
+		v, ok := value.([]credit.FeatureFilterVersion)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeterGroupByFilterVersions(v)
+		return nil
+	case feature.FieldUnitPrice:
+		v, ok := value.(*credit.UnitPrice)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnitPrice(v)
+		return nil
+	case feature.FieldArchived:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchived(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Feature field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FeatureMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FeatureMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeatureMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Feature numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FeatureMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(feature.FieldMeterGroupByFilters) {
+		fields = append(fields, feature.FieldMeterGroupByFilters)
+	}
+	if m.FieldCleared(feature.FieldMeterGroupByFilterVersions) {
+		fields = append(fields, feature.FieldMeterGroupByFilterVersions)
+	}
+	if m.FieldCleared(feature.FieldUnitPrice) {
+		fields = append(fields, feature.FieldUnitPrice)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FeatureMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FeatureMutation) ClearField(name string) error {
+	switch name {
+	case feature.FieldMeterGroupByFilters:
+		m.ClearMeterGroupByFilters()
+		return nil
+	case feature.FieldMeterGroupByFilterVersions:
+		m.ClearMeterGroupByFilterVersions()
+		return nil
+	case feature.FieldUnitPrice:
+		m.ClearUnitPrice()
+		return nil
+	}
+	return fmt.Errorf("unknown Feature nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FeatureMutation) ResetField(name string) error {
+	switch name {
+	case feature.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case feature.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case feature.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case feature.FieldName:
+		m.ResetName()
+		return nil
+	case feature.FieldMeterSlug:
+		m.ResetMeterSlug()
+		return nil
+	case feature.FieldMeterGroupByFilters:
+		m.ResetMeterGroupByFilters()
+		return nil
+	case feature.FieldMeterGroupByFilterVersions:
+		m.ResetMeterGroupByFilterVersions()
+		return nil
+	case feature.FieldUnitPrice:
+		m.ResetUnitPrice()
+		return nil
+	case feature.FieldArchived:
+		m.ResetArchived()
+		return nil
+	}
+	return fmt.Errorf("unknown Feature field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FeatureMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.credit_grants != nil {
+		edges = append(edges, feature.EdgeCreditGrants)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FeatureMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case feature.EdgeCreditGrants:
+		ids := make([]ent.Value, 0, len(m.credit_grants))
+		for id := range m.credit_grants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FeatureMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedcredit_grants != nil {
+		edges = append(edges, feature.EdgeCreditGrants)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FeatureMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case feature.EdgeCreditGrants:
+		ids := make([]ent.Value, 0, len(m.removedcredit_grants))
+		for id := range m.removedcredit_grants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FeatureMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcredit_grants {
+		edges = append(edges, feature.EdgeCreditGrants)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FeatureMutation) EdgeCleared(name string) bool {
+	switch name {
+	case feature.EdgeCreditGrants:
+		return m.clearedcredit_grants
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FeatureMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Feature unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FeatureMutation) ResetEdge(name string) error {
+	switch name {
+	case feature.EdgeCreditGrants:
+		m.ResetCreditGrants()
+		return nil
+	}
+	return fmt.Errorf("unknown Feature edge %s", name)
+}
+
+// GrantScheduleMutation represents an operation that mutates the GrantSchedule nodes in the graph.
+type GrantScheduleMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *string
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	namespace                  *string
+	ledger_id                  *string
+	_type                      *credit.GrantType
+	feature_id                 *string
+	amount                     *float64
+	addamount                  *float64
+	priority                   *uint8
+	addpriority                *int8
+	expiration_period_duration *credit.ExpirationPeriodDuration
+	expiration_period_count    *uint8
+	addexpiration_period_count *int8
+	rollover_type              *credit.GrantRolloverType
+	rollover_max_amount        *float64
+	addrollover_max_amount     *float64
+	metadata                   *map[string]string
+	recurrence_period          *credit.RecurrencePeriod
+	recurrence_anchor          *time.Time
+	next_grant_index           *int
+	addnext_grant_index        *int
+	next_grant_at              *time.Time
+	clearedFields              map[string]struct{}
+	done                       bool
+	oldValue                   func(context.Context) (*GrantSchedule, error)
+	predicates                 []predicate.GrantSchedule
+}
+
+var _ ent.Mutation = (*GrantScheduleMutation)(nil)
+
+// grantscheduleOption allows management of the mutation configuration using functional options.
+type grantscheduleOption func(*GrantScheduleMutation)
+
+// newGrantScheduleMutation creates new mutation for the GrantSchedule entity.
+func newGrantScheduleMutation(c config, op Op, opts ...grantscheduleOption) *GrantScheduleMutation {
+	m := &GrantScheduleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGrantSchedule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGrantScheduleID sets the ID field of the mutation.
+func withGrantScheduleID(id string) grantscheduleOption {
+	return func(m *GrantScheduleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GrantSchedule
+		)
+		m.oldValue = func(ctx context.Context) (*GrantSchedule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GrantSchedule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGrantSchedule sets the old GrantSchedule of the mutation.
+func withGrantSchedule(node *GrantSchedule) grantscheduleOption {
+	return func(m *GrantScheduleMutation) {
+		m.oldValue = func(context.Context) (*GrantSchedule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GrantScheduleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GrantScheduleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GrantSchedule entities.
+func (m *GrantScheduleMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GrantScheduleMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GrantScheduleMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GrantSchedule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GrantScheduleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GrantScheduleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GrantScheduleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GrantScheduleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GrantScheduleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GrantScheduleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *GrantScheduleMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *GrantScheduleMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *GrantScheduleMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (m *GrantScheduleMutation) SetLedgerID(s string) {
+	m.ledger_id = &s
+}
+
+// LedgerID returns the value of the "ledger_id" field in the mutation.
+func (m *GrantScheduleMutation) LedgerID() (r string, exists bool) {
+	v := m.ledger_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLedgerID returns the old "ledger_id" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldLedgerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLedgerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLedgerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLedgerID: %w", err)
+	}
+	return oldValue.LedgerID, nil
+}
+
+// ResetLedgerID resets all changes to the "ledger_id" field.
+func (m *GrantScheduleMutation) ResetLedgerID() {
+	m.ledger_id = nil
+}
+
+// SetType sets the "type" field.
+func (m *GrantScheduleMutation) SetType(ct credit.GrantType) {
+	m._type = &ct
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *GrantScheduleMutation) GetType() (r credit.GrantType, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldType(ctx context.Context) (v credit.GrantType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *GrantScheduleMutation) ResetType() {
+	m._type = nil
+}
+
+// SetFeatureID sets the "feature_id" field.
+func (m *GrantScheduleMutation) SetFeatureID(s string) {
+	m.feature_id = &s
+}
+
+// FeatureID returns the value of the "feature_id" field in the mutation.
+func (m *GrantScheduleMutation) FeatureID() (r string, exists bool) {
+	v := m.feature_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatureID returns the old "feature_id" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldFeatureID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatureID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatureID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatureID: %w", err)
+	}
+	return oldValue.FeatureID, nil
+}
+
+// ClearFeatureID clears the value of the "feature_id" field.
+func (m *GrantScheduleMutation) ClearFeatureID() {
+	m.feature_id = nil
+	m.clearedFields[grantschedule.FieldFeatureID] = struct{}{}
+}
+
+// FeatureIDCleared returns if the "feature_id" field was cleared in this mutation.
+func (m *GrantScheduleMutation) FeatureIDCleared() bool {
+	_, ok := m.clearedFields[grantschedule.FieldFeatureID]
+	return ok
+}
+
+// ResetFeatureID resets all changes to the "feature_id" field.
+func (m *GrantScheduleMutation) ResetFeatureID() {
+	m.feature_id = nil
+	delete(m.clearedFields, grantschedule.FieldFeatureID)
+}
+
+// SetAmount sets the "amount" field.
+func (m *GrantScheduleMutation) SetAmount(f float64) {
+	m.amount = &f
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *GrantScheduleMutation) Amount() (r float64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldAmount(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds f to the "amount" field.
+func (m *GrantScheduleMutation) AddAmount(f float64) {
+	if m.addamount != nil {
+		*m.addamount += f
+	} else {
+		m.addamount = &f
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *GrantScheduleMutation) AddedAmount() (r float64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *GrantScheduleMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *GrantScheduleMutation) SetPriority(u uint8) {
+	m.priority = &u
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *GrantScheduleMutation) Priority() (r uint8, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldPriority(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds u to the "priority" field.
+func (m *GrantScheduleMutation) AddPriority(u int8) {
+	if m.addpriority != nil {
+		*m.addpriority += u
+	} else {
+		m.addpriority = &u
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *GrantScheduleMutation) AddedPriority() (r int8, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *GrantScheduleMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetExpirationPeriodDuration sets the "expiration_period_duration" field.
+func (m *GrantScheduleMutation) SetExpirationPeriodDuration(cpd credit.ExpirationPeriodDuration) {
+	m.expiration_period_duration = &cpd
+}
+
+// ExpirationPeriodDuration returns the value of the "expiration_period_duration" field in the mutation.
+func (m *GrantScheduleMutation) ExpirationPeriodDuration() (r credit.ExpirationPeriodDuration, exists bool) {
+	v := m.expiration_period_duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpirationPeriodDuration returns the old "expiration_period_duration" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldExpirationPeriodDuration(ctx context.Context) (v credit.ExpirationPeriodDuration, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpirationPeriodDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpirationPeriodDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpirationPeriodDuration: %w", err)
+	}
+	return oldValue.ExpirationPeriodDuration, nil
+}
+
+// ResetExpirationPeriodDuration resets all changes to the "expiration_period_duration" field.
+func (m *GrantScheduleMutation) ResetExpirationPeriodDuration() {
+	m.expiration_period_duration = nil
+}
+
+// SetExpirationPeriodCount sets the "expiration_period_count" field.
+func (m *GrantScheduleMutation) SetExpirationPeriodCount(u uint8) {
+	m.expiration_period_count = &u
+	m.addexpiration_period_count = nil
+}
+
+// ExpirationPeriodCount returns the value of the "expiration_period_count" field in the mutation.
+func (m *GrantScheduleMutation) ExpirationPeriodCount() (r uint8, exists bool) {
+	v := m.expiration_period_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpirationPeriodCount returns the old "expiration_period_count" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldExpirationPeriodCount(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpirationPeriodCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpirationPeriodCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpirationPeriodCount: %w", err)
+	}
+	return oldValue.ExpirationPeriodCount, nil
+}
+
+// AddExpirationPeriodCount adds u to the "expiration_period_count" field.
+func (m *GrantScheduleMutation) AddExpirationPeriodCount(u int8) {
+	if m.addexpiration_period_count != nil {
+		*m.addexpiration_period_count += u
+	} else {
+		m.addexpiration_period_count = &u
+	}
+}
+
+// AddedExpirationPeriodCount returns the value that was added to the "expiration_period_count" field in this mutation.
+func (m *GrantScheduleMutation) AddedExpirationPeriodCount() (r int8, exists bool) {
+	v := m.addexpiration_period_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpirationPeriodCount resets all changes to the "expiration_period_count" field.
+func (m *GrantScheduleMutation) ResetExpirationPeriodCount() {
+	m.expiration_period_count = nil
+	m.addexpiration_period_count = nil
+}
+
+// SetRolloverType sets the "rollover_type" field.
+func (m *GrantScheduleMutation) SetRolloverType(crt credit.GrantRolloverType) {
+	m.rollover_type = &crt
+}
+
+// RolloverType returns the value of the "rollover_type" field in the mutation.
+func (m *GrantScheduleMutation) RolloverType() (r credit.GrantRolloverType, exists bool) {
+	v := m.rollover_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRolloverType returns the old "rollover_type" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldRolloverType(ctx context.Context) (v *credit.GrantRolloverType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRolloverType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRolloverType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRolloverType: %w", err)
+	}
+	return oldValue.RolloverType, nil
+}
+
+// ClearRolloverType clears the value of the "rollover_type" field.
+func (m *GrantScheduleMutation) ClearRolloverType() {
+	m.rollover_type = nil
+	m.clearedFields[grantschedule.FieldRolloverType] = struct{}{}
+}
+
+// RolloverTypeCleared returns if the "rollover_type" field was cleared in this mutation.
+func (m *GrantScheduleMutation) RolloverTypeCleared() bool {
+	_, ok := m.clearedFields[grantschedule.FieldRolloverType]
+	return ok
+}
+
+// ResetRolloverType resets all changes to the "rollover_type" field.
+func (m *GrantScheduleMutation) ResetRolloverType() {
+	m.rollover_type = nil
+	delete(m.clearedFields, grantschedule.FieldRolloverType)
+}
+
+// SetRolloverMaxAmount sets the "rollover_max_amount" field.
+func (m *GrantScheduleMutation) SetRolloverMaxAmount(f float64) {
+	m.rollover_max_amount = &f
+	m.addrollover_max_amount = nil
+}
+
+// RolloverMaxAmount returns the value of the "rollover_max_amount" field in the mutation.
+func (m *GrantScheduleMutation) RolloverMaxAmount() (r float64, exists bool) {
+	v := m.rollover_max_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRolloverMaxAmount returns the old "rollover_max_amount" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldRolloverMaxAmount(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRolloverMaxAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRolloverMaxAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRolloverMaxAmount: %w", err)
+	}
+	return oldValue.RolloverMaxAmount, nil
+}
+
+// AddRolloverMaxAmount adds f to the "rollover_max_amount" field.
+func (m *GrantScheduleMutation) AddRolloverMaxAmount(f float64) {
+	if m.addrollover_max_amount != nil {
+		*m.addrollover_max_amount += f
+	} else {
+		m.addrollover_max_amount = &f
+	}
+}
+
+// AddedRolloverMaxAmount returns the value that was added to the "rollover_max_amount" field in this mutation.
+func (m *GrantScheduleMutation) AddedRolloverMaxAmount() (r float64, exists bool) {
+	v := m.addrollover_max_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRolloverMaxAmount clears the value of the "rollover_max_amount" field.
+func (m *GrantScheduleMutation) ClearRolloverMaxAmount() {
+	m.rollover_max_amount = nil
+	m.addrollover_max_amount = nil
+	m.clearedFields[grantschedule.FieldRolloverMaxAmount] = struct{}{}
+}
+
+// RolloverMaxAmountCleared returns if the "rollover_max_amount" field was cleared in this mutation.
+func (m *GrantScheduleMutation) RolloverMaxAmountCleared() bool {
+	_, ok := m.clearedFields[grantschedule.FieldRolloverMaxAmount]
+	return ok
+}
+
+// ResetRolloverMaxAmount resets all changes to the "rollover_max_amount" field.
+func (m *GrantScheduleMutation) ResetRolloverMaxAmount() {
+	m.rollover_max_amount = nil
+	m.addrollover_max_amount = nil
+	delete(m.clearedFields, grantschedule.FieldRolloverMaxAmount)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *GrantScheduleMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *GrantScheduleMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *GrantScheduleMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[grantschedule.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *GrantScheduleMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[grantschedule.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *GrantScheduleMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, grantschedule.FieldMetadata)
+}
+
+// SetRecurrencePeriod sets the "recurrence_period" field.
+func (m *GrantScheduleMutation) SetRecurrencePeriod(cp credit.RecurrencePeriod) {
+	m.recurrence_period = &cp
+}
+
+// RecurrencePeriod returns the value of the "recurrence_period" field in the mutation.
+func (m *GrantScheduleMutation) RecurrencePeriod() (r credit.RecurrencePeriod, exists bool) {
+	v := m.recurrence_period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecurrencePeriod returns the old "recurrence_period" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldRecurrencePeriod(ctx context.Context) (v credit.RecurrencePeriod, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecurrencePeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecurrencePeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecurrencePeriod: %w", err)
+	}
+	return oldValue.RecurrencePeriod, nil
+}
+
+// ResetRecurrencePeriod resets all changes to the "recurrence_period" field.
+func (m *GrantScheduleMutation) ResetRecurrencePeriod() {
+	m.recurrence_period = nil
+}
+
+// SetRecurrenceAnchor sets the "recurrence_anchor" field.
+func (m *GrantScheduleMutation) SetRecurrenceAnchor(t time.Time) {
+	m.recurrence_anchor = &t
+}
+
+// RecurrenceAnchor returns the value of the "recurrence_anchor" field in the mutation.
+func (m *GrantScheduleMutation) RecurrenceAnchor() (r time.Time, exists bool) {
+	v := m.recurrence_anchor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecurrenceAnchor returns the old "recurrence_anchor" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldRecurrenceAnchor(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecurrenceAnchor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecurrenceAnchor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecurrenceAnchor: %w", err)
+	}
+	return oldValue.RecurrenceAnchor, nil
+}
+
+// ResetRecurrenceAnchor resets all changes to the "recurrence_anchor" field.
+func (m *GrantScheduleMutation) ResetRecurrenceAnchor() {
+	m.recurrence_anchor = nil
+}
+
+// SetNextGrantIndex sets the "next_grant_index" field.
+func (m *GrantScheduleMutation) SetNextGrantIndex(i int) {
+	m.next_grant_index = &i
+	m.addnext_grant_index = nil
+}
+
+// NextGrantIndex returns the value of the "next_grant_index" field in the mutation.
+func (m *GrantScheduleMutation) NextGrantIndex() (r int, exists bool) {
+	v := m.next_grant_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextGrantIndex returns the old "next_grant_index" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldNextGrantIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextGrantIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextGrantIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextGrantIndex: %w", err)
+	}
+	return oldValue.NextGrantIndex, nil
+}
+
+// AddNextGrantIndex adds i to the "next_grant_index" field.
+func (m *GrantScheduleMutation) AddNextGrantIndex(i int) {
+	if m.addnext_grant_index != nil {
+		*m.addnext_grant_index += i
+	} else {
+		m.addnext_grant_index = &i
+	}
+}
+
+// AddedNextGrantIndex returns the value that was added to the "next_grant_index" field in this mutation.
+func (m *GrantScheduleMutation) AddedNextGrantIndex() (r int, exists bool) {
+	v := m.addnext_grant_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNextGrantIndex resets all changes to the "next_grant_index" field.
+func (m *GrantScheduleMutation) ResetNextGrantIndex() {
+	m.next_grant_index = nil
+	m.addnext_grant_index = nil
+}
+
+// SetNextGrantAt sets the "next_grant_at" field.
+func (m *GrantScheduleMutation) SetNextGrantAt(t time.Time) {
+	m.next_grant_at = &t
+}
+
+// NextGrantAt returns the value of the "next_grant_at" field in the mutation.
+func (m *GrantScheduleMutation) NextGrantAt() (r time.Time, exists bool) {
+	v := m.next_grant_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextGrantAt returns the old "next_grant_at" field's value of the GrantSchedule entity.
+// If the GrantSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GrantScheduleMutation) OldNextGrantAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextGrantAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextGrantAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextGrantAt: %w", err)
+	}
+	return oldValue.NextGrantAt, nil
+}
+
+// ResetNextGrantAt resets all changes to the "next_grant_at" field.
+func (m *GrantScheduleMutation) ResetNextGrantAt() {
+	m.next_grant_at = nil
+}
+
+// Where appends a list predicates to the GrantScheduleMutation builder.
+func (m *GrantScheduleMutation) Where(ps ...predicate.GrantSchedule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GrantScheduleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GrantScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GrantSchedule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GrantScheduleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GrantScheduleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GrantSchedule).
+func (m *GrantScheduleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GrantScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.created_at != nil {
+		fields = append(fields, grantschedule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, grantschedule.FieldUpdatedAt)
+	}
+	if m.namespace != nil {
+		fields = append(fields, grantschedule.FieldNamespace)
+	}
+	if m.ledger_id != nil {
+		fields = append(fields, grantschedule.FieldLedgerID)
+	}
+	if m._type != nil {
+		fields = append(fields, grantschedule.FieldType)
+	}
+	if m.feature_id != nil {
+		fields = append(fields, grantschedule.FieldFeatureID)
+	}
+	if m.amount != nil {
+		fields = append(fields, grantschedule.FieldAmount)
+	}
+	if m.priority != nil {
+		fields = append(fields, grantschedule.FieldPriority)
+	}
+	if m.expiration_period_duration != nil {
+		fields = append(fields, grantschedule.FieldExpirationPeriodDuration)
+	}
+	if m.expiration_period_count != nil {
+		fields = append(fields, grantschedule.FieldExpirationPeriodCount)
+	}
+	if m.rollover_type != nil {
+		fields = append(fields, grantschedule.FieldRolloverType)
+	}
+	if m.rollover_max_amount != nil {
+		fields = append(fields, grantschedule.FieldRolloverMaxAmount)
+	}
+	if m.metadata != nil {
+		fields = append(fields, grantschedule.FieldMetadata)
+	}
+	if m.recurrence_period != nil {
+		fields = append(fields, grantschedule.FieldRecurrencePeriod)
+	}
+	if m.recurrence_anchor != nil {
+		fields = append(fields, grantschedule.FieldRecurrenceAnchor)
+	}
+	if m.next_grant_index != nil {
+		fields = append(fields, grantschedule.FieldNextGrantIndex)
+	}
+	if m.next_grant_at != nil {
+		fields = append(fields, grantschedule.FieldNextGrantAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GrantScheduleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case grantschedule.FieldCreatedAt:
+		return m.CreatedAt()
+	case grantschedule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case grantschedule.FieldNamespace:
+		return m.Namespace()
+	case grantschedule.FieldLedgerID:
+		return m.LedgerID()
+	case grantschedule.FieldType:
+		return m.GetType()
+	case grantschedule.FieldFeatureID:
+		return m.FeatureID()
+	case grantschedule.FieldAmount:
+		return m.Amount()
+	case grantschedule.FieldPriority:
+		return m.Priority()
+	case grantschedule.FieldExpirationPeriodDuration:
+		return m.ExpirationPeriodDuration()
+	case grantschedule.FieldExpirationPeriodCount:
+		return m.ExpirationPeriodCount()
+	case grantschedule.FieldRolloverType:
+		return m.RolloverType()
+	case grantschedule.FieldRolloverMaxAmount:
+		return m.RolloverMaxAmount()
+	case grantschedule.FieldMetadata:
+		return m.Metadata()
+	case grantschedule.FieldRecurrencePeriod:
+		return m.RecurrencePeriod()
+	case grantschedule.FieldRecurrenceAnchor:
+		return m.RecurrenceAnchor()
+	case grantschedule.FieldNextGrantIndex:
+		return m.NextGrantIndex()
+	case grantschedule.FieldNextGrantAt:
+		return m.NextGrantAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GrantScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case grantschedule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case grantschedule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case grantschedule.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case grantschedule.FieldLedgerID:
+		return m.OldLedgerID(ctx)
+	case grantschedule.FieldType:
+		return m.OldType(ctx)
+	case grantschedule.FieldFeatureID:
+		return m.OldFeatureID(ctx)
+	case grantschedule.FieldAmount:
+		return m.OldAmount(ctx)
+	case grantschedule.FieldPriority:
+		return m.OldPriority(ctx)
+	case grantschedule.FieldExpirationPeriodDuration:
+		return m.OldExpirationPeriodDuration(ctx)
+	case grantschedule.FieldExpirationPeriodCount:
+		return m.OldExpirationPeriodCount(ctx)
+	case grantschedule.FieldRolloverType:
+		return m.OldRolloverType(ctx)
+	case grantschedule.FieldRolloverMaxAmount:
+		return m.OldRolloverMaxAmount(ctx)
+	case grantschedule.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case grantschedule.FieldRecurrencePeriod:
+		return m.OldRecurrencePeriod(ctx)
+	case grantschedule.FieldRecurrenceAnchor:
+		return m.OldRecurrenceAnchor(ctx)
+	case grantschedule.FieldNextGrantIndex:
+		return m.OldNextGrantIndex(ctx)
+	case grantschedule.FieldNextGrantAt:
+		return m.OldNextGrantAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GrantSchedule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GrantScheduleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case grantschedule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case grantschedule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case grantschedule.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case grantschedule.FieldLedgerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLedgerID(v)
+		return nil
+	case grantschedule.FieldType:
+		v, ok := value.(credit.GrantType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case grantschedule.FieldFeatureID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatureID(v)
+		return nil
+	case grantschedule.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case grantschedule.FieldPriority:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case grantschedule.FieldExpirationPeriodDuration:
+		v, ok := value.(credit.ExpirationPeriodDuration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpirationPeriodDuration(v)
+		return nil
+	case grantschedule.FieldExpirationPeriodCount:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpirationPeriodCount(v)
+		return nil
+	case grantschedule.FieldRolloverType:
+		v, ok := value.(credit.GrantRolloverType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRolloverType(v)
+		return nil
+	case grantschedule.FieldRolloverMaxAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRolloverMaxAmount(v)
+		return nil
+	case grantschedule.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case grantschedule.FieldRecurrencePeriod:
+		v, ok := value.(credit.RecurrencePeriod)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecurrencePeriod(v)
+		return nil
+	case grantschedule.FieldRecurrenceAnchor:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecurrenceAnchor(v)
+		return nil
+	case grantschedule.FieldNextGrantIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextGrantIndex(v)
+		return nil
+	case grantschedule.FieldNextGrantAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextGrantAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GrantSchedule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GrantScheduleMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, grantschedule.FieldAmount)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, grantschedule.FieldPriority)
+	}
+	if m.addexpiration_period_count != nil {
+		fields = append(fields, grantschedule.FieldExpirationPeriodCount)
+	}
+	if m.addrollover_max_amount != nil {
+		fields = append(fields, grantschedule.FieldRolloverMaxAmount)
+	}
+	if m.addnext_grant_index != nil {
+		fields = append(fields, grantschedule.FieldNextGrantIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GrantScheduleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case grantschedule.FieldAmount:
+		return m.AddedAmount()
+	case grantschedule.FieldPriority:
+		return m.AddedPriority()
+	case grantschedule.FieldExpirationPeriodCount:
+		return m.AddedExpirationPeriodCount()
+	case grantschedule.FieldRolloverMaxAmount:
+		return m.AddedRolloverMaxAmount()
+	case grantschedule.FieldNextGrantIndex:
+		return m.AddedNextGrantIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GrantScheduleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case grantschedule.FieldAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case grantschedule.FieldPriority:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case grantschedule.FieldExpirationPeriodCount:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpirationPeriodCount(v)
+		return nil
+	case grantschedule.FieldRolloverMaxAmount:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRolloverMaxAmount(v)
+		return nil
+	case grantschedule.FieldNextGrantIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNextGrantIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GrantSchedule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GrantScheduleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(grantschedule.FieldFeatureID) {
+		fields = append(fields, grantschedule.FieldFeatureID)
+	}
+	if m.FieldCleared(grantschedule.FieldRolloverType) {
+		fields = append(fields, grantschedule.FieldRolloverType)
+	}
+	if m.FieldCleared(grantschedule.FieldRolloverMaxAmount) {
+		fields = append(fields, grantschedule.FieldRolloverMaxAmount)
+	}
+	if m.FieldCleared(grantschedule.FieldMetadata) {
+		fields = append(fields, grantschedule.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GrantScheduleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GrantScheduleMutation) ClearField(name string) error {
+	switch name {
+	case grantschedule.FieldFeatureID:
+		m.ClearFeatureID()
+		return nil
+	case grantschedule.FieldRolloverType:
+		m.ClearRolloverType()
+		return nil
+	case grantschedule.FieldRolloverMaxAmount:
+		m.ClearRolloverMaxAmount()
+		return nil
+	case grantschedule.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown GrantSchedule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GrantScheduleMutation) ResetField(name string) error {
+	switch name {
+	case grantschedule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case grantschedule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case grantschedule.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case grantschedule.FieldLedgerID:
+		m.ResetLedgerID()
+		return nil
+	case grantschedule.FieldType:
+		m.ResetType()
+		return nil
+	case grantschedule.FieldFeatureID:
+		m.ResetFeatureID()
+		return nil
+	case grantschedule.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case grantschedule.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case grantschedule.FieldExpirationPeriodDuration:
+		m.ResetExpirationPeriodDuration()
+		return nil
+	case grantschedule.FieldExpirationPeriodCount:
+		m.ResetExpirationPeriodCount()
+		return nil
+	case grantschedule.FieldRolloverType:
+		m.ResetRolloverType()
+		return nil
+	case grantschedule.FieldRolloverMaxAmount:
+		m.ResetRolloverMaxAmount()
+		return nil
+	case grantschedule.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case grantschedule.FieldRecurrencePeriod:
+		m.ResetRecurrencePeriod()
+		return nil
+	case grantschedule.FieldRecurrenceAnchor:
+		m.ResetRecurrenceAnchor()
+		return nil
+	case grantschedule.FieldNextGrantIndex:
+		m.ResetNextGrantIndex()
+		return nil
+	case grantschedule.FieldNextGrantAt:
+		m.ResetNextGrantAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GrantSchedule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GrantScheduleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GrantScheduleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GrantScheduleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GrantScheduleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GrantScheduleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GrantScheduleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GrantScheduleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GrantSchedule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GrantScheduleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GrantSchedule edge %s", name)
+}
+
+// LedgerMutation represents an operation that mutates the Ledger nodes in the graph.
+type LedgerMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *string
+	created_at             *time.Time
+	updated_at             *time.Time
+	namespace              *string
+	subject                *string
+	metadata               *map[string]string
+	overage_limit          *float64
+	addoverage_limit       *float64
+	feature_overage_limits *map[string]float64
+	usage_period           *credit.RecurrencePeriod
+	usage_period_anchor    *time.Time
+	next_reset_index       *int
+	addnext_reset_index    *int
+	next_reset_at          *time.Time
+	highwatermark          *time.Time
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*Ledger, error)
+	predicates             []predicate.Ledger
+}
+
+var _ ent.Mutation = (*LedgerMutation)(nil)
+
+// ledgerOption allows management of the mutation configuration using functional options.
+type ledgerOption func(*LedgerMutation)
+
+// newLedgerMutation creates new mutation for the Ledger entity.
+func newLedgerMutation(c config, op Op, opts ...ledgerOption) *LedgerMutation {
+	m := &LedgerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLedger,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLedgerID sets the ID field of the mutation.
+func withLedgerID(id string) ledgerOption {
+	return func(m *LedgerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Ledger
+		)
+		m.oldValue = func(ctx context.Context) (*Ledger, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Ledger.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLedger sets the old Ledger of the mutation.
+func withLedger(node *Ledger) ledgerOption {
+	return func(m *LedgerMutation) {
+		m.oldValue = func(context.Context) (*Ledger, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LedgerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LedgerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Ledger entities.
+func (m *LedgerMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LedgerMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LedgerMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Ledger.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LedgerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LedgerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Ledger entity.
+// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LedgerMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LedgerMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LedgerMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Ledger entity.
+// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LedgerMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *LedgerMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *LedgerMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the Ledger entity.
+// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *LedgerMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *LedgerMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *LedgerMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the Ledger entity.
+// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *LedgerMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *LedgerMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *LedgerMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Ledger entity.
+// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *LedgerMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[ledger.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *LedgerMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[ledger.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *LedgerMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, ledger.FieldMetadata)
+}
+
+// SetOverageLimit sets the "overage_limit" field.
+func (m *LedgerMutation) SetOverageLimit(f float64) {
+	m.overage_limit = &f
+	m.addoverage_limit = nil
+}
+
+// OverageLimit returns the value of the "overage_limit" field in the mutation.
+func (m *LedgerMutation) OverageLimit() (r float64, exists bool) {
+	v := m.overage_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOverageLimit returns the old "overage_limit" field's value of the Ledger entity.
+// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerMutation) OldOverageLimit(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOverageLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOverageLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOverageLimit: %w", err)
+	}
+	return oldValue.OverageLimit, nil
+}
+
+// AddOverageLimit adds f to the "overage_limit" field.
+func (m *LedgerMutation) AddOverageLimit(f float64) {
+	if m.addoverage_limit != nil {
+		*m.addoverage_limit += f
+	} else {
+		m.addoverage_limit = &f
+	}
+}
+
+// AddedOverageLimit returns the value that was added to the "overage_limit" field in this mutation.
+func (m *LedgerMutation) AddedOverageLimit() (r float64, exists bool) {
+	v := m.addoverage_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOverageLimit clears the value of the "overage_limit" field.
+func (m *LedgerMutation) ClearOverageLimit() {
+	m.overage_limit = nil
+	m.addoverage_limit = nil
+	m.clearedFields[ledger.FieldOverageLimit] = struct{}{}
+}
+
+// OverageLimitCleared returns if the "overage_limit" field was cleared in this mutation.
+func (m *LedgerMutation) OverageLimitCleared() bool {
+	_, ok := m.clearedFields[ledger.FieldOverageLimit]
+	return ok
+}
+
+// ResetOverageLimit resets all changes to the "overage_limit" field.
+func (m *LedgerMutation) ResetOverageLimit() {
+	m.overage_limit = nil
+	m.addoverage_limit = nil
+	delete(m.clearedFields, ledger.FieldOverageLimit)
+}
+
+// SetFeatureOverageLimits sets the "feature_overage_limits" field.
+func (m *LedgerMutation) SetFeatureOverageLimits(value map[string]float64) {
+	m.feature_overage_limits = &value
+}
+
+// FeatureOverageLimits returns the value of the "feature_overage_limits" field in the mutation.
+func (m *LedgerMutation) FeatureOverageLimits() (r map[string]float64, exists bool) {
+	v := m.feature_overage_limits
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeatureOverageLimits returns the old "feature_overage_limits" field's value of the Ledger entity.
+// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerMutation) OldFeatureOverageLimits(ctx context.Context) (v map[string]float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeatureOverageLimits is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeatureOverageLimits requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeatureOverageLimits: %w", err)
+	}
+	return oldValue.FeatureOverageLimits, nil
+}
+
+// ClearFeatureOverageLimits clears the value of the "feature_overage_limits" field.
+func (m *LedgerMutation) ClearFeatureOverageLimits() {
+	m.feature_overage_limits = nil
+	m.clearedFields[ledger.FieldFeatureOverageLimits] = struct{}{}
+}
+
+// FeatureOverageLimitsCleared returns if the "feature_overage_limits" field was cleared in this mutation.
+func (m *LedgerMutation) FeatureOverageLimitsCleared() bool {
+	_, ok := m.clearedFields[ledger.FieldFeatureOverageLimits]
+	return ok
+}
+
+// ResetFeatureOverageLimits resets all changes to the "feature_overage_limits" field.
+func (m *LedgerMutation) ResetFeatureOverageLimits() {
+	m.feature_overage_limits = nil
+	delete(m.clearedFields, ledger.FieldFeatureOverageLimits)
+}
+
+// SetUsagePeriod sets the "usage_period" field.
+func (m *LedgerMutation) SetUsagePeriod(cp credit.RecurrencePeriod) {
+	m.usage_period = &cp
+}
+
+// UsagePeriod returns the value of the "usage_period" field in the mutation.
+func (m *LedgerMutation) UsagePeriod() (r credit.RecurrencePeriod, exists bool) {
+	v := m.usage_period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsagePeriod returns the old "usage_period" field's value of the Ledger entity.
+// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerMutation) OldUsagePeriod(ctx context.Context) (v *credit.RecurrencePeriod, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsagePeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsagePeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsagePeriod: %w", err)
+	}
+	return oldValue.UsagePeriod, nil
+}
+
+// ClearUsagePeriod clears the value of the "usage_period" field.
+func (m *LedgerMutation) ClearUsagePeriod() {
+	m.usage_period = nil
+	m.clearedFields[ledger.FieldUsagePeriod] = struct{}{}
+}
+
+// UsagePeriodCleared returns if the "usage_period" field was cleared in this mutation.
+func (m *LedgerMutation) UsagePeriodCleared() bool {
+	_, ok := m.clearedFields[ledger.FieldUsagePeriod]
+	return ok
+}
+
+// ResetUsagePeriod resets all changes to the "usage_period" field.
+func (m *LedgerMutation) ResetUsagePeriod() {
+	m.usage_period = nil
+	delete(m.clearedFields, ledger.FieldUsagePeriod)
+}
+
+// SetUsagePeriodAnchor sets the "usage_period_anchor" field.
+func (m *LedgerMutation) SetUsagePeriodAnchor(t time.Time) {
+	m.usage_period_anchor = &t
+}
+
+// UsagePeriodAnchor returns the value of the "usage_period_anchor" field in the mutation.
+func (m *LedgerMutation) UsagePeriodAnchor() (r time.Time, exists bool) {
+	v := m.usage_period_anchor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsagePeriodAnchor returns the old "usage_period_anchor" field's value of the Ledger entity.
+// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerMutation) OldUsagePeriodAnchor(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsagePeriodAnchor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsagePeriodAnchor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsagePeriodAnchor: %w", err)
+	}
+	return oldValue.UsagePeriodAnchor, nil
+}
+
+// ClearUsagePeriodAnchor clears the value of the "usage_period_anchor" field.
+func (m *LedgerMutation) ClearUsagePeriodAnchor() {
+	m.usage_period_anchor = nil
+	m.clearedFields[ledger.FieldUsagePeriodAnchor] = struct{}{}
+}
+
+// UsagePeriodAnchorCleared returns if the "usage_period_anchor" field was cleared in this mutation.
+func (m *LedgerMutation) UsagePeriodAnchorCleared() bool {
+	_, ok := m.clearedFields[ledger.FieldUsagePeriodAnchor]
+	return ok
+}
+
+// ResetUsagePeriodAnchor resets all changes to the "usage_period_anchor" field.
+func (m *LedgerMutation) ResetUsagePeriodAnchor() {
+	m.usage_period_anchor = nil
+	delete(m.clearedFields, ledger.FieldUsagePeriodAnchor)
+}
+
+// SetNextResetIndex sets the "next_reset_index" field.
+func (m *LedgerMutation) SetNextResetIndex(i int) {
+	m.next_reset_index = &i
+	m.addnext_reset_index = nil
+}
+
+// NextResetIndex returns the value of the "next_reset_index" field in the mutation.
+func (m *LedgerMutation) NextResetIndex() (r int, exists bool) {
+	v := m.next_reset_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextResetIndex returns the old "next_reset_index" field's value of the Ledger entity.
+// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerMutation) OldNextResetIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextResetIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextResetIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextResetIndex: %w", err)
+	}
+	return oldValue.NextResetIndex, nil
+}
+
+// AddNextResetIndex adds i to the "next_reset_index" field.
+func (m *LedgerMutation) AddNextResetIndex(i int) {
+	if m.addnext_reset_index != nil {
+		*m.addnext_reset_index += i
+	} else {
+		m.addnext_reset_index = &i
+	}
+}
+
+// AddedNextResetIndex returns the value that was added to the "next_reset_index" field in this mutation.
+func (m *LedgerMutation) AddedNextResetIndex() (r int, exists bool) {
+	v := m.addnext_reset_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNextResetIndex resets all changes to the "next_reset_index" field.
+func (m *LedgerMutation) ResetNextResetIndex() {
+	m.next_reset_index = nil
+	m.addnext_reset_index = nil
+}
+
+// SetNextResetAt sets the "next_reset_at" field.
+func (m *LedgerMutation) SetNextResetAt(t time.Time) {
+	m.next_reset_at = &t
+}
+
+// NextResetAt returns the value of the "next_reset_at" field in the mutation.
+func (m *LedgerMutation) NextResetAt() (r time.Time, exists bool) {
+	v := m.next_reset_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextResetAt returns the old "next_reset_at" field's value of the Ledger entity.
+// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerMutation) OldNextResetAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextResetAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextResetAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextResetAt: %w", err)
+	}
+	return oldValue.NextResetAt, nil
+}
+
+// ClearNextResetAt clears the value of the "next_reset_at" field.
+func (m *LedgerMutation) ClearNextResetAt() {
+	m.next_reset_at = nil
+	m.clearedFields[ledger.FieldNextResetAt] = struct{}{}
+}
+
+// NextResetAtCleared returns if the "next_reset_at" field was cleared in this mutation.
+func (m *LedgerMutation) NextResetAtCleared() bool {
+	_, ok := m.clearedFields[ledger.FieldNextResetAt]
+	return ok
+}
+
+// ResetNextResetAt resets all changes to the "next_reset_at" field.
+func (m *LedgerMutation) ResetNextResetAt() {
+	m.next_reset_at = nil
+	delete(m.clearedFields, ledger.FieldNextResetAt)
+}
+
+// SetHighwatermark sets the "highwatermark" field.
+func (m *LedgerMutation) SetHighwatermark(t time.Time) {
+	m.highwatermark = &t
+}
+
+// Highwatermark returns the value of the "highwatermark" field in the mutation.
+func (m *LedgerMutation) Highwatermark() (r time.Time, exists bool) {
+	v := m.highwatermark
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHighwatermark returns the old "highwatermark" field's value of the Ledger entity.
+// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LedgerMutation) OldHighwatermark(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHighwatermark is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHighwatermark requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHighwatermark: %w", err)
+	}
+	return oldValue.Highwatermark, nil
+}
+
+// ResetHighwatermark resets all changes to the "highwatermark" field.
+func (m *LedgerMutation) ResetHighwatermark() {
+	m.highwatermark = nil
+}
+
+// Where appends a list predicates to the LedgerMutation builder.
+func (m *LedgerMutation) Where(ps ...predicate.Ledger) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LedgerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LedgerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Ledger, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LedgerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LedgerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Ledger).
+func (m *LedgerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LedgerMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.created_at != nil {
+		fields = append(fields, ledger.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, ledger.FieldUpdatedAt)
+	}
+	if m.namespace != nil {
+		fields = append(fields, ledger.FieldNamespace)
+	}
+	if m.subject != nil {
+		fields = append(fields, ledger.FieldSubject)
+	}
+	if m.metadata != nil {
+		fields = append(fields, ledger.FieldMetadata)
+	}
+	if m.overage_limit != nil {
+		fields = append(fields, ledger.FieldOverageLimit)
+	}
+	if m.feature_overage_limits != nil {
+		fields = append(fields, ledger.FieldFeatureOverageLimits)
+	}
+	if m.usage_period != nil {
+		fields = append(fields, ledger.FieldUsagePeriod)
+	}
+	if m.usage_period_anchor != nil {
+		fields = append(fields, ledger.FieldUsagePeriodAnchor)
+	}
+	if m.next_reset_index != nil {
+		fields = append(fields, ledger.FieldNextResetIndex)
+	}
+	if m.next_reset_at != nil {
+		fields = append(fields, ledger.FieldNextResetAt)
+	}
+	if m.highwatermark != nil {
+		fields = append(fields, ledger.FieldHighwatermark)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LedgerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ledger.FieldCreatedAt:
+		return m.CreatedAt()
+	case ledger.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case ledger.FieldNamespace:
+		return m.Namespace()
+	case ledger.FieldSubject:
+		return m.Subject()
+	case ledger.FieldMetadata:
+		return m.Metadata()
+	case ledger.FieldOverageLimit:
+		return m.OverageLimit()
+	case ledger.FieldFeatureOverageLimits:
+		return m.FeatureOverageLimits()
+	case ledger.FieldUsagePeriod:
+		return m.UsagePeriod()
+	case ledger.FieldUsagePeriodAnchor:
+		return m.UsagePeriodAnchor()
+	case ledger.FieldNextResetIndex:
+		return m.NextResetIndex()
+	case ledger.FieldNextResetAt:
+		return m.NextResetAt()
+	case ledger.FieldHighwatermark:
+		return m.Highwatermark()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LedgerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ledger.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case ledger.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case ledger.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case ledger.FieldSubject:
+		return m.OldSubject(ctx)
+	case ledger.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case ledger.FieldOverageLimit:
+		return m.OldOverageLimit(ctx)
+	case ledger.FieldFeatureOverageLimits:
+		return m.OldFeatureOverageLimits(ctx)
+	case ledger.FieldUsagePeriod:
+		return m.OldUsagePeriod(ctx)
+	case ledger.FieldUsagePeriodAnchor:
+		return m.OldUsagePeriodAnchor(ctx)
+	case ledger.FieldNextResetIndex:
+		return m.OldNextResetIndex(ctx)
+	case ledger.FieldNextResetAt:
+		return m.OldNextResetAt(ctx)
+	case ledger.FieldHighwatermark:
+		return m.OldHighwatermark(ctx)
+	}
+	return nil, fmt.Errorf("unknown Ledger field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LedgerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ledger.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case ledger.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case ledger.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case ledger.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case ledger.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case ledger.FieldOverageLimit:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOverageLimit(v)
+		return nil
+	case ledger.FieldFeatureOverageLimits:
+		v, ok := value.(map[string]float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeatureOverageLimits(v)
+		return nil
+	case ledger.FieldUsagePeriod:
+		v, ok := value.(credit.RecurrencePeriod)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsagePeriod(v)
+		return nil
+	case ledger.FieldUsagePeriodAnchor:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsagePeriodAnchor(v)
+		return nil
+	case ledger.FieldNextResetIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextResetIndex(v)
+		return nil
+	case ledger.FieldNextResetAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextResetAt(v)
+		return nil
+	case ledger.FieldHighwatermark:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHighwatermark(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Ledger field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LedgerMutation) AddedFields() []string {
+	var fields []string
+	if m.addoverage_limit != nil {
+		fields = append(fields, ledger.FieldOverageLimit)
+	}
+	if m.addnext_reset_index != nil {
+		fields = append(fields, ledger.FieldNextResetIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LedgerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ledger.FieldOverageLimit:
+		return m.AddedOverageLimit()
+	case ledger.FieldNextResetIndex:
+		return m.AddedNextResetIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LedgerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ledger.FieldOverageLimit:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOverageLimit(v)
+		return nil
+	case ledger.FieldNextResetIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNextResetIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Ledger numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LedgerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ledger.FieldMetadata) {
+		fields = append(fields, ledger.FieldMetadata)
+	}
+	if m.FieldCleared(ledger.FieldOverageLimit) {
+		fields = append(fields, ledger.FieldOverageLimit)
+	}
+	if m.FieldCleared(ledger.FieldFeatureOverageLimits) {
+		fields = append(fields, ledger.FieldFeatureOverageLimits)
+	}
+	if m.FieldCleared(ledger.FieldUsagePeriod) {
+		fields = append(fields, ledger.FieldUsagePeriod)
+	}
+	if m.FieldCleared(ledger.FieldUsagePeriodAnchor) {
+		fields = append(fields, ledger.FieldUsagePeriodAnchor)
+	}
+	if m.FieldCleared(ledger.FieldNextResetAt) {
+		fields = append(fields, ledger.FieldNextResetAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LedgerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LedgerMutation) ClearField(name string) error {
+	switch name {
+	case ledger.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case ledger.FieldOverageLimit:
+		m.ClearOverageLimit()
+		return nil
+	case ledger.FieldFeatureOverageLimits:
+		m.ClearFeatureOverageLimits()
+		return nil
+	case ledger.FieldUsagePeriod:
+		m.ClearUsagePeriod()
+		return nil
+	case ledger.FieldUsagePeriodAnchor:
+		m.ClearUsagePeriodAnchor()
+		return nil
+	case ledger.FieldNextResetAt:
+		m.ClearNextResetAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Ledger nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LedgerMutation) ResetField(name string) error {
+	switch name {
+	case ledger.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case ledger.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case ledger.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case ledger.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case ledger.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case ledger.FieldOverageLimit:
+		m.ResetOverageLimit()
+		return nil
+	case ledger.FieldFeatureOverageLimits:
+		m.ResetFeatureOverageLimits()
+		return nil
+	case ledger.FieldUsagePeriod:
+		m.ResetUsagePeriod()
+		return nil
+	case ledger.FieldUsagePeriodAnchor:
+		m.ResetUsagePeriodAnchor()
+		return nil
+	case ledger.FieldNextResetIndex:
+		m.ResetNextResetIndex()
+		return nil
+	case ledger.FieldNextResetAt:
+		m.ResetNextResetAt()
+		return nil
+	case ledger.FieldHighwatermark:
+		m.ResetHighwatermark()
+		return nil
+	}
+	return fmt.Errorf("unknown Ledger field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LedgerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LedgerMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LedgerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LedgerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LedgerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LedgerMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LedgerMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Ledger unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LedgerMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Ledger edge %s", name)
+}
+
+// NotificationDeliveryMutation represents an operation that mutates the NotificationDelivery nodes in the graph.
+type NotificationDeliveryMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	namespace           *string
+	rule_id             *string
+	ledger_id           *string
+	url                 *string
+	event               *credit.NotificationEvent
+	status              *credit.NotificationDeliveryStatus
+	attempts            *int
+	addattempts         *int
+	next_attempt_at     *time.Time
+	last_attempt_at     *time.Time
+	last_status_code    *int
+	addlast_status_code *int
+	last_error          *string
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*NotificationDelivery, error)
+	predicates          []predicate.NotificationDelivery
+}
+
+var _ ent.Mutation = (*NotificationDeliveryMutation)(nil)
+
+// notificationdeliveryOption allows management of the mutation configuration using functional options.
+type notificationdeliveryOption func(*NotificationDeliveryMutation)
+
+// newNotificationDeliveryMutation creates new mutation for the NotificationDelivery entity.
+func newNotificationDeliveryMutation(c config, op Op, opts ...notificationdeliveryOption) *NotificationDeliveryMutation {
+	m := &NotificationDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotificationDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationDeliveryID sets the ID field of the mutation.
+func withNotificationDeliveryID(id string) notificationdeliveryOption {
+	return func(m *NotificationDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NotificationDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*NotificationDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NotificationDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotificationDelivery sets the old NotificationDelivery of the mutation.
+func withNotificationDelivery(node *NotificationDelivery) notificationdeliveryOption {
+	return func(m *NotificationDeliveryMutation) {
+		m.oldValue = func(context.Context) (*NotificationDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("db: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NotificationDelivery entities.
+func (m *NotificationDeliveryMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationDeliveryMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationDeliveryMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NotificationDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpirationPeriodDuration returns the old "expiration_period_duration" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldExpirationPeriodDuration(ctx context.Context) (v *credit.ExpirationPeriodDuration, err error) {
+func (m *NotificationDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpirationPeriodDuration is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpirationPeriodDuration requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpirationPeriodDuration: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ExpirationPeriodDuration, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearExpirationPeriodDuration clears the value of the "expiration_period_duration" field.
-func (m *CreditEntryMutation) ClearExpirationPeriodDuration() {
-	m.expiration_period_duration = nil
-	m.clearedFields[creditentry.FieldExpirationPeriodDuration] = struct{}{}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ExpirationPeriodDurationCleared returns if the "expiration_period_duration" field was cleared in this mutation.
-func (m *CreditEntryMutation) ExpirationPeriodDurationCleared() bool {
-	_, ok := m.clearedFields[creditentry.FieldExpirationPeriodDuration]
-	return ok
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationDeliveryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ResetExpirationPeriodDuration resets all changes to the "expiration_period_duration" field.
-func (m *CreditEntryMutation) ResetExpirationPeriodDuration() {
-	m.expiration_period_duration = nil
-	delete(m.clearedFields, creditentry.FieldExpirationPeriodDuration)
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetExpirationPeriodCount sets the "expiration_period_count" field.
-func (m *CreditEntryMutation) SetExpirationPeriodCount(u uint8) {
-	m.expiration_period_count = &u
-	m.addexpiration_period_count = nil
+// OldUpdatedAt returns the old "updated_at" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ExpirationPeriodCount returns the value of the "expiration_period_count" field in the mutation.
-func (m *CreditEntryMutation) ExpirationPeriodCount() (r uint8, exists bool) {
-	v := m.expiration_period_count
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationDeliveryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *NotificationDeliveryMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *NotificationDeliveryMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpirationPeriodCount returns the old "expiration_period_count" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldNamespace returns the old "namespace" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldExpirationPeriodCount(ctx context.Context) (v *uint8, err error) {
+func (m *NotificationDeliveryMutation) OldNamespace(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpirationPeriodCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpirationPeriodCount requires an ID field in the mutation")
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpirationPeriodCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
 	}
-	return oldValue.ExpirationPeriodCount, nil
+	return oldValue.Namespace, nil
 }
 
-// AddExpirationPeriodCount adds u to the "expiration_period_count" field.
-func (m *CreditEntryMutation) AddExpirationPeriodCount(u int8) {
-	if m.addexpiration_period_count != nil {
-		*m.addexpiration_period_count += u
-	} else {
-		m.addexpiration_period_count = &u
-	}
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *NotificationDeliveryMutation) ResetNamespace() {
+	m.namespace = nil
 }
 
-// AddedExpirationPeriodCount returns the value that was added to the "expiration_period_count" field in this mutation.
-func (m *CreditEntryMutation) AddedExpirationPeriodCount() (r int8, exists bool) {
-	v := m.addexpiration_period_count
+// SetRuleID sets the "rule_id" field.
+func (m *NotificationDeliveryMutation) SetRuleID(s string) {
+	m.rule_id = &s
+}
+
+// RuleID returns the value of the "rule_id" field in the mutation.
+func (m *NotificationDeliveryMutation) RuleID() (r string, exists bool) {
+	v := m.rule_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearExpirationPeriodCount clears the value of the "expiration_period_count" field.
-func (m *CreditEntryMutation) ClearExpirationPeriodCount() {
-	m.expiration_period_count = nil
-	m.addexpiration_period_count = nil
-	m.clearedFields[creditentry.FieldExpirationPeriodCount] = struct{}{}
+// OldRuleID returns the old "rule_id" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldRuleID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleID: %w", err)
+	}
+	return oldValue.RuleID, nil
 }
 
-// ExpirationPeriodCountCleared returns if the "expiration_period_count" field was cleared in this mutation.
-func (m *CreditEntryMutation) ExpirationPeriodCountCleared() bool {
-	_, ok := m.clearedFields[creditentry.FieldExpirationPeriodCount]
-	return ok
+// ResetRuleID resets all changes to the "rule_id" field.
+func (m *NotificationDeliveryMutation) ResetRuleID() {
+	m.rule_id = nil
 }
 
-// ResetExpirationPeriodCount resets all changes to the "expiration_period_count" field.
-func (m *CreditEntryMutation) ResetExpirationPeriodCount() {
-	m.expiration_period_count = nil
-	m.addexpiration_period_count = nil
-	delete(m.clearedFields, creditentry.FieldExpirationPeriodCount)
+// SetLedgerID sets the "ledger_id" field.
+func (m *NotificationDeliveryMutation) SetLedgerID(s string) {
+	m.ledger_id = &s
 }
 
-// SetExpirationAt sets the "expiration_at" field.
-func (m *CreditEntryMutation) SetExpirationAt(t time.Time) {
-	m.expiration_at = &t
+// LedgerID returns the value of the "ledger_id" field in the mutation.
+func (m *NotificationDeliveryMutation) LedgerID() (r string, exists bool) {
+	v := m.ledger_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ExpirationAt returns the value of the "expiration_at" field in the mutation.
-func (m *CreditEntryMutation) ExpirationAt() (r time.Time, exists bool) {
-	v := m.expiration_at
+// OldLedgerID returns the old "ledger_id" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldLedgerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLedgerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLedgerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLedgerID: %w", err)
+	}
+	return oldValue.LedgerID, nil
+}
+
+// ResetLedgerID resets all changes to the "ledger_id" field.
+func (m *NotificationDeliveryMutation) ResetLedgerID() {
+	m.ledger_id = nil
+}
+
+// SetURL sets the "url" field.
+func (m *NotificationDeliveryMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *NotificationDeliveryMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpirationAt returns the old "expiration_at" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldExpirationAt(ctx context.Context) (v *time.Time, err error) {
+func (m *NotificationDeliveryMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpirationAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpirationAt requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpirationAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.ExpirationAt, nil
+	return oldValue.URL, nil
 }
 
-// ClearExpirationAt clears the value of the "expiration_at" field.
-func (m *CreditEntryMutation) ClearExpirationAt() {
-	m.expiration_at = nil
-	m.clearedFields[creditentry.FieldExpirationAt] = struct{}{}
+// ResetURL resets all changes to the "url" field.
+func (m *NotificationDeliveryMutation) ResetURL() {
+	m.url = nil
 }
 
-// ExpirationAtCleared returns if the "expiration_at" field was cleared in this mutation.
-func (m *CreditEntryMutation) ExpirationAtCleared() bool {
-	_, ok := m.clearedFields[creditentry.FieldExpirationAt]
-	return ok
+// SetEvent sets the "event" field.
+func (m *NotificationDeliveryMutation) SetEvent(ce credit.NotificationEvent) {
+	m.event = &ce
 }
 
-// ResetExpirationAt resets all changes to the "expiration_at" field.
-func (m *CreditEntryMutation) ResetExpirationAt() {
-	m.expiration_at = nil
-	delete(m.clearedFields, creditentry.FieldExpirationAt)
+// Event returns the value of the "event" field in the mutation.
+func (m *NotificationDeliveryMutation) Event() (r credit.NotificationEvent, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvent returns the old "event" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldEvent(ctx context.Context) (v credit.NotificationEvent, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
 }
 
-// SetRolloverType sets the "rollover_type" field.
-func (m *CreditEntryMutation) SetRolloverType(crt credit.GrantRolloverType) {
-	m.rollover_type = &crt
+// ResetEvent resets all changes to the "event" field.
+func (m *NotificationDeliveryMutation) ResetEvent() {
+	m.event = nil
 }
 
-// RolloverType returns the value of the "rollover_type" field in the mutation.
-func (m *CreditEntryMutation) RolloverType() (r credit.GrantRolloverType, exists bool) {
-	v := m.rollover_type
+// SetStatus sets the "status" field.
+func (m *NotificationDeliveryMutation) SetStatus(cds credit.NotificationDeliveryStatus) {
+	m.status = &cds
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *NotificationDeliveryMutation) Status() (r credit.NotificationDeliveryStatus, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRolloverType returns the old "rollover_type" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldRolloverType(ctx context.Context) (v *credit.GrantRolloverType, err error) {
+func (m *NotificationDeliveryMutation) OldStatus(ctx context.Context) (v credit.NotificationDeliveryStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRolloverType is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRolloverType requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRolloverType: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.RolloverType, nil
-}
-
-// ClearRolloverType clears the value of the "rollover_type" field.
-func (m *CreditEntryMutation) ClearRolloverType() {
-	m.rollover_type = nil
-	m.clearedFields[creditentry.FieldRolloverType] = struct{}{}
-}
-
-// RolloverTypeCleared returns if the "rollover_type" field was cleared in this mutation.
-func (m *CreditEntryMutation) RolloverTypeCleared() bool {
-	_, ok := m.clearedFields[creditentry.FieldRolloverType]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetRolloverType resets all changes to the "rollover_type" field.
-func (m *CreditEntryMutation) ResetRolloverType() {
-	m.rollover_type = nil
-	delete(m.clearedFields, creditentry.FieldRolloverType)
+// ResetStatus resets all changes to the "status" field.
+func (m *NotificationDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetRolloverMaxAmount sets the "rollover_max_amount" field.
-func (m *CreditEntryMutation) SetRolloverMaxAmount(f float64) {
-	m.rollover_max_amount = &f
-	m.addrollover_max_amount = nil
+// SetAttempts sets the "attempts" field.
+func (m *NotificationDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// RolloverMaxAmount returns the value of the "rollover_max_amount" field in the mutation.
-func (m *CreditEntryMutation) RolloverMaxAmount() (r float64, exists bool) {
-	v := m.rollover_max_amount
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *NotificationDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRolloverMaxAmount returns the old "rollover_max_amount" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldRolloverMaxAmount(ctx context.Context) (v *float64, err error) {
+func (m *NotificationDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRolloverMaxAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRolloverMaxAmount requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRolloverMaxAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.RolloverMaxAmount, nil
+	return oldValue.Attempts, nil
 }
 
-// AddRolloverMaxAmount adds f to the "rollover_max_amount" field.
-func (m *CreditEntryMutation) AddRolloverMaxAmount(f float64) {
-	if m.addrollover_max_amount != nil {
-		*m.addrollover_max_amount += f
+// AddAttempts adds i to the "attempts" field.
+func (m *NotificationDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
 	} else {
-		m.addrollover_max_amount = &f
+		m.addattempts = &i
 	}
 }
 
-// AddedRolloverMaxAmount returns the value that was added to the "rollover_max_amount" field in this mutation.
-func (m *CreditEntryMutation) AddedRolloverMaxAmount() (r float64, exists bool) {
-	v := m.addrollover_max_amount
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *NotificationDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearRolloverMaxAmount clears the value of the "rollover_max_amount" field.
-func (m *CreditEntryMutation) ClearRolloverMaxAmount() {
-	m.rollover_max_amount = nil
-	m.addrollover_max_amount = nil
-	m.clearedFields[creditentry.FieldRolloverMaxAmount] = struct{}{}
-}
-
-// RolloverMaxAmountCleared returns if the "rollover_max_amount" field was cleared in this mutation.
-func (m *CreditEntryMutation) RolloverMaxAmountCleared() bool {
-	_, ok := m.clearedFields[creditentry.FieldRolloverMaxAmount]
-	return ok
-}
-
-// ResetRolloverMaxAmount resets all changes to the "rollover_max_amount" field.
-func (m *CreditEntryMutation) ResetRolloverMaxAmount() {
-	m.rollover_max_amount = nil
-	m.addrollover_max_amount = nil
-	delete(m.clearedFields, creditentry.FieldRolloverMaxAmount)
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *NotificationDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *CreditEntryMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *NotificationDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *CreditEntryMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *NotificationDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *NotificationDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.NextAttemptAt, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *CreditEntryMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[creditentry.FieldMetadata] = struct{}{}
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *NotificationDeliveryMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[notificationdelivery.FieldNextAttemptAt] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *CreditEntryMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[creditentry.FieldMetadata]
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldNextAttemptAt]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *CreditEntryMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, creditentry.FieldMetadata)
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *NotificationDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, notificationdelivery.FieldNextAttemptAt)
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *CreditEntryMutation) SetParentID(s string) {
-	m.parent = &s
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (m *NotificationDeliveryMutation) SetLastAttemptAt(t time.Time) {
+	m.last_attempt_at = &t
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *CreditEntryMutation) ParentID() (r string, exists bool) {
-	v := m.parent
+// LastAttemptAt returns the value of the "last_attempt_at" field in the mutation.
+func (m *NotificationDeliveryMutation) LastAttemptAt() (r time.Time, exists bool) {
+	v := m.last_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentID returns the old "parent_id" field's value of the CreditEntry entity.
-// If the CreditEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldLastAttemptAt returns the old "last_attempt_at" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CreditEntryMutation) OldParentID(ctx context.Context) (v *string, err error) {
+func (m *NotificationDeliveryMutation) OldLastAttemptAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
+		return v, errors.New("OldLastAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastAttemptAt: %w", err)
 	}
-	return oldValue.ParentID, nil
+	return oldValue.LastAttemptAt, nil
 }
 
-// ClearParentID clears the value of the "parent_id" field.
-func (m *CreditEntryMutation) ClearParentID() {
-	m.parent = nil
-	m.clearedFields[creditentry.FieldParentID] = struct{}{}
+// ClearLastAttemptAt clears the value of the "last_attempt_at" field.
+func (m *NotificationDeliveryMutation) ClearLastAttemptAt() {
+	m.last_attempt_at = nil
+	m.clearedFields[notificationdelivery.FieldLastAttemptAt] = struct{}{}
 }
 
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *CreditEntryMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[creditentry.FieldParentID]
+// LastAttemptAtCleared returns if the "last_attempt_at" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) LastAttemptAtCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldLastAttemptAt]
 	return ok
 }
 
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *CreditEntryMutation) ResetParentID() {
-	m.parent = nil
-	delete(m.clearedFields, creditentry.FieldParentID)
+// ResetLastAttemptAt resets all changes to the "last_attempt_at" field.
+func (m *NotificationDeliveryMutation) ResetLastAttemptAt() {
+	m.last_attempt_at = nil
+	delete(m.clearedFields, notificationdelivery.FieldLastAttemptAt)
 }
 
-// ClearParent clears the "parent" edge to the CreditEntry entity.
-func (m *CreditEntryMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[creditentry.FieldParentID] = struct{}{}
+// SetLastStatusCode sets the "last_status_code" field.
+func (m *NotificationDeliveryMutation) SetLastStatusCode(i int) {
+	m.last_status_code = &i
+	m.addlast_status_code = nil
 }
 
-// ParentCleared reports if the "parent" edge to the CreditEntry entity was cleared.
-func (m *CreditEntryMutation) ParentCleared() bool {
-	return m.ParentIDCleared() || m.clearedparent
+// LastStatusCode returns the value of the "last_status_code" field in the mutation.
+func (m *NotificationDeliveryMutation) LastStatusCode() (r int, exists bool) {
+	v := m.last_status_code
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *CreditEntryMutation) ParentIDs() (ids []string) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
+// OldLastStatusCode returns the old "last_status_code" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldLastStatusCode(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastStatusCode is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastStatusCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastStatusCode: %w", err)
+	}
+	return oldValue.LastStatusCode, nil
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *CreditEntryMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// AddLastStatusCode adds i to the "last_status_code" field.
+func (m *NotificationDeliveryMutation) AddLastStatusCode(i int) {
+	if m.addlast_status_code != nil {
+		*m.addlast_status_code += i
+	} else {
+		m.addlast_status_code = &i
+	}
 }
 
-// SetChildrenID sets the "children" edge to the CreditEntry entity by id.
-func (m *CreditEntryMutation) SetChildrenID(id string) {
-	m.children = &id
+// AddedLastStatusCode returns the value that was added to the "last_status_code" field in this mutation.
+func (m *NotificationDeliveryMutation) AddedLastStatusCode() (r int, exists bool) {
+	v := m.addlast_status_code
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearChildren clears the "children" edge to the CreditEntry entity.
-func (m *CreditEntryMutation) ClearChildren() {
-	m.clearedchildren = true
+// ClearLastStatusCode clears the value of the "last_status_code" field.
+func (m *NotificationDeliveryMutation) ClearLastStatusCode() {
+	m.last_status_code = nil
+	m.addlast_status_code = nil
+	m.clearedFields[notificationdelivery.FieldLastStatusCode] = struct{}{}
 }
 
-// ChildrenCleared reports if the "children" edge to the CreditEntry entity was cleared.
-func (m *CreditEntryMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// LastStatusCodeCleared returns if the "last_status_code" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) LastStatusCodeCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldLastStatusCode]
+	return ok
 }
 
-// ChildrenID returns the "children" edge ID in the mutation.
-func (m *CreditEntryMutation) ChildrenID() (id string, exists bool) {
-	if m.children != nil {
-		return *m.children, true
-	}
-	return
+// ResetLastStatusCode resets all changes to the "last_status_code" field.
+func (m *NotificationDeliveryMutation) ResetLastStatusCode() {
+	m.last_status_code = nil
+	m.addlast_status_code = nil
+	delete(m.clearedFields, notificationdelivery.FieldLastStatusCode)
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChildrenID instead. It exists only for internal usage by the builders.
-func (m *CreditEntryMutation) ChildrenIDs() (ids []string) {
-	if id := m.children; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetLastError sets the "last_error" field.
+func (m *NotificationDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *CreditEntryMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *NotificationDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearFeature clears the "feature" edge to the Feature entity.
-func (m *CreditEntryMutation) ClearFeature() {
-	m.clearedfeature = true
-	m.clearedFields[creditentry.FieldFeatureID] = struct{}{}
+// OldLastError returns the old "last_error" field's value of the NotificationDelivery entity.
+// If the NotificationDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationDeliveryMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
 }
 
-// FeatureCleared reports if the "feature" edge to the Feature entity was cleared.
-func (m *CreditEntryMutation) FeatureCleared() bool {
-	return m.FeatureIDCleared() || m.clearedfeature
+// ClearLastError clears the value of the "last_error" field.
+func (m *NotificationDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[notificationdelivery.FieldLastError] = struct{}{}
 }
 
-// FeatureIDs returns the "feature" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FeatureID instead. It exists only for internal usage by the builders.
-func (m *CreditEntryMutation) FeatureIDs() (ids []string) {
-	if id := m.feature; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *NotificationDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[notificationdelivery.FieldLastError]
+	return ok
 }
 
-// ResetFeature resets all changes to the "feature" edge.
-func (m *CreditEntryMutation) ResetFeature() {
-	m.feature = nil
-	m.clearedfeature = false
+// ResetLastError resets all changes to the "last_error" field.
+func (m *NotificationDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, notificationdelivery.FieldLastError)
 }
 
-// Where appends a list predicates to the CreditEntryMutation builder.
-func (m *CreditEntryMutation) Where(ps ...predicate.CreditEntry) {
+// Where appends a list predicates to the NotificationDeliveryMutation builder.
+func (m *NotificationDeliveryMutation) Where(ps ...predicate.NotificationDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CreditEntryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NotificationDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CreditEntryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CreditEntry, len(ps))
+func (m *NotificationDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1107,75 +7637,63 @@ func (m *CreditEntryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CreditEntryMutation) Op() Op {
+func (m *NotificationDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CreditEntryMutation) SetOp(op Op) {
+func (m *NotificationDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CreditEntry).
-func (m *CreditEntryMutation) Type() string {
+// Type returns the node type of this mutation (NotificationDelivery).
+func (m *NotificationDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CreditEntryMutation) Fields() []string {
-	fields := make([]string, 0, 17)
+func (m *NotificationDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 13)
 	if m.created_at != nil {
-		fields = append(fields, creditentry.FieldCreatedAt)
+		fields = append(fields, notificationdelivery.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, creditentry.FieldUpdatedAt)
+		fields = append(fields, notificationdelivery.FieldUpdatedAt)
 	}
 	if m.namespace != nil {
-		fields = append(fields, creditentry.FieldNamespace)
-	}
-	if m.ledger_id != nil {
-		fields = append(fields, creditentry.FieldLedgerID)
-	}
-	if m.entry_type != nil {
-		fields = append(fields, creditentry.FieldEntryType)
-	}
-	if m._type != nil {
-		fields = append(fields, creditentry.FieldType)
-	}
-	if m.feature != nil {
-		fields = append(fields, creditentry.FieldFeatureID)
+		fields = append(fields, notificationdelivery.FieldNamespace)
 	}
-	if m.amount != nil {
-		fields = append(fields, creditentry.FieldAmount)
+	if m.rule_id != nil {
+		fields = append(fields, notificationdelivery.FieldRuleID)
 	}
-	if m.priority != nil {
-		fields = append(fields, creditentry.FieldPriority)
+	if m.ledger_id != nil {
+		fields = append(fields, notificationdelivery.FieldLedgerID)
 	}
-	if m.effective_at != nil {
-		fields = append(fields, creditentry.FieldEffectiveAt)
+	if m.url != nil {
+		fields = append(fields, notificationdelivery.FieldURL)
 	}
-	if m.expiration_period_duration != nil {
-		fields = append(fields, creditentry.FieldExpirationPeriodDuration)
+	if m.event != nil {
+		fields = append(fields, notificationdelivery.FieldEvent)
 	}
-	if m.expiration_period_count != nil {
-		fields = append(fields, creditentry.FieldExpirationPeriodCount)
+	if m.status != nil {
+		fields = append(fields, notificationdelivery.FieldStatus)
 	}
-	if m.expiration_at != nil {
-		fields = append(fields, creditentry.FieldExpirationAt)
+	if m.attempts != nil {
+		fields = append(fields, notificationdelivery.FieldAttempts)
 	}
-	if m.rollover_type != nil {
-		fields = append(fields, creditentry.FieldRolloverType)
+	if m.next_attempt_at != nil {
+		fields = append(fields, notificationdelivery.FieldNextAttemptAt)
 	}
-	if m.rollover_max_amount != nil {
-		fields = append(fields, creditentry.FieldRolloverMaxAmount)
+	if m.last_attempt_at != nil {
+		fields = append(fields, notificationdelivery.FieldLastAttemptAt)
 	}
-	if m.metadata != nil {
-		fields = append(fields, creditentry.FieldMetadata)
+	if m.last_status_code != nil {
+		fields = append(fields, notificationdelivery.FieldLastStatusCode)
 	}
-	if m.parent != nil {
-		fields = append(fields, creditentry.FieldParentID)
+	if m.last_error != nil {
+		fields = append(fields, notificationdelivery.FieldLastError)
 	}
 	return fields
 }
@@ -1183,42 +7701,34 @@ func (m *CreditEntryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CreditEntryMutation) Field(name string) (ent.Value, bool) {
+func (m *NotificationDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case creditentry.FieldCreatedAt:
+	case notificationdelivery.FieldCreatedAt:
 		return m.CreatedAt()
-	case creditentry.FieldUpdatedAt:
+	case notificationdelivery.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case creditentry.FieldNamespace:
+	case notificationdelivery.FieldNamespace:
 		return m.Namespace()
-	case creditentry.FieldLedgerID:
+	case notificationdelivery.FieldRuleID:
+		return m.RuleID()
+	case notificationdelivery.FieldLedgerID:
 		return m.LedgerID()
-	case creditentry.FieldEntryType:
-		return m.EntryType()
-	case creditentry.FieldType:
-		return m.GetType()
-	case creditentry.FieldFeatureID:
-		return m.FeatureID()
-	case creditentry.FieldAmount:
-		return m.Amount()
-	case creditentry.FieldPriority:
-		return m.Priority()
-	case creditentry.FieldEffectiveAt:
-		return m.EffectiveAt()
-	case creditentry.FieldExpirationPeriodDuration:
-		return m.ExpirationPeriodDuration()
-	case creditentry.FieldExpirationPeriodCount:
-		return m.ExpirationPeriodCount()
-	case creditentry.FieldExpirationAt:
-		return m.ExpirationAt()
-	case creditentry.FieldRolloverType:
-		return m.RolloverType()
-	case creditentry.FieldRolloverMaxAmount:
-		return m.RolloverMaxAmount()
-	case creditentry.FieldMetadata:
-		return m.Metadata()
-	case creditentry.FieldParentID:
-		return m.ParentID()
+	case notificationdelivery.FieldURL:
+		return m.URL()
+	case notificationdelivery.FieldEvent:
+		return m.Event()
+	case notificationdelivery.FieldStatus:
+		return m.Status()
+	case notificationdelivery.FieldAttempts:
+		return m.Attempts()
+	case notificationdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case notificationdelivery.FieldLastAttemptAt:
+		return m.LastAttemptAt()
+	case notificationdelivery.FieldLastStatusCode:
+		return m.LastStatusCode()
+	case notificationdelivery.FieldLastError:
+		return m.LastError()
 	}
 	return nil, false
 }
@@ -1226,189 +7736,147 @@ func (m *CreditEntryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CreditEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NotificationDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case creditentry.FieldCreatedAt:
+	case notificationdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case creditentry.FieldUpdatedAt:
+	case notificationdelivery.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case creditentry.FieldNamespace:
+	case notificationdelivery.FieldNamespace:
 		return m.OldNamespace(ctx)
-	case creditentry.FieldLedgerID:
+	case notificationdelivery.FieldRuleID:
+		return m.OldRuleID(ctx)
+	case notificationdelivery.FieldLedgerID:
 		return m.OldLedgerID(ctx)
-	case creditentry.FieldEntryType:
-		return m.OldEntryType(ctx)
-	case creditentry.FieldType:
-		return m.OldType(ctx)
-	case creditentry.FieldFeatureID:
-		return m.OldFeatureID(ctx)
-	case creditentry.FieldAmount:
-		return m.OldAmount(ctx)
-	case creditentry.FieldPriority:
-		return m.OldPriority(ctx)
-	case creditentry.FieldEffectiveAt:
-		return m.OldEffectiveAt(ctx)
-	case creditentry.FieldExpirationPeriodDuration:
-		return m.OldExpirationPeriodDuration(ctx)
-	case creditentry.FieldExpirationPeriodCount:
-		return m.OldExpirationPeriodCount(ctx)
-	case creditentry.FieldExpirationAt:
-		return m.OldExpirationAt(ctx)
-	case creditentry.FieldRolloverType:
-		return m.OldRolloverType(ctx)
-	case creditentry.FieldRolloverMaxAmount:
-		return m.OldRolloverMaxAmount(ctx)
-	case creditentry.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case creditentry.FieldParentID:
-		return m.OldParentID(ctx)
-	}
-	return nil, fmt.Errorf("unknown CreditEntry field %s", name)
+	case notificationdelivery.FieldURL:
+		return m.OldURL(ctx)
+	case notificationdelivery.FieldEvent:
+		return m.OldEvent(ctx)
+	case notificationdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case notificationdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case notificationdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case notificationdelivery.FieldLastAttemptAt:
+		return m.OldLastAttemptAt(ctx)
+	case notificationdelivery.FieldLastStatusCode:
+		return m.OldLastStatusCode(ctx)
+	case notificationdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	}
+	return nil, fmt.Errorf("unknown NotificationDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CreditEntryMutation) SetField(name string, value ent.Value) error {
+func (m *NotificationDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case creditentry.FieldCreatedAt:
+	case notificationdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case creditentry.FieldUpdatedAt:
+	case notificationdelivery.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case creditentry.FieldNamespace:
+	case notificationdelivery.FieldNamespace:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetNamespace(v)
 		return nil
-	case creditentry.FieldLedgerID:
+	case notificationdelivery.FieldRuleID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLedgerID(v)
-		return nil
-	case creditentry.FieldEntryType:
-		v, ok := value.(credit.EntryType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEntryType(v)
-		return nil
-	case creditentry.FieldType:
-		v, ok := value.(credit.GrantType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
+		m.SetRuleID(v)
 		return nil
-	case creditentry.FieldFeatureID:
+	case notificationdelivery.FieldLedgerID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFeatureID(v)
-		return nil
-	case creditentry.FieldAmount:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAmount(v)
+		m.SetLedgerID(v)
 		return nil
-	case creditentry.FieldPriority:
-		v, ok := value.(uint8)
+	case notificationdelivery.FieldURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPriority(v)
+		m.SetURL(v)
 		return nil
-	case creditentry.FieldEffectiveAt:
-		v, ok := value.(time.Time)
+	case notificationdelivery.FieldEvent:
+		v, ok := value.(credit.NotificationEvent)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEffectiveAt(v)
+		m.SetEvent(v)
 		return nil
-	case creditentry.FieldExpirationPeriodDuration:
-		v, ok := value.(credit.ExpirationPeriodDuration)
+	case notificationdelivery.FieldStatus:
+		v, ok := value.(credit.NotificationDeliveryStatus)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpirationPeriodDuration(v)
+		m.SetStatus(v)
 		return nil
-	case creditentry.FieldExpirationPeriodCount:
-		v, ok := value.(uint8)
+	case notificationdelivery.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpirationPeriodCount(v)
+		m.SetAttempts(v)
 		return nil
-	case creditentry.FieldExpirationAt:
+	case notificationdelivery.FieldNextAttemptAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpirationAt(v)
-		return nil
-	case creditentry.FieldRolloverType:
-		v, ok := value.(credit.GrantRolloverType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRolloverType(v)
+		m.SetNextAttemptAt(v)
 		return nil
-	case creditentry.FieldRolloverMaxAmount:
-		v, ok := value.(float64)
+	case notificationdelivery.FieldLastAttemptAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRolloverMaxAmount(v)
+		m.SetLastAttemptAt(v)
 		return nil
-	case creditentry.FieldMetadata:
-		v, ok := value.(map[string]string)
+	case notificationdelivery.FieldLastStatusCode:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetLastStatusCode(v)
 		return nil
-	case creditentry.FieldParentID:
+	case notificationdelivery.FieldLastError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentID(v)
+		m.SetLastError(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CreditEntry field %s", name)
+	return fmt.Errorf("unknown NotificationDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CreditEntryMutation) AddedFields() []string {
+func (m *NotificationDeliveryMutation) AddedFields() []string {
 	var fields []string
-	if m.addamount != nil {
-		fields = append(fields, creditentry.FieldAmount)
-	}
-	if m.addpriority != nil {
-		fields = append(fields, creditentry.FieldPriority)
-	}
-	if m.addexpiration_period_count != nil {
-		fields = append(fields, creditentry.FieldExpirationPeriodCount)
+	if m.addattempts != nil {
+		fields = append(fields, notificationdelivery.FieldAttempts)
 	}
-	if m.addrollover_max_amount != nil {
-		fields = append(fields, creditentry.FieldRolloverMaxAmount)
+	if m.addlast_status_code != nil {
+		fields = append(fields, notificationdelivery.FieldLastStatusCode)
 	}
 	return fields
 }
@@ -1416,16 +7884,12 @@ func (m *CreditEntryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CreditEntryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NotificationDeliveryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case creditentry.FieldAmount:
-		return m.AddedAmount()
-	case creditentry.FieldPriority:
-		return m.AddedPriority()
-	case creditentry.FieldExpirationPeriodCount:
-		return m.AddedExpirationPeriodCount()
-	case creditentry.FieldRolloverMaxAmount:
-		return m.AddedRolloverMaxAmount()
+	case notificationdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case notificationdelivery.FieldLastStatusCode:
+		return m.AddedLastStatusCode()
 	}
 	return nil, false
 }
@@ -1433,324 +7897,201 @@ func (m *CreditEntryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CreditEntryMutation) AddField(name string, value ent.Value) error {
+func (m *NotificationDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case creditentry.FieldAmount:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAmount(v)
-		return nil
-	case creditentry.FieldPriority:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPriority(v)
-		return nil
-	case creditentry.FieldExpirationPeriodCount:
-		v, ok := value.(int8)
+	case notificationdelivery.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddExpirationPeriodCount(v)
+		m.AddAttempts(v)
 		return nil
-	case creditentry.FieldRolloverMaxAmount:
-		v, ok := value.(float64)
+	case notificationdelivery.FieldLastStatusCode:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRolloverMaxAmount(v)
+		m.AddLastStatusCode(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CreditEntry numeric field %s", name)
+	return fmt.Errorf("unknown NotificationDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CreditEntryMutation) ClearedFields() []string {
+func (m *NotificationDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(creditentry.FieldType) {
-		fields = append(fields, creditentry.FieldType)
-	}
-	if m.FieldCleared(creditentry.FieldFeatureID) {
-		fields = append(fields, creditentry.FieldFeatureID)
-	}
-	if m.FieldCleared(creditentry.FieldAmount) {
-		fields = append(fields, creditentry.FieldAmount)
-	}
-	if m.FieldCleared(creditentry.FieldExpirationPeriodDuration) {
-		fields = append(fields, creditentry.FieldExpirationPeriodDuration)
-	}
-	if m.FieldCleared(creditentry.FieldExpirationPeriodCount) {
-		fields = append(fields, creditentry.FieldExpirationPeriodCount)
-	}
-	if m.FieldCleared(creditentry.FieldExpirationAt) {
-		fields = append(fields, creditentry.FieldExpirationAt)
-	}
-	if m.FieldCleared(creditentry.FieldRolloverType) {
-		fields = append(fields, creditentry.FieldRolloverType)
+	if m.FieldCleared(notificationdelivery.FieldNextAttemptAt) {
+		fields = append(fields, notificationdelivery.FieldNextAttemptAt)
 	}
-	if m.FieldCleared(creditentry.FieldRolloverMaxAmount) {
-		fields = append(fields, creditentry.FieldRolloverMaxAmount)
+	if m.FieldCleared(notificationdelivery.FieldLastAttemptAt) {
+		fields = append(fields, notificationdelivery.FieldLastAttemptAt)
 	}
-	if m.FieldCleared(creditentry.FieldMetadata) {
-		fields = append(fields, creditentry.FieldMetadata)
+	if m.FieldCleared(notificationdelivery.FieldLastStatusCode) {
+		fields = append(fields, notificationdelivery.FieldLastStatusCode)
 	}
-	if m.FieldCleared(creditentry.FieldParentID) {
-		fields = append(fields, creditentry.FieldParentID)
+	if m.FieldCleared(notificationdelivery.FieldLastError) {
+		fields = append(fields, notificationdelivery.FieldLastError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CreditEntryMutation) FieldCleared(name string) bool {
+func (m *NotificationDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CreditEntryMutation) ClearField(name string) error {
+func (m *NotificationDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case creditentry.FieldType:
-		m.ClearType()
-		return nil
-	case creditentry.FieldFeatureID:
-		m.ClearFeatureID()
-		return nil
-	case creditentry.FieldAmount:
-		m.ClearAmount()
-		return nil
-	case creditentry.FieldExpirationPeriodDuration:
-		m.ClearExpirationPeriodDuration()
-		return nil
-	case creditentry.FieldExpirationPeriodCount:
-		m.ClearExpirationPeriodCount()
+	case notificationdelivery.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
 		return nil
-	case creditentry.FieldExpirationAt:
-		m.ClearExpirationAt()
-		return nil
-	case creditentry.FieldRolloverType:
-		m.ClearRolloverType()
-		return nil
-	case creditentry.FieldRolloverMaxAmount:
-		m.ClearRolloverMaxAmount()
+	case notificationdelivery.FieldLastAttemptAt:
+		m.ClearLastAttemptAt()
 		return nil
-	case creditentry.FieldMetadata:
-		m.ClearMetadata()
+	case notificationdelivery.FieldLastStatusCode:
+		m.ClearLastStatusCode()
 		return nil
-	case creditentry.FieldParentID:
-		m.ClearParentID()
+	case notificationdelivery.FieldLastError:
+		m.ClearLastError()
 		return nil
 	}
-	return fmt.Errorf("unknown CreditEntry nullable field %s", name)
+	return fmt.Errorf("unknown NotificationDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CreditEntryMutation) ResetField(name string) error {
+func (m *NotificationDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case creditentry.FieldCreatedAt:
+	case notificationdelivery.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case creditentry.FieldUpdatedAt:
+	case notificationdelivery.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case creditentry.FieldNamespace:
+	case notificationdelivery.FieldNamespace:
 		m.ResetNamespace()
 		return nil
-	case creditentry.FieldLedgerID:
-		m.ResetLedgerID()
-		return nil
-	case creditentry.FieldEntryType:
-		m.ResetEntryType()
-		return nil
-	case creditentry.FieldType:
-		m.ResetType()
-		return nil
-	case creditentry.FieldFeatureID:
-		m.ResetFeatureID()
-		return nil
-	case creditentry.FieldAmount:
-		m.ResetAmount()
+	case notificationdelivery.FieldRuleID:
+		m.ResetRuleID()
 		return nil
-	case creditentry.FieldPriority:
-		m.ResetPriority()
+	case notificationdelivery.FieldLedgerID:
+		m.ResetLedgerID()
 		return nil
-	case creditentry.FieldEffectiveAt:
-		m.ResetEffectiveAt()
+	case notificationdelivery.FieldURL:
+		m.ResetURL()
 		return nil
-	case creditentry.FieldExpirationPeriodDuration:
-		m.ResetExpirationPeriodDuration()
+	case notificationdelivery.FieldEvent:
+		m.ResetEvent()
 		return nil
-	case creditentry.FieldExpirationPeriodCount:
-		m.ResetExpirationPeriodCount()
+	case notificationdelivery.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case creditentry.FieldExpirationAt:
-		m.ResetExpirationAt()
+	case notificationdelivery.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case creditentry.FieldRolloverType:
-		m.ResetRolloverType()
+	case notificationdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
 		return nil
-	case creditentry.FieldRolloverMaxAmount:
-		m.ResetRolloverMaxAmount()
+	case notificationdelivery.FieldLastAttemptAt:
+		m.ResetLastAttemptAt()
 		return nil
-	case creditentry.FieldMetadata:
-		m.ResetMetadata()
+	case notificationdelivery.FieldLastStatusCode:
+		m.ResetLastStatusCode()
 		return nil
-	case creditentry.FieldParentID:
-		m.ResetParentID()
+	case notificationdelivery.FieldLastError:
+		m.ResetLastError()
 		return nil
 	}
-	return fmt.Errorf("unknown CreditEntry field %s", name)
+	return fmt.Errorf("unknown NotificationDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CreditEntryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.parent != nil {
-		edges = append(edges, creditentry.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, creditentry.EdgeChildren)
-	}
-	if m.feature != nil {
-		edges = append(edges, creditentry.EdgeFeature)
-	}
+func (m *NotificationDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CreditEntryMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case creditentry.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case creditentry.EdgeChildren:
-		if id := m.children; id != nil {
-			return []ent.Value{*id}
-		}
-	case creditentry.EdgeFeature:
-		if id := m.feature; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *NotificationDeliveryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CreditEntryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *NotificationDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CreditEntryMutation) RemovedIDs(name string) []ent.Value {
+func (m *NotificationDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CreditEntryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedparent {
-		edges = append(edges, creditentry.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, creditentry.EdgeChildren)
-	}
-	if m.clearedfeature {
-		edges = append(edges, creditentry.EdgeFeature)
-	}
+func (m *NotificationDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CreditEntryMutation) EdgeCleared(name string) bool {
-	switch name {
-	case creditentry.EdgeParent:
-		return m.clearedparent
-	case creditentry.EdgeChildren:
-		return m.clearedchildren
-	case creditentry.EdgeFeature:
-		return m.clearedfeature
-	}
+func (m *NotificationDeliveryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CreditEntryMutation) ClearEdge(name string) error {
-	switch name {
-	case creditentry.EdgeParent:
-		m.ClearParent()
-		return nil
-	case creditentry.EdgeChildren:
-		m.ClearChildren()
-		return nil
-	case creditentry.EdgeFeature:
-		m.ClearFeature()
-		return nil
-	}
-	return fmt.Errorf("unknown CreditEntry unique edge %s", name)
+func (m *NotificationDeliveryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NotificationDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CreditEntryMutation) ResetEdge(name string) error {
-	switch name {
-	case creditentry.EdgeParent:
-		m.ResetParent()
-		return nil
-	case creditentry.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	case creditentry.EdgeFeature:
-		m.ResetFeature()
-		return nil
-	}
-	return fmt.Errorf("unknown CreditEntry edge %s", name)
+func (m *NotificationDeliveryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NotificationDelivery edge %s", name)
 }
 
-// FeatureMutation represents an operation that mutates the Feature nodes in the graph.
-type FeatureMutation struct {
+// NotificationRuleMutation represents an operation that mutates the NotificationRule nodes in the graph.
+type NotificationRuleMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *string
-	created_at             *time.Time
-	updated_at             *time.Time
-	namespace              *string
-	name                   *string
-	meter_slug             *string
-	meter_group_by_filters *map[string]string
-	archived               *bool
-	clearedFields          map[string]struct{}
-	credit_grants          map[string]struct{}
-	removedcredit_grants   map[string]struct{}
-	clearedcredit_grants   bool
-	done                   bool
-	oldValue               func(context.Context) (*Feature, error)
-	predicates             []predicate.Feature
+	op            Op
+	typ           string
+	id            *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	namespace     *string
+	ledger_id     *string
+	feature_id    *string
+	_type         *credit.NotificationRuleType
+	threshold     *float64
+	addthreshold  *float64
+	url           *string
+	triggered     *bool
+	triggered_at  *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*NotificationRule, error)
+	predicates    []predicate.NotificationRule
 }
 
-var _ ent.Mutation = (*FeatureMutation)(nil)
+var _ ent.Mutation = (*NotificationRuleMutation)(nil)
 
-// featureOption allows management of the mutation configuration using functional options.
-type featureOption func(*FeatureMutation)
+// notificationruleOption allows management of the mutation configuration using functional options.
+type notificationruleOption func(*NotificationRuleMutation)
 
-// newFeatureMutation creates new mutation for the Feature entity.
-func newFeatureMutation(c config, op Op, opts ...featureOption) *FeatureMutation {
-	m := &FeatureMutation{
+// newNotificationRuleMutation creates new mutation for the NotificationRule entity.
+func newNotificationRuleMutation(c config, op Op, opts ...notificationruleOption) *NotificationRuleMutation {
+	m := &NotificationRuleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFeature,
+		typ:           TypeNotificationRule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1759,20 +8100,20 @@ func newFeatureMutation(c config, op Op, opts ...featureOption) *FeatureMutation
 	return m
 }
 
-// withFeatureID sets the ID field of the mutation.
-func withFeatureID(id string) featureOption {
-	return func(m *FeatureMutation) {
+// withNotificationRuleID sets the ID field of the mutation.
+func withNotificationRuleID(id string) notificationruleOption {
+	return func(m *NotificationRuleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Feature
+			value *NotificationRule
 		)
-		m.oldValue = func(ctx context.Context) (*Feature, error) {
+		m.oldValue = func(ctx context.Context) (*NotificationRule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Feature.Get(ctx, id)
+					value, err = m.Client().NotificationRule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1781,10 +8122,10 @@ func withFeatureID(id string) featureOption {
 	}
 }
 
-// withFeature sets the old Feature of the mutation.
-func withFeature(node *Feature) featureOption {
-	return func(m *FeatureMutation) {
-		m.oldValue = func(context.Context) (*Feature, error) {
+// withNotificationRule sets the old NotificationRule of the mutation.
+func withNotificationRule(node *NotificationRule) notificationruleOption {
+	return func(m *NotificationRuleMutation) {
+		m.oldValue = func(context.Context) (*NotificationRule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1793,7 +8134,7 @@ func withFeature(node *Feature) featureOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FeatureMutation) Client() *Client {
+func (m NotificationRuleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1801,7 +8142,7 @@ func (m FeatureMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FeatureMutation) Tx() (*Tx, error) {
+func (m NotificationRuleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -1811,14 +8152,14 @@ func (m FeatureMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Feature entities.
-func (m *FeatureMutation) SetID(id string) {
+// operation is only accepted on creation of NotificationRule entities.
+func (m *NotificationRuleMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FeatureMutation) ID() (id string, exists bool) {
+func (m *NotificationRuleMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1829,7 +8170,7 @@ func (m *FeatureMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FeatureMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *NotificationRuleMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1838,19 +8179,19 @@ func (m *FeatureMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Feature.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().NotificationRule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *FeatureMutation) SetCreatedAt(t time.Time) {
+func (m *NotificationRuleMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FeatureMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *NotificationRuleMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1858,10 +8199,10 @@ func (m *FeatureMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Feature entity.
-// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeatureMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NotificationRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1876,17 +8217,17 @@ func (m *FeatureMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FeatureMutation) ResetCreatedAt() {
+func (m *NotificationRuleMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *FeatureMutation) SetUpdatedAt(t time.Time) {
+func (m *NotificationRuleMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FeatureMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *NotificationRuleMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1894,10 +8235,10 @@ func (m *FeatureMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Feature entity.
-// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeatureMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *NotificationRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1912,17 +8253,17 @@ func (m *FeatureMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FeatureMutation) ResetUpdatedAt() {
+func (m *NotificationRuleMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetNamespace sets the "namespace" field.
-func (m *FeatureMutation) SetNamespace(s string) {
+func (m *NotificationRuleMutation) SetNamespace(s string) {
 	m.namespace = &s
 }
 
 // Namespace returns the value of the "namespace" field in the mutation.
-func (m *FeatureMutation) Namespace() (r string, exists bool) {
+func (m *NotificationRuleMutation) Namespace() (r string, exists bool) {
 	v := m.namespace
 	if v == nil {
 		return
@@ -1930,10 +8271,10 @@ func (m *FeatureMutation) Namespace() (r string, exists bool) {
 	return *v, true
 }
 
-// OldNamespace returns the old "namespace" field's value of the Feature entity.
-// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// OldNamespace returns the old "namespace" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeatureMutation) OldNamespace(ctx context.Context) (v string, err error) {
+func (m *NotificationRuleMutation) OldNamespace(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
 	}
@@ -1948,230 +8289,331 @@ func (m *FeatureMutation) OldNamespace(ctx context.Context) (v string, err error
 }
 
 // ResetNamespace resets all changes to the "namespace" field.
-func (m *FeatureMutation) ResetNamespace() {
+func (m *NotificationRuleMutation) ResetNamespace() {
 	m.namespace = nil
 }
 
-// SetName sets the "name" field.
-func (m *FeatureMutation) SetName(s string) {
-	m.name = &s
+// SetLedgerID sets the "ledger_id" field.
+func (m *NotificationRuleMutation) SetLedgerID(s string) {
+	m.ledger_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *FeatureMutation) Name() (r string, exists bool) {
-	v := m.name
+// LedgerID returns the value of the "ledger_id" field in the mutation.
+func (m *NotificationRuleMutation) LedgerID() (r string, exists bool) {
+	v := m.ledger_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Feature entity.
-// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// OldLedgerID returns the old "ledger_id" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeatureMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *NotificationRuleMutation) OldLedgerID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldLedgerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldLedgerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldLedgerID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.LedgerID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FeatureMutation) ResetName() {
-	m.name = nil
+// ResetLedgerID resets all changes to the "ledger_id" field.
+func (m *NotificationRuleMutation) ResetLedgerID() {
+	m.ledger_id = nil
 }
 
-// SetMeterSlug sets the "meter_slug" field.
-func (m *FeatureMutation) SetMeterSlug(s string) {
-	m.meter_slug = &s
+// SetFeatureID sets the "feature_id" field.
+func (m *NotificationRuleMutation) SetFeatureID(s string) {
+	m.feature_id = &s
 }
 
-// MeterSlug returns the value of the "meter_slug" field in the mutation.
-func (m *FeatureMutation) MeterSlug() (r string, exists bool) {
-	v := m.meter_slug
+// FeatureID returns the value of the "feature_id" field in the mutation.
+func (m *NotificationRuleMutation) FeatureID() (r string, exists bool) {
+	v := m.feature_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMeterSlug returns the old "meter_slug" field's value of the Feature entity.
-// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// OldFeatureID returns the old "feature_id" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeatureMutation) OldMeterSlug(ctx context.Context) (v string, err error) {
+func (m *NotificationRuleMutation) OldFeatureID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMeterSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldFeatureID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMeterSlug requires an ID field in the mutation")
+		return v, errors.New("OldFeatureID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMeterSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldFeatureID: %w", err)
 	}
-	return oldValue.MeterSlug, nil
+	return oldValue.FeatureID, nil
+}
+
+// ClearFeatureID clears the value of the "feature_id" field.
+func (m *NotificationRuleMutation) ClearFeatureID() {
+	m.feature_id = nil
+	m.clearedFields[notificationrule.FieldFeatureID] = struct{}{}
+}
+
+// FeatureIDCleared returns if the "feature_id" field was cleared in this mutation.
+func (m *NotificationRuleMutation) FeatureIDCleared() bool {
+	_, ok := m.clearedFields[notificationrule.FieldFeatureID]
+	return ok
+}
+
+// ResetFeatureID resets all changes to the "feature_id" field.
+func (m *NotificationRuleMutation) ResetFeatureID() {
+	m.feature_id = nil
+	delete(m.clearedFields, notificationrule.FieldFeatureID)
+}
+
+// SetType sets the "type" field.
+func (m *NotificationRuleMutation) SetType(crt credit.NotificationRuleType) {
+	m._type = &crt
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *NotificationRuleMutation) GetType() (r credit.NotificationRuleType, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldType(ctx context.Context) (v credit.NotificationRuleType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *NotificationRuleMutation) ResetType() {
+	m._type = nil
+}
+
+// SetThreshold sets the "threshold" field.
+func (m *NotificationRuleMutation) SetThreshold(f float64) {
+	m.threshold = &f
+	m.addthreshold = nil
+}
+
+// Threshold returns the value of the "threshold" field in the mutation.
+func (m *NotificationRuleMutation) Threshold() (r float64, exists bool) {
+	v := m.threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThreshold returns the old "threshold" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldThreshold(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThreshold: %w", err)
+	}
+	return oldValue.Threshold, nil
+}
+
+// AddThreshold adds f to the "threshold" field.
+func (m *NotificationRuleMutation) AddThreshold(f float64) {
+	if m.addthreshold != nil {
+		*m.addthreshold += f
+	} else {
+		m.addthreshold = &f
+	}
+}
+
+// AddedThreshold returns the value that was added to the "threshold" field in this mutation.
+func (m *NotificationRuleMutation) AddedThreshold() (r float64, exists bool) {
+	v := m.addthreshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearThreshold clears the value of the "threshold" field.
+func (m *NotificationRuleMutation) ClearThreshold() {
+	m.threshold = nil
+	m.addthreshold = nil
+	m.clearedFields[notificationrule.FieldThreshold] = struct{}{}
+}
+
+// ThresholdCleared returns if the "threshold" field was cleared in this mutation.
+func (m *NotificationRuleMutation) ThresholdCleared() bool {
+	_, ok := m.clearedFields[notificationrule.FieldThreshold]
+	return ok
 }
 
-// ResetMeterSlug resets all changes to the "meter_slug" field.
-func (m *FeatureMutation) ResetMeterSlug() {
-	m.meter_slug = nil
+// ResetThreshold resets all changes to the "threshold" field.
+func (m *NotificationRuleMutation) ResetThreshold() {
+	m.threshold = nil
+	m.addthreshold = nil
+	delete(m.clearedFields, notificationrule.FieldThreshold)
 }
 
-// SetMeterGroupByFilters sets the "meter_group_by_filters" field.
-func (m *FeatureMutation) SetMeterGroupByFilters(value map[string]string) {
-	m.meter_group_by_filters = &value
+// SetURL sets the "url" field.
+func (m *NotificationRuleMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// MeterGroupByFilters returns the value of the "meter_group_by_filters" field in the mutation.
-func (m *FeatureMutation) MeterGroupByFilters() (r map[string]string, exists bool) {
-	v := m.meter_group_by_filters
+// URL returns the value of the "url" field in the mutation.
+func (m *NotificationRuleMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMeterGroupByFilters returns the old "meter_group_by_filters" field's value of the Feature entity.
-// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeatureMutation) OldMeterGroupByFilters(ctx context.Context) (v map[string]string, err error) {
+func (m *NotificationRuleMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMeterGroupByFilters is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMeterGroupByFilters requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMeterGroupByFilters: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.MeterGroupByFilters, nil
+	return oldValue.URL, nil
 }
 
-// ClearMeterGroupByFilters clears the value of the "meter_group_by_filters" field.
-func (m *FeatureMutation) ClearMeterGroupByFilters() {
-	m.meter_group_by_filters = nil
-	m.clearedFields[feature.FieldMeterGroupByFilters] = struct{}{}
-}
-
-// MeterGroupByFiltersCleared returns if the "meter_group_by_filters" field was cleared in this mutation.
-func (m *FeatureMutation) MeterGroupByFiltersCleared() bool {
-	_, ok := m.clearedFields[feature.FieldMeterGroupByFilters]
-	return ok
-}
-
-// ResetMeterGroupByFilters resets all changes to the "meter_group_by_filters" field.
-func (m *FeatureMutation) ResetMeterGroupByFilters() {
-	m.meter_group_by_filters = nil
-	delete(m.clearedFields, feature.FieldMeterGroupByFilters)
+// ResetURL resets all changes to the "url" field.
+func (m *NotificationRuleMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetArchived sets the "archived" field.
-func (m *FeatureMutation) SetArchived(b bool) {
-	m.archived = &b
+// SetTriggered sets the "triggered" field.
+func (m *NotificationRuleMutation) SetTriggered(b bool) {
+	m.triggered = &b
 }
 
-// Archived returns the value of the "archived" field in the mutation.
-func (m *FeatureMutation) Archived() (r bool, exists bool) {
-	v := m.archived
+// Triggered returns the value of the "triggered" field in the mutation.
+func (m *NotificationRuleMutation) Triggered() (r bool, exists bool) {
+	v := m.triggered
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldArchived returns the old "archived" field's value of the Feature entity.
-// If the Feature object wasn't provided to the builder, the object is fetched from the database.
+// OldTriggered returns the old "triggered" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeatureMutation) OldArchived(ctx context.Context) (v bool, err error) {
+func (m *NotificationRuleMutation) OldTriggered(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
+		return v, errors.New("OldTriggered is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldArchived requires an ID field in the mutation")
+		return v, errors.New("OldTriggered requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+		return v, fmt.Errorf("querying old value for OldTriggered: %w", err)
 	}
-	return oldValue.Archived, nil
-}
-
-// ResetArchived resets all changes to the "archived" field.
-func (m *FeatureMutation) ResetArchived() {
-	m.archived = nil
+	return oldValue.Triggered, nil
 }
 
-// AddCreditGrantIDs adds the "credit_grants" edge to the CreditEntry entity by ids.
-func (m *FeatureMutation) AddCreditGrantIDs(ids ...string) {
-	if m.credit_grants == nil {
-		m.credit_grants = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.credit_grants[ids[i]] = struct{}{}
-	}
+// ResetTriggered resets all changes to the "triggered" field.
+func (m *NotificationRuleMutation) ResetTriggered() {
+	m.triggered = nil
 }
 
-// ClearCreditGrants clears the "credit_grants" edge to the CreditEntry entity.
-func (m *FeatureMutation) ClearCreditGrants() {
-	m.clearedcredit_grants = true
+// SetTriggeredAt sets the "triggered_at" field.
+func (m *NotificationRuleMutation) SetTriggeredAt(t time.Time) {
+	m.triggered_at = &t
 }
 
-// CreditGrantsCleared reports if the "credit_grants" edge to the CreditEntry entity was cleared.
-func (m *FeatureMutation) CreditGrantsCleared() bool {
-	return m.clearedcredit_grants
+// TriggeredAt returns the value of the "triggered_at" field in the mutation.
+func (m *NotificationRuleMutation) TriggeredAt() (r time.Time, exists bool) {
+	v := m.triggered_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveCreditGrantIDs removes the "credit_grants" edge to the CreditEntry entity by IDs.
-func (m *FeatureMutation) RemoveCreditGrantIDs(ids ...string) {
-	if m.removedcredit_grants == nil {
-		m.removedcredit_grants = make(map[string]struct{})
+// OldTriggeredAt returns the old "triggered_at" field's value of the NotificationRule entity.
+// If the NotificationRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationRuleMutation) OldTriggeredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggeredAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.credit_grants, ids[i])
-		m.removedcredit_grants[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggeredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggeredAt: %w", err)
 	}
+	return oldValue.TriggeredAt, nil
 }
 
-// RemovedCreditGrants returns the removed IDs of the "credit_grants" edge to the CreditEntry entity.
-func (m *FeatureMutation) RemovedCreditGrantsIDs() (ids []string) {
-	for id := range m.removedcredit_grants {
-		ids = append(ids, id)
-	}
-	return
+// ClearTriggeredAt clears the value of the "triggered_at" field.
+func (m *NotificationRuleMutation) ClearTriggeredAt() {
+	m.triggered_at = nil
+	m.clearedFields[notificationrule.FieldTriggeredAt] = struct{}{}
 }
 
-// CreditGrantsIDs returns the "credit_grants" edge IDs in the mutation.
-func (m *FeatureMutation) CreditGrantsIDs() (ids []string) {
-	for id := range m.credit_grants {
-		ids = append(ids, id)
-	}
-	return
+// TriggeredAtCleared returns if the "triggered_at" field was cleared in this mutation.
+func (m *NotificationRuleMutation) TriggeredAtCleared() bool {
+	_, ok := m.clearedFields[notificationrule.FieldTriggeredAt]
+	return ok
 }
 
-// ResetCreditGrants resets all changes to the "credit_grants" edge.
-func (m *FeatureMutation) ResetCreditGrants() {
-	m.credit_grants = nil
-	m.clearedcredit_grants = false
-	m.removedcredit_grants = nil
+// ResetTriggeredAt resets all changes to the "triggered_at" field.
+func (m *NotificationRuleMutation) ResetTriggeredAt() {
+	m.triggered_at = nil
+	delete(m.clearedFields, notificationrule.FieldTriggeredAt)
 }
 
-// Where appends a list predicates to the FeatureMutation builder.
-func (m *FeatureMutation) Where(ps ...predicate.Feature) {
+// Where appends a list predicates to the NotificationRuleMutation builder.
+func (m *NotificationRuleMutation) Where(ps ...predicate.NotificationRule) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FeatureMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NotificationRuleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FeatureMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Feature, len(ps))
+func (m *NotificationRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NotificationRule, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2179,45 +8621,54 @@ func (m *FeatureMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FeatureMutation) Op() Op {
+func (m *NotificationRuleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FeatureMutation) SetOp(op Op) {
+func (m *NotificationRuleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Feature).
-func (m *FeatureMutation) Type() string {
+// Type returns the node type of this mutation (NotificationRule).
+func (m *NotificationRuleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FeatureMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *NotificationRuleMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.created_at != nil {
-		fields = append(fields, feature.FieldCreatedAt)
+		fields = append(fields, notificationrule.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, feature.FieldUpdatedAt)
+		fields = append(fields, notificationrule.FieldUpdatedAt)
 	}
 	if m.namespace != nil {
-		fields = append(fields, feature.FieldNamespace)
+		fields = append(fields, notificationrule.FieldNamespace)
 	}
-	if m.name != nil {
-		fields = append(fields, feature.FieldName)
+	if m.ledger_id != nil {
+		fields = append(fields, notificationrule.FieldLedgerID)
 	}
-	if m.meter_slug != nil {
-		fields = append(fields, feature.FieldMeterSlug)
+	if m.feature_id != nil {
+		fields = append(fields, notificationrule.FieldFeatureID)
 	}
-	if m.meter_group_by_filters != nil {
-		fields = append(fields, feature.FieldMeterGroupByFilters)
+	if m._type != nil {
+		fields = append(fields, notificationrule.FieldType)
 	}
-	if m.archived != nil {
-		fields = append(fields, feature.FieldArchived)
+	if m.threshold != nil {
+		fields = append(fields, notificationrule.FieldThreshold)
+	}
+	if m.url != nil {
+		fields = append(fields, notificationrule.FieldURL)
+	}
+	if m.triggered != nil {
+		fields = append(fields, notificationrule.FieldTriggered)
+	}
+	if m.triggered_at != nil {
+		fields = append(fields, notificationrule.FieldTriggeredAt)
 	}
 	return fields
 }
@@ -2225,22 +8676,28 @@ func (m *FeatureMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FeatureMutation) Field(name string) (ent.Value, bool) {
+func (m *NotificationRuleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case feature.FieldCreatedAt:
+	case notificationrule.FieldCreatedAt:
 		return m.CreatedAt()
-	case feature.FieldUpdatedAt:
+	case notificationrule.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case feature.FieldNamespace:
+	case notificationrule.FieldNamespace:
 		return m.Namespace()
-	case feature.FieldName:
-		return m.Name()
-	case feature.FieldMeterSlug:
-		return m.MeterSlug()
-	case feature.FieldMeterGroupByFilters:
-		return m.MeterGroupByFilters()
-	case feature.FieldArchived:
-		return m.Archived()
+	case notificationrule.FieldLedgerID:
+		return m.LedgerID()
+	case notificationrule.FieldFeatureID:
+		return m.FeatureID()
+	case notificationrule.FieldType:
+		return m.GetType()
+	case notificationrule.FieldThreshold:
+		return m.Threshold()
+	case notificationrule.FieldURL:
+		return m.URL()
+	case notificationrule.FieldTriggered:
+		return m.Triggered()
+	case notificationrule.FieldTriggeredAt:
+		return m.TriggeredAt()
 	}
 	return nil, false
 }
@@ -2248,276 +8705,303 @@ func (m *FeatureMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FeatureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NotificationRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case feature.FieldCreatedAt:
+	case notificationrule.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case feature.FieldUpdatedAt:
+	case notificationrule.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case feature.FieldNamespace:
+	case notificationrule.FieldNamespace:
 		return m.OldNamespace(ctx)
-	case feature.FieldName:
-		return m.OldName(ctx)
-	case feature.FieldMeterSlug:
-		return m.OldMeterSlug(ctx)
-	case feature.FieldMeterGroupByFilters:
-		return m.OldMeterGroupByFilters(ctx)
-	case feature.FieldArchived:
-		return m.OldArchived(ctx)
+	case notificationrule.FieldLedgerID:
+		return m.OldLedgerID(ctx)
+	case notificationrule.FieldFeatureID:
+		return m.OldFeatureID(ctx)
+	case notificationrule.FieldType:
+		return m.OldType(ctx)
+	case notificationrule.FieldThreshold:
+		return m.OldThreshold(ctx)
+	case notificationrule.FieldURL:
+		return m.OldURL(ctx)
+	case notificationrule.FieldTriggered:
+		return m.OldTriggered(ctx)
+	case notificationrule.FieldTriggeredAt:
+		return m.OldTriggeredAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Feature field %s", name)
+	return nil, fmt.Errorf("unknown NotificationRule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FeatureMutation) SetField(name string, value ent.Value) error {
+func (m *NotificationRuleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case feature.FieldCreatedAt:
+	case notificationrule.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case feature.FieldUpdatedAt:
+	case notificationrule.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case feature.FieldNamespace:
+	case notificationrule.FieldNamespace:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetNamespace(v)
 		return nil
-	case feature.FieldName:
+	case notificationrule.FieldLedgerID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetLedgerID(v)
 		return nil
-	case feature.FieldMeterSlug:
+	case notificationrule.FieldFeatureID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMeterSlug(v)
+		m.SetFeatureID(v)
 		return nil
-	case feature.FieldMeterGroupByFilters:
-		v, ok := value.(map[string]string)
+	case notificationrule.FieldType:
+		v, ok := value.(credit.NotificationRuleType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMeterGroupByFilters(v)
+		m.SetType(v)
 		return nil
-	case feature.FieldArchived:
+	case notificationrule.FieldThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThreshold(v)
+		return nil
+	case notificationrule.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case notificationrule.FieldTriggered:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetArchived(v)
+		m.SetTriggered(v)
+		return nil
+	case notificationrule.FieldTriggeredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggeredAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Feature field %s", name)
+	return fmt.Errorf("unknown NotificationRule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FeatureMutation) AddedFields() []string {
-	return nil
+func (m *NotificationRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addthreshold != nil {
+		fields = append(fields, notificationrule.FieldThreshold)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FeatureMutation) AddedField(name string) (ent.Value, bool) {
+func (m *NotificationRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case notificationrule.FieldThreshold:
+		return m.AddedThreshold()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FeatureMutation) AddField(name string, value ent.Value) error {
+func (m *NotificationRuleMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case notificationrule.FieldThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddThreshold(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Feature numeric field %s", name)
+	return fmt.Errorf("unknown NotificationRule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FeatureMutation) ClearedFields() []string {
+func (m *NotificationRuleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(feature.FieldMeterGroupByFilters) {
-		fields = append(fields, feature.FieldMeterGroupByFilters)
+	if m.FieldCleared(notificationrule.FieldFeatureID) {
+		fields = append(fields, notificationrule.FieldFeatureID)
+	}
+	if m.FieldCleared(notificationrule.FieldThreshold) {
+		fields = append(fields, notificationrule.FieldThreshold)
+	}
+	if m.FieldCleared(notificationrule.FieldTriggeredAt) {
+		fields = append(fields, notificationrule.FieldTriggeredAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FeatureMutation) FieldCleared(name string) bool {
+func (m *NotificationRuleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FeatureMutation) ClearField(name string) error {
+func (m *NotificationRuleMutation) ClearField(name string) error {
 	switch name {
-	case feature.FieldMeterGroupByFilters:
-		m.ClearMeterGroupByFilters()
+	case notificationrule.FieldFeatureID:
+		m.ClearFeatureID()
+		return nil
+	case notificationrule.FieldThreshold:
+		m.ClearThreshold()
+		return nil
+	case notificationrule.FieldTriggeredAt:
+		m.ClearTriggeredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Feature nullable field %s", name)
+	return fmt.Errorf("unknown NotificationRule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FeatureMutation) ResetField(name string) error {
+func (m *NotificationRuleMutation) ResetField(name string) error {
 	switch name {
-	case feature.FieldCreatedAt:
+	case notificationrule.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case feature.FieldUpdatedAt:
+	case notificationrule.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case feature.FieldNamespace:
+	case notificationrule.FieldNamespace:
 		m.ResetNamespace()
 		return nil
-	case feature.FieldName:
-		m.ResetName()
+	case notificationrule.FieldLedgerID:
+		m.ResetLedgerID()
 		return nil
-	case feature.FieldMeterSlug:
-		m.ResetMeterSlug()
+	case notificationrule.FieldFeatureID:
+		m.ResetFeatureID()
 		return nil
-	case feature.FieldMeterGroupByFilters:
-		m.ResetMeterGroupByFilters()
+	case notificationrule.FieldType:
+		m.ResetType()
 		return nil
-	case feature.FieldArchived:
-		m.ResetArchived()
+	case notificationrule.FieldThreshold:
+		m.ResetThreshold()
+		return nil
+	case notificationrule.FieldURL:
+		m.ResetURL()
+		return nil
+	case notificationrule.FieldTriggered:
+		m.ResetTriggered()
+		return nil
+	case notificationrule.FieldTriggeredAt:
+		m.ResetTriggeredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Feature field %s", name)
+	return fmt.Errorf("unknown NotificationRule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FeatureMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.credit_grants != nil {
-		edges = append(edges, feature.EdgeCreditGrants)
-	}
+func (m *NotificationRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FeatureMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case feature.EdgeCreditGrants:
-		ids := make([]ent.Value, 0, len(m.credit_grants))
-		for id := range m.credit_grants {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *NotificationRuleMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FeatureMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedcredit_grants != nil {
-		edges = append(edges, feature.EdgeCreditGrants)
-	}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FeatureMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case feature.EdgeCreditGrants:
-		ids := make([]ent.Value, 0, len(m.removedcredit_grants))
-		for id := range m.removedcredit_grants {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *NotificationRuleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FeatureMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedcredit_grants {
-		edges = append(edges, feature.EdgeCreditGrants)
-	}
+func (m *NotificationRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FeatureMutation) EdgeCleared(name string) bool {
-	switch name {
-	case feature.EdgeCreditGrants:
-		return m.clearedcredit_grants
-	}
+func (m *NotificationRuleMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FeatureMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Feature unique edge %s", name)
+func (m *NotificationRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NotificationRule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FeatureMutation) ResetEdge(name string) error {
-	switch name {
-	case feature.EdgeCreditGrants:
-		m.ResetCreditGrants()
-		return nil
-	}
-	return fmt.Errorf("unknown Feature edge %s", name)
+func (m *NotificationRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NotificationRule edge %s", name)
 }
 
-// LedgerMutation represents an operation that mutates the Ledger nodes in the graph.
-type LedgerMutation struct {
+// SubjectAliasMutation represents an operation that mutates the SubjectAlias nodes in the graph.
+type SubjectAliasMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	namespace     *string
-	subject       *string
-	metadata      *map[string]string
-	highwatermark *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Ledger, error)
-	predicates    []predicate.Ledger
-}
-
-var _ ent.Mutation = (*LedgerMutation)(nil)
-
-// ledgerOption allows management of the mutation configuration using functional options.
-type ledgerOption func(*LedgerMutation)
-
-// newLedgerMutation creates new mutation for the Ledger entity.
-func newLedgerMutation(c config, op Op, opts ...ledgerOption) *LedgerMutation {
-	m := &LedgerMutation{
+	op                Op
+	typ               string
+	id                *string
+	created_at        *time.Time
+	updated_at        *time.Time
+	namespace         *string
+	source            *string
+	subject           *string
+	canonical_subject *string
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*SubjectAlias, error)
+	predicates        []predicate.SubjectAlias
+}
+
+var _ ent.Mutation = (*SubjectAliasMutation)(nil)
+
+// subjectaliasOption allows management of the mutation configuration using functional options.
+type subjectaliasOption func(*SubjectAliasMutation)
+
+// newSubjectAliasMutation creates new mutation for the SubjectAlias entity.
+func newSubjectAliasMutation(c config, op Op, opts ...subjectaliasOption) *SubjectAliasMutation {
+	m := &SubjectAliasMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLedger,
+		typ:           TypeSubjectAlias,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2526,20 +9010,20 @@ func newLedgerMutation(c config, op Op, opts ...ledgerOption) *LedgerMutation {
 	return m
 }
 
-// withLedgerID sets the ID field of the mutation.
-func withLedgerID(id string) ledgerOption {
-	return func(m *LedgerMutation) {
+// withSubjectAliasID sets the ID field of the mutation.
+func withSubjectAliasID(id string) subjectaliasOption {
+	return func(m *SubjectAliasMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Ledger
+			value *SubjectAlias
 		)
-		m.oldValue = func(ctx context.Context) (*Ledger, error) {
+		m.oldValue = func(ctx context.Context) (*SubjectAlias, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Ledger.Get(ctx, id)
+					value, err = m.Client().SubjectAlias.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2548,10 +9032,10 @@ func withLedgerID(id string) ledgerOption {
 	}
 }
 
-// withLedger sets the old Ledger of the mutation.
-func withLedger(node *Ledger) ledgerOption {
-	return func(m *LedgerMutation) {
-		m.oldValue = func(context.Context) (*Ledger, error) {
+// withSubjectAlias sets the old SubjectAlias of the mutation.
+func withSubjectAlias(node *SubjectAlias) subjectaliasOption {
+	return func(m *SubjectAliasMutation) {
+		m.oldValue = func(context.Context) (*SubjectAlias, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2560,7 +9044,7 @@ func withLedger(node *Ledger) ledgerOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LedgerMutation) Client() *Client {
+func (m SubjectAliasMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2568,7 +9052,7 @@ func (m LedgerMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LedgerMutation) Tx() (*Tx, error) {
+func (m SubjectAliasMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("db: mutation is not running in a transaction")
 	}
@@ -2578,14 +9062,14 @@ func (m LedgerMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Ledger entities.
-func (m *LedgerMutation) SetID(id string) {
+// operation is only accepted on creation of SubjectAlias entities.
+func (m *SubjectAliasMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LedgerMutation) ID() (id string, exists bool) {
+func (m *SubjectAliasMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2596,7 +9080,7 @@ func (m *LedgerMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LedgerMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *SubjectAliasMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2605,19 +9089,19 @@ func (m *LedgerMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Ledger.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SubjectAlias.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *LedgerMutation) SetCreatedAt(t time.Time) {
+func (m *SubjectAliasMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LedgerMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SubjectAliasMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2625,10 +9109,10 @@ func (m *LedgerMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Ledger entity.
-// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SubjectAlias entity.
+// If the SubjectAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LedgerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SubjectAliasMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2643,17 +9127,17 @@ func (m *LedgerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LedgerMutation) ResetCreatedAt() {
+func (m *SubjectAliasMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *LedgerMutation) SetUpdatedAt(t time.Time) {
+func (m *SubjectAliasMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *LedgerMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SubjectAliasMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2661,10 +9145,10 @@ func (m *LedgerMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Ledger entity.
-// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SubjectAlias entity.
+// If the SubjectAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LedgerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SubjectAliasMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2679,17 +9163,17 @@ func (m *LedgerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *LedgerMutation) ResetUpdatedAt() {
+func (m *SubjectAliasMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetNamespace sets the "namespace" field.
-func (m *LedgerMutation) SetNamespace(s string) {
+func (m *SubjectAliasMutation) SetNamespace(s string) {
 	m.namespace = &s
 }
 
 // Namespace returns the value of the "namespace" field in the mutation.
-func (m *LedgerMutation) Namespace() (r string, exists bool) {
+func (m *SubjectAliasMutation) Namespace() (r string, exists bool) {
 	v := m.namespace
 	if v == nil {
 		return
@@ -2697,10 +9181,10 @@ func (m *LedgerMutation) Namespace() (r string, exists bool) {
 	return *v, true
 }
 
-// OldNamespace returns the old "namespace" field's value of the Ledger entity.
-// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// OldNamespace returns the old "namespace" field's value of the SubjectAlias entity.
+// If the SubjectAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LedgerMutation) OldNamespace(ctx context.Context) (v string, err error) {
+func (m *SubjectAliasMutation) OldNamespace(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
 	}
@@ -2715,140 +9199,127 @@ func (m *LedgerMutation) OldNamespace(ctx context.Context) (v string, err error)
 }
 
 // ResetNamespace resets all changes to the "namespace" field.
-func (m *LedgerMutation) ResetNamespace() {
+func (m *SubjectAliasMutation) ResetNamespace() {
 	m.namespace = nil
 }
 
-// SetSubject sets the "subject" field.
-func (m *LedgerMutation) SetSubject(s string) {
-	m.subject = &s
+// SetSource sets the "source" field.
+func (m *SubjectAliasMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// Subject returns the value of the "subject" field in the mutation.
-func (m *LedgerMutation) Subject() (r string, exists bool) {
-	v := m.subject
+// Source returns the value of the "source" field in the mutation.
+func (m *SubjectAliasMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubject returns the old "subject" field's value of the Ledger entity.
-// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the SubjectAlias entity.
+// If the SubjectAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LedgerMutation) OldSubject(ctx context.Context) (v string, err error) {
+func (m *SubjectAliasMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubject requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.Subject, nil
+	return oldValue.Source, nil
 }
 
-// ResetSubject resets all changes to the "subject" field.
-func (m *LedgerMutation) ResetSubject() {
-	m.subject = nil
+// ResetSource resets all changes to the "source" field.
+func (m *SubjectAliasMutation) ResetSource() {
+	m.source = nil
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *LedgerMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// SetSubject sets the "subject" field.
+func (m *SubjectAliasMutation) SetSubject(s string) {
+	m.subject = &s
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *LedgerMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// Subject returns the value of the "subject" field in the mutation.
+func (m *SubjectAliasMutation) Subject() (r string, exists bool) {
+	v := m.subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Ledger entity.
-// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the SubjectAlias entity.
+// If the SubjectAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LedgerMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *SubjectAliasMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
 	}
-	return oldValue.Metadata, nil
-}
-
-// ClearMetadata clears the value of the "metadata" field.
-func (m *LedgerMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[ledger.FieldMetadata] = struct{}{}
-}
-
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *LedgerMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[ledger.FieldMetadata]
-	return ok
+	return oldValue.Subject, nil
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *LedgerMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, ledger.FieldMetadata)
+// ResetSubject resets all changes to the "subject" field.
+func (m *SubjectAliasMutation) ResetSubject() {
+	m.subject = nil
 }
 
-// SetHighwatermark sets the "highwatermark" field.
-func (m *LedgerMutation) SetHighwatermark(t time.Time) {
-	m.highwatermark = &t
+// SetCanonicalSubject sets the "canonical_subject" field.
+func (m *SubjectAliasMutation) SetCanonicalSubject(s string) {
+	m.canonical_subject = &s
 }
 
-// Highwatermark returns the value of the "highwatermark" field in the mutation.
-func (m *LedgerMutation) Highwatermark() (r time.Time, exists bool) {
-	v := m.highwatermark
+// CanonicalSubject returns the value of the "canonical_subject" field in the mutation.
+func (m *SubjectAliasMutation) CanonicalSubject() (r string, exists bool) {
+	v := m.canonical_subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHighwatermark returns the old "highwatermark" field's value of the Ledger entity.
-// If the Ledger object wasn't provided to the builder, the object is fetched from the database.
+// OldCanonicalSubject returns the old "canonical_subject" field's value of the SubjectAlias entity.
+// If the SubjectAlias object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LedgerMutation) OldHighwatermark(ctx context.Context) (v time.Time, err error) {
+func (m *SubjectAliasMutation) OldCanonicalSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHighwatermark is only allowed on UpdateOne operations")
+		return v, errors.New("OldCanonicalSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHighwatermark requires an ID field in the mutation")
+		return v, errors.New("OldCanonicalSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHighwatermark: %w", err)
+		return v, fmt.Errorf("querying old value for OldCanonicalSubject: %w", err)
 	}
-	return oldValue.Highwatermark, nil
+	return oldValue.CanonicalSubject, nil
 }
 
-// ResetHighwatermark resets all changes to the "highwatermark" field.
-func (m *LedgerMutation) ResetHighwatermark() {
-	m.highwatermark = nil
+// ResetCanonicalSubject resets all changes to the "canonical_subject" field.
+func (m *SubjectAliasMutation) ResetCanonicalSubject() {
+	m.canonical_subject = nil
 }
 
-// Where appends a list predicates to the LedgerMutation builder.
-func (m *LedgerMutation) Where(ps ...predicate.Ledger) {
+// Where appends a list predicates to the SubjectAliasMutation builder.
+func (m *SubjectAliasMutation) Where(ps ...predicate.SubjectAlias) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LedgerMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SubjectAliasMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LedgerMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Ledger, len(ps))
+func (m *SubjectAliasMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SubjectAlias, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2856,42 +9327,42 @@ func (m *LedgerMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LedgerMutation) Op() Op {
+func (m *SubjectAliasMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LedgerMutation) SetOp(op Op) {
+func (m *SubjectAliasMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Ledger).
-func (m *LedgerMutation) Type() string {
+// Type returns the node type of this mutation (SubjectAlias).
+func (m *SubjectAliasMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LedgerMutation) Fields() []string {
+func (m *SubjectAliasMutation) Fields() []string {
 	fields := make([]string, 0, 6)
 	if m.created_at != nil {
-		fields = append(fields, ledger.FieldCreatedAt)
+		fields = append(fields, subjectalias.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, ledger.FieldUpdatedAt)
+		fields = append(fields, subjectalias.FieldUpdatedAt)
 	}
 	if m.namespace != nil {
-		fields = append(fields, ledger.FieldNamespace)
+		fields = append(fields, subjectalias.FieldNamespace)
 	}
-	if m.subject != nil {
-		fields = append(fields, ledger.FieldSubject)
+	if m.source != nil {
+		fields = append(fields, subjectalias.FieldSource)
 	}
-	if m.metadata != nil {
-		fields = append(fields, ledger.FieldMetadata)
+	if m.subject != nil {
+		fields = append(fields, subjectalias.FieldSubject)
 	}
-	if m.highwatermark != nil {
-		fields = append(fields, ledger.FieldHighwatermark)
+	if m.canonical_subject != nil {
+		fields = append(fields, subjectalias.FieldCanonicalSubject)
 	}
 	return fields
 }
@@ -2899,20 +9370,20 @@ func (m *LedgerMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LedgerMutation) Field(name string) (ent.Value, bool) {
+func (m *SubjectAliasMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case ledger.FieldCreatedAt:
+	case subjectalias.FieldCreatedAt:
 		return m.CreatedAt()
-	case ledger.FieldUpdatedAt:
+	case subjectalias.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case ledger.FieldNamespace:
+	case subjectalias.FieldNamespace:
 		return m.Namespace()
-	case ledger.FieldSubject:
+	case subjectalias.FieldSource:
+		return m.Source()
+	case subjectalias.FieldSubject:
 		return m.Subject()
-	case ledger.FieldMetadata:
-		return m.Metadata()
-	case ledger.FieldHighwatermark:
-		return m.Highwatermark()
+	case subjectalias.FieldCanonicalSubject:
+		return m.CanonicalSubject()
 	}
 	return nil, false
 }
@@ -2920,195 +9391,186 @@ func (m *LedgerMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LedgerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SubjectAliasMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case ledger.FieldCreatedAt:
+	case subjectalias.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case ledger.FieldUpdatedAt:
+	case subjectalias.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case ledger.FieldNamespace:
+	case subjectalias.FieldNamespace:
 		return m.OldNamespace(ctx)
-	case ledger.FieldSubject:
+	case subjectalias.FieldSource:
+		return m.OldSource(ctx)
+	case subjectalias.FieldSubject:
 		return m.OldSubject(ctx)
-	case ledger.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case ledger.FieldHighwatermark:
-		return m.OldHighwatermark(ctx)
+	case subjectalias.FieldCanonicalSubject:
+		return m.OldCanonicalSubject(ctx)
 	}
-	return nil, fmt.Errorf("unknown Ledger field %s", name)
+	return nil, fmt.Errorf("unknown SubjectAlias field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LedgerMutation) SetField(name string, value ent.Value) error {
+func (m *SubjectAliasMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case ledger.FieldCreatedAt:
+	case subjectalias.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case ledger.FieldUpdatedAt:
+	case subjectalias.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case ledger.FieldNamespace:
+	case subjectalias.FieldNamespace:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetNamespace(v)
 		return nil
-	case ledger.FieldSubject:
+	case subjectalias.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubject(v)
+		m.SetSource(v)
 		return nil
-	case ledger.FieldMetadata:
-		v, ok := value.(map[string]string)
+	case subjectalias.FieldSubject:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetSubject(v)
 		return nil
-	case ledger.FieldHighwatermark:
-		v, ok := value.(time.Time)
+	case subjectalias.FieldCanonicalSubject:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHighwatermark(v)
+		m.SetCanonicalSubject(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Ledger field %s", name)
+	return fmt.Errorf("unknown SubjectAlias field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LedgerMutation) AddedFields() []string {
+func (m *SubjectAliasMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LedgerMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SubjectAliasMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LedgerMutation) AddField(name string, value ent.Value) error {
+func (m *SubjectAliasMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Ledger numeric field %s", name)
+	return fmt.Errorf("unknown SubjectAlias numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LedgerMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(ledger.FieldMetadata) {
-		fields = append(fields, ledger.FieldMetadata)
-	}
-	return fields
+func (m *SubjectAliasMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LedgerMutation) FieldCleared(name string) bool {
+func (m *SubjectAliasMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LedgerMutation) ClearField(name string) error {
-	switch name {
-	case ledger.FieldMetadata:
-		m.ClearMetadata()
-		return nil
-	}
-	return fmt.Errorf("unknown Ledger nullable field %s", name)
+func (m *SubjectAliasMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SubjectAlias nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LedgerMutation) ResetField(name string) error {
+func (m *SubjectAliasMutation) ResetField(name string) error {
 	switch name {
-	case ledger.FieldCreatedAt:
+	case subjectalias.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case ledger.FieldUpdatedAt:
+	case subjectalias.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case ledger.FieldNamespace:
+	case subjectalias.FieldNamespace:
 		m.ResetNamespace()
 		return nil
-	case ledger.FieldSubject:
-		m.ResetSubject()
+	case subjectalias.FieldSource:
+		m.ResetSource()
 		return nil
-	case ledger.FieldMetadata:
-		m.ResetMetadata()
+	case subjectalias.FieldSubject:
+		m.ResetSubject()
 		return nil
-	case ledger.FieldHighwatermark:
-		m.ResetHighwatermark()
+	case subjectalias.FieldCanonicalSubject:
+		m.ResetCanonicalSubject()
 		return nil
 	}
-	return fmt.Errorf("unknown Ledger field %s", name)
+	return fmt.Errorf("unknown SubjectAlias field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LedgerMutation) AddedEdges() []string {
+func (m *SubjectAliasMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LedgerMutation) AddedIDs(name string) []ent.Value {
+func (m *SubjectAliasMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LedgerMutation) RemovedEdges() []string {
+func (m *SubjectAliasMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LedgerMutation) RemovedIDs(name string) []ent.Value {
+func (m *SubjectAliasMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LedgerMutation) ClearedEdges() []string {
+func (m *SubjectAliasMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LedgerMutation) EdgeCleared(name string) bool {
+func (m *SubjectAliasMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LedgerMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Ledger unique edge %s", name)
+func (m *SubjectAliasMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SubjectAlias unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LedgerMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Ledger edge %s", name)
+func (m *SubjectAliasMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SubjectAlias edge %s", name)
 }
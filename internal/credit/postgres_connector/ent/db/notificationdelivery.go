@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationdelivery"
+)
+
+// NotificationDelivery is the model entity for the NotificationDelivery schema.
+type NotificationDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// RuleID holds the value of the "rule_id" field.
+	RuleID string `json:"rule_id,omitempty"`
+	// LedgerID holds the value of the "ledger_id" field.
+	LedgerID string `json:"ledger_id,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Event holds the value of the "event" field.
+	Event credit.NotificationEvent `json:"event,omitempty"`
+	// Status holds the value of the "status" field.
+	Status credit.NotificationDeliveryStatus `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// LastAttemptAt holds the value of the "last_attempt_at" field.
+	LastAttemptAt *time.Time `json:"last_attempt_at,omitempty"`
+	// LastStatusCode holds the value of the "last_status_code" field.
+	LastStatusCode *int `json:"last_status_code,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError    *string `json:"last_error,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationdelivery.FieldEvent:
+			values[i] = new([]byte)
+		case notificationdelivery.FieldAttempts, notificationdelivery.FieldLastStatusCode:
+			values[i] = new(sql.NullInt64)
+		case notificationdelivery.FieldID, notificationdelivery.FieldNamespace, notificationdelivery.FieldRuleID, notificationdelivery.FieldLedgerID, notificationdelivery.FieldURL, notificationdelivery.FieldStatus, notificationdelivery.FieldLastError:
+			values[i] = new(sql.NullString)
+		case notificationdelivery.FieldCreatedAt, notificationdelivery.FieldUpdatedAt, notificationdelivery.FieldNextAttemptAt, notificationdelivery.FieldLastAttemptAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationDelivery fields.
+func (nd *NotificationDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationdelivery.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				nd.ID = value.String
+			}
+		case notificationdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				nd.CreatedAt = value.Time
+			}
+		case notificationdelivery.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				nd.UpdatedAt = value.Time
+			}
+		case notificationdelivery.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				nd.Namespace = value.String
+			}
+		case notificationdelivery.FieldRuleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_id", values[i])
+			} else if value.Valid {
+				nd.RuleID = value.String
+			}
+		case notificationdelivery.FieldLedgerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				nd.LedgerID = value.String
+			}
+		case notificationdelivery.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				nd.URL = value.String
+			}
+		case notificationdelivery.FieldEvent:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field event", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &nd.Event); err != nil {
+					return fmt.Errorf("unmarshal field event: %w", err)
+				}
+			}
+		case notificationdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				nd.Status = credit.NotificationDeliveryStatus(value.String)
+			}
+		case notificationdelivery.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				nd.Attempts = int(value.Int64)
+			}
+		case notificationdelivery.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				nd.NextAttemptAt = new(time.Time)
+				*nd.NextAttemptAt = value.Time
+			}
+		case notificationdelivery.FieldLastAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_attempt_at", values[i])
+			} else if value.Valid {
+				nd.LastAttemptAt = new(time.Time)
+				*nd.LastAttemptAt = value.Time
+			}
+		case notificationdelivery.FieldLastStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_status_code", values[i])
+			} else if value.Valid {
+				nd.LastStatusCode = new(int)
+				*nd.LastStatusCode = int(value.Int64)
+			}
+		case notificationdelivery.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				nd.LastError = new(string)
+				*nd.LastError = value.String
+			}
+		default:
+			nd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationDelivery.
+// This includes values selected through modifiers, order, etc.
+func (nd *NotificationDelivery) Value(name string) (ent.Value, error) {
+	return nd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NotificationDelivery.
+// Note that you need to call NotificationDelivery.Unwrap() before calling this method if this NotificationDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nd *NotificationDelivery) Update() *NotificationDeliveryUpdateOne {
+	return NewNotificationDeliveryClient(nd.config).UpdateOne(nd)
+}
+
+// Unwrap unwraps the NotificationDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nd *NotificationDelivery) Unwrap() *NotificationDelivery {
+	_tx, ok := nd.config.driver.(*txDriver)
+	if !ok {
+		panic("db: NotificationDelivery is not a transactional entity")
+	}
+	nd.config.driver = _tx.drv
+	return nd
+}
+
+// String implements the fmt.Stringer.
+func (nd *NotificationDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nd.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(nd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(nd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(nd.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("rule_id=")
+	builder.WriteString(nd.RuleID)
+	builder.WriteString(", ")
+	builder.WriteString("ledger_id=")
+	builder.WriteString(nd.LedgerID)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(nd.URL)
+	builder.WriteString(", ")
+	builder.WriteString("event=")
+	builder.WriteString(fmt.Sprintf("%v", nd.Event))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", nd.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", nd.Attempts))
+	builder.WriteString(", ")
+	if v := nd.NextAttemptAt; v != nil {
+		builder.WriteString("next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := nd.LastAttemptAt; v != nil {
+		builder.WriteString("last_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := nd.LastStatusCode; v != nil {
+		builder.WriteString("last_status_code=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := nd.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationDeliveries is a parsable slice of NotificationDelivery.
+type NotificationDeliveries []*NotificationDelivery
@@ -0,0 +1,771 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationdelivery
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldNamespace, v))
+}
+
+// RuleID applies equality check predicate on the "rule_id" field. It's identical to RuleIDEQ.
+func RuleID(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldRuleID, v))
+}
+
+// LedgerID applies equality check predicate on the "ledger_id" field. It's identical to LedgerIDEQ.
+func LedgerID(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldLedgerID, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldURL, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// LastAttemptAt applies equality check predicate on the "last_attempt_at" field. It's identical to LastAttemptAtEQ.
+func LastAttemptAt(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldLastAttemptAt, v))
+}
+
+// LastStatusCode applies equality check predicate on the "last_status_code" field. It's identical to LastStatusCodeEQ.
+func LastStatusCode(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldLastStatusCode, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// RuleIDEQ applies the EQ predicate on the "rule_id" field.
+func RuleIDEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldRuleID, v))
+}
+
+// RuleIDNEQ applies the NEQ predicate on the "rule_id" field.
+func RuleIDNEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldRuleID, v))
+}
+
+// RuleIDIn applies the In predicate on the "rule_id" field.
+func RuleIDIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldRuleID, vs...))
+}
+
+// RuleIDNotIn applies the NotIn predicate on the "rule_id" field.
+func RuleIDNotIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldRuleID, vs...))
+}
+
+// RuleIDGT applies the GT predicate on the "rule_id" field.
+func RuleIDGT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldRuleID, v))
+}
+
+// RuleIDGTE applies the GTE predicate on the "rule_id" field.
+func RuleIDGTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldRuleID, v))
+}
+
+// RuleIDLT applies the LT predicate on the "rule_id" field.
+func RuleIDLT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldRuleID, v))
+}
+
+// RuleIDLTE applies the LTE predicate on the "rule_id" field.
+func RuleIDLTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldRuleID, v))
+}
+
+// RuleIDContains applies the Contains predicate on the "rule_id" field.
+func RuleIDContains(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContains(FieldRuleID, v))
+}
+
+// RuleIDHasPrefix applies the HasPrefix predicate on the "rule_id" field.
+func RuleIDHasPrefix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasPrefix(FieldRuleID, v))
+}
+
+// RuleIDHasSuffix applies the HasSuffix predicate on the "rule_id" field.
+func RuleIDHasSuffix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasSuffix(FieldRuleID, v))
+}
+
+// RuleIDEqualFold applies the EqualFold predicate on the "rule_id" field.
+func RuleIDEqualFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEqualFold(FieldRuleID, v))
+}
+
+// RuleIDContainsFold applies the ContainsFold predicate on the "rule_id" field.
+func RuleIDContainsFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContainsFold(FieldRuleID, v))
+}
+
+// LedgerIDEQ applies the EQ predicate on the "ledger_id" field.
+func LedgerIDEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldLedgerID, v))
+}
+
+// LedgerIDNEQ applies the NEQ predicate on the "ledger_id" field.
+func LedgerIDNEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldLedgerID, v))
+}
+
+// LedgerIDIn applies the In predicate on the "ledger_id" field.
+func LedgerIDIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldLedgerID, vs...))
+}
+
+// LedgerIDNotIn applies the NotIn predicate on the "ledger_id" field.
+func LedgerIDNotIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldLedgerID, vs...))
+}
+
+// LedgerIDGT applies the GT predicate on the "ledger_id" field.
+func LedgerIDGT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldLedgerID, v))
+}
+
+// LedgerIDGTE applies the GTE predicate on the "ledger_id" field.
+func LedgerIDGTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldLedgerID, v))
+}
+
+// LedgerIDLT applies the LT predicate on the "ledger_id" field.
+func LedgerIDLT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldLedgerID, v))
+}
+
+// LedgerIDLTE applies the LTE predicate on the "ledger_id" field.
+func LedgerIDLTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldLedgerID, v))
+}
+
+// LedgerIDContains applies the Contains predicate on the "ledger_id" field.
+func LedgerIDContains(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContains(FieldLedgerID, v))
+}
+
+// LedgerIDHasPrefix applies the HasPrefix predicate on the "ledger_id" field.
+func LedgerIDHasPrefix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasPrefix(FieldLedgerID, v))
+}
+
+// LedgerIDHasSuffix applies the HasSuffix predicate on the "ledger_id" field.
+func LedgerIDHasSuffix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasSuffix(FieldLedgerID, v))
+}
+
+// LedgerIDEqualFold applies the EqualFold predicate on the "ledger_id" field.
+func LedgerIDEqualFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEqualFold(FieldLedgerID, v))
+}
+
+// LedgerIDContainsFold applies the ContainsFold predicate on the "ledger_id" field.
+func LedgerIDContainsFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContainsFold(FieldLedgerID, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContainsFold(FieldURL, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v credit.NotificationDeliveryStatus) predicate.NotificationDelivery {
+	vc := v
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v credit.NotificationDeliveryStatus) predicate.NotificationDelivery {
+	vc := v
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...credit.NotificationDeliveryStatus) predicate.NotificationDelivery {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationDelivery(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...credit.NotificationDeliveryStatus) predicate.NotificationDelivery {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIsNil applies the IsNil predicate on the "next_attempt_at" field.
+func NextAttemptAtIsNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIsNull(FieldNextAttemptAt))
+}
+
+// NextAttemptAtNotNil applies the NotNil predicate on the "next_attempt_at" field.
+func NextAttemptAtNotNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotNull(FieldNextAttemptAt))
+}
+
+// LastAttemptAtEQ applies the EQ predicate on the "last_attempt_at" field.
+func LastAttemptAtEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldLastAttemptAt, v))
+}
+
+// LastAttemptAtNEQ applies the NEQ predicate on the "last_attempt_at" field.
+func LastAttemptAtNEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldLastAttemptAt, v))
+}
+
+// LastAttemptAtIn applies the In predicate on the "last_attempt_at" field.
+func LastAttemptAtIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldLastAttemptAt, vs...))
+}
+
+// LastAttemptAtNotIn applies the NotIn predicate on the "last_attempt_at" field.
+func LastAttemptAtNotIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldLastAttemptAt, vs...))
+}
+
+// LastAttemptAtGT applies the GT predicate on the "last_attempt_at" field.
+func LastAttemptAtGT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldLastAttemptAt, v))
+}
+
+// LastAttemptAtGTE applies the GTE predicate on the "last_attempt_at" field.
+func LastAttemptAtGTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldLastAttemptAt, v))
+}
+
+// LastAttemptAtLT applies the LT predicate on the "last_attempt_at" field.
+func LastAttemptAtLT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldLastAttemptAt, v))
+}
+
+// LastAttemptAtLTE applies the LTE predicate on the "last_attempt_at" field.
+func LastAttemptAtLTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldLastAttemptAt, v))
+}
+
+// LastAttemptAtIsNil applies the IsNil predicate on the "last_attempt_at" field.
+func LastAttemptAtIsNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIsNull(FieldLastAttemptAt))
+}
+
+// LastAttemptAtNotNil applies the NotNil predicate on the "last_attempt_at" field.
+func LastAttemptAtNotNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotNull(FieldLastAttemptAt))
+}
+
+// LastStatusCodeEQ applies the EQ predicate on the "last_status_code" field.
+func LastStatusCodeEQ(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldLastStatusCode, v))
+}
+
+// LastStatusCodeNEQ applies the NEQ predicate on the "last_status_code" field.
+func LastStatusCodeNEQ(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldLastStatusCode, v))
+}
+
+// LastStatusCodeIn applies the In predicate on the "last_status_code" field.
+func LastStatusCodeIn(vs ...int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldLastStatusCode, vs...))
+}
+
+// LastStatusCodeNotIn applies the NotIn predicate on the "last_status_code" field.
+func LastStatusCodeNotIn(vs ...int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldLastStatusCode, vs...))
+}
+
+// LastStatusCodeGT applies the GT predicate on the "last_status_code" field.
+func LastStatusCodeGT(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldLastStatusCode, v))
+}
+
+// LastStatusCodeGTE applies the GTE predicate on the "last_status_code" field.
+func LastStatusCodeGTE(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldLastStatusCode, v))
+}
+
+// LastStatusCodeLT applies the LT predicate on the "last_status_code" field.
+func LastStatusCodeLT(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldLastStatusCode, v))
+}
+
+// LastStatusCodeLTE applies the LTE predicate on the "last_status_code" field.
+func LastStatusCodeLTE(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldLastStatusCode, v))
+}
+
+// LastStatusCodeIsNil applies the IsNil predicate on the "last_status_code" field.
+func LastStatusCodeIsNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIsNull(FieldLastStatusCode))
+}
+
+// LastStatusCodeNotNil applies the NotNil predicate on the "last_status_code" field.
+func LastStatusCodeNotNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotNull(FieldLastStatusCode))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationDelivery) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationDelivery) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationDelivery) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.NotPredicates(p))
+}
@@ -0,0 +1,1132 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationdelivery"
+)
+
+// NotificationDeliveryCreate is the builder for creating a NotificationDelivery entity.
+type NotificationDeliveryCreate struct {
+	config
+	mutation *NotificationDeliveryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ndc *NotificationDeliveryCreate) SetCreatedAt(t time.Time) *NotificationDeliveryCreate {
+	ndc.mutation.SetCreatedAt(t)
+	return ndc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableCreatedAt(t *time.Time) *NotificationDeliveryCreate {
+	if t != nil {
+		ndc.SetCreatedAt(*t)
+	}
+	return ndc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ndc *NotificationDeliveryCreate) SetUpdatedAt(t time.Time) *NotificationDeliveryCreate {
+	ndc.mutation.SetUpdatedAt(t)
+	return ndc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableUpdatedAt(t *time.Time) *NotificationDeliveryCreate {
+	if t != nil {
+		ndc.SetUpdatedAt(*t)
+	}
+	return ndc
+}
+
+// SetNamespace sets the "namespace" field.
+func (ndc *NotificationDeliveryCreate) SetNamespace(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetNamespace(s)
+	return ndc
+}
+
+// SetRuleID sets the "rule_id" field.
+func (ndc *NotificationDeliveryCreate) SetRuleID(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetRuleID(s)
+	return ndc
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (ndc *NotificationDeliveryCreate) SetLedgerID(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetLedgerID(s)
+	return ndc
+}
+
+// SetURL sets the "url" field.
+func (ndc *NotificationDeliveryCreate) SetURL(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetURL(s)
+	return ndc
+}
+
+// SetEvent sets the "event" field.
+func (ndc *NotificationDeliveryCreate) SetEvent(ce credit.NotificationEvent) *NotificationDeliveryCreate {
+	ndc.mutation.SetEvent(ce)
+	return ndc
+}
+
+// SetStatus sets the "status" field.
+func (ndc *NotificationDeliveryCreate) SetStatus(cds credit.NotificationDeliveryStatus) *NotificationDeliveryCreate {
+	ndc.mutation.SetStatus(cds)
+	return ndc
+}
+
+// SetAttempts sets the "attempts" field.
+func (ndc *NotificationDeliveryCreate) SetAttempts(i int) *NotificationDeliveryCreate {
+	ndc.mutation.SetAttempts(i)
+	return ndc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableAttempts(i *int) *NotificationDeliveryCreate {
+	if i != nil {
+		ndc.SetAttempts(*i)
+	}
+	return ndc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (ndc *NotificationDeliveryCreate) SetNextAttemptAt(t time.Time) *NotificationDeliveryCreate {
+	ndc.mutation.SetNextAttemptAt(t)
+	return ndc
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableNextAttemptAt(t *time.Time) *NotificationDeliveryCreate {
+	if t != nil {
+		ndc.SetNextAttemptAt(*t)
+	}
+	return ndc
+}
+
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (ndc *NotificationDeliveryCreate) SetLastAttemptAt(t time.Time) *NotificationDeliveryCreate {
+	ndc.mutation.SetLastAttemptAt(t)
+	return ndc
+}
+
+// SetNillableLastAttemptAt sets the "last_attempt_at" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableLastAttemptAt(t *time.Time) *NotificationDeliveryCreate {
+	if t != nil {
+		ndc.SetLastAttemptAt(*t)
+	}
+	return ndc
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (ndc *NotificationDeliveryCreate) SetLastStatusCode(i int) *NotificationDeliveryCreate {
+	ndc.mutation.SetLastStatusCode(i)
+	return ndc
+}
+
+// SetNillableLastStatusCode sets the "last_status_code" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableLastStatusCode(i *int) *NotificationDeliveryCreate {
+	if i != nil {
+		ndc.SetLastStatusCode(*i)
+	}
+	return ndc
+}
+
+// SetLastError sets the "last_error" field.
+func (ndc *NotificationDeliveryCreate) SetLastError(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetLastError(s)
+	return ndc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableLastError(s *string) *NotificationDeliveryCreate {
+	if s != nil {
+		ndc.SetLastError(*s)
+	}
+	return ndc
+}
+
+// SetID sets the "id" field.
+func (ndc *NotificationDeliveryCreate) SetID(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetID(s)
+	return ndc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableID(s *string) *NotificationDeliveryCreate {
+	if s != nil {
+		ndc.SetID(*s)
+	}
+	return ndc
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (ndc *NotificationDeliveryCreate) Mutation() *NotificationDeliveryMutation {
+	return ndc.mutation
+}
+
+// Save creates the NotificationDelivery in the database.
+func (ndc *NotificationDeliveryCreate) Save(ctx context.Context) (*NotificationDelivery, error) {
+	ndc.defaults()
+	return withHooks(ctx, ndc.sqlSave, ndc.mutation, ndc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ndc *NotificationDeliveryCreate) SaveX(ctx context.Context) *NotificationDelivery {
+	v, err := ndc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ndc *NotificationDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := ndc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndc *NotificationDeliveryCreate) ExecX(ctx context.Context) {
+	if err := ndc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ndc *NotificationDeliveryCreate) defaults() {
+	if _, ok := ndc.mutation.CreatedAt(); !ok {
+		v := notificationdelivery.DefaultCreatedAt()
+		ndc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ndc.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.DefaultUpdatedAt()
+		ndc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ndc.mutation.Attempts(); !ok {
+		v := notificationdelivery.DefaultAttempts
+		ndc.mutation.SetAttempts(v)
+	}
+	if _, ok := ndc.mutation.ID(); !ok {
+		v := notificationdelivery.DefaultID()
+		ndc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ndc *NotificationDeliveryCreate) check() error {
+	if _, ok := ndc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "NotificationDelivery.created_at"`)}
+	}
+	if _, ok := ndc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "NotificationDelivery.updated_at"`)}
+	}
+	if _, ok := ndc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`db: missing required field "NotificationDelivery.namespace"`)}
+	}
+	if v, ok := ndc.mutation.Namespace(); ok {
+		if err := notificationdelivery.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`db: validator failed for field "NotificationDelivery.namespace": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.RuleID(); !ok {
+		return &ValidationError{Name: "rule_id", err: errors.New(`db: missing required field "NotificationDelivery.rule_id"`)}
+	}
+	if _, ok := ndc.mutation.LedgerID(); !ok {
+		return &ValidationError{Name: "ledger_id", err: errors.New(`db: missing required field "NotificationDelivery.ledger_id"`)}
+	}
+	if _, ok := ndc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`db: missing required field "NotificationDelivery.url"`)}
+	}
+	if v, ok := ndc.mutation.URL(); ok {
+		if err := notificationdelivery.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`db: validator failed for field "NotificationDelivery.url": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`db: missing required field "NotificationDelivery.event"`)}
+	}
+	if _, ok := ndc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`db: missing required field "NotificationDelivery.status"`)}
+	}
+	if v, ok := ndc.mutation.Status(); ok {
+		if err := notificationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`db: validator failed for field "NotificationDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`db: missing required field "NotificationDelivery.attempts"`)}
+	}
+	return nil
+}
+
+func (ndc *NotificationDeliveryCreate) sqlSave(ctx context.Context) (*NotificationDelivery, error) {
+	if err := ndc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ndc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ndc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected NotificationDelivery.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ndc.mutation.id = &_node.ID
+	ndc.mutation.done = true
+	return _node, nil
+}
+
+func (ndc *NotificationDeliveryCreate) createSpec() (*NotificationDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationDelivery{config: ndc.config}
+		_spec = sqlgraph.NewCreateSpec(notificationdelivery.Table, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = ndc.conflict
+	if id, ok := ndc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ndc.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ndc.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ndc.mutation.Namespace(); ok {
+		_spec.SetField(notificationdelivery.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := ndc.mutation.RuleID(); ok {
+		_spec.SetField(notificationdelivery.FieldRuleID, field.TypeString, value)
+		_node.RuleID = value
+	}
+	if value, ok := ndc.mutation.LedgerID(); ok {
+		_spec.SetField(notificationdelivery.FieldLedgerID, field.TypeString, value)
+		_node.LedgerID = value
+	}
+	if value, ok := ndc.mutation.URL(); ok {
+		_spec.SetField(notificationdelivery.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := ndc.mutation.Event(); ok {
+		_spec.SetField(notificationdelivery.FieldEvent, field.TypeJSON, value)
+		_node.Event = value
+	}
+	if value, ok := ndc.mutation.Status(); ok {
+		_spec.SetField(notificationdelivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ndc.mutation.Attempts(); ok {
+		_spec.SetField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := ndc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(notificationdelivery.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := ndc.mutation.LastAttemptAt(); ok {
+		_spec.SetField(notificationdelivery.FieldLastAttemptAt, field.TypeTime, value)
+		_node.LastAttemptAt = &value
+	}
+	if value, ok := ndc.mutation.LastStatusCode(); ok {
+		_spec.SetField(notificationdelivery.FieldLastStatusCode, field.TypeInt, value)
+		_node.LastStatusCode = &value
+	}
+	if value, ok := ndc.mutation.LastError(); ok {
+		_spec.SetField(notificationdelivery.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationDelivery.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationDeliveryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ndc *NotificationDeliveryCreate) OnConflict(opts ...sql.ConflictOption) *NotificationDeliveryUpsertOne {
+	ndc.conflict = opts
+	return &NotificationDeliveryUpsertOne{
+		create: ndc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ndc *NotificationDeliveryCreate) OnConflictColumns(columns ...string) *NotificationDeliveryUpsertOne {
+	ndc.conflict = append(ndc.conflict, sql.ConflictColumns(columns...))
+	return &NotificationDeliveryUpsertOne{
+		create: ndc,
+	}
+}
+
+type (
+	// NotificationDeliveryUpsertOne is the builder for "upsert"-ing
+	//  one NotificationDelivery node.
+	NotificationDeliveryUpsertOne struct {
+		create *NotificationDeliveryCreate
+	}
+
+	// NotificationDeliveryUpsert is the "OnConflict" setter.
+	NotificationDeliveryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationDeliveryUpsert) SetUpdatedAt(v time.Time) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateUpdatedAt() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *NotificationDeliveryUpsert) SetStatus(v credit.NotificationDeliveryStatus) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateStatus() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *NotificationDeliveryUpsert) SetAttempts(v int) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateAttempts() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *NotificationDeliveryUpsert) AddAttempts(v int) *NotificationDeliveryUpsert {
+	u.Add(notificationdelivery.FieldAttempts, v)
+	return u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *NotificationDeliveryUpsert) SetNextAttemptAt(v time.Time) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldNextAttemptAt, v)
+	return u
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateNextAttemptAt() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldNextAttemptAt)
+	return u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *NotificationDeliveryUpsert) ClearNextAttemptAt() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldNextAttemptAt)
+	return u
+}
+
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (u *NotificationDeliveryUpsert) SetLastAttemptAt(v time.Time) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldLastAttemptAt, v)
+	return u
+}
+
+// UpdateLastAttemptAt sets the "last_attempt_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateLastAttemptAt() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldLastAttemptAt)
+	return u
+}
+
+// ClearLastAttemptAt clears the value of the "last_attempt_at" field.
+func (u *NotificationDeliveryUpsert) ClearLastAttemptAt() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldLastAttemptAt)
+	return u
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (u *NotificationDeliveryUpsert) SetLastStatusCode(v int) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldLastStatusCode, v)
+	return u
+}
+
+// UpdateLastStatusCode sets the "last_status_code" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateLastStatusCode() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldLastStatusCode)
+	return u
+}
+
+// AddLastStatusCode adds v to the "last_status_code" field.
+func (u *NotificationDeliveryUpsert) AddLastStatusCode(v int) *NotificationDeliveryUpsert {
+	u.Add(notificationdelivery.FieldLastStatusCode, v)
+	return u
+}
+
+// ClearLastStatusCode clears the value of the "last_status_code" field.
+func (u *NotificationDeliveryUpsert) ClearLastStatusCode() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldLastStatusCode)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *NotificationDeliveryUpsert) SetLastError(v string) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateLastError() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *NotificationDeliveryUpsert) ClearLastError() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldLastError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationDeliveryUpsertOne) UpdateNewValues() *NotificationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(notificationdelivery.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(notificationdelivery.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Namespace(); exists {
+			s.SetIgnore(notificationdelivery.FieldNamespace)
+		}
+		if _, exists := u.create.mutation.RuleID(); exists {
+			s.SetIgnore(notificationdelivery.FieldRuleID)
+		}
+		if _, exists := u.create.mutation.LedgerID(); exists {
+			s.SetIgnore(notificationdelivery.FieldLedgerID)
+		}
+		if _, exists := u.create.mutation.URL(); exists {
+			s.SetIgnore(notificationdelivery.FieldURL)
+		}
+		if _, exists := u.create.mutation.Event(); exists {
+			s.SetIgnore(notificationdelivery.FieldEvent)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotificationDeliveryUpsertOne) Ignore() *NotificationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationDeliveryUpsertOne) DoNothing() *NotificationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationDeliveryCreate.OnConflict
+// documentation for more info.
+func (u *NotificationDeliveryUpsertOne) Update(set func(*NotificationDeliveryUpsert)) *NotificationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationDeliveryUpsertOne) SetUpdatedAt(v time.Time) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateUpdatedAt() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *NotificationDeliveryUpsertOne) SetStatus(v credit.NotificationDeliveryStatus) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateStatus() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *NotificationDeliveryUpsertOne) SetAttempts(v int) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *NotificationDeliveryUpsertOne) AddAttempts(v int) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateAttempts() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *NotificationDeliveryUpsertOne) SetNextAttemptAt(v time.Time) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateNextAttemptAt() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *NotificationDeliveryUpsertOne) ClearNextAttemptAt() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearNextAttemptAt()
+	})
+}
+
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (u *NotificationDeliveryUpsertOne) SetLastAttemptAt(v time.Time) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetLastAttemptAt(v)
+	})
+}
+
+// UpdateLastAttemptAt sets the "last_attempt_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateLastAttemptAt() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateLastAttemptAt()
+	})
+}
+
+// ClearLastAttemptAt clears the value of the "last_attempt_at" field.
+func (u *NotificationDeliveryUpsertOne) ClearLastAttemptAt() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearLastAttemptAt()
+	})
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (u *NotificationDeliveryUpsertOne) SetLastStatusCode(v int) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetLastStatusCode(v)
+	})
+}
+
+// AddLastStatusCode adds v to the "last_status_code" field.
+func (u *NotificationDeliveryUpsertOne) AddLastStatusCode(v int) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.AddLastStatusCode(v)
+	})
+}
+
+// UpdateLastStatusCode sets the "last_status_code" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateLastStatusCode() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateLastStatusCode()
+	})
+}
+
+// ClearLastStatusCode clears the value of the "last_status_code" field.
+func (u *NotificationDeliveryUpsertOne) ClearLastStatusCode() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearLastStatusCode()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *NotificationDeliveryUpsertOne) SetLastError(v string) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateLastError() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *NotificationDeliveryUpsertOne) ClearLastError() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationDeliveryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for NotificationDeliveryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationDeliveryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationDeliveryUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: NotificationDeliveryUpsertOne.ID is not supported by MySQL driver. Use NotificationDeliveryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationDeliveryUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationDeliveryCreateBulk is the builder for creating many NotificationDelivery entities in bulk.
+type NotificationDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationDeliveryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NotificationDelivery entities in the database.
+func (ndcb *NotificationDeliveryCreateBulk) Save(ctx context.Context) ([]*NotificationDelivery, error) {
+	if ndcb.err != nil {
+		return nil, ndcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ndcb.builders))
+	nodes := make([]*NotificationDelivery, len(ndcb.builders))
+	mutators := make([]Mutator, len(ndcb.builders))
+	for i := range ndcb.builders {
+		func(i int, root context.Context) {
+			builder := ndcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ndcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ndcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ndcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ndcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ndcb *NotificationDeliveryCreateBulk) SaveX(ctx context.Context) []*NotificationDelivery {
+	v, err := ndcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ndcb *NotificationDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := ndcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndcb *NotificationDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := ndcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationDelivery.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationDeliveryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ndcb *NotificationDeliveryCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationDeliveryUpsertBulk {
+	ndcb.conflict = opts
+	return &NotificationDeliveryUpsertBulk{
+		create: ndcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ndcb *NotificationDeliveryCreateBulk) OnConflictColumns(columns ...string) *NotificationDeliveryUpsertBulk {
+	ndcb.conflict = append(ndcb.conflict, sql.ConflictColumns(columns...))
+	return &NotificationDeliveryUpsertBulk{
+		create: ndcb,
+	}
+}
+
+// NotificationDeliveryUpsertBulk is the builder for "upsert"-ing
+// a bulk of NotificationDelivery nodes.
+type NotificationDeliveryUpsertBulk struct {
+	create *NotificationDeliveryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationDeliveryUpsertBulk) UpdateNewValues() *NotificationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(notificationdelivery.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(notificationdelivery.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Namespace(); exists {
+				s.SetIgnore(notificationdelivery.FieldNamespace)
+			}
+			if _, exists := b.mutation.RuleID(); exists {
+				s.SetIgnore(notificationdelivery.FieldRuleID)
+			}
+			if _, exists := b.mutation.LedgerID(); exists {
+				s.SetIgnore(notificationdelivery.FieldLedgerID)
+			}
+			if _, exists := b.mutation.URL(); exists {
+				s.SetIgnore(notificationdelivery.FieldURL)
+			}
+			if _, exists := b.mutation.Event(); exists {
+				s.SetIgnore(notificationdelivery.FieldEvent)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotificationDeliveryUpsertBulk) Ignore() *NotificationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationDeliveryUpsertBulk) DoNothing() *NotificationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationDeliveryCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationDeliveryUpsertBulk) Update(set func(*NotificationDeliveryUpsert)) *NotificationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationDeliveryUpsertBulk) SetUpdatedAt(v time.Time) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateUpdatedAt() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *NotificationDeliveryUpsertBulk) SetStatus(v credit.NotificationDeliveryStatus) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateStatus() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *NotificationDeliveryUpsertBulk) SetAttempts(v int) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *NotificationDeliveryUpsertBulk) AddAttempts(v int) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateAttempts() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *NotificationDeliveryUpsertBulk) SetNextAttemptAt(v time.Time) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateNextAttemptAt() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *NotificationDeliveryUpsertBulk) ClearNextAttemptAt() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearNextAttemptAt()
+	})
+}
+
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (u *NotificationDeliveryUpsertBulk) SetLastAttemptAt(v time.Time) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetLastAttemptAt(v)
+	})
+}
+
+// UpdateLastAttemptAt sets the "last_attempt_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateLastAttemptAt() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateLastAttemptAt()
+	})
+}
+
+// ClearLastAttemptAt clears the value of the "last_attempt_at" field.
+func (u *NotificationDeliveryUpsertBulk) ClearLastAttemptAt() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearLastAttemptAt()
+	})
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (u *NotificationDeliveryUpsertBulk) SetLastStatusCode(v int) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetLastStatusCode(v)
+	})
+}
+
+// AddLastStatusCode adds v to the "last_status_code" field.
+func (u *NotificationDeliveryUpsertBulk) AddLastStatusCode(v int) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.AddLastStatusCode(v)
+	})
+}
+
+// UpdateLastStatusCode sets the "last_status_code" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateLastStatusCode() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateLastStatusCode()
+	})
+}
+
+// ClearLastStatusCode clears the value of the "last_status_code" field.
+func (u *NotificationDeliveryUpsertBulk) ClearLastStatusCode() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearLastStatusCode()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *NotificationDeliveryUpsertBulk) SetLastError(v string) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateLastError() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *NotificationDeliveryUpsertBulk) ClearLastError() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationDeliveryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the NotificationDeliveryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for NotificationDeliveryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationDeliveryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationdelivery"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+)
+
+// NotificationDeliveryQuery is the builder for querying NotificationDelivery entities.
+type NotificationDeliveryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []notificationdelivery.OrderOption
+	inters     []Interceptor
+	predicates []predicate.NotificationDelivery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NotificationDeliveryQuery builder.
+func (ndq *NotificationDeliveryQuery) Where(ps ...predicate.NotificationDelivery) *NotificationDeliveryQuery {
+	ndq.predicates = append(ndq.predicates, ps...)
+	return ndq
+}
+
+// Limit the number of records to be returned by this query.
+func (ndq *NotificationDeliveryQuery) Limit(limit int) *NotificationDeliveryQuery {
+	ndq.ctx.Limit = &limit
+	return ndq
+}
+
+// Offset to start from.
+func (ndq *NotificationDeliveryQuery) Offset(offset int) *NotificationDeliveryQuery {
+	ndq.ctx.Offset = &offset
+	return ndq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ndq *NotificationDeliveryQuery) Unique(unique bool) *NotificationDeliveryQuery {
+	ndq.ctx.Unique = &unique
+	return ndq
+}
+
+// Order specifies how the records should be ordered.
+func (ndq *NotificationDeliveryQuery) Order(o ...notificationdelivery.OrderOption) *NotificationDeliveryQuery {
+	ndq.order = append(ndq.order, o...)
+	return ndq
+}
+
+// First returns the first NotificationDelivery entity from the query.
+// Returns a *NotFoundError when no NotificationDelivery was found.
+func (ndq *NotificationDeliveryQuery) First(ctx context.Context) (*NotificationDelivery, error) {
+	nodes, err := ndq.Limit(1).All(setContextOp(ctx, ndq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{notificationdelivery.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ndq *NotificationDeliveryQuery) FirstX(ctx context.Context) *NotificationDelivery {
+	node, err := ndq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NotificationDelivery ID from the query.
+// Returns a *NotFoundError when no NotificationDelivery ID was found.
+func (ndq *NotificationDeliveryQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ndq.Limit(1).IDs(setContextOp(ctx, ndq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{notificationdelivery.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ndq *NotificationDeliveryQuery) FirstIDX(ctx context.Context) string {
+	id, err := ndq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NotificationDelivery entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NotificationDelivery entity is found.
+// Returns a *NotFoundError when no NotificationDelivery entities are found.
+func (ndq *NotificationDeliveryQuery) Only(ctx context.Context) (*NotificationDelivery, error) {
+	nodes, err := ndq.Limit(2).All(setContextOp(ctx, ndq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{notificationdelivery.Label}
+	default:
+		return nil, &NotSingularError{notificationdelivery.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ndq *NotificationDeliveryQuery) OnlyX(ctx context.Context) *NotificationDelivery {
+	node, err := ndq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NotificationDelivery ID in the query.
+// Returns a *NotSingularError when more than one NotificationDelivery ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ndq *NotificationDeliveryQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ndq.Limit(2).IDs(setContextOp(ctx, ndq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{notificationdelivery.Label}
+	default:
+		err = &NotSingularError{notificationdelivery.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ndq *NotificationDeliveryQuery) OnlyIDX(ctx context.Context) string {
+	id, err := ndq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NotificationDeliveries.
+func (ndq *NotificationDeliveryQuery) All(ctx context.Context) ([]*NotificationDelivery, error) {
+	ctx = setContextOp(ctx, ndq.ctx, "All")
+	if err := ndq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*NotificationDelivery, *NotificationDeliveryQuery]()
+	return withInterceptors[[]*NotificationDelivery](ctx, ndq, qr, ndq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ndq *NotificationDeliveryQuery) AllX(ctx context.Context) []*NotificationDelivery {
+	nodes, err := ndq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NotificationDelivery IDs.
+func (ndq *NotificationDeliveryQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if ndq.ctx.Unique == nil && ndq.path != nil {
+		ndq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ndq.ctx, "IDs")
+	if err = ndq.Select(notificationdelivery.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ndq *NotificationDeliveryQuery) IDsX(ctx context.Context) []string {
+	ids, err := ndq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ndq *NotificationDeliveryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ndq.ctx, "Count")
+	if err := ndq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ndq, querierCount[*NotificationDeliveryQuery](), ndq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ndq *NotificationDeliveryQuery) CountX(ctx context.Context) int {
+	count, err := ndq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ndq *NotificationDeliveryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ndq.ctx, "Exist")
+	switch _, err := ndq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ndq *NotificationDeliveryQuery) ExistX(ctx context.Context) bool {
+	exist, err := ndq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NotificationDeliveryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ndq *NotificationDeliveryQuery) Clone() *NotificationDeliveryQuery {
+	if ndq == nil {
+		return nil
+	}
+	return &NotificationDeliveryQuery{
+		config:     ndq.config,
+		ctx:        ndq.ctx.Clone(),
+		order:      append([]notificationdelivery.OrderOption{}, ndq.order...),
+		inters:     append([]Interceptor{}, ndq.inters...),
+		predicates: append([]predicate.NotificationDelivery{}, ndq.predicates...),
+		// clone intermediate query.
+		sql:  ndq.sql.Clone(),
+		path: ndq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NotificationDelivery.Query().
+//		GroupBy(notificationdelivery.FieldCreatedAt).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (ndq *NotificationDeliveryQuery) GroupBy(field string, fields ...string) *NotificationDeliveryGroupBy {
+	ndq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NotificationDeliveryGroupBy{build: ndq}
+	grbuild.flds = &ndq.ctx.Fields
+	grbuild.label = notificationdelivery.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.NotificationDelivery.Query().
+//		Select(notificationdelivery.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ndq *NotificationDeliveryQuery) Select(fields ...string) *NotificationDeliverySelect {
+	ndq.ctx.Fields = append(ndq.ctx.Fields, fields...)
+	sbuild := &NotificationDeliverySelect{NotificationDeliveryQuery: ndq}
+	sbuild.label = notificationdelivery.Label
+	sbuild.flds, sbuild.scan = &ndq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NotificationDeliverySelect configured with the given aggregations.
+func (ndq *NotificationDeliveryQuery) Aggregate(fns ...AggregateFunc) *NotificationDeliverySelect {
+	return ndq.Select().Aggregate(fns...)
+}
+
+func (ndq *NotificationDeliveryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ndq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ndq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ndq.ctx.Fields {
+		if !notificationdelivery.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if ndq.path != nil {
+		prev, err := ndq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ndq.sql = prev
+	}
+	return nil
+}
+
+func (ndq *NotificationDeliveryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NotificationDelivery, error) {
+	var (
+		nodes = []*NotificationDelivery{}
+		_spec = ndq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*NotificationDelivery).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &NotificationDelivery{config: ndq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ndq.modifiers) > 0 {
+		_spec.Modifiers = ndq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ndq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ndq *NotificationDeliveryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ndq.querySpec()
+	if len(ndq.modifiers) > 0 {
+		_spec.Modifiers = ndq.modifiers
+	}
+	_spec.Node.Columns = ndq.ctx.Fields
+	if len(ndq.ctx.Fields) > 0 {
+		_spec.Unique = ndq.ctx.Unique != nil && *ndq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ndq.driver, _spec)
+}
+
+func (ndq *NotificationDeliveryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(notificationdelivery.Table, notificationdelivery.Columns, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeString))
+	_spec.From = ndq.sql
+	if unique := ndq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ndq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ndq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationdelivery.FieldID)
+		for i := range fields {
+			if fields[i] != notificationdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ndq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ndq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ndq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ndq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ndq *NotificationDeliveryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ndq.driver.Dialect())
+	t1 := builder.Table(notificationdelivery.Table)
+	columns := ndq.ctx.Fields
+	if len(columns) == 0 {
+		columns = notificationdelivery.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ndq.sql != nil {
+		selector = ndq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ndq.ctx.Unique != nil && *ndq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ndq.modifiers {
+		m(selector)
+	}
+	for _, p := range ndq.predicates {
+		p(selector)
+	}
+	for _, p := range ndq.order {
+		p(selector)
+	}
+	if offset := ndq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ndq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ndq *NotificationDeliveryQuery) ForUpdate(opts ...sql.LockOption) *NotificationDeliveryQuery {
+	if ndq.driver.Dialect() == dialect.Postgres {
+		ndq.Unique(false)
+	}
+	ndq.modifiers = append(ndq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ndq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ndq *NotificationDeliveryQuery) ForShare(opts ...sql.LockOption) *NotificationDeliveryQuery {
+	if ndq.driver.Dialect() == dialect.Postgres {
+		ndq.Unique(false)
+	}
+	ndq.modifiers = append(ndq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ndq
+}
+
+// NotificationDeliveryGroupBy is the group-by builder for NotificationDelivery entities.
+type NotificationDeliveryGroupBy struct {
+	selector
+	build *NotificationDeliveryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ndgb *NotificationDeliveryGroupBy) Aggregate(fns ...AggregateFunc) *NotificationDeliveryGroupBy {
+	ndgb.fns = append(ndgb.fns, fns...)
+	return ndgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ndgb *NotificationDeliveryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ndgb.build.ctx, "GroupBy")
+	if err := ndgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NotificationDeliveryQuery, *NotificationDeliveryGroupBy](ctx, ndgb.build, ndgb, ndgb.build.inters, v)
+}
+
+func (ndgb *NotificationDeliveryGroupBy) sqlScan(ctx context.Context, root *NotificationDeliveryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ndgb.fns))
+	for _, fn := range ndgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ndgb.flds)+len(ndgb.fns))
+		for _, f := range *ndgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ndgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ndgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// NotificationDeliverySelect is the builder for selecting fields of NotificationDelivery entities.
+type NotificationDeliverySelect struct {
+	*NotificationDeliveryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (nds *NotificationDeliverySelect) Aggregate(fns ...AggregateFunc) *NotificationDeliverySelect {
+	nds.fns = append(nds.fns, fns...)
+	return nds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nds *NotificationDeliverySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nds.ctx, "Select")
+	if err := nds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NotificationDeliveryQuery, *NotificationDeliverySelect](ctx, nds.NotificationDeliveryQuery, nds, nds.inters, v)
+}
+
+func (nds *NotificationDeliverySelect) sqlScan(ctx context.Context, root *NotificationDeliveryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(nds.fns))
+	for _, fn := range nds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*nds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
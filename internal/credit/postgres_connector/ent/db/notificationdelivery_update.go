@@ -0,0 +1,555 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationdelivery"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+)
+
+// NotificationDeliveryUpdate is the builder for updating NotificationDelivery entities.
+type NotificationDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationDeliveryMutation
+}
+
+// Where appends a list predicates to the NotificationDeliveryUpdate builder.
+func (ndu *NotificationDeliveryUpdate) Where(ps ...predicate.NotificationDelivery) *NotificationDeliveryUpdate {
+	ndu.mutation.Where(ps...)
+	return ndu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ndu *NotificationDeliveryUpdate) SetUpdatedAt(t time.Time) *NotificationDeliveryUpdate {
+	ndu.mutation.SetUpdatedAt(t)
+	return ndu
+}
+
+// SetStatus sets the "status" field.
+func (ndu *NotificationDeliveryUpdate) SetStatus(cds credit.NotificationDeliveryStatus) *NotificationDeliveryUpdate {
+	ndu.mutation.SetStatus(cds)
+	return ndu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableStatus(cds *credit.NotificationDeliveryStatus) *NotificationDeliveryUpdate {
+	if cds != nil {
+		ndu.SetStatus(*cds)
+	}
+	return ndu
+}
+
+// SetAttempts sets the "attempts" field.
+func (ndu *NotificationDeliveryUpdate) SetAttempts(i int) *NotificationDeliveryUpdate {
+	ndu.mutation.ResetAttempts()
+	ndu.mutation.SetAttempts(i)
+	return ndu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableAttempts(i *int) *NotificationDeliveryUpdate {
+	if i != nil {
+		ndu.SetAttempts(*i)
+	}
+	return ndu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (ndu *NotificationDeliveryUpdate) AddAttempts(i int) *NotificationDeliveryUpdate {
+	ndu.mutation.AddAttempts(i)
+	return ndu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (ndu *NotificationDeliveryUpdate) SetNextAttemptAt(t time.Time) *NotificationDeliveryUpdate {
+	ndu.mutation.SetNextAttemptAt(t)
+	return ndu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableNextAttemptAt(t *time.Time) *NotificationDeliveryUpdate {
+	if t != nil {
+		ndu.SetNextAttemptAt(*t)
+	}
+	return ndu
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (ndu *NotificationDeliveryUpdate) ClearNextAttemptAt() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearNextAttemptAt()
+	return ndu
+}
+
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (ndu *NotificationDeliveryUpdate) SetLastAttemptAt(t time.Time) *NotificationDeliveryUpdate {
+	ndu.mutation.SetLastAttemptAt(t)
+	return ndu
+}
+
+// SetNillableLastAttemptAt sets the "last_attempt_at" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableLastAttemptAt(t *time.Time) *NotificationDeliveryUpdate {
+	if t != nil {
+		ndu.SetLastAttemptAt(*t)
+	}
+	return ndu
+}
+
+// ClearLastAttemptAt clears the value of the "last_attempt_at" field.
+func (ndu *NotificationDeliveryUpdate) ClearLastAttemptAt() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearLastAttemptAt()
+	return ndu
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (ndu *NotificationDeliveryUpdate) SetLastStatusCode(i int) *NotificationDeliveryUpdate {
+	ndu.mutation.ResetLastStatusCode()
+	ndu.mutation.SetLastStatusCode(i)
+	return ndu
+}
+
+// SetNillableLastStatusCode sets the "last_status_code" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableLastStatusCode(i *int) *NotificationDeliveryUpdate {
+	if i != nil {
+		ndu.SetLastStatusCode(*i)
+	}
+	return ndu
+}
+
+// AddLastStatusCode adds i to the "last_status_code" field.
+func (ndu *NotificationDeliveryUpdate) AddLastStatusCode(i int) *NotificationDeliveryUpdate {
+	ndu.mutation.AddLastStatusCode(i)
+	return ndu
+}
+
+// ClearLastStatusCode clears the value of the "last_status_code" field.
+func (ndu *NotificationDeliveryUpdate) ClearLastStatusCode() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearLastStatusCode()
+	return ndu
+}
+
+// SetLastError sets the "last_error" field.
+func (ndu *NotificationDeliveryUpdate) SetLastError(s string) *NotificationDeliveryUpdate {
+	ndu.mutation.SetLastError(s)
+	return ndu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableLastError(s *string) *NotificationDeliveryUpdate {
+	if s != nil {
+		ndu.SetLastError(*s)
+	}
+	return ndu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (ndu *NotificationDeliveryUpdate) ClearLastError() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearLastError()
+	return ndu
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (ndu *NotificationDeliveryUpdate) Mutation() *NotificationDeliveryMutation {
+	return ndu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ndu *NotificationDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	ndu.defaults()
+	return withHooks(ctx, ndu.sqlSave, ndu.mutation, ndu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ndu *NotificationDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := ndu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ndu *NotificationDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := ndu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndu *NotificationDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := ndu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ndu *NotificationDeliveryUpdate) defaults() {
+	if _, ok := ndu.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.UpdateDefaultUpdatedAt()
+		ndu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ndu *NotificationDeliveryUpdate) check() error {
+	if v, ok := ndu.mutation.Status(); ok {
+		if err := notificationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`db: validator failed for field "NotificationDelivery.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ndu *NotificationDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ndu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationdelivery.Table, notificationdelivery.Columns, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeString))
+	if ps := ndu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ndu.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ndu.mutation.Status(); ok {
+		_spec.SetField(notificationdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ndu.mutation.Attempts(); ok {
+		_spec.SetField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ndu.mutation.AddedAttempts(); ok {
+		_spec.AddField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ndu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(notificationdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if ndu.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := ndu.mutation.LastAttemptAt(); ok {
+		_spec.SetField(notificationdelivery.FieldLastAttemptAt, field.TypeTime, value)
+	}
+	if ndu.mutation.LastAttemptAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldLastAttemptAt, field.TypeTime)
+	}
+	if value, ok := ndu.mutation.LastStatusCode(); ok {
+		_spec.SetField(notificationdelivery.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if value, ok := ndu.mutation.AddedLastStatusCode(); ok {
+		_spec.AddField(notificationdelivery.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if ndu.mutation.LastStatusCodeCleared() {
+		_spec.ClearField(notificationdelivery.FieldLastStatusCode, field.TypeInt)
+	}
+	if value, ok := ndu.mutation.LastError(); ok {
+		_spec.SetField(notificationdelivery.FieldLastError, field.TypeString, value)
+	}
+	if ndu.mutation.LastErrorCleared() {
+		_spec.ClearField(notificationdelivery.FieldLastError, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ndu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ndu.mutation.done = true
+	return n, nil
+}
+
+// NotificationDeliveryUpdateOne is the builder for updating a single NotificationDelivery entity.
+type NotificationDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationDeliveryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nduo *NotificationDeliveryUpdateOne) SetUpdatedAt(t time.Time) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetUpdatedAt(t)
+	return nduo
+}
+
+// SetStatus sets the "status" field.
+func (nduo *NotificationDeliveryUpdateOne) SetStatus(cds credit.NotificationDeliveryStatus) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetStatus(cds)
+	return nduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableStatus(cds *credit.NotificationDeliveryStatus) *NotificationDeliveryUpdateOne {
+	if cds != nil {
+		nduo.SetStatus(*cds)
+	}
+	return nduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (nduo *NotificationDeliveryUpdateOne) SetAttempts(i int) *NotificationDeliveryUpdateOne {
+	nduo.mutation.ResetAttempts()
+	nduo.mutation.SetAttempts(i)
+	return nduo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableAttempts(i *int) *NotificationDeliveryUpdateOne {
+	if i != nil {
+		nduo.SetAttempts(*i)
+	}
+	return nduo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (nduo *NotificationDeliveryUpdateOne) AddAttempts(i int) *NotificationDeliveryUpdateOne {
+	nduo.mutation.AddAttempts(i)
+	return nduo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (nduo *NotificationDeliveryUpdateOne) SetNextAttemptAt(t time.Time) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetNextAttemptAt(t)
+	return nduo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableNextAttemptAt(t *time.Time) *NotificationDeliveryUpdateOne {
+	if t != nil {
+		nduo.SetNextAttemptAt(*t)
+	}
+	return nduo
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearNextAttemptAt() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearNextAttemptAt()
+	return nduo
+}
+
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (nduo *NotificationDeliveryUpdateOne) SetLastAttemptAt(t time.Time) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetLastAttemptAt(t)
+	return nduo
+}
+
+// SetNillableLastAttemptAt sets the "last_attempt_at" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableLastAttemptAt(t *time.Time) *NotificationDeliveryUpdateOne {
+	if t != nil {
+		nduo.SetLastAttemptAt(*t)
+	}
+	return nduo
+}
+
+// ClearLastAttemptAt clears the value of the "last_attempt_at" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearLastAttemptAt() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearLastAttemptAt()
+	return nduo
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (nduo *NotificationDeliveryUpdateOne) SetLastStatusCode(i int) *NotificationDeliveryUpdateOne {
+	nduo.mutation.ResetLastStatusCode()
+	nduo.mutation.SetLastStatusCode(i)
+	return nduo
+}
+
+// SetNillableLastStatusCode sets the "last_status_code" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableLastStatusCode(i *int) *NotificationDeliveryUpdateOne {
+	if i != nil {
+		nduo.SetLastStatusCode(*i)
+	}
+	return nduo
+}
+
+// AddLastStatusCode adds i to the "last_status_code" field.
+func (nduo *NotificationDeliveryUpdateOne) AddLastStatusCode(i int) *NotificationDeliveryUpdateOne {
+	nduo.mutation.AddLastStatusCode(i)
+	return nduo
+}
+
+// ClearLastStatusCode clears the value of the "last_status_code" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearLastStatusCode() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearLastStatusCode()
+	return nduo
+}
+
+// SetLastError sets the "last_error" field.
+func (nduo *NotificationDeliveryUpdateOne) SetLastError(s string) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetLastError(s)
+	return nduo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableLastError(s *string) *NotificationDeliveryUpdateOne {
+	if s != nil {
+		nduo.SetLastError(*s)
+	}
+	return nduo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearLastError() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearLastError()
+	return nduo
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (nduo *NotificationDeliveryUpdateOne) Mutation() *NotificationDeliveryMutation {
+	return nduo.mutation
+}
+
+// Where appends a list predicates to the NotificationDeliveryUpdate builder.
+func (nduo *NotificationDeliveryUpdateOne) Where(ps ...predicate.NotificationDelivery) *NotificationDeliveryUpdateOne {
+	nduo.mutation.Where(ps...)
+	return nduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nduo *NotificationDeliveryUpdateOne) Select(field string, fields ...string) *NotificationDeliveryUpdateOne {
+	nduo.fields = append([]string{field}, fields...)
+	return nduo
+}
+
+// Save executes the query and returns the updated NotificationDelivery entity.
+func (nduo *NotificationDeliveryUpdateOne) Save(ctx context.Context) (*NotificationDelivery, error) {
+	nduo.defaults()
+	return withHooks(ctx, nduo.sqlSave, nduo.mutation, nduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nduo *NotificationDeliveryUpdateOne) SaveX(ctx context.Context) *NotificationDelivery {
+	node, err := nduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nduo *NotificationDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := nduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nduo *NotificationDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := nduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nduo *NotificationDeliveryUpdateOne) defaults() {
+	if _, ok := nduo.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.UpdateDefaultUpdatedAt()
+		nduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nduo *NotificationDeliveryUpdateOne) check() error {
+	if v, ok := nduo.mutation.Status(); ok {
+		if err := notificationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`db: validator failed for field "NotificationDelivery.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (nduo *NotificationDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *NotificationDelivery, err error) {
+	if err := nduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationdelivery.Table, notificationdelivery.Columns, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeString))
+	id, ok := nduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "NotificationDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationdelivery.FieldID)
+		for _, f := range fields {
+			if !notificationdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != notificationdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := nduo.mutation.Status(); ok {
+		_spec.SetField(notificationdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := nduo.mutation.Attempts(); ok {
+		_spec.SetField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := nduo.mutation.AddedAttempts(); ok {
+		_spec.AddField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := nduo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(notificationdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if nduo.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := nduo.mutation.LastAttemptAt(); ok {
+		_spec.SetField(notificationdelivery.FieldLastAttemptAt, field.TypeTime, value)
+	}
+	if nduo.mutation.LastAttemptAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldLastAttemptAt, field.TypeTime)
+	}
+	if value, ok := nduo.mutation.LastStatusCode(); ok {
+		_spec.SetField(notificationdelivery.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if value, ok := nduo.mutation.AddedLastStatusCode(); ok {
+		_spec.AddField(notificationdelivery.FieldLastStatusCode, field.TypeInt, value)
+	}
+	if nduo.mutation.LastStatusCodeCleared() {
+		_spec.ClearField(notificationdelivery.FieldLastStatusCode, field.TypeInt)
+	}
+	if value, ok := nduo.mutation.LastError(); ok {
+		_spec.SetField(notificationdelivery.FieldLastError, field.TypeString, value)
+	}
+	if nduo.mutation.LastErrorCleared() {
+		_spec.ClearField(notificationdelivery.FieldLastError, field.TypeString)
+	}
+	_node = &NotificationDelivery{config: nduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationrule"
+)
+
+// NotificationRule is the model entity for the NotificationRule schema.
+type NotificationRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// LedgerID holds the value of the "ledger_id" field.
+	LedgerID string `json:"ledger_id,omitempty"`
+	// FeatureID holds the value of the "feature_id" field.
+	FeatureID *string `json:"feature_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type credit.NotificationRuleType `json:"type,omitempty"`
+	// Threshold holds the value of the "threshold" field.
+	Threshold *float64 `json:"threshold,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Triggered holds the value of the "triggered" field.
+	Triggered bool `json:"triggered,omitempty"`
+	// TriggeredAt holds the value of the "triggered_at" field.
+	TriggeredAt  *time.Time `json:"triggered_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationrule.FieldTriggered:
+			values[i] = new(sql.NullBool)
+		case notificationrule.FieldThreshold:
+			values[i] = new(sql.NullFloat64)
+		case notificationrule.FieldID, notificationrule.FieldNamespace, notificationrule.FieldLedgerID, notificationrule.FieldFeatureID, notificationrule.FieldType, notificationrule.FieldURL:
+			values[i] = new(sql.NullString)
+		case notificationrule.FieldCreatedAt, notificationrule.FieldUpdatedAt, notificationrule.FieldTriggeredAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationRule fields.
+func (nr *NotificationRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationrule.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				nr.ID = value.String
+			}
+		case notificationrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				nr.CreatedAt = value.Time
+			}
+		case notificationrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				nr.UpdatedAt = value.Time
+			}
+		case notificationrule.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				nr.Namespace = value.String
+			}
+		case notificationrule.FieldLedgerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger_id", values[i])
+			} else if value.Valid {
+				nr.LedgerID = value.String
+			}
+		case notificationrule.FieldFeatureID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feature_id", values[i])
+			} else if value.Valid {
+				nr.FeatureID = new(string)
+				*nr.FeatureID = value.String
+			}
+		case notificationrule.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				nr.Type = credit.NotificationRuleType(value.String)
+			}
+		case notificationrule.FieldThreshold:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold", values[i])
+			} else if value.Valid {
+				nr.Threshold = new(float64)
+				*nr.Threshold = value.Float64
+			}
+		case notificationrule.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				nr.URL = value.String
+			}
+		case notificationrule.FieldTriggered:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field triggered", values[i])
+			} else if value.Valid {
+				nr.Triggered = value.Bool
+			}
+		case notificationrule.FieldTriggeredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field triggered_at", values[i])
+			} else if value.Valid {
+				nr.TriggeredAt = new(time.Time)
+				*nr.TriggeredAt = value.Time
+			}
+		default:
+			nr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationRule.
+// This includes values selected through modifiers, order, etc.
+func (nr *NotificationRule) Value(name string) (ent.Value, error) {
+	return nr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NotificationRule.
+// Note that you need to call NotificationRule.Unwrap() before calling this method if this NotificationRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nr *NotificationRule) Update() *NotificationRuleUpdateOne {
+	return NewNotificationRuleClient(nr.config).UpdateOne(nr)
+}
+
+// Unwrap unwraps the NotificationRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nr *NotificationRule) Unwrap() *NotificationRule {
+	_tx, ok := nr.config.driver.(*txDriver)
+	if !ok {
+		panic("db: NotificationRule is not a transactional entity")
+	}
+	nr.config.driver = _tx.drv
+	return nr
+}
+
+// String implements the fmt.Stringer.
+func (nr *NotificationRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(nr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(nr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(nr.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("ledger_id=")
+	builder.WriteString(nr.LedgerID)
+	builder.WriteString(", ")
+	if v := nr.FeatureID; v != nil {
+		builder.WriteString("feature_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", nr.Type))
+	builder.WriteString(", ")
+	if v := nr.Threshold; v != nil {
+		builder.WriteString("threshold=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(nr.URL)
+	builder.WriteString(", ")
+	builder.WriteString("triggered=")
+	builder.WriteString(fmt.Sprintf("%v", nr.Triggered))
+	builder.WriteString(", ")
+	if v := nr.TriggeredAt; v != nil {
+		builder.WriteString("triggered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationRules is a parsable slice of NotificationRule.
+type NotificationRules []*NotificationRule
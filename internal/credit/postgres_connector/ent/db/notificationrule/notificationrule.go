@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationrule
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit"
+)
+
+const (
+	// Label holds the string label denoting the notificationrule type in the database.
+	Label = "notification_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldLedgerID holds the string denoting the ledger_id field in the database.
+	FieldLedgerID = "ledger_id"
+	// FieldFeatureID holds the string denoting the feature_id field in the database.
+	FieldFeatureID = "feature_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldThreshold holds the string denoting the threshold field in the database.
+	FieldThreshold = "threshold"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldTriggered holds the string denoting the triggered field in the database.
+	FieldTriggered = "triggered"
+	// FieldTriggeredAt holds the string denoting the triggered_at field in the database.
+	FieldTriggeredAt = "triggered_at"
+	// Table holds the table name of the notificationrule in the database.
+	Table = "notification_rules"
+)
+
+// Columns holds all SQL columns for notificationrule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldNamespace,
+	FieldLedgerID,
+	FieldFeatureID,
+	FieldType,
+	FieldThreshold,
+	FieldURL,
+	FieldTriggered,
+	FieldTriggeredAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	NamespaceValidator func(string) error
+	// URLValidator is a validator for the "url" field. It is called by the builders before save.
+	URLValidator func(string) error
+	// DefaultTriggered holds the default value on creation for the "triggered" field.
+	DefaultTriggered bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type credit.NotificationRuleType) error {
+	switch _type {
+	case "BALANCE_BELOW", "BALANCE_BELOW_PERCENT", "BALANCE_EXHAUSTED":
+		return nil
+	default:
+		return fmt.Errorf("notificationrule: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the NotificationRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByNamespace orders the results by the namespace field.
+func ByNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespace, opts...).ToFunc()
+}
+
+// ByLedgerID orders the results by the ledger_id field.
+func ByLedgerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLedgerID, opts...).ToFunc()
+}
+
+// ByFeatureID orders the results by the feature_id field.
+func ByFeatureID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeatureID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByThreshold orders the results by the threshold field.
+func ByThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThreshold, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByTriggered orders the results by the triggered field.
+func ByTriggered(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTriggered, opts...).ToFunc()
+}
+
+// ByTriggeredAt orders the results by the triggered_at field.
+func ByTriggeredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTriggeredAt, opts...).ToFunc()
+}
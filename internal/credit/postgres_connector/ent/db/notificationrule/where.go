@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldNamespace, v))
+}
+
+// LedgerID applies equality check predicate on the "ledger_id" field. It's identical to LedgerIDEQ.
+func LedgerID(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldLedgerID, v))
+}
+
+// FeatureID applies equality check predicate on the "feature_id" field. It's identical to FeatureIDEQ.
+func FeatureID(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldFeatureID, v))
+}
+
+// Threshold applies equality check predicate on the "threshold" field. It's identical to ThresholdEQ.
+func Threshold(v float64) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldThreshold, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldURL, v))
+}
+
+// Triggered applies equality check predicate on the "triggered" field. It's identical to TriggeredEQ.
+func Triggered(v bool) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldTriggered, v))
+}
+
+// TriggeredAt applies equality check predicate on the "triggered_at" field. It's identical to TriggeredAtEQ.
+func TriggeredAt(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldTriggeredAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// LedgerIDEQ applies the EQ predicate on the "ledger_id" field.
+func LedgerIDEQ(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldLedgerID, v))
+}
+
+// LedgerIDNEQ applies the NEQ predicate on the "ledger_id" field.
+func LedgerIDNEQ(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldLedgerID, v))
+}
+
+// LedgerIDIn applies the In predicate on the "ledger_id" field.
+func LedgerIDIn(vs ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldLedgerID, vs...))
+}
+
+// LedgerIDNotIn applies the NotIn predicate on the "ledger_id" field.
+func LedgerIDNotIn(vs ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldLedgerID, vs...))
+}
+
+// LedgerIDGT applies the GT predicate on the "ledger_id" field.
+func LedgerIDGT(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldLedgerID, v))
+}
+
+// LedgerIDGTE applies the GTE predicate on the "ledger_id" field.
+func LedgerIDGTE(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldLedgerID, v))
+}
+
+// LedgerIDLT applies the LT predicate on the "ledger_id" field.
+func LedgerIDLT(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldLedgerID, v))
+}
+
+// LedgerIDLTE applies the LTE predicate on the "ledger_id" field.
+func LedgerIDLTE(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldLedgerID, v))
+}
+
+// LedgerIDContains applies the Contains predicate on the "ledger_id" field.
+func LedgerIDContains(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldContains(FieldLedgerID, v))
+}
+
+// LedgerIDHasPrefix applies the HasPrefix predicate on the "ledger_id" field.
+func LedgerIDHasPrefix(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldHasPrefix(FieldLedgerID, v))
+}
+
+// LedgerIDHasSuffix applies the HasSuffix predicate on the "ledger_id" field.
+func LedgerIDHasSuffix(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldHasSuffix(FieldLedgerID, v))
+}
+
+// LedgerIDEqualFold applies the EqualFold predicate on the "ledger_id" field.
+func LedgerIDEqualFold(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEqualFold(FieldLedgerID, v))
+}
+
+// LedgerIDContainsFold applies the ContainsFold predicate on the "ledger_id" field.
+func LedgerIDContainsFold(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldContainsFold(FieldLedgerID, v))
+}
+
+// FeatureIDEQ applies the EQ predicate on the "feature_id" field.
+func FeatureIDEQ(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldFeatureID, v))
+}
+
+// FeatureIDNEQ applies the NEQ predicate on the "feature_id" field.
+func FeatureIDNEQ(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldFeatureID, v))
+}
+
+// FeatureIDIn applies the In predicate on the "feature_id" field.
+func FeatureIDIn(vs ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldFeatureID, vs...))
+}
+
+// FeatureIDNotIn applies the NotIn predicate on the "feature_id" field.
+func FeatureIDNotIn(vs ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldFeatureID, vs...))
+}
+
+// FeatureIDGT applies the GT predicate on the "feature_id" field.
+func FeatureIDGT(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldFeatureID, v))
+}
+
+// FeatureIDGTE applies the GTE predicate on the "feature_id" field.
+func FeatureIDGTE(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldFeatureID, v))
+}
+
+// FeatureIDLT applies the LT predicate on the "feature_id" field.
+func FeatureIDLT(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldFeatureID, v))
+}
+
+// FeatureIDLTE applies the LTE predicate on the "feature_id" field.
+func FeatureIDLTE(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldFeatureID, v))
+}
+
+// FeatureIDContains applies the Contains predicate on the "feature_id" field.
+func FeatureIDContains(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldContains(FieldFeatureID, v))
+}
+
+// FeatureIDHasPrefix applies the HasPrefix predicate on the "feature_id" field.
+func FeatureIDHasPrefix(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldHasPrefix(FieldFeatureID, v))
+}
+
+// FeatureIDHasSuffix applies the HasSuffix predicate on the "feature_id" field.
+func FeatureIDHasSuffix(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldHasSuffix(FieldFeatureID, v))
+}
+
+// FeatureIDIsNil applies the IsNil predicate on the "feature_id" field.
+func FeatureIDIsNil() predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIsNull(FieldFeatureID))
+}
+
+// FeatureIDNotNil applies the NotNil predicate on the "feature_id" field.
+func FeatureIDNotNil() predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotNull(FieldFeatureID))
+}
+
+// FeatureIDEqualFold applies the EqualFold predicate on the "feature_id" field.
+func FeatureIDEqualFold(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEqualFold(FieldFeatureID, v))
+}
+
+// FeatureIDContainsFold applies the ContainsFold predicate on the "feature_id" field.
+func FeatureIDContainsFold(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldContainsFold(FieldFeatureID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v credit.NotificationRuleType) predicate.NotificationRule {
+	vc := v
+	return predicate.NotificationRule(sql.FieldEQ(FieldType, vc))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v credit.NotificationRuleType) predicate.NotificationRule {
+	vc := v
+	return predicate.NotificationRule(sql.FieldNEQ(FieldType, vc))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...credit.NotificationRuleType) predicate.NotificationRule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationRule(sql.FieldIn(FieldType, v...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...credit.NotificationRuleType) predicate.NotificationRule {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationRule(sql.FieldNotIn(FieldType, v...))
+}
+
+// ThresholdEQ applies the EQ predicate on the "threshold" field.
+func ThresholdEQ(v float64) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldThreshold, v))
+}
+
+// ThresholdNEQ applies the NEQ predicate on the "threshold" field.
+func ThresholdNEQ(v float64) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldThreshold, v))
+}
+
+// ThresholdIn applies the In predicate on the "threshold" field.
+func ThresholdIn(vs ...float64) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldThreshold, vs...))
+}
+
+// ThresholdNotIn applies the NotIn predicate on the "threshold" field.
+func ThresholdNotIn(vs ...float64) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldThreshold, vs...))
+}
+
+// ThresholdGT applies the GT predicate on the "threshold" field.
+func ThresholdGT(v float64) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldThreshold, v))
+}
+
+// ThresholdGTE applies the GTE predicate on the "threshold" field.
+func ThresholdGTE(v float64) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldThreshold, v))
+}
+
+// ThresholdLT applies the LT predicate on the "threshold" field.
+func ThresholdLT(v float64) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldThreshold, v))
+}
+
+// ThresholdLTE applies the LTE predicate on the "threshold" field.
+func ThresholdLTE(v float64) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldThreshold, v))
+}
+
+// ThresholdIsNil applies the IsNil predicate on the "threshold" field.
+func ThresholdIsNil() predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIsNull(FieldThreshold))
+}
+
+// ThresholdNotNil applies the NotNil predicate on the "threshold" field.
+func ThresholdNotNil() predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotNull(FieldThreshold))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldContainsFold(FieldURL, v))
+}
+
+// TriggeredEQ applies the EQ predicate on the "triggered" field.
+func TriggeredEQ(v bool) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldTriggered, v))
+}
+
+// TriggeredNEQ applies the NEQ predicate on the "triggered" field.
+func TriggeredNEQ(v bool) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldTriggered, v))
+}
+
+// TriggeredAtEQ applies the EQ predicate on the "triggered_at" field.
+func TriggeredAtEQ(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldEQ(FieldTriggeredAt, v))
+}
+
+// TriggeredAtNEQ applies the NEQ predicate on the "triggered_at" field.
+func TriggeredAtNEQ(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNEQ(FieldTriggeredAt, v))
+}
+
+// TriggeredAtIn applies the In predicate on the "triggered_at" field.
+func TriggeredAtIn(vs ...time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIn(FieldTriggeredAt, vs...))
+}
+
+// TriggeredAtNotIn applies the NotIn predicate on the "triggered_at" field.
+func TriggeredAtNotIn(vs ...time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotIn(FieldTriggeredAt, vs...))
+}
+
+// TriggeredAtGT applies the GT predicate on the "triggered_at" field.
+func TriggeredAtGT(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGT(FieldTriggeredAt, v))
+}
+
+// TriggeredAtGTE applies the GTE predicate on the "triggered_at" field.
+func TriggeredAtGTE(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldGTE(FieldTriggeredAt, v))
+}
+
+// TriggeredAtLT applies the LT predicate on the "triggered_at" field.
+func TriggeredAtLT(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLT(FieldTriggeredAt, v))
+}
+
+// TriggeredAtLTE applies the LTE predicate on the "triggered_at" field.
+func TriggeredAtLTE(v time.Time) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldLTE(FieldTriggeredAt, v))
+}
+
+// TriggeredAtIsNil applies the IsNil predicate on the "triggered_at" field.
+func TriggeredAtIsNil() predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldIsNull(FieldTriggeredAt))
+}
+
+// TriggeredAtNotNil applies the NotNil predicate on the "triggered_at" field.
+func TriggeredAtNotNil() predicate.NotificationRule {
+	return predicate.NotificationRule(sql.FieldNotNull(FieldTriggeredAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationRule) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationRule) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationRule) predicate.NotificationRule {
+	return predicate.NotificationRule(sql.NotPredicates(p))
+}
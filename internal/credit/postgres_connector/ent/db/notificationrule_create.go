@@ -0,0 +1,834 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationrule"
+)
+
+// NotificationRuleCreate is the builder for creating a NotificationRule entity.
+type NotificationRuleCreate struct {
+	config
+	mutation *NotificationRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nrc *NotificationRuleCreate) SetCreatedAt(t time.Time) *NotificationRuleCreate {
+	nrc.mutation.SetCreatedAt(t)
+	return nrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nrc *NotificationRuleCreate) SetNillableCreatedAt(t *time.Time) *NotificationRuleCreate {
+	if t != nil {
+		nrc.SetCreatedAt(*t)
+	}
+	return nrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nrc *NotificationRuleCreate) SetUpdatedAt(t time.Time) *NotificationRuleCreate {
+	nrc.mutation.SetUpdatedAt(t)
+	return nrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (nrc *NotificationRuleCreate) SetNillableUpdatedAt(t *time.Time) *NotificationRuleCreate {
+	if t != nil {
+		nrc.SetUpdatedAt(*t)
+	}
+	return nrc
+}
+
+// SetNamespace sets the "namespace" field.
+func (nrc *NotificationRuleCreate) SetNamespace(s string) *NotificationRuleCreate {
+	nrc.mutation.SetNamespace(s)
+	return nrc
+}
+
+// SetLedgerID sets the "ledger_id" field.
+func (nrc *NotificationRuleCreate) SetLedgerID(s string) *NotificationRuleCreate {
+	nrc.mutation.SetLedgerID(s)
+	return nrc
+}
+
+// SetFeatureID sets the "feature_id" field.
+func (nrc *NotificationRuleCreate) SetFeatureID(s string) *NotificationRuleCreate {
+	nrc.mutation.SetFeatureID(s)
+	return nrc
+}
+
+// SetNillableFeatureID sets the "feature_id" field if the given value is not nil.
+func (nrc *NotificationRuleCreate) SetNillableFeatureID(s *string) *NotificationRuleCreate {
+	if s != nil {
+		nrc.SetFeatureID(*s)
+	}
+	return nrc
+}
+
+// SetType sets the "type" field.
+func (nrc *NotificationRuleCreate) SetType(crt credit.NotificationRuleType) *NotificationRuleCreate {
+	nrc.mutation.SetType(crt)
+	return nrc
+}
+
+// SetThreshold sets the "threshold" field.
+func (nrc *NotificationRuleCreate) SetThreshold(f float64) *NotificationRuleCreate {
+	nrc.mutation.SetThreshold(f)
+	return nrc
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (nrc *NotificationRuleCreate) SetNillableThreshold(f *float64) *NotificationRuleCreate {
+	if f != nil {
+		nrc.SetThreshold(*f)
+	}
+	return nrc
+}
+
+// SetURL sets the "url" field.
+func (nrc *NotificationRuleCreate) SetURL(s string) *NotificationRuleCreate {
+	nrc.mutation.SetURL(s)
+	return nrc
+}
+
+// SetTriggered sets the "triggered" field.
+func (nrc *NotificationRuleCreate) SetTriggered(b bool) *NotificationRuleCreate {
+	nrc.mutation.SetTriggered(b)
+	return nrc
+}
+
+// SetNillableTriggered sets the "triggered" field if the given value is not nil.
+func (nrc *NotificationRuleCreate) SetNillableTriggered(b *bool) *NotificationRuleCreate {
+	if b != nil {
+		nrc.SetTriggered(*b)
+	}
+	return nrc
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (nrc *NotificationRuleCreate) SetTriggeredAt(t time.Time) *NotificationRuleCreate {
+	nrc.mutation.SetTriggeredAt(t)
+	return nrc
+}
+
+// SetNillableTriggeredAt sets the "triggered_at" field if the given value is not nil.
+func (nrc *NotificationRuleCreate) SetNillableTriggeredAt(t *time.Time) *NotificationRuleCreate {
+	if t != nil {
+		nrc.SetTriggeredAt(*t)
+	}
+	return nrc
+}
+
+// SetID sets the "id" field.
+func (nrc *NotificationRuleCreate) SetID(s string) *NotificationRuleCreate {
+	nrc.mutation.SetID(s)
+	return nrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (nrc *NotificationRuleCreate) SetNillableID(s *string) *NotificationRuleCreate {
+	if s != nil {
+		nrc.SetID(*s)
+	}
+	return nrc
+}
+
+// Mutation returns the NotificationRuleMutation object of the builder.
+func (nrc *NotificationRuleCreate) Mutation() *NotificationRuleMutation {
+	return nrc.mutation
+}
+
+// Save creates the NotificationRule in the database.
+func (nrc *NotificationRuleCreate) Save(ctx context.Context) (*NotificationRule, error) {
+	nrc.defaults()
+	return withHooks(ctx, nrc.sqlSave, nrc.mutation, nrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nrc *NotificationRuleCreate) SaveX(ctx context.Context) *NotificationRule {
+	v, err := nrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nrc *NotificationRuleCreate) Exec(ctx context.Context) error {
+	_, err := nrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nrc *NotificationRuleCreate) ExecX(ctx context.Context) {
+	if err := nrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nrc *NotificationRuleCreate) defaults() {
+	if _, ok := nrc.mutation.CreatedAt(); !ok {
+		v := notificationrule.DefaultCreatedAt()
+		nrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := nrc.mutation.UpdatedAt(); !ok {
+		v := notificationrule.DefaultUpdatedAt()
+		nrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := nrc.mutation.Triggered(); !ok {
+		v := notificationrule.DefaultTriggered
+		nrc.mutation.SetTriggered(v)
+	}
+	if _, ok := nrc.mutation.ID(); !ok {
+		v := notificationrule.DefaultID()
+		nrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nrc *NotificationRuleCreate) check() error {
+	if _, ok := nrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "NotificationRule.created_at"`)}
+	}
+	if _, ok := nrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "NotificationRule.updated_at"`)}
+	}
+	if _, ok := nrc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`db: missing required field "NotificationRule.namespace"`)}
+	}
+	if v, ok := nrc.mutation.Namespace(); ok {
+		if err := notificationrule.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`db: validator failed for field "NotificationRule.namespace": %w`, err)}
+		}
+	}
+	if _, ok := nrc.mutation.LedgerID(); !ok {
+		return &ValidationError{Name: "ledger_id", err: errors.New(`db: missing required field "NotificationRule.ledger_id"`)}
+	}
+	if _, ok := nrc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`db: missing required field "NotificationRule.type"`)}
+	}
+	if v, ok := nrc.mutation.GetType(); ok {
+		if err := notificationrule.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`db: validator failed for field "NotificationRule.type": %w`, err)}
+		}
+	}
+	if _, ok := nrc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`db: missing required field "NotificationRule.url"`)}
+	}
+	if v, ok := nrc.mutation.URL(); ok {
+		if err := notificationrule.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`db: validator failed for field "NotificationRule.url": %w`, err)}
+		}
+	}
+	if _, ok := nrc.mutation.Triggered(); !ok {
+		return &ValidationError{Name: "triggered", err: errors.New(`db: missing required field "NotificationRule.triggered"`)}
+	}
+	return nil
+}
+
+func (nrc *NotificationRuleCreate) sqlSave(ctx context.Context) (*NotificationRule, error) {
+	if err := nrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected NotificationRule.ID type: %T", _spec.ID.Value)
+		}
+	}
+	nrc.mutation.id = &_node.ID
+	nrc.mutation.done = true
+	return _node, nil
+}
+
+func (nrc *NotificationRuleCreate) createSpec() (*NotificationRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationRule{config: nrc.config}
+		_spec = sqlgraph.NewCreateSpec(notificationrule.Table, sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = nrc.conflict
+	if id, ok := nrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := nrc.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := nrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := nrc.mutation.Namespace(); ok {
+		_spec.SetField(notificationrule.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := nrc.mutation.LedgerID(); ok {
+		_spec.SetField(notificationrule.FieldLedgerID, field.TypeString, value)
+		_node.LedgerID = value
+	}
+	if value, ok := nrc.mutation.FeatureID(); ok {
+		_spec.SetField(notificationrule.FieldFeatureID, field.TypeString, value)
+		_node.FeatureID = &value
+	}
+	if value, ok := nrc.mutation.GetType(); ok {
+		_spec.SetField(notificationrule.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := nrc.mutation.Threshold(); ok {
+		_spec.SetField(notificationrule.FieldThreshold, field.TypeFloat64, value)
+		_node.Threshold = &value
+	}
+	if value, ok := nrc.mutation.URL(); ok {
+		_spec.SetField(notificationrule.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := nrc.mutation.Triggered(); ok {
+		_spec.SetField(notificationrule.FieldTriggered, field.TypeBool, value)
+		_node.Triggered = value
+	}
+	if value, ok := nrc.mutation.TriggeredAt(); ok {
+		_spec.SetField(notificationrule.FieldTriggeredAt, field.TypeTime, value)
+		_node.TriggeredAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (nrc *NotificationRuleCreate) OnConflict(opts ...sql.ConflictOption) *NotificationRuleUpsertOne {
+	nrc.conflict = opts
+	return &NotificationRuleUpsertOne{
+		create: nrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nrc *NotificationRuleCreate) OnConflictColumns(columns ...string) *NotificationRuleUpsertOne {
+	nrc.conflict = append(nrc.conflict, sql.ConflictColumns(columns...))
+	return &NotificationRuleUpsertOne{
+		create: nrc,
+	}
+}
+
+type (
+	// NotificationRuleUpsertOne is the builder for "upsert"-ing
+	//  one NotificationRule node.
+	NotificationRuleUpsertOne struct {
+		create *NotificationRuleCreate
+	}
+
+	// NotificationRuleUpsert is the "OnConflict" setter.
+	NotificationRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationRuleUpsert) SetUpdatedAt(v time.Time) *NotificationRuleUpsert {
+	u.Set(notificationrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationRuleUpsert) UpdateUpdatedAt() *NotificationRuleUpsert {
+	u.SetExcluded(notificationrule.FieldUpdatedAt)
+	return u
+}
+
+// SetTriggered sets the "triggered" field.
+func (u *NotificationRuleUpsert) SetTriggered(v bool) *NotificationRuleUpsert {
+	u.Set(notificationrule.FieldTriggered, v)
+	return u
+}
+
+// UpdateTriggered sets the "triggered" field to the value that was provided on create.
+func (u *NotificationRuleUpsert) UpdateTriggered() *NotificationRuleUpsert {
+	u.SetExcluded(notificationrule.FieldTriggered)
+	return u
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (u *NotificationRuleUpsert) SetTriggeredAt(v time.Time) *NotificationRuleUpsert {
+	u.Set(notificationrule.FieldTriggeredAt, v)
+	return u
+}
+
+// UpdateTriggeredAt sets the "triggered_at" field to the value that was provided on create.
+func (u *NotificationRuleUpsert) UpdateTriggeredAt() *NotificationRuleUpsert {
+	u.SetExcluded(notificationrule.FieldTriggeredAt)
+	return u
+}
+
+// ClearTriggeredAt clears the value of the "triggered_at" field.
+func (u *NotificationRuleUpsert) ClearTriggeredAt() *NotificationRuleUpsert {
+	u.SetNull(notificationrule.FieldTriggeredAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NotificationRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationRuleUpsertOne) UpdateNewValues() *NotificationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(notificationrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(notificationrule.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Namespace(); exists {
+			s.SetIgnore(notificationrule.FieldNamespace)
+		}
+		if _, exists := u.create.mutation.LedgerID(); exists {
+			s.SetIgnore(notificationrule.FieldLedgerID)
+		}
+		if _, exists := u.create.mutation.FeatureID(); exists {
+			s.SetIgnore(notificationrule.FieldFeatureID)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(notificationrule.FieldType)
+		}
+		if _, exists := u.create.mutation.Threshold(); exists {
+			s.SetIgnore(notificationrule.FieldThreshold)
+		}
+		if _, exists := u.create.mutation.URL(); exists {
+			s.SetIgnore(notificationrule.FieldURL)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotificationRuleUpsertOne) Ignore() *NotificationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationRuleUpsertOne) DoNothing() *NotificationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationRuleCreate.OnConflict
+// documentation for more info.
+func (u *NotificationRuleUpsertOne) Update(set func(*NotificationRuleUpsert)) *NotificationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationRuleUpsertOne) SetUpdatedAt(v time.Time) *NotificationRuleUpsertOne {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationRuleUpsertOne) UpdateUpdatedAt() *NotificationRuleUpsertOne {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTriggered sets the "triggered" field.
+func (u *NotificationRuleUpsertOne) SetTriggered(v bool) *NotificationRuleUpsertOne {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.SetTriggered(v)
+	})
+}
+
+// UpdateTriggered sets the "triggered" field to the value that was provided on create.
+func (u *NotificationRuleUpsertOne) UpdateTriggered() *NotificationRuleUpsertOne {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.UpdateTriggered()
+	})
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (u *NotificationRuleUpsertOne) SetTriggeredAt(v time.Time) *NotificationRuleUpsertOne {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.SetTriggeredAt(v)
+	})
+}
+
+// UpdateTriggeredAt sets the "triggered_at" field to the value that was provided on create.
+func (u *NotificationRuleUpsertOne) UpdateTriggeredAt() *NotificationRuleUpsertOne {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.UpdateTriggeredAt()
+	})
+}
+
+// ClearTriggeredAt clears the value of the "triggered_at" field.
+func (u *NotificationRuleUpsertOne) ClearTriggeredAt() *NotificationRuleUpsertOne {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.ClearTriggeredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for NotificationRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationRuleUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: NotificationRuleUpsertOne.ID is not supported by MySQL driver. Use NotificationRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationRuleUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationRuleCreateBulk is the builder for creating many NotificationRule entities in bulk.
+type NotificationRuleCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NotificationRule entities in the database.
+func (nrcb *NotificationRuleCreateBulk) Save(ctx context.Context) ([]*NotificationRule, error) {
+	if nrcb.err != nil {
+		return nil, nrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(nrcb.builders))
+	nodes := make([]*NotificationRule, len(nrcb.builders))
+	mutators := make([]Mutator, len(nrcb.builders))
+	for i := range nrcb.builders {
+		func(i int, root context.Context) {
+			builder := nrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, nrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = nrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, nrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, nrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nrcb *NotificationRuleCreateBulk) SaveX(ctx context.Context) []*NotificationRule {
+	v, err := nrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nrcb *NotificationRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := nrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nrcb *NotificationRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := nrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (nrcb *NotificationRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationRuleUpsertBulk {
+	nrcb.conflict = opts
+	return &NotificationRuleUpsertBulk{
+		create: nrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nrcb *NotificationRuleCreateBulk) OnConflictColumns(columns ...string) *NotificationRuleUpsertBulk {
+	nrcb.conflict = append(nrcb.conflict, sql.ConflictColumns(columns...))
+	return &NotificationRuleUpsertBulk{
+		create: nrcb,
+	}
+}
+
+// NotificationRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of NotificationRule nodes.
+type NotificationRuleUpsertBulk struct {
+	create *NotificationRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NotificationRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationRuleUpsertBulk) UpdateNewValues() *NotificationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(notificationrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(notificationrule.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Namespace(); exists {
+				s.SetIgnore(notificationrule.FieldNamespace)
+			}
+			if _, exists := b.mutation.LedgerID(); exists {
+				s.SetIgnore(notificationrule.FieldLedgerID)
+			}
+			if _, exists := b.mutation.FeatureID(); exists {
+				s.SetIgnore(notificationrule.FieldFeatureID)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(notificationrule.FieldType)
+			}
+			if _, exists := b.mutation.Threshold(); exists {
+				s.SetIgnore(notificationrule.FieldThreshold)
+			}
+			if _, exists := b.mutation.URL(); exists {
+				s.SetIgnore(notificationrule.FieldURL)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotificationRuleUpsertBulk) Ignore() *NotificationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationRuleUpsertBulk) DoNothing() *NotificationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationRuleUpsertBulk) Update(set func(*NotificationRuleUpsert)) *NotificationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationRuleUpsertBulk) SetUpdatedAt(v time.Time) *NotificationRuleUpsertBulk {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationRuleUpsertBulk) UpdateUpdatedAt() *NotificationRuleUpsertBulk {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTriggered sets the "triggered" field.
+func (u *NotificationRuleUpsertBulk) SetTriggered(v bool) *NotificationRuleUpsertBulk {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.SetTriggered(v)
+	})
+}
+
+// UpdateTriggered sets the "triggered" field to the value that was provided on create.
+func (u *NotificationRuleUpsertBulk) UpdateTriggered() *NotificationRuleUpsertBulk {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.UpdateTriggered()
+	})
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (u *NotificationRuleUpsertBulk) SetTriggeredAt(v time.Time) *NotificationRuleUpsertBulk {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.SetTriggeredAt(v)
+	})
+}
+
+// UpdateTriggeredAt sets the "triggered_at" field to the value that was provided on create.
+func (u *NotificationRuleUpsertBulk) UpdateTriggeredAt() *NotificationRuleUpsertBulk {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.UpdateTriggeredAt()
+	})
+}
+
+// ClearTriggeredAt clears the value of the "triggered_at" field.
+func (u *NotificationRuleUpsertBulk) ClearTriggeredAt() *NotificationRuleUpsertBulk {
+	return u.Update(func(s *NotificationRuleUpsert) {
+		s.ClearTriggeredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the NotificationRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for NotificationRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationrule"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+)
+
+// NotificationRuleUpdate is the builder for updating NotificationRule entities.
+type NotificationRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationRuleMutation
+}
+
+// Where appends a list predicates to the NotificationRuleUpdate builder.
+func (nru *NotificationRuleUpdate) Where(ps ...predicate.NotificationRule) *NotificationRuleUpdate {
+	nru.mutation.Where(ps...)
+	return nru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nru *NotificationRuleUpdate) SetUpdatedAt(t time.Time) *NotificationRuleUpdate {
+	nru.mutation.SetUpdatedAt(t)
+	return nru
+}
+
+// SetTriggered sets the "triggered" field.
+func (nru *NotificationRuleUpdate) SetTriggered(b bool) *NotificationRuleUpdate {
+	nru.mutation.SetTriggered(b)
+	return nru
+}
+
+// SetNillableTriggered sets the "triggered" field if the given value is not nil.
+func (nru *NotificationRuleUpdate) SetNillableTriggered(b *bool) *NotificationRuleUpdate {
+	if b != nil {
+		nru.SetTriggered(*b)
+	}
+	return nru
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (nru *NotificationRuleUpdate) SetTriggeredAt(t time.Time) *NotificationRuleUpdate {
+	nru.mutation.SetTriggeredAt(t)
+	return nru
+}
+
+// SetNillableTriggeredAt sets the "triggered_at" field if the given value is not nil.
+func (nru *NotificationRuleUpdate) SetNillableTriggeredAt(t *time.Time) *NotificationRuleUpdate {
+	if t != nil {
+		nru.SetTriggeredAt(*t)
+	}
+	return nru
+}
+
+// ClearTriggeredAt clears the value of the "triggered_at" field.
+func (nru *NotificationRuleUpdate) ClearTriggeredAt() *NotificationRuleUpdate {
+	nru.mutation.ClearTriggeredAt()
+	return nru
+}
+
+// Mutation returns the NotificationRuleMutation object of the builder.
+func (nru *NotificationRuleUpdate) Mutation() *NotificationRuleMutation {
+	return nru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nru *NotificationRuleUpdate) Save(ctx context.Context) (int, error) {
+	nru.defaults()
+	return withHooks(ctx, nru.sqlSave, nru.mutation, nru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nru *NotificationRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := nru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nru *NotificationRuleUpdate) Exec(ctx context.Context) error {
+	_, err := nru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nru *NotificationRuleUpdate) ExecX(ctx context.Context) {
+	if err := nru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nru *NotificationRuleUpdate) defaults() {
+	if _, ok := nru.mutation.UpdatedAt(); !ok {
+		v := notificationrule.UpdateDefaultUpdatedAt()
+		nru.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (nru *NotificationRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(notificationrule.Table, notificationrule.Columns, sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeString))
+	if ps := nru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nru.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if nru.mutation.FeatureIDCleared() {
+		_spec.ClearField(notificationrule.FieldFeatureID, field.TypeString)
+	}
+	if nru.mutation.ThresholdCleared() {
+		_spec.ClearField(notificationrule.FieldThreshold, field.TypeFloat64)
+	}
+	if value, ok := nru.mutation.Triggered(); ok {
+		_spec.SetField(notificationrule.FieldTriggered, field.TypeBool, value)
+	}
+	if value, ok := nru.mutation.TriggeredAt(); ok {
+		_spec.SetField(notificationrule.FieldTriggeredAt, field.TypeTime, value)
+	}
+	if nru.mutation.TriggeredAtCleared() {
+		_spec.ClearField(notificationrule.FieldTriggeredAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nru.mutation.done = true
+	return n, nil
+}
+
+// NotificationRuleUpdateOne is the builder for updating a single NotificationRule entity.
+type NotificationRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationRuleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nruo *NotificationRuleUpdateOne) SetUpdatedAt(t time.Time) *NotificationRuleUpdateOne {
+	nruo.mutation.SetUpdatedAt(t)
+	return nruo
+}
+
+// SetTriggered sets the "triggered" field.
+func (nruo *NotificationRuleUpdateOne) SetTriggered(b bool) *NotificationRuleUpdateOne {
+	nruo.mutation.SetTriggered(b)
+	return nruo
+}
+
+// SetNillableTriggered sets the "triggered" field if the given value is not nil.
+func (nruo *NotificationRuleUpdateOne) SetNillableTriggered(b *bool) *NotificationRuleUpdateOne {
+	if b != nil {
+		nruo.SetTriggered(*b)
+	}
+	return nruo
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (nruo *NotificationRuleUpdateOne) SetTriggeredAt(t time.Time) *NotificationRuleUpdateOne {
+	nruo.mutation.SetTriggeredAt(t)
+	return nruo
+}
+
+// SetNillableTriggeredAt sets the "triggered_at" field if the given value is not nil.
+func (nruo *NotificationRuleUpdateOne) SetNillableTriggeredAt(t *time.Time) *NotificationRuleUpdateOne {
+	if t != nil {
+		nruo.SetTriggeredAt(*t)
+	}
+	return nruo
+}
+
+// ClearTriggeredAt clears the value of the "triggered_at" field.
+func (nruo *NotificationRuleUpdateOne) ClearTriggeredAt() *NotificationRuleUpdateOne {
+	nruo.mutation.ClearTriggeredAt()
+	return nruo
+}
+
+// Mutation returns the NotificationRuleMutation object of the builder.
+func (nruo *NotificationRuleUpdateOne) Mutation() *NotificationRuleMutation {
+	return nruo.mutation
+}
+
+// Where appends a list predicates to the NotificationRuleUpdate builder.
+func (nruo *NotificationRuleUpdateOne) Where(ps ...predicate.NotificationRule) *NotificationRuleUpdateOne {
+	nruo.mutation.Where(ps...)
+	return nruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nruo *NotificationRuleUpdateOne) Select(field string, fields ...string) *NotificationRuleUpdateOne {
+	nruo.fields = append([]string{field}, fields...)
+	return nruo
+}
+
+// Save executes the query and returns the updated NotificationRule entity.
+func (nruo *NotificationRuleUpdateOne) Save(ctx context.Context) (*NotificationRule, error) {
+	nruo.defaults()
+	return withHooks(ctx, nruo.sqlSave, nruo.mutation, nruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nruo *NotificationRuleUpdateOne) SaveX(ctx context.Context) *NotificationRule {
+	node, err := nruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nruo *NotificationRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := nruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nruo *NotificationRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := nruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nruo *NotificationRuleUpdateOne) defaults() {
+	if _, ok := nruo.mutation.UpdatedAt(); !ok {
+		v := notificationrule.UpdateDefaultUpdatedAt()
+		nruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (nruo *NotificationRuleUpdateOne) sqlSave(ctx context.Context) (_node *NotificationRule, err error) {
+	_spec := sqlgraph.NewUpdateSpec(notificationrule.Table, notificationrule.Columns, sqlgraph.NewFieldSpec(notificationrule.FieldID, field.TypeString))
+	id, ok := nruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "NotificationRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationrule.FieldID)
+		for _, f := range fields {
+			if !notificationrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != notificationrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if nruo.mutation.FeatureIDCleared() {
+		_spec.ClearField(notificationrule.FieldFeatureID, field.TypeString)
+	}
+	if nruo.mutation.ThresholdCleared() {
+		_spec.ClearField(notificationrule.FieldThreshold, field.TypeFloat64)
+	}
+	if value, ok := nruo.mutation.Triggered(); ok {
+		_spec.SetField(notificationrule.FieldTriggered, field.TypeBool, value)
+	}
+	if value, ok := nruo.mutation.TriggeredAt(); ok {
+		_spec.SetField(notificationrule.FieldTriggeredAt, field.TypeTime, value)
+	}
+	if nruo.mutation.TriggeredAtCleared() {
+		_spec.ClearField(notificationrule.FieldTriggeredAt, field.TypeTime)
+	}
+	_node = &NotificationRule{config: nruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nruo.mutation.done = true
+	return _node, nil
+}
@@ -6,11 +6,29 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// BalanceSnapshot is the predicate function for balancesnapshot builders.
+type BalanceSnapshot func(*sql.Selector)
+
 // CreditEntry is the predicate function for creditentry builders.
 type CreditEntry func(*sql.Selector)
 
+// EventType is the predicate function for eventtype builders.
+type EventType func(*sql.Selector)
+
 // Feature is the predicate function for feature builders.
 type Feature func(*sql.Selector)
 
+// GrantSchedule is the predicate function for grantschedule builders.
+type GrantSchedule func(*sql.Selector)
+
 // Ledger is the predicate function for ledger builders.
 type Ledger func(*sql.Selector)
+
+// NotificationDelivery is the predicate function for notificationdelivery builders.
+type NotificationDelivery func(*sql.Selector)
+
+// NotificationRule is the predicate function for notificationrule builders.
+type NotificationRule func(*sql.Selector)
+
+// SubjectAlias is the predicate function for subjectalias builders.
+type SubjectAlias func(*sql.Selector)
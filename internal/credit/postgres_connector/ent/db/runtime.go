@@ -5,9 +5,15 @@ package db
 import (
 	"time"
 
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/balancesnapshot"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/creditentry"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/eventtype"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/feature"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/grantschedule"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/ledger"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationdelivery"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationrule"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/subjectalias"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/schema"
 )
 
@@ -15,6 +21,31 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	balancesnapshotMixin := schema.BalanceSnapshot{}.Mixin()
+	balancesnapshotMixinFields0 := balancesnapshotMixin[0].Fields()
+	_ = balancesnapshotMixinFields0
+	balancesnapshotMixinFields1 := balancesnapshotMixin[1].Fields()
+	_ = balancesnapshotMixinFields1
+	balancesnapshotFields := schema.BalanceSnapshot{}.Fields()
+	_ = balancesnapshotFields
+	// balancesnapshotDescCreatedAt is the schema descriptor for created_at field.
+	balancesnapshotDescCreatedAt := balancesnapshotMixinFields1[0].Descriptor()
+	// balancesnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	balancesnapshot.DefaultCreatedAt = balancesnapshotDescCreatedAt.Default.(func() time.Time)
+	// balancesnapshotDescUpdatedAt is the schema descriptor for updated_at field.
+	balancesnapshotDescUpdatedAt := balancesnapshotMixinFields1[1].Descriptor()
+	// balancesnapshot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	balancesnapshot.DefaultUpdatedAt = balancesnapshotDescUpdatedAt.Default.(func() time.Time)
+	// balancesnapshot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	balancesnapshot.UpdateDefaultUpdatedAt = balancesnapshotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// balancesnapshotDescNamespace is the schema descriptor for namespace field.
+	balancesnapshotDescNamespace := balancesnapshotFields[0].Descriptor()
+	// balancesnapshot.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	balancesnapshot.NamespaceValidator = balancesnapshotDescNamespace.Validators[0].(func(string) error)
+	// balancesnapshotDescID is the schema descriptor for id field.
+	balancesnapshotDescID := balancesnapshotMixinFields0[0].Descriptor()
+	// balancesnapshot.DefaultID holds the default value on creation for the id field.
+	balancesnapshot.DefaultID = balancesnapshotDescID.Default.(func() string)
 	creditentryMixin := schema.CreditEntry{}.Mixin()
 	creditentryMixinFields0 := creditentryMixin[0].Fields()
 	_ = creditentryMixinFields0
@@ -37,17 +68,46 @@ func init() {
 	// creditentry.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
 	creditentry.NamespaceValidator = creditentryDescNamespace.Validators[0].(func(string) error)
 	// creditentryDescPriority is the schema descriptor for priority field.
-	creditentryDescPriority := creditentryFields[6].Descriptor()
+	creditentryDescPriority := creditentryFields[7].Descriptor()
 	// creditentry.DefaultPriority holds the default value on creation for the priority field.
 	creditentry.DefaultPriority = creditentryDescPriority.Default.(uint8)
 	// creditentryDescEffectiveAt is the schema descriptor for effective_at field.
-	creditentryDescEffectiveAt := creditentryFields[7].Descriptor()
+	creditentryDescEffectiveAt := creditentryFields[8].Descriptor()
 	// creditentry.DefaultEffectiveAt holds the default value on creation for the effective_at field.
 	creditentry.DefaultEffectiveAt = creditentryDescEffectiveAt.Default.(func() time.Time)
 	// creditentryDescID is the schema descriptor for id field.
 	creditentryDescID := creditentryMixinFields0[0].Descriptor()
 	// creditentry.DefaultID holds the default value on creation for the id field.
 	creditentry.DefaultID = creditentryDescID.Default.(func() string)
+	eventtypeMixin := schema.EventType{}.Mixin()
+	eventtypeMixinFields0 := eventtypeMixin[0].Fields()
+	_ = eventtypeMixinFields0
+	eventtypeMixinFields1 := eventtypeMixin[1].Fields()
+	_ = eventtypeMixinFields1
+	eventtypeFields := schema.EventType{}.Fields()
+	_ = eventtypeFields
+	// eventtypeDescCreatedAt is the schema descriptor for created_at field.
+	eventtypeDescCreatedAt := eventtypeMixinFields1[0].Descriptor()
+	// eventtype.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventtype.DefaultCreatedAt = eventtypeDescCreatedAt.Default.(func() time.Time)
+	// eventtypeDescUpdatedAt is the schema descriptor for updated_at field.
+	eventtypeDescUpdatedAt := eventtypeMixinFields1[1].Descriptor()
+	// eventtype.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	eventtype.DefaultUpdatedAt = eventtypeDescUpdatedAt.Default.(func() time.Time)
+	// eventtype.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	eventtype.UpdateDefaultUpdatedAt = eventtypeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// eventtypeDescNamespace is the schema descriptor for namespace field.
+	eventtypeDescNamespace := eventtypeFields[0].Descriptor()
+	// eventtype.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	eventtype.NamespaceValidator = eventtypeDescNamespace.Validators[0].(func(string) error)
+	// eventtypeDescType is the schema descriptor for type field.
+	eventtypeDescType := eventtypeFields[1].Descriptor()
+	// eventtype.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	eventtype.TypeValidator = eventtypeDescType.Validators[0].(func(string) error)
+	// eventtypeDescID is the schema descriptor for id field.
+	eventtypeDescID := eventtypeMixinFields0[0].Descriptor()
+	// eventtype.DefaultID holds the default value on creation for the id field.
+	eventtype.DefaultID = eventtypeDescID.Default.(func() string)
 	featureMixin := schema.Feature{}.Mixin()
 	featureMixinFields0 := featureMixin[0].Fields()
 	_ = featureMixinFields0
@@ -78,13 +138,46 @@ func init() {
 	// feature.MeterSlugValidator is a validator for the "meter_slug" field. It is called by the builders before save.
 	feature.MeterSlugValidator = featureDescMeterSlug.Validators[0].(func(string) error)
 	// featureDescArchived is the schema descriptor for archived field.
-	featureDescArchived := featureFields[4].Descriptor()
+	featureDescArchived := featureFields[6].Descriptor()
 	// feature.DefaultArchived holds the default value on creation for the archived field.
 	feature.DefaultArchived = featureDescArchived.Default.(bool)
 	// featureDescID is the schema descriptor for id field.
 	featureDescID := featureMixinFields0[0].Descriptor()
 	// feature.DefaultID holds the default value on creation for the id field.
 	feature.DefaultID = featureDescID.Default.(func() string)
+	grantscheduleMixin := schema.GrantSchedule{}.Mixin()
+	grantscheduleMixinFields0 := grantscheduleMixin[0].Fields()
+	_ = grantscheduleMixinFields0
+	grantscheduleMixinFields1 := grantscheduleMixin[1].Fields()
+	_ = grantscheduleMixinFields1
+	grantscheduleFields := schema.GrantSchedule{}.Fields()
+	_ = grantscheduleFields
+	// grantscheduleDescCreatedAt is the schema descriptor for created_at field.
+	grantscheduleDescCreatedAt := grantscheduleMixinFields1[0].Descriptor()
+	// grantschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	grantschedule.DefaultCreatedAt = grantscheduleDescCreatedAt.Default.(func() time.Time)
+	// grantscheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	grantscheduleDescUpdatedAt := grantscheduleMixinFields1[1].Descriptor()
+	// grantschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	grantschedule.DefaultUpdatedAt = grantscheduleDescUpdatedAt.Default.(func() time.Time)
+	// grantschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	grantschedule.UpdateDefaultUpdatedAt = grantscheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// grantscheduleDescNamespace is the schema descriptor for namespace field.
+	grantscheduleDescNamespace := grantscheduleFields[0].Descriptor()
+	// grantschedule.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	grantschedule.NamespaceValidator = grantscheduleDescNamespace.Validators[0].(func(string) error)
+	// grantscheduleDescPriority is the schema descriptor for priority field.
+	grantscheduleDescPriority := grantscheduleFields[5].Descriptor()
+	// grantschedule.DefaultPriority holds the default value on creation for the priority field.
+	grantschedule.DefaultPriority = grantscheduleDescPriority.Default.(uint8)
+	// grantscheduleDescNextGrantIndex is the schema descriptor for next_grant_index field.
+	grantscheduleDescNextGrantIndex := grantscheduleFields[13].Descriptor()
+	// grantschedule.DefaultNextGrantIndex holds the default value on creation for the next_grant_index field.
+	grantschedule.DefaultNextGrantIndex = grantscheduleDescNextGrantIndex.Default.(int)
+	// grantscheduleDescID is the schema descriptor for id field.
+	grantscheduleDescID := grantscheduleMixinFields0[0].Descriptor()
+	// grantschedule.DefaultID holds the default value on creation for the id field.
+	grantschedule.DefaultID = grantscheduleDescID.Default.(func() string)
 	ledgerMixin := schema.Ledger{}.Mixin()
 	ledgerMixinFields0 := ledgerMixin[0].Fields()
 	_ = ledgerMixinFields0
@@ -110,12 +203,119 @@ func init() {
 	ledgerDescSubject := ledgerFields[1].Descriptor()
 	// ledger.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
 	ledger.SubjectValidator = ledgerDescSubject.Validators[0].(func(string) error)
+	// ledgerDescNextResetIndex is the schema descriptor for next_reset_index field.
+	ledgerDescNextResetIndex := ledgerFields[7].Descriptor()
+	// ledger.DefaultNextResetIndex holds the default value on creation for the next_reset_index field.
+	ledger.DefaultNextResetIndex = ledgerDescNextResetIndex.Default.(int)
 	// ledgerDescHighwatermark is the schema descriptor for highwatermark field.
-	ledgerDescHighwatermark := ledgerFields[3].Descriptor()
+	ledgerDescHighwatermark := ledgerFields[9].Descriptor()
 	// ledger.DefaultHighwatermark holds the default value on creation for the highwatermark field.
 	ledger.DefaultHighwatermark = ledgerDescHighwatermark.Default.(func() time.Time)
 	// ledgerDescID is the schema descriptor for id field.
 	ledgerDescID := ledgerMixinFields1[0].Descriptor()
 	// ledger.DefaultID holds the default value on creation for the id field.
 	ledger.DefaultID = ledgerDescID.Default.(func() string)
+	notificationdeliveryMixin := schema.NotificationDelivery{}.Mixin()
+	notificationdeliveryMixinFields0 := notificationdeliveryMixin[0].Fields()
+	_ = notificationdeliveryMixinFields0
+	notificationdeliveryMixinFields1 := notificationdeliveryMixin[1].Fields()
+	_ = notificationdeliveryMixinFields1
+	notificationdeliveryFields := schema.NotificationDelivery{}.Fields()
+	_ = notificationdeliveryFields
+	// notificationdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	notificationdeliveryDescCreatedAt := notificationdeliveryMixinFields1[0].Descriptor()
+	// notificationdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationdelivery.DefaultCreatedAt = notificationdeliveryDescCreatedAt.Default.(func() time.Time)
+	// notificationdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationdeliveryDescUpdatedAt := notificationdeliveryMixinFields1[1].Descriptor()
+	// notificationdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationdelivery.DefaultUpdatedAt = notificationdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// notificationdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationdelivery.UpdateDefaultUpdatedAt = notificationdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationdeliveryDescNamespace is the schema descriptor for namespace field.
+	notificationdeliveryDescNamespace := notificationdeliveryFields[0].Descriptor()
+	// notificationdelivery.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	notificationdelivery.NamespaceValidator = notificationdeliveryDescNamespace.Validators[0].(func(string) error)
+	// notificationdeliveryDescURL is the schema descriptor for url field.
+	notificationdeliveryDescURL := notificationdeliveryFields[3].Descriptor()
+	// notificationdelivery.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	notificationdelivery.URLValidator = notificationdeliveryDescURL.Validators[0].(func(string) error)
+	// notificationdeliveryDescAttempts is the schema descriptor for attempts field.
+	notificationdeliveryDescAttempts := notificationdeliveryFields[6].Descriptor()
+	// notificationdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	notificationdelivery.DefaultAttempts = notificationdeliveryDescAttempts.Default.(int)
+	// notificationdeliveryDescID is the schema descriptor for id field.
+	notificationdeliveryDescID := notificationdeliveryMixinFields0[0].Descriptor()
+	// notificationdelivery.DefaultID holds the default value on creation for the id field.
+	notificationdelivery.DefaultID = notificationdeliveryDescID.Default.(func() string)
+	notificationruleMixin := schema.NotificationRule{}.Mixin()
+	notificationruleMixinFields0 := notificationruleMixin[0].Fields()
+	_ = notificationruleMixinFields0
+	notificationruleMixinFields1 := notificationruleMixin[1].Fields()
+	_ = notificationruleMixinFields1
+	notificationruleFields := schema.NotificationRule{}.Fields()
+	_ = notificationruleFields
+	// notificationruleDescCreatedAt is the schema descriptor for created_at field.
+	notificationruleDescCreatedAt := notificationruleMixinFields1[0].Descriptor()
+	// notificationrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationrule.DefaultCreatedAt = notificationruleDescCreatedAt.Default.(func() time.Time)
+	// notificationruleDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationruleDescUpdatedAt := notificationruleMixinFields1[1].Descriptor()
+	// notificationrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationrule.DefaultUpdatedAt = notificationruleDescUpdatedAt.Default.(func() time.Time)
+	// notificationrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationrule.UpdateDefaultUpdatedAt = notificationruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationruleDescNamespace is the schema descriptor for namespace field.
+	notificationruleDescNamespace := notificationruleFields[0].Descriptor()
+	// notificationrule.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	notificationrule.NamespaceValidator = notificationruleDescNamespace.Validators[0].(func(string) error)
+	// notificationruleDescURL is the schema descriptor for url field.
+	notificationruleDescURL := notificationruleFields[5].Descriptor()
+	// notificationrule.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	notificationrule.URLValidator = notificationruleDescURL.Validators[0].(func(string) error)
+	// notificationruleDescTriggered is the schema descriptor for triggered field.
+	notificationruleDescTriggered := notificationruleFields[6].Descriptor()
+	// notificationrule.DefaultTriggered holds the default value on creation for the triggered field.
+	notificationrule.DefaultTriggered = notificationruleDescTriggered.Default.(bool)
+	// notificationruleDescID is the schema descriptor for id field.
+	notificationruleDescID := notificationruleMixinFields0[0].Descriptor()
+	// notificationrule.DefaultID holds the default value on creation for the id field.
+	notificationrule.DefaultID = notificationruleDescID.Default.(func() string)
+	subjectaliasMixin := schema.SubjectAlias{}.Mixin()
+	subjectaliasMixinFields0 := subjectaliasMixin[0].Fields()
+	_ = subjectaliasMixinFields0
+	subjectaliasMixinFields1 := subjectaliasMixin[1].Fields()
+	_ = subjectaliasMixinFields1
+	subjectaliasFields := schema.SubjectAlias{}.Fields()
+	_ = subjectaliasFields
+	// subjectaliasDescCreatedAt is the schema descriptor for created_at field.
+	subjectaliasDescCreatedAt := subjectaliasMixinFields1[0].Descriptor()
+	// subjectalias.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subjectalias.DefaultCreatedAt = subjectaliasDescCreatedAt.Default.(func() time.Time)
+	// subjectaliasDescUpdatedAt is the schema descriptor for updated_at field.
+	subjectaliasDescUpdatedAt := subjectaliasMixinFields1[1].Descriptor()
+	// subjectalias.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subjectalias.DefaultUpdatedAt = subjectaliasDescUpdatedAt.Default.(func() time.Time)
+	// subjectalias.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subjectalias.UpdateDefaultUpdatedAt = subjectaliasDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// subjectaliasDescNamespace is the schema descriptor for namespace field.
+	subjectaliasDescNamespace := subjectaliasFields[0].Descriptor()
+	// subjectalias.NamespaceValidator is a validator for the "namespace" field. It is called by the builders before save.
+	subjectalias.NamespaceValidator = subjectaliasDescNamespace.Validators[0].(func(string) error)
+	// subjectaliasDescSource is the schema descriptor for source field.
+	subjectaliasDescSource := subjectaliasFields[1].Descriptor()
+	// subjectalias.DefaultSource holds the default value on creation for the source field.
+	subjectalias.DefaultSource = subjectaliasDescSource.Default.(string)
+	// subjectaliasDescSubject is the schema descriptor for subject field.
+	subjectaliasDescSubject := subjectaliasFields[2].Descriptor()
+	// subjectalias.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	subjectalias.SubjectValidator = subjectaliasDescSubject.Validators[0].(func(string) error)
+	// subjectaliasDescCanonicalSubject is the schema descriptor for canonical_subject field.
+	subjectaliasDescCanonicalSubject := subjectaliasFields[3].Descriptor()
+	// subjectalias.CanonicalSubjectValidator is a validator for the "canonical_subject" field. It is called by the builders before save.
+	subjectalias.CanonicalSubjectValidator = subjectaliasDescCanonicalSubject.Validators[0].(func(string) error)
+	// subjectaliasDescID is the schema descriptor for id field.
+	subjectaliasDescID := subjectaliasMixinFields0[0].Descriptor()
+	// subjectalias.DefaultID holds the default value on creation for the id field.
+	subjectalias.DefaultID = subjectaliasDescID.Default.(func() string)
 }
@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/subjectalias"
+)
+
+// SubjectAlias is the model entity for the SubjectAlias schema.
+type SubjectAlias struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// CanonicalSubject holds the value of the "canonical_subject" field.
+	CanonicalSubject string `json:"canonical_subject,omitempty"`
+	selectValues     sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SubjectAlias) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case subjectalias.FieldID, subjectalias.FieldNamespace, subjectalias.FieldSource, subjectalias.FieldSubject, subjectalias.FieldCanonicalSubject:
+			values[i] = new(sql.NullString)
+		case subjectalias.FieldCreatedAt, subjectalias.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SubjectAlias fields.
+func (sa *SubjectAlias) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case subjectalias.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				sa.ID = value.String
+			}
+		case subjectalias.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sa.CreatedAt = value.Time
+			}
+		case subjectalias.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sa.UpdatedAt = value.Time
+			}
+		case subjectalias.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				sa.Namespace = value.String
+			}
+		case subjectalias.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				sa.Source = value.String
+			}
+		case subjectalias.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				sa.Subject = value.String
+			}
+		case subjectalias.FieldCanonicalSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field canonical_subject", values[i])
+			} else if value.Valid {
+				sa.CanonicalSubject = value.String
+			}
+		default:
+			sa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SubjectAlias.
+// This includes values selected through modifiers, order, etc.
+func (sa *SubjectAlias) Value(name string) (ent.Value, error) {
+	return sa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SubjectAlias.
+// Note that you need to call SubjectAlias.Unwrap() before calling this method if this SubjectAlias
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *SubjectAlias) Update() *SubjectAliasUpdateOne {
+	return NewSubjectAliasClient(sa.config).UpdateOne(sa)
+}
+
+// Unwrap unwraps the SubjectAlias entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *SubjectAlias) Unwrap() *SubjectAlias {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("db: SubjectAlias is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *SubjectAlias) String() string {
+	var builder strings.Builder
+	builder.WriteString("SubjectAlias(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(sa.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(sa.Source)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(sa.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("canonical_subject=")
+	builder.WriteString(sa.CanonicalSubject)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SubjectAliasSlice is a parsable slice of SubjectAlias.
+type SubjectAliasSlice []*SubjectAlias
@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subjectalias
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldSource, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldSubject, v))
+}
+
+// CanonicalSubject applies equality check predicate on the "canonical_subject" field. It's identical to CanonicalSubjectEQ.
+func CanonicalSubject(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldCanonicalSubject, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldContainsFold(FieldSource, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// CanonicalSubjectEQ applies the EQ predicate on the "canonical_subject" field.
+func CanonicalSubjectEQ(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEQ(FieldCanonicalSubject, v))
+}
+
+// CanonicalSubjectNEQ applies the NEQ predicate on the "canonical_subject" field.
+func CanonicalSubjectNEQ(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNEQ(FieldCanonicalSubject, v))
+}
+
+// CanonicalSubjectIn applies the In predicate on the "canonical_subject" field.
+func CanonicalSubjectIn(vs ...string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldIn(FieldCanonicalSubject, vs...))
+}
+
+// CanonicalSubjectNotIn applies the NotIn predicate on the "canonical_subject" field.
+func CanonicalSubjectNotIn(vs ...string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldNotIn(FieldCanonicalSubject, vs...))
+}
+
+// CanonicalSubjectGT applies the GT predicate on the "canonical_subject" field.
+func CanonicalSubjectGT(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGT(FieldCanonicalSubject, v))
+}
+
+// CanonicalSubjectGTE applies the GTE predicate on the "canonical_subject" field.
+func CanonicalSubjectGTE(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldGTE(FieldCanonicalSubject, v))
+}
+
+// CanonicalSubjectLT applies the LT predicate on the "canonical_subject" field.
+func CanonicalSubjectLT(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLT(FieldCanonicalSubject, v))
+}
+
+// CanonicalSubjectLTE applies the LTE predicate on the "canonical_subject" field.
+func CanonicalSubjectLTE(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldLTE(FieldCanonicalSubject, v))
+}
+
+// CanonicalSubjectContains applies the Contains predicate on the "canonical_subject" field.
+func CanonicalSubjectContains(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldContains(FieldCanonicalSubject, v))
+}
+
+// CanonicalSubjectHasPrefix applies the HasPrefix predicate on the "canonical_subject" field.
+func CanonicalSubjectHasPrefix(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldHasPrefix(FieldCanonicalSubject, v))
+}
+
+// CanonicalSubjectHasSuffix applies the HasSuffix predicate on the "canonical_subject" field.
+func CanonicalSubjectHasSuffix(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldHasSuffix(FieldCanonicalSubject, v))
+}
+
+// CanonicalSubjectEqualFold applies the EqualFold predicate on the "canonical_subject" field.
+func CanonicalSubjectEqualFold(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldEqualFold(FieldCanonicalSubject, v))
+}
+
+// CanonicalSubjectContainsFold applies the ContainsFold predicate on the "canonical_subject" field.
+func CanonicalSubjectContainsFold(v string) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.FieldContainsFold(FieldCanonicalSubject, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SubjectAlias) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SubjectAlias) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SubjectAlias) predicate.SubjectAlias {
+	return predicate.SubjectAlias(sql.NotPredicates(p))
+}
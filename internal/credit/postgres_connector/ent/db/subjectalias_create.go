@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/subjectalias"
+)
+
+// SubjectAliasCreate is the builder for creating a SubjectAlias entity.
+type SubjectAliasCreate struct {
+	config
+	mutation *SubjectAliasMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sac *SubjectAliasCreate) SetCreatedAt(t time.Time) *SubjectAliasCreate {
+	sac.mutation.SetCreatedAt(t)
+	return sac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sac *SubjectAliasCreate) SetNillableCreatedAt(t *time.Time) *SubjectAliasCreate {
+	if t != nil {
+		sac.SetCreatedAt(*t)
+	}
+	return sac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sac *SubjectAliasCreate) SetUpdatedAt(t time.Time) *SubjectAliasCreate {
+	sac.mutation.SetUpdatedAt(t)
+	return sac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sac *SubjectAliasCreate) SetNillableUpdatedAt(t *time.Time) *SubjectAliasCreate {
+	if t != nil {
+		sac.SetUpdatedAt(*t)
+	}
+	return sac
+}
+
+// SetNamespace sets the "namespace" field.
+func (sac *SubjectAliasCreate) SetNamespace(s string) *SubjectAliasCreate {
+	sac.mutation.SetNamespace(s)
+	return sac
+}
+
+// SetSource sets the "source" field.
+func (sac *SubjectAliasCreate) SetSource(s string) *SubjectAliasCreate {
+	sac.mutation.SetSource(s)
+	return sac
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (sac *SubjectAliasCreate) SetNillableSource(s *string) *SubjectAliasCreate {
+	if s != nil {
+		sac.SetSource(*s)
+	}
+	return sac
+}
+
+// SetSubject sets the "subject" field.
+func (sac *SubjectAliasCreate) SetSubject(s string) *SubjectAliasCreate {
+	sac.mutation.SetSubject(s)
+	return sac
+}
+
+// SetCanonicalSubject sets the "canonical_subject" field.
+func (sac *SubjectAliasCreate) SetCanonicalSubject(s string) *SubjectAliasCreate {
+	sac.mutation.SetCanonicalSubject(s)
+	return sac
+}
+
+// SetID sets the "id" field.
+func (sac *SubjectAliasCreate) SetID(s string) *SubjectAliasCreate {
+	sac.mutation.SetID(s)
+	return sac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sac *SubjectAliasCreate) SetNillableID(s *string) *SubjectAliasCreate {
+	if s != nil {
+		sac.SetID(*s)
+	}
+	return sac
+}
+
+// Mutation returns the SubjectAliasMutation object of the builder.
+func (sac *SubjectAliasCreate) Mutation() *SubjectAliasMutation {
+	return sac.mutation
+}
+
+// Save creates the SubjectAlias in the database.
+func (sac *SubjectAliasCreate) Save(ctx context.Context) (*SubjectAlias, error) {
+	sac.defaults()
+	return withHooks(ctx, sac.sqlSave, sac.mutation, sac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *SubjectAliasCreate) SaveX(ctx context.Context) *SubjectAlias {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *SubjectAliasCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *SubjectAliasCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *SubjectAliasCreate) defaults() {
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		v := subjectalias.DefaultCreatedAt()
+		sac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sac.mutation.UpdatedAt(); !ok {
+		v := subjectalias.DefaultUpdatedAt()
+		sac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sac.mutation.Source(); !ok {
+		v := subjectalias.DefaultSource
+		sac.mutation.SetSource(v)
+	}
+	if _, ok := sac.mutation.ID(); !ok {
+		v := subjectalias.DefaultID()
+		sac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *SubjectAliasCreate) check() error {
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "SubjectAlias.created_at"`)}
+	}
+	if _, ok := sac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "SubjectAlias.updated_at"`)}
+	}
+	if _, ok := sac.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`db: missing required field "SubjectAlias.namespace"`)}
+	}
+	if v, ok := sac.mutation.Namespace(); ok {
+		if err := subjectalias.NamespaceValidator(v); err != nil {
+			return &ValidationError{Name: "namespace", err: fmt.Errorf(`db: validator failed for field "SubjectAlias.namespace": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`db: missing required field "SubjectAlias.source"`)}
+	}
+	if _, ok := sac.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`db: missing required field "SubjectAlias.subject"`)}
+	}
+	if v, ok := sac.mutation.Subject(); ok {
+		if err := subjectalias.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`db: validator failed for field "SubjectAlias.subject": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.CanonicalSubject(); !ok {
+		return &ValidationError{Name: "canonical_subject", err: errors.New(`db: missing required field "SubjectAlias.canonical_subject"`)}
+	}
+	if v, ok := sac.mutation.CanonicalSubject(); ok {
+		if err := subjectalias.CanonicalSubjectValidator(v); err != nil {
+			return &ValidationError{Name: "canonical_subject", err: fmt.Errorf(`db: validator failed for field "SubjectAlias.canonical_subject": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sac *SubjectAliasCreate) sqlSave(ctx context.Context) (*SubjectAlias, error) {
+	if err := sac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SubjectAlias.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sac.mutation.id = &_node.ID
+	sac.mutation.done = true
+	return _node, nil
+}
+
+func (sac *SubjectAliasCreate) createSpec() (*SubjectAlias, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SubjectAlias{config: sac.config}
+		_spec = sqlgraph.NewCreateSpec(subjectalias.Table, sqlgraph.NewFieldSpec(subjectalias.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = sac.conflict
+	if id, ok := sac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sac.mutation.CreatedAt(); ok {
+		_spec.SetField(subjectalias.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sac.mutation.UpdatedAt(); ok {
+		_spec.SetField(subjectalias.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sac.mutation.Namespace(); ok {
+		_spec.SetField(subjectalias.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := sac.mutation.Source(); ok {
+		_spec.SetField(subjectalias.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := sac.mutation.Subject(); ok {
+		_spec.SetField(subjectalias.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := sac.mutation.CanonicalSubject(); ok {
+		_spec.SetField(subjectalias.FieldCanonicalSubject, field.TypeString, value)
+		_node.CanonicalSubject = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SubjectAlias.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubjectAliasUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sac *SubjectAliasCreate) OnConflict(opts ...sql.ConflictOption) *SubjectAliasUpsertOne {
+	sac.conflict = opts
+	return &SubjectAliasUpsertOne{
+		create: sac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SubjectAlias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sac *SubjectAliasCreate) OnConflictColumns(columns ...string) *SubjectAliasUpsertOne {
+	sac.conflict = append(sac.conflict, sql.ConflictColumns(columns...))
+	return &SubjectAliasUpsertOne{
+		create: sac,
+	}
+}
+
+type (
+	// SubjectAliasUpsertOne is the builder for "upsert"-ing
+	//  one SubjectAlias node.
+	SubjectAliasUpsertOne struct {
+		create *SubjectAliasCreate
+	}
+
+	// SubjectAliasUpsert is the "OnConflict" setter.
+	SubjectAliasUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubjectAliasUpsert) SetUpdatedAt(v time.Time) *SubjectAliasUpsert {
+	u.Set(subjectalias.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubjectAliasUpsert) UpdateUpdatedAt() *SubjectAliasUpsert {
+	u.SetExcluded(subjectalias.FieldUpdatedAt)
+	return u
+}
+
+// SetCanonicalSubject sets the "canonical_subject" field.
+func (u *SubjectAliasUpsert) SetCanonicalSubject(v string) *SubjectAliasUpsert {
+	u.Set(subjectalias.FieldCanonicalSubject, v)
+	return u
+}
+
+// UpdateCanonicalSubject sets the "canonical_subject" field to the value that was provided on create.
+func (u *SubjectAliasUpsert) UpdateCanonicalSubject() *SubjectAliasUpsert {
+	u.SetExcluded(subjectalias.FieldCanonicalSubject)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SubjectAlias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(subjectalias.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SubjectAliasUpsertOne) UpdateNewValues() *SubjectAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(subjectalias.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(subjectalias.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Namespace(); exists {
+			s.SetIgnore(subjectalias.FieldNamespace)
+		}
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(subjectalias.FieldSource)
+		}
+		if _, exists := u.create.mutation.Subject(); exists {
+			s.SetIgnore(subjectalias.FieldSubject)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SubjectAlias.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SubjectAliasUpsertOne) Ignore() *SubjectAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubjectAliasUpsertOne) DoNothing() *SubjectAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubjectAliasCreate.OnConflict
+// documentation for more info.
+func (u *SubjectAliasUpsertOne) Update(set func(*SubjectAliasUpsert)) *SubjectAliasUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubjectAliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubjectAliasUpsertOne) SetUpdatedAt(v time.Time) *SubjectAliasUpsertOne {
+	return u.Update(func(s *SubjectAliasUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubjectAliasUpsertOne) UpdateUpdatedAt() *SubjectAliasUpsertOne {
+	return u.Update(func(s *SubjectAliasUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCanonicalSubject sets the "canonical_subject" field.
+func (u *SubjectAliasUpsertOne) SetCanonicalSubject(v string) *SubjectAliasUpsertOne {
+	return u.Update(func(s *SubjectAliasUpsert) {
+		s.SetCanonicalSubject(v)
+	})
+}
+
+// UpdateCanonicalSubject sets the "canonical_subject" field to the value that was provided on create.
+func (u *SubjectAliasUpsertOne) UpdateCanonicalSubject() *SubjectAliasUpsertOne {
+	return u.Update(func(s *SubjectAliasUpsert) {
+		s.UpdateCanonicalSubject()
+	})
+}
+
+// Exec executes the query.
+func (u *SubjectAliasUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for SubjectAliasCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubjectAliasUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SubjectAliasUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: SubjectAliasUpsertOne.ID is not supported by MySQL driver. Use SubjectAliasUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SubjectAliasUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SubjectAliasCreateBulk is the builder for creating many SubjectAlias entities in bulk.
+type SubjectAliasCreateBulk struct {
+	config
+	err      error
+	builders []*SubjectAliasCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SubjectAlias entities in the database.
+func (sacb *SubjectAliasCreateBulk) Save(ctx context.Context) ([]*SubjectAlias, error) {
+	if sacb.err != nil {
+		return nil, sacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*SubjectAlias, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubjectAliasMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *SubjectAliasCreateBulk) SaveX(ctx context.Context) []*SubjectAlias {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *SubjectAliasCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *SubjectAliasCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SubjectAlias.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubjectAliasUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sacb *SubjectAliasCreateBulk) OnConflict(opts ...sql.ConflictOption) *SubjectAliasUpsertBulk {
+	sacb.conflict = opts
+	return &SubjectAliasUpsertBulk{
+		create: sacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SubjectAlias.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sacb *SubjectAliasCreateBulk) OnConflictColumns(columns ...string) *SubjectAliasUpsertBulk {
+	sacb.conflict = append(sacb.conflict, sql.ConflictColumns(columns...))
+	return &SubjectAliasUpsertBulk{
+		create: sacb,
+	}
+}
+
+// SubjectAliasUpsertBulk is the builder for "upsert"-ing
+// a bulk of SubjectAlias nodes.
+type SubjectAliasUpsertBulk struct {
+	create *SubjectAliasCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SubjectAlias.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(subjectalias.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SubjectAliasUpsertBulk) UpdateNewValues() *SubjectAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(subjectalias.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(subjectalias.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Namespace(); exists {
+				s.SetIgnore(subjectalias.FieldNamespace)
+			}
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(subjectalias.FieldSource)
+			}
+			if _, exists := b.mutation.Subject(); exists {
+				s.SetIgnore(subjectalias.FieldSubject)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SubjectAlias.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SubjectAliasUpsertBulk) Ignore() *SubjectAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubjectAliasUpsertBulk) DoNothing() *SubjectAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubjectAliasCreateBulk.OnConflict
+// documentation for more info.
+func (u *SubjectAliasUpsertBulk) Update(set func(*SubjectAliasUpsert)) *SubjectAliasUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubjectAliasUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubjectAliasUpsertBulk) SetUpdatedAt(v time.Time) *SubjectAliasUpsertBulk {
+	return u.Update(func(s *SubjectAliasUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubjectAliasUpsertBulk) UpdateUpdatedAt() *SubjectAliasUpsertBulk {
+	return u.Update(func(s *SubjectAliasUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCanonicalSubject sets the "canonical_subject" field.
+func (u *SubjectAliasUpsertBulk) SetCanonicalSubject(v string) *SubjectAliasUpsertBulk {
+	return u.Update(func(s *SubjectAliasUpsert) {
+		s.SetCanonicalSubject(v)
+	})
+}
+
+// UpdateCanonicalSubject sets the "canonical_subject" field to the value that was provided on create.
+func (u *SubjectAliasUpsertBulk) UpdateCanonicalSubject() *SubjectAliasUpsertBulk {
+	return u.Update(func(s *SubjectAliasUpsert) {
+		s.UpdateCanonicalSubject()
+	})
+}
+
+// Exec executes the query.
+func (u *SubjectAliasUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the SubjectAliasCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for SubjectAliasCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubjectAliasUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/subjectalias"
+)
+
+// SubjectAliasDelete is the builder for deleting a SubjectAlias entity.
+type SubjectAliasDelete struct {
+	config
+	hooks    []Hook
+	mutation *SubjectAliasMutation
+}
+
+// Where appends a list predicates to the SubjectAliasDelete builder.
+func (sad *SubjectAliasDelete) Where(ps ...predicate.SubjectAlias) *SubjectAliasDelete {
+	sad.mutation.Where(ps...)
+	return sad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sad *SubjectAliasDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sad.sqlExec, sad.mutation, sad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sad *SubjectAliasDelete) ExecX(ctx context.Context) int {
+	n, err := sad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sad *SubjectAliasDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(subjectalias.Table, sqlgraph.NewFieldSpec(subjectalias.FieldID, field.TypeString))
+	if ps := sad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sad.mutation.done = true
+	return affected, err
+}
+
+// SubjectAliasDeleteOne is the builder for deleting a single SubjectAlias entity.
+type SubjectAliasDeleteOne struct {
+	sad *SubjectAliasDelete
+}
+
+// Where appends a list predicates to the SubjectAliasDelete builder.
+func (sado *SubjectAliasDeleteOne) Where(ps ...predicate.SubjectAlias) *SubjectAliasDeleteOne {
+	sado.sad.mutation.Where(ps...)
+	return sado
+}
+
+// Exec executes the deletion query.
+func (sado *SubjectAliasDeleteOne) Exec(ctx context.Context) error {
+	n, err := sado.sad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{subjectalias.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sado *SubjectAliasDeleteOne) ExecX(ctx context.Context) {
+	if err := sado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
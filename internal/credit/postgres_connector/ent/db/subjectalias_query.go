@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/subjectalias"
+)
+
+// SubjectAliasQuery is the builder for querying SubjectAlias entities.
+type SubjectAliasQuery struct {
+	config
+	ctx        *QueryContext
+	order      []subjectalias.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SubjectAlias
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SubjectAliasQuery builder.
+func (saq *SubjectAliasQuery) Where(ps ...predicate.SubjectAlias) *SubjectAliasQuery {
+	saq.predicates = append(saq.predicates, ps...)
+	return saq
+}
+
+// Limit the number of records to be returned by this query.
+func (saq *SubjectAliasQuery) Limit(limit int) *SubjectAliasQuery {
+	saq.ctx.Limit = &limit
+	return saq
+}
+
+// Offset to start from.
+func (saq *SubjectAliasQuery) Offset(offset int) *SubjectAliasQuery {
+	saq.ctx.Offset = &offset
+	return saq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (saq *SubjectAliasQuery) Unique(unique bool) *SubjectAliasQuery {
+	saq.ctx.Unique = &unique
+	return saq
+}
+
+// Order specifies how the records should be ordered.
+func (saq *SubjectAliasQuery) Order(o ...subjectalias.OrderOption) *SubjectAliasQuery {
+	saq.order = append(saq.order, o...)
+	return saq
+}
+
+// First returns the first SubjectAlias entity from the query.
+// Returns a *NotFoundError when no SubjectAlias was found.
+func (saq *SubjectAliasQuery) First(ctx context.Context) (*SubjectAlias, error) {
+	nodes, err := saq.Limit(1).All(setContextOp(ctx, saq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{subjectalias.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (saq *SubjectAliasQuery) FirstX(ctx context.Context) *SubjectAlias {
+	node, err := saq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SubjectAlias ID from the query.
+// Returns a *NotFoundError when no SubjectAlias ID was found.
+func (saq *SubjectAliasQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = saq.Limit(1).IDs(setContextOp(ctx, saq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{subjectalias.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (saq *SubjectAliasQuery) FirstIDX(ctx context.Context) string {
+	id, err := saq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SubjectAlias entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SubjectAlias entity is found.
+// Returns a *NotFoundError when no SubjectAlias entities are found.
+func (saq *SubjectAliasQuery) Only(ctx context.Context) (*SubjectAlias, error) {
+	nodes, err := saq.Limit(2).All(setContextOp(ctx, saq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{subjectalias.Label}
+	default:
+		return nil, &NotSingularError{subjectalias.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (saq *SubjectAliasQuery) OnlyX(ctx context.Context) *SubjectAlias {
+	node, err := saq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SubjectAlias ID in the query.
+// Returns a *NotSingularError when more than one SubjectAlias ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (saq *SubjectAliasQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = saq.Limit(2).IDs(setContextOp(ctx, saq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{subjectalias.Label}
+	default:
+		err = &NotSingularError{subjectalias.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (saq *SubjectAliasQuery) OnlyIDX(ctx context.Context) string {
+	id, err := saq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SubjectAliasSlice.
+func (saq *SubjectAliasQuery) All(ctx context.Context) ([]*SubjectAlias, error) {
+	ctx = setContextOp(ctx, saq.ctx, "All")
+	if err := saq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SubjectAlias, *SubjectAliasQuery]()
+	return withInterceptors[[]*SubjectAlias](ctx, saq, qr, saq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (saq *SubjectAliasQuery) AllX(ctx context.Context) []*SubjectAlias {
+	nodes, err := saq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SubjectAlias IDs.
+func (saq *SubjectAliasQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if saq.ctx.Unique == nil && saq.path != nil {
+		saq.Unique(true)
+	}
+	ctx = setContextOp(ctx, saq.ctx, "IDs")
+	if err = saq.Select(subjectalias.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (saq *SubjectAliasQuery) IDsX(ctx context.Context) []string {
+	ids, err := saq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (saq *SubjectAliasQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, saq.ctx, "Count")
+	if err := saq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, saq, querierCount[*SubjectAliasQuery](), saq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (saq *SubjectAliasQuery) CountX(ctx context.Context) int {
+	count, err := saq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (saq *SubjectAliasQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, saq.ctx, "Exist")
+	switch _, err := saq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (saq *SubjectAliasQuery) ExistX(ctx context.Context) bool {
+	exist, err := saq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SubjectAliasQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (saq *SubjectAliasQuery) Clone() *SubjectAliasQuery {
+	if saq == nil {
+		return nil
+	}
+	return &SubjectAliasQuery{
+		config:     saq.config,
+		ctx:        saq.ctx.Clone(),
+		order:      append([]subjectalias.OrderOption{}, saq.order...),
+		inters:     append([]Interceptor{}, saq.inters...),
+		predicates: append([]predicate.SubjectAlias{}, saq.predicates...),
+		// clone intermediate query.
+		sql:  saq.sql.Clone(),
+		path: saq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SubjectAlias.Query().
+//		GroupBy(subjectalias.FieldCreatedAt).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (saq *SubjectAliasQuery) GroupBy(field string, fields ...string) *SubjectAliasGroupBy {
+	saq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SubjectAliasGroupBy{build: saq}
+	grbuild.flds = &saq.ctx.Fields
+	grbuild.label = subjectalias.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.SubjectAlias.Query().
+//		Select(subjectalias.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (saq *SubjectAliasQuery) Select(fields ...string) *SubjectAliasSelect {
+	saq.ctx.Fields = append(saq.ctx.Fields, fields...)
+	sbuild := &SubjectAliasSelect{SubjectAliasQuery: saq}
+	sbuild.label = subjectalias.Label
+	sbuild.flds, sbuild.scan = &saq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SubjectAliasSelect configured with the given aggregations.
+func (saq *SubjectAliasQuery) Aggregate(fns ...AggregateFunc) *SubjectAliasSelect {
+	return saq.Select().Aggregate(fns...)
+}
+
+func (saq *SubjectAliasQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range saq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, saq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range saq.ctx.Fields {
+		if !subjectalias.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if saq.path != nil {
+		prev, err := saq.path(ctx)
+		if err != nil {
+			return err
+		}
+		saq.sql = prev
+	}
+	return nil
+}
+
+func (saq *SubjectAliasQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SubjectAlias, error) {
+	var (
+		nodes = []*SubjectAlias{}
+		_spec = saq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SubjectAlias).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SubjectAlias{config: saq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(saq.modifiers) > 0 {
+		_spec.Modifiers = saq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, saq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (saq *SubjectAliasQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := saq.querySpec()
+	if len(saq.modifiers) > 0 {
+		_spec.Modifiers = saq.modifiers
+	}
+	_spec.Node.Columns = saq.ctx.Fields
+	if len(saq.ctx.Fields) > 0 {
+		_spec.Unique = saq.ctx.Unique != nil && *saq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, saq.driver, _spec)
+}
+
+func (saq *SubjectAliasQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(subjectalias.Table, subjectalias.Columns, sqlgraph.NewFieldSpec(subjectalias.FieldID, field.TypeString))
+	_spec.From = saq.sql
+	if unique := saq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if saq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := saq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subjectalias.FieldID)
+		for i := range fields {
+			if fields[i] != subjectalias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := saq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := saq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := saq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := saq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (saq *SubjectAliasQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(saq.driver.Dialect())
+	t1 := builder.Table(subjectalias.Table)
+	columns := saq.ctx.Fields
+	if len(columns) == 0 {
+		columns = subjectalias.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if saq.sql != nil {
+		selector = saq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if saq.ctx.Unique != nil && *saq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range saq.modifiers {
+		m(selector)
+	}
+	for _, p := range saq.predicates {
+		p(selector)
+	}
+	for _, p := range saq.order {
+		p(selector)
+	}
+	if offset := saq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := saq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (saq *SubjectAliasQuery) ForUpdate(opts ...sql.LockOption) *SubjectAliasQuery {
+	if saq.driver.Dialect() == dialect.Postgres {
+		saq.Unique(false)
+	}
+	saq.modifiers = append(saq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return saq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (saq *SubjectAliasQuery) ForShare(opts ...sql.LockOption) *SubjectAliasQuery {
+	if saq.driver.Dialect() == dialect.Postgres {
+		saq.Unique(false)
+	}
+	saq.modifiers = append(saq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return saq
+}
+
+// SubjectAliasGroupBy is the group-by builder for SubjectAlias entities.
+type SubjectAliasGroupBy struct {
+	selector
+	build *SubjectAliasQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sagb *SubjectAliasGroupBy) Aggregate(fns ...AggregateFunc) *SubjectAliasGroupBy {
+	sagb.fns = append(sagb.fns, fns...)
+	return sagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sagb *SubjectAliasGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sagb.build.ctx, "GroupBy")
+	if err := sagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SubjectAliasQuery, *SubjectAliasGroupBy](ctx, sagb.build, sagb, sagb.build.inters, v)
+}
+
+func (sagb *SubjectAliasGroupBy) sqlScan(ctx context.Context, root *SubjectAliasQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sagb.fns))
+	for _, fn := range sagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sagb.flds)+len(sagb.fns))
+		for _, f := range *sagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SubjectAliasSelect is the builder for selecting fields of SubjectAlias entities.
+type SubjectAliasSelect struct {
+	*SubjectAliasQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sas *SubjectAliasSelect) Aggregate(fns ...AggregateFunc) *SubjectAliasSelect {
+	sas.fns = append(sas.fns, fns...)
+	return sas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sas *SubjectAliasSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sas.ctx, "Select")
+	if err := sas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SubjectAliasQuery, *SubjectAliasSelect](ctx, sas.SubjectAliasQuery, sas, sas.inters, v)
+}
+
+func (sas *SubjectAliasSelect) sqlScan(ctx context.Context, root *SubjectAliasQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sas.fns))
+	for _, fn := range sas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
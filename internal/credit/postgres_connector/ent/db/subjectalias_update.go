@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/predicate"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/subjectalias"
+)
+
+// SubjectAliasUpdate is the builder for updating SubjectAlias entities.
+type SubjectAliasUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubjectAliasMutation
+}
+
+// Where appends a list predicates to the SubjectAliasUpdate builder.
+func (sau *SubjectAliasUpdate) Where(ps ...predicate.SubjectAlias) *SubjectAliasUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sau *SubjectAliasUpdate) SetUpdatedAt(t time.Time) *SubjectAliasUpdate {
+	sau.mutation.SetUpdatedAt(t)
+	return sau
+}
+
+// SetCanonicalSubject sets the "canonical_subject" field.
+func (sau *SubjectAliasUpdate) SetCanonicalSubject(s string) *SubjectAliasUpdate {
+	sau.mutation.SetCanonicalSubject(s)
+	return sau
+}
+
+// SetNillableCanonicalSubject sets the "canonical_subject" field if the given value is not nil.
+func (sau *SubjectAliasUpdate) SetNillableCanonicalSubject(s *string) *SubjectAliasUpdate {
+	if s != nil {
+		sau.SetCanonicalSubject(*s)
+	}
+	return sau
+}
+
+// Mutation returns the SubjectAliasMutation object of the builder.
+func (sau *SubjectAliasUpdate) Mutation() *SubjectAliasMutation {
+	return sau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *SubjectAliasUpdate) Save(ctx context.Context) (int, error) {
+	sau.defaults()
+	return withHooks(ctx, sau.sqlSave, sau.mutation, sau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *SubjectAliasUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *SubjectAliasUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *SubjectAliasUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sau *SubjectAliasUpdate) defaults() {
+	if _, ok := sau.mutation.UpdatedAt(); !ok {
+		v := subjectalias.UpdateDefaultUpdatedAt()
+		sau.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sau *SubjectAliasUpdate) check() error {
+	if v, ok := sau.mutation.CanonicalSubject(); ok {
+		if err := subjectalias.CanonicalSubjectValidator(v); err != nil {
+			return &ValidationError{Name: "canonical_subject", err: fmt.Errorf(`db: validator failed for field "SubjectAlias.canonical_subject": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sau *SubjectAliasUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subjectalias.Table, subjectalias.Columns, sqlgraph.NewFieldSpec(subjectalias.FieldID, field.TypeString))
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sau.mutation.UpdatedAt(); ok {
+		_spec.SetField(subjectalias.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sau.mutation.CanonicalSubject(); ok {
+		_spec.SetField(subjectalias.FieldCanonicalSubject, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subjectalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sau.mutation.done = true
+	return n, nil
+}
+
+// SubjectAliasUpdateOne is the builder for updating a single SubjectAlias entity.
+type SubjectAliasUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubjectAliasMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sauo *SubjectAliasUpdateOne) SetUpdatedAt(t time.Time) *SubjectAliasUpdateOne {
+	sauo.mutation.SetUpdatedAt(t)
+	return sauo
+}
+
+// SetCanonicalSubject sets the "canonical_subject" field.
+func (sauo *SubjectAliasUpdateOne) SetCanonicalSubject(s string) *SubjectAliasUpdateOne {
+	sauo.mutation.SetCanonicalSubject(s)
+	return sauo
+}
+
+// SetNillableCanonicalSubject sets the "canonical_subject" field if the given value is not nil.
+func (sauo *SubjectAliasUpdateOne) SetNillableCanonicalSubject(s *string) *SubjectAliasUpdateOne {
+	if s != nil {
+		sauo.SetCanonicalSubject(*s)
+	}
+	return sauo
+}
+
+// Mutation returns the SubjectAliasMutation object of the builder.
+func (sauo *SubjectAliasUpdateOne) Mutation() *SubjectAliasMutation {
+	return sauo.mutation
+}
+
+// Where appends a list predicates to the SubjectAliasUpdate builder.
+func (sauo *SubjectAliasUpdateOne) Where(ps ...predicate.SubjectAlias) *SubjectAliasUpdateOne {
+	sauo.mutation.Where(ps...)
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *SubjectAliasUpdateOne) Select(field string, fields ...string) *SubjectAliasUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated SubjectAlias entity.
+func (sauo *SubjectAliasUpdateOne) Save(ctx context.Context) (*SubjectAlias, error) {
+	sauo.defaults()
+	return withHooks(ctx, sauo.sqlSave, sauo.mutation, sauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *SubjectAliasUpdateOne) SaveX(ctx context.Context) *SubjectAlias {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *SubjectAliasUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *SubjectAliasUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sauo *SubjectAliasUpdateOne) defaults() {
+	if _, ok := sauo.mutation.UpdatedAt(); !ok {
+		v := subjectalias.UpdateDefaultUpdatedAt()
+		sauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sauo *SubjectAliasUpdateOne) check() error {
+	if v, ok := sauo.mutation.CanonicalSubject(); ok {
+		if err := subjectalias.CanonicalSubjectValidator(v); err != nil {
+			return &ValidationError{Name: "canonical_subject", err: fmt.Errorf(`db: validator failed for field "SubjectAlias.canonical_subject": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sauo *SubjectAliasUpdateOne) sqlSave(ctx context.Context) (_node *SubjectAlias, err error) {
+	if err := sauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subjectalias.Table, subjectalias.Columns, sqlgraph.NewFieldSpec(subjectalias.FieldID, field.TypeString))
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "SubjectAlias.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subjectalias.FieldID)
+		for _, f := range fields {
+			if !subjectalias.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != subjectalias.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(subjectalias.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sauo.mutation.CanonicalSubject(); ok {
+		_spec.SetField(subjectalias.FieldCanonicalSubject, field.TypeString, value)
+	}
+	_node = &SubjectAlias{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subjectalias.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sauo.mutation.done = true
+	return _node, nil
+}
@@ -12,12 +12,24 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// BalanceSnapshot is the client for interacting with the BalanceSnapshot builders.
+	BalanceSnapshot *BalanceSnapshotClient
 	// CreditEntry is the client for interacting with the CreditEntry builders.
 	CreditEntry *CreditEntryClient
+	// EventType is the client for interacting with the EventType builders.
+	EventType *EventTypeClient
 	// Feature is the client for interacting with the Feature builders.
 	Feature *FeatureClient
+	// GrantSchedule is the client for interacting with the GrantSchedule builders.
+	GrantSchedule *GrantScheduleClient
 	// Ledger is the client for interacting with the Ledger builders.
 	Ledger *LedgerClient
+	// NotificationDelivery is the client for interacting with the NotificationDelivery builders.
+	NotificationDelivery *NotificationDeliveryClient
+	// NotificationRule is the client for interacting with the NotificationRule builders.
+	NotificationRule *NotificationRuleClient
+	// SubjectAlias is the client for interacting with the SubjectAlias builders.
+	SubjectAlias *SubjectAliasClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +161,15 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.BalanceSnapshot = NewBalanceSnapshotClient(tx.config)
 	tx.CreditEntry = NewCreditEntryClient(tx.config)
+	tx.EventType = NewEventTypeClient(tx.config)
 	tx.Feature = NewFeatureClient(tx.config)
+	tx.GrantSchedule = NewGrantScheduleClient(tx.config)
 	tx.Ledger = NewLedgerClient(tx.config)
+	tx.NotificationDelivery = NewNotificationDeliveryClient(tx.config)
+	tx.NotificationRule = NewNotificationRuleClient(tx.config)
+	tx.SubjectAlias = NewSubjectAliasClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +179,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: CreditEntry.QueryXXX(), the query will be executed
+// applies a query, for example: BalanceSnapshot.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+)
+
+type BalanceSnapshot struct {
+	ent.Schema
+}
+
+// Mixin of the BalanceSnapshot.
+func (BalanceSnapshot) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+	}
+}
+
+// Fields of the BalanceSnapshot.
+func (BalanceSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("namespace").NotEmpty().Immutable(),
+		field.String("ledger_id").Immutable().SchemaType(map[string]string{
+			dialect.Postgres: "char(26)",
+		}),
+		field.Time("at").Immutable(),
+		field.JSON("grant_balances", map[credit.GrantID]float64{}).Immutable(),
+		field.JSON("uncovered_usage", map[credit.FeatureID]float64{}).Immutable(),
+		field.JSON("features", []credit.FeatureID{}).Optional().Immutable(),
+		field.JSON("overage_features", []credit.FeatureID{}).Optional().Immutable(),
+		field.JSON("monetary_usage", map[credit.FeatureID]credit.MonetaryUsage{}).Optional().Immutable(),
+	}
+}
+
+// Indexes of the BalanceSnapshot.
+func (BalanceSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "ledger_id", "at"),
+	}
+}
@@ -40,6 +40,9 @@ func (CreditEntry) Fields() []ent.Field {
 		field.Float("amount").Optional().Nillable().Immutable().SchemaType(map[string]string{
 			dialect.Postgres: "numeric",
 		}),
+		field.String("currency").Optional().Nillable().Immutable().SchemaType(map[string]string{
+			dialect.Postgres: "char(3)",
+		}),
 		field.Uint8("priority").Default(1).Immutable(),
 		field.Time("effective_at").Default(time.Now).Immutable(),
 		// Expiration
@@ -52,10 +55,14 @@ func (CreditEntry) Fields() []ent.Field {
 			dialect.Postgres: "numeric",
 		}),
 		field.JSON("metadata", map[string]string{}).Optional(),
-		// Rollover or void grants will have a parent_id
+		// Rollover or void grants and grant amendments will have a parent_id
 		field.String("parent_id").Optional().Nillable().Immutable().SchemaType(map[string]string{
 			dialect.Postgres: "char(26)",
 		}),
+		// Grants issued by a grant schedule
+		field.String("grant_schedule_id").Optional().Nillable().Immutable().SchemaType(map[string]string{
+			dialect.Postgres: "char(26)",
+		}),
 	}
 }
 
@@ -63,14 +70,16 @@ func (CreditEntry) Fields() []ent.Field {
 func (CreditEntry) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("namespace", "ledger_id"),
+		// A grant schedule issues a single grant per occurrence
+		index.Fields("grant_schedule_id", "effective_at").Unique(),
 	}
 }
 
 // Edges of the CreditGrant define the relations to other entities.
 func (CreditEntry) Edges() []ent.Edge {
 	return []ent.Edge{
+		// A grant can have several amendments
 		edge.To("children", CreditEntry.Type).
-			Unique().
 			Annotations(entsql.OnDelete(entsql.Restrict)).
 			From("parent").
 			Unique().
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EventType describes the data of events with a CloudEvents type with a JSON Schema.
+type EventType struct {
+	ent.Schema
+}
+
+// Mixin of the EventType.
+func (EventType) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+	}
+}
+
+// Fields of the EventType.
+func (EventType) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("namespace").NotEmpty().Immutable(),
+		field.String("type").NotEmpty().Immutable(),
+		field.String("description").Optional().Nillable(),
+		field.JSON("schema", map[string]interface{}{}),
+	}
+}
+
+// Indexes of the EventType.
+func (EventType) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "id"),
+		index.Fields("namespace", "type").Unique(),
+	}
+}
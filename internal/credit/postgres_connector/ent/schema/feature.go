@@ -6,6 +6,8 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+
+	"github.com/openmeterio/openmeter/internal/credit"
 )
 
 type Feature struct {
@@ -27,6 +29,8 @@ func (Feature) Fields() []ent.Field {
 		field.String("name").NotEmpty(),
 		field.String("meter_slug").NotEmpty().Immutable(),
 		field.JSON("meter_group_by_filters", map[string]string{}).Optional(),
+		field.JSON("meter_group_by_filter_versions", []credit.FeatureFilterVersion{}).Optional(),
+		field.JSON("unit_price", &credit.UnitPrice{}).Optional().Immutable(),
 		field.Bool("archived").Default(false),
 	}
 }
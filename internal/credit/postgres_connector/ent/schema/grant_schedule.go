@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+)
+
+type GrantSchedule struct {
+	ent.Schema
+}
+
+// Mixin of the GrantSchedule.
+func (GrantSchedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+	}
+}
+
+// Fields of the GrantSchedule.
+func (GrantSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("namespace").NotEmpty().Immutable(),
+		field.String("ledger_id").Immutable().SchemaType(map[string]string{
+			dialect.Postgres: "char(26)",
+		}),
+		field.Enum("type").GoType(credit.GrantType("")).Immutable(),
+		field.String("feature_id").Optional().Nillable().Immutable().SchemaType(map[string]string{
+			dialect.Postgres: "char(26)",
+		}),
+		field.Float("amount").Immutable().SchemaType(map[string]string{
+			dialect.Postgres: "numeric",
+		}),
+		field.Uint8("priority").Default(1).Immutable(),
+		// Expiration
+		field.Enum("expiration_period_duration").GoType(credit.ExpirationPeriodDuration("")).Immutable(),
+		field.Uint8("expiration_period_count").Immutable(),
+		// Rollover
+		field.Enum("rollover_type").GoType(credit.GrantRolloverType("")).Optional().Nillable().Immutable(),
+		field.Float("rollover_max_amount").Optional().Nillable().Immutable().SchemaType(map[string]string{
+			dialect.Postgres: "numeric",
+		}),
+		field.JSON("metadata", map[string]string{}).Optional(),
+		// Recurrence
+		field.Enum("recurrence_period").GoType(credit.RecurrencePeriod("")).Immutable(),
+		field.Time("recurrence_anchor").Immutable(),
+		// The next occurrence to issue a grant for
+		field.Int("next_grant_index").Default(0),
+		field.Time("next_grant_at"),
+	}
+}
+
+// Indexes of the GrantSchedule.
+func (GrantSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "ledger_id"),
+		index.Fields("next_grant_at"),
+	}
+}
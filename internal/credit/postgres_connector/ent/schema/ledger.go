@@ -4,8 +4,11 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+
+	"github.com/openmeterio/openmeter/internal/credit"
 )
 
 var defaultHighwatermark, _ = time.Parse(time.RFC3339, "2024-01-01T00:00:00Z")
@@ -28,6 +31,17 @@ func (Ledger) Fields() []ent.Field {
 		field.String("namespace").NotEmpty().Immutable(),
 		field.String("subject").NotEmpty().Immutable(),
 		field.JSON("metadata", map[string]string{}).Optional(),
+		// Overage limits
+		field.Float("overage_limit").Optional().Nillable().SchemaType(map[string]string{
+			dialect.Postgres: "numeric",
+		}),
+		field.JSON("feature_overage_limits", map[string]float64{}).Optional(),
+		// Usage period, the ledger is reset at each boundary
+		field.Enum("usage_period").GoType(credit.RecurrencePeriod("")).Optional().Nillable(),
+		field.Time("usage_period_anchor").Optional().Nillable(),
+		// The next boundary of the usage period to reset the ledger at
+		field.Int("next_reset_index").Default(0),
+		field.Time("next_reset_at").Optional().Nillable(),
 		field.Time("highwatermark").Default(func() time.Time {
 			return defaultHighwatermark
 		}),
@@ -38,5 +52,6 @@ func (Ledger) Fields() []ent.Field {
 func (Ledger) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("namespace", "subject").Unique(),
+		index.Fields("next_reset_at"),
 	}
 }
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+)
+
+type NotificationDelivery struct {
+	ent.Schema
+}
+
+// Mixin of the NotificationDelivery.
+func (NotificationDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+	}
+}
+
+// Fields of the NotificationDelivery.
+func (NotificationDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("namespace").NotEmpty().Immutable(),
+		field.String("rule_id").Immutable().SchemaType(map[string]string{
+			dialect.Postgres: "char(26)",
+		}),
+		field.String("ledger_id").Immutable().SchemaType(map[string]string{
+			dialect.Postgres: "char(26)",
+		}),
+		field.String("url").NotEmpty().Immutable(),
+		field.JSON("event", credit.NotificationEvent{}).Immutable(),
+		field.Enum("status").GoType(credit.NotificationDeliveryStatus("")),
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_at").Optional().Nillable(),
+		field.Time("last_attempt_at").Optional().Nillable(),
+		field.Int("last_status_code").Optional().Nillable(),
+		field.Text("last_error").Optional().Nillable(),
+	}
+}
+
+// Indexes of the NotificationDelivery.
+func (NotificationDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "rule_id"),
+		index.Fields("status", "next_attempt_at"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+)
+
+type NotificationRule struct {
+	ent.Schema
+}
+
+// Mixin of the NotificationRule.
+func (NotificationRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+	}
+}
+
+// Fields of the NotificationRule.
+func (NotificationRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("namespace").NotEmpty().Immutable(),
+		field.String("ledger_id").Immutable().SchemaType(map[string]string{
+			dialect.Postgres: "char(26)",
+		}),
+		field.String("feature_id").Optional().Nillable().Immutable().SchemaType(map[string]string{
+			dialect.Postgres: "char(26)",
+		}),
+		field.Enum("type").GoType(credit.NotificationRuleType("")).Immutable(),
+		field.Float("threshold").Optional().Nillable().Immutable().SchemaType(map[string]string{
+			dialect.Postgres: "numeric",
+		}),
+		field.String("url").NotEmpty().Immutable(),
+		// State of the rule, true while the condition is met
+		field.Bool("triggered").Default(false),
+		field.Time("triggered_at").Optional().Nillable(),
+	}
+}
+
+// Indexes of the NotificationRule.
+func (NotificationRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "ledger_id"),
+	}
+}
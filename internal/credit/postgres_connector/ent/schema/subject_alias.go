@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SubjectAlias maps a subject sent by a producer to a canonical subject.
+type SubjectAlias struct {
+	ent.Schema
+}
+
+// Mixin of the SubjectAlias.
+func (SubjectAlias) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+	}
+}
+
+// Fields of the SubjectAlias.
+func (SubjectAlias) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("namespace").NotEmpty().Immutable(),
+		// Empty source matches events from any source.
+		field.String("source").Default("").Immutable(),
+		field.String("subject").NotEmpty().Immutable(),
+		field.String("canonical_subject").NotEmpty(),
+	}
+}
+
+// Indexes of the SubjectAlias.
+func (SubjectAlias) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace", "id"),
+		index.Fields("namespace", "source", "subject").Unique(),
+	}
+}
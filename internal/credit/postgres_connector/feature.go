@@ -3,6 +3,7 @@ package postgres_connector
 import (
 	"context"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/openmeterio/openmeter/internal/credit"
@@ -26,38 +27,31 @@ func (c *PostgresConnector) CreateFeature(ctx context.Context, featureIn credit.
 		return credit.Feature{}, &models.MeterNotFoundError{MeterSlug: featureIn.MeterSlug}
 	}
 
+	var filters map[string]string
 	if featureIn.MeterGroupByFilters != nil {
+		filters = *featureIn.MeterGroupByFilters
+
 		// validate that the MeterGroupByFilters point to actual meter groupbys
-		for filterProp := range *featureIn.MeterGroupByFilters {
-			if _, ok := meter.GroupBy[filterProp]; !ok {
-				meterGroupByColumns := make([]string, 0, len(meter.GroupBy))
-				for k := range meter.GroupBy {
-					meterGroupByColumns = append(meterGroupByColumns, k)
-				}
-				return credit.Feature{}, &credit.FeatureInvalidFiltersError{
-					RequestedFilters:    *featureIn.MeterGroupByFilters,
-					MeterGroupByColumns: meterGroupByColumns,
-				}
-			}
+		if err := validateFeatureFilters(meter, filters); err != nil {
+			return credit.Feature{}, err
 		}
-		query.SetMeterGroupByFilters(*featureIn.MeterGroupByFilters)
+		query.SetMeterGroupByFilters(filters)
 	}
 
-	// validate that the feature name is uniq among active features
-	r, err := c.db.Feature.Query().
-		Where(db_feature.Namespace(featureIn.Namespace)).
-		Where(db_feature.Name(featureIn.Name)).
-		Where(db_feature.Archived(false)).
-		All(ctx)
+	// validate that the usage of the feature does not overlap the usage of other active features
+	err = checkFeatureFiltersAvailable(ctx, c.db.Feature, featureIn.Namespace, featureIn.MeterSlug, filters, nil)
+	if err != nil {
+		return credit.Feature{}, err
+	}
 
+	// validate that the feature name is uniq among active features
+	err = checkFeatureNameAvailable(ctx, c.db.Feature, featureIn.Namespace, featureIn.Name, nil)
 	if err != nil {
-		return credit.Feature{}, fmt.Errorf("failed to query for existing features: %w", err)
+		return credit.Feature{}, err
 	}
 
-	if len(r) > 0 {
-		foundFeature := r[0]
-		return credit.Feature{},
-			&credit.FeatureWithNameAlreadyExistsError{Name: featureIn.Name, ID: credit.FeatureID(foundFeature.ID)}
+	if featureIn.UnitPrice != nil {
+		query.SetUnitPrice(featureIn.UnitPrice)
 	}
 
 	entity, err := query.Save(ctx)
@@ -69,6 +63,129 @@ func (c *PostgresConnector) CreateFeature(ctx context.Context, featureIn credit.
 	return featureOut, nil
 }
 
+// UpdateFeature updates a feature.
+// Filter changes are versioned: the new filters apply from the effective time of the update,
+// the usage before it is still queried with the filters in effect back then.
+func (c *PostgresConnector) UpdateFeature(ctx context.Context, id credit.NamespacedFeatureID, update credit.FeatureUpdate) (credit.Feature, error) {
+	feature, err := transaction(ctx, c, func(tx *db.Tx) (*credit.Feature, error) {
+		entity, err := tx.Feature.Query().
+			Where(db_feature.Namespace(id.Namespace)).
+			Where(db_feature.ID(string(id.ID))).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if db.IsNotFound(err) {
+				return nil, &credit.FeatureNotFoundError{ID: id.ID}
+			}
+
+			return nil, fmt.Errorf("failed to get feature: %w", err)
+		}
+
+		query := tx.Feature.UpdateOne(entity)
+
+		name := entity.Name
+		if update.Name != nil {
+			if *update.Name == "" {
+				return nil, &credit.InvalidFeatureUpdateError{ID: id.ID, Reason: "name must not be empty"}
+			}
+
+			name = *update.Name
+			query = query.SetName(name)
+		}
+
+		archived := entity.Archived
+		if update.Archived != nil {
+			archived = *update.Archived
+			query = query.SetArchived(archived)
+		}
+
+		// Renamed and unarchived features must not clash with the name of an active feature
+		if !archived && (name != entity.Name || entity.Archived) {
+			err = checkFeatureNameAvailable(ctx, tx.Feature, id.Namespace, name, &id.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		filters := entity.MeterGroupByFilters
+		filtersChanged := update.MeterGroupByFilters != nil && !maps.Equal(*update.MeterGroupByFilters, entity.MeterGroupByFilters)
+		if filtersChanged {
+			filters = *update.MeterGroupByFilters
+		}
+
+		// Unarchived features and changed filters must not overlap the usage of an active feature
+		if !archived && (filtersChanged || entity.Archived) {
+			err = checkFeatureFiltersAvailable(ctx, tx.Feature, id.Namespace, entity.MeterSlug, filters, &id.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if filtersChanged {
+
+			meter, err := c.meterRepository.GetMeterByIDOrSlug(ctx, id.Namespace, entity.MeterSlug)
+			if err != nil {
+				return nil, &models.MeterNotFoundError{MeterSlug: entity.MeterSlug}
+			}
+
+			if err := validateFeatureFilters(meter, filters); err != nil {
+				return nil, err
+			}
+
+			// Filters take effect at the start of the next window like grants
+			now := time.Now()
+			effectiveAt := now
+			if update.EffectiveAt != nil {
+				effectiveAt = *update.EffectiveAt
+			}
+			if truncated := effectiveAt.Truncate(c.config.WindowSize); !truncated.Equal(effectiveAt) {
+				effectiveAt = truncated.Add(c.config.WindowSize)
+			}
+			effectiveAt = effectiveAt.In(time.UTC)
+
+			// Balances in the past are not recalculated with the new filters
+			if effectiveAt.Before(now) {
+				return nil, &credit.InvalidFeatureUpdateError{ID: id.ID, Reason: "filters must not be effective in the past"}
+			}
+
+			// The filters before the first update apply from the beginning
+			versions := entity.MeterGroupByFilterVersions
+			if len(versions) == 0 {
+				versions = []credit.FeatureFilterVersion{{MeterGroupByFilters: entity.MeterGroupByFilters}}
+			}
+
+			if last := versions[len(versions)-1]; effectiveAt.Before(last.EffectiveAt) {
+				return nil, &credit.InvalidFeatureUpdateError{ID: id.ID, Reason: "filters must not be effective before the last filter change"}
+			} else if effectiveAt.Equal(last.EffectiveAt) {
+				// Replace the filters of a change not effective yet
+				versions = versions[:len(versions)-1]
+			}
+
+			versions = append(versions, credit.FeatureFilterVersion{
+				EffectiveAt:         effectiveAt,
+				MeterGroupByFilters: filters,
+			})
+
+			query = query.
+				SetMeterGroupByFilters(filters).
+				SetMeterGroupByFilterVersions(versions)
+		}
+
+		entity, err = query.Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update feature: %w", err)
+		}
+
+		feature := mapFeatureEntity(entity)
+		return &feature, nil
+	})
+	if err != nil {
+		return credit.Feature{}, err
+	}
+
+	return *feature, nil
+}
+
 // DeleteFeature deletes a feature.
 func (c *PostgresConnector) DeleteFeature(ctx context.Context, id credit.NamespacedFeatureID) error {
 	err := c.db.Feature.Update().
@@ -153,6 +270,7 @@ func mapFeatureEntity(entity *db.Feature) credit.Feature {
 		Namespace: entity.Namespace,
 		Name:      entity.Name,
 		MeterSlug: entity.MeterSlug,
+		UnitPrice: entity.UnitPrice,
 		Archived:  &entity.Archived,
 		CreatedAt: convert.ToPointer(entity.CreatedAt.In(time.UTC)),
 		UpdatedAt: convert.ToPointer(entity.UpdatedAt.In(time.UTC)),
@@ -162,5 +280,96 @@ func mapFeatureEntity(entity *db.Feature) credit.Feature {
 		feature.MeterGroupByFilters = &entity.MeterGroupByFilters
 	}
 
+	if len(entity.MeterGroupByFilterVersions) > 0 {
+		feature.MeterGroupByFilterVersions = make([]credit.FeatureFilterVersion, 0, len(entity.MeterGroupByFilterVersions))
+		for _, version := range entity.MeterGroupByFilterVersions {
+			version.EffectiveAt = version.EffectiveAt.In(time.UTC)
+			feature.MeterGroupByFilterVersions = append(feature.MeterGroupByFilterVersions, version)
+		}
+	}
+
 	return feature
 }
+
+// validateFeatureFilters validates that the filters point to the group bys of the meter.
+func validateFeatureFilters(meter models.Meter, filters map[string]string) error {
+	for filterProp := range filters {
+		if _, ok := meter.GroupBy[filterProp]; !ok {
+			meterGroupByColumns := make([]string, 0, len(meter.GroupBy))
+			for k := range meter.GroupBy {
+				meterGroupByColumns = append(meterGroupByColumns, k)
+			}
+			return &credit.FeatureInvalidFiltersError{
+				RequestedFilters:    filters,
+				MeterGroupByColumns: meterGroupByColumns,
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkFeatureNameAvailable checks that no active feature other than the excluded one has the name.
+func checkFeatureNameAvailable(ctx context.Context, client *db.FeatureClient, namespace string, name string, exclude *credit.FeatureID) error {
+	query := client.Query().
+		Where(db_feature.Namespace(namespace)).
+		Where(db_feature.Name(name)).
+		Where(db_feature.Archived(false))
+
+	if exclude != nil {
+		query = query.Where(db_feature.IDNEQ(string(*exclude)))
+	}
+
+	r, err := query.All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query for existing features: %w", err)
+	}
+
+	if len(r) > 0 {
+		foundFeature := r[0]
+		return &credit.FeatureWithNameAlreadyExistsError{Name: name, ID: credit.FeatureID(foundFeature.ID)}
+	}
+
+	return nil
+}
+
+// checkFeatureFiltersAvailable checks that no active feature of the meter other than the excluded one has filters
+// overlapping the filters without being the same. Only the latest filters of the features are compared.
+func checkFeatureFiltersAvailable(ctx context.Context, client *db.FeatureClient, namespace string, meterSlug string, filters map[string]string, exclude *credit.FeatureID) error {
+	query := client.Query().
+		Where(db_feature.Namespace(namespace)).
+		Where(db_feature.MeterSlug(meterSlug)).
+		Where(db_feature.Archived(false))
+
+	if exclude != nil {
+		query = query.Where(db_feature.IDNEQ(string(*exclude)))
+	}
+
+	r, err := query.All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query for existing features: %w", err)
+	}
+
+	for _, foundFeature := range r {
+		if maps.Equal(filters, foundFeature.MeterGroupByFilters) {
+			continue
+		}
+
+		if filtersOverlap(filters, foundFeature.MeterGroupByFilters) {
+			return &credit.FeatureFiltersOverlapError{Filters: filters, ID: credit.FeatureID(foundFeature.ID)}
+		}
+	}
+
+	return nil
+}
+
+// filtersOverlap returns true if some usage can match both filters, i.e. they do not differ in the value of a group by.
+func filtersOverlap(a map[string]string, b map[string]string) bool {
+	for k, v := range a {
+		if other, ok := b[k]; ok && other != v {
+			return false
+		}
+	}
+
+	return true
+}
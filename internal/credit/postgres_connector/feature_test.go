@@ -133,6 +133,150 @@ func TestFeature(t *testing.T) {
 				assert.Equal(t, []credit.Feature{expected}, features)
 			},
 		},
+		{
+			name:        "UpdateFeature",
+			description: "Rename a feature and version its filters",
+			test: func(t *testing.T, connector credit.Connector, db_client *db.Client) {
+				ctx := context.Background()
+				feature, err := connector.CreateFeature(ctx, testFeature)
+				assert.NoError(t, err)
+
+				featureID := credit.NewNamespacedFeatureID(namespace, *feature.ID)
+				effectiveAt := time.Now().Truncate(time.Minute).Add(time.Hour).In(time.UTC)
+
+				updated, err := connector.UpdateFeature(ctx, featureID, credit.FeatureUpdate{
+					Name:                convert.ToPointer("feature-2"),
+					MeterGroupByFilters: &map[string]string{"key": "other"},
+					EffectiveAt:         &effectiveAt,
+				})
+				assert.NoError(t, err)
+				assert.Equal(t, "feature-2", updated.Name)
+				assert.Equal(t, &map[string]string{"key": "other"}, updated.MeterGroupByFilters)
+				assert.Equal(t, []credit.FeatureFilterVersion{
+					{MeterGroupByFilters: map[string]string{"key": "value"}},
+					{EffectiveAt: effectiveAt, MeterGroupByFilters: map[string]string{"key": "other"}},
+				}, updated.MeterGroupByFilterVersions)
+
+				// The filters in effect before the change are kept
+				assert.Equal(t, testFeature.MeterGroupByFilters, updated.FiltersAt(effectiveAt.Add(-time.Minute)))
+				assert.Equal(t, &map[string]string{"key": "other"}, updated.FiltersAt(effectiveAt))
+
+				feature, err = connector.GetFeature(ctx, featureID)
+				assert.NoError(t, err)
+				assert.Equal(t, updated.MeterGroupByFilterVersions, feature.MeterGroupByFilterVersions)
+
+				// Filters cannot change the past
+				_, err = connector.UpdateFeature(ctx, featureID, credit.FeatureUpdate{
+					MeterGroupByFilters: &map[string]string{},
+					EffectiveAt:         convert.ToPointer(time.Now().Add(-time.Hour)),
+				})
+				assert.Equal(t, &credit.InvalidFeatureUpdateError{ID: *feature.ID, Reason: "filters must not be effective in the past"}, err)
+
+				_, err = connector.UpdateFeature(ctx, featureID, credit.FeatureUpdate{
+					MeterGroupByFilters: &map[string]string{},
+				})
+				assert.Equal(t, &credit.InvalidFeatureUpdateError{ID: *feature.ID, Reason: "filters must not be effective before the last filter change"}, err)
+
+				_, err = connector.UpdateFeature(ctx, featureID, credit.FeatureUpdate{
+					MeterGroupByFilters: &map[string]string{"unknown": "value"},
+					EffectiveAt:         &effectiveAt,
+				})
+				assert.IsType(t, &credit.FeatureInvalidFiltersError{}, err)
+
+				_, err = connector.UpdateFeature(ctx, credit.NewNamespacedFeatureID(namespace, "unknown"), credit.FeatureUpdate{})
+				assert.Equal(t, &credit.FeatureNotFoundError{ID: "unknown"}, err)
+			},
+		},
+		{
+			name:        "UnarchiveFeature",
+			description: "Unarchive a feature unless an active feature has its name",
+			test: func(t *testing.T, connector credit.Connector, db_client *db.Client) {
+				ctx := context.Background()
+				archived, err := connector.CreateFeature(ctx, testFeature)
+				assert.NoError(t, err)
+
+				archivedID := credit.NewNamespacedFeatureID(namespace, *archived.ID)
+				err = connector.DeleteFeature(ctx, archivedID)
+				assert.NoError(t, err)
+
+				active, err := connector.CreateFeature(ctx, testFeature)
+				assert.NoError(t, err)
+
+				_, err = connector.UpdateFeature(ctx, archivedID, credit.FeatureUpdate{Archived: convert.ToPointer(false)})
+				assert.Equal(t, &credit.FeatureWithNameAlreadyExistsError{Name: testFeature.Name, ID: *active.ID}, err)
+
+				unarchived, err := connector.UpdateFeature(ctx, archivedID, credit.FeatureUpdate{
+					Name:     convert.ToPointer("feature-2"),
+					Archived: convert.ToPointer(false),
+				})
+				assert.NoError(t, err)
+				assert.False(t, *unarchived.Archived)
+				assert.Equal(t, "feature-2", unarchived.Name)
+
+				// Renaming to the name of another active feature is rejected too
+				_, err = connector.UpdateFeature(ctx, archivedID, credit.FeatureUpdate{Name: convert.ToPointer(testFeature.Name)})
+				assert.Equal(t, &credit.FeatureWithNameAlreadyExistsError{Name: testFeature.Name, ID: *active.ID}, err)
+			},
+		},
+		{
+			name:        "OverlappingFeatures",
+			description: "Reject features of a meter with filters overlapping an active feature without being the same",
+			test: func(t *testing.T, connector credit.Connector, db_client *db.Client) {
+				ctx := context.Background()
+				feature, err := connector.CreateFeature(ctx, testFeature)
+				assert.NoError(t, err)
+
+				// Same filters
+				featureCopy := testFeature
+				featureCopy.Name = "feature-1-copy"
+				copied, err := connector.CreateFeature(ctx, featureCopy)
+				assert.NoError(t, err)
+
+				// Different filters
+				otherFeature := testFeature
+				otherFeature.Name = "feature-2"
+				otherFeature.MeterGroupByFilters = &map[string]string{"key": "other"}
+				other, err := connector.CreateFeature(ctx, otherFeature)
+				assert.NoError(t, err)
+
+				// Unfiltered usage includes the usage of the filtered features
+				unfilteredFeature := testFeature
+				unfilteredFeature.Name = "feature-3"
+				unfilteredFeature.MeterGroupByFilters = nil
+				_, err = connector.CreateFeature(ctx, unfilteredFeature)
+				assert.IsType(t, &credit.FeatureFiltersOverlapError{}, err)
+
+				otherID := credit.NewNamespacedFeatureID(namespace, *other.ID)
+				effectiveAt := time.Now().Truncate(time.Minute).Add(time.Hour)
+				_, err = connector.UpdateFeature(ctx, otherID, credit.FeatureUpdate{
+					MeterGroupByFilters: &map[string]string{},
+					EffectiveAt:         &effectiveAt,
+				})
+				assert.IsType(t, &credit.FeatureFiltersOverlapError{}, err)
+
+				// Archived features are not checked until they are unarchived
+				err = connector.DeleteFeature(ctx, otherID)
+				assert.NoError(t, err)
+
+				_, err = connector.UpdateFeature(ctx, otherID, credit.FeatureUpdate{
+					MeterGroupByFilters: &map[string]string{},
+					EffectiveAt:         &effectiveAt,
+				})
+				assert.NoError(t, err)
+
+				_, err = connector.UpdateFeature(ctx, otherID, credit.FeatureUpdate{Archived: convert.ToPointer(false)})
+				assert.IsType(t, &credit.FeatureFiltersOverlapError{}, err)
+
+				// Once the overlapping features are archived the unfiltered feature can be unarchived
+				err = connector.DeleteFeature(ctx, credit.NewNamespacedFeatureID(namespace, *feature.ID))
+				assert.NoError(t, err)
+				err = connector.DeleteFeature(ctx, credit.NewNamespacedFeatureID(namespace, *copied.ID))
+				assert.NoError(t, err)
+
+				_, err = connector.UpdateFeature(ctx, otherID, credit.FeatureUpdate{Archived: convert.ToPointer(false)})
+				assert.NoError(t, err)
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
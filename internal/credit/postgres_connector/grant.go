@@ -34,33 +34,9 @@ func (c *PostgresConnector) CreateGrant(ctx context.Context, grantIn credit.Gran
 
 		// TODO: validate namespace for not being empty (at all places)
 
-		q := tx.CreditEntry.Create().
-			SetNamespace(grantIn.Namespace).
-			SetNillableID((*string)(grantIn.ID)).
-			SetLedgerID(string(grantIn.LedgerID)).
-			SetEntryType(credit.EntryTypeGrant).
-			SetType(grantIn.Type).
-			SetNillableParentID((*string)(grantIn.ParentID)).
-			SetNillableFeatureID((*string)(grantIn.FeatureID)).
-			SetAmount(grantIn.Amount).
-			SetPriority(grantIn.Priority).
-			SetEffectiveAt(grantIn.EffectiveAt).
-			SetExpirationPeriodDuration(grantIn.Expiration.Duration).
-			SetExpirationPeriodCount(grantIn.Expiration.Count).
-			SetExpirationAt(grantIn.Expiration.GetExpiration(grantIn.EffectiveAt)).
-			SetMetadata(grantIn.Metadata)
-		if grantIn.Rollover != nil {
-			q = q.SetRolloverType(grantIn.Rollover.Type).
-				SetNillableRolloverMaxAmount(grantIn.Rollover.MaxAmount)
-		}
-		entity, err := q.Save(ctx)
+		grant, err := createGrantEntity(ctx, tx, grantIn)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create grant: %w", err)
-		}
-
-		grant, err := mapGrantEntity(entity)
-		if err != nil {
-			return nil, fmt.Errorf("failed to map grant entity: %w", err)
+			return nil, err
 		}
 
 		return &grant, nil
@@ -73,6 +49,48 @@ func (c *PostgresConnector) CreateGrant(ctx context.Context, grantIn credit.Gran
 	return *grant, nil
 }
 
+// createGrantEntity saves the grant as a credit entry.
+func createGrantEntity(ctx context.Context, tx *db.Tx, grantIn credit.Grant) (credit.Grant, error) {
+	q := tx.CreditEntry.Create().
+		SetNamespace(grantIn.Namespace).
+		SetNillableID((*string)(grantIn.ID)).
+		SetLedgerID(string(grantIn.LedgerID)).
+		SetEntryType(credit.EntryTypeGrant).
+		SetType(grantIn.Type).
+		SetNillableParentID((*string)(grantIn.ParentID)).
+		SetNillableGrantScheduleID((*string)(grantIn.ScheduleID)).
+		SetNillableFeatureID((*string)(grantIn.FeatureID)).
+		SetAmount(grantIn.Amount).
+		SetNillableCurrency(grantIn.Currency).
+		SetPriority(grantIn.Priority).
+		SetEffectiveAt(grantIn.EffectiveAt).
+		SetExpirationPeriodDuration(grantIn.Expiration.Duration).
+		SetExpirationPeriodCount(grantIn.Expiration.Count).
+		SetExpirationAt(grantIn.Expiration.GetExpiration(grantIn.EffectiveAt)).
+		SetMetadata(grantIn.Metadata)
+	if grantIn.Rollover != nil {
+		q = q.SetRolloverType(grantIn.Rollover.Type).
+			SetNillableRolloverMaxAmount(grantIn.Rollover.MaxAmount)
+	}
+	entity, err := q.Save(ctx)
+	if err != nil {
+		return credit.Grant{}, fmt.Errorf("failed to create grant: %w", err)
+	}
+
+	// Grants effective in the past change the balances snapshotted since
+	err = invalidateBalanceSnapshots(ctx, tx, credit.NewNamespacedLedgerID(grantIn.Namespace, grantIn.LedgerID), grantIn.EffectiveAt)
+	if err != nil {
+		return credit.Grant{}, err
+	}
+
+	grant, err := mapGrantEntity(entity)
+	if err != nil {
+		return credit.Grant{}, fmt.Errorf("failed to map grant entity: %w", err)
+	}
+
+	return grant, nil
+}
+
 func (c *PostgresConnector) VoidGrant(ctx context.Context, grantIn credit.Grant) (credit.Grant, error) {
 	grant, err := mutationTransaction(ctx, c, credit.NewNamespacedLedgerID(grantIn.Namespace, grantIn.LedgerID), func(tx *db.Tx, ledgerEntity *db.Ledger) (*credit.Grant, error) {
 		// Check if the reset is in the future
@@ -94,7 +112,8 @@ func (c *PostgresConnector) VoidGrant(ctx context.Context, grantIn credit.Grant)
 
 		for _, entry := range balance.GrantBalances {
 			if *entry.Grant.ID == *grantIn.ID {
-				if entry.Balance != grantIn.Amount {
+				// The amount of the grant balance is amended if the grant was amended
+				if entry.Balance != entry.Grant.Amount {
 					return nil, fmt.Errorf("grant has been used, cannot void: %s", *grantIn.ID)
 				}
 				break
@@ -129,6 +148,7 @@ func (c *PostgresConnector) VoidGrant(ctx context.Context, grantIn credit.Grant)
 			SetType(*entity.Type).
 			SetNillableFeatureID(entity.FeatureID).
 			SetAmount(*entity.Amount).
+			SetNillableCurrency(entity.Currency).
 			SetPriority(entity.Priority).
 			SetEffectiveAt(entity.EffectiveAt).
 			SetExpirationPeriodDuration(*entity.ExpirationPeriodDuration).
@@ -139,6 +159,12 @@ func (c *PostgresConnector) VoidGrant(ctx context.Context, grantIn credit.Grant)
 			return nil, fmt.Errorf("failed to void grant: %w", err)
 		}
 
+		// The voided grant is no longer part of the balances snapshotted since it was effective
+		err = invalidateBalanceSnapshots(ctx, tx, credit.NewNamespacedLedgerID(entity.Namespace, credit.LedgerID(entity.LedgerID)), entity.EffectiveAt)
+		if err != nil {
+			return nil, err
+		}
+
 		grant, err := mapGrantEntity(entity)
 		if err != nil {
 			return nil, fmt.Errorf("failed to map grant entity: %w", err)
@@ -250,7 +276,11 @@ func (c *PostgresConnector) GetGrant(ctx context.Context, grantID credit.Namespa
 				db_credit.Namespace(grantID.Namespace),
 				db_credit.ID(string(grantID.ID)),
 				db_credit.EntryTypeEQ(credit.EntryTypeGrant),
-				db_credit.Not(db_credit.HasChildren()),
+				// Amendments do not replace the grant, entries without parent are excluded as NOT IN does not match NULL
+				db_credit.Not(db_credit.HasChildrenWith(
+					db_credit.ParentIDNotNil(),
+					db_credit.EntryTypeNEQ(credit.EntryTypeAmendGrant),
+				)),
 			),
 			// void grant
 			db_credit.And(
@@ -297,10 +327,12 @@ func mapGrantEntity(entry *db.CreditEntry) (credit.Grant, error) {
 		Namespace:   entry.Namespace,
 		ID:          (*credit.GrantID)(&entry.ID),
 		ParentID:    (*credit.GrantID)(entry.ParentID),
+		ScheduleID:  (*credit.GrantScheduleID)(entry.GrantScheduleID),
 		LedgerID:    credit.LedgerID(entry.LedgerID),
 		Type:        *entry.Type,
 		FeatureID:   (*credit.FeatureID)(entry.FeatureID),
 		Amount:      *entry.Amount,
+		Currency:    entry.Currency,
 		Priority:    entry.Priority,
 		EffectiveAt: entry.EffectiveAt.In(time.UTC),
 		Expiration: credit.ExpirationPeriod{
@@ -0,0 +1,218 @@
+package postgres_connector
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	db_credit "github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/creditentry"
+	"github.com/openmeterio/openmeter/pkg/convert"
+	"github.com/openmeterio/openmeter/pkg/slicesx"
+)
+
+// AmendGrant records an amendment of the grant, the amended terms apply from the effective time of the amendment.
+// Amendments are appended: an amendment cannot be effective before the last amendment of the grant or after the grant expired.
+func (c *PostgresConnector) AmendGrant(ctx context.Context, amendmentIn credit.GrantAmendment) (credit.GrantAmendment, error) {
+	ledgerID := credit.NewNamespacedLedgerID(amendmentIn.Namespace, amendmentIn.LedgerID)
+
+	amendment, err := mutationTransaction(ctx, c, ledgerID, func(tx *db.Tx, ledgerEntity *db.Ledger) (*credit.GrantAmendment, error) {
+		// Amendments take effect at the start of the next window like grants
+		if truncated := amendmentIn.EffectiveAt.Truncate(c.config.WindowSize); !truncated.Equal(amendmentIn.EffectiveAt) {
+			amendmentIn.EffectiveAt = truncated.Add(c.config.WindowSize)
+		}
+
+		err := checkAfterHighWatermark(amendmentIn.EffectiveAt, ledgerEntity)
+		if err != nil {
+			// Typed error do not wrap
+			return nil, err
+		}
+
+		if err := amendmentIn.Validate(); err != nil {
+			return nil, &credit.InvalidGrantAmendmentError{GrantID: amendmentIn.GrantID, Reason: err.Error()}
+		}
+
+		grantEntity, err := tx.CreditEntry.Query().
+			Where(
+				db_credit.Namespace(amendmentIn.Namespace),
+				db_credit.LedgerID(string(amendmentIn.LedgerID)),
+				db_credit.ID(string(amendmentIn.GrantID)),
+				db_credit.EntryTypeEQ(credit.EntryTypeGrant),
+			).
+			WithChildren(func(q *db.CreditEntryQuery) {
+				q.Order(db_credit.ByEffectiveAt(), db_credit.ByCreatedAt())
+			}).
+			Only(ctx)
+		if err != nil {
+			if db.IsNotFound(err) {
+				return nil, &credit.GrantNotFoundError{GrantID: amendmentIn.GrantID}
+			}
+
+			return nil, fmt.Errorf("failed to get grant: %w", err)
+		}
+
+		grant, err := mapGrantEntity(grantEntity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map grant entity: %w", err)
+		}
+
+		if amendmentIn.EffectiveAt.Before(grant.EffectiveAt) {
+			return nil, &credit.InvalidGrantAmendmentError{GrantID: *grant.ID, Reason: "amendment must not be effective before the grant"}
+		}
+
+		// Voided grants cannot be amended and amendments do not rewrite the terms of the grant before the last amendment
+		for _, child := range grantEntity.Edges.Children {
+			switch child.EntryType {
+			case credit.EntryTypeVoidGrant:
+				return nil, &credit.InvalidGrantAmendmentError{GrantID: *grant.ID, Reason: "grant is voided"}
+			case credit.EntryTypeAmendGrant:
+				if child.EffectiveAt.After(amendmentIn.EffectiveAt) {
+					return nil, &credit.InvalidGrantAmendmentError{GrantID: *grant.ID, Reason: "amendment must not be effective before the last amendment of the grant"}
+				}
+			}
+		}
+
+		amendments, err := mapGrantAmendmentEntities(grantEntity.Edges.Children)
+		if err != nil {
+			return nil, err
+		}
+
+		// Apply the previous amendments to check the amendment against the current terms of the grant
+		for _, amendment := range amendments {
+			grant = amendment.Apply(grant)
+		}
+
+		if !grant.ExpirationDate().After(amendmentIn.EffectiveAt) {
+			return nil, &credit.InvalidGrantAmendmentError{GrantID: *grant.ID, Reason: "grant is expired"}
+		}
+
+		q := tx.CreditEntry.Create().
+			SetNamespace(amendmentIn.Namespace).
+			SetLedgerID(string(amendmentIn.LedgerID)).
+			SetEntryType(credit.EntryTypeAmendGrant).
+			SetParentID(string(amendmentIn.GrantID)).
+			SetEffectiveAt(amendmentIn.EffectiveAt).
+			SetNillableAmount(amendmentIn.Amount).
+			SetNillableExpirationAt(amendmentIn.ExpiresAt)
+		if amendmentIn.Metadata != nil {
+			q = q.SetMetadata(amendmentIn.Metadata)
+		}
+
+		entity, err := q.Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to amend grant: %w", err)
+		}
+
+		// The amended terms change the balances snapshotted since the amendment is effective
+		err = invalidateBalanceSnapshots(ctx, tx, ledgerID, amendmentIn.EffectiveAt)
+		if err != nil {
+			return nil, err
+		}
+
+		amendment, err := mapGrantAmendmentEntity(entity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map grant amendment entity: %w", err)
+		}
+
+		return &amendment, nil
+	})
+
+	if err != nil {
+		return credit.GrantAmendment{}, err
+	}
+
+	return *amendment, nil
+}
+
+// ListGrantAmendments lists the amendments of the grant in the order they apply.
+func (c *PostgresConnector) ListGrantAmendments(ctx context.Context, grantID credit.NamespacedGrantID) ([]credit.GrantAmendment, error) {
+	amendments, err := c.listGrantAmendments(ctx, grantID.Namespace, []credit.GrantID{grantID.ID}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return amendments[grantID.ID], nil
+}
+
+// listGrantAmendments lists the amendments of the grants effective before the given time by grant, in the order they apply.
+func (c *PostgresConnector) listGrantAmendments(ctx context.Context, namespace string, grantIDs []credit.GrantID, before *time.Time) (map[credit.GrantID][]credit.GrantAmendment, error) {
+	q := c.db.CreditEntry.Query().
+		Where(
+			db_credit.Namespace(namespace),
+			db_credit.EntryTypeEQ(credit.EntryTypeAmendGrant),
+			db_credit.ParentIDIn(slicesx.Map(grantIDs, func(id credit.GrantID) string {
+				return string(id)
+			})...),
+		).
+		Order(
+			db_credit.ByEffectiveAt(),
+			db_credit.ByCreatedAt(),
+		)
+	if before != nil {
+		q = q.Where(db_credit.EffectiveAtLT(*before))
+	}
+
+	entities, err := q.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grant amendments: %w", err)
+	}
+
+	amendments, err := mapGrantAmendmentEntities(entities)
+	if err != nil {
+		return nil, err
+	}
+
+	byGrant := map[credit.GrantID][]credit.GrantAmendment{}
+	for _, amendment := range amendments {
+		byGrant[amendment.GrantID] = append(byGrant[amendment.GrantID], amendment)
+	}
+
+	return byGrant, nil
+}
+
+// mapGrantAmendmentEntities maps the amendments among the entities, other entries are skipped.
+func mapGrantAmendmentEntities(entities []*db.CreditEntry) ([]credit.GrantAmendment, error) {
+	amendments := []credit.GrantAmendment{}
+	for _, entity := range entities {
+		if entity.EntryType != credit.EntryTypeAmendGrant {
+			continue
+		}
+
+		amendment, err := mapGrantAmendmentEntity(entity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map grant amendment entity: %w", err)
+		}
+
+		amendments = append(amendments, amendment)
+	}
+
+	return amendments, nil
+}
+
+func mapGrantAmendmentEntity(entry *db.CreditEntry) (credit.GrantAmendment, error) {
+	if entry.EntryType != credit.EntryTypeAmendGrant {
+		return credit.GrantAmendment{}, fmt.Errorf("entry type must be grant amendment: %s", entry.EntryType)
+	}
+
+	if entry.ParentID == nil {
+		return credit.GrantAmendment{}, fmt.Errorf("grant amendment has no parent: %s", entry.ID)
+	}
+
+	amendment := credit.GrantAmendment{
+		Namespace:   entry.Namespace,
+		ID:          (*credit.GrantID)(&entry.ID),
+		GrantID:     credit.GrantID(*entry.ParentID),
+		LedgerID:    credit.LedgerID(entry.LedgerID),
+		EffectiveAt: entry.EffectiveAt.In(time.UTC),
+		Amount:      entry.Amount,
+		Metadata:    entry.Metadata,
+		CreatedAt:   convert.ToPointer(entry.CreatedAt.In(time.UTC)),
+	}
+
+	if entry.ExpirationAt != nil {
+		amendment.ExpiresAt = convert.ToPointer(entry.ExpirationAt.In(time.UTC))
+	}
+
+	return amendment, nil
+}
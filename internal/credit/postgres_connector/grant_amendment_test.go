@@ -0,0 +1,233 @@
+package postgres_connector
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/testutils"
+	meter_model "github.com/openmeterio/openmeter/internal/meter"
+	om_testutils "github.com/openmeterio/openmeter/internal/testutils"
+	"github.com/openmeterio/openmeter/pkg/convert"
+	"github.com/openmeterio/openmeter/pkg/models"
+)
+
+func TestPostgresConnectorGrantAmendments(t *testing.T) {
+	windowSize := time.Minute
+	namespace := "default"
+	meter := models.Meter{
+		Namespace:   namespace,
+		ID:          "meter-1",
+		Slug:        "meter-1",
+		Aggregation: models.MeterAggregationSum,
+	}
+	meterRepository := meter_model.NewInMemoryRepository([]models.Meter{meter})
+	featureIn := credit.Feature{
+		Namespace: namespace,
+		MeterSlug: meter.Slug,
+		Name:      "feature-1",
+	}
+
+	// We need to truncate the time to workaround pgx driver timezone issue
+	today := time.Now().In(time.UTC).Truncate(time.Hour * 24)
+	day := time.Hour * 24
+	t0 := today.Add(-3 * day)
+
+	createGrant := func(t *testing.T, connector credit.Connector, ledger credit.Ledger, expiration credit.ExpirationPeriod) credit.Grant {
+		feature := testutils.CreateFeature(t, connector, featureIn)
+
+		grant, err := connector.CreateGrant(context.Background(), credit.Grant{
+			Namespace:   namespace,
+			LedgerID:    ledger.ID,
+			FeatureID:   feature.ID,
+			Type:        credit.GrantTypeUsage,
+			Amount:      100,
+			Priority:    1,
+			EffectiveAt: t0,
+			Expiration:  expiration,
+			Metadata:    map[string]string{"source": "stripe"},
+		})
+		assert.NoError(t, err)
+
+		return grant
+	}
+
+	monthly := credit.ExpirationPeriod{
+		Duration: credit.ExpirationPeriodDurationMonth,
+		Count:    1,
+	}
+
+	tt := []struct {
+		name        string
+		description string
+		test        func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger)
+	}{
+		{
+			name:        "AmendAmount",
+			description: "Should change the remaining balance by the change of the amount from the effective time of the amendment",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				ledgerID := credit.NewNamespacedLedgerID(namespace, ledger.ID)
+				grant := createGrant(t, connector, ledger, monthly)
+
+				streamingConnector.AddSimpleEvent(meter.Slug, 30, t0.Add(time.Hour))
+				streamingConnector.AddSimpleEvent(meter.Slug, 20, t0.Add(2*day).Add(time.Hour))
+
+				amendment, err := connector.AmendGrant(ctx, credit.GrantAmendment{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					GrantID:     *grant.ID,
+					EffectiveAt: t0.Add(day),
+					Amount:      convert.ToPointer(150.0),
+					Metadata:    map[string]string{"source": "support"},
+				})
+				assert.NoError(t, err)
+				assert.Equal(t, *grant.ID, amendment.GrantID)
+				assert.Equal(t, t0.Add(day), amendment.EffectiveAt)
+
+				balance, err := connector.GetBalance(ctx, ledgerID, today)
+				assert.NoError(t, err)
+				assert.Len(t, balance.GrantBalances, 1)
+				assert.Equal(t, 100.0, balance.GrantBalances[0].Balance)
+				assert.Equal(t, 150.0, balance.GrantBalances[0].Amount)
+				assert.Equal(t, map[string]string{"source": "support"}, balance.GrantBalances[0].Metadata)
+
+				// The original terms apply before the amendment
+				balance, err = connector.GetBalance(ctx, ledgerID, t0.Add(day))
+				assert.NoError(t, err)
+				assert.Len(t, balance.GrantBalances, 1)
+				assert.Equal(t, 70.0, balance.GrantBalances[0].Balance)
+				assert.Equal(t, 100.0, balance.GrantBalances[0].Amount)
+
+				history, err := connector.GetHistory(ctx, ledgerID, t0, today, credit.Pagination{})
+				assert.NoError(t, err)
+
+				var amendments []credit.LedgerEntry
+				for _, entry := range history.GetEntries() {
+					if entry.Type == credit.LedgerEntryTypeAmendment {
+						amendments = append(amendments, entry)
+					}
+				}
+				assert.Equal(t, []credit.LedgerEntry{{
+					ID:        grant.ID,
+					Type:      credit.LedgerEntryTypeAmendment,
+					Time:      t0.Add(day),
+					FeatureID: grant.FeatureID,
+					Amount:    convert.ToPointer(50.0),
+				}}, amendments)
+
+				// The grant keeps its original terms
+				grant, err = connector.GetGrant(ctx, credit.NewNamespacedGrantID(namespace, *grant.ID))
+				assert.NoError(t, err)
+				assert.Equal(t, 100.0, grant.Amount)
+			},
+		},
+		{
+			name:        "ExtendExpiration",
+			description: "Should burn down usage after the original expiration",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				ledgerID := credit.NewNamespacedLedgerID(namespace, ledger.ID)
+				grant := createGrant(t, connector, ledger, credit.ExpirationPeriod{
+					Duration: credit.ExpirationPeriodDurationDay,
+					Count:    1,
+				})
+
+				streamingConnector.AddSimpleEvent(meter.Slug, 5, t0.Add(time.Hour))
+				streamingConnector.AddSimpleEvent(meter.Slug, 10, t0.Add(2*day).Add(time.Hour))
+
+				expiresAt := today.Add(day)
+				_, err := connector.AmendGrant(ctx, credit.GrantAmendment{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					GrantID:     *grant.ID,
+					EffectiveAt: t0.Add(12 * time.Hour),
+					ExpiresAt:   &expiresAt,
+				})
+				assert.NoError(t, err)
+
+				balance, err := connector.GetBalance(ctx, ledgerID, today)
+				assert.NoError(t, err)
+				assert.Len(t, balance.GrantBalances, 1)
+				assert.Equal(t, 85.0, balance.GrantBalances[0].Balance)
+				assert.Equal(t, expiresAt, balance.GrantBalances[0].ExpiresAt)
+
+				amendments, err := connector.ListGrantAmendments(ctx, credit.NewNamespacedGrantID(namespace, *grant.ID))
+				assert.NoError(t, err)
+				assert.Len(t, amendments, 1)
+				assert.Equal(t, expiresAt, *amendments[0].ExpiresAt)
+			},
+		},
+		{
+			name:        "InvalidAmendments",
+			description: "Should not amend grants before the last amendment, after expiration or after void",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				grant := createGrant(t, connector, ledger, credit.ExpirationPeriod{
+					Duration: credit.ExpirationPeriodDurationDay,
+					Count:    2,
+				})
+
+				// We need to add a row to the streaming connector as we call balance in the void
+				streamingConnector.AddRow(meter.Slug, models.MeterQueryRow{})
+
+				amend := func(effectiveAt time.Time) error {
+					_, err := connector.AmendGrant(ctx, credit.GrantAmendment{
+						Namespace:   namespace,
+						LedgerID:    ledger.ID,
+						GrantID:     *grant.ID,
+						EffectiveAt: effectiveAt,
+						Amount:      convert.ToPointer(120.0),
+					})
+					return err
+				}
+
+				assert.NoError(t, amend(t0.Add(day)))
+
+				err := amend(t0.Add(12 * time.Hour))
+				assert.IsType(t, &credit.InvalidGrantAmendmentError{}, err)
+
+				err = amend(t0.Add(2 * day))
+				assert.IsType(t, &credit.InvalidGrantAmendmentError{}, err)
+
+				_, err = connector.VoidGrant(ctx, grant)
+				assert.NoError(t, err)
+
+				err = amend(t0.Add(day).Add(time.Hour))
+				assert.IsType(t, &credit.InvalidGrantAmendmentError{}, err)
+			},
+		},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			t.Log(tc.description)
+			driver := om_testutils.InitPostgresDB(t)
+			databaseClient := db.NewClient(db.Driver(driver))
+			defer databaseClient.Close()
+
+			old, err := time.Parse(time.RFC3339, "2020-01-01T00:00:00Z")
+			assert.NoError(t, err)
+
+			streamingConnector := testutils.NewMockStreamingConnector(t, testutils.MockStreamingConnectorParams{DefaultHighwatermark: old})
+			connector := NewPostgresConnector(slog.Default(), databaseClient, streamingConnector, meterRepository, PostgresConnectorConfig{
+				WindowSize: windowSize,
+			})
+
+			ledger, err := connector.CreateLedger(context.Background(), credit.Ledger{
+				Namespace: namespace,
+				Subject:   ulid.Make().String(),
+			})
+			assert.NoError(t, err)
+
+			tc.test(t, connector, streamingConnector, databaseClient, ledger)
+		})
+	}
+}
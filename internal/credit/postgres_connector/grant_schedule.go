@@ -0,0 +1,247 @@
+package postgres_connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	db_feature "github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/feature"
+	db_grantschedule "github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/grantschedule"
+	db_ledger "github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/ledger"
+	"github.com/openmeterio/openmeter/pkg/convert"
+	"github.com/openmeterio/openmeter/pkg/slicesx"
+)
+
+// CreateGrantSchedule creates a grant schedule.
+// Grants of occurrences in the past are issued by the next run of IssueScheduledGrants unless they are before the last reset.
+func (c *PostgresConnector) CreateGrantSchedule(ctx context.Context, scheduleIn credit.GrantSchedule) (credit.GrantSchedule, error) {
+	exists, err := c.db.Ledger.Query().
+		Where(db_ledger.Namespace(scheduleIn.Namespace)).
+		Where(db_ledger.ID(string(scheduleIn.LedgerID))).
+		Exist(ctx)
+	if err != nil {
+		return credit.GrantSchedule{}, fmt.Errorf("failed to query ledger: %w", err)
+	}
+	if !exists {
+		return credit.GrantSchedule{}, &credit.LedgerNotFoundError{LedgerID: scheduleIn.LedgerID}
+	}
+
+	// Grants take effect at the start of a window like grants created directly
+	if truncated := scheduleIn.Recurrence.Anchor.Truncate(c.config.WindowSize); !truncated.Equal(scheduleIn.Recurrence.Anchor) {
+		scheduleIn.Recurrence.Anchor = truncated.Add(c.config.WindowSize)
+	}
+
+	q := c.db.GrantSchedule.Create().
+		SetNamespace(scheduleIn.Namespace).
+		SetNillableID((*string)(scheduleIn.ID)).
+		SetLedgerID(string(scheduleIn.LedgerID)).
+		SetType(scheduleIn.Type).
+		SetNillableFeatureID((*string)(scheduleIn.FeatureID)).
+		SetAmount(scheduleIn.Amount).
+		SetPriority(scheduleIn.Priority).
+		SetExpirationPeriodDuration(scheduleIn.Expiration.Duration).
+		SetExpirationPeriodCount(scheduleIn.Expiration.Count).
+		SetMetadata(scheduleIn.Metadata).
+		SetRecurrencePeriod(scheduleIn.Recurrence.Period).
+		SetRecurrenceAnchor(scheduleIn.Recurrence.Anchor).
+		SetNextGrantIndex(0).
+		SetNextGrantAt(scheduleIn.Recurrence.At(0))
+	if scheduleIn.Rollover != nil {
+		q = q.SetRolloverType(scheduleIn.Rollover.Type).
+			SetNillableRolloverMaxAmount(scheduleIn.Rollover.MaxAmount)
+	}
+
+	entity, err := q.Save(ctx)
+	if err != nil {
+		return credit.GrantSchedule{}, fmt.Errorf("failed to create grant schedule: %w", err)
+	}
+
+	return mapGrantScheduleEntity(entity), nil
+}
+
+// ListGrantSchedules lists the grant schedules of a namespace.
+func (c *PostgresConnector) ListGrantSchedules(ctx context.Context, params credit.ListGrantSchedulesParams) ([]credit.GrantSchedule, error) {
+	q := c.db.GrantSchedule.Query().
+		Where(db_grantschedule.Namespace(params.Namespace))
+
+	if len(params.LedgerIDs) > 0 {
+		q = q.Where(db_grantschedule.LedgerIDIn(slicesx.Map(params.LedgerIDs, func(id credit.LedgerID) string {
+			return string(id)
+		})...))
+	}
+
+	entities, err := q.Order(db_grantschedule.ByID()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grant schedules: %w", err)
+	}
+
+	return slicesx.Map(entities, mapGrantScheduleEntity), nil
+}
+
+// GetGrantSchedule gets a grant schedule by ID.
+func (c *PostgresConnector) GetGrantSchedule(ctx context.Context, scheduleID credit.NamespacedGrantScheduleID) (credit.GrantSchedule, error) {
+	entity, err := c.db.GrantSchedule.Query().
+		Where(db_grantschedule.Namespace(scheduleID.Namespace)).
+		Where(db_grantschedule.ID(string(scheduleID.ID))).
+		Only(ctx)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return credit.GrantSchedule{}, &credit.GrantScheduleNotFoundError{ID: scheduleID.ID}
+		}
+
+		return credit.GrantSchedule{}, fmt.Errorf("failed to get grant schedule: %w", err)
+	}
+
+	return mapGrantScheduleEntity(entity), nil
+}
+
+// DeleteGrantSchedule deletes a grant schedule, grants already issued by the schedule are kept.
+func (c *PostgresConnector) DeleteGrantSchedule(ctx context.Context, scheduleID credit.NamespacedGrantScheduleID) error {
+	affected, err := c.db.GrantSchedule.Delete().
+		Where(db_grantschedule.Namespace(scheduleID.Namespace)).
+		Where(db_grantschedule.ID(string(scheduleID.ID))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete grant schedule: %w", err)
+	}
+
+	if affected == 0 {
+		return &credit.GrantScheduleNotFoundError{ID: scheduleID.ID}
+	}
+
+	return nil
+}
+
+// IssueScheduledGrants issues the grants of all grant schedules due until the given time.
+// Issuing is idempotent: the grants of a schedule are issued in the same transaction that advances the schedule,
+// while the ledger is locked, and a schedule can issue a single grant per occurrence.
+func (c *PostgresConnector) IssueScheduledGrants(ctx context.Context, until time.Time) ([]credit.Grant, error) {
+	entities, err := c.db.GrantSchedule.Query().
+		Where(db_grantschedule.NextGrantAtLTE(until)).
+		Order(db_grantschedule.ByNextGrantAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due grant schedules: %w", err)
+	}
+
+	grants := []credit.Grant{}
+	var errs []error
+
+	for _, entity := range entities {
+		scheduleID := credit.NewNamespacedGrantScheduleID(entity.Namespace, credit.GrantScheduleID(entity.ID))
+		ledgerID := credit.NewNamespacedLedgerID(entity.Namespace, credit.LedgerID(entity.LedgerID))
+
+		issued, err := mutationTransaction(ctx, c, ledgerID, func(tx *db.Tx, ledgerEntity *db.Ledger) (*[]credit.Grant, error) {
+			return issueScheduledGrants(ctx, tx, ledgerEntity, scheduleID, until)
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to issue grants of schedule %s: %w", scheduleID.ID, err))
+			continue
+		}
+
+		grants = append(grants, *issued...)
+	}
+
+	return grants, errors.Join(errs...)
+}
+
+// issueScheduledGrants issues the grants of a schedule due until the given time, the ledger must be locked.
+func issueScheduledGrants(ctx context.Context, tx *db.Tx, ledgerEntity *db.Ledger, scheduleID credit.NamespacedGrantScheduleID, until time.Time) (*[]credit.Grant, error) {
+	grants := []credit.Grant{}
+
+	// Read the schedule again with the ledger locked as another worker may have issued the grants meanwhile
+	entity, err := tx.GrantSchedule.Query().
+		Where(db_grantschedule.Namespace(scheduleID.Namespace)).
+		Where(db_grantschedule.ID(string(scheduleID.ID))).
+		Only(ctx)
+	if err != nil {
+		if db.IsNotFound(err) {
+			// Deleted meanwhile
+			return &grants, nil
+		}
+
+		return nil, fmt.Errorf("failed to get grant schedule: %w", err)
+	}
+
+	schedule := mapGrantScheduleEntity(entity)
+
+	// Occurrences are skipped while the feature of the schedule is archived, they are not issued once it is unarchived.
+	// The feature is checked when the occurrences are issued, not when they fall due, as archiving is not versioned:
+	// if issuing lags, occurrences falling due around archiving or unarchiving follow the state of the feature now.
+	archived := false
+	if schedule.FeatureID != nil {
+		archived, err = tx.Feature.Query().
+			Where(db_feature.Namespace(scheduleID.Namespace)).
+			Where(db_feature.ID(string(*schedule.FeatureID))).
+			Where(db_feature.Archived(true)).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get feature of grant schedule: %w", err)
+		}
+	}
+
+	index := entity.NextGrantIndex
+	nextGrantAt := entity.NextGrantAt
+
+	for ; !nextGrantAt.After(until); index, nextGrantAt = index+1, schedule.Recurrence.At(index+1) {
+		// Occurrences before the last reset are skipped, the reset would have zeroed their grants.
+		// Grants at the time of the reset are kept by it, so occurrences at the start of a usage period are issued
+		// regardless of whether the ledger is reset before or after.
+		if nextGrantAt.Before(ledgerEntity.Highwatermark) || archived {
+			continue
+		}
+
+		grant, err := createGrantEntity(ctx, tx, schedule.Grant(nextGrantAt))
+		if err != nil {
+			return nil, err
+		}
+
+		grants = append(grants, grant)
+	}
+
+	err = tx.GrantSchedule.UpdateOneID(entity.ID).
+		SetNextGrantIndex(index).
+		SetNextGrantAt(nextGrantAt).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update grant schedule: %w", err)
+	}
+
+	return &grants, nil
+}
+
+func mapGrantScheduleEntity(entity *db.GrantSchedule) credit.GrantSchedule {
+	schedule := credit.GrantSchedule{
+		Namespace: entity.Namespace,
+		ID:        (*credit.GrantScheduleID)(&entity.ID),
+		LedgerID:  credit.LedgerID(entity.LedgerID),
+		Type:      entity.Type,
+		FeatureID: (*credit.FeatureID)(entity.FeatureID),
+		Amount:    entity.Amount,
+		Priority:  entity.Priority,
+		Expiration: credit.ExpirationPeriod{
+			Duration: entity.ExpirationPeriodDuration,
+			Count:    entity.ExpirationPeriodCount,
+		},
+		Metadata: entity.Metadata,
+		Recurrence: credit.Recurrence{
+			Period: entity.RecurrencePeriod,
+			Anchor: entity.RecurrenceAnchor.In(time.UTC),
+		},
+		NextGrantAt: entity.NextGrantAt.In(time.UTC),
+		CreatedAt:   convert.ToPointer(entity.CreatedAt.In(time.UTC)),
+		UpdatedAt:   convert.ToPointer(entity.UpdatedAt.In(time.UTC)),
+	}
+
+	if entity.RolloverType != nil {
+		schedule.Rollover = &credit.GrantRollover{
+			Type:      *entity.RolloverType,
+			MaxAmount: entity.RolloverMaxAmount,
+		}
+	}
+
+	return schedule
+}
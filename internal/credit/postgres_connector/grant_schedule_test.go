@@ -0,0 +1,233 @@
+package postgres_connector
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/testutils"
+	"github.com/openmeterio/openmeter/internal/meter"
+	om_testutils "github.com/openmeterio/openmeter/internal/testutils"
+	"github.com/openmeterio/openmeter/pkg/convert"
+	"github.com/openmeterio/openmeter/pkg/models"
+)
+
+func TestPostgresConnectorGrantSchedules(t *testing.T) {
+	windowSize := time.Minute
+	namespace := "default"
+
+	meters := []models.Meter{
+		{
+			Namespace: namespace,
+			ID:        "meter-1",
+			Slug:      "meter-1",
+		},
+	}
+	meterRepository := meter.NewInMemoryRepository(meters)
+	features := []credit.Feature{{
+		Namespace: namespace,
+		MeterSlug: "meter-1",
+		Name:      "feature-1",
+	}}
+
+	newSchedule := func(ledger credit.Ledger, feature credit.Feature, recurrence credit.Recurrence) credit.GrantSchedule {
+		return credit.GrantSchedule{
+			Namespace: namespace,
+			LedgerID:  ledger.ID,
+			FeatureID: feature.ID,
+			Type:      credit.GrantTypeUsage,
+			Amount:    100,
+			Priority:  1,
+			Expiration: credit.ExpirationPeriod{
+				Duration: credit.ExpirationPeriodDurationMonth,
+				Count:    1,
+			},
+			Recurrence: recurrence,
+		}
+	}
+
+	tt := []struct {
+		name        string
+		description string
+		test        func(t *testing.T, connector credit.Connector, db_client *db.Client, ledger credit.Ledger)
+	}{
+		{
+			name:        "IssueScheduledGrants",
+			description: "Should issue a grant per occurrence once, even if issued several times",
+			test: func(t *testing.T, connector credit.Connector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				feature := testutils.CreateFeature(t, connector, features[0])
+
+				schedule, err := connector.CreateGrantSchedule(ctx, newSchedule(ledger, feature, credit.Recurrence{
+					Period: credit.RecurrencePeriodMonth,
+					// Ceiled to the window size
+					Anchor: time.Date(2024, 1, 31, 0, 0, 30, 0, time.UTC),
+				}))
+				assert.NoError(t, err)
+				assert.Equal(t, time.Date(2024, 1, 31, 0, 1, 0, 0, time.UTC), schedule.NextGrantAt)
+
+				until := time.Date(2024, 3, 31, 0, 1, 0, 0, time.UTC)
+
+				grants, err := connector.IssueScheduledGrants(ctx, until)
+				assert.NoError(t, err)
+
+				effectiveAts := make([]time.Time, 0, len(grants))
+				for _, grant := range grants {
+					assert.Equal(t, schedule.ID, grant.ScheduleID)
+					assert.Equal(t, feature.ID, grant.FeatureID)
+					assert.Equal(t, 100.0, grant.Amount)
+					effectiveAts = append(effectiveAts, grant.EffectiveAt)
+				}
+				assert.Equal(t, []time.Time{
+					time.Date(2024, 1, 31, 0, 1, 0, 0, time.UTC),
+					time.Date(2024, 2, 29, 0, 1, 0, 0, time.UTC),
+					time.Date(2024, 3, 31, 0, 1, 0, 0, time.UTC),
+				}, effectiveAts)
+
+				// Issuing again is a no-op
+				grants, err = connector.IssueScheduledGrants(ctx, until)
+				assert.NoError(t, err)
+				assert.Empty(t, grants)
+
+				ledgerGrants, err := connector.ListGrants(ctx, credit.ListGrantsParams{
+					Namespace: namespace,
+					LedgerIDs: []credit.LedgerID{ledger.ID},
+				})
+				assert.NoError(t, err)
+				assert.Len(t, ledgerGrants, 3)
+
+				schedule, err = connector.GetGrantSchedule(ctx, credit.NewNamespacedGrantScheduleID(namespace, *schedule.ID))
+				assert.NoError(t, err)
+				assert.Equal(t, time.Date(2024, 4, 30, 0, 1, 0, 0, time.UTC), schedule.NextGrantAt)
+			},
+		},
+		{
+			name:        "IssueScheduledGrantsAfterReset",
+			description: "Should skip occurrences before the last reset",
+			test: func(t *testing.T, connector credit.Connector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				feature := testutils.CreateFeature(t, connector, features[0])
+
+				_, _, err := connector.Reset(ctx, credit.Reset{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					EffectiveAt: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+				})
+				assert.NoError(t, err)
+
+				_, err = connector.CreateGrantSchedule(ctx, newSchedule(ledger, feature, credit.Recurrence{
+					Period: credit.RecurrencePeriodWeek,
+					Anchor: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				}))
+				assert.NoError(t, err)
+
+				grants, err := connector.IssueScheduledGrants(ctx, time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC))
+				assert.NoError(t, err)
+				assert.Len(t, grants, 1)
+				assert.Equal(t, time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC), grants[0].EffectiveAt)
+			},
+		},
+		{
+			name:        "DeleteGrantSchedule",
+			description: "Should not issue grants of deleted schedules",
+			test: func(t *testing.T, connector credit.Connector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				feature := testutils.CreateFeature(t, connector, features[0])
+
+				schedule, err := connector.CreateGrantSchedule(ctx, newSchedule(ledger, feature, credit.Recurrence{
+					Period: credit.RecurrencePeriodYear,
+					Anchor: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				}))
+				assert.NoError(t, err)
+
+				scheduleID := credit.NewNamespacedGrantScheduleID(namespace, *schedule.ID)
+
+				schedules, err := connector.ListGrantSchedules(ctx, credit.ListGrantSchedulesParams{
+					Namespace: namespace,
+					LedgerIDs: []credit.LedgerID{ledger.ID},
+				})
+				assert.NoError(t, err)
+				assert.Len(t, schedules, 1)
+
+				err = connector.DeleteGrantSchedule(ctx, scheduleID)
+				assert.NoError(t, err)
+
+				_, err = connector.GetGrantSchedule(ctx, scheduleID)
+				assert.Equal(t, &credit.GrantScheduleNotFoundError{ID: *schedule.ID}, err)
+
+				err = connector.DeleteGrantSchedule(ctx, scheduleID)
+				assert.Equal(t, &credit.GrantScheduleNotFoundError{ID: *schedule.ID}, err)
+
+				grants, err := connector.IssueScheduledGrants(ctx, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))
+				assert.NoError(t, err)
+				assert.Empty(t, grants)
+			},
+		},
+		{
+			name:        "IssueScheduledGrantsOfArchivedFeature",
+			description: "Should skip the occurrences while the feature of the schedule is archived",
+			test: func(t *testing.T, connector credit.Connector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				feature := testutils.CreateFeature(t, connector, features[0])
+				featureID := credit.NewNamespacedFeatureID(namespace, *feature.ID)
+
+				_, err := connector.CreateGrantSchedule(ctx, newSchedule(ledger, feature, credit.Recurrence{
+					Period: credit.RecurrencePeriodMonth,
+					Anchor: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				}))
+				assert.NoError(t, err)
+
+				err = connector.DeleteFeature(ctx, featureID)
+				assert.NoError(t, err)
+
+				grants, err := connector.IssueScheduledGrants(ctx, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+				assert.NoError(t, err)
+				assert.Empty(t, grants)
+
+				_, err = connector.UpdateFeature(ctx, featureID, credit.FeatureUpdate{Archived: convert.ToPointer(false)})
+				assert.NoError(t, err)
+
+				// Skipped occurrences are not issued once the feature is unarchived
+				grants, err = connector.IssueScheduledGrants(ctx, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+				assert.NoError(t, err)
+				assert.Len(t, grants, 1)
+				assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), grants[0].EffectiveAt)
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			t.Log(tc.description)
+			driver := om_testutils.InitPostgresDB(t)
+			databaseClient := db.NewClient(db.Driver(driver))
+			defer databaseClient.Close()
+			old, err := time.Parse(time.RFC3339, "2020-01-01T00:00:00Z")
+			assert.NoError(t, err)
+			streamingConnector := testutils.NewMockStreamingConnector(t, testutils.MockStreamingConnectorParams{DefaultHighwatermark: old})
+			for _, meter := range meters {
+				streamingConnector.AddRow(meter.Slug, models.MeterQueryRow{
+					Value: 0,
+				})
+			}
+
+			connector := NewPostgresConnector(slog.Default(), databaseClient, streamingConnector, meterRepository, PostgresConnectorConfig{
+				WindowSize: windowSize,
+			})
+
+			ledger, err := connector.CreateLedger(context.Background(), credit.Ledger{
+				Namespace: namespace,
+				Subject:   ulid.Make().String(),
+			})
+			assert.NoError(t, err)
+
+			tc.test(t, connector, databaseClient, ledger)
+		})
+	}
+}
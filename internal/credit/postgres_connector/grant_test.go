@@ -170,6 +170,86 @@ func TestPostgresConnectorGrants(t *testing.T) {
 				testutils.AssertGrantsEqual(t, v, grant)
 			},
 		},
+		{
+			name:        "GetRolledOverGrant",
+			description: "Get the grant rolled over by a reset instead of the grant it rolled over",
+			test: func(t *testing.T, connector credit.Connector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				p := testutils.CreateFeature(t, connector, features[0])
+				g, err := connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					FeatureID:   p.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      100,
+					Priority:    1,
+					EffectiveAt: effectiveTime,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationMonth,
+						Count:    1,
+					},
+					Rollover: &credit.GrantRollover{
+						Type: credit.GrantRolloverTypeOriginalAmount,
+					},
+				})
+				assert.NoError(t, err)
+
+				_, rolledOver, err := connector.Reset(ctx, credit.Reset{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					EffectiveAt: effectiveTime.Add(time.Hour),
+				})
+				assert.NoError(t, err)
+				assert.Len(t, rolledOver, 1)
+
+				// The grant rolled over is replaced by its rollover
+				_, err = connector.GetGrant(ctx, credit.NewNamespacedGrantID(namespace, *g.ID))
+				assert.Equal(t, &credit.GrantNotFoundError{GrantID: *g.ID}, err)
+
+				g2, err := connector.GetGrant(ctx, credit.NewNamespacedGrantID(namespace, *rolledOver[0].ID))
+				assert.NoError(t, err)
+				testutils.AssertGrantsEqual(t, rolledOver[0], g2)
+			},
+		},
+		{
+			name:        "GetAmendedGrant",
+			description: "Get a grant that was amended",
+			test: func(t *testing.T, connector credit.Connector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				p := testutils.CreateFeature(t, connector, features[0])
+				g, err := connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					FeatureID:   p.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      100,
+					Priority:    1,
+					EffectiveAt: effectiveTime,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationMonth,
+						Count:    1,
+					},
+				})
+				assert.NoError(t, err)
+
+				for _, amount := range []float64{150, 200} {
+					_, err = connector.AmendGrant(ctx, credit.GrantAmendment{
+						Namespace:   namespace,
+						LedgerID:    ledger.ID,
+						GrantID:     *g.ID,
+						EffectiveAt: effectiveTime.Add(time.Hour),
+						Amount:      convert.ToPointer(amount),
+					})
+					assert.NoError(t, err)
+				}
+
+				// Amendments do not replace the grant
+				g2, err := connector.GetGrant(ctx, credit.NewNamespacedGrantID(namespace, *g.ID))
+				assert.NoError(t, err)
+				assert.Equal(t, *g.ID, *g2.ID)
+				assert.False(t, g2.Void)
+			},
+		},
 		{
 			name:        "VoidGrantNotFound",
 			description: "Void a grant that does not exist",
@@ -3,20 +3,37 @@ package postgres_connector
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/openmeterio/openmeter/internal/credit"
 	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
 	db_ledger "github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/ledger"
+	"github.com/openmeterio/openmeter/pkg/convert"
 	"github.com/openmeterio/openmeter/pkg/slicesx"
 )
 
 func (c *PostgresConnector) CreateLedger(ctx context.Context, ledgerIn credit.Ledger) (credit.Ledger, error) {
-	entity, err := c.db.Ledger.Create().
+	overageLimit, featureOverageLimits := mapOverageLimitsToDB(ledgerIn.OverageLimits)
+
+	q := c.db.Ledger.Create().
 		SetNamespace(ledgerIn.Namespace).
 		SetMetadata(ledgerIn.Metadata).
 		SetSubject(ledgerIn.Subject).
-		SetHighwatermark(defaultHighwatermark).
-		Save(ctx)
+		SetNillableOverageLimit(overageLimit).
+		SetFeatureOverageLimits(featureOverageLimits).
+		SetHighwatermark(defaultHighwatermark)
+
+	if ledgerIn.UsagePeriod != nil {
+		usagePeriod := c.alignUsagePeriod(*ledgerIn.UsagePeriod)
+		nextResetIndex := usagePeriod.Next(time.Now())
+
+		q = q.SetUsagePeriod(usagePeriod.Period).
+			SetUsagePeriodAnchor(usagePeriod.Anchor).
+			SetNextResetIndex(nextResetIndex).
+			SetNextResetAt(usagePeriod.At(nextResetIndex))
+	}
+
+	entity, err := q.Save(ctx)
 
 	if db.IsConstraintError(err) {
 		// This cannot happen in the same transaction as the previous Create
@@ -92,6 +109,83 @@ func (c *PostgresConnector) ListLedgers(ctx context.Context, params credit.ListL
 	return slicesx.Map(dbLedgers, mapDBLedgerToModel), nil
 }
 
+// UpdateOverageLimits replaces the overage limits of a ledger.
+func (c *PostgresConnector) UpdateOverageLimits(ctx context.Context, ledgerID credit.NamespacedLedgerID, limits credit.OverageLimits) (credit.Ledger, error) {
+	overageLimit, featureOverageLimits := mapOverageLimitsToDB(&limits)
+
+	update := c.db.Ledger.Update().
+		Where(db_ledger.Namespace(ledgerID.Namespace)).
+		Where(db_ledger.ID(string(ledgerID.ID))).
+		SetFeatureOverageLimits(featureOverageLimits)
+
+	if overageLimit != nil {
+		update = update.SetOverageLimit(*overageLimit)
+	} else {
+		update = update.ClearOverageLimit()
+	}
+
+	affected, err := update.Save(ctx)
+	if err != nil {
+		return credit.Ledger{}, fmt.Errorf("failed to update overage limits: %w", err)
+	}
+
+	if affected == 0 {
+		return credit.Ledger{}, &credit.LedgerNotFoundError{LedgerID: ledgerID.ID}
+	}
+
+	entity, err := c.getLedger(ctx, ledgerID)
+	if err != nil {
+		return credit.Ledger{}, fmt.Errorf("failed to get ledger: %w", err)
+	}
+
+	return mapDBLedgerToModel(entity), nil
+}
+
+// UpdateUsagePeriod replaces the usage period of a ledger, the ledger is no longer reset automatically if the period is nil.
+// The first automatic reset is at the first boundary of the new period in the future, the ledger is not reset retroactively.
+func (c *PostgresConnector) UpdateUsagePeriod(ctx context.Context, ledgerID credit.NamespacedLedgerID, usagePeriod *credit.Recurrence) (credit.Ledger, error) {
+	// Lock the ledger to not interfere with the reset of the previous usage period
+	ledger, err := mutationTransaction(ctx, c, ledgerID, func(tx *db.Tx, ledgerEntity *db.Ledger) (*db.Ledger, error) {
+		update := tx.Ledger.UpdateOneID(ledgerEntity.ID)
+
+		if usagePeriod != nil {
+			period := c.alignUsagePeriod(*usagePeriod)
+			nextResetIndex := period.Next(time.Now())
+
+			update = update.SetUsagePeriod(period.Period).
+				SetUsagePeriodAnchor(period.Anchor).
+				SetNextResetIndex(nextResetIndex).
+				SetNextResetAt(period.At(nextResetIndex))
+		} else {
+			update = update.ClearUsagePeriod().
+				ClearUsagePeriodAnchor().
+				SetNextResetIndex(0).
+				ClearNextResetAt()
+		}
+
+		entity, err := update.Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update usage period: %w", err)
+		}
+
+		return entity, nil
+	})
+	if err != nil {
+		return credit.Ledger{}, err
+	}
+
+	return mapDBLedgerToModel(ledger), nil
+}
+
+// alignUsagePeriod aligns the anchor of the usage period to the window size as resets take effect at the start of a window.
+func (c *PostgresConnector) alignUsagePeriod(usagePeriod credit.Recurrence) credit.Recurrence {
+	if truncated := usagePeriod.Anchor.Truncate(c.config.WindowSize); !truncated.Equal(usagePeriod.Anchor) {
+		usagePeriod.Anchor = truncated.Add(c.config.WindowSize)
+	}
+
+	return usagePeriod
+}
+
 func (c *PostgresConnector) getLedger(ctx context.Context, ledgerID credit.NamespacedLedgerID) (*db.Ledger, error) {
 	return c.db.Ledger.Query().
 		Where(db_ledger.Namespace(ledgerID.Namespace)).
@@ -101,10 +195,64 @@ func (c *PostgresConnector) getLedger(ctx context.Context, ledgerID credit.Names
 
 func mapDBLedgerToModel(ledger *db.Ledger) credit.Ledger {
 	return credit.Ledger{
-		Namespace: ledger.Namespace,
-		ID:        credit.LedgerID(ledger.ID),
-		Subject:   ledger.Subject,
-		Metadata:  ledger.Metadata,
-		CreatedAt: ledger.CreatedAt,
+		Namespace:     ledger.Namespace,
+		ID:            credit.LedgerID(ledger.ID),
+		Subject:       ledger.Subject,
+		Metadata:      ledger.Metadata,
+		OverageLimits: mapOverageLimitsFromDB(ledger.OverageLimit, ledger.FeatureOverageLimits),
+		UsagePeriod:   mapUsagePeriodFromDB(ledger),
+		NextResetAt:   mapNextResetAtFromDB(ledger.NextResetAt),
+		CreatedAt:     ledger.CreatedAt,
+	}
+}
+
+func mapNextResetAtFromDB(nextResetAt *time.Time) *time.Time {
+	if nextResetAt == nil {
+		return nil
+	}
+
+	return convert.ToPointer(nextResetAt.In(time.UTC))
+}
+
+func mapUsagePeriodFromDB(ledger *db.Ledger) *credit.Recurrence {
+	if ledger.UsagePeriod == nil || ledger.UsagePeriodAnchor == nil {
+		return nil
+	}
+
+	return &credit.Recurrence{
+		Period: *ledger.UsagePeriod,
+		Anchor: ledger.UsagePeriodAnchor.In(time.UTC),
 	}
 }
+
+func mapOverageLimitsToDB(limits *credit.OverageLimits) (*float64, map[string]float64) {
+	if limits == nil {
+		return nil, nil
+	}
+
+	featureLimits := make(map[string]float64, len(limits.Features))
+	for featureID, limit := range limits.Features {
+		featureLimits[string(featureID)] = limit
+	}
+
+	return limits.Limit, featureLimits
+}
+
+func mapOverageLimitsFromDB(limit *float64, featureLimits map[string]float64) *credit.OverageLimits {
+	if limit == nil && len(featureLimits) == 0 {
+		return nil
+	}
+
+	limits := &credit.OverageLimits{
+		Limit: limit,
+	}
+
+	if len(featureLimits) > 0 {
+		limits.Features = make(map[credit.FeatureID]float64, len(featureLimits))
+		for featureID, limit := range featureLimits {
+			limits.Features[credit.FeatureID(featureID)] = limit
+		}
+	}
+
+	return limits
+}
@@ -0,0 +1,388 @@
+package postgres_connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/oklog/ulid/v2"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	db_ledger "github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/ledger"
+	db_notificationdelivery "github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationdelivery"
+	db_notificationrule "github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/notificationrule"
+	"github.com/openmeterio/openmeter/pkg/convert"
+	"github.com/openmeterio/openmeter/pkg/slicesx"
+)
+
+// CreateNotificationRule creates a notification rule, the rule is evaluated by the next run of EvaluateNotificationRules.
+func (c *PostgresConnector) CreateNotificationRule(ctx context.Context, ruleIn credit.NotificationRule) (credit.NotificationRule, error) {
+	exists, err := c.db.Ledger.Query().
+		Where(db_ledger.Namespace(ruleIn.Namespace)).
+		Where(db_ledger.ID(string(ruleIn.LedgerID))).
+		Exist(ctx)
+	if err != nil {
+		return credit.NotificationRule{}, fmt.Errorf("failed to query ledger: %w", err)
+	}
+	if !exists {
+		return credit.NotificationRule{}, &credit.LedgerNotFoundError{LedgerID: ruleIn.LedgerID}
+	}
+
+	entity, err := c.db.NotificationRule.Create().
+		SetNamespace(ruleIn.Namespace).
+		SetNillableID((*string)(ruleIn.ID)).
+		SetLedgerID(string(ruleIn.LedgerID)).
+		SetNillableFeatureID((*string)(ruleIn.FeatureID)).
+		SetType(ruleIn.Type).
+		SetNillableThreshold(ruleIn.Threshold).
+		SetURL(ruleIn.URL).
+		Save(ctx)
+	if err != nil {
+		return credit.NotificationRule{}, fmt.Errorf("failed to create notification rule: %w", err)
+	}
+
+	return mapNotificationRuleEntity(entity), nil
+}
+
+// ListNotificationRules lists the notification rules of a namespace.
+func (c *PostgresConnector) ListNotificationRules(ctx context.Context, params credit.ListNotificationRulesParams) ([]credit.NotificationRule, error) {
+	q := c.db.NotificationRule.Query().
+		Where(db_notificationrule.Namespace(params.Namespace))
+
+	if len(params.LedgerIDs) > 0 {
+		q = q.Where(db_notificationrule.LedgerIDIn(slicesx.Map(params.LedgerIDs, func(id credit.LedgerID) string {
+			return string(id)
+		})...))
+	}
+
+	entities, err := q.Order(db_notificationrule.ByID()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification rules: %w", err)
+	}
+
+	return slicesx.Map(entities, mapNotificationRuleEntity), nil
+}
+
+// GetNotificationRule gets a notification rule by ID.
+func (c *PostgresConnector) GetNotificationRule(ctx context.Context, ruleID credit.NamespacedNotificationRuleID) (credit.NotificationRule, error) {
+	entity, err := c.db.NotificationRule.Query().
+		Where(db_notificationrule.Namespace(ruleID.Namespace)).
+		Where(db_notificationrule.ID(string(ruleID.ID))).
+		Only(ctx)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return credit.NotificationRule{}, &credit.NotificationRuleNotFoundError{ID: ruleID.ID}
+		}
+
+		return credit.NotificationRule{}, fmt.Errorf("failed to get notification rule: %w", err)
+	}
+
+	return mapNotificationRuleEntity(entity), nil
+}
+
+// DeleteNotificationRule deletes a notification rule.
+// The delivery log of the rule is kept and pending deliveries are still delivered.
+func (c *PostgresConnector) DeleteNotificationRule(ctx context.Context, ruleID credit.NamespacedNotificationRuleID) error {
+	affected, err := c.db.NotificationRule.Delete().
+		Where(db_notificationrule.Namespace(ruleID.Namespace)).
+		Where(db_notificationrule.ID(string(ruleID.ID))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete notification rule: %w", err)
+	}
+
+	if affected == 0 {
+		return &credit.NotificationRuleNotFoundError{ID: ruleID.ID}
+	}
+
+	return nil
+}
+
+// EvaluateNotificationRules evaluates all notification rules at the given time and
+// creates a pending delivery for each rule triggered.
+// The balance of a ledger is calculated without locking the ledger, the rules are compared to it and
+// updated while the ledger is locked, so a rule is triggered once even if several workers evaluate it at the same time.
+// Changes of the ledger committed meanwhile are evaluated by the next run.
+func (c *PostgresConnector) EvaluateNotificationRules(ctx context.Context, at time.Time) ([]credit.NotificationDelivery, error) {
+	entities, err := c.db.NotificationRule.Query().
+		Order(db_notificationrule.ByNamespace(), db_notificationrule.ByLedgerID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification rules: %w", err)
+	}
+
+	// Rules are ordered by ledger, balances are calculated once per ledger
+	ledgerIDs := []credit.NamespacedLedgerID{}
+	for _, entity := range entities {
+		ledgerID := credit.NewNamespacedLedgerID(entity.Namespace, credit.LedgerID(entity.LedgerID))
+		if len(ledgerIDs) == 0 || ledgerIDs[len(ledgerIDs)-1] != ledgerID {
+			ledgerIDs = append(ledgerIDs, ledgerID)
+		}
+	}
+
+	deliveries := []credit.NotificationDelivery{}
+	var errs []error
+
+	for _, ledgerID := range ledgerIDs {
+		// Calculating the balance queries usage, it must not block changes of the ledger
+		balance, err := c.GetBalance(ctx, ledgerID, at)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get balance of ledger %s: %w", ledgerID.ID, err))
+			continue
+		}
+
+		triggered, err := mutationTransaction(ctx, c, ledgerID, func(tx *db.Tx, ledgerEntity *db.Ledger) (*[]credit.NotificationDelivery, error) {
+			return c.evaluateNotificationRules(ctx, tx, ledgerEntity, balance, at)
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to evaluate notification rules of ledger %s: %w", ledgerID.ID, err))
+			continue
+		}
+
+		deliveries = append(deliveries, *triggered...)
+	}
+
+	return deliveries, errors.Join(errs...)
+}
+
+// evaluateNotificationRules evaluates the notification rules of a ledger against its balance, the ledger must be locked.
+func (c *PostgresConnector) evaluateNotificationRules(ctx context.Context, tx *db.Tx, ledgerEntity *db.Ledger, balance credit.Balance, at time.Time) (*[]credit.NotificationDelivery, error) {
+	deliveries := []credit.NotificationDelivery{}
+
+	// Read the rules again with the ledger locked as another worker may have evaluated them meanwhile
+	entities, err := tx.NotificationRule.Query().
+		Where(db_notificationrule.Namespace(ledgerEntity.Namespace)).
+		Where(db_notificationrule.LedgerID(ledgerEntity.ID)).
+		Order(db_notificationrule.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification rules: %w", err)
+	}
+
+	if len(entities) == 0 {
+		return &deliveries, nil
+	}
+
+	for _, entity := range entities {
+		rule := mapNotificationRuleEntity(entity)
+
+		watched, met := rule.Evaluate(balance)
+
+		switch {
+		case met && !rule.Triggered:
+			err := tx.NotificationRule.UpdateOneID(entity.ID).
+				SetTriggered(true).
+				SetTriggeredAt(at).
+				Exec(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update notification rule: %w", err)
+			}
+
+			// Retries of the delivery send the same event ID so receivers can deduplicate
+			deliveryID := ulid.Make().String()
+
+			delivery, err := tx.NotificationDelivery.Create().
+				SetID(deliveryID).
+				SetNamespace(entity.Namespace).
+				SetRuleID(entity.ID).
+				SetLedgerID(entity.LedgerID).
+				SetURL(entity.URL).
+				SetEvent(credit.NotificationEvent{
+					ID:                  deliveryID,
+					Time:                at,
+					RuleID:              *rule.ID,
+					Type:                rule.Type,
+					Threshold:           rule.Threshold,
+					LedgerID:            rule.LedgerID,
+					Subject:             ledgerEntity.Subject,
+					FeatureID:           rule.FeatureID,
+					NotificationBalance: watched,
+				}).
+				SetStatus(credit.NotificationDeliveryStatusPending).
+				SetNextAttemptAt(at).
+				Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create notification delivery: %w", err)
+			}
+
+			deliveries = append(deliveries, mapNotificationDeliveryEntity(delivery))
+		case !met && rule.Triggered:
+			// The condition cleared (eg. after a top-up), the rule can trigger again
+			err := tx.NotificationRule.UpdateOneID(entity.ID).
+				SetTriggered(false).
+				Exec(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update notification rule: %w", err)
+			}
+		}
+	}
+
+	return &deliveries, nil
+}
+
+// ListNotificationDeliveries lists the delivery log of notification rules, most recent first.
+func (c *PostgresConnector) ListNotificationDeliveries(ctx context.Context, params credit.ListNotificationDeliveriesParams) ([]credit.NotificationDelivery, error) {
+	q := c.db.NotificationDelivery.Query().
+		Where(db_notificationdelivery.Namespace(params.Namespace))
+
+	if len(params.RuleIDs) > 0 {
+		q = q.Where(db_notificationdelivery.RuleIDIn(slicesx.Map(params.RuleIDs, func(id credit.NotificationRuleID) string {
+			return string(id)
+		})...))
+	}
+
+	if params.Limit > 0 {
+		q = q.Limit(params.Limit)
+	}
+
+	entities, err := q.Order(db_notificationdelivery.ByCreatedAt(sql.OrderDesc()), db_notificationdelivery.ByID(sql.OrderDesc())).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification deliveries: %w", err)
+	}
+
+	return slicesx.Map(entities, mapNotificationDeliveryEntity), nil
+}
+
+// ClaimNotificationDeliveries returns pending deliveries due until the given time.
+// Claimed deliveries are not returned to other callers for the duration of the lease,
+// a delivery not updated by the end of the lease (eg. the worker crashed) is claimed again.
+func (c *PostgresConnector) ClaimNotificationDeliveries(ctx context.Context, until time.Time, lease time.Duration, limit int) ([]credit.NotificationDelivery, error) {
+	deliveries, err := transaction(ctx, c, func(tx *db.Tx) (*[]credit.NotificationDelivery, error) {
+		entities, err := tx.NotificationDelivery.Query().
+			Where(db_notificationdelivery.StatusEQ(credit.NotificationDeliveryStatusPending)).
+			Where(db_notificationdelivery.NextAttemptAtLTE(until)).
+			Order(db_notificationdelivery.ByNextAttemptAt()).
+			Limit(limit).
+			// Deliveries claimed by other workers meanwhile are skipped instead of waited for
+			ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list due notification deliveries: %w", err)
+		}
+
+		deliveries := slicesx.Map(entities, mapNotificationDeliveryEntity)
+		if len(deliveries) == 0 {
+			return &deliveries, nil
+		}
+
+		// The end of the lease identifies the claim, it is stored at the precision of the database
+		leaseUntil := until.Add(lease).Truncate(time.Microsecond)
+
+		err = tx.NotificationDelivery.Update().
+			Where(db_notificationdelivery.IDIn(slicesx.Map(deliveries, func(delivery credit.NotificationDelivery) string {
+				return delivery.ID
+			})...)).
+			SetNextAttemptAt(leaseUntil).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim notification deliveries: %w", err)
+		}
+
+		for i := range deliveries {
+			deliveries[i].NextAttemptAt = convert.ToPointer(leaseUntil.In(time.UTC))
+			deliveries[i].LeasedUntil = convert.ToPointer(leaseUntil.In(time.UTC))
+		}
+
+		return &deliveries, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return *deliveries, nil
+}
+
+// UpdateNotificationDelivery records the state of a delivery after an attempt.
+// A claimed delivery is only updated while its lease is held, a delivery claimed again meanwhile is not overwritten.
+func (c *PostgresConnector) UpdateNotificationDelivery(ctx context.Context, delivery credit.NotificationDelivery) (credit.NotificationDelivery, error) {
+	q := c.db.NotificationDelivery.UpdateOneID(delivery.ID).
+		Where(db_notificationdelivery.Namespace(delivery.Namespace)).
+		SetStatus(delivery.Status).
+		SetAttempts(delivery.Attempts).
+		SetNillableLastAttemptAt(delivery.LastAttemptAt)
+
+	// Claiming a delivery moves its next attempt to the end of the lease
+	if delivery.LeasedUntil != nil {
+		q = q.Where(db_notificationdelivery.NextAttemptAtEQ(*delivery.LeasedUntil))
+	}
+
+	if delivery.NextAttemptAt != nil {
+		q = q.SetNextAttemptAt(*delivery.NextAttemptAt)
+	} else {
+		q = q.ClearNextAttemptAt()
+	}
+
+	if delivery.LastStatusCode != nil {
+		q = q.SetLastStatusCode(*delivery.LastStatusCode)
+	} else {
+		q = q.ClearLastStatusCode()
+	}
+
+	if delivery.LastError != nil {
+		q = q.SetLastError(*delivery.LastError)
+	} else {
+		q = q.ClearLastError()
+	}
+
+	entity, err := q.Save(ctx)
+	if err != nil {
+		if db.IsNotFound(err) && delivery.LeasedUntil != nil {
+			return credit.NotificationDelivery{}, &credit.NotificationDeliveryLeaseLostError{ID: delivery.ID}
+		}
+
+		return credit.NotificationDelivery{}, fmt.Errorf("failed to update notification delivery: %w", err)
+	}
+
+	return mapNotificationDeliveryEntity(entity), nil
+}
+
+func mapNotificationRuleEntity(entity *db.NotificationRule) credit.NotificationRule {
+	rule := credit.NotificationRule{
+		Namespace: entity.Namespace,
+		ID:        (*credit.NotificationRuleID)(&entity.ID),
+		LedgerID:  credit.LedgerID(entity.LedgerID),
+		FeatureID: (*credit.FeatureID)(entity.FeatureID),
+		Type:      entity.Type,
+		Threshold: entity.Threshold,
+		URL:       entity.URL,
+		Triggered: entity.Triggered,
+		CreatedAt: convert.ToPointer(entity.CreatedAt.In(time.UTC)),
+		UpdatedAt: convert.ToPointer(entity.UpdatedAt.In(time.UTC)),
+	}
+
+	if entity.TriggeredAt != nil {
+		rule.TriggeredAt = convert.ToPointer(entity.TriggeredAt.In(time.UTC))
+	}
+
+	return rule
+}
+
+func mapNotificationDeliveryEntity(entity *db.NotificationDelivery) credit.NotificationDelivery {
+	delivery := credit.NotificationDelivery{
+		Namespace:      entity.Namespace,
+		ID:             entity.ID,
+		RuleID:         credit.NotificationRuleID(entity.RuleID),
+		LedgerID:       credit.LedgerID(entity.LedgerID),
+		URL:            entity.URL,
+		Event:          entity.Event,
+		Status:         entity.Status,
+		Attempts:       entity.Attempts,
+		LastStatusCode: entity.LastStatusCode,
+		LastError:      entity.LastError,
+		CreatedAt:      convert.ToPointer(entity.CreatedAt.In(time.UTC)),
+		UpdatedAt:      convert.ToPointer(entity.UpdatedAt.In(time.UTC)),
+	}
+
+	if entity.NextAttemptAt != nil {
+		delivery.NextAttemptAt = convert.ToPointer(entity.NextAttemptAt.In(time.UTC))
+	}
+
+	if entity.LastAttemptAt != nil {
+		delivery.LastAttemptAt = convert.ToPointer(entity.LastAttemptAt.In(time.UTC))
+	}
+
+	return delivery
+}
@@ -0,0 +1,259 @@
+package postgres_connector
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/testutils"
+	meter_model "github.com/openmeterio/openmeter/internal/meter"
+	om_testutils "github.com/openmeterio/openmeter/internal/testutils"
+	"github.com/openmeterio/openmeter/pkg/convert"
+	"github.com/openmeterio/openmeter/pkg/models"
+)
+
+func TestPostgresConnectorNotifications(t *testing.T) {
+	windowSize := time.Minute
+	namespace := "default"
+	meter := models.Meter{
+		Namespace:   namespace,
+		ID:          "meter-1",
+		Slug:        "meter-1",
+		Aggregation: models.MeterAggregationSum,
+	}
+	meterRepository := meter_model.NewInMemoryRepository([]models.Meter{meter})
+	featureIn := credit.Feature{
+		Namespace: namespace,
+		MeterSlug: meter.Slug,
+		Name:      "feature-1",
+	}
+
+	// We need to truncate the time to workaround pgx driver timezone issue
+	t1 := time.Now().In(time.UTC).Truncate(time.Hour * 24).Add(-time.Hour * 24)
+
+	newGrant := func(ledger credit.Ledger, feature credit.Feature, amount float64) credit.Grant {
+		return credit.Grant{
+			Namespace:   namespace,
+			LedgerID:    ledger.ID,
+			FeatureID:   feature.ID,
+			Type:        credit.GrantTypeUsage,
+			Amount:      amount,
+			Priority:    1,
+			EffectiveAt: t1,
+			Expiration: credit.ExpirationPeriod{
+				Duration: credit.ExpirationPeriodDurationMonth,
+				Count:    1,
+			},
+		}
+	}
+
+	tt := []struct {
+		name        string
+		description string
+		test        func(t *testing.T, connector credit.Connector, db_client *db.Client, ledger credit.Ledger)
+	}{
+		{
+			name:        "EvaluateNotificationRules",
+			description: "Should trigger a rule once while its condition is met and again after it cleared",
+			test: func(t *testing.T, connector credit.Connector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				feature := testutils.CreateFeature(t, connector, featureIn)
+
+				_, err := connector.CreateGrant(ctx, newGrant(ledger, feature, 100))
+				assert.NoError(t, err)
+
+				rule, err := connector.CreateNotificationRule(ctx, credit.NotificationRule{
+					Namespace: namespace,
+					LedgerID:  ledger.ID,
+					FeatureID: feature.ID,
+					Type:      credit.NotificationRuleTypeBalanceBelow,
+					Threshold: convert.ToPointer(150.0),
+					URL:       "https://example.com/webhook",
+				})
+				assert.NoError(t, err)
+				assert.False(t, rule.Triggered)
+
+				deliveries, err := connector.EvaluateNotificationRules(ctx, time.Now())
+				assert.NoError(t, err)
+				assert.Len(t, deliveries, 1)
+				assert.Equal(t, *rule.ID, deliveries[0].RuleID)
+				assert.Equal(t, "https://example.com/webhook", deliveries[0].URL)
+				assert.Equal(t, credit.NotificationDeliveryStatusPending, deliveries[0].Status)
+				assert.Equal(t, deliveries[0].ID, deliveries[0].Event.ID)
+				assert.Equal(t, ledger.Subject, deliveries[0].Event.Subject)
+				assert.Equal(t, credit.NotificationBalance{Balance: 100, Granted: 100}, deliveries[0].Event.NotificationBalance)
+
+				// The rule stays triggered while the condition is met
+				deliveries, err = connector.EvaluateNotificationRules(ctx, time.Now())
+				assert.NoError(t, err)
+				assert.Empty(t, deliveries)
+
+				rule, err = connector.GetNotificationRule(ctx, credit.NewNamespacedNotificationRuleID(namespace, *rule.ID))
+				assert.NoError(t, err)
+				assert.True(t, rule.Triggered)
+				assert.NotNil(t, rule.TriggeredAt)
+
+				// A top-up clears the condition
+				topUp, err := connector.CreateGrant(ctx, newGrant(ledger, feature, 100))
+				assert.NoError(t, err)
+
+				deliveries, err = connector.EvaluateNotificationRules(ctx, time.Now())
+				assert.NoError(t, err)
+				assert.Empty(t, deliveries)
+
+				rule, err = connector.GetNotificationRule(ctx, credit.NewNamespacedNotificationRuleID(namespace, *rule.ID))
+				assert.NoError(t, err)
+				assert.False(t, rule.Triggered)
+
+				// The rule triggers again when the condition is met again
+				_, err = connector.VoidGrant(ctx, topUp)
+				assert.NoError(t, err)
+
+				deliveries, err = connector.EvaluateNotificationRules(ctx, time.Now())
+				assert.NoError(t, err)
+				assert.Len(t, deliveries, 1)
+
+				log, err := connector.ListNotificationDeliveries(ctx, credit.ListNotificationDeliveriesParams{
+					Namespace: namespace,
+					RuleIDs:   []credit.NotificationRuleID{*rule.ID},
+				})
+				assert.NoError(t, err)
+				assert.Len(t, log, 2)
+			},
+		},
+		{
+			name:        "ClaimNotificationDeliveries",
+			description: "Should claim a due delivery once per lease and record attempts",
+			test: func(t *testing.T, connector credit.Connector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				feature := testutils.CreateFeature(t, connector, featureIn)
+
+				_, err := connector.CreateGrant(ctx, newGrant(ledger, feature, 100))
+				assert.NoError(t, err)
+
+				_, err = connector.CreateNotificationRule(ctx, credit.NotificationRule{
+					Namespace: namespace,
+					LedgerID:  ledger.ID,
+					Type:      credit.NotificationRuleTypeBalanceBelow,
+					Threshold: convert.ToPointer(1000.0),
+					URL:       "https://example.com/webhook",
+				})
+				assert.NoError(t, err)
+
+				now := time.Now()
+
+				_, err = connector.EvaluateNotificationRules(ctx, now)
+				assert.NoError(t, err)
+
+				claimed, err := connector.ClaimNotificationDeliveries(ctx, now, time.Minute, 10)
+				assert.NoError(t, err)
+				assert.Len(t, claimed, 1)
+
+				// Leased deliveries are not claimed again
+				claimedAgain, err := connector.ClaimNotificationDeliveries(ctx, now, time.Minute, 10)
+				assert.NoError(t, err)
+				assert.Empty(t, claimedAgain)
+
+				// The delivery is claimed again after the lease expires
+				claimedAgain, err = connector.ClaimNotificationDeliveries(ctx, now.Add(2*time.Minute), time.Minute, 10)
+				assert.NoError(t, err)
+				assert.Len(t, claimedAgain, 1)
+
+				// The worker whose lease expired does not overwrite the delivery claimed again
+				stale := claimed[0]
+				stale.Status = credit.NotificationDeliveryStatusFailed
+				stale.Attempts = 1
+				_, err = connector.UpdateNotificationDelivery(ctx, stale)
+				assert.Equal(t, &credit.NotificationDeliveryLeaseLostError{ID: stale.ID}, err)
+
+				delivery := claimedAgain[0]
+				delivery.Status = credit.NotificationDeliveryStatusSucceeded
+				delivery.Attempts = 1
+				delivery.LastAttemptAt = convert.ToPointer(now.Truncate(time.Second).In(time.UTC))
+				delivery.LastStatusCode = convert.ToPointer(200)
+				delivery.NextAttemptAt = nil
+
+				updated, err := connector.UpdateNotificationDelivery(ctx, delivery)
+				assert.NoError(t, err)
+				assert.Equal(t, credit.NotificationDeliveryStatusSucceeded, updated.Status)
+				assert.Equal(t, 1, updated.Attempts)
+				assert.Nil(t, updated.NextAttemptAt)
+
+				// Completed deliveries are not claimed
+				claimedAgain, err = connector.ClaimNotificationDeliveries(ctx, now.Add(time.Hour), time.Minute, 10)
+				assert.NoError(t, err)
+				assert.Empty(t, claimedAgain)
+			},
+		},
+		{
+			name:        "DeleteNotificationRule",
+			description: "Should not evaluate deleted rules",
+			test: func(t *testing.T, connector credit.Connector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				feature := testutils.CreateFeature(t, connector, featureIn)
+
+				_, err := connector.CreateGrant(ctx, newGrant(ledger, feature, 100))
+				assert.NoError(t, err)
+
+				rule, err := connector.CreateNotificationRule(ctx, credit.NotificationRule{
+					Namespace: namespace,
+					LedgerID:  ledger.ID,
+					Type:      credit.NotificationRuleTypeBalanceBelow,
+					Threshold: convert.ToPointer(1000.0),
+					URL:       "https://example.com/webhook",
+				})
+				assert.NoError(t, err)
+
+				ruleID := credit.NewNamespacedNotificationRuleID(namespace, *rule.ID)
+
+				err = connector.DeleteNotificationRule(ctx, ruleID)
+				assert.NoError(t, err)
+
+				_, err = connector.GetNotificationRule(ctx, ruleID)
+				assert.Equal(t, &credit.NotificationRuleNotFoundError{ID: *rule.ID}, err)
+
+				err = connector.DeleteNotificationRule(ctx, ruleID)
+				assert.Equal(t, &credit.NotificationRuleNotFoundError{ID: *rule.ID}, err)
+
+				deliveries, err := connector.EvaluateNotificationRules(ctx, time.Now())
+				assert.NoError(t, err)
+				assert.Empty(t, deliveries)
+			},
+		},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			t.Log(tc.description)
+			driver := om_testutils.InitPostgresDB(t)
+			databaseClient := db.NewClient(db.Driver(driver))
+			defer databaseClient.Close()
+
+			old, err := time.Parse(time.RFC3339, "2020-01-01T00:00:00Z")
+			assert.NoError(t, err)
+
+			streamingConnector := testutils.NewMockStreamingConnector(t, testutils.MockStreamingConnectorParams{DefaultHighwatermark: old})
+			// We need to add a row to the streaming connector as the rules are evaluated on the balance
+			streamingConnector.AddRow(meter.Slug, models.MeterQueryRow{})
+
+			connector := NewPostgresConnector(slog.Default(), databaseClient, streamingConnector, meterRepository, PostgresConnectorConfig{
+				WindowSize: windowSize,
+			})
+
+			ledger, err := connector.CreateLedger(context.Background(), credit.Ledger{
+				Namespace: namespace,
+				Subject:   ulid.Make().String(),
+			})
+			assert.NoError(t, err)
+
+			tc.test(t, connector, databaseClient, ledger)
+		})
+	}
+}
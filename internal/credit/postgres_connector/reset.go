@@ -31,133 +31,146 @@ func (c *PostgresConnector) Reset(ctx context.Context, reset credit.Reset) (cred
 		c,
 		ledgerID,
 		func(tx *db.Tx, ledgerEntity *db.Ledger) (*resetWithRollovedGrants, error) {
-			var rollovedGrants []credit.Grant
+			return c.reset(ctx, tx, ledgerEntity, reset)
+		})
 
-			// Check if the reset is in the future
-			err := checkAfterHighWatermark(reset.EffectiveAt, ledgerEntity)
-			if err != nil {
-				// Typed error do not wrap
-				return nil, err
-			}
+	if err != nil {
+		return credit.Reset{}, nil, err
+	}
 
-			// Collect grants to rollover
-			balance, err := c.GetBalance(ctx, ledgerID, reset.EffectiveAt)
-			if err != nil {
-				return nil, fmt.Errorf("failed to list grants: %w", err)
-			}
+	return result.Reset, result.rollovedGrants, err
+}
 
-			// Collect grants to rollover
-			rolloverGrants := []credit.Grant{}
-			for _, grantBalance := range balance.GrantBalances {
-				grant := grantBalance.Grant
-
-				// Do not rollover grants without rollover
-				if grant.Rollover == nil {
-					continue
-				}
-
-				switch grant.Rollover.Type {
-				case credit.GrantRolloverTypeOriginalAmount:
-					// Nothing to do, we rollover the original amount
-				case credit.GrantRolloverTypeRemainingAmount:
-					if grant.Rollover.MaxAmount != nil {
-						// We roll over up to the max amount
-						grant.Amount = math.Min(*grant.Rollover.MaxAmount, grant.Amount)
-					} else {
-						// We rollover the remaining amount
-						grant.Amount = grantBalance.Balance
-					}
-				}
-				if grant.Rollover.MaxAmount != nil {
-					grant.Amount = math.Max(*grant.Rollover.MaxAmount, grant.Amount)
-				}
-				// Skip grants with zero amount, amount never goes negative
-				if grant.Amount == 0 {
-					continue
-				}
-
-				// Set the parent ID to the grant ID we are rolling over
-				grant.ParentID = grant.ID
-				grant.EffectiveAt = reset.EffectiveAt
-
-				// Append grant to rollover grants
-				rolloverGrants = append(rolloverGrants, grant)
-			}
+// reset resets the locked ledger, the effective time must be aligned to the window size.
+func (c *PostgresConnector) reset(ctx context.Context, tx *db.Tx, ledgerEntity *db.Ledger, reset credit.Reset) (*resetWithRollovedGrants, error) {
+	ledgerID := credit.NewNamespacedLedgerID(reset.Namespace, reset.LedgerID)
 
-			// Add reset entry to the transaction
-			createEntities := []*db.CreditEntryCreate{
-				tx.CreditEntry.Create().
-					SetNamespace(reset.Namespace).
-					SetLedgerID(string(reset.LedgerID)).
-					SetEntryType(credit.EntryTypeReset).
-					SetEffectiveAt(reset.EffectiveAt),
-			}
+	var rollovedGrants []credit.Grant
 
-			// Add new grants to the transaction
-			for _, grant := range rolloverGrants {
-				grantEntityCreate := tx.CreditEntry.Create().
-					SetNamespace(reset.Namespace).
-					SetLedgerID(string(grant.LedgerID)).
-					SetEntryType(credit.EntryTypeGrant).
-					SetType(grant.Type).
-					SetNillableParentID((*string)(grant.ParentID)).
-					SetNillableFeatureID((*string)(grant.FeatureID)).
-					SetAmount(grant.Amount).
-					SetPriority(grant.Priority).
-					SetEffectiveAt(grant.EffectiveAt).
-					SetExpirationPeriodDuration(grant.Expiration.Duration).
-					SetExpirationPeriodCount(grant.Expiration.Count).
-					SetMetadata(grant.Metadata)
-
-				if grant.Rollover != nil {
-					grantEntityCreate = grantEntityCreate.
-						SetNillableRolloverMaxAmount(grant.Rollover.MaxAmount).
-						SetRolloverType(grant.Rollover.Type)
-				}
-
-				createEntities = append(createEntities, grantEntityCreate)
+	// Check if the reset is in the future
+	err := checkAfterHighWatermark(reset.EffectiveAt, ledgerEntity)
+	if err != nil {
+		// Typed error do not wrap
+		return nil, err
+	}
 
-			}
+	// Collect grants to rollover
+	balance, err := c.GetBalance(ctx, ledgerID, reset.EffectiveAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grants: %w", err)
+	}
 
-			// Create the reset and grant entries
-			entryEntities, err := tx.CreditEntry.CreateBulk(createEntities...).Save(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("failed to create grant entity: %w", err)
+	// Collect grants to rollover
+	rolloverGrants := []credit.Grant{}
+	for _, grantBalance := range balance.GrantBalances {
+		grant := grantBalance.Grant
+
+		// Do not rollover grants without rollover
+		if grant.Rollover == nil {
+			continue
+		}
+
+		switch grant.Rollover.Type {
+		case credit.GrantRolloverTypeOriginalAmount:
+			// Nothing to do, we rollover the original amount
+		case credit.GrantRolloverTypeRemainingAmount:
+			if grant.Rollover.MaxAmount != nil {
+				// We roll over up to the max amount
+				grant.Amount = math.Min(*grant.Rollover.MaxAmount, grant.Amount)
+			} else {
+				// We rollover the remaining amount
+				grant.Amount = grantBalance.Balance
 			}
+		}
+		if grant.Rollover.MaxAmount != nil {
+			grant.Amount = math.Max(*grant.Rollover.MaxAmount, grant.Amount)
+		}
+		// Skip grants with zero amount, amount never goes negative
+		if grant.Amount == 0 {
+			continue
+		}
+
+		// Set the parent ID to the grant ID we are rolling over
+		grant.ParentID = grant.ID
+		grant.EffectiveAt = reset.EffectiveAt
+
+		// Append grant to rollover grants
+		rolloverGrants = append(rolloverGrants, grant)
+	}
 
-			// Convert the entities to models
-			resetEntity := entryEntities[0]
-			reset, err = mapResetEntity(resetEntity)
-			if err != nil {
-				return nil, fmt.Errorf("failed to map reset entity: %w", err)
-			}
+	// Add reset entry to the transaction
+	createEntities := []*db.CreditEntryCreate{
+		tx.CreditEntry.Create().
+			SetNamespace(reset.Namespace).
+			SetLedgerID(string(reset.LedgerID)).
+			SetEntryType(credit.EntryTypeReset).
+			SetEffectiveAt(reset.EffectiveAt),
+	}
 
-			grantEntities := entryEntities[1:]
-			for _, entity := range grantEntities {
-				grant, err := mapGrantEntity(entity)
-				if err != nil {
-					return nil, fmt.Errorf("failed to map grant entity: %w", err)
-				}
-				rollovedGrants = append(rollovedGrants, grant)
-			}
+	// Add new grants to the transaction
+	for _, grant := range rolloverGrants {
+		grantEntityCreate := tx.CreditEntry.Create().
+			SetNamespace(reset.Namespace).
+			SetLedgerID(string(grant.LedgerID)).
+			SetEntryType(credit.EntryTypeGrant).
+			SetType(grant.Type).
+			SetNillableParentID((*string)(grant.ParentID)).
+			SetNillableFeatureID((*string)(grant.FeatureID)).
+			SetAmount(grant.Amount).
+			SetPriority(grant.Priority).
+			SetEffectiveAt(grant.EffectiveAt).
+			SetExpirationPeriodDuration(grant.Expiration.Duration).
+			SetExpirationPeriodCount(grant.Expiration.Count).
+			SetMetadata(grant.Metadata)
+
+		if grant.Rollover != nil {
+			grantEntityCreate = grantEntityCreate.
+				SetNillableRolloverMaxAmount(grant.Rollover.MaxAmount).
+				SetRolloverType(grant.Rollover.Type)
+		}
+
+		createEntities = append(createEntities, grantEntityCreate)
 
-			// Update the ledger high watermark
-			err = ledgerEntity.Update().SetHighwatermark(reset.EffectiveAt).Exec(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("failed to update ledger highwatermark: %w", err)
-			}
+	}
 
-			return &resetWithRollovedGrants{
-				Reset:          reset,
-				rollovedGrants: rollovedGrants,
-			}, nil
-		})
+	// Create the reset and grant entries
+	entryEntities, err := tx.CreditEntry.CreateBulk(createEntities...).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grant entity: %w", err)
+	}
 
+	// Convert the entities to models
+	resetEntity := entryEntities[0]
+	reset, err = mapResetEntity(resetEntity)
 	if err != nil {
-		return credit.Reset{}, nil, err
+		return nil, fmt.Errorf("failed to map reset entity: %w", err)
 	}
 
-	return result.Reset, result.rollovedGrants, err
+	grantEntities := entryEntities[1:]
+	for _, entity := range grantEntities {
+		grant, err := mapGrantEntity(entity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map grant entity: %w", err)
+		}
+		rollovedGrants = append(rollovedGrants, grant)
+	}
+
+	// Update the ledger high watermark
+	err = ledgerEntity.Update().SetHighwatermark(reset.EffectiveAt).Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ledger highwatermark: %w", err)
+	}
+
+	// Snapshots before the reset are not used anymore and snapshots after it do not include the rolled over grants
+	err = invalidateBalanceSnapshots(ctx, tx, ledgerID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &resetWithRollovedGrants{
+		Reset:          reset,
+		rollovedGrants: rollovedGrants,
+	}, nil
 }
 
 func mapResetEntity(entry *db.CreditEntry) (credit.Reset, error) {
@@ -31,9 +31,15 @@ type SimpleEvent struct {
 }
 
 type MockStreamingConnector struct {
-	rows   map[string][]models.MeterQueryRow
-	events map[string][]SimpleEvent
-	params MockStreamingConnectorParams
+	rows          map[string][]models.MeterQueryRow
+	events        map[string][]SimpleEvent
+	params        MockStreamingConnectorParams
+	completeUntil *time.Time
+}
+
+// SetCompleteUntil sets the time usage data is complete until, by default the usage data has no freshness information.
+func (m *MockStreamingConnector) SetCompleteUntil(at time.Time) {
+	m.completeUntil = &at
 }
 
 func (m *MockStreamingConnector) AddSimpleEvent(meterSlug string, value float64, at time.Time) {
@@ -123,3 +129,7 @@ func (m *MockStreamingConnector) aggregateEvents(meterSlug string, params *strea
 func (m *MockStreamingConnector) ListMeterSubjects(ctx context.Context, namespace string, meterSlug string, from *time.Time, to *time.Time) ([]string, error) {
 	return []string{}, nil
 }
+
+func (m *MockStreamingConnector) GetFreshness(ctx context.Context, namespace string) (streaming.Freshness, error) {
+	return streaming.Freshness{CompleteUntil: m.completeUntil, Partitions: []streaming.PartitionFreshness{}}, nil
+}
@@ -0,0 +1,134 @@
+package postgres_connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	db_ledger "github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/ledger"
+)
+
+type usagePeriodReset struct {
+	// reset is nil if the boundary was skipped
+	reset *credit.Reset
+	// done is true if there are no more boundaries to reset at
+	done bool
+}
+
+// ResetUsagePeriods resets the ledgers at the boundaries of their usage periods until the given time.
+// Ledgers catch up with all boundaries missed, boundaries at or before the high watermark
+// (eg. after a manual reset) are skipped.
+// Boundaries are only reset once the usage data of the namespace is complete until them,
+// otherwise usage arriving late from before the boundary would be left out of the rollover and never charged.
+func (c *PostgresConnector) ResetUsagePeriods(ctx context.Context, until time.Time) ([]credit.Reset, error) {
+	entities, err := c.db.Ledger.Query().
+		Where(db_ledger.NextResetAtLTE(until)).
+		Order(db_ledger.ByNextResetAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ledgers due for reset: %w", err)
+	}
+
+	resets := []credit.Reset{}
+	namespaceUntil := map[string]time.Time{}
+	var errs []error
+
+	for _, entity := range entities {
+		ledgerID := credit.NewNamespacedLedgerID(entity.Namespace, credit.LedgerID(entity.ID))
+
+		ledgerUntil, ok := namespaceUntil[entity.Namespace]
+		if !ok {
+			ledgerUntil, err = c.completeUntil(ctx, entity.Namespace, until)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			namespaceUntil[entity.Namespace] = ledgerUntil
+		}
+
+		ledgerResets, err := c.resetUsagePeriods(ctx, ledgerID, ledgerUntil)
+		resets = append(resets, ledgerResets...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to reset usage periods of ledger %s: %w", ledgerID.ID, err))
+		}
+	}
+
+	return resets, errors.Join(errs...)
+}
+
+// resetUsagePeriods resets a ledger at the boundaries of its usage period until the given time.
+// Each boundary is reset in its own transaction as the rollover at a boundary depends on the previous reset.
+func (c *PostgresConnector) resetUsagePeriods(ctx context.Context, ledgerID credit.NamespacedLedgerID, until time.Time) ([]credit.Reset, error) {
+	resets := []credit.Reset{}
+
+	for {
+		result, err := mutationTransaction(ctx, c, ledgerID, func(tx *db.Tx, ledgerEntity *db.Ledger) (*usagePeriodReset, error) {
+			// The ledger is read with the lock held, another worker may have reset it meanwhile
+			if ledgerEntity.NextResetAt == nil || ledgerEntity.NextResetAt.After(until) {
+				return &usagePeriodReset{done: true}, nil
+			}
+
+			usagePeriod := mapUsagePeriodFromDB(ledgerEntity)
+			if usagePeriod == nil {
+				return nil, errors.New("ledger has no usage period")
+			}
+
+			result := &usagePeriodReset{}
+
+			boundary := ledgerEntity.NextResetAt.In(time.UTC)
+			if err := checkAfterHighWatermark(boundary, ledgerEntity); err == nil {
+				reset, err := c.reset(ctx, tx, ledgerEntity, credit.Reset{
+					Namespace:   ledgerID.Namespace,
+					LedgerID:    ledgerID.ID,
+					EffectiveAt: boundary,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				result.reset = &reset.Reset
+			}
+
+			nextResetIndex := ledgerEntity.NextResetIndex + 1
+
+			err := tx.Ledger.UpdateOneID(ledgerEntity.ID).
+				SetNextResetIndex(nextResetIndex).
+				SetNextResetAt(usagePeriod.At(nextResetIndex)).
+				Exec(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update next reset: %w", err)
+			}
+
+			return result, nil
+		})
+		if err != nil {
+			return resets, err
+		}
+
+		if result.done {
+			return resets, nil
+		}
+
+		if result.reset != nil {
+			resets = append(resets, *result.reset)
+		}
+	}
+}
+
+// completeUntil returns the given time or the time the usage data of the namespace is complete until if it is earlier.
+func (c *PostgresConnector) completeUntil(ctx context.Context, namespace string, until time.Time) (time.Time, error) {
+	freshness, err := c.streamingConnector.GetFreshness(ctx, namespace)
+	if err != nil {
+		return until, fmt.Errorf("failed to get usage data freshness of namespace %s: %w", namespace, err)
+	}
+
+	if freshness.CompleteUntil != nil && freshness.CompleteUntil.Before(until) {
+		return *freshness.CompleteUntil, nil
+	}
+
+	return until, nil
+}
@@ -0,0 +1,273 @@
+package postgres_connector
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/testutils"
+	meter_model "github.com/openmeterio/openmeter/internal/meter"
+	om_testutils "github.com/openmeterio/openmeter/internal/testutils"
+	"github.com/openmeterio/openmeter/pkg/models"
+)
+
+func TestPostgresConnectorUsagePeriods(t *testing.T) {
+	windowSize := time.Minute
+	namespace := "default"
+	meter := models.Meter{
+		Namespace:   namespace,
+		ID:          "meter-1",
+		Slug:        "meter-1",
+		Aggregation: models.MeterAggregationSum,
+	}
+	meterRepository := meter_model.NewInMemoryRepository([]models.Meter{meter})
+	featureIn := credit.Feature{
+		Namespace: namespace,
+		MeterSlug: meter.Slug,
+		Name:      "feature-1",
+	}
+
+	// We need to truncate the time to workaround pgx driver timezone issue
+	today := time.Now().In(time.UTC).Truncate(time.Hour * 24)
+	day := time.Hour * 24
+
+	usagePeriod := credit.Recurrence{
+		Period: credit.RecurrencePeriodDay,
+		Anchor: today.Add(-10 * day),
+	}
+
+	// setNextReset moves the next reset of the ledger back to simulate the worker missing the boundaries
+	setNextReset := func(t *testing.T, db_client *db.Client, ledger credit.Ledger, index int) {
+		err := db_client.Ledger.UpdateOneID(string(ledger.ID)).
+			SetNextResetIndex(index).
+			SetNextResetAt(usagePeriod.At(index)).
+			Exec(context.Background())
+		assert.NoError(t, err)
+	}
+
+	tt := []struct {
+		name        string
+		description string
+		test        func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger)
+	}{
+		{
+			name:        "UpdateUsagePeriod",
+			description: "Should schedule the first reset at the next period boundary and expose the current period",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				ledgerID := credit.NewNamespacedLedgerID(namespace, ledger.ID)
+
+				updated, err := connector.UpdateUsagePeriod(ctx, ledgerID, &usagePeriod)
+				assert.NoError(t, err)
+				assert.Equal(t, &usagePeriod, updated.UsagePeriod)
+				assert.Equal(t, today.Add(day), *updated.NextResetAt)
+
+				// No boundary is due, resets are not applied retroactively
+				resets, err := connector.ResetUsagePeriods(ctx, time.Now())
+				assert.NoError(t, err)
+				assert.Empty(t, resets)
+
+				balance, err := connector.GetBalance(ctx, ledgerID, time.Now())
+				assert.NoError(t, err)
+				assert.Equal(t, &credit.Period{From: today, To: today.Add(day)}, balance.CurrentPeriod)
+
+				updated, err = connector.UpdateUsagePeriod(ctx, ledgerID, nil)
+				assert.NoError(t, err)
+				assert.Nil(t, updated.UsagePeriod)
+				assert.Nil(t, updated.NextResetAt)
+
+				balance, err = connector.GetBalance(ctx, ledgerID, time.Now())
+				assert.NoError(t, err)
+				assert.Nil(t, balance.CurrentPeriod)
+			},
+		},
+		{
+			name:        "ResetUsagePeriods",
+			description: "Should catch up with missed boundaries once and rollover grants at each",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				ledgerID := credit.NewNamespacedLedgerID(namespace, ledger.ID)
+				feature := testutils.CreateFeature(t, connector, featureIn)
+
+				_, err := connector.CreateGrant(ctx, credit.Grant{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					FeatureID:   feature.ID,
+					Type:        credit.GrantTypeUsage,
+					Amount:      100,
+					Priority:    1,
+					EffectiveAt: usagePeriod.Anchor,
+					Expiration: credit.ExpirationPeriod{
+						Duration: credit.ExpirationPeriodDurationMonth,
+						Count:    1,
+					},
+					Rollover: &credit.GrantRollover{
+						Type: credit.GrantRolloverTypeOriginalAmount,
+					},
+				})
+				assert.NoError(t, err)
+
+				// We need to add a row to the streaming connector as we call balance in the reset
+				streamingConnector.AddRow(meter.Slug, models.MeterQueryRow{})
+
+				_, err = connector.UpdateUsagePeriod(ctx, ledgerID, &usagePeriod)
+				assert.NoError(t, err)
+				setNextReset(t, db_client, ledger, 7)
+
+				resets, err := connector.ResetUsagePeriods(ctx, time.Now())
+				assert.NoError(t, err)
+
+				effectiveAts := make([]time.Time, 0, len(resets))
+				for _, reset := range resets {
+					assert.Equal(t, ledger.ID, reset.LedgerID)
+					effectiveAts = append(effectiveAts, reset.EffectiveAt)
+				}
+				assert.Equal(t, []time.Time{
+					today.Add(-3 * day),
+					today.Add(-2 * day),
+					today.Add(-1 * day),
+					today,
+				}, effectiveAts)
+
+				// Resetting again is a no-op
+				resets, err = connector.ResetUsagePeriods(ctx, time.Now())
+				assert.NoError(t, err)
+				assert.Empty(t, resets)
+
+				highWatermark, err := connector.GetHighWatermark(ctx, ledgerID)
+				assert.NoError(t, err)
+				assert.Equal(t, today, highWatermark.Time)
+
+				// The grant is rolled over at each reset
+				grants, err := connector.ListGrants(ctx, credit.ListGrantsParams{
+					Namespace:         namespace,
+					LedgerIDs:         []credit.LedgerID{ledger.ID},
+					FromHighWatermark: true,
+				})
+				assert.NoError(t, err)
+				assert.Len(t, grants, 1)
+				assert.Equal(t, 100.0, grants[0].Amount)
+				assert.Equal(t, today, grants[0].EffectiveAt)
+
+				ledgers, err := connector.ListLedgers(ctx, credit.ListLedgersParams{
+					Namespace: namespace,
+					Subjects:  []string{ledger.Subject},
+				})
+				assert.NoError(t, err)
+				assert.Len(t, ledgers, 1)
+				assert.Equal(t, today.Add(day), *ledgers[0].NextResetAt)
+			},
+		},
+		{
+			name:        "ResetUsagePeriodsAfterManualReset",
+			description: "Should skip boundaries before the high watermark",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				ledgerID := credit.NewNamespacedLedgerID(namespace, ledger.ID)
+
+				// We need to add a row to the streaming connector as we call balance in the reset
+				streamingConnector.AddRow(meter.Slug, models.MeterQueryRow{})
+
+				_, err := connector.UpdateUsagePeriod(ctx, ledgerID, &usagePeriod)
+				assert.NoError(t, err)
+				setNextReset(t, db_client, ledger, 7)
+
+				_, _, err = connector.Reset(ctx, credit.Reset{
+					Namespace:   namespace,
+					LedgerID:    ledger.ID,
+					EffectiveAt: today.Add(-2 * day).Add(time.Hour),
+				})
+				assert.NoError(t, err)
+
+				resets, err := connector.ResetUsagePeriods(ctx, time.Now())
+				assert.NoError(t, err)
+
+				effectiveAts := make([]time.Time, 0, len(resets))
+				for _, reset := range resets {
+					effectiveAts = append(effectiveAts, reset.EffectiveAt)
+				}
+				assert.Equal(t, []time.Time{
+					today.Add(-1 * day),
+					today,
+				}, effectiveAts)
+			},
+		},
+		{
+			name:        "ResetUsagePeriodsUntilUsageIsComplete",
+			description: "Should only reset at boundaries the usage data is complete until",
+			test: func(t *testing.T, connector credit.Connector, streamingConnector *testutils.MockStreamingConnector, db_client *db.Client, ledger credit.Ledger) {
+				ctx := context.Background()
+				ledgerID := credit.NewNamespacedLedgerID(namespace, ledger.ID)
+
+				// We need to add a row to the streaming connector as we call balance in the reset
+				streamingConnector.AddRow(meter.Slug, models.MeterQueryRow{})
+
+				_, err := connector.UpdateUsagePeriod(ctx, ledgerID, &usagePeriod)
+				assert.NoError(t, err)
+				setNextReset(t, db_client, ledger, 7)
+
+				// Usage from before the last boundaries may still arrive
+				streamingConnector.SetCompleteUntil(today.Add(-2 * day).Add(time.Hour))
+
+				resets, err := connector.ResetUsagePeriods(ctx, time.Now())
+				assert.NoError(t, err)
+
+				effectiveAts := make([]time.Time, 0, len(resets))
+				for _, reset := range resets {
+					effectiveAts = append(effectiveAts, reset.EffectiveAt)
+				}
+				assert.Equal(t, []time.Time{
+					today.Add(-3 * day),
+					today.Add(-2 * day),
+				}, effectiveAts)
+
+				// The remaining boundaries are reset once the usage data is complete
+				streamingConnector.SetCompleteUntil(time.Now())
+
+				resets, err = connector.ResetUsagePeriods(ctx, time.Now())
+				assert.NoError(t, err)
+
+				effectiveAts = make([]time.Time, 0, len(resets))
+				for _, reset := range resets {
+					effectiveAts = append(effectiveAts, reset.EffectiveAt)
+				}
+				assert.Equal(t, []time.Time{
+					today.Add(-1 * day),
+					today,
+				}, effectiveAts)
+			},
+		},
+	}
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			t.Log(tc.description)
+			driver := om_testutils.InitPostgresDB(t)
+			databaseClient := db.NewClient(db.Driver(driver))
+			defer databaseClient.Close()
+
+			old, err := time.Parse(time.RFC3339, "2020-01-01T00:00:00Z")
+			assert.NoError(t, err)
+
+			streamingConnector := testutils.NewMockStreamingConnector(t, testutils.MockStreamingConnectorParams{DefaultHighwatermark: old})
+			connector := NewPostgresConnector(slog.Default(), databaseClient, streamingConnector, meterRepository, PostgresConnectorConfig{
+				WindowSize: windowSize,
+			})
+
+			ledger, err := connector.CreateLedger(context.Background(), credit.Ledger{
+				Namespace: namespace,
+				Subject:   ulid.Make().String(),
+			})
+			assert.NoError(t, err)
+
+			tc.test(t, connector, streamingConnector, databaseClient, ledger)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package credit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+)
+
+var currencyRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ValidateCurrency validates that the currency is an ISO 4217 currency code.
+func ValidateCurrency(currency string) error {
+	if !currencyRegexp.MatchString(currency) {
+		return fmt.Errorf("currency must be an ISO 4217 code: %s", currency)
+	}
+
+	return nil
+}
+
+// UnitPrice is the price of a unit of usage of a feature.
+// Grants denominated in the currency of the price burn down the cost of the usage instead of the units.
+type UnitPrice struct {
+	// Currency The ISO 4217 currency code of the price.
+	Currency string `json:"currency"`
+
+	// Amount The price of a unit, used if the price has no tiers.
+	Amount float64 `json:"amount,omitempty"`
+
+	// Tiers Graduated tiers of the price, the usage within a tier is priced at the unit amount of the tier.
+	// Tiers graduate on the usage paid with grants in the currency since the last reset.
+	Tiers []PriceTier `json:"tiers,omitempty"`
+}
+
+// PriceTier is a tier of a graduated unit price.
+type PriceTier struct {
+	// UpTo The usage the tier applies up to, the last tier has no upper bound.
+	UpTo *float64 `json:"upTo,omitempty"`
+
+	// UnitAmount The price of a unit within the tier.
+	UnitAmount float64 `json:"unitAmount"`
+}
+
+// Validate validates the unit price.
+func (p UnitPrice) Validate() error {
+	if err := ValidateCurrency(p.Currency); err != nil {
+		return err
+	}
+
+	if len(p.Tiers) == 0 {
+		if p.Amount <= 0 {
+			return errors.New("amount must be positive")
+		}
+
+		return nil
+	}
+
+	if p.Amount != 0 {
+		return errors.New("amount must not be set with tiers")
+	}
+
+	upTo := 0.0
+	for i, tier := range p.Tiers {
+		if tier.UnitAmount < 0 {
+			return fmt.Errorf("unit amount of tier %d must not be negative", i+1)
+		}
+
+		if i == len(p.Tiers)-1 {
+			if tier.UpTo != nil {
+				return errors.New("last tier must not have an upper bound")
+			}
+
+			// The usage a balance pays for must be finite
+			if tier.UnitAmount == 0 {
+				return errors.New("unit amount of the last tier must be positive")
+			}
+
+			break
+		}
+
+		if tier.UpTo == nil || *tier.UpTo <= upTo {
+			return fmt.Errorf("upper bound of tier %d must be greater than the previous", i+1)
+		}
+
+		upTo = *tier.UpTo
+	}
+
+	return nil
+}
+
+// Cost returns the cost of the usage following the usage already paid for.
+func (p UnitPrice) Cost(paid float64, usage float64) float64 {
+	cost := 0.0
+	to := paid + usage
+
+	for _, tier := range p.tierRanges() {
+		if lower, upper := max(paid, tier.from), min(to, tier.to); upper > lower {
+			cost += (upper - lower) * tier.unitAmount
+		}
+	}
+
+	return cost
+}
+
+// Units returns the usage the amount pays for following the usage already paid for.
+func (p UnitPrice) Units(paid float64, amount float64) float64 {
+	units := 0.0
+
+	for _, tier := range p.tierRanges() {
+		if paid >= tier.to {
+			continue
+		}
+
+		tierUnits := tier.to - max(paid, tier.from)
+
+		// Usage within free tiers is paid for
+		if tier.unitAmount == 0 {
+			units += tierUnits
+			continue
+		}
+
+		if tierCost := tierUnits * tier.unitAmount; tierCost < amount {
+			units += tierUnits
+			amount -= tierCost
+			continue
+		}
+
+		units += amount / tier.unitAmount
+		break
+	}
+
+	return units
+}
+
+type tierRange struct {
+	from       float64
+	to         float64
+	unitAmount float64
+}
+
+// tierRanges returns the usage ranges of the tiers, a price without tiers is a single unbounded tier.
+func (p UnitPrice) tierRanges() []tierRange {
+	if len(p.Tiers) == 0 {
+		return []tierRange{{to: math.Inf(1), unitAmount: p.Amount}}
+	}
+
+	ranges := make([]tierRange, 0, len(p.Tiers))
+	from := 0.0
+	for _, tier := range p.Tiers {
+		to := math.Inf(1)
+		if tier.UpTo != nil {
+			to = *tier.UpTo
+		}
+
+		ranges = append(ranges, tierRange{from: from, to: to, unitAmount: tier.UnitAmount})
+		from = to
+	}
+
+	return ranges
+}
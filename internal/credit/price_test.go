@@ -0,0 +1,145 @@
+package credit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openmeterio/openmeter/pkg/convert"
+)
+
+func TestUnitPriceValidate(t *testing.T) {
+	tt := []struct {
+		name  string
+		price UnitPrice
+		valid bool
+	}{
+		{
+			name:  "Flat",
+			price: UnitPrice{Currency: "USD", Amount: 0.01},
+			valid: true,
+		},
+		{
+			name: "Tiered",
+			price: UnitPrice{Currency: "USD", Tiers: []PriceTier{
+				{UpTo: convert.ToPointer(100.0)},
+				{UpTo: convert.ToPointer(1000.0), UnitAmount: 0.02},
+				{UnitAmount: 0.01},
+			}},
+			valid: true,
+		},
+		{
+			name:  "InvalidCurrency",
+			price: UnitPrice{Currency: "usd", Amount: 0.01},
+			valid: false,
+		},
+		{
+			name:  "NoAmount",
+			price: UnitPrice{Currency: "USD"},
+			valid: false,
+		},
+		{
+			name: "AmountWithTiers",
+			price: UnitPrice{Currency: "USD", Amount: 0.01, Tiers: []PriceTier{
+				{UnitAmount: 0.01},
+			}},
+			valid: false,
+		},
+		{
+			name: "DecreasingTiers",
+			price: UnitPrice{Currency: "USD", Tiers: []PriceTier{
+				{UpTo: convert.ToPointer(100.0), UnitAmount: 0.02},
+				{UpTo: convert.ToPointer(50.0), UnitAmount: 0.01},
+				{UnitAmount: 0.01},
+			}},
+			valid: false,
+		},
+		{
+			name: "BoundedLastTier",
+			price: UnitPrice{Currency: "USD", Tiers: []PriceTier{
+				{UpTo: convert.ToPointer(100.0), UnitAmount: 0.02},
+			}},
+			valid: false,
+		},
+		{
+			name: "FreeLastTier",
+			price: UnitPrice{Currency: "USD", Tiers: []PriceTier{
+				{UpTo: convert.ToPointer(100.0), UnitAmount: 0.02},
+				{},
+			}},
+			valid: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.price.Validate()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestUnitPriceCostAndUnits(t *testing.T) {
+	flat := UnitPrice{Currency: "USD", Amount: 0.5}
+	tiered := UnitPrice{Currency: "USD", Tiers: []PriceTier{
+		{UpTo: convert.ToPointer(10.0)},
+		{UpTo: convert.ToPointer(20.0), UnitAmount: 2},
+		{UnitAmount: 1},
+	}}
+
+	tt := []struct {
+		name  string
+		price UnitPrice
+		paid  float64
+		usage float64
+		cost  float64
+	}{
+		{
+			name:  "Flat",
+			price: flat,
+			paid:  100,
+			usage: 10,
+			cost:  5,
+		},
+		{
+			name:  "FreeTier",
+			price: tiered,
+			usage: 10,
+			cost:  0,
+		},
+		{
+			name:  "AcrossTiers",
+			price: tiered,
+			paid:  5,
+			usage: 20,
+			cost:  5*0 + 10*2 + 5*1,
+		},
+		{
+			name:  "LastTier",
+			price: tiered,
+			paid:  30,
+			usage: 10,
+			cost:  10,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.cost, tc.price.Cost(tc.paid, tc.usage))
+
+			// Usage in free tiers is paid for by any amount
+			if tc.cost > 0 {
+				assert.Equal(t, tc.usage, tc.price.Units(tc.paid, tc.cost))
+			}
+		})
+	}
+
+	t.Run("PartialTier", func(t *testing.T) {
+		// 10 free units and 2.5 units of the second tier
+		assert.Equal(t, 12.5, tiered.Units(0, 5))
+	})
+}
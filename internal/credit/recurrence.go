@@ -0,0 +1,132 @@
+package credit
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type RecurrencePeriod string
+
+// Defines values for RecurrencePeriod.
+const (
+	RecurrencePeriodDay   RecurrencePeriod = "DAY"
+	RecurrencePeriodWeek  RecurrencePeriod = "WEEK"
+	RecurrencePeriodMonth RecurrencePeriod = "MONTH"
+	RecurrencePeriodYear  RecurrencePeriod = "YEAR"
+)
+
+func (RecurrencePeriod) Values() (kinds []string) {
+	for _, s := range []RecurrencePeriod{
+		RecurrencePeriodDay,
+		RecurrencePeriodWeek,
+		RecurrencePeriodMonth,
+		RecurrencePeriodYear,
+	} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// Recurrence of a grant schedule or usage period.
+type Recurrence struct {
+	// Period The time between two occurrences.
+	Period RecurrencePeriod `json:"period"`
+
+	// Anchor The time of the first occurrence, later occurrences are on the same time of the day, weekday, day of the month or day of the year.
+	Anchor time.Time `json:"anchor"`
+}
+
+// Validate validates the recurrence.
+func (r Recurrence) Validate() error {
+	switch r.Period {
+	case RecurrencePeriodDay, RecurrencePeriodWeek, RecurrencePeriodMonth, RecurrencePeriodYear:
+	default:
+		return fmt.Errorf("invalid recurrence period: %s", r.Period)
+	}
+
+	if r.Anchor.IsZero() {
+		return errors.New("recurrence anchor is required")
+	}
+
+	return nil
+}
+
+// At returns the time of the nth occurrence, the first occurrence being the anchor.
+// Monthly and yearly occurrences fall on the last day of the month if the month is shorter than the day of the anchor.
+func (r Recurrence) At(n int) time.Time {
+	switch r.Period {
+	case RecurrencePeriodDay:
+		return r.Anchor.AddDate(0, 0, n)
+	case RecurrencePeriodWeek:
+		return r.Anchor.AddDate(0, 0, 7*n)
+	case RecurrencePeriodMonth:
+		return addMonthsClamped(r.Anchor, n)
+	case RecurrencePeriodYear:
+		return addMonthsClamped(r.Anchor, 12*n)
+	default:
+		return time.Time{}
+	}
+}
+
+// Next returns the index of the first occurrence after t.
+func (r Recurrence) Next(t time.Time) int {
+	if t.Before(r.Anchor) {
+		return 0
+	}
+
+	return r.index(t) + 1
+}
+
+// PeriodAt returns the period between the occurrences around t, false if t is before the anchor.
+func (r Recurrence) PeriodAt(t time.Time) (Period, bool) {
+	if t.Before(r.Anchor) {
+		return Period{}, false
+	}
+
+	n := r.index(t)
+
+	return Period{
+		From: r.At(n),
+		To:   r.At(n + 1),
+	}, true
+}
+
+// index returns the index of the last occurrence at or before t, t must not be before the anchor.
+func (r Recurrence) index(t time.Time) int {
+	// Estimate the index, then correct it as periods vary in length
+	var n int
+	switch r.Period {
+	case RecurrencePeriodDay:
+		n = int(t.Sub(r.Anchor) / (24 * time.Hour))
+	case RecurrencePeriodWeek:
+		n = int(t.Sub(r.Anchor) / (7 * 24 * time.Hour))
+	case RecurrencePeriodMonth:
+		n = (t.Year()-r.Anchor.Year())*12 + int(t.Month()) - int(r.Anchor.Month())
+	case RecurrencePeriodYear:
+		n = t.Year() - r.Anchor.Year()
+	default:
+		return 0
+	}
+
+	for n > 0 && r.At(n).After(t) {
+		n--
+	}
+
+	for !r.At(n + 1).After(t) {
+		n++
+	}
+
+	return n
+}
+
+// addMonthsClamped adds months to t without overflowing to the next month like time.AddDate does.
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+
+	// Day zero of the next month is the last day of the month
+	lastDay := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, t.Location()).Day()
+
+	return time.Date(year, month+time.Month(months), min(day, lastDay), hour, minute, sec, t.Nanosecond(), t.Location())
+}
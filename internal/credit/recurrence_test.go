@@ -0,0 +1,119 @@
+package credit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecurrenceAt(t *testing.T) {
+	tt := []struct {
+		name       string
+		recurrence Recurrence
+		expected   map[int]string
+	}{
+		{
+			name: "Week",
+			recurrence: Recurrence{
+				Period: RecurrencePeriodWeek,
+				Anchor: time.Date(2024, 1, 29, 10, 0, 0, 0, time.UTC),
+			},
+			expected: map[int]string{0: "2024-01-29T10:00:00Z", 1: "2024-02-05T10:00:00Z", 2: "2024-02-12T10:00:00Z"},
+		},
+		{
+			name: "MonthOnAnchorDay",
+			recurrence: Recurrence{
+				Period: RecurrencePeriodMonth,
+				Anchor: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			},
+			expected: map[int]string{0: "2024-01-15T00:00:00Z", 1: "2024-02-15T00:00:00Z", 2: "2024-03-15T00:00:00Z"},
+		},
+		{
+			name: "MonthOnLastDayOfShorterMonths",
+			recurrence: Recurrence{
+				Period: RecurrencePeriodMonth,
+				Anchor: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			},
+			expected: map[int]string{0: "2024-01-31T00:00:00Z", 1: "2024-02-29T00:00:00Z", 2: "2024-03-31T00:00:00Z", 3: "2024-04-30T00:00:00Z"},
+		},
+		{
+			name: "YearFromLeapDay",
+			recurrence: Recurrence{
+				Period: RecurrencePeriodYear,
+				Anchor: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+			expected: map[int]string{0: "2024-02-29T00:00:00Z", 1: "2025-02-28T00:00:00Z", 2: "2026-02-28T00:00:00Z", 4: "2028-02-29T00:00:00Z"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			for n, expected := range tc.expected {
+				assert.Equal(t, expected, tc.recurrence.At(n).Format(time.RFC3339), "occurrence %d", n)
+			}
+		})
+	}
+}
+
+func TestRecurrencePeriodAt(t *testing.T) {
+	recurrence := Recurrence{
+		Period: RecurrencePeriodMonth,
+		Anchor: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	tt := []struct {
+		name     string
+		at       time.Time
+		expected *Period
+		next     int
+	}{
+		{
+			name:     "BeforeAnchor",
+			at:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: nil,
+			next:     0,
+		},
+		{
+			name: "AtAnchor",
+			at:   time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			expected: &Period{
+				From: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+			next: 1,
+		},
+		{
+			name: "WithinShortMonth",
+			at:   time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+			expected: &Period{
+				From: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+			},
+			next: 2,
+		},
+		{
+			name: "BeforeOccurrenceInSameMonth",
+			at:   time.Date(2024, 4, 29, 23, 59, 0, 0, time.UTC),
+			expected: &Period{
+				From: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+			},
+			next: 3,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			period, ok := recurrence.PeriodAt(tc.at)
+			if tc.expected == nil {
+				assert.False(t, ok)
+			} else {
+				assert.True(t, ok)
+				assert.Equal(t, *tc.expected, period)
+			}
+
+			assert.Equal(t, tc.next, recurrence.Next(tc.at))
+		})
+	}
+}
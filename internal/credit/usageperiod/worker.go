@@ -0,0 +1,85 @@
+// Package usageperiod resets ledgers at the boundaries of their usage periods.
+package usageperiod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/openmeterio/openmeter/internal/credit"
+)
+
+// WorkerConfig configures a {Worker}.
+type WorkerConfig struct {
+	// Interval is the time between two runs resetting due ledgers.
+	Interval time.Duration
+}
+
+// Worker resets ledgers as the usage periods of the ledgers end.
+// A boundary is only reset once the usage data of the namespace is complete until it.
+//
+// Resetting is idempotent, several workers (eg. one per server replica) can run at the same time
+// and a worker catches up with the period boundaries it missed while it was not running.
+type Worker struct {
+	Connector credit.Connector
+	Logger    *slog.Logger
+	Config    WorkerConfig
+}
+
+// NewWorker returns a new {Worker}.
+func NewWorker(connector credit.Connector, logger *slog.Logger, config WorkerConfig) (*Worker, error) {
+	if connector == nil {
+		return nil, fmt.Errorf("connector is required")
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+
+	if config.Interval <= 0 {
+		return nil, fmt.Errorf("interval must be positive")
+	}
+
+	return &Worker{
+		Connector: connector,
+		Logger:    logger,
+		Config:    config,
+	}, nil
+}
+
+// Run resets due ledgers periodically. It is meant to be added to a [run.Group].
+func (w *Worker) Run(ctx context.Context) (execute func() error, interrupt func(error)) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return func() error {
+			ticker := time.NewTicker(w.Config.Interval)
+			defer ticker.Stop()
+
+			for {
+				w.reset(ctx)
+
+				select {
+				case <-ticker.C:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		},
+		func(error) {
+			cancel()
+		}
+}
+
+func (w *Worker) reset(ctx context.Context) {
+	resets, err := w.Connector.ResetUsagePeriods(ctx, time.Now())
+	if err != nil && !errors.Is(err, context.Canceled) {
+		// Ledgers failing to reset are retried on the next run
+		w.Logger.WarnContext(ctx, "resetting usage periods failed", "error", err)
+	}
+
+	if len(resets) > 0 {
+		w.Logger.InfoContext(ctx, "reset usage periods", "count", len(resets))
+	}
+}
@@ -0,0 +1,275 @@
+package redisdedupe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/openmeterio/openmeter/internal/dedupe"
+)
+
+// Filter is the probabilistic data structure used for deduplication.
+type Filter string
+
+const (
+	// FilterBloom uses RedisBloom Bloom filters.
+	FilterBloom Filter = "bloom"
+	// FilterCuckoo uses RedisBloom Cuckoo filters.
+	FilterCuckoo Filter = "cuckoo"
+)
+
+// MinCuckooErrorRate is the lowest false positive rate of a RedisBloom Cuckoo filter (8 bit fingerprints with a bucket size of 1).
+const MinCuckooErrorRate = 2.0 / 255
+
+// FilterErrorRate returns the false positive rate of a single filter for the false positive rate of deduplication:
+// an event is checked against the filters of every bucket within the expiration.
+func FilterErrorRate(errorRate float64, expiration time.Duration, bucketSize time.Duration) float64 {
+	return errorRate / float64(bucketCount(expiration, bucketSize))
+}
+
+// bucketCount returns the maximum number of buckets within the expiration (including the current one).
+func bucketCount(expiration time.Duration, bucketSize time.Duration) int64 {
+	return int64((expiration+bucketSize-1)/bucketSize) + 1
+}
+
+// ProbabilisticDeduplicator implements event deduplication using Bloom or Cuckoo filters of RedisBloom.
+//
+// Items are added to a filter per namespace and time bucket, a bucket is checked until it is older than Expiration.
+// Filters bound the memory used at the cost of false positives: a unique event is reported as a duplicate with a probability of about ErrorRate.
+// An event is checked against the filters of every bucket, so each filter is created with a fraction of the error rate (see FilterErrorRate).
+type ProbabilisticDeduplicator struct {
+	Redis      redis.UniversalClient
+	Expiration time.Duration
+	Filter     Filter
+	// ErrorRate is the false positive rate of deduplication while the filters hold at most Capacity items
+	ErrorRate float64
+	// Capacity is the number of items a filter holds at ErrorRate, filters grow beyond it
+	Capacity int64
+	// BucketSize is the time range covered by a filter
+	BucketSize time.Duration
+
+	// reserved is the latest Cuckoo filter reserved per namespace
+	reserved   map[string]string
+	reservedMu sync.Mutex
+}
+
+// PingContext checks the connection to Redis.
+func (d *ProbabilisticDeduplicator) PingContext(ctx context.Context) error {
+	return d.Redis.Ping(ctx).Err()
+}
+
+// IsUnique checks if an event is unique AND adds it to the deduplication index.
+func (d *ProbabilisticDeduplicator) IsUnique(ctx context.Context, namespace string, ev event.Event) (bool, error) {
+	if d.Redis == nil {
+		return false, errors.New("redis client not initialized")
+	}
+
+	item := dedupe.Item{
+		Namespace: namespace,
+		ID:        ev.ID(),
+		Source:    ev.Source(),
+	}
+
+	keys := d.bucketKeys(namespace, time.Now())
+
+	exists, err := d.exists(ctx, keys[:len(keys)-1], item.Key())
+	if err != nil {
+		return false, err
+	}
+
+	if exists[0] {
+		return false, nil
+	}
+
+	added, err := d.add(ctx, namespace, keys[len(keys)-1], item.Key())
+	if err != nil {
+		return false, err
+	}
+
+	return added[0], nil
+}
+
+// CheckUnique checks if the item is unique based on the key
+func (d *ProbabilisticDeduplicator) CheckUnique(ctx context.Context, item dedupe.Item) (bool, error) {
+	exists, err := d.exists(ctx, d.bucketKeys(item.Namespace, time.Now()), item.Key())
+	if err != nil {
+		return false, err
+	}
+
+	return !exists[0], nil
+}
+
+// Set adds the items to the filters of the current time bucket
+func (d *ProbabilisticDeduplicator) Set(ctx context.Context, items ...dedupe.Item) error {
+	now := time.Now()
+
+	membersByNamespace := make(map[string][]string)
+	for _, item := range items {
+		membersByNamespace[item.Namespace] = append(membersByNamespace[item.Namespace], item.Key())
+	}
+
+	for namespace, members := range membersByNamespace {
+		keys := d.bucketKeys(namespace, now)
+
+		_, err := d.add(ctx, namespace, keys[len(keys)-1], members...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bucketKeys returns the keys of the filters covering the expiration, the last one is the current bucket.
+// Keys are hash tagged by namespace, so the filters of a namespace are in the same Redis Cluster hash slot.
+func (d *ProbabilisticDeduplicator) bucketKeys(namespace string, now time.Time) []string {
+	var keys []string
+
+	for bucket := now.Add(-d.Expiration).Truncate(d.BucketSize); !bucket.After(now); bucket = bucket.Add(d.BucketSize) {
+		keys = append(keys, fmt.Sprintf("{%s}:dedupe:%s:%d", namespace, d.Filter, bucket.Unix()))
+	}
+
+	return keys
+}
+
+// exists returns if the members are in any of the filters.
+func (d *ProbabilisticDeduplicator) exists(ctx context.Context, keys []string, members ...string) ([]bool, error) {
+	exists := make([]bool, len(members))
+
+	if len(keys) == 0 {
+		return exists, nil
+	}
+
+	args := toArgs(members)
+
+	cmds, err := d.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, key := range keys {
+			switch d.Filter {
+			case FilterCuckoo:
+				pipe.CFMExists(ctx, key, args...)
+			default:
+				pipe.BFMExists(ctx, key, args...)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check filters in redis: %w", err)
+	}
+
+	for _, cmd := range cmds {
+		result, err := cmd.(*redis.BoolSliceCmd).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to check filter in redis: %w", err)
+		}
+
+		for i := range exists {
+			exists[i] = exists[i] || result[i]
+		}
+	}
+
+	return exists, nil
+}
+
+// add adds the members to the filter and returns if they were added, members already in the filter are not.
+func (d *ProbabilisticDeduplicator) add(ctx context.Context, namespace string, key string, members ...string) ([]bool, error) {
+	args := toArgs(members)
+
+	// The filter must be kept until its bucket is older than the expiration
+	ttl := d.Expiration + d.BucketSize
+
+	switch d.Filter {
+	case FilterCuckoo:
+		if err := d.reserveCuckoo(ctx, namespace, key); err != nil {
+			return nil, err
+		}
+
+		var insert *redis.IntSliceCmd
+
+		_, err := d.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			insert = pipe.CFInsertNX(ctx, key, &redis.CFInsertOptions{Capacity: d.Capacity}, args...)
+			pipe.Expire(ctx, key, ttl)
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add to cuckoo filter in redis: %w", err)
+		}
+
+		added := make([]bool, len(members))
+		for i, result := range insert.Val() {
+			if result < 0 {
+				return nil, fmt.Errorf("cuckoo filter is full: %s", key)
+			}
+
+			added[i] = result == 1
+		}
+
+		return added, nil
+
+	default:
+		var insert *redis.BoolSliceCmd
+
+		_, err := d.Redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			insert = pipe.BFInsert(ctx, key, &redis.BFInsertOptions{Capacity: d.Capacity, Error: FilterErrorRate(d.ErrorRate, d.Expiration, d.BucketSize)}, args...)
+			pipe.Expire(ctx, key, ttl)
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add to bloom filter in redis: %w", err)
+		}
+
+		return insert.Val(), nil
+	}
+}
+
+// reserveCuckoo creates the Cuckoo filter with a bucket size matching the error rate unless it exists.
+// Only the latest filter of a namespace is remembered as it is the only one items are added to.
+func (d *ProbabilisticDeduplicator) reserveCuckoo(ctx context.Context, namespace string, key string) error {
+	d.reservedMu.Lock()
+	defer d.reservedMu.Unlock()
+
+	if d.reserved[namespace] == key {
+		return nil
+	}
+
+	err := d.Redis.CFReserveWithArgs(ctx, key, &redis.CFReserveOptions{
+		Capacity:   d.Capacity,
+		BucketSize: cuckooBucketSize(FilterErrorRate(d.ErrorRate, d.Expiration, d.BucketSize)),
+	}).Err()
+	if err != nil && !strings.Contains(err.Error(), "item exists") {
+		return fmt.Errorf("failed to reserve cuckoo filter in redis: %w", err)
+	}
+
+	if d.reserved == nil {
+		d.reserved = make(map[string]string)
+	}
+
+	d.reserved[namespace] = key
+
+	return nil
+}
+
+// cuckooBucketSize returns the largest bucket size with a false positive rate below the error rate.
+// RedisBloom uses 8 bit fingerprints, the false positive rate is about 2 * bucket size / 255,
+// error rates below MinCuckooErrorRate cannot be reached.
+func cuckooBucketSize(errorRate float64) int64 {
+	return min(max(int64(errorRate*255/2), 1), 255)
+}
+
+func toArgs(members []string) []interface{} {
+	args := make([]interface{}, len(members))
+	for i, member := range members {
+		args[i] = member
+	}
+
+	return args
+}
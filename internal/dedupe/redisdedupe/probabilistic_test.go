@@ -0,0 +1,279 @@
+package redisdedupe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/dedupe"
+)
+
+func TestProbabilisticDeduplicatorBucketKeys(t *testing.T) {
+	d := &ProbabilisticDeduplicator{
+		Expiration: 2 * time.Hour,
+		Filter:     FilterBloom,
+		BucketSize: time.Hour,
+	}
+
+	now := time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, []string{
+		"{default}:dedupe:bloom:1704103200",
+		"{default}:dedupe:bloom:1704106800",
+		"{default}:dedupe:bloom:1704110400",
+	}, d.bucketKeys("default", now))
+
+	// The current bucket is the last one
+	now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	keys := d.bucketKeys("default", now)
+	assert.Len(t, keys, 3)
+	assert.Equal(t, "{default}:dedupe:bloom:1704110400", keys[len(keys)-1])
+}
+
+func TestCuckooBucketSize(t *testing.T) {
+	assert.Equal(t, int64(1), cuckooBucketSize(0.001))
+	assert.Equal(t, int64(1), cuckooBucketSize(0.01))
+	assert.Equal(t, int64(6), cuckooBucketSize(0.05))
+	assert.Equal(t, int64(12), cuckooBucketSize(0.1))
+}
+
+func TestFilterErrorRate(t *testing.T) {
+	assert.InDelta(t, 0.01, FilterErrorRate(0.03, 2*time.Hour, time.Hour), 1e-9)
+
+	// Expirations not aligned to buckets span one more bucket
+	assert.InDelta(t, 0.01/3, FilterErrorRate(0.01, 90*time.Minute, time.Hour), 1e-9)
+}
+
+func TestProbabilisticDeduplicator(t *testing.T) {
+	for _, filter := range []Filter{FilterBloom, FilterCuckoo} {
+		t.Run(string(filter), func(t *testing.T) {
+			ctx := context.Background()
+
+			fake := newFakeBloom()
+			client := redis.NewClient(&redis.Options{Addr: "127.0.0.1:0"})
+			client.AddHook(fake)
+			defer client.Close()
+
+			d := &ProbabilisticDeduplicator{
+				Redis:      client,
+				Expiration: 2 * time.Hour,
+				Filter:     filter,
+				ErrorRate:  0.03,
+				Capacity:   1000,
+				BucketSize: time.Hour,
+			}
+
+			newEvent := func(id string) event.Event {
+				ev := event.New()
+				ev.SetID(id)
+				ev.SetSource("test")
+
+				return ev
+			}
+
+			unique, err := d.IsUnique(ctx, "default", newEvent("1"))
+			require.NoError(t, err)
+			assert.True(t, unique)
+
+			unique, err = d.IsUnique(ctx, "default", newEvent("1"))
+			require.NoError(t, err)
+			assert.False(t, unique)
+
+			// Namespaces have their own filters
+			unique, err = d.IsUnique(ctx, "other", newEvent("1"))
+			require.NoError(t, err)
+			assert.True(t, unique)
+
+			// Items set are not unique anymore
+			item := dedupe.Item{Namespace: "default", ID: "2", Source: "test"}
+
+			unique, err = d.CheckUnique(ctx, item)
+			require.NoError(t, err)
+			assert.True(t, unique)
+
+			require.NoError(t, d.Set(ctx, item, dedupe.Item{Namespace: "other", ID: "2", Source: "test"}))
+
+			unique, err = d.CheckUnique(ctx, item)
+			require.NoError(t, err)
+			assert.False(t, unique)
+
+			unique, err = d.IsUnique(ctx, "other", newEvent("2"))
+			require.NoError(t, err)
+			assert.False(t, unique)
+
+			// Items in the filters of earlier buckets within the expiration are not unique
+			keys := d.bucketKeys("default", time.Now())
+			fake.add(keys[0], dedupe.Item{Namespace: "default", ID: "3", Source: "test"}.Key())
+
+			unique, err = d.IsUnique(ctx, "default", newEvent("3"))
+			require.NoError(t, err)
+			assert.False(t, unique)
+
+			// Filters are created with the error rate shared by the filters of the buckets
+			current := keys[len(keys)-1]
+			switch filter {
+			case FilterBloom:
+				assert.InDelta(t, 0.01, fake.errorRates[current], 1e-9)
+			case FilterCuckoo:
+				assert.Equal(t, cuckooBucketSize(0.01), fake.bucketSizes[current])
+			}
+
+			// Commands sent at once only touch the filters of a namespace, they are in the same Redis Cluster hash slot
+			require.NotEmpty(t, fake.batches)
+			for _, batch := range fake.batches {
+				for _, key := range batch {
+					assert.Equal(t, hashTag(batch[0]), hashTag(key))
+				}
+			}
+		})
+	}
+}
+
+// hashTag returns the part of the key hashed by Redis Cluster.
+func hashTag(key string) string {
+	start := strings.Index(key, "{")
+	end := strings.Index(key[start+1:], "}")
+	if start < 0 || end <= 0 {
+		return key
+	}
+
+	return key[start+1 : start+1+end]
+}
+
+// fakeBloom is a Redis test double answering the RedisBloom commands of the deduplicator with exact sets.
+type fakeBloom struct {
+	mu sync.Mutex
+
+	filters     map[string]map[string]bool
+	errorRates  map[string]float64
+	bucketSizes map[string]int64
+
+	// batches are the keys of the commands sent at once (a command or a pipeline)
+	batches [][]string
+}
+
+func newFakeBloom() *fakeBloom {
+	return &fakeBloom{
+		filters:     make(map[string]map[string]bool),
+		errorRates:  make(map[string]float64),
+		bucketSizes: make(map[string]int64),
+	}
+}
+
+func (f *fakeBloom) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (f *fakeBloom) ProcessHook(_ redis.ProcessHook) redis.ProcessHook {
+	return func(_ context.Context, cmd redis.Cmder) error {
+		return f.process(cmd)
+	}
+}
+
+func (f *fakeBloom) ProcessPipelineHook(_ redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(_ context.Context, cmds []redis.Cmder) error {
+		return f.process(cmds...)
+	}
+}
+
+func (f *fakeBloom) add(key string, member string) bool {
+	if f.filters[key] == nil {
+		f.filters[key] = make(map[string]bool)
+	}
+
+	if f.filters[key][member] {
+		return false
+	}
+
+	f.filters[key][member] = true
+
+	return true
+}
+
+func (f *fakeBloom) process(cmds ...redis.Cmder) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var batch []string
+	var firstErr error
+
+	for _, cmd := range cmds {
+		args := cmd.Args()
+		key := args[1].(string)
+		batch = append(batch, key)
+
+		options := args[2:]
+		var members []string
+		if i := slices.Index(args, any("ITEMS")); i >= 0 {
+			options = args[2:i]
+			for _, arg := range args[i+1:] {
+				members = append(members, arg.(string))
+			}
+		}
+
+		switch strings.ToUpper(args[0].(string)) {
+		case "BF.INSERT":
+			if i := slices.Index(options, any("ERROR")); i >= 0 {
+				f.errorRates[key] = options[i+1].(float64)
+			}
+
+			added := make([]bool, len(members))
+			for j, member := range members {
+				added[j] = f.add(key, member)
+			}
+
+			cmd.(*redis.BoolSliceCmd).SetVal(added)
+		case "CF.INSERTNX":
+			added := make([]int64, len(members))
+			for j, member := range members {
+				if f.add(key, member) {
+					added[j] = 1
+				}
+			}
+
+			cmd.(*redis.IntSliceCmd).SetVal(added)
+		case "BF.MEXISTS", "CF.MEXISTS":
+			exists := make([]bool, 0, len(args)-2)
+			for _, arg := range args[2:] {
+				exists = append(exists, f.filters[key][arg.(string)])
+			}
+
+			cmd.(*redis.BoolSliceCmd).SetVal(exists)
+		case "CF.RESERVE":
+			if _, ok := f.filters[key]; ok {
+				cmd.SetErr(errors.New("ERR item exists"))
+				break
+			}
+
+			f.filters[key] = make(map[string]bool)
+			if i := slices.Index(args, any("BUCKETSIZE")); i >= 0 {
+				f.bucketSizes[key] = args[i+1].(int64)
+			}
+
+			cmd.(*redis.StatusCmd).SetVal("OK")
+		case "EXPIRE":
+			cmd.(*redis.BoolCmd).SetVal(true)
+		default:
+			cmd.SetErr(fmt.Errorf("unsupported command: %s", args[0]))
+		}
+
+		if firstErr == nil {
+			firstErr = cmd.Err()
+		}
+	}
+
+	f.batches = append(f.batches, batch)
+
+	return firstErr
+}
@@ -15,8 +15,15 @@ import (
 
 // Deduplicator implements event deduplication using Redis.
 type Deduplicator struct {
-	Redis      *redis.Client
+	Redis      redis.UniversalClient
 	Expiration time.Duration
+	// HashTag puts the keys of a namespace in the same Redis Cluster hash slot
+	HashTag bool
+}
+
+// PingContext checks the connection to Redis.
+func (d Deduplicator) PingContext(ctx context.Context) error {
+	return d.Redis.Ping(ctx).Err()
 }
 
 // IsUnique checks if an event is unique AND adds it to the deduplication index.
@@ -31,7 +38,7 @@ func (d Deduplicator) IsUnique(ctx context.Context, namespace string, ev event.E
 		Source:    ev.Source(),
 	}
 
-	status, err := d.Redis.SetArgs(ctx, item.Key(), "", redis.SetArgs{
+	status, err := d.Redis.SetArgs(ctx, d.key(item), "", redis.SetArgs{
 		TTL:  d.Expiration,
 		Mode: "nx",
 	}).Result()
@@ -56,7 +63,7 @@ func (d Deduplicator) IsUnique(ctx context.Context, namespace string, ev event.E
 
 // CheckUnique checks if the event is unique based on the key
 func (d Deduplicator) CheckUnique(ctx context.Context, item dedupe.Item) (bool, error) {
-	isSet, err := d.Redis.Exists(ctx, item.Key()).Result()
+	isSet, err := d.Redis.Exists(ctx, d.key(item)).Result()
 	if err != nil {
 		return false, err
 	}
@@ -65,20 +72,33 @@ func (d Deduplicator) CheckUnique(ctx context.Context, item dedupe.Item) (bool,
 
 // Set sets events into redis
 func (d Deduplicator) Set(ctx context.Context, items ...dedupe.Item) error {
-	keys := make([]string, len(items))
+	// Keys of a script must be in the same hash slot in Redis Cluster, so they are set per namespace
+	keysByNamespace := make(map[string][]string)
 	for _, item := range items {
-		keys = append(keys, item.Key())
+		keysByNamespace[item.Namespace] = append(keysByNamespace[item.Namespace], d.key(item))
 	}
 
-	// We use a lua script to set multiple keys at once, this is more efficient than calling redis one by one
-	err := setMultiple.Run(ctx, d.Redis, keys, d.Expiration.Seconds()).Err()
-	if err != nil && err != redis.Nil {
-		return fmt.Errorf("failed to set multiple keys in redis: %w", err)
+	for _, keys := range keysByNamespace {
+		// We use a lua script to set multiple keys at once, this is more efficient than calling redis one by one
+		err := setMultiple.Run(ctx, d.Redis, keys, d.Expiration.Seconds()).Err()
+		if err != nil && err != redis.Nil {
+			return fmt.Errorf("failed to set multiple keys in redis: %w", err)
+		}
 	}
 
 	return nil
 }
 
+// key returns the Redis key of an item.
+// Hash tagged keys are different from plain ones, so switching invalidates the existing deduplication index.
+func (d Deduplicator) key(item dedupe.Item) string {
+	if d.HashTag {
+		return fmt.Sprintf("{%s}-%s-%s", item.Namespace, item.Source, item.ID)
+	}
+
+	return item.Key()
+}
+
 var setMultiple = redis.NewScript(`
 local expiration = tonumber(ARGV[1])
 
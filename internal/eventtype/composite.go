@@ -0,0 +1,84 @@
+package eventtype
+
+import (
+	"context"
+	"errors"
+)
+
+// CompositeRepository combines event types of multiple repositories,
+// like the ones defined in configuration and the ones registered via the API.
+// Repositories listed first take precedence when the same event type is defined more than once.
+type CompositeRepository struct {
+	repositories []Repository
+}
+
+// Implement the Repository interface
+var _ Repository = &CompositeRepository{}
+
+// NewCompositeRepository returns a repository combining the event types of repositories.
+func NewCompositeRepository(repositories ...Repository) *CompositeRepository {
+	return &CompositeRepository{
+		repositories: repositories,
+	}
+}
+
+// ListAllEventTypes implements the [Repository] interface.
+func (r *CompositeRepository) ListAllEventTypes(ctx context.Context) ([]EventType, error) {
+	return r.list(ctx, func(repository Repository) ([]EventType, error) {
+		return repository.ListAllEventTypes(ctx)
+	})
+}
+
+// ListEventTypes implements the [Repository] interface.
+func (r *CompositeRepository) ListEventTypes(ctx context.Context, namespace string) ([]EventType, error) {
+	return r.list(ctx, func(repository Repository) ([]EventType, error) {
+		return repository.ListEventTypes(ctx, namespace)
+	})
+}
+
+// GetEventType implements the [Repository] interface.
+func (r *CompositeRepository) GetEventType(ctx context.Context, namespace string, eventType string) (EventType, error) {
+	for _, repository := range r.repositories {
+		et, err := repository.GetEventType(ctx, namespace, eventType)
+		if err == nil {
+			return et, nil
+		}
+
+		if _, ok := err.(*EventTypeNotFoundError); !ok {
+			return EventType{}, err
+		}
+	}
+
+	return EventType{}, &EventTypeNotFoundError{Type: eventType}
+}
+
+func (r *CompositeRepository) list(_ context.Context, list func(repository Repository) ([]EventType, error)) ([]EventType, error) {
+	type key struct {
+		namespace string
+		eventType string
+	}
+
+	seen := map[key]bool{}
+	eventTypes := []EventType{}
+	var errs []error
+
+	for _, repository := range r.repositories {
+		list, err := list(repository)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, eventType := range list {
+			k := key{namespace: eventType.Namespace, eventType: eventType.Type}
+			if seen[k] {
+				continue
+			}
+
+			seen[k] = true
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+
+	return eventTypes, errors.Join(errs...)
+}
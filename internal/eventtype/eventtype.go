@@ -0,0 +1,73 @@
+// Package eventtype implements event type definitions: JSON Schemas describing the data of events with a CloudEvents type.
+package eventtype
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type EventTypeNotFoundError struct {
+	Type string
+}
+
+func (e *EventTypeNotFoundError) Error() string {
+	return fmt.Sprintf("event type not found: %s", e.Type)
+}
+
+// EventType describes the data of events with a CloudEvents type.
+// Events with the type are validated against the schema at ingestion, mismatching events are marked invalid.
+type EventType struct {
+	Namespace string `json:"-"`
+
+	// Type The CloudEvents type of the events.
+	Type string `json:"type"`
+
+	// Description A description of the event type.
+	Description *string `json:"description,omitempty"`
+
+	// Schema The JSON Schema the event data must match.
+	Schema map[string]interface{} `json:"schema"`
+
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Validate validates the event type definition.
+func (e EventType) Validate() error {
+	if e.Type == "" {
+		return errors.New("event type is required")
+	}
+
+	if len(e.Schema) == 0 {
+		return errors.New("event type schema is required")
+	}
+
+	if _, err := CompileSchema(e.Schema); err != nil {
+		return fmt.Errorf("invalid schema of event type %s: %w", e.Type, err)
+	}
+
+	return nil
+}
+
+// Repository is an interface to the event type store.
+type Repository interface {
+	// ListAllEventTypes returns the event types of every namespace.
+	ListAllEventTypes(ctx context.Context) ([]EventType, error)
+
+	// ListEventTypes returns the event types of a namespace.
+	ListEventTypes(ctx context.Context, namespace string) ([]EventType, error)
+
+	// GetEventType returns an event type of a namespace.
+	GetEventType(ctx context.Context, namespace string, eventType string) (EventType, error)
+}
+
+// Connector manages event types.
+type Connector interface {
+	Repository
+
+	// UpsertEventType creates an event type or replaces the definition of an existing one.
+	UpsertEventType(ctx context.Context, eventType EventType) (EventType, error)
+	DeleteEventType(ctx context.Context, namespace string, eventType string) error
+}
@@ -0,0 +1,132 @@
+package eventtypedriver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/openmeterio/openmeter/api"
+	"github.com/openmeterio/openmeter/internal/eventtype"
+	"github.com/openmeterio/openmeter/pkg/framework/commonhttp"
+	"github.com/openmeterio/openmeter/pkg/framework/transport/httptransport"
+	"github.com/openmeterio/openmeter/pkg/models"
+)
+
+// NamespacedEventType identifies an event type of a namespace.
+type NamespacedEventType struct {
+	Namespace string
+	Type      string
+}
+
+type ListEventTypesHandler httptransport.Handler[string, []eventtype.EventType]
+
+func (b *builder) ListEventTypes() ListEventTypesHandler {
+	return httptransport.NewHandler(
+		func(ctx context.Context, r *http.Request) (string, error) {
+			return b.resolveNamespace(ctx)
+		},
+		b.Connector.ListEventTypes,
+		commonhttp.JSONResponseEncoder,
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("listEventTypes"),
+		)...,
+	)
+}
+
+type GetEventTypeHandler httptransport.HandlerWithArgs[NamespacedEventType, eventtype.EventType, api.EventTypeName]
+
+func (b *builder) GetEventType() GetEventTypeHandler {
+	return httptransport.NewHandlerWithArgs(
+		func(ctx context.Context, r *http.Request, eventType api.EventTypeName) (NamespacedEventType, error) {
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return NamespacedEventType{}, err
+			}
+
+			return NamespacedEventType{
+				Namespace: ns,
+				Type:      eventType,
+			}, nil
+		},
+		func(ctx context.Context, id NamespacedEventType) (eventtype.EventType, error) {
+			return b.Connector.GetEventType(ctx, id.Namespace, id.Type)
+		},
+		commonhttp.JSONResponseEncoder,
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("getEventType"),
+			httptransport.WithErrorEncoder(eventTypeNotFoundErrorEncoder),
+		)...,
+	)
+}
+
+type UpsertEventTypeHandler httptransport.Handler[eventtype.EventType, eventtype.EventType]
+
+func (b *builder) UpsertEventType() UpsertEventTypeHandler {
+	return httptransport.NewHandler(
+		func(ctx context.Context, r *http.Request) (eventtype.EventType, error) {
+			eventType := eventtype.EventType{}
+			if err := commonhttp.JSONRequestBodyDecoder(r, &eventType); err != nil {
+				return eventType, err
+			}
+
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return eventType, err
+			}
+
+			eventType.Namespace = ns
+
+			if err := eventType.Validate(); err != nil {
+				return eventType, commonhttp.NewHTTPError(http.StatusBadRequest, err)
+			}
+
+			return eventType, nil
+		},
+		b.Connector.UpsertEventType,
+		commonhttp.JSONResponseEncoder,
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("upsertEventType"),
+		)...,
+	)
+}
+
+type DeleteEventTypeHandler httptransport.HandlerWithArgs[NamespacedEventType, any, api.EventTypeName]
+
+func (b *builder) DeleteEventType() DeleteEventTypeHandler {
+	return httptransport.NewHandlerWithArgs(
+		func(ctx context.Context, r *http.Request, eventType api.EventTypeName) (NamespacedEventType, error) {
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return NamespacedEventType{}, err
+			}
+
+			return NamespacedEventType{
+				Namespace: ns,
+				Type:      eventType,
+			}, nil
+		},
+		func(ctx context.Context, id NamespacedEventType) (any, error) {
+			return nil, b.Connector.DeleteEventType(ctx, id.Namespace, id.Type)
+		},
+		func(ctx context.Context, w http.ResponseWriter, response any) error {
+			w.WriteHeader(http.StatusNoContent)
+			return nil
+		},
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("deleteEventType"),
+			httptransport.WithErrorEncoder(eventTypeNotFoundErrorEncoder),
+		)...,
+	)
+}
+
+func eventTypeNotFoundErrorEncoder(ctx context.Context, err error, w http.ResponseWriter) bool {
+	if _, ok := err.(*eventtype.EventTypeNotFoundError); ok {
+		models.NewStatusProblem(ctx, err, http.StatusNotFound).Respond(w)
+		return true
+	}
+
+	return false
+}
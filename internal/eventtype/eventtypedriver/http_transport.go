@@ -0,0 +1,54 @@
+package eventtypedriver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/openmeterio/openmeter/internal/eventtype"
+	"github.com/openmeterio/openmeter/internal/namespace/namespacedriver"
+	"github.com/openmeterio/openmeter/pkg/framework/commonhttp"
+	"github.com/openmeterio/openmeter/pkg/framework/transport/httptransport"
+)
+
+type Handlers struct {
+	ListEventTypes  ListEventTypesHandler
+	GetEventType    GetEventTypeHandler
+	UpsertEventType UpsertEventTypeHandler
+	DeleteEventType DeleteEventTypeHandler
+}
+
+// New returns the event type handlers.
+func New(
+	connector eventtype.Connector,
+	namespaceDecoder namespacedriver.NamespaceDecoder,
+	options ...httptransport.HandlerOption,
+) Handlers {
+	builder := &builder{
+		Connector:        connector,
+		NamespaceDecoder: namespaceDecoder,
+		Options:          options,
+	}
+
+	return Handlers{
+		ListEventTypes:  builder.ListEventTypes(),
+		GetEventType:    builder.GetEventType(),
+		UpsertEventType: builder.UpsertEventType(),
+		DeleteEventType: builder.DeleteEventType(),
+	}
+}
+
+type builder struct {
+	Connector        eventtype.Connector
+	NamespaceDecoder namespacedriver.NamespaceDecoder
+	Options          []httptransport.HandlerOption
+}
+
+func (b *builder) resolveNamespace(ctx context.Context) (string, error) {
+	ns, ok := b.NamespaceDecoder.GetNamespace(ctx)
+	if !ok {
+		return "", commonhttp.NewHTTPError(http.StatusInternalServerError, errors.New("internal server error"))
+	}
+
+	return ns, nil
+}
@@ -0,0 +1,49 @@
+package eventtype
+
+import (
+	"context"
+	"slices"
+)
+
+// InMemoryRepository is an in-memory event type repository, used for event types defined in configuration.
+type InMemoryRepository struct {
+	eventTypes []EventType
+}
+
+// Implement the Repository interface
+var _ Repository = &InMemoryRepository{}
+
+// NewInMemoryRepository returns an in-memory event type repository.
+func NewInMemoryRepository(eventTypes []EventType) *InMemoryRepository {
+	return &InMemoryRepository{
+		eventTypes: slices.Clone(eventTypes),
+	}
+}
+
+// ListAllEventTypes implements the [Repository] interface.
+func (r *InMemoryRepository) ListAllEventTypes(_ context.Context) ([]EventType, error) {
+	return slices.Clone(r.eventTypes), nil
+}
+
+// ListEventTypes implements the [Repository] interface.
+func (r *InMemoryRepository) ListEventTypes(_ context.Context, namespace string) ([]EventType, error) {
+	list := []EventType{}
+	for _, eventType := range r.eventTypes {
+		if eventType.Namespace == namespace {
+			list = append(list, eventType)
+		}
+	}
+
+	return list, nil
+}
+
+// GetEventType implements the [Repository] interface.
+func (r *InMemoryRepository) GetEventType(_ context.Context, namespace string, eventType string) (EventType, error) {
+	for _, et := range r.eventTypes {
+		if et.Namespace == namespace && et.Type == eventType {
+			return et, nil
+		}
+	}
+
+	return EventType{}, &EventTypeNotFoundError{Type: eventType}
+}
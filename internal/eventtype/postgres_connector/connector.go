@@ -0,0 +1,127 @@
+package postgres_connector
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	db_eventtype "github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/eventtype"
+	"github.com/openmeterio/openmeter/internal/eventtype"
+	"github.com/openmeterio/openmeter/pkg/convert"
+)
+
+type PostgresConnector struct {
+	db *db.Client
+}
+
+// Implement the Connector interface
+var _ eventtype.Connector = &PostgresConnector{}
+
+func NewPostgresConnector(db *db.Client) eventtype.Connector {
+	return &PostgresConnector{
+		db: db,
+	}
+}
+
+// ListAllEventTypes lists the event types of every namespace.
+func (c *PostgresConnector) ListAllEventTypes(ctx context.Context) ([]eventtype.EventType, error) {
+	entities, err := c.db.EventType.Query().
+		Order(db_eventtype.ByNamespace(), db_eventtype.ByType()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event types: %w", err)
+	}
+
+	return mapEventTypeEntities(entities), nil
+}
+
+// ListEventTypes lists the event types of a namespace.
+func (c *PostgresConnector) ListEventTypes(ctx context.Context, namespace string) ([]eventtype.EventType, error) {
+	entities, err := c.db.EventType.Query().
+		Where(db_eventtype.Namespace(namespace)).
+		Order(db_eventtype.ByType()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event types: %w", err)
+	}
+
+	return mapEventTypeEntities(entities), nil
+}
+
+// GetEventType gets an event type of a namespace.
+func (c *PostgresConnector) GetEventType(ctx context.Context, namespace string, eventType string) (eventtype.EventType, error) {
+	entity, err := c.db.EventType.Query().
+		Where(db_eventtype.Namespace(namespace)).
+		Where(db_eventtype.Type(eventType)).
+		Only(ctx)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return eventtype.EventType{}, &eventtype.EventTypeNotFoundError{Type: eventType}
+		}
+
+		return eventtype.EventType{}, fmt.Errorf("failed to get event type: %w", err)
+	}
+
+	return mapEventTypeEntity(entity), nil
+}
+
+// UpsertEventType creates an event type or replaces the description and schema of an existing one.
+func (c *PostgresConnector) UpsertEventType(ctx context.Context, eventType eventtype.EventType) (eventtype.EventType, error) {
+	err := c.db.EventType.Create().
+		SetNamespace(eventType.Namespace).
+		SetType(eventType.Type).
+		SetNillableDescription(eventType.Description).
+		SetSchema(eventType.Schema).
+		OnConflictColumns(
+			db_eventtype.FieldNamespace,
+			db_eventtype.FieldType,
+		).
+		UpdateDescription().
+		UpdateSchema().
+		UpdateUpdatedAt().
+		Exec(ctx)
+	if err != nil {
+		return eventtype.EventType{}, fmt.Errorf("failed to upsert event type: %w", err)
+	}
+
+	return c.GetEventType(ctx, eventType.Namespace, eventType.Type)
+}
+
+// DeleteEventType deletes an event type.
+func (c *PostgresConnector) DeleteEventType(ctx context.Context, namespace string, eventType string) error {
+	deleted, err := c.db.EventType.Delete().
+		Where(db_eventtype.Namespace(namespace)).
+		Where(db_eventtype.Type(eventType)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete event type: %w", err)
+	}
+
+	if deleted == 0 {
+		return &eventtype.EventTypeNotFoundError{Type: eventType}
+	}
+
+	return nil
+}
+
+func mapEventTypeEntities(entities []*db.EventType) []eventtype.EventType {
+	list := make([]eventtype.EventType, 0, len(entities))
+	for _, entity := range entities {
+		list = append(list, mapEventTypeEntity(entity))
+	}
+
+	return list
+}
+
+// mapEventTypeEntity maps a database event type entity to an event type model.
+func mapEventTypeEntity(entity *db.EventType) eventtype.EventType {
+	return eventtype.EventType{
+		Namespace:   entity.Namespace,
+		Type:        entity.Type,
+		Description: entity.Description,
+		Schema:      entity.Schema,
+		CreatedAt:   convert.ToPointer(entity.CreatedAt.In(time.UTC)),
+		UpdatedAt:   convert.ToPointer(entity.UpdatedAt.In(time.UTC)),
+	}
+}
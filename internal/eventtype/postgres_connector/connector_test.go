@@ -0,0 +1,120 @@
+package postgres_connector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	"github.com/openmeterio/openmeter/internal/eventtype"
+	"github.com/openmeterio/openmeter/internal/testutils"
+	"github.com/openmeterio/openmeter/pkg/convert"
+)
+
+func TestEventTypeConnector(t *testing.T) {
+	namespace := "default"
+
+	schema := map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"model"},
+	}
+
+	tt := []struct {
+		name        string
+		description string
+		test        func(t *testing.T, connector eventtype.Connector)
+	}{
+		{
+			name:        "UpsertEventType",
+			description: "Upserting an event type with the same type replaces its definition",
+			test: func(t *testing.T, connector eventtype.Connector) {
+				ctx := context.Background()
+
+				created, err := connector.UpsertEventType(ctx, eventtype.EventType{
+					Namespace:   namespace,
+					Type:        "prompt",
+					Description: convert.ToPointer("LLM prompts"),
+					Schema:      schema,
+				})
+				require.NoError(t, err)
+				assert.Equal(t, "prompt", created.Type)
+				assert.Equal(t, schema, created.Schema)
+
+				updated, err := connector.UpsertEventType(ctx, eventtype.EventType{
+					Namespace: namespace,
+					Type:      "prompt",
+					Schema:    map[string]interface{}{"type": "object"},
+				})
+				require.NoError(t, err)
+				assert.Nil(t, updated.Description)
+				assert.Equal(t, map[string]interface{}{"type": "object"}, updated.Schema)
+				assert.Equal(t, created.CreatedAt, updated.CreatedAt)
+
+				eventTypes, err := connector.ListEventTypes(ctx, namespace)
+				require.NoError(t, err)
+				assert.Len(t, eventTypes, 1)
+			},
+		},
+		{
+			name:        "GetEventType",
+			description: "Get an event type, event types of other namespaces are not found",
+			test: func(t *testing.T, connector eventtype.Connector) {
+				ctx := context.Background()
+
+				created, err := connector.UpsertEventType(ctx, eventtype.EventType{
+					Namespace: namespace,
+					Type:      "prompt",
+					Schema:    schema,
+				})
+				require.NoError(t, err)
+
+				eventType, err := connector.GetEventType(ctx, namespace, "prompt")
+				require.NoError(t, err)
+				assert.Equal(t, created, eventType)
+
+				_, err = connector.GetEventType(ctx, "other", "prompt")
+				assert.ErrorAs(t, err, new(*eventtype.EventTypeNotFoundError))
+
+				all, err := connector.ListAllEventTypes(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, []eventtype.EventType{created}, all)
+			},
+		},
+		{
+			name:        "DeleteEventType",
+			description: "Delete an event type",
+			test: func(t *testing.T, connector eventtype.Connector) {
+				ctx := context.Background()
+
+				_, err := connector.UpsertEventType(ctx, eventtype.EventType{
+					Namespace: namespace,
+					Type:      "prompt",
+					Schema:    schema,
+				})
+				require.NoError(t, err)
+
+				require.NoError(t, connector.DeleteEventType(ctx, namespace, "prompt"))
+
+				_, err = connector.GetEventType(ctx, namespace, "prompt")
+				assert.ErrorAs(t, err, new(*eventtype.EventTypeNotFoundError))
+
+				err = connector.DeleteEventType(ctx, namespace, "prompt")
+				assert.ErrorAs(t, err, new(*eventtype.EventTypeNotFoundError))
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			t.Log(tc.description)
+			driver := testutils.InitPostgresDB(t)
+			databaseClient := db.NewClient(db.Driver(driver))
+			defer databaseClient.Close()
+			connector := NewPostgresConnector(databaseClient)
+			tc.test(t, connector)
+		})
+	}
+}
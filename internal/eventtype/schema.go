@@ -0,0 +1,66 @@
+package eventtype
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// Schema is a compiled JSON Schema of an event type.
+type Schema struct {
+	schema *gojsonschema.Schema
+}
+
+// CompileSchema compiles a JSON Schema.
+func CompileSchema(schema map[string]interface{}) (*Schema, error) {
+	compiled, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(schema))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schema{schema: compiled}, nil
+}
+
+// FieldError is a schema mismatch at a path of the event data.
+type FieldError struct {
+	// Path is the JSONPath of the mismatching field, $ is the root of the event data
+	Path    string
+	Message string
+}
+
+// ValidationError lists every mismatch of event data with a schema.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	errs := make([]string, 0, len(e.Errors))
+	for _, fieldErr := range e.Errors {
+		errs = append(errs, fmt.Sprintf("%s: %s", fieldErr.Path, fieldErr.Message))
+	}
+
+	return strings.Join(errs, "; ")
+}
+
+// Validate validates JSON event data against the schema.
+func (s *Schema) Validate(data []byte) error {
+	result, err := s.schema.Validate(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return fmt.Errorf("cannot validate event data: %w", err)
+	}
+
+	if result.Valid() {
+		return nil
+	}
+
+	validationErr := &ValidationError{}
+	for _, resultErr := range result.Errors() {
+		validationErr.Errors = append(validationErr.Errors, FieldError{
+			Path:    "$" + strings.TrimPrefix(resultErr.Context().String("."), gojsonschema.STRING_CONTEXT_ROOT),
+			Message: resultErr.Description(),
+		})
+	}
+
+	return validationErr
+}
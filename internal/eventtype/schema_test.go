@@ -0,0 +1,75 @@
+package eventtype_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/eventtype"
+)
+
+func TestSchemaValidate(t *testing.T) {
+	schema, err := eventtype.CompileSchema(map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"model", "tokens"},
+		"properties": map[string]interface{}{
+			"model": map[string]interface{}{"type": "string"},
+			"tokens": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"input": map[string]interface{}{"type": "integer", "minimum": 0},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		description string
+		data        string
+		want        []eventtype.FieldError
+	}{
+		{
+			description: "should accept matching data",
+			data:        `{"model": "gpt-4", "tokens": {"input": 10}}`,
+		},
+		{
+			description: "should report missing properties at the root",
+			data:        `{"tokens": {}}`,
+			want: []eventtype.FieldError{
+				{Path: "$", Message: "model is required"},
+			},
+		},
+		{
+			description: "should report nested mismatches by path",
+			data:        `{"model": 4, "tokens": {"input": -1}}`,
+			want: []eventtype.FieldError{
+				{Path: "$.model", Message: "Invalid type. Expected: string, given: integer"},
+				{Path: "$.tokens.input", Message: "Must be greater than or equal to 0"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			err := schema.Validate([]byte(tt.data))
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErr *eventtype.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.ElementsMatch(t, tt.want, validationErr.Errors)
+		})
+	}
+}
+
+func TestEventTypeValidate(t *testing.T) {
+	assert.NoError(t, eventtype.EventType{Type: "prompt", Schema: map[string]interface{}{"type": "object"}}.Validate())
+	assert.Error(t, eventtype.EventType{Schema: map[string]interface{}{"type": "object"}}.Validate())
+	assert.Error(t, eventtype.EventType{Type: "prompt"}.Validate())
+	assert.Error(t, eventtype.EventType{Type: "prompt", Schema: map[string]interface{}{"type": "unknown"}}.Validate())
+}
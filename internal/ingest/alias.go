@@ -0,0 +1,58 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+)
+
+// OriginalSubjectExtension is the CloudEvents extension holding the subject of an aliased event as it was sent.
+const OriginalSubjectExtension = "originalsubject"
+
+// SubjectResolver resolves subjects to canonical subjects.
+type SubjectResolver interface {
+	ResolveSubject(ctx context.Context, namespace string, source string, subject string) (string, bool, error)
+}
+
+// SubjectAliasingCollector replaces aliased subjects with their canonical subject at event ingestion.
+// The original subject is kept in the {OriginalSubjectExtension} extension for auditing.
+type SubjectAliasingCollector struct {
+	Collector
+
+	Resolver SubjectResolver
+}
+
+// Ingest implements the {Collector} interface wrapping an existing {Collector} and resolving subject aliases.
+func (c SubjectAliasingCollector) Ingest(ctx context.Context, namespace string, ev event.Event) error {
+	if err := c.resolve(ctx, namespace, &ev); err != nil {
+		return err
+	}
+
+	return c.Collector.Ingest(ctx, namespace, ev)
+}
+
+// IngestBatch implements the {BatchCollector} interface, resolving every subject before passing the batch on.
+func (c SubjectAliasingCollector) IngestBatch(ctx context.Context, namespace string, events []event.Event) error {
+	for i := range events {
+		if err := c.resolve(ctx, namespace, &events[i]); err != nil {
+			return err
+		}
+	}
+
+	return ingestBatch(ctx, c.Collector, namespace, events)
+}
+
+func (c SubjectAliasingCollector) resolve(ctx context.Context, namespace string, ev *event.Event) error {
+	canonicalSubject, found, err := c.Resolver.ResolveSubject(ctx, namespace, ev.Source(), ev.Subject())
+	if err != nil {
+		return fmt.Errorf("resolving subject alias: %w", err)
+	}
+
+	if found && canonicalSubject != ev.Subject() {
+		ev.SetExtension(OriginalSubjectExtension, ev.Subject())
+		ev.SetSubject(canonicalSubject)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package ingest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/ingest"
+)
+
+type staticSubjectResolver map[string]string
+
+func (r staticSubjectResolver) ResolveSubject(_ context.Context, _ string, _ string, subject string) (string, bool, error) {
+	if canonicalSubject, ok := r[subject]; ok {
+		return canonicalSubject, true, nil
+	}
+
+	return subject, false, nil
+}
+
+func TestSubjectAliasingCollector(t *testing.T) {
+	collector := ingest.NewInMemoryCollector()
+
+	aliasingCollector := ingest.SubjectAliasingCollector{
+		Collector: collector,
+		Resolver:  staticSubjectResolver{"user-1": "customer-1"},
+	}
+
+	const namespace = "default"
+
+	newEvent := func(id string, subject string) event.Event {
+		ev := event.New()
+		ev.SetID(id)
+		ev.SetSource("source")
+		ev.SetType("some-type")
+		ev.SetSubject(subject)
+
+		return ev
+	}
+
+	require.NoError(t, aliasingCollector.Ingest(context.Background(), namespace, newEvent("1", "user-1")))
+	require.NoError(t, aliasingCollector.Ingest(context.Background(), namespace, newEvent("2", "user-2")))
+
+	events := collector.Events(namespace)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, "customer-1", events[0].Subject())
+	assert.Equal(t, "user-1", events[0].Extensions()[ingest.OriginalSubjectExtension])
+
+	assert.Equal(t, "user-2", events[1].Subject())
+	assert.NotContains(t, events[1].Extensions(), ingest.OriginalSubjectExtension)
+}
@@ -9,7 +9,9 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/cloudevents/sdk-go/v2/binding"
 	"github.com/cloudevents/sdk-go/v2/event"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 
 	"github.com/openmeterio/openmeter/api"
 	"github.com/openmeterio/openmeter/internal/ingest"
@@ -51,13 +53,16 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request, namespace str
 	ctx = contextx.WithAttr(ctx, "namespace", namespace)
 
 	contentType := r.Header.Get("Content-Type")
+	message := cehttp.NewMessageFromHttpRequest(r)
 
 	var err error
 	var handled bool
-	switch contentType {
-	case "application/cloudevents+json":
+	switch {
+	case message.ReadEncoding() == binding.EncodingBinary:
+		err, handled = h.processBinaryRequest(ctx, w, message, namespace)
+	case contentType == "application/cloudevents+json":
 		err, handled = h.processSingleRequest(ctx, w, r, namespace)
-	case "application/cloudevents-batch+json":
+	case contentType == "application/cloudevents-batch+json":
 		err, handled = h.processBatchRequest(ctx, w, r, namespace)
 	default:
 		// this should never happen
@@ -117,6 +122,27 @@ func (h Handler) processSingleRequest(ctx context.Context, w http.ResponseWriter
 	return nil, false
 }
 
+// processBinaryRequest handles events sent in CloudEvents binary content mode,
+// where event attributes are sent in ce-* headers and the body holds the event data.
+func (h Handler) processBinaryRequest(ctx context.Context, w http.ResponseWriter, message binding.Message, namespace string) (error, bool) {
+	event, err := binding.ToEvent(ctx, message)
+	if err == nil {
+		err = event.Validate()
+	}
+	if err != nil {
+		models.NewStatusProblem(ctx, fmt.Errorf("parsing event: %w", err), http.StatusBadRequest).Respond(w)
+
+		return nil, true
+	}
+
+	err = h.processEvent(ctx, *event, namespace)
+	if err != nil {
+		return err, false
+	}
+
+	return nil, false
+}
+
 func (h Handler) processEvent(ctx context.Context, event event.Event, namespace string) error {
 	logger := h.getLogger()
 
@@ -147,3 +147,47 @@ func TestBatchHandler(t *testing.T) {
 		assert.Equal(t, event.Time(), events[i].Time())
 	}
 }
+
+func TestHandler_BinaryMode(t *testing.T) {
+	collector := ingest.NewInMemoryCollector()
+	httpHandler, err := NewHandler(HandlerConfig{
+		Collector:        collector,
+		NamespaceManager: namespaceManager,
+		ErrorHandler:     errorsx.NopHandler{},
+	})
+	require.NoError(t, err)
+	handler := MockHandler{
+		handler: httpHandler,
+	}
+
+	server := httptest.NewServer(handler)
+	client := server.Client()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewBufferString(`{"duration_ms": 100}`))
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("ce-specversion", "1.0")
+	req.Header.Set("ce-id", "id")
+	req.Header.Set("ce-source", "test")
+	req.Header.Set("ce-type", "api-calls")
+	req.Header.Set("ce-subject", "sub")
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	events := collector.Events("test")
+
+	require.Len(t, events, 1)
+
+	receivedEvent := events[0]
+
+	assert.Equal(t, "id", receivedEvent.ID())
+	assert.Equal(t, "sub", receivedEvent.Subject())
+	assert.Equal(t, "test", receivedEvent.Source())
+	assert.Equal(t, "api-calls", receivedEvent.Type())
+	assert.False(t, receivedEvent.Time().IsZero())
+	assert.JSONEq(t, `{"duration_ms": 100}`, string(receivedEvent.Data()))
+}
@@ -12,3 +12,23 @@ type Collector interface {
 	Ingest(ctx context.Context, namespace string, ev event.Event) error
 	Close()
 }
+
+// BatchCollector is implemented by collectors that handle a batch of events at once (eg. to reject the whole batch).
+type BatchCollector interface {
+	IngestBatch(ctx context.Context, namespace string, events []event.Event) error
+}
+
+// ingestBatch ingests a batch of events at once if the collector supports it, one by one otherwise.
+func ingestBatch(ctx context.Context, collector Collector, namespace string, events []event.Event) error {
+	if batchCollector, ok := collector.(BatchCollector); ok {
+		return batchCollector.IngestBatch(ctx, namespace, events)
+	}
+
+	for _, ev := range events {
+		if err := collector.Ingest(ctx, namespace, ev); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
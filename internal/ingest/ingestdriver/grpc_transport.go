@@ -0,0 +1,137 @@
+package ingestdriver
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	format "github.com/cloudevents/sdk-go/binding/format/protobuf/v2"
+	"github.com/cloudevents/sdk-go/binding/format/protobuf/v2/pb"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	ingestv1 "github.com/openmeterio/openmeter/api/proto/openmeter/ingest/v1"
+	"github.com/openmeterio/openmeter/internal/ingest"
+	"github.com/openmeterio/openmeter/internal/namespace/namespacedriver"
+	"github.com/openmeterio/openmeter/pkg/framework/operation"
+)
+
+// NewIngestServiceServer returns a new gRPC ingest service that wraps the given [operation.Operation].
+//
+// Events are passed to the operation one by one so that every event can be acknowledged individually.
+func NewIngestServiceServer(
+	op operation.Operation[ingest.IngestEventsRequest, bool],
+	namespaceDecoder namespacedriver.NamespaceDecoder,
+) ingestv1.IngestServiceServer {
+	return ingestServiceServer{
+		op:               op,
+		namespaceDecoder: namespaceDecoder,
+	}
+}
+
+type ingestServiceServer struct {
+	ingestv1.UnimplementedIngestServiceServer
+
+	op               operation.Operation[ingest.IngestEventsRequest, bool]
+	namespaceDecoder namespacedriver.NamespaceDecoder
+}
+
+func (s ingestServiceServer) IngestEvents(ctx context.Context, req *ingestv1.IngestEventsRequest) (*ingestv1.IngestEventsResponse, error) {
+	namespace, ok := s.namespaceDecoder.GetNamespace(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "namespace not found")
+	}
+
+	return s.ingest(ctx, namespace, req), nil
+}
+
+func (s ingestServiceServer) IngestEventStream(stream ingestv1.IngestService_IngestEventStreamServer) error {
+	ctx := stream.Context()
+
+	namespace, ok := s.namespaceDecoder.GetNamespace(ctx)
+	if !ok {
+		return status.Error(codes.InvalidArgument, "namespace not found")
+	}
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(s.ingest(ctx, namespace, req)); err != nil {
+			return err
+		}
+	}
+}
+
+func (s ingestServiceServer) ingest(ctx context.Context, namespace string, req *ingestv1.IngestEventsRequest) *ingestv1.IngestEventsResponse {
+	resp := &ingestv1.IngestEventsResponse{
+		Sequence: req.GetSequence(),
+		Acks:     make([]*ingestv1.EventAck, 0, len(req.GetEvents())),
+	}
+
+	for _, container := range req.GetEvents() {
+		ack := &ingestv1.EventAck{
+			Id:     container.GetId(),
+			Status: ingestv1.EventAck_STATUS_ACCEPTED,
+		}
+
+		ev, err := eventFromProto(container)
+		if err != nil {
+			ack.Status = ingestv1.EventAck_STATUS_INVALID
+			ack.Error = ErrorInvalidEvent{Err: err}.Error()
+		} else if _, err := s.op(ctx, ingest.IngestEventsRequest{Namespace: namespace, Events: []event.Event{*ev}}); err != nil {
+			ack.Status = ingestv1.EventAck_STATUS_FAILED
+			ack.Error = err.Error()
+
+			if e := (ingest.RateLimitExceededError{}); errors.As(err, &e) {
+				ack.Status = ingestv1.EventAck_STATUS_RATE_LIMITED
+				ack.RetryAfter = durationpb.New(e.RetryAfter)
+			}
+		}
+
+		resp.Acks = append(resp.Acks, ack)
+	}
+
+	return resp
+}
+
+func eventFromProto(container *pb.CloudEvent) (*event.Event, error) {
+	// FromProto passes text data through the codec of the content type (turning JSON text into a JSON string),
+	// so only attributes are converted by it and data is kept as is.
+	ev, err := format.FromProto(&pb.CloudEvent{
+		Id:          container.GetId(),
+		Source:      container.GetSource(),
+		SpecVersion: container.GetSpecVersion(),
+		Type:        container.GetType(),
+		Attributes:  container.GetAttributes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch data := container.GetData().(type) {
+	case *pb.CloudEvent_BinaryData:
+		ev.DataEncoded = data.BinaryData
+	case *pb.CloudEvent_TextData:
+		ev.DataEncoded = []byte(data.TextData)
+	case *pb.CloudEvent_ProtoData:
+		if ev.DataContentType() == "" {
+			ev.SetDataContentType(format.ContentTypeProtobuf)
+		}
+
+		ev.DataEncoded = data.ProtoData.GetValue()
+	}
+
+	if err := ev.Validate(); err != nil {
+		return nil, err
+	}
+
+	return ev, nil
+}
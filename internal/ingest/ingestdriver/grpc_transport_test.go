@@ -0,0 +1,185 @@
+package ingestdriver_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cloudevents/sdk-go/binding/format/protobuf/v2/pb"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ingestv1 "github.com/openmeterio/openmeter/api/proto/openmeter/ingest/v1"
+	"github.com/openmeterio/openmeter/internal/dedupe/memorydedupe"
+	"github.com/openmeterio/openmeter/internal/ingest"
+	"github.com/openmeterio/openmeter/internal/ingest/ingestdriver"
+	"github.com/openmeterio/openmeter/internal/namespace/namespacedriver"
+)
+
+func newGRPCIngestClient(t *testing.T, collector ingest.Collector) ingestv1.IngestServiceClient {
+	t.Helper()
+
+	service := ingest.Service{
+		Collector: collector,
+		Logger:    slog.Default(),
+	}
+
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := grpc.NewServer()
+	ingestv1.RegisterIngestServiceServer(server, ingestdriver.NewIngestServiceServer(
+		service.IngestEvents,
+		namespacedriver.StaticNamespaceDecoder("test"),
+	))
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return ingestv1.NewIngestServiceClient(conn)
+}
+
+func newProtoEvent(id string, data string) *pb.CloudEvent {
+	return &pb.CloudEvent{
+		Id:          id,
+		Source:      "test",
+		SpecVersion: "1.0",
+		Type:        "test",
+		Attributes: map[string]*pb.CloudEventAttributeValue{
+			"subject": {Attr: &pb.CloudEventAttributeValue_CeString{CeString: "sub"}},
+			"time":    {Attr: &pb.CloudEventAttributeValue_CeTimestamp{CeTimestamp: timestamppb.New(time.Date(2023, 06, 15, 14, 33, 00, 00, time.UTC))}},
+			"datacontenttype": {
+				Attr: &pb.CloudEventAttributeValue_CeString{CeString: "application/json"},
+			},
+		},
+		Data: &pb.CloudEvent_TextData{TextData: data},
+	}
+}
+
+func TestIngestServiceServer_IngestEvents(t *testing.T) {
+	collector := ingest.NewInMemoryCollector()
+	client := newGRPCIngestClient(t, collector)
+
+	invalidEvent := newProtoEvent("invalid", `{}`)
+	invalidEvent.Source = ""
+
+	resp, err := client.IngestEvents(context.Background(), &ingestv1.IngestEventsRequest{
+		Sequence: 1,
+		Events: []*pb.CloudEvent{
+			newProtoEvent("id1", `{"value": 1}`),
+			invalidEvent,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(1), resp.GetSequence())
+	require.Len(t, resp.GetAcks(), 2)
+	assert.Equal(t, "id1", resp.GetAcks()[0].GetId())
+	assert.Equal(t, ingestv1.EventAck_STATUS_ACCEPTED, resp.GetAcks()[0].GetStatus())
+	assert.Equal(t, "invalid", resp.GetAcks()[1].GetId())
+	assert.Equal(t, ingestv1.EventAck_STATUS_INVALID, resp.GetAcks()[1].GetStatus())
+	assert.NotEmpty(t, resp.GetAcks()[1].GetError())
+
+	events := collector.Events("test")
+
+	require.Len(t, events, 1)
+
+	receivedEvent := events[0]
+
+	assert.Equal(t, "id1", receivedEvent.ID())
+	assert.Equal(t, "sub", receivedEvent.Subject())
+	assert.Equal(t, "test", receivedEvent.Source())
+	assert.Equal(t, time.Date(2023, 06, 15, 14, 33, 00, 00, time.UTC), receivedEvent.Time())
+	assert.Equal(t, `{"value": 1}`, string(receivedEvent.Data()))
+}
+
+func TestIngestServiceServer_IngestEventStream(t *testing.T) {
+	deduplicator, err := memorydedupe.NewDeduplicator(100)
+	require.NoError(t, err)
+
+	collector := ingest.NewInMemoryCollector()
+	client := newGRPCIngestClient(t, ingest.DeduplicatingCollector{
+		Collector:    collector,
+		Deduplicator: deduplicator,
+	})
+
+	stream, err := client.IngestEventStream(context.Background())
+	require.NoError(t, err)
+
+	batches := [][]*pb.CloudEvent{
+		{newProtoEvent("id1", `{}`), newProtoEvent("id2", `{}`)},
+		{newProtoEvent("id2", `{}`), newProtoEvent("id3", `{}`)},
+	}
+
+	for i, batch := range batches {
+		err := stream.Send(&ingestv1.IngestEventsRequest{
+			Sequence: uint64(i),
+			Events:   batch,
+		})
+		require.NoError(t, err)
+
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+
+		assert.Equal(t, uint64(i), resp.GetSequence())
+		require.Len(t, resp.GetAcks(), len(batch))
+
+		for j, ack := range resp.GetAcks() {
+			assert.Equal(t, batch[j].GetId(), ack.GetId())
+			assert.Equal(t, ingestv1.EventAck_STATUS_ACCEPTED, ack.GetStatus())
+		}
+	}
+
+	require.NoError(t, stream.CloseSend())
+
+	_, err = stream.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+
+	// Duplicates are acknowledged but only forwarded once
+	events := collector.Events("test")
+
+	require.Len(t, events, 3)
+}
+
+type failingCollector struct{}
+
+func (failingCollector) Ingest(_ context.Context, _ string, _ event.Event) error {
+	return errors.New("collector unavailable")
+}
+
+func (failingCollector) Close() {}
+
+func TestIngestServiceServer_CollectorFailure(t *testing.T) {
+	client := newGRPCIngestClient(t, failingCollector{})
+
+	resp, err := client.IngestEvents(context.Background(), &ingestv1.IngestEventsRequest{
+		Events: []*pb.CloudEvent{newProtoEvent("id1", `{}`)},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, resp.GetAcks(), 1)
+	assert.Equal(t, ingestv1.EventAck_STATUS_FAILED, resp.GetAcks()[0].GetStatus())
+	assert.Contains(t, resp.GetAcks()[0].GetError(), "collector unavailable")
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
 
+	"github.com/cloudevents/sdk-go/v2/binding"
 	"github.com/cloudevents/sdk-go/v2/event"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 
 	"github.com/openmeterio/openmeter/api"
 	"github.com/openmeterio/openmeter/internal/ingest"
@@ -85,6 +89,27 @@ func (d ingestEventsRequestDecoder) decode(ctx context.Context, r *http.Request)
 
 	req.Namespace = namespace
 
+	// In CloudEvents binary content mode event attributes are sent in ce-* headers
+	// and the body holds the event data with its own content type.
+	if message := cehttp.NewMessageFromHttpRequest(r); message.ReadEncoding() == binding.EncodingBinary {
+		ev, err := binding.ToEvent(ctx, message)
+		if err != nil {
+			return req, ErrorInvalidEvent{
+				Err: err,
+			}
+		}
+
+		if err := ev.Validate(); err != nil {
+			return req, ErrorInvalidEvent{
+				Err: err,
+			}
+		}
+
+		req.Events = []event.Event{*ev}
+
+		return req, nil
+	}
+
 	contentType := r.Header.Get("Content-Type")
 
 	switch contentType {
@@ -140,6 +165,19 @@ func (e ingestEventsErrorEncoder) encode(ctx context.Context, err error, w http.
 		return true
 	}
 
+	if e := (ingest.RateLimitBurstExceededError{}); errors.As(err, &e) {
+		models.NewStatusProblem(ctx, e, http.StatusBadRequest).Respond(w)
+
+		return true
+	}
+
+	if e := (ingest.RateLimitExceededError{}); errors.As(err, &e) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(e.RetryAfter.Seconds()))))
+		models.NewStatusProblem(ctx, e, http.StatusTooManyRequests).Respond(w)
+
+		return true
+	}
+
 	if e.CommonErrorEncoder != nil {
 		return e.CommonErrorEncoder(ctx, err, w)
 	}
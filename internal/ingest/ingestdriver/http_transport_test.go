@@ -14,10 +14,13 @@ import (
 	"github.com/cloudevents/sdk-go/v2/event"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
 
 	"github.com/openmeterio/openmeter/internal/ingest"
 	"github.com/openmeterio/openmeter/internal/ingest/ingestdriver"
 	"github.com/openmeterio/openmeter/internal/namespace/namespacedriver"
+	"github.com/openmeterio/openmeter/internal/ratelimit"
+	"github.com/openmeterio/openmeter/internal/ratelimit/memoryratelimit"
 	"github.com/openmeterio/openmeter/pkg/errorsx"
 )
 
@@ -145,3 +148,194 @@ func TestBatchHandler(t *testing.T) {
 		assert.Equal(t, event.Time(), events[i].Time())
 	}
 }
+
+func TestIngestEvents_BinaryMode(t *testing.T) {
+	collector := ingest.NewInMemoryCollector()
+
+	service := ingest.Service{
+		Collector: collector,
+		Logger:    slog.Default(),
+	}
+
+	handler := ingestdriver.NewIngestEventsHandler(
+		service.IngestEvents,
+		namespacedriver.StaticNamespaceDecoder("test"),
+		nil,
+		errorsx.NewContextHandler(errorsx.NopHandler{}),
+	)
+
+	server := httptest.NewServer(handler)
+	client := server.Client()
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewBufferString("tokens=1234&model=gpt-4"))
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("ce-specversion", "1.0")
+	req.Header.Set("ce-id", "id")
+	req.Header.Set("ce-source", "test")
+	req.Header.Set("ce-type", "prompt")
+	req.Header.Set("ce-subject", "sub")
+	req.Header.Set("ce-time", "2023-06-15T14:33:00Z")
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	events := collector.Events("test")
+
+	require.Len(t, events, 1)
+
+	receivedEvent := events[0]
+
+	assert.Equal(t, "id", receivedEvent.ID())
+	assert.Equal(t, "sub", receivedEvent.Subject())
+	assert.Equal(t, "test", receivedEvent.Source())
+	assert.Equal(t, "prompt", receivedEvent.Type())
+	assert.Equal(t, time.Date(2023, 06, 15, 14, 33, 00, 00, time.UTC), receivedEvent.Time())
+	assert.Equal(t, "application/x-www-form-urlencoded", receivedEvent.DataContentType())
+	assert.Equal(t, []byte("tokens=1234&model=gpt-4"), receivedEvent.Data())
+}
+
+func TestIngestEvents_BinaryModeInvalidEvent(t *testing.T) {
+	collector := ingest.NewInMemoryCollector()
+
+	service := ingest.Service{
+		Collector: collector,
+		Logger:    slog.Default(),
+	}
+
+	handler := ingestdriver.NewIngestEventsHandler(
+		service.IngestEvents,
+		namespacedriver.StaticNamespaceDecoder("test"),
+		nil,
+		errorsx.NewContextHandler(errorsx.NopHandler{}),
+	)
+
+	server := httptest.NewServer(handler)
+	client := server.Client()
+
+	// Missing required ce-id and ce-source attributes
+	req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewBufferString(`{"tokens": 1234}`))
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("ce-specversion", "1.0")
+	req.Header.Set("ce-type", "prompt")
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Empty(t, collector.Events("test"))
+}
+
+func TestIngestEvents_RateLimited(t *testing.T) {
+	limiter, err := memoryratelimit.NewLimiter(0)
+	require.NoError(t, err)
+
+	collector, err := ingest.NewRateLimitingCollector(
+		ingest.NewInMemoryCollector(),
+		limiter,
+		ingest.RateLimits{
+			Namespace: ratelimit.Limit{Rate: 0.5, Burst: 1},
+		},
+		noop.NewMeterProvider().Meter("test"),
+	)
+	require.NoError(t, err)
+
+	service := ingest.Service{
+		Collector: collector,
+		Logger:    slog.Default(),
+	}
+
+	handler := ingestdriver.NewIngestEventsHandler(
+		service.IngestEvents,
+		namespacedriver.StaticNamespaceDecoder("test"),
+		nil,
+		errorsx.NewContextHandler(errorsx.NopHandler{}),
+	)
+
+	server := httptest.NewServer(handler)
+	client := server.Client()
+
+	for i, expectedStatus := range []int{http.StatusNoContent, http.StatusTooManyRequests} {
+		ev := event.New()
+		ev.SetID(strconv.Itoa(i))
+		ev.SetSubject("sub")
+		ev.SetSource("test")
+
+		var buf bytes.Buffer
+
+		err := json.NewEncoder(&buf).Encode(ev)
+		require.NoError(t, err)
+
+		resp, err := client.Post(server.URL, "application/cloudevents+json", &buf)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedStatus, resp.StatusCode)
+
+		if expectedStatus == http.StatusTooManyRequests {
+			assert.Equal(t, "2", resp.Header.Get("Retry-After"))
+		}
+	}
+}
+
+func TestIngestEvents_RateLimitedBatch(t *testing.T) {
+	limiter, err := memoryratelimit.NewLimiter(0)
+	require.NoError(t, err)
+
+	inMemoryCollector := ingest.NewInMemoryCollector()
+
+	collector, err := ingest.NewRateLimitingCollector(
+		inMemoryCollector,
+		limiter,
+		ingest.RateLimits{
+			Namespace: ratelimit.Limit{Rate: 0.5, Burst: 3},
+		},
+		noop.NewMeterProvider().Meter("test"),
+	)
+	require.NoError(t, err)
+
+	service := ingest.Service{
+		Collector: collector,
+		Logger:    slog.Default(),
+	}
+
+	handler := ingestdriver.NewIngestEventsHandler(
+		service.IngestEvents,
+		namespacedriver.StaticNamespaceDecoder("test"),
+		nil,
+		errorsx.NewContextHandler(errorsx.NopHandler{}),
+	)
+
+	server := httptest.NewServer(handler)
+	client := server.Client()
+
+	// The second batch exceeds the limit in the middle, none of its events are ingested
+	for i, expectedStatus := range []int{http.StatusNoContent, http.StatusTooManyRequests} {
+		var events []event.Event
+		for j := 0; j < 2; j++ {
+			ev := event.New()
+			ev.SetID(fmt.Sprintf("%d-%d", i, j))
+			ev.SetSubject("sub")
+			ev.SetSource("test")
+			ev.SetType("test")
+
+			events = append(events, ev)
+		}
+
+		var buf bytes.Buffer
+
+		err := json.NewEncoder(&buf).Encode(events)
+		require.NoError(t, err)
+
+		resp, err := client.Post(server.URL, "application/cloudevents-batch+json", &buf)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedStatus, resp.StatusCode)
+	}
+
+	assert.Len(t, inMemoryCollector.Events("test"), 2)
+}
@@ -26,6 +26,9 @@ type Collector struct {
 	// For example: "om_%s_events"
 	NamespacedTopicTemplate string
 
+	// Spooler is optional, if set messages are produced through it to survive Kafka outages.
+	Spooler *Spooler
+
 	ingestEventCounter metric.Int64Counter
 }
 
@@ -90,7 +93,11 @@ func (s Collector) Ingest(ctx context.Context, namespace string, ev event.Event)
 		Value: value,
 	}
 
-	err = s.Producer.Produce(msg, nil)
+	if s.Spooler != nil {
+		err = s.Spooler.Produce(ctx, msg)
+	} else {
+		err = s.Producer.Produce(msg, nil)
+	}
 	if err != nil {
 		return fmt.Errorf("producing kafka message: %w", err)
 	}
@@ -102,10 +109,14 @@ func (s Collector) Ingest(ctx context.Context, namespace string, ev event.Event)
 	return nil
 }
 
-// Close closes the underlying producer.
+// Close closes the underlying producer (and spool).
 func (s Collector) Close() {
 	s.Producer.Flush(30 * 1000)
 	s.Producer.Close()
+
+	if s.Spooler != nil {
+		_ = s.Spooler.Spool.Close()
+	}
 }
 
 func KafkaProducerGroup(ctx context.Context, producer *kafka.Producer, logger *slog.Logger, kafkaMetrics *kafkametrics.Metrics) (execute func() error, interrupt func(error)) {
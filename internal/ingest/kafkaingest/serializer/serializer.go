@@ -3,6 +3,10 @@ package serializer
 import (
 	_ "embed"
 	"encoding/json"
+	"fmt"
+	"mime"
+	"net/url"
+	"strings"
 
 	"github.com/cloudevents/sdk-go/v2/event"
 )
@@ -23,6 +27,10 @@ type CloudEventsKafkaPayload struct {
 	// Note: By converting to unix timestamp we loose timezone information.
 	Time int64  `json:"time"`
 	Data string `json:"data"`
+	// DataContentType is only set when data is not JSON and it is stored as is.
+	DataContentType string `json:"datacontenttype,omitempty"`
+	// OriginalSubject is only set when the subject was replaced by a canonical subject alias.
+	OriginalSubject string `json:"originalsubject,omitempty"`
 }
 
 func toCloudEventsKafkaPayload(ev event.Event) (CloudEventsKafkaPayload, error) {
@@ -34,16 +42,82 @@ func toCloudEventsKafkaPayload(ev event.Event) (CloudEventsKafkaPayload, error)
 		Time:    ev.Time().Unix(),
 	}
 
-	// We try to parse data as JSON.
-	// CloudEvents data can be other than JSON but currently we only support JSON data.
-	var data interface{}
-	err := json.Unmarshal(ev.Data(), &data)
+	if originalSubject, ok := ev.Extensions()["originalsubject"].(string); ok {
+		payload.OriginalSubject = originalSubject
+	}
+
+	mediaType, err := dataMediaType(ev)
 	if err != nil {
 		return payload, err
 	}
 
-	payloadData, _ := json.Marshal(data)
-	payload.Data = string(payloadData)
+	switch {
+	case isJSONMediaType(mediaType):
+		// We try to parse data as JSON.
+		var data interface{}
+		err := json.Unmarshal(ev.Data(), &data)
+		if err != nil {
+			return payload, err
+		}
+
+		payloadData, _ := json.Marshal(data)
+		payload.Data = string(payloadData)
+	case mediaType == "application/x-www-form-urlencoded":
+		// Form data is converted into a JSON object so meters can process it.
+		payloadData, err := formToJSON(ev.Data())
+		if err != nil {
+			return payload, err
+		}
+
+		payload.Data = string(payloadData)
+	default:
+		// Any other data is stored as is, meters will mark the event as invalid.
+		payload.Data = string(ev.Data())
+		payload.DataContentType = ev.DataContentType()
+	}
 
 	return payload, nil
 }
+
+// dataMediaType returns the media type of the event data without parameters.
+// Events without data content type are considered to have JSON data.
+func dataMediaType(ev event.Event) (string, error) {
+	contentType := ev.DataContentType()
+	if contentType == "" {
+		return "", nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", fmt.Errorf("parse data content type: %w", err)
+	}
+
+	return mediaType, nil
+}
+
+func isJSONMediaType(mediaType string) bool {
+	return mediaType == "" ||
+		mediaType == "application/json" ||
+		mediaType == "text/json" ||
+		strings.HasSuffix(mediaType, "+json")
+}
+
+// formToJSON converts URL encoded form data into a JSON object.
+// Fields with a single value are converted to strings, fields with multiple values to arrays of strings.
+func formToJSON(data []byte) ([]byte, error) {
+	values, err := url.ParseQuery(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("parse form data: %w", err)
+	}
+
+	obj := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		if len(value) == 1 {
+			obj[key] = value[0]
+		} else {
+			obj[key] = value
+		}
+	}
+
+	return json.Marshal(obj)
+}
@@ -0,0 +1,111 @@
+package serializer_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/ingest/kafkaingest/serializer"
+)
+
+func TestJSONSerializer_SerializeValue(t *testing.T) {
+	now := time.Date(2023, 06, 15, 14, 33, 00, 00, time.UTC)
+
+	tests := []struct {
+		description string
+		contentType string
+		data        []byte
+		extensions  map[string]interface{}
+		want        serializer.CloudEventsKafkaPayload
+		wantErr     bool
+	}{
+		{
+			description: "should normalize JSON data",
+			contentType: "application/json",
+			data:        []byte(`{"duration_ms": 100,  "method": "GET"}`),
+			want: serializer.CloudEventsKafkaPayload{
+				Data: `{"duration_ms":100,"method":"GET"}`,
+			},
+		},
+		{
+			description: "should handle data without content type as JSON",
+			data:        []byte(`{"duration_ms": 100}`),
+			want: serializer.CloudEventsKafkaPayload{
+				Data: `{"duration_ms":100}`,
+			},
+		},
+		{
+			description: "should return error for invalid JSON data",
+			contentType: "application/json",
+			data:        []byte(`{`),
+			wantErr:     true,
+		},
+		{
+			description: "should convert form data into JSON",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			data:        []byte(`duration_ms=100&method=GET&tag=a&tag=b`),
+			want: serializer.CloudEventsKafkaPayload{
+				Data: `{"duration_ms":"100","method":"GET","tag":["a","b"]}`,
+			},
+		},
+		{
+			description: "should store other data as is",
+			contentType: "text/plain",
+			data:        []byte(`duration_ms 100`),
+			want: serializer.CloudEventsKafkaPayload{
+				Data:            `duration_ms 100`,
+				DataContentType: "text/plain",
+			},
+		},
+		{
+			description: "should keep the original subject of aliased events",
+			data:        []byte(`{}`),
+			extensions:  map[string]interface{}{"originalsubject": "user-1"},
+			want: serializer.CloudEventsKafkaPayload{
+				Data:            `{}`,
+				OriginalSubject: "user-1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ev := event.New()
+			ev.SetID("id")
+			ev.SetType("api-calls")
+			ev.SetSource("test")
+			ev.SetSubject("sub")
+			ev.SetTime(now)
+			ev.SetDataContentType(tt.contentType)
+			ev.DataEncoded = tt.data
+			for name, value := range tt.extensions {
+				ev.SetExtension(name, value)
+			}
+
+			value, err := serializer.NewJSONSerializer().SerializeValue("topic", ev)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var payload serializer.CloudEventsKafkaPayload
+			err = json.Unmarshal(value, &payload)
+			require.NoError(t, err)
+
+			want := tt.want
+			want.Id = "id"
+			want.Type = "api-calls"
+			want.Source = "test"
+			want.Subject = "sub"
+			want.Time = now.Unix()
+
+			assert.Equal(t, want, payload)
+		})
+	}
+}
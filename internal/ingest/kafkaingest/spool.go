@@ -0,0 +1,419 @@
+package kafkaingest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/openmeterio/openmeter/pkg/spool"
+)
+
+// SpoolerConfig configures a {Spooler}.
+type SpoolerConfig struct {
+	// ReplayBatchSize is the number of spooled messages produced at once during replay.
+	ReplayBatchSize int
+	// ReplayInterval is the time to wait between replay attempts while Kafka is unavailable.
+	ReplayInterval time.Duration
+}
+
+// Spooler produces messages to Kafka and holds them in a durable on-disk spool while Kafka is unavailable.
+//
+// Messages are spooled when producing fails or when Kafka reports a failed delivery.
+// While the spool is not empty, new messages are appended to it as well to keep their order,
+// and spooled messages are replayed in order once Kafka recovers.
+//
+// The order of messages (even of the same key) is not kept when a delivery fails asynchronously:
+// the failed message is spooled after messages produced later may have been delivered already.
+// Events carry their own timestamps, so the order they arrive in Kafka does not change meter values.
+//
+// Messages may be produced more than once (eg. when a replay is interrupted), duplicates are filtered out by the sink.
+//
+// Messages Kafka rejects permanently (eg. too large messages, unknown topics or authorization failures) are never spooled:
+// they would block the replay of every message after them. Produce returns the error and failed deliveries
+// and spooled messages rejected during replay are dropped with an error log.
+//
+// The producer must include headers in delivery reports (go.delivery.report.fields), otherwise they are lost when spooling failed deliveries.
+type Spooler struct {
+	Producer *kafka.Producer
+	Spool    *spool.Spool
+	Logger   *slog.Logger
+	Config   SpoolerConfig
+
+	// deliveries receives delivery reports of messages produced by the spooler
+	deliveries chan kafka.Event
+
+	// mu makes checking the spool and producing a message atomic
+	mu sync.Mutex
+
+	spooledCounter  metric.Int64Counter
+	replayedCounter metric.Int64Counter
+	droppedCounter  metric.Int64Counter
+}
+
+// NewSpooler returns a new {Spooler}.
+func NewSpooler(
+	producer *kafka.Producer,
+	spool *spool.Spool,
+	logger *slog.Logger,
+	config SpoolerConfig,
+	metricMeter metric.Meter,
+) (*Spooler, error) {
+	if producer == nil {
+		return nil, fmt.Errorf("producer is required")
+	}
+	if spool == nil {
+		return nil, fmt.Errorf("spool is required")
+	}
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+	if config.ReplayBatchSize <= 0 {
+		return nil, fmt.Errorf("replay batch size must be greater than zero")
+	}
+	if config.ReplayInterval <= 0 {
+		return nil, fmt.Errorf("replay interval must be greater than zero")
+	}
+	if metricMeter == nil {
+		return nil, fmt.Errorf("metric meter is required")
+	}
+
+	spooledCounter, err := metricMeter.Int64Counter(
+		"ingest.spool.spooled",
+		metric.WithDescription("The number of events written to the spool"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create spooled events counter: %w", err)
+	}
+
+	replayedCounter, err := metricMeter.Int64Counter(
+		"ingest.spool.replayed",
+		metric.WithDescription("The number of spooled events delivered to Kafka"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create replayed events counter: %w", err)
+	}
+
+	droppedCounter, err := metricMeter.Int64Counter(
+		"ingest.spool.dropped",
+		metric.WithDescription("The number of events dropped because Kafka rejected them permanently"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dropped events counter: %w", err)
+	}
+
+	_, err = metricMeter.Int64ObservableGauge(
+		"ingest.spool.depth",
+		metric.WithDescription("The number of events waiting in the spool"),
+		metric.WithUnit("{event}"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(spool.Len())
+
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create spool depth gauge: %w", err)
+	}
+
+	return &Spooler{
+		Producer:        producer,
+		Spool:           spool,
+		Logger:          logger,
+		Config:          config,
+		deliveries:      make(chan kafka.Event, 1024),
+		spooledCounter:  spooledCounter,
+		replayedCounter: replayedCounter,
+		droppedCounter:  droppedCounter,
+	}, nil
+}
+
+// Produce produces a message to Kafka or appends it to the spool if Kafka is unavailable.
+func (s *Spooler) Produce(ctx context.Context, msg *kafka.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Spool.Len() == 0 {
+		err := s.Producer.Produce(msg, s.deliveries)
+		if err == nil {
+			return nil
+		}
+
+		// Spooling the message would not help, it would block the replay instead
+		if isPermanentError(err) {
+			return err
+		}
+
+		s.Logger.WarnContext(ctx, "producing kafka message failed, spooling", "error", err)
+	}
+
+	return s.spool(ctx, msg)
+}
+
+func (s *Spooler) spool(ctx context.Context, msg *kafka.Message) error {
+	record, err := json.Marshal(newSpoolRecord(msg))
+	if err != nil {
+		return fmt.Errorf("encoding spool record: %w", err)
+	}
+
+	if err := s.Spool.Append(record); err != nil {
+		return fmt.Errorf("appending to spool: %w", err)
+	}
+
+	s.spooledCounter.Add(ctx, 1)
+
+	return nil
+}
+
+// Run handles delivery reports and replays spooled messages. It is meant to be added to a [run.Group].
+func (s *Spooler) Run(ctx context.Context) (execute func() error, interrupt func(error)) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return func() error {
+			var wg sync.WaitGroup
+			defer wg.Wait()
+
+			// Delivery reports are handled separately from replays:
+			// the producer blocks on a full delivery channel and a replay waits for its own delivery reports.
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				for {
+					select {
+					case e := <-s.deliveries:
+						s.handleDelivery(ctx, e)
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+
+			ticker := time.NewTicker(s.Config.ReplayInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ticker.C:
+					if err := s.replay(ctx); err != nil && !errors.Is(err, context.Canceled) {
+						s.Logger.WarnContext(ctx, "replaying spooled messages failed", "error", err, "depth", s.Spool.Len())
+					}
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		},
+		func(error) {
+			cancel()
+		}
+}
+
+func (s *Spooler) handleDelivery(ctx context.Context, e kafka.Event) {
+	m, ok := e.(*kafka.Message)
+	if !ok {
+		return
+	}
+
+	if m.TopicPartition.Error == nil {
+		s.Logger.DebugContext(ctx, "kafka message delivered", "topic", *m.TopicPartition.Topic, "partition", m.TopicPartition.Partition, "offset", m.TopicPartition.Offset)
+
+		return
+	}
+
+	if isPermanentError(m.TopicPartition.Error) {
+		s.drop(ctx, m, m.TopicPartition.Error)
+
+		return
+	}
+
+	s.Logger.WarnContext(ctx, "kafka delivery failed, spooling", "error", m.TopicPartition.Error)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.spool(ctx, m); err != nil {
+		s.Logger.ErrorContext(ctx, "spooling failed delivery", "error", err)
+	}
+}
+
+// drop logs and counts a message Kafka rejected permanently.
+func (s *Spooler) drop(ctx context.Context, msg *kafka.Message, err error) {
+	var topic string
+	if msg.TopicPartition.Topic != nil {
+		topic = *msg.TopicPartition.Topic
+	}
+
+	s.Logger.ErrorContext(ctx, "dropping kafka message rejected permanently", "error", err, "topic", topic, "key", string(msg.Key), "size", len(msg.Value))
+	s.droppedCounter.Add(ctx, 1)
+}
+
+// replay produces spooled messages in batches until the spool is empty or a delivery fails.
+func (s *Spooler) replay(ctx context.Context) error {
+	for s.Spool.Len() > 0 {
+		records, err := s.Spool.Peek(s.Config.ReplayBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(records) == 0 {
+			return nil
+		}
+
+		delivered, err := s.replayBatch(ctx, records)
+
+		// Commit the delivered prefix, anything after the first failure is replayed again
+		if commitErr := s.Spool.Commit(delivered); commitErr != nil {
+			return commitErr
+		}
+
+		s.replayedCounter.Add(ctx, int64(delivered))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replayBatch produces a batch of records and returns the number of records delivered (or dropped) before the first failure.
+func (s *Spooler) replayBatch(ctx context.Context, records [][]byte) (int, error) {
+	deliveries := make(chan kafka.Event, len(records))
+	messages := make([]*kafka.Message, len(records))
+	results := make([]error, len(records))
+	pending := 0
+
+	for i, data := range records {
+		var record spoolRecord
+
+		if err := json.Unmarshal(data, &record); err != nil {
+			// A record that cannot be decoded will never be delivered, skip it
+			s.Logger.ErrorContext(ctx, "dropping invalid spool record", "error", err)
+			s.droppedCounter.Add(ctx, 1)
+
+			continue
+		}
+
+		msg := record.message()
+		msg.Opaque = i
+		messages[i] = msg
+
+		if err := s.Producer.Produce(msg, deliveries); err != nil {
+			results[i] = err
+
+			// Records after a permanently rejected one can still be delivered
+			if isPermanentError(err) {
+				continue
+			}
+
+			break
+		}
+
+		pending++
+	}
+
+	for pending > 0 {
+		select {
+		case e := <-deliveries:
+			if m, ok := e.(*kafka.Message); ok {
+				results[m.Opaque.(int)] = m.TopicPartition.Error
+				pending--
+			}
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+
+	for i, err := range results {
+		if err == nil {
+			continue
+		}
+
+		if !isPermanentError(err) {
+			return i, err
+		}
+
+		s.drop(ctx, messages[i], err)
+	}
+
+	return len(records), nil
+}
+
+// isPermanentError reports whether Kafka will reject the message again when it is produced later.
+func isPermanentError(err error) bool {
+	var kafkaErr kafka.Error
+	if !errors.As(err, &kafkaErr) {
+		return false
+	}
+
+	switch kafkaErr.Code() {
+	case kafka.ErrMsgSizeTooLarge,
+		kafka.ErrInvalidMsgSize,
+		kafka.ErrRecordListTooLarge,
+		kafka.ErrInvalidMsg,
+		kafka.ErrUnknownTopic,
+		kafka.ErrUnknownTopicOrPart,
+		kafka.ErrTopicException,
+		kafka.ErrTopicAuthorizationFailed,
+		kafka.ErrClusterAuthorizationFailed:
+		return true
+	}
+
+	return false
+}
+
+type spoolRecord struct {
+	Topic     string        `json:"topic"`
+	Key       []byte        `json:"key"`
+	Value     []byte        `json:"value"`
+	Headers   []spoolHeader `json:"headers"`
+	Timestamp time.Time     `json:"timestamp"`
+}
+
+type spoolHeader struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+func newSpoolRecord(msg *kafka.Message) spoolRecord {
+	record := spoolRecord{
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   make([]spoolHeader, 0, len(msg.Headers)),
+		Timestamp: msg.Timestamp,
+	}
+
+	if msg.TopicPartition.Topic != nil {
+		record.Topic = *msg.TopicPartition.Topic
+	}
+
+	for _, header := range msg.Headers {
+		record.Headers = append(record.Headers, spoolHeader{Key: header.Key, Value: header.Value})
+	}
+
+	return record
+}
+
+func (r spoolRecord) message() *kafka.Message {
+	msg := &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &r.Topic, Partition: kafka.PartitionAny},
+		Timestamp:      r.Timestamp,
+		Key:            r.Key,
+		Value:          r.Value,
+	}
+
+	for _, header := range r.Headers {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: header.Key, Value: header.Value})
+	}
+
+	return msg
+}
@@ -0,0 +1,119 @@
+package kafkaingest_test
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/openmeterio/openmeter/internal/ingest/kafkaingest"
+	"github.com/openmeterio/openmeter/pkg/spool"
+)
+
+func TestSpooler(t *testing.T) {
+	const topic = "om_test_events"
+
+	cluster, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer cluster.Close()
+
+	require.NoError(t, cluster.CreateTopic(topic, 1, 1))
+
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers":         cluster.BootstrapServers(),
+		"message.timeout.ms":        500,
+		"go.delivery.report.fields": "key,value,headers",
+		"message.max.bytes":         1000,
+	})
+	require.NoError(t, err)
+	defer producer.Close()
+
+	s, err := spool.Open(t.TempDir(), spool.Options{})
+	require.NoError(t, err)
+	defer s.Close()
+
+	spooler, err := kafkaingest.NewSpooler(
+		producer,
+		s,
+		slog.Default(),
+		kafkaingest.SpoolerConfig{
+			ReplayBatchSize: 10,
+			ReplayInterval:  100 * time.Millisecond,
+		},
+		noop.NewMeterProvider().Meter("test"),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	newMessage := func(value string) *kafka.Message {
+		return &kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: func() *string { t := topic; return &t }(), Partition: kafka.PartitionAny},
+			Headers:        []kafka.Header{{Key: "namespace", Value: []byte("test")}},
+			Value:          []byte(value),
+		}
+	}
+
+	execute, interrupt := spooler.Run(ctx)
+	done := make(chan struct{})
+	go func() {
+		_ = execute()
+		close(done)
+	}()
+	defer func() {
+		interrupt(nil)
+		<-done
+	}()
+
+	// Messages Kafka rejects permanently are not spooled
+	tooLarge := newMessage(strings.Repeat("x", 2000))
+	err = spooler.Produce(ctx, tooLarge)
+	assert.Equal(t, kafka.ErrMsgSizeTooLarge, err.(kafka.Error).Code())
+	assert.Equal(t, int64(0), s.Len())
+
+	// Broker outage: failed deliveries are spooled
+	require.NoError(t, cluster.SetBrokerDown(1))
+
+	require.NoError(t, spooler.Produce(ctx, newMessage("1")))
+
+	require.Eventually(t, func() bool { return s.Len() == 1 }, 10*time.Second, 50*time.Millisecond)
+
+	// While the spool is not empty new messages are spooled to keep their order
+	require.NoError(t, spooler.Produce(ctx, tooLarge))
+	require.NoError(t, spooler.Produce(ctx, newMessage("2")))
+	assert.Equal(t, int64(3), s.Len())
+
+	// Broker recovers: spooled messages are replayed, the message that always fails is dropped instead of blocking the others
+	require.NoError(t, cluster.SetBrokerUp(1))
+
+	require.Eventually(t, func() bool { return s.Len() == 0 }, 30*time.Second, 50*time.Millisecond)
+
+	require.NoError(t, spooler.Produce(ctx, newMessage("3")))
+
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers": cluster.BootstrapServers(),
+		"group.id":          "test",
+		"auto.offset.reset": "earliest",
+	})
+	require.NoError(t, err)
+	defer consumer.Close()
+
+	require.NoError(t, consumer.Subscribe(topic, nil))
+
+	var values []string
+	for len(values) < 3 {
+		msg, err := consumer.ReadMessage(10 * time.Second)
+		require.NoError(t, err)
+
+		values = append(values, string(msg.Value))
+		assert.Equal(t, []kafka.Header{{Key: "namespace", Value: []byte("test")}}, msg.Headers)
+	}
+
+	assert.Equal(t, []string{"1", "2", "3"}, values)
+}
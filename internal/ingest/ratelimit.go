@@ -0,0 +1,175 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/openmeterio/openmeter/internal/ratelimit"
+)
+
+// RateLimits configures the token buckets applied to ingested events.
+// A zero limit disables limiting for the given scope.
+type RateLimits struct {
+	// Namespace limits events per namespace.
+	Namespace ratelimit.Limit
+	// Subject limits events per subject within a namespace.
+	Subject ratelimit.Limit
+	// Source limits events per source within a namespace.
+	Source ratelimit.Limit
+}
+
+// RateLimitExceededError is returned when an event is rejected by a rate limit.
+type RateLimitExceededError struct {
+	// Scope is the scope of the exceeded limit (namespace, subject or source).
+	Scope string
+	// RetryAfter is the time until the next event may be accepted.
+	RetryAfter time.Duration
+}
+
+func (e RateLimitExceededError) Error() string {
+	return fmt.Sprintf("%s rate limit exceeded, retry after %s", e.Scope, e.RetryAfter)
+}
+
+// RateLimitBurstExceededError is returned when a batch holds more events than a rate limit allows at once.
+type RateLimitBurstExceededError struct {
+	// Scope is the scope of the exceeded limit (namespace, subject or source).
+	Scope string
+	// Burst is the number of events the limit allows at once.
+	Burst int
+}
+
+func (e RateLimitBurstExceededError) Error() string {
+	return fmt.Sprintf("batch exceeds the %s rate limit of %d events at once", e.Scope, e.Burst)
+}
+
+// RateLimitingCollector implements rate limiting at event ingestion.
+type RateLimitingCollector struct {
+	Collector
+
+	Limiter ratelimit.Limiter
+	Limits  RateLimits
+
+	rateLimitedEventCounter metric.Int64Counter
+}
+
+// NewRateLimitingCollector wraps an existing {Collector} with rate limiting.
+func NewRateLimitingCollector(
+	collector Collector,
+	limiter ratelimit.Limiter,
+	limits RateLimits,
+	metricMeter metric.Meter,
+) (*RateLimitingCollector, error) {
+	if collector == nil {
+		return nil, fmt.Errorf("collector is required")
+	}
+	if limiter == nil {
+		return nil, fmt.Errorf("limiter is required")
+	}
+	if metricMeter == nil {
+		return nil, fmt.Errorf("metric meter is required")
+	}
+
+	rateLimitedEventCounter, err := metricMeter.Int64Counter(
+		"ingest.events.rate_limited",
+		metric.WithDescription("The number of events rejected by rate limits"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rate limited events counter: %w", err)
+	}
+
+	return &RateLimitingCollector{
+		Collector:               collector,
+		Limiter:                 limiter,
+		Limits:                  limits,
+		rateLimitedEventCounter: rateLimitedEventCounter,
+	}, nil
+}
+
+// Ingest implements the {Collector} interface wrapping an existing {Collector} and rejecting events over the limits.
+func (c RateLimitingCollector) Ingest(ctx context.Context, namespace string, ev event.Event) error {
+	return c.IngestBatch(ctx, namespace, []event.Event{ev})
+}
+
+// IngestBatch implements the {BatchCollector} interface.
+// The whole batch is rejected before any event is ingested if it exceeds any of the limits.
+func (c RateLimitingCollector) IngestBatch(ctx context.Context, namespace string, events []event.Event) error {
+	type bucket struct {
+		scope   string
+		request ratelimit.Request
+	}
+
+	var buckets []bucket
+	index := make(map[string]int)
+
+	// Keys share the namespace as hash tag, so the buckets of a batch are updated at once in a Redis Cluster
+	take := func(scope string, key string, limit ratelimit.Limit) {
+		if limit.IsZero() {
+			return
+		}
+
+		if i, ok := index[key]; ok {
+			buckets[i].request.Tokens++
+
+			return
+		}
+
+		index[key] = len(buckets)
+		buckets = append(buckets, bucket{
+			scope:   scope,
+			request: ratelimit.Request{Key: key, Limit: limit, Tokens: 1},
+		})
+	}
+
+	for _, ev := range events {
+		take("namespace", fmt.Sprintf("{%s}:namespace", namespace), c.Limits.Namespace)
+		take("subject", fmt.Sprintf("{%s}:subject:%s", namespace, ev.Subject()), c.Limits.Subject)
+		take("source", fmt.Sprintf("{%s}:source:%s", namespace, ev.Source()), c.Limits.Source)
+	}
+
+	if len(buckets) > 0 {
+		requests := make([]ratelimit.Request, 0, len(buckets))
+		for _, bucket := range buckets {
+			requests = append(requests, bucket.request)
+		}
+
+		res, err := c.Limiter.Allow(ctx, requests...)
+		if err != nil {
+			return fmt.Errorf("checking rate limits: %w", err)
+		}
+
+		if !res.Allowed {
+			rejected := buckets[res.Rejected]
+
+			c.rateLimitedEventCounter.Add(ctx, int64(len(events)), metric.WithAttributes(
+				attribute.String("namespace", namespace),
+				attribute.String("scope", rejected.scope),
+			))
+
+			if res.RetryAfter == 0 {
+				return RateLimitBurstExceededError{
+					Scope: rejected.scope,
+					Burst: rejected.request.Limit.Burst,
+				}
+			}
+
+			return RateLimitExceededError{
+				Scope:      rejected.scope,
+				RetryAfter: res.RetryAfter,
+			}
+		}
+	}
+
+	for _, ev := range events {
+		if err := c.Collector.Ingest(ctx, namespace, ev); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package ingest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/openmeterio/openmeter/internal/ingest"
+	"github.com/openmeterio/openmeter/internal/ratelimit"
+	"github.com/openmeterio/openmeter/internal/ratelimit/memoryratelimit"
+)
+
+func TestRateLimitingCollector(t *testing.T) {
+	collector := ingest.NewInMemoryCollector()
+	limiter, err := memoryratelimit.NewLimiter(0)
+	require.NoError(t, err)
+
+	rateLimitingCollector, err := ingest.NewRateLimitingCollector(
+		collector,
+		limiter,
+		ingest.RateLimits{
+			Namespace: ratelimit.Limit{Rate: 0.001, Burst: 4},
+			Subject:   ratelimit.Limit{Rate: 0.001, Burst: 1},
+		},
+		noop.NewMeterProvider().Meter("test"),
+	)
+	require.NoError(t, err)
+
+	const namespace = "default"
+
+	newEvent := func(id string, subject string) event.Event {
+		ev := event.New()
+		ev.SetID(id)
+		ev.SetSource("source")
+		ev.SetType("some-type")
+		ev.SetSubject(subject)
+
+		return ev
+	}
+
+	err = rateLimitingCollector.Ingest(context.Background(), namespace, newEvent("1", "subject-1"))
+	require.NoError(t, err)
+
+	// Subject limit
+	err = rateLimitingCollector.Ingest(context.Background(), namespace, newEvent("2", "subject-1"))
+
+	var rateLimitErr ingest.RateLimitExceededError
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.Equal(t, "subject", rateLimitErr.Scope)
+	assert.Positive(t, rateLimitErr.RetryAfter)
+
+	err = rateLimitingCollector.Ingest(context.Background(), namespace, newEvent("3", "subject-2"))
+	require.NoError(t, err)
+
+	// Batches are rejected as a whole: the event of subject-3 is not ingested and does not use up tokens
+	err = rateLimitingCollector.IngestBatch(context.Background(), namespace, []event.Event{newEvent("4", "subject-3"), newEvent("5", "subject-1")})
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.Equal(t, "subject", rateLimitErr.Scope)
+
+	err = rateLimitingCollector.Ingest(context.Background(), namespace, newEvent("6", "subject-3"))
+	require.NoError(t, err)
+
+	err = rateLimitingCollector.Ingest(context.Background(), namespace, newEvent("7", "subject-4"))
+	require.NoError(t, err)
+
+	// Namespace limit
+	err = rateLimitingCollector.Ingest(context.Background(), namespace, newEvent("8", "subject-5"))
+	require.True(t, errors.As(err, &rateLimitErr))
+	assert.Equal(t, "namespace", rateLimitErr.Scope)
+
+	// Other namespaces are not affected
+	err = rateLimitingCollector.Ingest(context.Background(), "other", newEvent("9", "subject-1"))
+	require.NoError(t, err)
+
+	// Batches larger than the burst are never allowed
+	err = rateLimitingCollector.IngestBatch(context.Background(), "other", []event.Event{newEvent("10", "subject-2"), newEvent("11", "subject-2")})
+
+	var burstErr ingest.RateLimitBurstExceededError
+	require.True(t, errors.As(err, &burstErr))
+	assert.Equal(t, "subject", burstErr.Scope)
+
+	assert.Len(t, collector.Events(namespace), 4)
+	assert.Len(t, collector.Events("other"), 1)
+}
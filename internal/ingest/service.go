@@ -2,6 +2,7 @@ package ingest
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -20,42 +21,52 @@ type IngestEventsRequest struct {
 	Events    []event.Event
 }
 
+// IngestEvents ingests a batch of events.
+// Events rejected by rate limits reject the whole batch, so a retried batch is not ingested twice.
 func (s Service) IngestEvents(ctx context.Context, request IngestEventsRequest) (bool, error) {
 	for _, ev := range request.Events {
-		err := s.processEvent(ctx, ev, request.Namespace)
-		if err != nil {
-			return false, err
-		}
+		s.processEvent(ctx, ev, request.Namespace)
 	}
 
-	return true, nil
-}
-
-func (s Service) processEvent(ctx context.Context, event event.Event, namespace string) error {
 	logger := s.Logger.With(
-		slog.String("event_id", event.ID()),
-		slog.String("event_subject", event.Subject()),
-		slog.String("event_source", event.Source()),
-		slog.String("namespace", namespace),
+		slog.String("namespace", request.Namespace),
+		slog.Int("events", len(request.Events)),
 	)
 
-	if event.Time().IsZero() {
-		logger.DebugContext(ctx, "event does not have a timestamp")
+	err := ingestBatch(ctx, s.Collector, request.Namespace, request.Events)
+	if e := (RateLimitExceededError{}); errors.As(err, &e) {
+		logger.DebugContext(ctx, "events rejected by rate limit", "scope", e.Scope)
 
-		event.SetTime(time.Now().UTC())
-	} else {
-		event.SetTime(event.Time().UTC())
+		return false, err
 	}
+	if e := (RateLimitBurstExceededError{}); errors.As(err, &e) {
+		logger.DebugContext(ctx, "events rejected by rate limit burst", "scope", e.Scope)
 
-	err := s.Collector.Ingest(ctx, namespace, event)
+		return false, err
+	}
 	if err != nil {
 		// TODO: attach context to error and log at a higher level
-		logger.ErrorContext(ctx, "unable to forward event to collector", "error", err)
+		logger.ErrorContext(ctx, "unable to forward events to collector", "error", err)
 
-		return fmt.Errorf("forwarding event to collector: %w", err)
+		return false, fmt.Errorf("forwarding event to collector: %w", err)
 	}
 
-	logger.DebugContext(ctx, "event forwarded to downstream collector")
+	logger.DebugContext(ctx, "events forwarded to downstream collector")
 
-	return nil
+	return true, nil
+}
+
+func (s Service) processEvent(ctx context.Context, event event.Event, namespace string) {
+	if event.Time().IsZero() {
+		s.Logger.DebugContext(ctx, "event does not have a timestamp",
+			slog.String("event_id", event.ID()),
+			slog.String("event_subject", event.Subject()),
+			slog.String("event_source", event.Source()),
+			slog.String("namespace", namespace),
+		)
+
+		event.SetTime(time.Now().UTC())
+	} else {
+		event.SetTime(event.Time().UTC())
+	}
 }
@@ -0,0 +1,99 @@
+// Package memoryratelimit implements in-process rate limiting.
+package memoryratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"golang.org/x/time/rate"
+
+	"github.com/openmeterio/openmeter/internal/ratelimit"
+)
+
+const defaultSize = 1024
+
+// Limiter implements in-process rate limiting.
+//
+// At most size buckets are kept, the least recently used bucket is evicted to make room for a new one.
+// A bucket evicted and created again would allow a fresh burst, so once the limiter is full
+// new buckets start empty and only allow requests at the rate of the limit.
+// The size should be larger than the number of keys (eg. active subjects) limited at once,
+// otherwise keys seen for the first time are rejected until their bucket fills up.
+type Limiter struct {
+	buckets *lru.Cache[string, *rate.Limiter]
+	size    int
+
+	// mu guards bucket creation
+	mu sync.Mutex
+}
+
+// NewLimiter returns a new {Limiter} keeping at most size buckets in memory.
+func NewLimiter(size int) (*Limiter, error) {
+	if size == 0 {
+		size = defaultSize
+	}
+
+	buckets, err := lru.New[string, *rate.Limiter](size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Limiter{
+		buckets: buckets,
+		size:    size,
+	}, nil
+}
+
+// Allow implements the {ratelimit.Limiter} interface.
+func (l *Limiter) Allow(_ context.Context, requests ...ratelimit.Request) (ratelimit.Result, error) {
+	now := time.Now()
+
+	reservations := make([]*rate.Reservation, 0, len(requests))
+
+	// Give the tokens back: the requests are rejected, not delayed
+	cancel := func() {
+		for _, reservation := range reservations {
+			reservation.CancelAt(now)
+		}
+	}
+
+	for i, request := range requests {
+		reservation := l.bucket(now, request.Key, request.Limit).ReserveN(now, request.Tokens)
+		if !reservation.OK() {
+			cancel()
+
+			return ratelimit.Result{Allowed: false, Rejected: i}, nil
+		}
+
+		reservations = append(reservations, reservation)
+
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			cancel()
+
+			return ratelimit.Result{Allowed: false, Rejected: i, RetryAfter: delay}, nil
+		}
+	}
+
+	return ratelimit.Result{Allowed: true}, nil
+}
+
+func (l *Limiter) bucket(now time.Time, key string, limit ratelimit.Limit) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	bucket, ok := l.buckets.Get(key)
+	if !ok {
+		bucket = rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)
+
+		// The key may have been evicted before, it must not get a fresh burst
+		if l.buckets.Len() >= l.size {
+			bucket.ReserveN(now, limit.Burst)
+		}
+
+		l.buckets.Add(key, bucket)
+	}
+
+	return bucket
+}
@@ -0,0 +1,83 @@
+package memoryratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/ratelimit"
+	"github.com/openmeterio/openmeter/internal/ratelimit/memoryratelimit"
+)
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	limiter, err := memoryratelimit.NewLimiter(128)
+	require.NoError(t, err)
+
+	limit := ratelimit.Limit{Rate: 1, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		res, err := limiter.Allow(ctx, ratelimit.Request{Key: "a", Limit: limit, Tokens: 1})
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+	}
+
+	res, err := limiter.Allow(ctx, ratelimit.Request{Key: "a", Limit: limit, Tokens: 1})
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Greater(t, res.RetryAfter, time.Duration(0))
+
+	// Buckets are independent
+	res, err = limiter.Allow(ctx, ratelimit.Request{Key: "b", Limit: limit, Tokens: 1})
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// Tokens are taken from none of the buckets if any of them is short
+	res, err = limiter.Allow(ctx,
+		ratelimit.Request{Key: "c", Limit: limit, Tokens: 2},
+		ratelimit.Request{Key: "b", Limit: limit, Tokens: 2},
+	)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 1, res.Rejected)
+
+	res, err = limiter.Allow(ctx, ratelimit.Request{Key: "c", Limit: limit, Tokens: 2})
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// Requests over the burst are never allowed
+	res, err = limiter.Allow(ctx, ratelimit.Request{Key: "d", Limit: limit, Tokens: 3})
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Zero(t, res.RetryAfter)
+}
+
+func TestLimiterOverCapacity(t *testing.T) {
+	ctx := context.Background()
+
+	limiter, err := memoryratelimit.NewLimiter(2)
+	require.NoError(t, err)
+
+	limit := ratelimit.Limit{Rate: 1, Burst: 2}
+
+	// The first keys get their burst
+	for _, key := range []string{"a", "b"} {
+		res, err := limiter.Allow(ctx, ratelimit.Request{Key: key, Limit: limit, Tokens: 2})
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+	}
+
+	// Cycling through more keys than the capacity evicts and recreates buckets, the limit still applies
+	for i := 0; i < 3; i++ {
+		for _, key := range []string{"a", "b", "c", "d"} {
+			res, err := limiter.Allow(ctx, ratelimit.Request{Key: key, Limit: limit, Tokens: 1})
+			require.NoError(t, err)
+			assert.False(t, res.Allowed, key)
+			assert.Greater(t, res.RetryAfter, time.Duration(0))
+		}
+	}
+}
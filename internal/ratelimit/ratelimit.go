@@ -0,0 +1,46 @@
+// Package ratelimit implements token bucket rate limiting.
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit describes a token bucket.
+type Limit struct {
+	// Rate is the number of tokens added to the bucket per second.
+	Rate float64
+	// Burst is the maximum number of tokens in the bucket.
+	Burst int
+}
+
+// IsZero reports whether the limit is unset.
+func (l Limit) IsZero() bool {
+	return l.Rate <= 0 || l.Burst <= 0
+}
+
+// Request asks for tokens from the bucket identified by Key.
+type Request struct {
+	Key   string
+	Limit Limit
+	// Tokens is the number of tokens to take, a request for more tokens than the burst is never allowed.
+	Tokens int
+}
+
+// Result is the outcome of taking tokens from buckets.
+type Result struct {
+	Allowed bool
+	// Rejected is the index of the first request that is not allowed.
+	Rejected int
+	// RetryAfter is the time until the tokens of the rejected request become available, zero if they never do.
+	RetryAfter time.Duration
+}
+
+// Limiter takes tokens from buckets identified by a key.
+type Limiter interface {
+	// Allow takes the tokens of every request if all buckets hold enough tokens, otherwise it takes none.
+	//
+	// Keys of a single call should share a Redis Cluster hash tag (eg. "{namespace}:subject"),
+	// so they are updated at once in a cluster.
+	Allow(ctx context.Context, requests ...Request) (Result, error)
+}
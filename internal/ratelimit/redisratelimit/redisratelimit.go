@@ -0,0 +1,101 @@
+// Package redisratelimit implements rate limiting shared between instances using Redis.
+package redisratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/openmeterio/openmeter/internal/ratelimit"
+)
+
+// tokenBucketScript refills the buckets based on the elapsed time and takes the requested tokens from all of them if every bucket holds enough.
+//
+// Returns whether the tokens were taken, the (1-based) index of the first bucket without enough tokens
+// and the number of milliseconds until its tokens become available (-1 if they never do).
+var tokenBucketScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local buckets = {}
+
+for i, key in ipairs(KEYS) do
+	local rate = tonumber(ARGV[i * 3 - 1])
+	local burst = tonumber(ARGV[i * 3])
+	local requested = tonumber(ARGV[i * 3 + 1])
+
+	local bucket = redis.call("HMGET", key, "tokens", "ts")
+	local tokens = tonumber(bucket[1]) or burst
+	local ts = tonumber(bucket[2]) or now
+
+	tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+
+	if requested > burst then
+		return {0, i, -1}
+	end
+
+	if tokens < requested then
+		return {0, i, math.ceil((requested - tokens) * 1000 / rate)}
+	end
+
+	buckets[i] = {key = key, tokens = tokens - requested, ttl = math.ceil(burst * 1000 / rate) + 1000}
+end
+
+for _, bucket in ipairs(buckets) do
+	redis.call("HSET", bucket.key, "tokens", bucket.tokens, "ts", now)
+	redis.call("PEXPIRE", bucket.key, bucket.ttl)
+end
+
+return {1, 0, 0}
+`)
+
+// Limiter implements rate limiting shared between instances using Redis.
+type Limiter struct {
+	Redis redis.UniversalClient
+}
+
+// Allow implements the {ratelimit.Limiter} interface.
+func (l Limiter) Allow(ctx context.Context, requests ...ratelimit.Request) (ratelimit.Result, error) {
+	if l.Redis == nil {
+		return ratelimit.Result{}, errors.New("redis client not initialized")
+	}
+
+	if len(requests) == 0 {
+		return ratelimit.Result{Allowed: true}, nil
+	}
+
+	keys := make([]string, 0, len(requests))
+	args := make([]any, 0, len(requests)*3+1)
+	args = append(args, time.Now().UnixMilli())
+
+	for _, request := range requests {
+		keys = append(keys, "ratelimit:"+request.Key)
+		args = append(args, request.Limit.Rate, request.Limit.Burst, request.Tokens)
+	}
+
+	res, err := tokenBucketScript.Run(ctx, l.Redis, keys, args...).Int64Slice()
+	if err != nil {
+		return ratelimit.Result{}, fmt.Errorf("running token bucket script: %w", err)
+	}
+
+	if len(res) != 3 {
+		return ratelimit.Result{}, fmt.Errorf("unexpected token bucket script result: %v", res)
+	}
+
+	if res[0] == 1 {
+		return ratelimit.Result{Allowed: true}, nil
+	}
+
+	result := ratelimit.Result{
+		Allowed:  false,
+		Rejected: int(res[1]) - 1,
+	}
+
+	if res[2] >= 0 {
+		result.RetryAfter = time.Duration(math.Max(float64(res[2]), 1)) * time.Millisecond
+	}
+
+	return result, nil
+}
@@ -0,0 +1,23 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/openmeterio/openmeter/api"
+	"github.com/openmeterio/openmeter/pkg/contextx"
+	"github.com/openmeterio/openmeter/pkg/models"
+)
+
+// Check the entitlement of a subject to a feature, GET /api/v1/ledgers/entitlement
+func (a *Router) CheckLedgerEntitlement(w http.ResponseWriter, r *http.Request, params api.CheckLedgerEntitlementParams) {
+	if a.config.EntitlementCache == nil {
+		ctx := contextx.WithAttr(r.Context(), "operation", "checkLedgerEntitlement")
+		err := errors.New("not implemented: entitlement checks require entitlements to be enabled")
+
+		models.NewStatusProblem(ctx, err, http.StatusNotImplemented).Respond(w)
+		return
+	}
+
+	a.CreditHandlers.CheckLedgerEntitlement.With(params).ServeHTTP(w, r)
+}
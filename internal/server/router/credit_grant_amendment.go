@@ -0,0 +1,24 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/openmeterio/openmeter/api"
+	"github.com/openmeterio/openmeter/internal/credit/creditdriver"
+)
+
+// List grant amendments, GET /api/v1/ledgers/{ledgerID}/grants/{ledgerGrantID}/amendments
+func (a *Router) ListLedgerGrantAmendments(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID, grantID api.LedgerGrantID) {
+	a.CreditHandlers.ListLedgerGrantAmendments.With(creditdriver.GrantPathParams{
+		LedgerID: ledgerID,
+		GrantID:  grantID,
+	}).ServeHTTP(w, r)
+}
+
+// Amend grant, POST /api/v1/ledgers/{ledgerID}/grants/{ledgerGrantID}/amendments
+func (a *Router) AmendLedgerGrant(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID, grantID api.LedgerGrantID) {
+	a.CreditHandlers.AmendLedgerGrant.With(creditdriver.GrantPathParams{
+		LedgerID: ledgerID,
+		GrantID:  grantID,
+	}).ServeHTTP(w, r)
+}
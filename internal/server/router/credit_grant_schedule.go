@@ -0,0 +1,34 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/openmeterio/openmeter/api"
+	"github.com/openmeterio/openmeter/internal/credit/creditdriver"
+)
+
+// List grant schedules, GET /api/v1/ledgers/{ledgerID}/grant-schedules
+func (a *Router) ListLedgerGrantSchedules(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID) {
+	a.CreditHandlers.ListLedgerGrantSchedules.With(ledgerID).ServeHTTP(w, r)
+}
+
+// Create grant schedule, POST /api/v1/ledgers/{ledgerID}/grant-schedules
+func (a *Router) CreateLedgerGrantSchedule(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID) {
+	a.CreditHandlers.CreateLedgerGrantSchedule.With(ledgerID).ServeHTTP(w, r)
+}
+
+// Get grant schedule, GET /api/v1/ledgers/{ledgerID}/grant-schedules/{ledgerGrantScheduleID}
+func (a *Router) GetLedgerGrantSchedule(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID, scheduleID api.LedgerGrantScheduleID) {
+	a.CreditHandlers.GetLedgerGrantSchedule.With(creditdriver.GrantSchedulePathParams{
+		LedgerID:   ledgerID,
+		ScheduleID: scheduleID,
+	}).ServeHTTP(w, r)
+}
+
+// Delete grant schedule, DELETE /api/v1/ledgers/{ledgerID}/grant-schedules/{ledgerGrantScheduleID}
+func (a *Router) DeleteLedgerGrantSchedule(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID, scheduleID api.LedgerGrantScheduleID) {
+	a.CreditHandlers.DeleteLedgerGrantSchedule.With(creditdriver.GrantSchedulePathParams{
+		LedgerID:   ledgerID,
+		ScheduleID: scheduleID,
+	}).ServeHTTP(w, r)
+}
@@ -24,3 +24,18 @@ func (a *Router) CreateLedger(w http.ResponseWriter, r *http.Request) {
 func (a *Router) ListLedgers(w http.ResponseWriter, r *http.Request, params api.ListLedgersParams) {
 	a.CreditHandlers.ListLedgers.With(params).ServeHTTP(w, r)
 }
+
+// UpdateLedgerOverageLimits PUT /api/v1/ledgers/{ledgerID}/overage-limits
+func (a *Router) UpdateLedgerOverageLimits(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID) {
+	a.CreditHandlers.UpdateLedgerOverageLimits.With(ledgerID).ServeHTTP(w, r)
+}
+
+// UpdateLedgerUsagePeriod PUT /api/v1/ledgers/{ledgerID}/usage-period
+func (a *Router) UpdateLedgerUsagePeriod(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID) {
+	a.CreditHandlers.UpdateLedgerUsagePeriod.With(ledgerID).ServeHTTP(w, r)
+}
+
+// DeleteLedgerUsagePeriod DELETE /api/v1/ledgers/{ledgerID}/usage-period
+func (a *Router) DeleteLedgerUsagePeriod(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID) {
+	a.CreditHandlers.DeleteLedgerUsagePeriod.With(ledgerID).ServeHTTP(w, r)
+}
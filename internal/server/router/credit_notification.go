@@ -0,0 +1,45 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/openmeterio/openmeter/api"
+	"github.com/openmeterio/openmeter/internal/credit/creditdriver"
+)
+
+// List notification rules, GET /api/v1/ledgers/{ledgerID}/notification-rules
+func (a *Router) ListLedgerNotificationRules(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID) {
+	a.CreditHandlers.ListLedgerNotificationRules.With(ledgerID).ServeHTTP(w, r)
+}
+
+// Create notification rule, POST /api/v1/ledgers/{ledgerID}/notification-rules
+func (a *Router) CreateLedgerNotificationRule(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID) {
+	a.CreditHandlers.CreateLedgerNotificationRule.With(ledgerID).ServeHTTP(w, r)
+}
+
+// Get notification rule, GET /api/v1/ledgers/{ledgerID}/notification-rules/{ledgerNotificationRuleID}
+func (a *Router) GetLedgerNotificationRule(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID, ruleID api.LedgerNotificationRuleID) {
+	a.CreditHandlers.GetLedgerNotificationRule.With(creditdriver.NotificationRulePathParams{
+		LedgerID: ledgerID,
+		RuleID:   ruleID,
+	}).ServeHTTP(w, r)
+}
+
+// Delete notification rule, DELETE /api/v1/ledgers/{ledgerID}/notification-rules/{ledgerNotificationRuleID}
+func (a *Router) DeleteLedgerNotificationRule(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID, ruleID api.LedgerNotificationRuleID) {
+	a.CreditHandlers.DeleteLedgerNotificationRule.With(creditdriver.NotificationRulePathParams{
+		LedgerID: ledgerID,
+		RuleID:   ruleID,
+	}).ServeHTTP(w, r)
+}
+
+// List notification deliveries, GET /api/v1/ledgers/{ledgerID}/notification-rules/{ledgerNotificationRuleID}/deliveries
+func (a *Router) ListLedgerNotificationDeliveries(w http.ResponseWriter, r *http.Request, ledgerID api.LedgerID, ruleID api.LedgerNotificationRuleID, params api.ListLedgerNotificationDeliveriesParams) {
+	a.CreditHandlers.ListLedgerNotificationDeliveries.With(creditdriver.ListLedgerNotificationDeliveriesRequest{
+		NotificationRulePathParams: creditdriver.NotificationRulePathParams{
+			LedgerID: ledgerID,
+			RuleID:   ruleID,
+		},
+		ListLedgerNotificationDeliveriesParams: params,
+	}).ServeHTTP(w, r)
+}
@@ -41,3 +41,36 @@ func (a *Router) ListEvents(w http.ResponseWriter, r *http.Request, params api.L
 
 	render.JSON(w, r, events)
 }
+
+// GetFreshness returns how complete the stored usage data of the namespace is.
+func (a *Router) GetFreshness(w http.ResponseWriter, r *http.Request) {
+	ctx := contextx.WithAttr(r.Context(), "operation", "getFreshness")
+
+	namespace := a.config.NamespaceManager.GetDefaultNamespace()
+
+	freshness, err := a.config.StreamingConnector.GetFreshness(ctx, namespace)
+	if err != nil {
+		err := fmt.Errorf("get freshness: %w", err)
+
+		a.config.ErrorHandler.HandleContext(ctx, err)
+		models.NewStatusProblem(ctx, err, http.StatusInternalServerError).Respond(w)
+
+		return
+	}
+
+	resp := api.Freshness{
+		CompleteUntil: freshness.CompleteUntil,
+		Partitions:    make([]api.PartitionFreshness, 0, len(freshness.Partitions)),
+	}
+
+	for _, partition := range freshness.Partitions {
+		resp.Partitions = append(resp.Partitions, api.PartitionFreshness{
+			Partition:     partition.Partition,
+			Offset:        partition.Offset,
+			CompleteUntil: partition.CompleteUntil,
+			UpdatedAt:     partition.UpdatedAt,
+		})
+	}
+
+	render.JSON(w, r, resp)
+}
@@ -0,0 +1,58 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/openmeterio/openmeter/api"
+	"github.com/openmeterio/openmeter/pkg/contextx"
+	"github.com/openmeterio/openmeter/pkg/models"
+)
+
+// List event types: GET /api/v1/event-types
+func (a *Router) ListEventTypes(w http.ResponseWriter, r *http.Request) {
+	if a.config.EventTypeConnector == nil {
+		a.eventTypesNotImplemented(w, r, "listEventTypes")
+		return
+	}
+
+	a.EventTypeHandlers.ListEventTypes.ServeHTTP(w, r)
+}
+
+// Upsert event type: POST /api/v1/event-types
+func (a *Router) UpsertEventType(w http.ResponseWriter, r *http.Request) {
+	if a.config.EventTypeConnector == nil {
+		a.eventTypesNotImplemented(w, r, "upsertEventType")
+		return
+	}
+
+	a.EventTypeHandlers.UpsertEventType.ServeHTTP(w, r)
+}
+
+// Get event type: GET /api/v1/event-types/{eventType}
+func (a *Router) GetEventType(w http.ResponseWriter, r *http.Request, eventType api.EventTypeName) {
+	if a.config.EventTypeConnector == nil {
+		a.eventTypesNotImplemented(w, r, "getEventType")
+		return
+	}
+
+	a.EventTypeHandlers.GetEventType.With(eventType).ServeHTTP(w, r)
+}
+
+// Delete event type: DELETE /api/v1/event-types/{eventType}
+func (a *Router) DeleteEventType(w http.ResponseWriter, r *http.Request, eventType api.EventTypeName) {
+	if a.config.EventTypeConnector == nil {
+		a.eventTypesNotImplemented(w, r, "deleteEventType")
+		return
+	}
+
+	a.EventTypeHandlers.DeleteEventType.With(eventType).ServeHTTP(w, r)
+}
+
+func (a *Router) eventTypesNotImplemented(w http.ResponseWriter, r *http.Request, operation string) {
+	ctx := contextx.WithAttr(r.Context(), "operation", operation)
+
+	err := fmt.Errorf("not implemented: registering event types requires postgres")
+
+	models.NewStatusProblem(ctx, err, http.StatusNotImplemented).Respond(w)
+}
@@ -21,6 +21,11 @@ func (a *Router) CreateFeature(w http.ResponseWriter, r *http.Request) {
 	a.CreditHandlers.CreateFeature.ServeHTTP(w, r)
 }
 
+// Update feature, PATCH:/api/v1/features/{featureID}
+func (a *Router) UpdateFeature(w http.ResponseWriter, r *http.Request, featureID api.FeatureID) {
+	a.CreditHandlers.UpdateFeature.With(featureID).ServeHTTP(w, r)
+}
+
 // Delete feature, DELETE:/api/v1/features/{featureID}
 func (a *Router) DeleteFeature(w http.ResponseWriter, r *http.Request, featureID api.FeatureID) {
 	a.CreditHandlers.DeleteFeature.With(featureID).ServeHTTP(w, r)
@@ -121,22 +121,63 @@ func (a *Router) QueryMeterWithMeter(ctx context.Context, w http.ResponseWriter,
 		return
 	}
 
-	// Query connector
-	data, err := a.config.StreamingConnector.QueryMeter(ctx, meter.Namespace, meter.Slug, queryParams)
+	excludeIncomplete := params.ExcludeIncomplete != nil && *params.ExcludeIncomplete
+
+	// Usage data freshness is informational unless incomplete windows are excluded
+	freshness, err := a.config.StreamingConnector.GetFreshness(ctx, meter.Namespace)
 	if err != nil {
-		err := fmt.Errorf("query meter: %w", err)
+		err := fmt.Errorf("get freshness: %w", err)
 
-		a.config.ErrorHandler.HandleContext(ctx, err)
-		models.NewStatusProblem(ctx, err, http.StatusInternalServerError).Respond(w)
+		if excludeIncomplete {
+			a.config.ErrorHandler.HandleContext(ctx, err)
+			models.NewStatusProblem(ctx, err, http.StatusInternalServerError).Respond(w)
 
-		return
+			return
+		}
+
+		logger.Warn("failed to get usage data freshness", "error", err)
+	}
+
+	// Limit the query to complete windows, the range is empty if nothing is complete in it yet
+	emptyRange := false
+	if excludeIncomplete && freshness.CompleteUntil != nil {
+		windowSize := meter.WindowSize
+		if params.WindowSize != nil {
+			windowSize = *params.WindowSize
+		}
+
+		completeTo := truncateToWindow(*freshness.CompleteUntil, windowSize, queryParams.WindowTimeZone)
+		if queryParams.To == nil || completeTo.Before(*queryParams.To) {
+			queryParams.To = &completeTo
+
+			if queryParams.From != nil && !completeTo.After(*queryParams.From) {
+				queryParams.To = queryParams.From
+				emptyRange = true
+			}
+		}
+	}
+
+	data := []models.MeterQueryRow{}
+
+	// Query connector
+	if !emptyRange {
+		data, err = a.config.StreamingConnector.QueryMeter(ctx, meter.Namespace, meter.Slug, queryParams)
+		if err != nil {
+			err := fmt.Errorf("query meter: %w", err)
+
+			a.config.ErrorHandler.HandleContext(ctx, err)
+			models.NewStatusProblem(ctx, err, http.StatusInternalServerError).Respond(w)
+
+			return
+		}
 	}
 
 	resp := &QueryMeterResponse{
-		WindowSize: params.WindowSize,
-		From:       params.From,
-		To:         params.To,
-		Data:       data,
+		WindowSize:    params.WindowSize,
+		From:          params.From,
+		To:            queryParams.To,
+		CompleteUntil: freshness.CompleteUntil,
+		Data:          data,
 	}
 
 	// Parse media type
@@ -161,10 +202,12 @@ func (a *Router) QueryMeterWithMeter(ctx context.Context, w http.ResponseWriter,
 
 // QueryMeterResponse is returned by the QueryMeter endpoint.
 type QueryMeterResponse struct {
-	WindowSize *models.WindowSize     `json:"windowSize,omitempty"`
-	From       *time.Time             `json:"from,omitempty"`
-	To         *time.Time             `json:"to,omitempty"`
-	Data       []models.MeterQueryRow `json:"data"`
+	WindowSize *models.WindowSize `json:"windowSize,omitempty"`
+	From       *time.Time         `json:"from,omitempty"`
+	To         *time.Time         `json:"to,omitempty"`
+	// CompleteUntil is the time usage data is complete until, later windows may still miss events
+	CompleteUntil *time.Time             `json:"completeUntil,omitempty"`
+	Data          []models.MeterQueryRow `json:"data"`
 }
 
 // Render implements the chi renderer interface.
@@ -227,3 +270,24 @@ func (resp QueryMeterResponse) RenderCSV(w http.ResponseWriter, r *http.Request,
 		slog.Error("writing csv", "error", err)
 	}
 }
+
+// truncateToWindow truncates the time to the start of its window in the time zone of the windows.
+func truncateToWindow(t time.Time, windowSize models.WindowSize, tz *time.Location) time.Time {
+	if tz == nil {
+		tz = time.UTC
+	}
+
+	local := t.In(tz)
+
+	var start time.Time
+	switch windowSize {
+	case models.WindowSizeMinute:
+		start = time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), local.Minute(), 0, 0, tz)
+	case models.WindowSizeHour:
+		start = time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), 0, 0, 0, tz)
+	default:
+		start = time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, tz)
+	}
+
+	return start.In(t.Location())
+}
@@ -11,11 +11,15 @@ import (
 	"github.com/openmeterio/openmeter/api"
 	"github.com/openmeterio/openmeter/internal/credit"
 	"github.com/openmeterio/openmeter/internal/credit/creditdriver"
+	"github.com/openmeterio/openmeter/internal/eventtype"
+	"github.com/openmeterio/openmeter/internal/eventtype/eventtypedriver"
 	"github.com/openmeterio/openmeter/internal/meter"
 	"github.com/openmeterio/openmeter/internal/namespace"
 	"github.com/openmeterio/openmeter/internal/namespace/namespacedriver"
 	"github.com/openmeterio/openmeter/internal/server/authenticator"
 	"github.com/openmeterio/openmeter/internal/streaming"
+	"github.com/openmeterio/openmeter/internal/subject"
+	"github.com/openmeterio/openmeter/internal/subject/subjectdriver"
 	"github.com/openmeterio/openmeter/pkg/errorsx"
 	"github.com/openmeterio/openmeter/pkg/framework/transport/httptransport"
 )
@@ -47,11 +51,23 @@ type Config struct {
 	PortalCORSEnabled   bool
 	PortalTokenStrategy *authenticator.PortalTokenStrategy
 	ErrorHandler        errorsx.Handler
+
+	// SubjectAliasConnector is optional, subject alias endpoints are not implemented without it
+	SubjectAliasConnector subject.AliasConnector
+	SubjectAliasCache     subjectdriver.AliasCache
+
+	// EventTypeConnector is optional, event type endpoints are not implemented without it
+	EventTypeConnector eventtype.Connector
+
+	// EntitlementCache is optional, entitlement checks are not implemented without it
+	EntitlementCache creditdriver.EntitlementCache
 }
 
 type Router struct {
-	CreditHandlers creditdriver.Handlers
-	config         Config
+	CreditHandlers    creditdriver.Handlers
+	SubjectHandlers   subjectdriver.Handlers
+	EventTypeHandlers eventtypedriver.Handlers
+	config            Config
 }
 
 // Make sure we conform to ServerInterface
@@ -65,10 +81,28 @@ func NewRouter(config Config) (*Router, error) {
 	if config.CreditConnector != nil {
 		router.CreditHandlers = creditdriver.New(
 			config.CreditConnector,
+			config.EntitlementCache,
 			config.Meters,
 			namespacedriver.StaticNamespaceDecoder("default"),
 			httptransport.WithErrorHandler(config.ErrorHandler),
 		)
 	}
+
+	if config.SubjectAliasConnector != nil {
+		router.SubjectHandlers = subjectdriver.New(
+			config.SubjectAliasConnector,
+			config.SubjectAliasCache,
+			namespacedriver.StaticNamespaceDecoder("default"),
+			httptransport.WithErrorHandler(config.ErrorHandler),
+		)
+	}
+
+	if config.EventTypeConnector != nil {
+		router.EventTypeHandlers = eventtypedriver.New(
+			config.EventTypeConnector,
+			namespacedriver.StaticNamespaceDecoder("default"),
+			httptransport.WithErrorHandler(config.ErrorHandler),
+		)
+	}
 	return router, nil
 }
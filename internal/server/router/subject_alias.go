@@ -0,0 +1,58 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/openmeterio/openmeter/api"
+	"github.com/openmeterio/openmeter/pkg/contextx"
+	"github.com/openmeterio/openmeter/pkg/models"
+)
+
+// List subject aliases: GET /api/v1/subject-aliases
+func (a *Router) ListSubjectAliases(w http.ResponseWriter, r *http.Request) {
+	if a.config.SubjectAliasConnector == nil {
+		a.subjectAliasesNotImplemented(w, r, "listSubjectAliases")
+		return
+	}
+
+	a.SubjectHandlers.ListSubjectAliases.ServeHTTP(w, r)
+}
+
+// Upsert subject alias: POST /api/v1/subject-aliases
+func (a *Router) UpsertSubjectAlias(w http.ResponseWriter, r *http.Request) {
+	if a.config.SubjectAliasConnector == nil {
+		a.subjectAliasesNotImplemented(w, r, "upsertSubjectAlias")
+		return
+	}
+
+	a.SubjectHandlers.UpsertSubjectAlias.ServeHTTP(w, r)
+}
+
+// Get subject alias: GET /api/v1/subject-aliases/{subjectAliasID}
+func (a *Router) GetSubjectAlias(w http.ResponseWriter, r *http.Request, subjectAliasID api.SubjectAliasID) {
+	if a.config.SubjectAliasConnector == nil {
+		a.subjectAliasesNotImplemented(w, r, "getSubjectAlias")
+		return
+	}
+
+	a.SubjectHandlers.GetSubjectAlias.With(subjectAliasID).ServeHTTP(w, r)
+}
+
+// Delete subject alias: DELETE /api/v1/subject-aliases/{subjectAliasID}
+func (a *Router) DeleteSubjectAlias(w http.ResponseWriter, r *http.Request, subjectAliasID api.SubjectAliasID) {
+	if a.config.SubjectAliasConnector == nil {
+		a.subjectAliasesNotImplemented(w, r, "deleteSubjectAlias")
+		return
+	}
+
+	a.SubjectHandlers.DeleteSubjectAlias.With(subjectAliasID).ServeHTTP(w, r)
+}
+
+func (a *Router) subjectAliasesNotImplemented(w http.ResponseWriter, r *http.Request, operation string) {
+	ctx := contextx.WithAttr(r.Context(), "operation", operation)
+
+	err := fmt.Errorf("not implemented: subject aliases require subject aliasing to be enabled")
+
+	models.NewStatusProblem(ctx, err, http.StatusNotImplemented).Respond(w)
+}
@@ -59,6 +59,7 @@ var (
 		WindowEnd:   time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC),
 		Value:       300,
 	}
+	mockCompleteUntil = time.Date(2021, 1, 1, 0, 30, 0, 0, time.UTC)
 )
 
 type MockConnector struct{}
@@ -94,6 +95,17 @@ func (c *MockConnector) ListMeterSubjects(ctx context.Context, namespace string,
 	return []string{"s1"}, nil
 }
 
+func (c *MockConnector) GetFreshness(ctx context.Context, namespace string) (streaming.Freshness, error) {
+	completeUntil := mockCompleteUntil
+
+	return streaming.Freshness{
+		CompleteUntil: &completeUntil,
+		Partitions: []streaming.PartitionFreshness{
+			{Partition: 0, Offset: 10, CompleteUntil: completeUntil, UpdatedAt: completeUntil},
+		},
+	}, nil
+}
+
 type MockHandler struct{}
 
 func (h MockHandler) ServeHTTP(w http.ResponseWriter, r *http.Request, namespace string) {
@@ -136,6 +148,7 @@ type testRequest struct {
 	path        string
 	accept      string
 	contentType string
+	headers     map[string]string
 	body        interface{}
 }
 
@@ -170,6 +183,25 @@ func TestRoutes(t *testing.T) {
 				status: http.StatusNoContent,
 			},
 		},
+		{
+			name: "ingest event in binary content mode",
+			req: testRequest{
+				method:      http.MethodPost,
+				path:        "/api/v1/events",
+				contentType: "text/plain",
+				headers: map[string]string{
+					"ce-specversion": "1.0",
+					"ce-id":          "test-1",
+					"ce-type":        "type",
+					"ce-subject":     "subject",
+					"ce-source":      "source",
+				},
+				body: "data",
+			},
+			res: testResponse{
+				status: http.StatusNoContent,
+			},
+		},
 		{
 			name: "query events",
 			req: testRequest{
@@ -184,6 +216,23 @@ func TestRoutes(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "get freshness",
+			req: testRequest{
+				method:      http.MethodGet,
+				path:        "/api/v1/freshness",
+				contentType: "application/json",
+			},
+			res: testResponse{
+				status: http.StatusOK,
+				body: api.Freshness{
+					CompleteUntil: &mockCompleteUntil,
+					Partitions: []api.PartitionFreshness{
+						{Partition: 0, Offset: 10, CompleteUntil: mockCompleteUntil, UpdatedAt: mockCompleteUntil},
+					},
+				},
+			},
+		},
 		// Meters
 		{
 			name: "list meters",
@@ -247,8 +296,10 @@ func TestRoutes(t *testing.T) {
 			res: testResponse{
 				status: http.StatusOK,
 				body: struct {
-					Data []models.MeterQueryRow `json:"data"`
+					CompleteUntil time.Time              `json:"completeUntil"`
+					Data          []models.MeterQueryRow `json:"data"`
 				}{
+					CompleteUntil: mockCompleteUntil,
 					Data: []models.MeterQueryRow{
 						{Subject: nil, WindowStart: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), WindowEnd: time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC), Value: 300},
 					},
@@ -298,8 +349,10 @@ func TestRoutes(t *testing.T) {
 			res: testResponse{
 				status: http.StatusOK,
 				body: struct {
-					Data []models.MeterQueryRow `json:"data"`
+					CompleteUntil time.Time              `json:"completeUntil"`
+					Data          []models.MeterQueryRow `json:"data"`
 				}{
+					CompleteUntil: mockCompleteUntil,
 					Data: []models.MeterQueryRow{
 						{Subject: mockQueryValue.Subject, WindowStart: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), WindowEnd: time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC), Value: 300},
 					},
@@ -316,8 +369,58 @@ func TestRoutes(t *testing.T) {
 			res: testResponse{
 				status: http.StatusOK,
 				body: struct {
-					Data []models.MeterQueryRow `json:"data"`
+					CompleteUntil time.Time              `json:"completeUntil"`
+					Data          []models.MeterQueryRow `json:"data"`
 				}{
+					CompleteUntil: mockCompleteUntil,
+					Data: []models.MeterQueryRow{
+						{Subject: nil, WindowStart: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), WindowEnd: time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC), Value: 300},
+					},
+				},
+			},
+		},
+		{
+			name: "query meter excluding incomplete windows",
+			req: testRequest{
+				method:      http.MethodGet,
+				contentType: "application/json",
+				path:        "/api/v1/meters/" + mockMeters[0].Slug + "/query?from=2021-01-01T01:00:00Z&excludeIncomplete=true",
+			},
+			res: testResponse{
+				status: http.StatusOK,
+				body: struct {
+					From          time.Time              `json:"from"`
+					To            time.Time              `json:"to"`
+					CompleteUntil time.Time              `json:"completeUntil"`
+					Data          []models.MeterQueryRow `json:"data"`
+				}{
+					From:          time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC),
+					To:            time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC),
+					CompleteUntil: mockCompleteUntil,
+					Data:          []models.MeterQueryRow{},
+				},
+			},
+		},
+		{
+			name: "query meter excluding incomplete windows in time zone",
+			req: testRequest{
+				method:      http.MethodGet,
+				contentType: "application/json",
+				path:        "/api/v1/meters/" + mockMeters[0].Slug + "/query?from=2020-12-30T05:00:00Z&windowSize=DAY&windowTimeZone=America/New_York&excludeIncomplete=true",
+			},
+			res: testResponse{
+				status: http.StatusOK,
+				body: struct {
+					WindowSize    models.WindowSize      `json:"windowSize"`
+					From          time.Time              `json:"from"`
+					To            time.Time              `json:"to"`
+					CompleteUntil time.Time              `json:"completeUntil"`
+					Data          []models.MeterQueryRow `json:"data"`
+				}{
+					WindowSize:    models.WindowSizeDay,
+					From:          time.Date(2020, 12, 30, 5, 0, 0, 0, time.UTC),
+					To:            time.Date(2020, 12, 31, 5, 0, 0, 0, time.UTC),
+					CompleteUntil: mockCompleteUntil,
 					Data: []models.MeterQueryRow{
 						{Subject: nil, WindowStart: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), WindowEnd: time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC), Value: 300},
 					},
@@ -468,6 +571,78 @@ func TestRoutes(t *testing.T) {
 				status: http.StatusNotImplemented,
 			},
 		},
+		// Subject aliases
+		{
+			name: "upsert subject alias",
+			req: testRequest{
+				method:      http.MethodPost,
+				path:        "/api/v1/subject-aliases",
+				contentType: "application/json",
+				body: api.UpsertSubjectAliasJSONRequestBody{
+					Subject:          "user",
+					CanonicalSubject: "customer",
+				},
+			},
+			res: testResponse{
+				status: http.StatusNotImplemented,
+			},
+		},
+		{
+			name: "list subject aliases",
+			req: testRequest{
+				method: http.MethodGet,
+				path:   "/api/v1/subject-aliases",
+			},
+			res: testResponse{
+				status: http.StatusNotImplemented,
+			},
+		},
+		{
+			name: "delete subject alias",
+			req: testRequest{
+				method: http.MethodDelete,
+				path:   "/api/v1/subject-aliases/abcd",
+			},
+			res: testResponse{
+				status: http.StatusNotImplemented,
+			},
+		},
+		// Event types
+		{
+			name: "upsert event type",
+			req: testRequest{
+				method:      http.MethodPost,
+				path:        "/api/v1/event-types",
+				contentType: "application/json",
+				body: api.UpsertEventTypeJSONRequestBody{
+					Type:   "prompt",
+					Schema: map[string]interface{}{"type": "object"},
+				},
+			},
+			res: testResponse{
+				status: http.StatusNotImplemented,
+			},
+		},
+		{
+			name: "list event types",
+			req: testRequest{
+				method: http.MethodGet,
+				path:   "/api/v1/event-types",
+			},
+			res: testResponse{
+				status: http.StatusNotImplemented,
+			},
+		},
+		{
+			name: "get event type",
+			req: testRequest{
+				method: http.MethodGet,
+				path:   "/api/v1/event-types/prompt",
+			},
+			res: testResponse{
+				status: http.StatusNotImplemented,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -484,6 +659,9 @@ func TestRoutes(t *testing.T) {
 			if tt.req.contentType != "" {
 				req.Header.Set("Content-Type", tt.req.contentType)
 			}
+			for k, v := range tt.req.headers {
+				req.Header.Set(k, v)
+			}
 			w, err := makeRequest(req)
 			assert.NoError(t, err)
 			res := w.Result()
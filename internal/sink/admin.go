@@ -0,0 +1,167 @@
+package sink
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// Status is a snapshot of the state of the sink.
+type Status struct {
+	// Paused is true if consumption was paused by Pause
+	Paused bool `json:"paused"`
+	// ThrottledUntil is set while consumption is throttled because storage is degraded
+	ThrottledUntil *time.Time `json:"throttledUntil,omitempty"`
+	// BufferSize is the number of messages waiting to be flushed
+	BufferSize int `json:"bufferSize"`
+	// BatchSize is the number of messages buffered before flushing
+	BatchSize int `json:"batchSize"`
+	// LastFlushAt is the time of the last successful flush
+	LastFlushAt *time.Time `json:"lastFlushAt,omitempty"`
+	// BackoffNamespaces are the namespaces waiting to retry a failed flush
+	BackoffNamespaces []string          `json:"backoffNamespaces"`
+	Partitions        []PartitionStatus `json:"partitions"`
+}
+
+// PartitionStatus is the consumption state of an assigned partition.
+type PartitionStatus struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+	Namespace string `json:"namespace,omitempty"`
+	// Offset is the offset of the next message to consume, unknown until a message is consumed or an offset is committed
+	Offset *int64 `json:"offset,omitempty"`
+	// HighWatermark is the offset of the next message produced to the partition
+	HighWatermark int64 `json:"highWatermark"`
+	// Lag is the number of messages produced to the partition but not consumed yet
+	Lag *int64 `json:"lag,omitempty"`
+}
+
+// Status returns the state of the sink and the consumption lag of the assigned partitions.
+func (s *Sink) Status() (Status, error) {
+	status := Status{
+		BufferSize:        s.buffer.Size(),
+		BatchSize:         s.batchSizer.Size(),
+		BackoffNamespaces: s.namespaceBackoff.Namespaces(),
+		Partitions:        []PartitionStatus{},
+	}
+
+	s.pauseMu.Lock()
+	status.Paused = s.manuallyPaused
+	if time.Now().Before(s.throttledUntil) {
+		throttledUntil := s.throttledUntil
+		status.ThrottledUntil = &throttledUntil
+	}
+	s.pauseMu.Unlock()
+
+	s.lastFlushMu.Lock()
+	if !s.lastFlushAt.IsZero() {
+		lastFlushAt := s.lastFlushAt
+		status.LastFlushAt = &lastFlushAt
+	}
+	s.lastFlushMu.Unlock()
+
+	assignedPartitions, err := s.config.Consumer.Assignment()
+	if err != nil {
+		return status, fmt.Errorf("failed to get assigned partitions: %w", err)
+	}
+
+	if len(assignedPartitions) == 0 {
+		return status, nil
+	}
+
+	positions, err := s.config.Consumer.Position(assignedPartitions)
+	if err != nil {
+		return status, fmt.Errorf("failed to get partition positions: %w", err)
+	}
+
+	// Partitions without consumed messages since the assignment start from the committed offset
+	committed, err := s.config.Consumer.Committed(assignedPartitions, 5000)
+	if err != nil {
+		return status, fmt.Errorf("failed to get committed offsets: %w", err)
+	}
+
+	committedOffsets := make(map[string]kafka.Offset, len(committed))
+	for _, partition := range committed {
+		committedOffsets[topicPartitionKey(partition)] = partition.Offset
+	}
+
+	for _, partition := range positions {
+		if partition.Topic == nil {
+			continue
+		}
+
+		_, high, err := s.config.Consumer.QueryWatermarkOffsets(*partition.Topic, partition.Partition, 5000)
+		if err != nil {
+			return status, fmt.Errorf("failed to get watermark offsets of %s: %w", topicPartitionKey(partition), err)
+		}
+
+		partitionStatus := PartitionStatus{
+			Topic:         *partition.Topic,
+			Partition:     partition.Partition,
+			HighWatermark: high,
+		}
+
+		if namespace, err := getNamespace(*partition.Topic); err == nil {
+			partitionStatus.Namespace = namespace
+		}
+
+		offset := partition.Offset
+		if offset < 0 {
+			offset = committedOffsets[topicPartitionKey(partition)]
+		}
+
+		if offset >= 0 {
+			position := int64(offset)
+			lag := max(high-position, 0)
+
+			partitionStatus.Offset = &position
+			partitionStatus.Lag = &lag
+		}
+
+		status.Partitions = append(status.Partitions, partitionStatus)
+	}
+
+	sort.Slice(status.Partitions, func(i, j int) bool {
+		if status.Partitions[i].Topic != status.Partitions[j].Topic {
+			return status.Partitions[i].Topic < status.Partitions[j].Topic
+		}
+
+		return status.Partitions[i].Partition < status.Partitions[j].Partition
+	})
+
+	return status, nil
+}
+
+// Pause pauses consumption until Resume is called, buffered messages are still flushed.
+func (s *Sink) Pause() error {
+	s.pauseMu.Lock()
+	s.manuallyPaused = true
+	s.pauseMu.Unlock()
+
+	return s.pause()
+}
+
+// Resume resumes consumption paused by Pause.
+// Partitions of namespaces backing off after a failure stay paused, throttling still applies.
+func (s *Sink) Resume() error {
+	s.pauseMu.Lock()
+	s.manuallyPaused = false
+	s.pauseMu.Unlock()
+
+	return s.resume()
+}
+
+// Flush flushes the buffer without waiting for MinCommitCount or MaxCommitWait.
+// Namespaces backing off after a failure are retried immediately.
+func (s *Sink) Flush() error {
+	s.namespaceBackoff.RetryNow(time.Now())
+
+	return s.flush()
+}
+
+// Refetch refetches namespaces and meters without waiting for NamespaceRefetch.
+func (s *Sink) Refetch() error {
+	return s.subscribeToNamespaces()
+}
@@ -0,0 +1,79 @@
+// Package admin implements an HTTP API to inspect and control a running sink worker.
+package admin
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/openmeterio/openmeter/internal/sink"
+	"github.com/openmeterio/openmeter/pkg/models"
+)
+
+// Sink is the runtime control surface of the sink worker.
+type Sink interface {
+	Status() (sink.Status, error)
+	Pause() error
+	Resume() error
+	Flush() error
+	Refetch() error
+}
+
+// NewHandler returns the admin API of a sink:
+//
+//	GET  /status  returns the sink status with the lag of the assigned partitions
+//	POST /pause   pauses consumption
+//	POST /resume  resumes consumption
+//	POST /flush   flushes the buffer immediately
+//	POST /refetch refetches namespaces and meters immediately
+//
+// Actions respond with the status of the sink after the action.
+func NewHandler(s Sink, logger *slog.Logger) http.Handler {
+	h := handler{
+		sink:   s,
+		logger: logger,
+	}
+
+	r := chi.NewRouter()
+	r.Get("/status", h.status)
+	r.Post("/pause", h.action("pause", s.Pause))
+	r.Post("/resume", h.action("resume", s.Resume))
+	r.Post("/flush", h.action("flush", s.Flush))
+	r.Post("/refetch", h.action("refetch", s.Refetch))
+
+	return r
+}
+
+type handler struct {
+	sink   Sink
+	logger *slog.Logger
+}
+
+func (h handler) status(w http.ResponseWriter, r *http.Request) {
+	status, err := h.sink.Status()
+	if err != nil {
+		h.logger.Error("failed to get sink status", "err", err)
+		models.NewStatusProblem(r.Context(), err, http.StatusInternalServerError).Respond(w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+func (h handler) action(name string, fn func() error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := fn()
+		if err != nil {
+			h.logger.Error("failed to execute admin action", "action", name, "err", err)
+			models.NewStatusProblem(r.Context(), err, http.StatusInternalServerError).Respond(w)
+			return
+		}
+
+		h.logger.Info("executed admin action", "action", name)
+
+		h.status(w, r)
+	}
+}
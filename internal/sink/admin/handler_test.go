@@ -0,0 +1,104 @@
+package admin_test
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/sink"
+	"github.com/openmeterio/openmeter/internal/sink/admin"
+)
+
+type fakeSink struct {
+	paused     bool
+	flushes    int
+	refetches  int
+	flushError error
+}
+
+func (s *fakeSink) Status() (sink.Status, error) {
+	return sink.Status{
+		Paused:     s.paused,
+		BufferSize: 10,
+		BatchSize:  100,
+		Partitions: []sink.PartitionStatus{
+			{Topic: "om_default_events", Partition: 0, Namespace: "default", HighWatermark: 15},
+		},
+	}, nil
+}
+
+func (s *fakeSink) Pause() error {
+	s.paused = true
+	return nil
+}
+
+func (s *fakeSink) Resume() error {
+	s.paused = false
+	return nil
+}
+
+func (s *fakeSink) Flush() error {
+	s.flushes++
+	return s.flushError
+}
+
+func (s *fakeSink) Refetch() error {
+	s.refetches++
+	return nil
+}
+
+func TestHandler(t *testing.T) {
+	s := &fakeSink{}
+	handler := admin.NewHandler(s, slog.Default())
+
+	do := func(method string, path string) (*httptest.ResponseRecorder, sink.Status) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+
+		var status sink.Status
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&status))
+		}
+
+		return w, status
+	}
+
+	w, status := do(http.MethodGet, "/status")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 10, status.BufferSize)
+	assert.Equal(t, "default", status.Partitions[0].Namespace)
+	assert.False(t, status.Paused)
+
+	w, status = do(http.MethodPost, "/pause")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, status.Paused)
+
+	w, status = do(http.MethodPost, "/resume")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.False(t, status.Paused)
+
+	w, _ = do(http.MethodPost, "/flush")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1, s.flushes)
+
+	w, _ = do(http.MethodPost, "/refetch")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1, s.refetches)
+
+	// Actions change state, so they require POST
+	w, _ = do(http.MethodGet, "/flush")
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, 1, s.flushes)
+
+	s.flushError = errors.New("storage unavailable")
+
+	w, _ = do(http.MethodPost, "/flush")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, 2, s.flushes)
+}
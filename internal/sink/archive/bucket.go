@@ -0,0 +1,55 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Bucket stores archive objects.
+type Bucket interface {
+	// Put stores an object, replacing any existing object with the same key.
+	Put(ctx context.Context, key string, data []byte) error
+}
+
+// LocalBucket stores archive objects as files in a local directory.
+type LocalBucket struct {
+	Directory string
+}
+
+// NewLocalBucket returns a new {LocalBucket}.
+func NewLocalBucket(directory string) (*LocalBucket, error) {
+	if directory == "" {
+		return nil, fmt.Errorf("directory is required")
+	}
+
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, fmt.Errorf("create archive directory: %w", err)
+	}
+
+	return &LocalBucket{
+		Directory: directory,
+	}, nil
+}
+
+// Put implements the {Bucket} interface. Files are written to a temporary file first, so readers never see partial objects.
+func (b *LocalBucket) Put(_ context.Context, key string, data []byte) error {
+	path := filepath.Join(b.Directory, filepath.FromSlash(key))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create archive partition directory: %w", err)
+	}
+
+	tmp := path + ".tmp"
+
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write archive file: %w", err)
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("rename archive file: %w", err)
+	}
+
+	return nil
+}
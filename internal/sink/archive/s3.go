@@ -0,0 +1,80 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// S3BucketConfig configures an {S3Bucket}.
+type S3BucketConfig struct {
+	// Endpoint is the URL of an S3-compatible endpoint (eg. MinIO). The AWS endpoint of the region is used when empty.
+	Endpoint string
+	Region   string
+	Bucket   string
+	// Prefix is prepended to every object key.
+	Prefix string
+
+	AccessKeyID     string
+	SecretAccessKey string
+
+	// UsePathStyle addresses the bucket in the path instead of the host name, required by most S3-compatible endpoints.
+	UsePathStyle bool
+}
+
+// S3Bucket stores archive objects in an S3-compatible bucket.
+type S3Bucket struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+// NewS3Bucket returns a new {S3Bucket}.
+func NewS3Bucket(config S3BucketConfig) (*S3Bucket, error) {
+	if config.Region == "" {
+		return nil, fmt.Errorf("region is required")
+	}
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("bucket is required")
+	}
+
+	options := s3.Options{
+		Region:       config.Region,
+		UsePathStyle: config.UsePathStyle,
+	}
+
+	if config.Endpoint != "" {
+		options.BaseEndpoint = aws.String(config.Endpoint)
+	}
+
+	if config.AccessKeyID != "" {
+		options.Credentials = credentials.NewStaticCredentialsProvider(config.AccessKeyID, config.SecretAccessKey, "")
+	}
+
+	return &S3Bucket{
+		client: s3.New(options),
+		bucket: config.Bucket,
+		prefix: config.Prefix,
+	}, nil
+}
+
+// Put implements the {Bucket} interface.
+func (b *S3Bucket) Put(ctx context.Context, key string, data []byte) error {
+	_, err := b.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(b.bucket),
+		Key:           aws.String(path.Join(b.prefix, key)),
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+		ContentType:   aws.String("application/vnd.apache.parquet"),
+	})
+	if err != nil {
+		return fmt.Errorf("put archive object: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package archive_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/sink"
+	"github.com/openmeterio/openmeter/internal/sink/archive"
+)
+
+// fakeS3 is a minimal stand-in for an S3-compatible endpoint storing objects in memory.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.objects[r.URL.Path] = body
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestS3Bucket(t *testing.T) {
+	s3 := &fakeS3{objects: map[string][]byte{}}
+
+	server := httptest.NewServer(s3)
+	defer server.Close()
+
+	bucket, err := archive.NewS3Bucket(archive.S3BucketConfig{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		Bucket:          "archive",
+		Prefix:          "events",
+		AccessKeyID:     "access-key",
+		SecretAccessKey: "secret-key",
+		UsePathStyle:    true,
+	})
+	require.NoError(t, err)
+
+	storage, err := archive.NewStorage(bucket)
+	require.NoError(t, err)
+
+	hour := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	err = storage.BatchInsert(context.Background(), []sink.SinkMessage{
+		newSinkMessage("default", "1", hour, 10),
+	})
+	require.NoError(t, err)
+
+	data, ok := s3.objects["/archive/events/namespace=default/date=2024-01-02/hour=15/om_default_events-0-10.parquet"]
+	require.True(t, ok, "object not found: %v", s3.objects)
+
+	events, err := parquet.Read[archive.Event](bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "1", events[0].ID)
+}
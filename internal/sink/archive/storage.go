@@ -0,0 +1,162 @@
+// Package archive implements a sink storage archiving raw events to Parquet files for long term retention.
+package archive
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/openmeterio/openmeter/internal/sink"
+)
+
+// Event is a row of an archive file.
+type Event struct {
+	Namespace       string    `parquet:"namespace"`
+	ID              string    `parquet:"id"`
+	Type            string    `parquet:"type"`
+	Source          string    `parquet:"source"`
+	Subject         string    `parquet:"subject"`
+	OriginalSubject string    `parquet:"original_subject,optional"`
+	Time            time.Time `parquet:"time,timestamp(millisecond)"`
+	Data            string    `parquet:"data"`
+	DataContentType string    `parquet:"data_content_type,optional"`
+	ValidationError string    `parquet:"validation_error,optional"`
+
+	// Kafka position of the event for lineage
+	KafkaTopic     string `parquet:"kafka_topic"`
+	KafkaPartition int32  `parquet:"kafka_partition"`
+	KafkaOffset    int64  `parquet:"kafka_offset"`
+}
+
+// Storage archives events to Parquet files partitioned by namespace and hour of the event time.
+//
+// Every batch writes one file per partition named after the Kafka position of its first event,
+// so retrying a batch overwrites the files written by the failed attempt.
+// Batches starting at a different position after a restart may archive events again,
+// the archive is at least once and readers should deduplicate on namespace, source and id.
+//
+// The storage is meant to be the archive storage of the sink, which inserts the events of a namespace flush at once:
+// a file holds the events of a namespace and hour from a single flush. A flush is at most sink.minCommitCount events
+// (500 by default) or the events consumed in sink.maxCommitWait (5s by default), so files are at most a few hundred
+// kilobytes compressed. A namespace with steady traffic gets at least 720 files per hour with the defaults,
+// more when flushes fill up before the wait. Raise the commit count and wait of the sink for larger files,
+// or compact the archive for query engines.
+type Storage struct {
+	bucket Bucket
+}
+
+// NewStorage returns a new {Storage}.
+func NewStorage(bucket Bucket) (*Storage, error) {
+	if bucket == nil {
+		return nil, fmt.Errorf("bucket is required")
+	}
+
+	return &Storage{
+		bucket: bucket,
+	}, nil
+}
+
+var _ sink.Storage = (*Storage)(nil)
+
+type partition struct {
+	namespace string
+	hour      time.Time
+}
+
+// Key returns the object key of an archive file in the partition.
+func (p partition) Key(name string) string {
+	return fmt.Sprintf("namespace=%s/date=%s/hour=%02d/%s.parquet", p.namespace, p.hour.Format(time.DateOnly), p.hour.Hour(), name)
+}
+
+// BatchInsert implements the {sink.Storage} interface.
+func (s *Storage) BatchInsert(ctx context.Context, messages []sink.SinkMessage) error {
+	partitions := map[partition][]Event{}
+
+	for _, message := range messages {
+		event := newEvent(message)
+
+		p := partition{
+			namespace: message.Namespace,
+			hour:      event.Time.Truncate(time.Hour),
+		}
+
+		partitions[p] = append(partitions[p], event)
+	}
+
+	for p, events := range partitions {
+		sort.Slice(events, func(i, j int) bool {
+			if events[i].KafkaTopic != events[j].KafkaTopic {
+				return events[i].KafkaTopic < events[j].KafkaTopic
+			}
+
+			if events[i].KafkaPartition != events[j].KafkaPartition {
+				return events[i].KafkaPartition < events[j].KafkaPartition
+			}
+
+			return events[i].KafkaOffset < events[j].KafkaOffset
+		})
+
+		data, err := encode(events)
+		if err != nil {
+			return fmt.Errorf("encode archive file: %w", err)
+		}
+
+		first := events[0]
+		name := fmt.Sprintf("%s-%d-%d", first.KafkaTopic, first.KafkaPartition, first.KafkaOffset)
+
+		if err := s.bucket.Put(ctx, p.Key(name), data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newEvent(message sink.SinkMessage) Event {
+	event := Event{
+		Namespace:       message.Namespace,
+		ID:              message.Serialized.Id,
+		Type:            message.Serialized.Type,
+		Source:          message.Serialized.Source,
+		Subject:         message.Serialized.Subject,
+		OriginalSubject: message.Serialized.OriginalSubject,
+		Time:            time.Unix(message.Serialized.Time, 0).UTC(),
+		Data:            message.Serialized.Data,
+		DataContentType: message.Serialized.DataContentType,
+	}
+
+	if message.Error != nil {
+		event.ValidationError = message.Error.Error()
+	}
+
+	if message.KafkaMessage != nil {
+		if message.KafkaMessage.TopicPartition.Topic != nil {
+			event.KafkaTopic = *message.KafkaMessage.TopicPartition.Topic
+		}
+
+		event.KafkaPartition = message.KafkaMessage.TopicPartition.Partition
+		event.KafkaOffset = int64(message.KafkaMessage.TopicPartition.Offset)
+	}
+
+	return event
+}
+
+func encode(events []Event) ([]byte, error) {
+	var buf bytes.Buffer
+
+	writer := parquet.NewGenericWriter[Event](&buf, parquet.Compression(&parquet.Zstd))
+
+	if _, err := writer.Write(events); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,99 @@
+package archive_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/ingest/kafkaingest/serializer"
+	"github.com/openmeterio/openmeter/internal/sink"
+	"github.com/openmeterio/openmeter/internal/sink/archive"
+)
+
+func newSinkMessage(namespace string, id string, eventTime time.Time, offset kafka.Offset) sink.SinkMessage {
+	topic := "om_" + namespace + "_events"
+
+	return sink.SinkMessage{
+		Namespace: namespace,
+		KafkaMessage: &kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0, Offset: offset},
+		},
+		Serialized: &serializer.CloudEventsKafkaPayload{
+			Id:      id,
+			Type:    "api-calls",
+			Source:  "source",
+			Subject: "customer-1",
+			Time:    eventTime.Unix(),
+			Data:    `{"duration_ms":100}`,
+		},
+	}
+}
+
+func TestStorage(t *testing.T) {
+	dir := t.TempDir()
+
+	bucket, err := archive.NewLocalBucket(dir)
+	require.NoError(t, err)
+
+	storage, err := archive.NewStorage(bucket)
+	require.NoError(t, err)
+
+	hour := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	invalid := newSinkMessage("default", "3", hour.Add(10*time.Minute), 12)
+	invalid.Error = sink.NewProcessingError("event data value cannot be parsed as float64: not a number", sink.INVALID)
+
+	messages := []sink.SinkMessage{
+		newSinkMessage("default", "2", hour.Add(5*time.Minute), 11),
+		newSinkMessage("default", "1", hour.Add(time.Minute), 10),
+		invalid,
+		newSinkMessage("default", "4", hour.Add(time.Hour), 13),
+		newSinkMessage("other", "5", hour, 7),
+	}
+
+	require.NoError(t, storage.BatchInsert(context.Background(), messages))
+
+	events, err := parquet.ReadFile[archive.Event](filepath.Join(dir, "namespace=default/date=2024-01-02/hour=15/om_default_events-0-10.parquet"))
+	require.NoError(t, err)
+
+	// Events are ordered by Kafka position
+	require.Len(t, events, 3)
+	assert.Equal(t, archive.Event{
+		Namespace:      "default",
+		ID:             "1",
+		Type:           "api-calls",
+		Source:         "source",
+		Subject:        "customer-1",
+		Time:           hour.Add(time.Minute),
+		Data:           `{"duration_ms":100}`,
+		KafkaTopic:     "om_default_events",
+		KafkaPartition: 0,
+		KafkaOffset:    10,
+	}, events[0])
+	assert.Equal(t, "2", events[1].ID)
+	assert.Equal(t, "3", events[2].ID)
+	assert.Equal(t, "event data value cannot be parsed as float64: not a number", events[2].ValidationError)
+
+	events, err = parquet.ReadFile[archive.Event](filepath.Join(dir, "namespace=default/date=2024-01-02/hour=16/om_default_events-0-13.parquet"))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "4", events[0].ID)
+
+	events, err = parquet.ReadFile[archive.Event](filepath.Join(dir, "namespace=other/date=2024-01-02/hour=15/om_other_events-0-7.parquet"))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "5", events[0].ID)
+
+	// Retrying a batch overwrites the same files
+	require.NoError(t, storage.BatchInsert(context.Background(), messages))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*", "*", "*", "*"))
+	require.NoError(t, err)
+	assert.Len(t, files, 3)
+}
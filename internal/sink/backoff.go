@@ -0,0 +1,107 @@
+package sink
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// namespaceBackoff tracks namespaces that failed to flush.
+// Their partitions stay paused and their flush is retried with exponential backoff until it succeeds.
+type namespaceBackoff struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+
+	mu         sync.Mutex
+	namespaces map[string]*namespaceBackoffState
+}
+
+type namespaceBackoffState struct {
+	failures int
+	retryAt  time.Time
+}
+
+func newNamespaceBackoff(initialInterval time.Duration, maxInterval time.Duration) *namespaceBackoff {
+	return &namespaceBackoff{
+		initialInterval: initialInterval,
+		maxInterval:     maxInterval,
+		namespaces:      map[string]*namespaceBackoffState{},
+	}
+}
+
+// Failed records a failed flush and returns the time to wait before the next attempt.
+func (b *namespaceBackoff) Failed(namespace string, now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state, ok := b.namespaces[namespace]
+	if !ok {
+		state = &namespaceBackoffState{}
+		b.namespaces[namespace] = state
+	}
+
+	interval := b.initialInterval
+	for i := 0; i < state.failures && interval < b.maxInterval; i++ {
+		interval *= 2
+	}
+	if interval > b.maxInterval {
+		interval = b.maxInterval
+	}
+
+	state.failures++
+	state.retryAt = now.Add(interval)
+
+	return interval
+}
+
+// Reset clears the backoff of a namespace after a successful flush.
+func (b *namespaceBackoff) Reset(namespace string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.namespaces, namespace)
+}
+
+// Ready returns true if the namespace can be flushed.
+func (b *namespaceBackoff) Ready(namespace string, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state, ok := b.namespaces[namespace]
+
+	return !ok || !now.Before(state.retryAt)
+}
+
+// RetryNow makes every namespace in backoff ready to be flushed, failures still count towards the next backoff.
+func (b *namespaceBackoff) RetryNow(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, state := range b.namespaces {
+		state.retryAt = now
+	}
+}
+
+// Paused returns true if the partitions of the namespace should not be consumed.
+func (b *namespaceBackoff) Paused(namespace string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, ok := b.namespaces[namespace]
+
+	return ok
+}
+
+// Namespaces returns the namespaces in backoff.
+func (b *namespaceBackoff) Namespaces() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	namespaces := make([]string, 0, len(b.namespaces))
+	for namespace := range b.namespaces {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	return namespaces
+}
@@ -0,0 +1,86 @@
+package sink
+
+import (
+	"sync"
+	"time"
+)
+
+// AdaptiveBatchingConfig configures adjusting the batch size to the observed storage insert latency and errors.
+type AdaptiveBatchingConfig struct {
+	Enabled bool
+	// MinBatchSize and MaxBatchSize bound the batch size, the sink starts with MinCommitCount
+	MinBatchSize int
+	MaxBatchSize int
+	// TargetLatency is the storage insert latency the batch size is adjusted to.
+	// Storage is considered degraded when inserts fail or take longer than twice the target latency.
+	TargetLatency time.Duration
+	// MaxThrottle is the maximum time consumption is paused after a flush while storage is degraded.
+	MaxThrottle time.Duration
+}
+
+// batchSizer adjusts the batch size with additive increase and multiplicative decrease:
+// the batch grows while inserts are faster than the target latency and halves when storage is degraded.
+type batchSizer struct {
+	config AdaptiveBatchingConfig
+
+	mu       sync.Mutex
+	size     int
+	throttle time.Duration
+}
+
+func newBatchSizer(config AdaptiveBatchingConfig, initialSize int) *batchSizer {
+	if config.Enabled {
+		initialSize = min(max(initialSize, config.MinBatchSize), config.MaxBatchSize)
+	}
+
+	return &batchSizer{
+		config: config,
+		size:   initialSize,
+	}
+}
+
+// Size returns the number of messages to buffer before flushing.
+func (b *batchSizer) Size() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.size
+}
+
+// Throttle returns the time to keep consumption paused after a flush.
+func (b *batchSizer) Throttle() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.throttle
+}
+
+// Observe adjusts the batch size and throttling after a storage insert.
+func (b *batchSizer) Observe(latency time.Duration, err error) {
+	if !b.config.Enabled {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	// Degraded: shrink batches and slow down consumption
+	case err != nil || latency > 2*b.config.TargetLatency:
+		b.size = max(b.size/2, b.config.MinBatchSize)
+
+		if b.throttle == 0 {
+			b.throttle = b.config.TargetLatency
+		} else {
+			b.throttle = min(2*b.throttle, b.config.MaxThrottle)
+		}
+	// Slow: shrink batches
+	case latency > b.config.TargetLatency:
+		b.size = max(b.size*3/4, b.config.MinBatchSize)
+		b.throttle = 0
+	// Healthy: grow batches
+	default:
+		b.size = min(b.size+max(b.size/10, 1), b.config.MaxBatchSize)
+		b.throttle = 0
+	}
+}
@@ -0,0 +1,61 @@
+package sink
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchSizer(t *testing.T) {
+	sizer := newBatchSizer(AdaptiveBatchingConfig{
+		Enabled:       true,
+		MinBatchSize:  10,
+		MaxBatchSize:  100,
+		TargetLatency: 100 * time.Millisecond,
+		MaxThrottle:   time.Second,
+	}, 50)
+
+	assert.Equal(t, 50, sizer.Size())
+
+	// Fast inserts grow the batch up to the max batch size
+	sizer.Observe(10*time.Millisecond, nil)
+	assert.Equal(t, 55, sizer.Size())
+
+	for i := 0; i < 100; i++ {
+		sizer.Observe(10*time.Millisecond, nil)
+	}
+	assert.Equal(t, 100, sizer.Size())
+	assert.Zero(t, sizer.Throttle())
+
+	// Slow inserts shrink the batch
+	sizer.Observe(150*time.Millisecond, nil)
+	assert.Equal(t, 75, sizer.Size())
+	assert.Zero(t, sizer.Throttle())
+
+	// Degraded storage halves the batch and throttles consumption with backoff
+	sizer.Observe(10*time.Millisecond, errors.New("storage unavailable"))
+	assert.Equal(t, 37, sizer.Size())
+	assert.Equal(t, 100*time.Millisecond, sizer.Throttle())
+
+	for i := 0; i < 10; i++ {
+		sizer.Observe(time.Second, nil)
+	}
+	assert.Equal(t, 10, sizer.Size())
+	assert.Equal(t, time.Second, sizer.Throttle())
+
+	// Recovery stops throttling
+	sizer.Observe(10*time.Millisecond, nil)
+	assert.Equal(t, 11, sizer.Size())
+	assert.Zero(t, sizer.Throttle())
+}
+
+func TestBatchSizerDisabled(t *testing.T) {
+	sizer := newBatchSizer(AdaptiveBatchingConfig{}, 500)
+
+	sizer.Observe(time.Hour, errors.New("storage unavailable"))
+
+	assert.Equal(t, 500, sizer.Size())
+	assert.Zero(t, sizer.Throttle())
+}
@@ -2,47 +2,107 @@ package sink
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 )
 
+// SinkBuffer holds the messages waiting to be flushed grouped by namespace,
+// so namespaces can be flushed independently.
 type SinkBuffer struct {
 	mu   sync.Mutex
-	data map[string]SinkMessage
+	data map[string]map[string]SinkMessage
 }
 
 func NewSinkBuffer() *SinkBuffer {
 	return &SinkBuffer{
-		data: map[string]SinkMessage{},
+		data: map[string]map[string]SinkMessage{},
 	}
 }
 
 func (b *SinkBuffer) Size() int {
 	b.mu.Lock()
 	defer b.mu.Unlock()
-	return len(b.data)
+
+	size := 0
+	for _, messages := range b.data {
+		size += len(messages)
+	}
+	return size
+}
+
+// NamespaceSize returns the number of buffered messages of a namespace.
+func (b *SinkBuffer) NamespaceSize(namespace string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.data[namespace])
+}
+
+// Namespaces returns the namespaces with buffered messages.
+func (b *SinkBuffer) Namespaces() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	namespaces := make([]string, 0, len(b.data))
+	for namespace := range b.data {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
 }
 
 func (b *SinkBuffer) Add(message SinkMessage) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
+	messages, ok := b.data[message.Namespace]
+	if !ok {
+		messages = map[string]SinkMessage{}
+		b.data[message.Namespace] = messages
+	}
 	// Unique identifier for each message (topic + partition + offset)
 	key := message.KafkaMessage.String()
-	b.data[key] = message
+	messages[key] = message
 }
 
 func (b *SinkBuffer) Dequeue() []SinkMessage {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 	list := []SinkMessage{}
-	for key, message := range b.data {
+	for namespace, messages := range b.data {
+		for _, message := range messages {
+			list = append(list, message)
+		}
+		delete(b.data, namespace)
+	}
+	return list
+}
+
+// DequeueNamespace removes and returns the buffered messages of a namespace.
+func (b *SinkBuffer) DequeueNamespace(namespace string) []SinkMessage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	list := []SinkMessage{}
+	for _, message := range b.data[namespace] {
 		list = append(list, message)
-		delete(b.data, key)
 	}
+	delete(b.data, namespace)
 	return list
 }
 
+// PartitionSizes returns the number of buffered messages by topic partition.
+func (b *SinkBuffer) PartitionSizes() map[string]int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sizes := map[string]int{}
+	for _, messages := range b.data {
+		for _, message := range messages {
+			sizes[topicPartitionKey(message.KafkaMessage.TopicPartition)]++
+		}
+	}
+	return sizes
+}
+
 // RemoveByPartitions removes messages from the buffer by partitions
 // Useful when partitions are revoked.
 func (b *SinkBuffer) RemoveByPartitions(partitions []kafka.TopicPartition) {
@@ -55,11 +115,17 @@ func (b *SinkBuffer) RemoveByPartitions(partitions []kafka.TopicPartition) {
 		partitionMap[key] = true
 	}
 
-	for key, message := range b.data {
-		topicKey := topicPartitionKey(message.KafkaMessage.TopicPartition)
+	for namespace, messages := range b.data {
+		for key, message := range messages {
+			topicKey := topicPartitionKey(message.KafkaMessage.TopicPartition)
+
+			if partitionMap[topicKey] {
+				delete(messages, key)
+			}
+		}
 
-		if partitionMap[topicKey] {
-			delete(b.data, key)
+		if len(messages) == 0 {
+			delete(b.data, namespace)
 		}
 	}
 }
@@ -75,3 +75,41 @@ func TestBufferRemoveByPartitions(t *testing.T) {
 	buffer.RemoveByPartitions([]kafka.TopicPartition{partition2})
 	assert.Equal(t, 1, buffer.Size())
 }
+
+func TestBufferNamespaces(t *testing.T) {
+	buffer := sink.NewSinkBuffer()
+	topicA := "om_a_events"
+	topicB := "om_b_events"
+
+	sinkMessageA := sink.SinkMessage{
+		Namespace: "a",
+		KafkaMessage: &kafka.Message{
+			TopicPartition: kafka.TopicPartition{
+				Topic:     &topicA,
+				Partition: 1,
+				Offset:    1,
+			},
+		},
+	}
+	sinkMessageB := sink.SinkMessage{
+		Namespace: "b",
+		KafkaMessage: &kafka.Message{
+			TopicPartition: kafka.TopicPartition{
+				Topic:     &topicB,
+				Partition: 1,
+				Offset:    1,
+			},
+		},
+	}
+
+	buffer.Add(sinkMessageA)
+	buffer.Add(sinkMessageB)
+
+	assert.Equal(t, []string{"a", "b"}, buffer.Namespaces())
+	assert.Equal(t, 1, buffer.NamespaceSize("a"))
+
+	// Dequeuing a namespace leaves the others in the buffer
+	assert.Equal(t, []sink.SinkMessage{sinkMessageA}, buffer.DequeueNamespace("a"))
+	assert.Equal(t, []string{"b"}, buffer.Namespaces())
+	assert.Equal(t, 1, buffer.Size())
+}
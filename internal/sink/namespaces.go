@@ -8,12 +8,15 @@ import (
 
 	"github.com/oliveagle/jsonpath"
 
+	"github.com/openmeterio/openmeter/internal/eventtype"
 	"github.com/openmeterio/openmeter/internal/ingest/kafkaingest/serializer"
 	"github.com/openmeterio/openmeter/pkg/models"
 )
 
 type MeterStore struct {
 	Meters []models.Meter
+	// EventTypes holds the compiled schemas of event types by type
+	EventTypes map[string]*eventtype.Schema
 }
 
 type NamespaceStore struct {
@@ -36,6 +39,25 @@ func (n *NamespaceStore) AddMeter(meter models.Meter) {
 	}
 }
 
+// AddEventType adds an event type, events with the type are validated against its schema
+func (n *NamespaceStore) AddEventType(eventType eventtype.EventType) error {
+	schema, err := eventtype.CompileSchema(eventType.Schema)
+	if err != nil {
+		return fmt.Errorf("invalid schema of event type %s: %w", eventType.Type, err)
+	}
+
+	if n.namespaces[eventType.Namespace] == nil {
+		n.namespaces[eventType.Namespace] = &MeterStore{}
+	}
+	if n.namespaces[eventType.Namespace].EventTypes == nil {
+		n.namespaces[eventType.Namespace].EventTypes = map[string]*eventtype.Schema{}
+	}
+
+	n.namespaces[eventType.Namespace].EventTypes[eventType.Type] = schema
+
+	return nil
+}
+
 // ValidateEvent validates a single event by matching it with the corresponding meter if any
 func (a *NamespaceStore) ValidateEvent(ctx context.Context, event serializer.CloudEventsKafkaPayload, namespace string) error {
 	namespaceStore := a.namespaces[namespace]
@@ -44,6 +66,15 @@ func (a *NamespaceStore) ValidateEvent(ctx context.Context, event serializer.Clo
 		return NewProcessingError(fmt.Sprintf("namespace not found: %s", namespace), DROP)
 	}
 
+	// Validate event data against the schema of the event type if any
+	schema, foundSchema := namespaceStore.EventTypes[event.Type]
+	if foundSchema {
+		err := validateEventWithSchema(event, schema)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Validate a single event against multiple meters
 	var foundMeter bool
 	for _, meter := range namespaceStore.Meters {
@@ -57,7 +88,8 @@ func (a *NamespaceStore) ValidateEvent(ctx context.Context, event serializer.Clo
 		}
 	}
 
-	if !foundMeter {
+	// Events described by an event type are valid without a meter
+	if !foundMeter && !foundSchema {
 		// Mark as invalid so we can show it to the user
 		return NewProcessingError(fmt.Sprintf("no meter found for event type: %s", event.Type), INVALID)
 	}
@@ -65,8 +97,31 @@ func (a *NamespaceStore) ValidateEvent(ctx context.Context, event serializer.Clo
 	return nil
 }
 
+// validateEventWithSchema validates the data of a single event against the schema of its event type
+func validateEventWithSchema(ev serializer.CloudEventsKafkaPayload, schema *eventtype.Schema) *ProcessingError {
+	if ev.DataContentType != "" {
+		return NewProcessingError(fmt.Sprintf("event data with content type %s cannot be validated against the schema of event type %s, data must be JSON", ev.DataContentType, ev.Type), INVALID)
+	}
+
+	err := schema.Validate([]byte(ev.Data))
+	if err != nil {
+		if validationErr, ok := err.(*eventtype.ValidationError); ok {
+			return NewProcessingError(fmt.Sprintf("event data does not match the schema of event type %s: %s", ev.Type, validationErr), INVALID)
+		}
+
+		return NewProcessingError("cannot unmarshal event data as json", INVALID)
+	}
+
+	return nil
+}
+
 // validateEventWithMeter validates a single event against a single meter
 func validateEventWithMeter(meter models.Meter, ev serializer.CloudEventsKafkaPayload) *ProcessingError {
+	// Non-JSON data is stored as is at ingestion but meters cannot process it
+	if ev.DataContentType != "" {
+		return NewProcessingError(fmt.Sprintf("event data with content type %s is not supported by meters, data must be JSON", ev.DataContentType), INVALID)
+	}
+
 	// Parse CloudEvents data as JSON, currently we only support JSON encoding
 	var data interface{}
 	err := json.Unmarshal([]byte(ev.Data), &data)
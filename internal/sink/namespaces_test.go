@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/openmeterio/openmeter/internal/eventtype"
 	"github.com/openmeterio/openmeter/internal/ingest/kafkaingest/serializer"
 	"github.com/openmeterio/openmeter/internal/sink"
 	"github.com/openmeterio/openmeter/pkg/models"
@@ -60,6 +62,16 @@ func TestNamespaStore(t *testing.T) {
 			},
 			want: sink.NewProcessingError("cannot unmarshal event data as json", sink.INVALID),
 		},
+		{
+			description: "should return error with non-JSON data",
+			namespace:   "default",
+			event: serializer.CloudEventsKafkaPayload{
+				Type:            "api-calls",
+				Data:            `duration_ms 100`,
+				DataContentType: "text/plain",
+			},
+			want: sink.NewProcessingError("event data with content type text/plain is not supported by meters, data must be JSON", sink.INVALID),
+		},
 		{
 			description: "should return error with value property not found",
 			namespace:   "default",
@@ -110,3 +122,79 @@ func TestNamespaStore(t *testing.T) {
 		})
 	}
 }
+
+func TestNamespaceStoreEventTypes(t *testing.T) {
+	ctx := context.Background()
+	namespaces := sink.NewNamespaceStore()
+
+	namespaces.AddMeter(models.Meter{
+		Namespace:     "default",
+		Slug:          "m1",
+		Aggregation:   "SUM",
+		EventType:     "api-calls",
+		ValueProperty: "$.duration_ms",
+		WindowSize:    models.WindowSizeMinute,
+	})
+
+	schema := map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"duration_ms"},
+		"properties": map[string]interface{}{
+			"duration_ms": map[string]interface{}{"type": "number", "minimum": 0},
+		},
+	}
+
+	require.NoError(t, namespaces.AddEventType(eventtype.EventType{Namespace: "default", Type: "api-calls", Schema: schema}))
+	require.NoError(t, namespaces.AddEventType(eventtype.EventType{Namespace: "default", Type: "audit", Schema: map[string]interface{}{"type": "object"}}))
+	assert.Error(t, namespaces.AddEventType(eventtype.EventType{Namespace: "default", Type: "broken", Schema: map[string]interface{}{"type": 1}}))
+
+	tests := []struct {
+		description string
+		event       serializer.CloudEventsKafkaPayload
+		want        error
+	}{
+		{
+			description: "should return path-level error when data does not match the schema",
+			event: serializer.CloudEventsKafkaPayload{
+				Type: "api-calls",
+				Data: `{"duration_ms": -1}`,
+			},
+			want: sink.NewProcessingError("event data does not match the schema of event type api-calls: $.duration_ms: Must be greater than or equal to 0", sink.INVALID),
+		},
+		{
+			description: "should return error with non-JSON data",
+			event: serializer.CloudEventsKafkaPayload{
+				Type:            "audit",
+				Data:            `login`,
+				DataContentType: "text/plain",
+			},
+			want: sink.NewProcessingError("event data with content type text/plain cannot be validated against the schema of event type audit, data must be JSON", sink.INVALID),
+		},
+		{
+			description: "should pass event matching the schema and the meter",
+			event: serializer.CloudEventsKafkaPayload{
+				Type: "api-calls",
+				Data: `{"duration_ms": 100}`,
+			},
+		},
+		{
+			description: "should pass event with schema but without meter",
+			event: serializer.CloudEventsKafkaPayload{
+				Type: "audit",
+				Data: `{"action": "login"}`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			err := namespaces.ValidateEvent(ctx, tt.event, "default")
+			if tt.want == nil {
+				assert.Nil(t, err)
+				return
+			}
+			assert.Equal(t, tt.want, err)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// PartitionProgress is how far the sink stored the events of a partition.
+type PartitionProgress struct {
+	Namespace string
+	Partition int32
+	// Offset is the offset of the next message to store
+	Offset int64
+	// CompleteUntil is the time events of the partition are stored up to:
+	// the time of the report if the partition is caught up, the time of the latest stored event otherwise.
+	CompleteUntil time.Time
+	UpdatedAt     time.Time
+}
+
+// ProgressStorage stores the progress of the sink, so the server can tell how fresh usage data is.
+type ProgressStorage interface {
+	StoreProgress(ctx context.Context, progress []PartitionProgress) error
+}
+
+// flushedPartition is the last offset and latest event time stored from a partition
+type flushedPartition struct {
+	offset    kafka.Offset
+	eventTime time.Time
+}
+
+// recordProgress records the offsets and event times of stored messages.
+func (s *Sink) recordProgress(messages []SinkMessage) {
+	s.progressMu.Lock()
+	defer s.progressMu.Unlock()
+
+	for _, message := range messages {
+		key := topicPartitionKey(message.KafkaMessage.TopicPartition)
+		flushed := s.flushedPartitions[key]
+
+		if message.KafkaMessage.TopicPartition.Offset > flushed.offset {
+			flushed.offset = message.KafkaMessage.TopicPartition.Offset
+		}
+
+		if message.Serialized != nil {
+			if eventTime := time.Unix(message.Serialized.Time, 0); eventTime.After(flushed.eventTime) {
+				flushed.eventTime = eventTime
+			}
+		}
+
+		s.flushedPartitions[key] = flushed
+	}
+}
+
+// forgetProgress forgets the progress of revoked partitions, their new owner reports it from now.
+func (s *Sink) forgetProgress(partitions []kafka.TopicPartition) {
+	s.progressMu.Lock()
+	defer s.progressMu.Unlock()
+
+	for _, partition := range partitions {
+		delete(s.flushedPartitions, topicPartitionKey(partition))
+	}
+}
+
+// reportProgress stores the progress of the assigned partitions at most once every ProgressInterval.
+// Progress is best effort: failures are logged only and reported again in the next interval.
+func (s *Sink) reportProgress(ctx context.Context) {
+	if s.config.ProgressStorage == nil {
+		return
+	}
+
+	logger := s.config.Logger.With("operation", "reportProgress")
+	now := time.Now()
+
+	s.progressMu.Lock()
+	if now.Sub(s.progressReportedAt) < s.config.ProgressInterval {
+		s.progressMu.Unlock()
+		return
+	}
+	s.progressReportedAt = now
+
+	flushedPartitions := make(map[string]flushedPartition, len(s.flushedPartitions))
+	for key, flushed := range s.flushedPartitions {
+		flushedPartitions[key] = flushed
+	}
+	s.progressMu.Unlock()
+
+	progress, err := s.getProgress(now, flushedPartitions)
+	if err != nil {
+		logger.Warn("failed to get progress", "err", err)
+		return
+	}
+
+	if len(progress) == 0 {
+		return
+	}
+
+	err = s.config.ProgressStorage.StoreProgress(ctx, progress)
+	if err != nil {
+		logger.Warn("failed to store progress", "err", err)
+	}
+}
+
+// getProgress returns the progress of the assigned partitions.
+// A partition is caught up if every message produced to it was consumed and stored.
+func (s *Sink) getProgress(now time.Time, flushedPartitions map[string]flushedPartition) ([]PartitionProgress, error) {
+	assignedPartitions, err := s.config.Consumer.Assignment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assigned partitions: %w", err)
+	}
+
+	if len(assignedPartitions) == 0 {
+		return nil, nil
+	}
+
+	positions, err := s.config.Consumer.Position(assignedPartitions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get partition positions: %w", err)
+	}
+
+	// Idle partitions without consumed messages since the assignment are at the committed offset
+	var idlePartitions []kafka.TopicPartition
+	for _, partition := range positions {
+		if partition.Offset < 0 {
+			idlePartitions = append(idlePartitions, partition)
+		}
+	}
+
+	committedOffsets := map[string]kafka.Offset{}
+	if len(idlePartitions) > 0 {
+		committed, err := s.config.Consumer.Committed(idlePartitions, 5000)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get committed offsets: %w", err)
+		}
+
+		for _, partition := range committed {
+			committedOffsets[topicPartitionKey(partition)] = partition.Offset
+		}
+	}
+
+	buffered := s.buffer.PartitionSizes()
+
+	// Watermarks of paused partitions are not updated, so they cannot tell if a partition is caught up
+	s.pauseMu.Lock()
+	paused := s.manuallyPaused || now.Before(s.throttledUntil)
+	s.pauseMu.Unlock()
+
+	progress := make([]PartitionProgress, 0, len(positions))
+	for _, partition := range positions {
+		if partition.Topic == nil {
+			continue
+		}
+
+		namespace, err := getNamespace(*partition.Topic)
+		if err != nil {
+			continue
+		}
+
+		key := topicPartitionKey(partition)
+
+		offset := partition.Offset
+		if offset < 0 {
+			offset = committedOffsets[key]
+		}
+
+		// Watermarks are cached from fetches, so they don't cost a request per partition
+		_, high, err := s.config.Consumer.GetWatermarkOffsets(*partition.Topic, partition.Partition)
+
+		caughtUp := err == nil && !paused && offset >= 0 && high >= 0 && int64(offset) >= high &&
+			buffered[key] == 0 && !s.namespaceBackoff.Paused(namespace)
+
+		if caughtUp {
+			progress = append(progress, PartitionProgress{
+				Namespace:     namespace,
+				Partition:     partition.Partition,
+				Offset:        int64(offset),
+				CompleteUntil: now,
+				UpdatedAt:     now,
+			})
+
+			continue
+		}
+
+		// Lagging partitions are complete up to the latest stored event
+		if flushed, ok := flushedPartitions[key]; ok {
+			progress = append(progress, PartitionProgress{
+				Namespace:     namespace,
+				Partition:     partition.Partition,
+				Offset:        int64(flushed.offset) + 1,
+				CompleteUntil: flushed.eventTime,
+				UpdatedAt:     now,
+			})
+		}
+	}
+
+	return progress, nil
+}
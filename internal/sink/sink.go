@@ -21,6 +21,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/openmeterio/openmeter/internal/dedupe"
+	"github.com/openmeterio/openmeter/internal/eventtype"
 	"github.com/openmeterio/openmeter/internal/ingest/kafkaingest/serializer"
 	"github.com/openmeterio/openmeter/internal/meter"
 	kafkametrics "github.com/openmeterio/openmeter/pkg/kafka/metrics"
@@ -48,6 +49,40 @@ type Sink struct {
 
 	kafkaMetrics *kafkametrics.Metrics
 
+	// replays holds the last offset of flushes interrupted by a crash by topic partition
+	replays   map[string]kafka.Offset
+	replaysMu sync.Mutex
+
+	// namespaceBackoff holds back namespaces failing to flush
+	namespaceBackoff    *namespaceBackoff
+	flushFailureCounter metric.Int64Counter
+
+	// batchSizer adjusts the batch size and throttles consumption based on storage health
+	batchSizer         *batchSizer
+	flushSizeHistogram metric.Int64Histogram
+	flushDuration      metric.Int64Histogram
+	pausedDuration     metric.Int64Counter
+	// pausedAt is the time all partitions were paused, zero when consuming
+	pausedAt time.Time
+	// throttledUntil keeps partitions paused while storage is degraded
+	throttledUntil time.Time
+	throttleTimer  *time.Timer
+	// manuallyPaused keeps partitions paused until consumption is resumed via the admin API
+	manuallyPaused bool
+	pauseMu        sync.Mutex
+
+	// lastFlushAt is the time of the last successful namespace flush
+	lastFlushAt time.Time
+	lastFlushMu sync.Mutex
+
+	// refetchMu serializes periodic and requested namespace refetches
+	refetchMu sync.Mutex
+
+	// flushedPartitions holds the progress of the assigned partitions reported to ProgressStorage
+	flushedPartitions  map[string]flushedPartition
+	progressReportedAt time.Time
+	progressMu         sync.Mutex
+
 	mu sync.Mutex
 }
 
@@ -57,7 +92,10 @@ type SinkConfig struct {
 	MetricMeter     metric.Meter
 	MeterRepository meter.Repository
 	Storage         Storage
-	Deduplicator    dedupe.Deduplicator
+	// ArchiveStorage is optional, the messages of a namespace flush are inserted into it at once before they are persisted to Storage.
+	// Storage gets a batch per topic partition, archives writing a file per batch would write many small files.
+	ArchiveStorage Storage
+	Deduplicator   dedupe.Deduplicator
 	Consumer        *kafka.Consumer
 	// MinCommitCount is the minimum number of messages to wait before flushing the buffer.
 	// Whichever happens earlier MinCommitCount or MaxCommitWait will trigger a flush.
@@ -68,6 +106,19 @@ type SinkConfig struct {
 	// this information is used to configure which topics the consumer subscribes and
 	// the meter configs used in event validation.
 	NamespaceRefetch time.Duration
+	// NamespaceRetryInitialInterval is the time to wait before retrying the flush of a namespace that failed.
+	// The partitions of the namespace are paused while waiting, other namespaces keep flushing.
+	NamespaceRetryInitialInterval time.Duration
+	// NamespaceRetryMaxInterval is the maximum time to wait between retries as the wait doubles after every failure.
+	NamespaceRetryMaxInterval time.Duration
+	// AdaptiveBatching replaces the static MinCommitCount with a batch size adjusted to storage latency and errors,
+	// and throttles consumption while storage is degraded.
+	AdaptiveBatching AdaptiveBatchingConfig
+	// EventTypeRepository is optional, events are validated against the schema of their event type
+	EventTypeRepository eventtype.Repository
+	// ProgressStorage is optional, the progress of the assigned partitions is reported to it every ProgressInterval
+	ProgressStorage  ProgressStorage
+	ProgressInterval time.Duration
 	// OnFlushSuccess is an optional lifecycle hook
 	OnFlushSuccess func(string, int64)
 }
@@ -87,6 +138,32 @@ func NewSink(config SinkConfig) (*Sink, error) {
 	if config.NamespaceRefetch == 0 {
 		config.NamespaceRefetch = 15 * time.Second
 	}
+	if config.NamespaceRetryInitialInterval == 0 {
+		config.NamespaceRetryInitialInterval = 1 * time.Second
+	}
+	if config.NamespaceRetryMaxInterval == 0 {
+		config.NamespaceRetryMaxInterval = 1 * time.Minute
+	}
+	if config.ProgressInterval == 0 {
+		config.ProgressInterval = 10 * time.Second
+	}
+	if config.AdaptiveBatching.Enabled {
+		if config.AdaptiveBatching.MinBatchSize == 0 {
+			config.AdaptiveBatching.MinBatchSize = 1
+		}
+		if config.AdaptiveBatching.MaxBatchSize == 0 {
+			config.AdaptiveBatching.MaxBatchSize = 10000
+		}
+		if config.AdaptiveBatching.TargetLatency == 0 {
+			config.AdaptiveBatching.TargetLatency = 1 * time.Second
+		}
+		if config.AdaptiveBatching.MaxThrottle == 0 {
+			config.AdaptiveBatching.MaxThrottle = 30 * time.Second
+		}
+		if config.AdaptiveBatching.MaxBatchSize < config.AdaptiveBatching.MinBatchSize {
+			return nil, fmt.Errorf("adaptive batching max batch size must not be less than min batch size")
+		}
+	}
 
 	// Initialize OTel metrics
 	messageCounter, err := config.MetricMeter.Int64Counter(
@@ -107,24 +184,84 @@ func NewSink(config SinkConfig) (*Sink, error) {
 		return nil, fmt.Errorf("failed to create events counter: %w", err)
 	}
 
+	flushFailureCounter, err := config.MetricMeter.Int64Counter(
+		"sink.flush.failures",
+		metric.WithDescription("The number of namespace flushes failed to persist"),
+		metric.WithUnit("{flush}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create flush failures counter: %w", err)
+	}
+
+	flushSizeHistogram, err := config.MetricMeter.Int64Histogram(
+		"sink.flush.size",
+		metric.WithDescription("The number of messages flushed at once"),
+		metric.WithUnit("{message}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create flush size histogram: %w", err)
+	}
+
+	flushDuration, err := config.MetricMeter.Int64Histogram(
+		"sink.flush.duration",
+		metric.WithDescription("The time it takes to flush the buffer"),
+		metric.WithUnit("ms"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create flush duration histogram: %w", err)
+	}
+
+	pausedDuration, err := config.MetricMeter.Int64Counter(
+		"sink.consumer.paused",
+		metric.WithDescription("The time consumption was paused for flushes and throttling"),
+		metric.WithUnit("ms"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create paused duration counter: %w", err)
+	}
+
+	batchSizer := newBatchSizer(config.AdaptiveBatching, config.MinCommitCount)
+
+	_, err = config.MetricMeter.Int64ObservableGauge(
+		"sink.batch.size",
+		metric.WithDescription("The number of messages to buffer before flushing"),
+		metric.WithUnit("{message}"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(int64(batchSizer.Size()))
+
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create batch size gauge: %w", err)
+	}
+
 	kafkaMetrics, err := kafkametrics.New(config.MetricMeter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Kafka client metrics: %w", err)
 	}
 
 	sink := &Sink{
-		config:            config,
-		buffer:            NewSinkBuffer(),
-		namespaceStore:    NewNamespaceStore(),
-		flushEventCounter: flushEventCounter,
-		messageCounter:    messageCounter,
-		kafkaMetrics:      kafkaMetrics,
+		config:              config,
+		buffer:              NewSinkBuffer(),
+		namespaceStore:      NewNamespaceStore(),
+		flushEventCounter:   flushEventCounter,
+		messageCounter:      messageCounter,
+		flushFailureCounter: flushFailureCounter,
+		kafkaMetrics:        kafkaMetrics,
+		replays:             map[string]kafka.Offset{},
+		namespaceBackoff:    newNamespaceBackoff(config.NamespaceRetryInitialInterval, config.NamespaceRetryMaxInterval),
+		batchSizer:          batchSizer,
+		flushSizeHistogram:  flushSizeHistogram,
+		flushDuration:       flushDuration,
+		pausedDuration:      pausedDuration,
+		flushedPartitions:   map[string]flushedPartition{},
 	}
 
 	return sink, nil
 }
 
-// flush flushes the 1. buffer to storage, 2. sets dedupe and 3. store the offset
+// flush flushes the buffer of every namespace that is not backing off after a failure
 // called when max wait time or min commit count reached
 func (s *Sink) flush() error {
 	ctx := context.TODO()
@@ -133,6 +270,9 @@ func (s *Sink) flush() error {
 	s.clearFlushTimer()
 	defer s.setFlushTimer()
 
+	// Report progress after flushing, also when there is nothing to flush to advance idle partitions
+	defer s.reportProgress(ctx)
+
 	// Nothing to flush
 	if s.buffer.Size() == 0 {
 		logger.Debug("buffer is empty: nothing to flush")
@@ -159,30 +299,139 @@ func (s *Sink) flush() error {
 		return fmt.Errorf("failed to pause partitions before flush: %w", err)
 	}
 	defer func() {
+		// Backpressure: keep consuming paused while storage is degraded
+		if throttle := s.batchSizer.Throttle(); throttle > 0 {
+			logger.Warn("storage degraded, throttling consumption", "throttle", throttle, "batchSize", s.batchSizer.Size())
+			s.throttle(throttle)
+			return
+		}
+
+		// Partitions of namespaces backing off after a failure stay paused
 		err = s.resume()
 		if err != nil {
 			logger.Error("failed to resume partitions after flush", "err", err)
 		}
 	}()
 
-	messages := s.buffer.Dequeue()
+	// Namespaces backing off after a failure are retried later
+	now := time.Now()
+	messages := []SinkMessage{}
+	for _, namespace := range s.buffer.Namespaces() {
+		if s.namespaceBackoff.Ready(namespace, now) {
+			messages = append(messages, s.buffer.DequeueNamespace(namespace)...)
+		}
+	}
+
+	// Messages of partitions replaying an interrupted flush wait in the buffer until the flush can be repeated as it was
+	blocks, pending := flushBlocks(messages, s.getReplays())
+	for _, message := range pending {
+		s.buffer.Add(message)
+	}
+
+	if len(blocks) == 0 {
+		logger.Debug("no namespace is ready to flush")
+		return nil
+	}
 
 	// Start tracing
-	ctx, flushSpan := s.config.Tracer.Start(ctx, "flush", trace.WithSpanKind(trace.SpanKindConsumer), trace.WithAttributes(attribute.Int("size", len(messages))))
+	ctx, flushSpan := s.config.Tracer.Start(ctx, "flush", trace.WithSpanKind(trace.SpanKindConsumer), trace.WithAttributes(attribute.Int("size", len(messages)-len(pending))))
 	defer flushSpan.End()
 
+	flushStart := time.Now()
+	defer func() {
+		s.flushSizeHistogram.Record(ctx, int64(len(messages)-len(pending)))
+		s.flushDuration.Record(ctx, time.Since(flushStart).Milliseconds())
+	}()
+
+	// Every namespace is flushed independently, so a failing namespace doesn't hold back the others
+	namespaces, namespaceBlocks := groupBlocksByNamespace(blocks)
+	for _, namespace := range namespaces {
+		err := s.flushNamespace(ctx, namespace, namespaceBlocks[namespace])
+		if err != nil {
+			flushSpan.SetStatus(codes.Error, "failed to flush namespace")
+			flushSpan.RecordError(err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flushNamespace flushes the blocks of a namespace: 1. buffer to storage, 2. store the offset and 3. sets dedupe
+// Storage failures only hold back the namespace, other failures stop the sink.
+func (s *Sink) flushNamespace(ctx context.Context, namespace string, blocks []flushBlock) error {
+	logger := s.config.Logger.With("operation", "flush", "namespace", namespace)
+
+	messages := []SinkMessage{}
+	for _, block := range blocks {
+		messages = append(messages, block.Messages...)
+	}
+
+	ctx, namespaceSpan := s.config.Tracer.Start(ctx, "flush-namespace", trace.WithAttributes(attribute.String("namespace", namespace), attribute.Int("size", len(messages))))
+	defer namespaceSpan.End()
+
+	// 0. Store flush intent
+	// If we crash before offsets are committed, the same blocks are flushed again with the same deduplication tokens
+	err := s.storeFlushIntent(blocks)
+	if err != nil {
+		return fmt.Errorf("failed to store flush intent: %w", err)
+	}
+
 	// Dedupe messages so if we have multiple messages in the same batch
 	dedupedMessages := dedupeSinkMessages(messages)
 
-	// 1. Persist to storage
-	if len(dedupedMessages) > 0 {
+	// Storage failures hold back the namespace until it is retried
+	holdBack := func(err error) {
+		namespaceSpan.SetStatus(codes.Error, "failed to persist")
+		namespaceSpan.RecordError(err)
+
+		s.holdBackNamespace(ctx, namespace, blocks)
+
+		retryIn := s.namespaceBackoff.Failed(namespace, time.Now())
+		logger.Error("failed to persist, pausing namespace", "err", err, "retryIn", retryIn)
+	}
+
+	// 1. Archive and persist to storage
+	// The namespace is archived at once, retries archive the same blocks again
+	if s.config.ArchiveStorage != nil {
+		err := s.persistToArchive(ctx, dedupedMessages)
+		if err != nil {
+			holdBack(err)
+
+			return nil
+		}
+	}
+
+	// Every topic partition is persisted separately, with a deduplication token derived from its offset range
+	dedupedByPartition := map[string][]SinkMessage{}
+	for _, message := range dedupedMessages {
+		key := topicPartitionKey(message.KafkaMessage.TopicPartition)
+		dedupedByPartition[key] = append(dedupedByPartition[key], message)
+	}
+
+	for _, block := range blocks {
+		blockMessages := dedupedByPartition[partitionKey(block.Topic, block.Partition)]
+		if len(blockMessages) == 0 {
+			continue
+		}
+
 		// Persist events to permanent storage
-		err := s.persistToStorage(ctx, dedupedMessages)
+		err := s.persistToStorage(WithDeduplicationToken(ctx, block.DeduplicationToken()), blockMessages)
 		if err != nil {
-			return fmt.Errorf("failed to persist: %w", err)
+			holdBack(err)
+
+			return nil
 		}
 	}
 
+	s.clearReplays(blocks)
+	s.namespaceBackoff.Reset(namespace)
+	s.recordProgress(messages)
+
+	s.lastFlushMu.Lock()
+	s.lastFlushAt = time.Now()
+	s.lastFlushMu.Unlock()
+
 	// 2. Store Offset
 	// Least once guarantee, if offset commit fails we will re-process the same messages again as they are not committed yet
 	var offsetStoreErr error
@@ -231,14 +480,107 @@ func (s *Sink) flush() error {
 	logger.Debug("succeeded to flush", "buffer size", len(messages))
 	err = s.reportFlushMetrics(ctx, messages)
 	if err != nil {
-		flushSpan.SetStatus(codes.Error, "failed to report flush metrics")
-		flushSpan.RecordError(err)
+		namespaceSpan.SetStatus(codes.Error, "failed to report flush metrics")
+		namespaceSpan.RecordError(err)
 		return fmt.Errorf("failed to report flush metrics: %w", err)
 	}
 
 	return nil
 }
 
+// holdBackNamespace returns the blocks of a namespace that failed to flush to the buffer.
+// The retry flushes the same offset ranges, so storage can ignore the blocks persisted before the failure.
+func (s *Sink) holdBackNamespace(ctx context.Context, namespace string, blocks []flushBlock) {
+	s.replaysMu.Lock()
+	for _, block := range blocks {
+		s.replays[partitionKey(block.Topic, block.Partition)] = block.LastOffset
+	}
+	s.replaysMu.Unlock()
+
+	for _, block := range blocks {
+		for _, message := range block.Messages {
+			s.buffer.Add(message)
+		}
+	}
+
+	s.flushFailureCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("namespace", namespace)))
+}
+
+// flushableSize returns the number of buffered messages of namespaces that are not backing off after a failure.
+func (s *Sink) flushableSize() int {
+	now := time.Now()
+	size := 0
+
+	for _, namespace := range s.buffer.Namespaces() {
+		if s.namespaceBackoff.Ready(namespace, now) {
+			size += s.buffer.NamespaceSize(namespace)
+		}
+	}
+
+	return size
+}
+
+// storeFlushIntent commits the first offset of every block with its offset range as metadata.
+// The offsets are stored as well, so automatic offset commits keep the metadata until the flush completes.
+func (s *Sink) storeFlushIntent(blocks []flushBlock) error {
+	offsets := make([]kafka.TopicPartition, 0, len(blocks))
+	for _, block := range blocks {
+		offsets = append(offsets, block.Intent())
+	}
+
+	_, err := s.config.Consumer.StoreOffsets(offsets)
+	if err != nil {
+		return fmt.Errorf("failed to store offsets: %w", err)
+	}
+
+	_, err = s.config.Consumer.CommitOffsets(offsets)
+	if err != nil {
+		return fmt.Errorf("failed to commit offsets: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Sink) getReplays() map[string]kafka.Offset {
+	s.replaysMu.Lock()
+	defer s.replaysMu.Unlock()
+
+	replays := make(map[string]kafka.Offset, len(s.replays))
+	for key, offset := range s.replays {
+		replays[key] = offset
+	}
+
+	return replays
+}
+
+func (s *Sink) clearReplays(blocks []flushBlock) {
+	s.replaysMu.Lock()
+	defer s.replaysMu.Unlock()
+
+	for _, block := range blocks {
+		delete(s.replays, partitionKey(block.Topic, block.Partition))
+	}
+}
+
+// loadReplays finds flushes interrupted by a crash in the committed offsets of newly assigned partitions.
+func (s *Sink) loadReplays(partitions []kafka.TopicPartition) error {
+	committed, err := s.config.Consumer.Committed(partitions, 5000)
+	if err != nil {
+		return fmt.Errorf("failed to get committed offsets: %w", err)
+	}
+
+	s.replaysMu.Lock()
+	defer s.replaysMu.Unlock()
+
+	for _, partition := range committed {
+		if _, last, ok := parseFlushIntent(partition); ok {
+			s.replays[topicPartitionKey(partition)] = last
+		}
+	}
+
+	return nil
+}
+
 // reportFlushMetrics reports metrics to OTel
 func (s *Sink) reportFlushMetrics(ctx context.Context, messages []SinkMessage) error {
 	namespacesReport := map[string]int64{}
@@ -278,30 +620,18 @@ func (s *Sink) persistToStorage(ctx context.Context, messages []SinkMessage) err
 	persistCtx, persistSpan := s.config.Tracer.Start(ctx, "persist")
 	defer persistSpan.End()
 
-	batch := []SinkMessage{}
-
-	// Flter out dropped messages
-	for _, message := range messages {
-		if message.Error != nil {
-			switch message.Error.ProcessingControl {
-			case INVALID:
-				// Do nothing: include in batch
-			case DROP:
-				// Skip message from batch
-				logger.Debug("dropping message", "error", message.Error, "message", string(message.KafkaMessage.Value), "namespace", message.Namespace)
-				continue
-			default:
-				return fmt.Errorf("unknown error type: %s", message.Error)
-			}
-		}
-		batch = append(batch, message)
+	batch, err := storageBatch(logger, messages)
+	if err != nil {
+		return err
 	}
 
 	// Storage Batch insert
 	if len(batch) > 0 {
 		storageCtx, storageSpan := s.config.Tracer.Start(persistCtx, "storage-batch-insert")
 		defer storageSpan.End()
+		insertStart := time.Now()
 		err := s.config.Storage.BatchInsert(storageCtx, batch)
+		s.batchSizer.Observe(time.Since(insertStart), err)
 		if err != nil {
 			// Returning and error means we will retry the whole batch again
 			storageSpan.SetStatus(codes.Error, "failure")
@@ -314,6 +644,52 @@ func (s *Sink) persistToStorage(ctx context.Context, messages []SinkMessage) err
 	return nil
 }
 
+func (s *Sink) persistToArchive(ctx context.Context, messages []SinkMessage) error {
+	logger := s.config.Logger.With("operation", "persistToArchive")
+	archiveCtx, archiveSpan := s.config.Tracer.Start(ctx, "archive")
+	defer archiveSpan.End()
+
+	batch, err := storageBatch(logger, messages)
+	if err != nil {
+		return err
+	}
+
+	if len(batch) > 0 {
+		err := s.config.ArchiveStorage.BatchInsert(archiveCtx, batch)
+		if err != nil {
+			archiveSpan.SetStatus(codes.Error, "failure")
+			archiveSpan.RecordError(err)
+			return fmt.Errorf("failed to sink to archive: %s", err)
+		}
+		logger.Debug("succeeded to sink to archive", "buffer size", len(messages))
+	}
+
+	return nil
+}
+
+// storageBatch filters out dropped messages
+func storageBatch(logger *slog.Logger, messages []SinkMessage) ([]SinkMessage, error) {
+	batch := []SinkMessage{}
+
+	for _, message := range messages {
+		if message.Error != nil {
+			switch message.Error.ProcessingControl {
+			case INVALID:
+				// Do nothing: include in batch
+			case DROP:
+				// Skip message from batch
+				logger.Debug("dropping message", "error", message.Error, "message", string(message.KafkaMessage.Value), "namespace", message.Namespace)
+				continue
+			default:
+				return nil, fmt.Errorf("unknown error type: %s", message.Error)
+			}
+		}
+		batch = append(batch, message)
+	}
+
+	return batch, nil
+}
+
 // dedupeSet sets the dedupe keys in Deduplicator with retry
 func (s *Sink) dedupeSet(ctx context.Context, messages []SinkMessage) error {
 	logger := s.config.Logger.With("operation", "dedupeSet")
@@ -366,11 +742,30 @@ func (s *Sink) getNamespaces() (*NamespaceStore, error) {
 		namespaceStore.AddMeter(meter)
 	}
 
+	if s.config.EventTypeRepository != nil {
+		eventTypes, err := s.config.EventTypeRepository.ListAllEventTypes(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get event types: %s", err)
+		}
+
+		for _, eventType := range eventTypes {
+			err := namespaceStore.AddEventType(eventType)
+			if err != nil {
+				// Events of the type are validated by meters only
+				s.config.Logger.Warn("skipping event type", "namespace", eventType.Namespace, "err", err)
+			}
+		}
+	}
+
 	return namespaceStore, nil
 }
 
 func (s *Sink) subscribeToNamespaces() error {
 	logger := s.config.Logger.With("operation", "subscribeToNamespaces")
+
+	s.refetchMu.Lock()
+	defer s.refetchMu.Unlock()
+
 	ns, err := s.getNamespaces()
 	if err != nil {
 		return fmt.Errorf("failed to get namespaces: %s", err)
@@ -499,7 +894,7 @@ func (s *Sink) Run() error {
 				logger.Debug("event added to buffer", "partition", e.TopicPartition.Partition, "offset", e.TopicPartition.Offset, "event", kafkaCloudEvent)
 
 				// Flush buffer and commit messages
-				if s.buffer.Size() >= s.config.MinCommitCount {
+				if s.flushableSize() >= s.batchSizer.Size() {
 					err = s.flush()
 					if err != nil {
 						// Stop processing, non-recoverable error
@@ -552,19 +947,78 @@ func (s *Sink) pause() error {
 	if err != nil {
 		return fmt.Errorf("failed to pause partitions before flush: %w", err)
 	}
+
+	s.pauseMu.Lock()
+	if s.pausedAt.IsZero() {
+		s.pausedAt = time.Now()
+	}
+	s.pauseMu.Unlock()
+
 	return nil
 }
 
+// throttle keeps partitions paused for the given time, resuming is delayed until the throttling ends
+func (s *Sink) throttle(throttle time.Duration) {
+	logger := s.config.Logger.With("operation", "throttle")
+
+	s.pauseMu.Lock()
+	defer s.pauseMu.Unlock()
+
+	s.throttledUntil = time.Now().Add(throttle)
+
+	if s.throttleTimer != nil {
+		s.throttleTimer.Stop()
+	}
+
+	s.throttleTimer = time.AfterFunc(throttle, func() {
+		err := s.resume()
+		if err != nil {
+			logger.Error("failed to resume partitions after throttling", "err", err)
+		}
+	})
+}
+
+// resume resumes the assigned partitions except the ones of namespaces backing off after a failure
 func (s *Sink) resume() error {
+	s.pauseMu.Lock()
+	defer s.pauseMu.Unlock()
+
+	// Throttling resumes partitions once it ends
+	if time.Now().Before(s.throttledUntil) {
+		return nil
+	}
+
+	// Paused via the admin API until resumed
+	if s.manuallyPaused {
+		return nil
+	}
+
 	assignedPartitions, err := s.config.Consumer.Assignment()
 	if err != nil {
 		return fmt.Errorf("failed to get assigned partitions: %w", err)
 	}
-	err = s.config.Consumer.Resume(assignedPartitions)
+
+	partitions := make([]kafka.TopicPartition, 0, len(assignedPartitions))
+	for _, partition := range assignedPartitions {
+		if partition.Topic != nil {
+			if namespace, err := getNamespace(*partition.Topic); err == nil && s.namespaceBackoff.Paused(namespace) {
+				continue
+			}
+		}
+
+		partitions = append(partitions, partition)
+	}
+
+	err = s.config.Consumer.Resume(partitions)
 	if err != nil {
 		return fmt.Errorf("failed to resume partitions after flush: %w", err)
 	}
 
+	if !s.pausedAt.IsZero() {
+		s.pausedDuration.Add(context.Background(), time.Since(s.pausedAt).Milliseconds())
+		s.pausedAt = time.Time{}
+	}
+
 	return nil
 }
 
@@ -586,6 +1040,12 @@ func (s *Sink) rebalance(c *kafka.Consumer, event kafka.Event) error {
 			return nil
 		}
 
+		// Flushes interrupted by a crash are replayed with the same offset ranges
+		err = s.loadReplays(e.Partitions)
+		if err != nil {
+			logger.Error("failed to load interrupted flushes, replayed events may be stored twice", "err", err)
+		}
+
 		// Consumer to use the committed offset as a start position,
 		// with a fallback to `auto.offset.reset` if there is no committed offset.
 		// Auto offset reset is typically should be set to latest, so we will only consume new messages.
@@ -600,6 +1060,18 @@ func (s *Sink) rebalance(c *kafka.Consumer, event kafka.Event) error {
 			return fmt.Errorf("failed to assign partitions: %w", err)
 		}
 
+		// Newly assigned partitions are consumed right away, unless consumption is paused via the admin API
+		s.pauseMu.Lock()
+		manuallyPaused := s.manuallyPaused
+		s.pauseMu.Unlock()
+
+		if manuallyPaused {
+			err = s.config.Consumer.Pause(e.Partitions)
+			if err != nil {
+				return fmt.Errorf("failed to pause assigned partitions: %w", err)
+			}
+		}
+
 	case kafka.RevokedPartitions:
 		// We pause the consumer before the partitions are revoked to avoid processing new messages from revoked partitions.
 		// Consumption will be resumed after the new partitions are assigned. See above.
@@ -638,6 +1110,21 @@ func (s *Sink) rebalance(c *kafka.Consumer, event kafka.Event) error {
 
 		// Remove messages for revoked partitions from buffer
 		s.buffer.RemoveByPartitions(e.Partitions)
+
+		s.replaysMu.Lock()
+		for _, partition := range e.Partitions {
+			delete(s.replays, topicPartitionKey(partition))
+		}
+		s.replaysMu.Unlock()
+
+		s.forgetProgress(e.Partitions)
+
+		// Namespaces without buffered messages have nothing to retry, their partitions are consumed again once assigned
+		for _, namespace := range s.namespaceBackoff.Namespaces() {
+			if s.buffer.NamespaceSize(namespace) == 0 {
+				s.namespaceBackoff.Reset(namespace)
+			}
+		}
 	default:
 		logger.Error("unxpected event type", "event", e)
 	}
@@ -709,6 +1196,12 @@ func (s *Sink) Close() error {
 		s.flushTimer.Stop()
 	}
 
+	s.pauseMu.Lock()
+	if s.throttleTimer != nil {
+		s.throttleTimer.Stop()
+	}
+	s.pauseMu.Unlock()
+
 	return nil
 }
 
@@ -0,0 +1,540 @@
+package sink_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/openmeterio/openmeter/internal/ingest/kafkaingest/serializer"
+	"github.com/openmeterio/openmeter/internal/meter"
+	"github.com/openmeterio/openmeter/internal/sink"
+	"github.com/openmeterio/openmeter/pkg/models"
+)
+
+// tokenDedupingStorage mimics ClickHouse insert deduplication: inserts with a token seen before are ignored.
+type tokenDedupingStorage struct {
+	mu      sync.Mutex
+	tokens  map[string]bool
+	ids     []string
+	ignored int
+	inserts int
+
+	// crashAfter panics in the given insert after storing it, like a crash right after ClickHouse acknowledged the insert
+	crashAfter int
+	// failingNamespace fails inserts of the namespace without storing them
+	failingNamespace string
+}
+
+func (s *tokenDedupingStorage) BatchInsert(ctx context.Context, messages []sink.SinkMessage) error {
+	token, ok := sink.DeduplicationTokenFromContext(ctx)
+	if !ok {
+		return errors.New("missing deduplication token")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if messages[0].Namespace == s.failingNamespace {
+		return errors.New("table unavailable")
+	}
+
+	if s.tokens[token] {
+		s.ignored++
+	} else {
+		s.tokens[token] = true
+		for _, message := range messages {
+			s.ids = append(s.ids, message.Serialized.Id)
+		}
+	}
+
+	s.inserts++
+	if s.inserts == s.crashAfter {
+		panic("crash")
+	}
+
+	return nil
+}
+
+func (s *tokenDedupingStorage) state() ([]string, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := append([]string{}, s.ids...)
+	sort.Strings(ids)
+
+	return ids, s.ignored
+}
+
+func (s *tokenDedupingStorage) disableCrash() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.crashAfter = 0
+}
+
+func (s *tokenDedupingStorage) setFailingNamespace(namespace string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failingNamespace = namespace
+}
+
+func produceTestEvents(t *testing.T, cluster *kafka.MockCluster, topic string, partitions int, ids []string) {
+	t.Helper()
+
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": cluster.BootstrapServers()})
+	require.NoError(t, err)
+	defer producer.Close()
+
+	delivery := make(chan kafka.Event, len(ids))
+
+	for i, id := range ids {
+		payload, err := json.Marshal(serializer.CloudEventsKafkaPayload{
+			Id:      id,
+			Type:    "api-calls",
+			Source:  "test",
+			Subject: "customer-1",
+			Time:    time.Now().Unix(),
+			Data:    `{"duration_ms":1}`,
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, producer.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: int32(i % partitions)},
+			Value:          payload,
+		}, delivery))
+	}
+
+	for range ids {
+		report := (<-delivery).(*kafka.Message)
+		require.NoError(t, report.TopicPartition.Error)
+	}
+}
+
+func newTestSink(t *testing.T, cluster *kafka.MockCluster, storage sink.Storage, namespaces []string, configure func(config *sink.SinkConfig)) (*sink.Sink, *kafka.Consumer) {
+	t.Helper()
+
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":               cluster.BootstrapServers(),
+		"group.id":                        "openmeter-sink-worker",
+		"session.timeout.ms":              6000,
+		"enable.auto.commit":              true,
+		"enable.auto.offset.store":        false,
+		"go.application.rebalance.enable": true,
+		"auto.offset.reset":               "earliest",
+		"partition.assignment.strategy":   "cooperative-sticky",
+	})
+	require.NoError(t, err)
+
+	meters := make([]models.Meter, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		meters = append(meters, models.Meter{
+			Namespace:     namespace,
+			Slug:          "m1",
+			Aggregation:   models.MeterAggregationSum,
+			EventType:     "api-calls",
+			ValueProperty: "$.duration_ms",
+			WindowSize:    models.WindowSizeMinute,
+		})
+	}
+
+	config := sink.SinkConfig{
+		Logger:          slog.Default(),
+		Tracer:          tracenoop.NewTracerProvider().Tracer("test"),
+		MetricMeter:     metricnoop.NewMeterProvider().Meter("test"),
+		MeterRepository: meter.NewInMemoryRepository(meters),
+		Storage:         storage,
+		Consumer:        consumer,
+		MaxCommitWait:   time.Minute,
+	}
+	configure(&config)
+
+	s, err := sink.NewSink(config)
+	require.NoError(t, err)
+
+	return s, consumer
+}
+
+func TestSinkReplayAfterCrash(t *testing.T) {
+	const (
+		topic      = "om_default_events"
+		partitions = 2
+		events     = 10
+	)
+
+	tests := []struct {
+		name        string
+		description string
+		crashAfter  int
+	}{
+		{
+			name:        "PartialPersist",
+			description: "Crash after persisting the first partition of the batch",
+			crashAfter:  1,
+		},
+		{
+			name:        "BeforeOffsetStore",
+			description: "Crash after persisting the batch, before storing offsets",
+			crashAfter:  partitions,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Log(tc.description)
+
+			cluster, err := kafka.NewMockCluster(1)
+			require.NoError(t, err)
+			defer cluster.Close()
+
+			require.NoError(t, cluster.CreateTopic(topic, partitions, 1))
+
+			var want []string
+			for i := 0; i < events; i++ {
+				want = append(want, fmt.Sprintf("%02d", i))
+			}
+
+			produceTestEvents(t, cluster, topic, partitions, want)
+
+			storage := &tokenDedupingStorage{
+				tokens:     map[string]bool{},
+				crashAfter: tc.crashAfter,
+			}
+
+			// First run flushes every event in one batch and crashes while persisting it
+			crashed, consumer := newTestSink(t, cluster, storage, []string{"default"}, func(config *sink.SinkConfig) {
+				config.MinCommitCount = events
+			})
+
+			crashc := make(chan any, 1)
+			go func() {
+				defer func() { crashc <- recover() }()
+
+				_ = crashed.Run()
+			}()
+
+			select {
+			case crash := <-crashc:
+				require.Equal(t, "crash", crash)
+			case <-time.After(30 * time.Second):
+				t.Fatal("sink did not crash")
+			}
+
+			require.NoError(t, crashed.Close())
+			require.NoError(t, consumer.Close())
+
+			storage.disableCrash()
+
+			// Replay with a different batch size: the interrupted flush must be repeated as it was
+			replay, consumer := newTestSink(t, cluster, storage, []string{"default"}, func(config *sink.SinkConfig) {
+				config.MinCommitCount = 3
+			})
+			defer consumer.Close()
+
+			errc := make(chan error, 1)
+			go func() { errc <- replay.Run() }()
+
+			require.Eventually(t, func() bool {
+				ids, ignored := storage.state()
+
+				return len(ids) == events && ignored == tc.crashAfter
+			}, 30*time.Second, 50*time.Millisecond)
+
+			require.NoError(t, replay.Close())
+			require.NoError(t, <-errc)
+
+			ids, _ := storage.state()
+			assert.Equal(t, want, ids)
+		})
+	}
+}
+
+func TestSinkNamespaceIsolation(t *testing.T) {
+	cluster, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer cluster.Close()
+
+	require.NoError(t, cluster.CreateTopic("om_healthy_events", 1, 1))
+	require.NoError(t, cluster.CreateTopic("om_failing_events", 1, 1))
+
+	produceTestEvents(t, cluster, "om_healthy_events", 1, []string{"h1", "h2", "h3"})
+	produceTestEvents(t, cluster, "om_failing_events", 1, []string{"f1", "f2", "f3"})
+
+	storage := &tokenDedupingStorage{
+		tokens:           map[string]bool{},
+		failingNamespace: "failing",
+	}
+
+	s, consumer := newTestSink(t, cluster, storage, []string{"healthy", "failing"}, func(config *sink.SinkConfig) {
+		config.MinCommitCount = 1
+		config.MaxCommitWait = 50 * time.Millisecond
+		config.NamespaceRetryInitialInterval = 50 * time.Millisecond
+		config.NamespaceRetryMaxInterval = 200 * time.Millisecond
+	})
+	defer consumer.Close()
+
+	errc := make(chan error, 1)
+	go func() { errc <- s.Run() }()
+
+	// The failing namespace doesn't hold back the healthy one
+	require.Eventually(t, func() bool {
+		ids, _ := storage.state()
+
+		return assert.ObjectsAreEqual([]string{"h1", "h2", "h3"}, ids)
+	}, 30*time.Second, 50*time.Millisecond)
+
+	// The failing namespace is retried once the storage recovers
+	storage.setFailingNamespace("")
+
+	require.Eventually(t, func() bool {
+		ids, _ := storage.state()
+
+		return len(ids) == 6
+	}, 30*time.Second, 50*time.Millisecond)
+
+	require.NoError(t, s.Close())
+	require.NoError(t, <-errc)
+
+	ids, _ := storage.state()
+	assert.Equal(t, []string{"f1", "f2", "f3", "h1", "h2", "h3"}, ids)
+}
+
+func TestSinkAdmin(t *testing.T) {
+	cluster, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer cluster.Close()
+
+	require.NoError(t, cluster.CreateTopic("om_default_events", 1, 1))
+
+	produceTestEvents(t, cluster, "om_default_events", 1, []string{"e1", "e2", "e3"})
+
+	storage := &tokenDedupingStorage{
+		tokens: map[string]bool{},
+	}
+
+	s, consumer := newTestSink(t, cluster, storage, []string{"default"}, func(config *sink.SinkConfig) {
+		config.MinCommitCount = 100
+		config.MaxCommitWait = time.Hour
+	})
+	defer consumer.Close()
+
+	errc := make(chan error, 1)
+	go func() { errc <- s.Run() }()
+
+	// Events wait in the buffer until MinCommitCount or MaxCommitWait is reached
+	require.Eventually(t, func() bool {
+		status, err := s.Status()
+
+		return err == nil && status.BufferSize == 3
+	}, 30*time.Second, 50*time.Millisecond)
+
+	status, err := s.Status()
+	require.NoError(t, err)
+	require.Len(t, status.Partitions, 1)
+	assert.Equal(t, "default", status.Partitions[0].Namespace)
+	assert.Equal(t, int64(3), status.Partitions[0].HighWatermark)
+	assert.Nil(t, status.LastFlushAt)
+
+	// Forced flush doesn't wait for the buffer to fill up
+	require.NoError(t, s.Flush())
+
+	ids, _ := storage.state()
+	assert.Equal(t, []string{"e1", "e2", "e3"}, ids)
+
+	status, err = s.Status()
+	require.NoError(t, err)
+	assert.Equal(t, 0, status.BufferSize)
+	assert.NotNil(t, status.LastFlushAt)
+
+	// Paused partitions are not consumed, new events show up as lag
+	require.NoError(t, s.Pause())
+
+	produceTestEvents(t, cluster, "om_default_events", 1, []string{"e4", "e5"})
+
+	require.Eventually(t, func() bool {
+		status, err := s.Status()
+
+		return err == nil && status.Partitions[0].HighWatermark == 5
+	}, 30*time.Second, 50*time.Millisecond)
+
+	time.Sleep(500 * time.Millisecond)
+
+	status, err = s.Status()
+	require.NoError(t, err)
+	assert.True(t, status.Paused)
+	assert.Equal(t, 0, status.BufferSize)
+	require.NotNil(t, status.Partitions[0].Lag)
+	assert.Equal(t, int64(2), *status.Partitions[0].Lag)
+
+	// Resumed partitions are consumed again
+	require.NoError(t, s.Resume())
+
+	require.Eventually(t, func() bool {
+		status, err := s.Status()
+
+		return err == nil && status.BufferSize == 2 && *status.Partitions[0].Lag == 0
+	}, 30*time.Second, 50*time.Millisecond)
+
+	require.NoError(t, s.Close())
+	require.NoError(t, <-errc)
+}
+
+type recordingProgressStorage struct {
+	mu       sync.Mutex
+	progress []sink.PartitionProgress
+}
+
+func (s *recordingProgressStorage) StoreProgress(_ context.Context, progress []sink.PartitionProgress) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.progress = append(s.progress, progress...)
+
+	return nil
+}
+
+func (s *recordingProgressStorage) last() (sink.PartitionProgress, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.progress) == 0 {
+		return sink.PartitionProgress{}, false
+	}
+
+	return s.progress[len(s.progress)-1], true
+}
+
+func TestSinkProgress(t *testing.T) {
+	cluster, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer cluster.Close()
+
+	require.NoError(t, cluster.CreateTopic("om_default_events", 1, 1))
+
+	start := time.Now().Truncate(time.Second)
+	produceTestEvents(t, cluster, "om_default_events", 1, []string{"e1", "e2", "e3"})
+
+	storage := &tokenDedupingStorage{
+		tokens: map[string]bool{},
+	}
+	progressStorage := &recordingProgressStorage{}
+
+	s, consumer := newTestSink(t, cluster, storage, []string{"default"}, func(config *sink.SinkConfig) {
+		config.MinCommitCount = 1
+		config.MaxCommitWait = 50 * time.Millisecond
+		config.ProgressStorage = progressStorage
+		config.ProgressInterval = 10 * time.Millisecond
+	})
+	defer consumer.Close()
+
+	errc := make(chan error, 1)
+	go func() { errc <- s.Run() }()
+
+	// Caught up partitions are complete until the time of the report
+	require.Eventually(t, func() bool {
+		progress, ok := progressStorage.last()
+
+		return ok && progress.Offset == 3 && progress.CompleteUntil.Equal(progress.UpdatedAt)
+	}, 30*time.Second, 50*time.Millisecond)
+
+	progress, _ := progressStorage.last()
+	assert.Equal(t, "default", progress.Namespace)
+	assert.Equal(t, int32(0), progress.Partition)
+
+	// Lagging partitions are complete until the latest stored event
+	require.NoError(t, s.Pause())
+	pausedAt := time.Now()
+
+	produceTestEvents(t, cluster, "om_default_events", 1, []string{"e4", "e5"})
+
+	require.Eventually(t, func() bool {
+		progress, ok := progressStorage.last()
+
+		return ok && progress.UpdatedAt.After(pausedAt)
+	}, 30*time.Second, 50*time.Millisecond)
+
+	progress, _ = progressStorage.last()
+	assert.Equal(t, int64(3), progress.Offset)
+	assert.False(t, progress.CompleteUntil.Before(start))
+	assert.False(t, progress.CompleteUntil.After(pausedAt))
+
+	require.NoError(t, s.Close())
+	require.NoError(t, <-errc)
+}
+
+// recordingArchiveStorage records the ids of every batch inserted.
+type recordingArchiveStorage struct {
+	mu      sync.Mutex
+	batches [][]string
+}
+
+func (s *recordingArchiveStorage) BatchInsert(_ context.Context, messages []sink.SinkMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0, len(messages))
+	for _, message := range messages {
+		ids = append(ids, message.Serialized.Id)
+	}
+	sort.Strings(ids)
+
+	s.batches = append(s.batches, ids)
+
+	return nil
+}
+
+func (s *recordingArchiveStorage) state() [][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([][]string{}, s.batches...)
+}
+
+func TestSinkArchive(t *testing.T) {
+	cluster, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer cluster.Close()
+
+	require.NoError(t, cluster.CreateTopic("om_test_events", 3, 1))
+
+	produceTestEvents(t, cluster, "om_test_events", 3, []string{"1", "2", "3", "4", "5", "6"})
+
+	storage := &tokenDedupingStorage{
+		tokens: map[string]bool{},
+	}
+	archiveStorage := &recordingArchiveStorage{}
+
+	s, consumer := newTestSink(t, cluster, storage, []string{"test"}, func(config *sink.SinkConfig) {
+		config.MinCommitCount = 6
+		config.ArchiveStorage = archiveStorage
+	})
+	defer consumer.Close()
+
+	errc := make(chan error, 1)
+	go func() { errc <- s.Run() }()
+
+	require.Eventually(t, func() bool {
+		ids, _ := storage.state()
+
+		return len(ids) == 6
+	}, 30*time.Second, 50*time.Millisecond)
+
+	require.NoError(t, s.Close())
+	require.NoError(t, <-errc)
+
+	// Storage gets a batch per topic partition, the archive a single batch per namespace flush
+	assert.Equal(t, 3, storage.inserts)
+	assert.Equal(t, [][]string{{"1", "2", "3", "4", "5", "6"}}, archiveStorage.state())
+}
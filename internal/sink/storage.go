@@ -3,6 +3,7 @@ package sink
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/huandu/go-sqlbuilder"
@@ -14,9 +15,39 @@ type Storage interface {
 	BatchInsert(ctx context.Context, messages []SinkMessage) error
 }
 
+// FanOutStorage inserts batches into multiple storages in order.
+// A batch is inserted into the next storage only if it was inserted into the previous one,
+// so storages that handle retried batches idempotently should come first.
+type FanOutStorage struct {
+	storages []Storage
+}
+
+func NewFanOutStorage(storages ...Storage) *FanOutStorage {
+	return &FanOutStorage{
+		storages: storages,
+	}
+}
+
+func (f *FanOutStorage) BatchInsert(ctx context.Context, messages []SinkMessage) error {
+	for _, storage := range f.storages {
+		if err := storage.BatchInsert(ctx, messages); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type ClickHouseStorageConfig struct {
 	ClickHouse clickhouse.Conn
 	Database   string
+	// AsyncInsert makes ClickHouse buffer inserts server side and write them in larger parts.
+	// Deduplication tokens of async inserts are only honored by replicated tables,
+	// so it must only be enabled if the events table is a Replicated*MergeTree table.
+	AsyncInsert bool
+	// AsyncInsertWait makes inserts wait until ClickHouse writes the buffered events.
+	// Without waiting, events acknowledged but not written yet are lost if ClickHouse fails.
+	AsyncInsertWait bool
 }
 
 func NewClickhouseStorage(config ClickHouseStorageConfig) *ClickHouseStorage {
@@ -25,6 +56,7 @@ func NewClickhouseStorage(config ClickHouseStorageConfig) *ClickHouseStorage {
 	}
 }
 
+// ClickHouseStorage inserts events with the native protocol in columnar batches.
 type ClickHouseStorage struct {
 	config ClickHouseStorageConfig
 }
@@ -34,12 +66,38 @@ func (c *ClickHouseStorage) BatchInsert(ctx context.Context, messages []SinkMess
 		Database: c.config.Database,
 		Messages: messages,
 	}
-	sql, args, err := query.ToSQL()
+
+	settings := clickhouse.Settings{}
+
+	// Replayed batches are ignored by ClickHouse
+	if token, ok := DeduplicationTokenFromContext(ctx); ok {
+		settings["insert_deduplication_token"] = token
+	}
+
+	// Async inserts are only deduplicated by the token on replicated tables
+	if c.config.AsyncInsert {
+		settings["async_insert"] = 1
+		settings["async_insert_deduplicate"] = 1
+		settings["wait_for_async_insert"] = 0
+		if c.config.AsyncInsertWait {
+			settings["wait_for_async_insert"] = 1
+		}
+	}
+
+	batch, err := c.config.ClickHouse.PrepareBatch(clickhouse.Context(ctx, clickhouse.WithSettings(settings)), query.BatchSQL())
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to prepare batch: %w", err)
+	}
+
+	for i, column := range query.Columns() {
+		err = batch.Column(i).Append(column)
+		if err != nil {
+			_ = batch.Abort()
+			return fmt.Errorf("failed to append column %s to batch: %w", insertEventsColumns[i], err)
+		}
 	}
 
-	err = c.config.ClickHouse.Exec(ctx, sql, args...)
+	err = batch.Send()
 	if err != nil {
 		return fmt.Errorf("failed to batch insert events: %w", err)
 	}
@@ -47,9 +105,41 @@ func (c *ClickHouseStorage) BatchInsert(ctx context.Context, messages []SinkMess
 	return nil
 }
 
+// StoreProgress stores the progress of the sink in the sink progress table.
+func (c *ClickHouseStorage) StoreProgress(ctx context.Context, progress []PartitionProgress) error {
+	tableName := clickhouse_connector.GetSinkProgressTableName(c.config.Database)
+
+	batch, err := c.config.ClickHouse.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s (namespace, partition, offset, complete_until, updated_at)", tableName))
+	if err != nil {
+		return fmt.Errorf("failed to prepare batch: %w", err)
+	}
+
+	for _, p := range progress {
+		err = batch.Append(p.Namespace, p.Partition, p.Offset, p.CompleteUntil, p.UpdatedAt)
+		if err != nil {
+			_ = batch.Abort()
+			return fmt.Errorf("failed to append progress to batch: %w", err)
+		}
+	}
+
+	err = batch.Send()
+	if err != nil {
+		return fmt.Errorf("failed to store progress: %w", err)
+	}
+
+	return nil
+}
+
+// insertEventsColumns are the columns of the events table written by the sink
+var insertEventsColumns = []string{"namespace", "validation_error", "id", "type", "source", "subject", "time", "data", "original_subject", "datacontenttype"}
+
+// InsertEventsQuery inserts a batch of events into the events table,
+// either as a single statement with values or as a native columnar batch.
 type InsertEventsQuery struct {
 	Database string
 	Messages []SinkMessage
+	// DeduplicationToken makes ClickHouse ignore the insert if a block with the same token was inserted before
+	DeduplicationToken string
 }
 
 func (q InsertEventsQuery) ToSQL() (string, []interface{}, error) {
@@ -57,26 +147,72 @@ func (q InsertEventsQuery) ToSQL() (string, []interface{}, error) {
 
 	query := sqlbuilder.ClickHouse.NewInsertBuilder()
 	query.InsertInto(tableName)
-	query.Cols("namespace", "validation_error", "id", "type", "source", "subject", "time", "data")
+	query.Cols(insertEventsColumns...)
 
-	for _, message := range q.Messages {
-		var eventErr string
-		if message.Error != nil {
-			eventErr = message.Error.Error()
-		}
+	if q.DeduplicationToken != "" {
+		query.SQL(query.Var(sqlbuilder.Build("SETTINGS insert_deduplication_token = $?", q.DeduplicationToken)))
+	}
 
+	for _, message := range q.Messages {
 		query.Values(
 			message.Namespace,
-			eventErr,
+			validationError(message),
 			message.Serialized.Id,
 			message.Serialized.Type,
 			message.Serialized.Source,
 			message.Serialized.Subject,
 			message.Serialized.Time,
 			message.Serialized.Data,
+			message.Serialized.OriginalSubject,
+			message.Serialized.DataContentType,
 		)
 	}
 
 	sql, args := query.Build()
 	return sql, args, nil
 }
+
+// BatchSQL returns the statement of a native batch, the values are appended by column with Columns.
+// The deduplication token of a native batch is set in the query settings of the context.
+func (q InsertEventsQuery) BatchSQL() string {
+	tableName := clickhouse_connector.GetEventsTableName(q.Database)
+
+	return fmt.Sprintf("INSERT INTO %s (%s)", tableName, strings.Join(insertEventsColumns, ", "))
+}
+
+// Columns returns the values of the events by column in the order of BatchSQL.
+func (q InsertEventsQuery) Columns() []any {
+	namespaces := make([]string, 0, len(q.Messages))
+	validationErrors := make([]string, 0, len(q.Messages))
+	ids := make([]string, 0, len(q.Messages))
+	types := make([]string, 0, len(q.Messages))
+	sources := make([]string, 0, len(q.Messages))
+	subjects := make([]string, 0, len(q.Messages))
+	times := make([]int64, 0, len(q.Messages))
+	data := make([]string, 0, len(q.Messages))
+	originalSubjects := make([]string, 0, len(q.Messages))
+	dataContentTypes := make([]string, 0, len(q.Messages))
+
+	for _, message := range q.Messages {
+		namespaces = append(namespaces, message.Namespace)
+		validationErrors = append(validationErrors, validationError(message))
+		ids = append(ids, message.Serialized.Id)
+		types = append(types, message.Serialized.Type)
+		sources = append(sources, message.Serialized.Source)
+		subjects = append(subjects, message.Serialized.Subject)
+		times = append(times, message.Serialized.Time)
+		data = append(data, message.Serialized.Data)
+		originalSubjects = append(originalSubjects, message.Serialized.OriginalSubject)
+		dataContentTypes = append(dataContentTypes, message.Serialized.DataContentType)
+	}
+
+	return []any{namespaces, validationErrors, ids, types, sources, subjects, times, data, originalSubjects, dataContentTypes}
+}
+
+func validationError(message SinkMessage) string {
+	if message.Error == nil {
+		return ""
+	}
+
+	return message.Error.Error()
+}
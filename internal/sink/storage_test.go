@@ -1,13 +1,22 @@
 package sink_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/openmeterio/openmeter/internal/ingest/kafkaingest/serializer"
 	"github.com/openmeterio/openmeter/internal/sink"
+	"github.com/openmeterio/openmeter/internal/streaming/clickhouse_connector"
 )
 
 func TestInsertEventsQuery(t *testing.T) {
@@ -36,6 +45,8 @@ func TestInsertEventsQuery(t *testing.T) {
 					Time:    now.UnixMilli(),
 					Type:    "api-calls",
 					Data:    `{"duration_ms": 80, "method": "GET", "path": "/api/v1"}`,
+
+					OriginalSubject: "user-2",
 				},
 			},
 			{
@@ -56,10 +67,195 @@ func TestInsertEventsQuery(t *testing.T) {
 	sql, args, err := query.ToSQL()
 	assert.NoError(t, err)
 	assert.Equal(t, args, []interface{}{
-		"my_namespace", "", "1", "api-calls", "source", "subject-1", now.UnixMilli(), `{"duration_ms": 100, "method": "GET", "path": "/api/v1"}`,
-		"my_namespace", "", "2", "api-calls", "source", "subject-2", now.UnixMilli(), `{"duration_ms": 80, "method": "GET", "path": "/api/v1"}`,
-		"my_namespace", "event data value cannot be parsed as float64: not a number", "3", "api-calls", "source", "subject-2", now.UnixMilli(), `{"duration_ms": "foo", "method": "GET", "path": "/api/v1"}`,
+		"my_namespace", "", "1", "api-calls", "source", "subject-1", now.UnixMilli(), `{"duration_ms": 100, "method": "GET", "path": "/api/v1"}`, "", "",
+		"my_namespace", "", "2", "api-calls", "source", "subject-2", now.UnixMilli(), `{"duration_ms": 80, "method": "GET", "path": "/api/v1"}`, "user-2", "",
+		"my_namespace", "event data value cannot be parsed as float64: not a number", "3", "api-calls", "source", "subject-2", now.UnixMilli(), `{"duration_ms": "foo", "method": "GET", "path": "/api/v1"}`, "", "",
+	})
+	assert.Equal(t, `INSERT INTO database.om_events (namespace, validation_error, id, type, source, subject, time, data, original_subject, datacontenttype) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, sql)
+
+	// Native batches are sent by column
+	assert.Equal(t, `INSERT INTO database.om_events (namespace, validation_error, id, type, source, subject, time, data, original_subject, datacontenttype)`, query.BatchSQL())
+	assert.Equal(t, []any{
+		[]string{"my_namespace", "my_namespace", "my_namespace"},
+		[]string{"", "", "event data value cannot be parsed as float64: not a number"},
+		[]string{"1", "2", "3"},
+		[]string{"api-calls", "api-calls", "api-calls"},
+		[]string{"source", "source", "source"},
+		[]string{"subject-1", "subject-2", "subject-2"},
+		[]int64{now.UnixMilli(), now.UnixMilli(), now.UnixMilli()},
+		[]string{`{"duration_ms": 100, "method": "GET", "path": "/api/v1"}`, `{"duration_ms": 80, "method": "GET", "path": "/api/v1"}`, `{"duration_ms": "foo", "method": "GET", "path": "/api/v1"}`},
+		[]string{"", "user-2", ""},
+		[]string{"", "", ""},
+	}, query.Columns())
+
+	// Retried flushes carry the same deduplication token
+	query.Messages = query.Messages[:1]
+	query.DeduplicationToken = "om_my_namespace_events-0-10-20"
+
+	sql, args, err = query.ToSQL()
+	assert.NoError(t, err)
+	assert.Equal(t, args, []interface{}{
+		"om_my_namespace_events-0-10-20",
+		"my_namespace", "", "1", "api-calls", "source", "subject-1", now.UnixMilli(), `{"duration_ms": 100, "method": "GET", "path": "/api/v1"}`, "", "",
 	})
-	assert.Equal(t, `INSERT INTO database.om_events (namespace, validation_error, id, type, source, subject, time, data) VALUES (?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?)`, sql)
+	assert.Equal(t, `INSERT INTO database.om_events (namespace, validation_error, id, type, source, subject, time, data, original_subject, datacontenttype) SETTINGS insert_deduplication_token = ? VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, sql)
+}
+
+type recordingStorage struct {
+	name    string
+	calls   *[]string
+	failing bool
+}
+
+func (s recordingStorage) BatchInsert(_ context.Context, _ []sink.SinkMessage) error {
+	*s.calls = append(*s.calls, s.name)
+
+	if s.failing {
+		return errors.New("storage unavailable")
+	}
+
+	return nil
+}
+
+func TestFanOutStorage(t *testing.T) {
+	var calls []string
+
+	storage := sink.NewFanOutStorage(
+		recordingStorage{name: "archive", calls: &calls},
+		recordingStorage{name: "clickhouse", calls: &calls},
+	)
+
+	err := storage.BatchInsert(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"archive", "clickhouse"}, calls)
+
+	// A failing storage stops the batch
+	calls = nil
+
+	storage = sink.NewFanOutStorage(
+		recordingStorage{name: "archive", calls: &calls, failing: true},
+		recordingStorage{name: "clickhouse", calls: &calls},
+	)
 
+	err = storage.BatchInsert(context.Background(), nil)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"archive"}, calls)
+}
+
+func benchmarkMessages(size int) []sink.SinkMessage {
+	data := fmt.Sprintf(`{"duration_ms": 100, "method": "GET", "path": "/api/v1", "payload": %q}`, strings.Repeat("x", 1024))
+
+	messages := make([]sink.SinkMessage, 0, size)
+	for i := 0; i < size; i++ {
+		messages = append(messages, sink.SinkMessage{
+			Namespace: "benchmark",
+			Serialized: &serializer.CloudEventsKafkaPayload{
+				Id:      fmt.Sprintf("%d", i),
+				Source:  "source",
+				Subject: fmt.Sprintf("subject-%d", i%100),
+				Time:    time.Now().Unix(),
+				Type:    "api-calls",
+				Data:    data,
+			},
+		})
+	}
+
+	return messages
+}
+
+// BenchmarkInsertEventsQuery compares the client side cost of building a statement with values and native columns.
+func BenchmarkInsertEventsQuery(b *testing.B) {
+	for _, size := range []int{500, 5000} {
+		query := sink.InsertEventsQuery{
+			Database: "openmeter",
+			Messages: benchmarkMessages(size),
+		}
+
+		b.Run(fmt.Sprintf("values/%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				_, _, _ = query.ToSQL()
+			}
+		})
+
+		b.Run(fmt.Sprintf("columns/%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				_ = query.Columns()
+			}
+		})
+	}
+}
+
+// BenchmarkClickHouseStorage compares insert throughput against ClickHouse, set CLICKHOUSE_ADDRESS to run it.
+func BenchmarkClickHouseStorage(b *testing.B) {
+	address := os.Getenv("CLICKHOUSE_ADDRESS")
+	if address == "" {
+		b.Skip("CLICKHOUSE_ADDRESS not set")
+	}
+
+	ctx := context.Background()
+	database := "openmeter_benchmark"
+
+	conn, err := clickhouse.Open(&clickhouse.Options{
+		Addr: []string{address},
+	})
+	require.NoError(b, err)
+	defer conn.Close()
+
+	require.NoError(b, conn.Exec(ctx, fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", database)))
+	defer func() {
+		_ = conn.Exec(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s", database))
+	}()
+
+	connector, err := clickhouse_connector.NewClickhouseConnector(clickhouse_connector.ClickhouseConnectorConfig{
+		Logger:     slog.Default(),
+		ClickHouse: conn,
+		Database:   database,
+	})
+	require.NoError(b, err)
+	require.NoError(b, connector.CreateNamespace(ctx, "benchmark"))
+
+	storages := []struct {
+		name   string
+		insert func(ctx context.Context, messages []sink.SinkMessage) error
+	}{
+		{
+			name: "values",
+			insert: func(ctx context.Context, messages []sink.SinkMessage) error {
+				sql, args, err := sink.InsertEventsQuery{Database: database, Messages: messages}.ToSQL()
+				if err != nil {
+					return err
+				}
+
+				return conn.Exec(ctx, sql, args...)
+			},
+		},
+		{
+			name:   "native",
+			insert: sink.NewClickhouseStorage(sink.ClickHouseStorageConfig{ClickHouse: conn, Database: database}).BatchInsert,
+		},
+		{
+			name:   "native-async",
+			insert: sink.NewClickhouseStorage(sink.ClickHouseStorageConfig{ClickHouse: conn, Database: database, AsyncInsert: true, AsyncInsertWait: true}).BatchInsert,
+		},
+	}
+
+	for _, size := range []int{500, 5000} {
+		messages := benchmarkMessages(size)
+
+		for _, storage := range storages {
+			b.Run(fmt.Sprintf("%s/%d", storage.name, size), func(b *testing.B) {
+				b.ReportAllocs()
+
+				for i := 0; i < b.N; i++ {
+					require.NoError(b, storage.insert(ctx, messages))
+				}
+
+				b.ReportMetric(float64(size*b.N)/b.Elapsed().Seconds(), "events/s")
+			})
+		}
+	}
 }
@@ -0,0 +1,166 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+type deduplicationTokenKey struct{}
+
+// WithDeduplicationToken returns a context carrying the deduplication token of a batch.
+func WithDeduplicationToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, deduplicationTokenKey{}, token)
+}
+
+// DeduplicationTokenFromContext returns the deduplication token of the batch being inserted.
+//
+// The token is derived from the topic, partition and offset range of the batch,
+// so storages can use it to ignore batches replayed after a crash.
+func DeduplicationTokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(deduplicationTokenKey{}).(string)
+
+	return token, ok && token != ""
+}
+
+// flushIntentPrefix marks offset commit metadata recording the offset range of a flush in progress.
+const flushIntentPrefix = "openmeter-sink-flush:"
+
+// flushBlock is the part of a flushed batch from a single topic partition.
+type flushBlock struct {
+	Topic       string
+	Partition   int32
+	FirstOffset kafka.Offset
+	LastOffset  kafka.Offset
+	Messages    []SinkMessage
+}
+
+func newFlushBlock(messages []SinkMessage) flushBlock {
+	first := messages[0].KafkaMessage.TopicPartition
+	last := messages[len(messages)-1].KafkaMessage.TopicPartition
+
+	return flushBlock{
+		Topic:       *first.Topic,
+		Partition:   first.Partition,
+		FirstOffset: first.Offset,
+		LastOffset:  last.Offset,
+		Messages:    messages,
+	}
+}
+
+// DeduplicationToken returns a token identifying the block by its offset range.
+func (b flushBlock) DeduplicationToken() string {
+	return fmt.Sprintf("%s-%d-%d-%d", b.Topic, b.Partition, b.FirstOffset, b.LastOffset)
+}
+
+// Intent returns the offset to commit before persisting the block,
+// its metadata records the offset range so a replay after a crash can flush the same block.
+func (b flushBlock) Intent() kafka.TopicPartition {
+	topic := b.Topic
+	metadata := fmt.Sprintf("%s%d-%d", flushIntentPrefix, b.FirstOffset, b.LastOffset)
+
+	return kafka.TopicPartition{
+		Topic:     &topic,
+		Partition: b.Partition,
+		Offset:    b.FirstOffset,
+		Metadata:  &metadata,
+	}
+}
+
+// parseFlushIntent returns the offset range of a flush intent recorded in offset commit metadata.
+func parseFlushIntent(partition kafka.TopicPartition) (first kafka.Offset, last kafka.Offset, ok bool) {
+	if partition.Metadata == nil {
+		return 0, 0, false
+	}
+
+	var f, l int64
+	if _, err := fmt.Sscanf(*partition.Metadata, flushIntentPrefix+"%d-%d", &f, &l); err != nil {
+		return 0, 0, false
+	}
+
+	// Offsets were committed after the flush, the intent is stale
+	if partition.Offset != kafka.Offset(f) {
+		return 0, 0, false
+	}
+
+	return kafka.Offset(f), kafka.Offset(l), true
+}
+
+// flushBlocks groups messages into blocks by topic partition.
+//
+// Partitions replaying a flush interrupted by a crash are flushed with the same offset range as before:
+// their messages are held back until the range is complete, and messages after the range wait for the next flush.
+func flushBlocks(messages []SinkMessage, replays map[string]kafka.Offset) ([]flushBlock, []SinkMessage) {
+	sort.Slice(messages, func(i, j int) bool {
+		a, b := messages[i].KafkaMessage.TopicPartition, messages[j].KafkaMessage.TopicPartition
+
+		if *a.Topic != *b.Topic {
+			return *a.Topic < *b.Topic
+		}
+
+		if a.Partition != b.Partition {
+			return a.Partition < b.Partition
+		}
+
+		return a.Offset < b.Offset
+	})
+
+	var blocks []flushBlock
+	var pending []SinkMessage
+
+	for start := 0; start < len(messages); {
+		key := topicPartitionKey(messages[start].KafkaMessage.TopicPartition)
+
+		end := start
+		for end < len(messages) && topicPartitionKey(messages[end].KafkaMessage.TopicPartition) == key {
+			end++
+		}
+
+		group := messages[start:end]
+		start = end
+
+		replayEnd, ok := replays[key]
+		if !ok || group[0].KafkaMessage.TopicPartition.Offset > replayEnd {
+			blocks = append(blocks, newFlushBlock(group))
+
+			continue
+		}
+
+		// The interrupted flush is not fully consumed yet
+		if group[len(group)-1].KafkaMessage.TopicPartition.Offset < replayEnd {
+			pending = append(pending, group...)
+
+			continue
+		}
+
+		split := sort.Search(len(group), func(i int) bool {
+			return group[i].KafkaMessage.TopicPartition.Offset > replayEnd
+		})
+
+		blocks = append(blocks, newFlushBlock(group[:split]))
+		pending = append(pending, group[split:]...)
+	}
+
+	return blocks, pending
+}
+
+// groupBlocksByNamespace groups blocks by the namespace of their messages, namespaces are returned in order.
+func groupBlocksByNamespace(blocks []flushBlock) ([]string, map[string][]flushBlock) {
+	var namespaces []string
+	byNamespace := map[string][]flushBlock{}
+
+	for _, block := range blocks {
+		namespace := block.Messages[0].Namespace
+
+		if _, ok := byNamespace[namespace]; !ok {
+			namespaces = append(namespaces, namespace)
+		}
+		byNamespace[namespace] = append(byNamespace[namespace], block)
+	}
+
+	sort.Strings(namespaces)
+
+	return namespaces, byNamespace
+}
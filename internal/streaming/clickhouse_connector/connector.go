@@ -20,6 +20,8 @@ import (
 var (
 	tablePrefix     = "om_"
 	EventsTableName = "events"
+	// SinkProgressTableName is the table the sink reports its progress to, see Freshness
+	SinkProgressTableName = "sink_progress"
 )
 
 // ClickhouseConnector implements `ingest.Connector“ and `namespace.Handler interfaces.
@@ -145,12 +147,30 @@ func (c *ClickhouseConnector) ListMeterSubjects(ctx context.Context, namespace s
 	return subjects, nil
 }
 
+func (c *ClickhouseConnector) GetFreshness(ctx context.Context, namespace string) (streaming.Freshness, error) {
+	if namespace == "" {
+		return streaming.Freshness{}, fmt.Errorf("namespace is required")
+	}
+
+	freshness, err := c.querySinkProgress(ctx, namespace)
+	if err != nil {
+		return streaming.Freshness{}, fmt.Errorf("get freshness: %w", err)
+	}
+
+	return freshness, nil
+}
+
 func (c *ClickhouseConnector) CreateNamespace(ctx context.Context, namespace string) error {
 	err := c.createEventsTable(ctx, namespace)
 	if err != nil {
 		return fmt.Errorf("create namespace in clickhouse: %w", err)
 	}
 
+	err = c.createSinkProgressTable(ctx)
+	if err != nil {
+		return fmt.Errorf("create namespace in clickhouse: %w", err)
+	}
+
 	return nil
 }
 
@@ -196,9 +216,69 @@ func (c *ClickhouseConnector) createEventsTable(ctx context.Context, namespace s
 		return fmt.Errorf("create events table: %w", err)
 	}
 
+	for _, sql := range (migrateEventsTable{Database: c.config.Database}).toSQL() {
+		err = c.config.ClickHouse.Exec(ctx, sql)
+		if err != nil {
+			return fmt.Errorf("migrate events table: %w", err)
+		}
+	}
+
 	return nil
 }
 
+func (c *ClickhouseConnector) createSinkProgressTable(ctx context.Context) error {
+	table := createSinkProgressTable{
+		Database: c.config.Database,
+	}
+
+	err := c.config.ClickHouse.Exec(ctx, table.toSQL())
+	if err != nil {
+		return fmt.Errorf("create sink progress table: %w", err)
+	}
+
+	return nil
+}
+
+func (c *ClickhouseConnector) querySinkProgress(ctx context.Context, namespace string) (streaming.Freshness, error) {
+	query := querySinkProgress{
+		Database:  c.config.Database,
+		Namespace: namespace,
+	}
+
+	sql, args := query.toSQL()
+
+	rows, err := c.config.ClickHouse.Query(ctx, sql, args...)
+	if err != nil {
+		return streaming.Freshness{}, fmt.Errorf("query sink progress: %w", err)
+	}
+	defer rows.Close()
+
+	freshness := streaming.Freshness{
+		Partitions: []streaming.PartitionFreshness{},
+	}
+
+	for rows.Next() {
+		var partition streaming.PartitionFreshness
+		if err = rows.Scan(&partition.Partition, &partition.Offset, &partition.CompleteUntil, &partition.UpdatedAt); err != nil {
+			return streaming.Freshness{}, err
+		}
+
+		// Usage data is complete up to the partition that is the most behind
+		if freshness.CompleteUntil == nil || partition.CompleteUntil.Before(*freshness.CompleteUntil) {
+			completeUntil := partition.CompleteUntil
+			freshness.CompleteUntil = &completeUntil
+		}
+
+		freshness.Partitions = append(freshness.Partitions, partition)
+	}
+
+	if err = rows.Err(); err != nil {
+		return streaming.Freshness{}, fmt.Errorf("query sink progress: %w", err)
+	}
+
+	return freshness, nil
+}
+
 func (c *ClickhouseConnector) queryEventsTable(ctx context.Context, namespace string, params streaming.ListEventsParams) ([]api.IngestedEvent, error) {
 	table := queryEventsTable{
 		Database:  c.config.Database,
@@ -220,6 +300,7 @@ func (c *ClickhouseConnector) queryEventsTable(ctx context.Context, namespace st
 
 		return nil, fmt.Errorf("query events table query: %w", err)
 	}
+	defer rows.Close()
 
 	events := []api.IngestedEvent{}
 
@@ -231,27 +312,39 @@ func (c *ClickhouseConnector) queryEventsTable(ctx context.Context, namespace st
 		var time time.Time
 		var dataStr string
 		var validationError string
+		var originalSubject string
+		var dataContentType string
 
-		if err = rows.Scan(&id, &eventType, &subject, &source, &time, &dataStr, &validationError); err != nil {
+		if err = rows.Scan(&id, &eventType, &subject, &source, &time, &dataStr, &validationError, &originalSubject, &dataContentType); err != nil {
 			return nil, err
 		}
 
-		// Parse data
-		var data interface{}
-		err := json.Unmarshal([]byte(dataStr), &data)
-		if err != nil {
-			return nil, fmt.Errorf("query events parse data: %w", err)
-		}
-
 		event := event.New()
 		event.SetID(id)
 		event.SetType(eventType)
 		event.SetSubject(subject)
 		event.SetSource(source)
 		event.SetTime(time)
-		err = event.SetData("application/json", data)
-		if err != nil {
-			return nil, fmt.Errorf("query events set data: %w", err)
+		if originalSubject != "" {
+			event.SetExtension("originalsubject", originalSubject)
+		}
+
+		// Data is JSON unless it was stored as is with its content type
+		if dataContentType == "" {
+			var data interface{}
+			err := json.Unmarshal([]byte(dataStr), &data)
+			if err != nil {
+				return nil, fmt.Errorf("query events parse data: %w", err)
+			}
+
+			err = event.SetData("application/json", data)
+			if err != nil {
+				return nil, fmt.Errorf("query events set data: %w", err)
+			}
+		} else {
+			// Returned as a string, SetData would encode bytes in base64
+			event.SetDataContentType(dataContentType)
+			event.DataEncoded = []byte(dataStr)
 		}
 
 		ingestedEvent := api.IngestedEvent{
@@ -265,6 +358,10 @@ func (c *ClickhouseConnector) queryEventsTable(ctx context.Context, namespace st
 		events = append(events, ingestedEvent)
 	}
 
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("query events table rows: %w", err)
+	}
+
 	return events, nil
 }
 
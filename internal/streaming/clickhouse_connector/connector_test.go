@@ -0,0 +1,91 @@
+package clickhouse_connector
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/streaming"
+)
+
+func TestListEventsWithNonJSONData(t *testing.T) {
+	eventTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	conn := &fakeConn{
+		rows: [][]any{
+			{"1", "api-calls", "subject-1", "source", eventTime, `{"method":"GET"}`, "", "", ""},
+			{"2", "api-calls", "subject-1", "source", eventTime, `<call method="GET"/>`, "no value", "", "application/xml"},
+		},
+	}
+
+	connector, err := NewClickhouseConnector(ClickhouseConnectorConfig{
+		ClickHouse: conn,
+		Database:   "openmeter",
+	})
+	require.NoError(t, err)
+
+	events, err := connector.ListEvents(context.Background(), "default", streaming.ListEventsParams{Limit: 100})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, "application/json", events[0].Event.DataContentType())
+	assert.JSONEq(t, `{"method":"GET"}`, string(events[0].Event.Data()))
+
+	// Data that is not JSON is returned as is
+	assert.Equal(t, "application/xml", events[1].Event.DataContentType())
+	assert.Equal(t, `<call method="GET"/>`, string(events[1].Event.Data()))
+	assert.Equal(t, "no value", *events[1].ValidationError)
+
+	body, err := json.Marshal(events[1].Event)
+	require.NoError(t, err)
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, `<call method="GET"/>`, payload["data"])
+}
+
+// fakeConn is a ClickHouse connection returning the same rows to every query.
+type fakeConn struct {
+	driver.Conn
+
+	rows [][]any
+}
+
+func (c *fakeConn) Query(_ context.Context, _ string, _ ...any) (driver.Rows, error) {
+	return &fakeRows{rows: c.rows, index: -1}, nil
+}
+
+type fakeRows struct {
+	driver.Rows
+
+	rows  [][]any
+	index int
+}
+
+func (r *fakeRows) Next() bool {
+	r.index++
+
+	return r.index < len(r.rows)
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(reflect.ValueOf(r.rows[r.index][i]))
+	}
+
+	return nil
+}
+
+func (r *fakeRows) Err() error {
+	return nil
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
@@ -37,14 +37,84 @@ func (d createEventsTable) toSQL() string {
 	sb.Define("source", "String")
 	sb.Define("time", "DateTime")
 	sb.Define("data", "String")
+	sb.Define("original_subject", "String")
+	// datacontenttype is only set for data that is not JSON, it is stored as is
+	sb.Define("datacontenttype", "String")
 	sb.SQL("ENGINE = MergeTree")
 	sb.SQL("PARTITION BY toYYYYMM(time)")
 	sb.SQL("ORDER BY (namespace, time, type, subject)")
+	// Sink inserts are deduplicated by token, see insert_deduplication_token
+	sb.SQL(fmt.Sprintf("SETTINGS non_replicated_deduplication_window = %d", eventsDeduplicationWindow))
 
 	sql, _ := sb.Build()
 	return sql
 }
 
+// eventsDeduplicationWindow is the number of recent inserts ClickHouse keeps deduplication tokens of
+const eventsDeduplicationWindow = 1000
+
+// Migrate an events table created by an earlier version
+type migrateEventsTable struct {
+	Database string
+}
+
+func (d migrateEventsTable) toSQL() []string {
+	tableName := GetEventsTableName(d.Database)
+
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS original_subject String", tableName),
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS datacontenttype String", tableName),
+		fmt.Sprintf("ALTER TABLE %s MODIFY SETTING non_replicated_deduplication_window = %d", tableName, eventsDeduplicationWindow),
+	}
+}
+
+// Create Sink Progress Table
+// The sink reports how far it stored the events of every partition, the latest report of a partition wins.
+type createSinkProgressTable struct {
+	Database string
+}
+
+func (d createSinkProgressTable) toSQL() string {
+	tableName := GetSinkProgressTableName(d.Database)
+
+	sb := sqlbuilder.ClickHouse.NewCreateTableBuilder()
+	sb.CreateTable(tableName)
+	sb.IfNotExists()
+	sb.Define("namespace", "String")
+	sb.Define("partition", "Int32")
+	sb.Define("offset", "Int64")
+	sb.Define("complete_until", "DateTime")
+	sb.Define("updated_at", "DateTime64(3)")
+	sb.SQL("ENGINE = ReplacingMergeTree(updated_at)")
+	sb.SQL("ORDER BY (namespace, partition)")
+
+	sql, _ := sb.Build()
+	return sql
+}
+
+type querySinkProgress struct {
+	Database  string
+	Namespace string
+}
+
+func (d querySinkProgress) toSQL() (string, []interface{}) {
+	tableName := GetSinkProgressTableName(d.Database)
+
+	query := sqlbuilder.ClickHouse.NewSelectBuilder()
+	query.Select(
+		"partition",
+		"argMax(offset, updated_at)",
+		"argMax(complete_until, updated_at)",
+		"max(updated_at)",
+	)
+	query.From(tableName)
+	query.Where(query.Equal("namespace", d.Namespace))
+	query.GroupBy("partition")
+	query.OrderBy("partition")
+
+	return query.Build()
+}
+
 type queryEventsTable struct {
 	Database  string
 	Namespace string
@@ -58,7 +128,7 @@ func (d queryEventsTable) toSQL() (string, []interface{}, error) {
 	where := []string{}
 
 	query := sqlbuilder.ClickHouse.NewSelectBuilder()
-	query.Select("id", "type", "subject", "source", "time", "data", "validation_error")
+	query.Select("id", "type", "subject", "source", "time", "data", "validation_error", "original_subject", "datacontenttype")
 	query.From(tableName)
 
 	where = append(where, query.Equal("namespace", d.Namespace))
@@ -413,6 +483,10 @@ func GetEventsTableName(database string) string {
 	return fmt.Sprintf("%s.%s%s", sqlbuilder.Escape(database), tablePrefix, EventsTableName)
 }
 
+func GetSinkProgressTableName(database string) string {
+	return fmt.Sprintf("%s.%s%s", sqlbuilder.Escape(database), tablePrefix, SinkProgressTableName)
+}
+
 func GetMeterViewName(database string, namespace string, meterSlug string) string {
 	meterViewName := fmt.Sprintf("%s%s_%s", tablePrefix, namespace, meterSlug)
 	return fmt.Sprintf("%s.%s", sqlbuilder.Escape(database), sqlbuilder.Escape(meterViewName))
@@ -18,7 +18,7 @@ func TestCreateEventsTable(t *testing.T) {
 			data: createEventsTable{
 				Database: "openmeter",
 			},
-			want: "CREATE TABLE IF NOT EXISTS openmeter.om_events (namespace String, validation_error String, id String, type LowCardinality(String), subject String, source String, time DateTime, data String) ENGINE = MergeTree PARTITION BY toYYYYMM(time) ORDER BY (namespace, time, type, subject)",
+			want: "CREATE TABLE IF NOT EXISTS openmeter.om_events (namespace String, validation_error String, id String, type LowCardinality(String), subject String, source String, time DateTime, data String, original_subject String, datacontenttype String) ENGINE = MergeTree PARTITION BY toYYYYMM(time) ORDER BY (namespace, time, type, subject) SETTINGS non_replicated_deduplication_window = 1000",
 		},
 	}
 
@@ -31,6 +31,37 @@ func TestCreateEventsTable(t *testing.T) {
 	}
 }
 
+func TestMigrateEventsTable(t *testing.T) {
+	table := migrateEventsTable{
+		Database: "openmeter",
+	}
+
+	assert.Equal(t, []string{
+		"ALTER TABLE openmeter.om_events ADD COLUMN IF NOT EXISTS original_subject String",
+		"ALTER TABLE openmeter.om_events ADD COLUMN IF NOT EXISTS datacontenttype String",
+		"ALTER TABLE openmeter.om_events MODIFY SETTING non_replicated_deduplication_window = 1000",
+	}, table.toSQL())
+}
+
+func TestCreateSinkProgressTable(t *testing.T) {
+	table := createSinkProgressTable{
+		Database: "openmeter",
+	}
+
+	assert.Equal(t, "CREATE TABLE IF NOT EXISTS openmeter.om_sink_progress (namespace String, partition Int32, offset Int64, complete_until DateTime, updated_at DateTime64(3)) ENGINE = ReplacingMergeTree(updated_at) ORDER BY (namespace, partition)", table.toSQL())
+}
+
+func TestQuerySinkProgress(t *testing.T) {
+	query := querySinkProgress{
+		Database:  "openmeter",
+		Namespace: "my_namespace",
+	}
+
+	sql, args := query.toSQL()
+	assert.Equal(t, "SELECT partition, argMax(offset, updated_at), argMax(complete_until, updated_at), max(updated_at) FROM openmeter.om_sink_progress WHERE namespace = ? GROUP BY partition ORDER BY partition", sql)
+	assert.Equal(t, []interface{}{"my_namespace"}, args)
+}
+
 func TestQueryEventsTable(t *testing.T) {
 	tests := []struct {
 		query    queryEventsTable
@@ -43,7 +74,7 @@ func TestQueryEventsTable(t *testing.T) {
 				Namespace: "my_namespace",
 				Limit:     100,
 			},
-			wantSQL:  "SELECT id, type, subject, source, time, data, validation_error FROM openmeter.om_events WHERE namespace = ? ORDER BY time DESC LIMIT 100",
+			wantSQL:  "SELECT id, type, subject, source, time, data, validation_error, original_subject, datacontenttype FROM openmeter.om_events WHERE namespace = ? ORDER BY time DESC LIMIT 100",
 			wantArgs: []interface{}{"my_namespace"},
 		},
 	}
@@ -435,7 +466,7 @@ func TestQueryEvents(t *testing.T) {
 				To:        &toTime,
 				Limit:     10,
 			},
-			wantSQL:  "SELECT id, type, subject, source, time, data, validation_error FROM openmeter.om_events WHERE namespace = ? AND time >= ? AND time <= ? ORDER BY time DESC LIMIT 10",
+			wantSQL:  "SELECT id, type, subject, source, time, data, validation_error, original_subject, datacontenttype FROM openmeter.om_events WHERE namespace = ? AND time >= ? AND time <= ? ORDER BY time DESC LIMIT 10",
 			wantArgs: []interface{}{"my_namespace", fromTime.Unix(), toTime.Unix()},
 		},
 		{
@@ -445,7 +476,7 @@ func TestQueryEvents(t *testing.T) {
 				From:      &fromTime,
 				Limit:     10,
 			},
-			wantSQL:  "SELECT id, type, subject, source, time, data, validation_error FROM openmeter.om_events WHERE namespace = ? AND time >= ? ORDER BY time DESC LIMIT 10",
+			wantSQL:  "SELECT id, type, subject, source, time, data, validation_error, original_subject, datacontenttype FROM openmeter.om_events WHERE namespace = ? AND time >= ? ORDER BY time DESC LIMIT 10",
 			wantArgs: []interface{}{"my_namespace", fromTime.Unix()},
 		},
 		{
@@ -455,7 +486,7 @@ func TestQueryEvents(t *testing.T) {
 				To:        &toTime,
 				Limit:     10,
 			},
-			wantSQL:  "SELECT id, type, subject, source, time, data, validation_error FROM openmeter.om_events WHERE namespace = ? AND time <= ? ORDER BY time DESC LIMIT 10",
+			wantSQL:  "SELECT id, type, subject, source, time, data, validation_error, original_subject, datacontenttype FROM openmeter.om_events WHERE namespace = ? AND time <= ? ORDER BY time DESC LIMIT 10",
 			wantArgs: []interface{}{"my_namespace", toTime.Unix()},
 		},
 	}
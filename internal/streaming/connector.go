@@ -14,11 +14,30 @@ type ListEventsParams struct {
 	Limit int
 }
 
+// Freshness tells how complete the usage data stored for a namespace is, based on the progress reported by the sink.
+type Freshness struct {
+	// CompleteUntil is the time usage data is complete up to, it is the earliest of the partitions.
+	// Nil if the sink has not reported progress yet.
+	CompleteUntil *time.Time           `json:"completeUntil,omitempty"`
+	Partitions    []PartitionFreshness `json:"partitions"`
+}
+
+// PartitionFreshness is the progress of the sink on a partition of the events topic of a namespace.
+type PartitionFreshness struct {
+	Partition int32 `json:"partition"`
+	// Offset is the offset of the next message to store
+	Offset int64 `json:"offset"`
+	// CompleteUntil is the time events of the partition are stored up to
+	CompleteUntil time.Time `json:"completeUntil"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
 type Connector interface {
 	ListEvents(ctx context.Context, namespace string, params ListEventsParams) ([]api.IngestedEvent, error)
 	CreateMeter(ctx context.Context, namespace string, meter *models.Meter) error
 	DeleteMeter(ctx context.Context, namespace string, meterSlug string) error
 	QueryMeter(ctx context.Context, namespace string, meterSlug string, params *QueryParams) ([]models.MeterQueryRow, error)
 	ListMeterSubjects(ctx context.Context, namespace string, meterSlug string, from *time.Time, to *time.Time) ([]string, error)
+	GetFreshness(ctx context.Context, namespace string) (Freshness, error)
 	// Add more methods as needed ...
 }
@@ -0,0 +1,70 @@
+// Package subject implements subject aliasing: mapping subjects sent by producers to canonical subjects.
+package subject
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// AliasID is the unique identifier for an alias.
+type AliasID string
+
+type NamespacedAliasID struct {
+	Namespace string
+	ID        AliasID
+}
+
+type AliasNotFoundError struct {
+	ID AliasID
+}
+
+func (e *AliasNotFoundError) Error() string {
+	return fmt.Sprintf("subject alias not found: %s", e.ID)
+}
+
+// InvalidAliasError is returned when an alias would chain or cycle with other aliases.
+type InvalidAliasError struct {
+	Subject          string
+	CanonicalSubject string
+	Reason           string
+}
+
+func (e *InvalidAliasError) Error() string {
+	return fmt.Sprintf("invalid subject alias %s -> %s: %s", e.Subject, e.CanonicalSubject, e.Reason)
+}
+
+// Alias maps a subject (optionally from a single source) to a canonical subject.
+// Events with the aliased subject are ingested with the canonical subject, so usage rolls up under it.
+// Aliases are resolved in a single hop: a canonical subject cannot be an alias itself
+// and a subject other aliases point to cannot be aliased.
+type Alias struct {
+	Namespace string   `json:"-"`
+	ID        *AliasID `json:"id,omitempty"`
+
+	// Source The event source the alias applies to. Empty source matches every source.
+	Source string `json:"source,omitempty"`
+
+	// Subject The subject sent by the producer.
+	Subject string `json:"subject"`
+
+	// CanonicalSubject The subject events are ingested with.
+	CanonicalSubject string `json:"canonicalSubject"`
+
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+type ListAliasesParams struct {
+	Namespace string
+}
+
+// AliasConnector manages subject aliases.
+type AliasConnector interface {
+	ListAliases(ctx context.Context, params ListAliasesParams) ([]Alias, error)
+	GetAlias(ctx context.Context, id NamespacedAliasID) (Alias, error)
+	// UpsertAlias creates an alias or updates the canonical subject of an existing alias with the same source and subject.
+	// It returns an InvalidAliasError if the alias would chain or cycle with other aliases.
+	UpsertAlias(ctx context.Context, alias Alias) (Alias, error)
+	DeleteAlias(ctx context.Context, id NamespacedAliasID) error
+}
@@ -0,0 +1,173 @@
+package postgres_connector
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	db_subjectalias "github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db/subjectalias"
+	"github.com/openmeterio/openmeter/internal/subject"
+	"github.com/openmeterio/openmeter/pkg/convert"
+)
+
+type PostgresConnector struct {
+	db *db.Client
+}
+
+// Implement the AliasConnector interface
+var _ subject.AliasConnector = &PostgresConnector{}
+
+func NewPostgresConnector(db *db.Client) subject.AliasConnector {
+	return &PostgresConnector{
+		db: db,
+	}
+}
+
+// ListAliases lists the aliases of a namespace.
+func (c *PostgresConnector) ListAliases(ctx context.Context, params subject.ListAliasesParams) ([]subject.Alias, error) {
+	entities, err := c.db.SubjectAlias.Query().
+		Where(db_subjectalias.Namespace(params.Namespace)).
+		Order(db_subjectalias.BySource(), db_subjectalias.BySubject()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subject aliases: %w", err)
+	}
+
+	list := make([]subject.Alias, 0, len(entities))
+	for _, entity := range entities {
+		list = append(list, mapAliasEntity(entity))
+	}
+
+	return list, nil
+}
+
+// GetAlias gets a single alias by ID.
+func (c *PostgresConnector) GetAlias(ctx context.Context, id subject.NamespacedAliasID) (subject.Alias, error) {
+	entity, err := c.db.SubjectAlias.Query().
+		Where(db_subjectalias.ID(string(id.ID))).
+		Where(db_subjectalias.Namespace(id.Namespace)).
+		Only(ctx)
+	if err != nil {
+		if db.IsNotFound(err) {
+			return subject.Alias{}, &subject.AliasNotFoundError{ID: id.ID}
+		}
+
+		return subject.Alias{}, fmt.Errorf("failed to get subject alias: %w", err)
+	}
+
+	return mapAliasEntity(entity), nil
+}
+
+// UpsertAlias creates an alias or updates the canonical subject of an existing one.
+// Aliases are checked in a serializable transaction, so concurrent upserts cannot create a chain or a cycle together.
+func (c *PostgresConnector) UpsertAlias(ctx context.Context, alias subject.Alias) (subject.Alias, error) {
+	tx, err := c.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return subject.Alias{}, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	id, err := upsertAlias(ctx, tx, alias)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+
+		return subject.Alias{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return subject.Alias{}, fmt.Errorf("failed to commit subject alias: %w", err)
+	}
+
+	return c.GetAlias(ctx, subject.NamespacedAliasID{
+		Namespace: alias.Namespace,
+		ID:        subject.AliasID(id),
+	})
+}
+
+func upsertAlias(ctx context.Context, tx *db.Tx, alias subject.Alias) (string, error) {
+	// The canonical subject must not be aliased itself (A -> B while B -> C, or a cycle)
+	aliased, err := tx.SubjectAlias.Query().
+		Where(db_subjectalias.Namespace(alias.Namespace)).
+		Where(db_subjectalias.Subject(alias.CanonicalSubject)).
+		Exist(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to query subject aliases: %w", err)
+	}
+
+	if aliased {
+		return "", &subject.InvalidAliasError{
+			Subject:          alias.Subject,
+			CanonicalSubject: alias.CanonicalSubject,
+			Reason:           "canonical subject is an alias",
+		}
+	}
+
+	// The subject must not be the canonical subject of other aliases (A -> B while C -> A)
+	canonical, err := tx.SubjectAlias.Query().
+		Where(db_subjectalias.Namespace(alias.Namespace)).
+		Where(db_subjectalias.CanonicalSubject(alias.Subject)).
+		Exist(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to query subject aliases: %w", err)
+	}
+
+	if canonical {
+		return "", &subject.InvalidAliasError{
+			Subject:          alias.Subject,
+			CanonicalSubject: alias.CanonicalSubject,
+			Reason:           "subject is the canonical subject of other aliases",
+		}
+	}
+
+	id, err := tx.SubjectAlias.Create().
+		SetNamespace(alias.Namespace).
+		SetSource(alias.Source).
+		SetSubject(alias.Subject).
+		SetCanonicalSubject(alias.CanonicalSubject).
+		OnConflictColumns(
+			db_subjectalias.FieldNamespace,
+			db_subjectalias.FieldSource,
+			db_subjectalias.FieldSubject,
+		).
+		UpdateCanonicalSubject().
+		UpdateUpdatedAt().
+		ID(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to upsert subject alias: %w", err)
+	}
+
+	return id, nil
+}
+
+// DeleteAlias deletes an alias.
+func (c *PostgresConnector) DeleteAlias(ctx context.Context, id subject.NamespacedAliasID) error {
+	deleted, err := c.db.SubjectAlias.Delete().
+		Where(db_subjectalias.ID(string(id.ID))).
+		Where(db_subjectalias.Namespace(id.Namespace)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete subject alias: %w", err)
+	}
+
+	if deleted == 0 {
+		return &subject.AliasNotFoundError{ID: id.ID}
+	}
+
+	return nil
+}
+
+// mapAliasEntity maps a database subject alias entity to an alias model.
+func mapAliasEntity(entity *db.SubjectAlias) subject.Alias {
+	return subject.Alias{
+		ID:               (*subject.AliasID)(&entity.ID),
+		Namespace:        entity.Namespace,
+		Source:           entity.Source,
+		Subject:          entity.Subject,
+		CanonicalSubject: entity.CanonicalSubject,
+		CreatedAt:        convert.ToPointer(entity.CreatedAt.In(time.UTC)),
+		UpdatedAt:        convert.ToPointer(entity.UpdatedAt.In(time.UTC)),
+	}
+}
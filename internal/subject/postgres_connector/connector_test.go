@@ -0,0 +1,193 @@
+package postgres_connector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/credit/postgres_connector/ent/db"
+	"github.com/openmeterio/openmeter/internal/subject"
+	"github.com/openmeterio/openmeter/internal/testutils"
+)
+
+func TestAliasConnector(t *testing.T) {
+	namespace := "default"
+
+	tt := []struct {
+		name        string
+		description string
+		test        func(t *testing.T, connector subject.AliasConnector)
+	}{
+		{
+			name:        "UpsertAlias",
+			description: "Upserting an alias with the same source and subject updates the canonical subject",
+			test: func(t *testing.T, connector subject.AliasConnector) {
+				ctx := context.Background()
+
+				created, err := connector.UpsertAlias(ctx, subject.Alias{
+					Namespace:        namespace,
+					Source:           "source-1",
+					Subject:          "user-1",
+					CanonicalSubject: "customer-1",
+				})
+				require.NoError(t, err)
+				require.NotNil(t, created.ID)
+				assert.Equal(t, "customer-1", created.CanonicalSubject)
+
+				updated, err := connector.UpsertAlias(ctx, subject.Alias{
+					Namespace:        namespace,
+					Source:           "source-1",
+					Subject:          "user-1",
+					CanonicalSubject: "customer-2",
+				})
+				require.NoError(t, err)
+				assert.Equal(t, *created.ID, *updated.ID)
+				assert.Equal(t, "customer-2", updated.CanonicalSubject)
+
+				// Same subject from any source is a different alias
+				other, err := connector.UpsertAlias(ctx, subject.Alias{
+					Namespace:        namespace,
+					Subject:          "user-1",
+					CanonicalSubject: "customer-3",
+				})
+				require.NoError(t, err)
+				assert.NotEqual(t, *created.ID, *other.ID)
+
+				aliases, err := connector.ListAliases(ctx, subject.ListAliasesParams{Namespace: namespace})
+				require.NoError(t, err)
+				assert.Len(t, aliases, 2)
+			},
+		},
+		{
+			name:        "UpsertAliasChain",
+			description: "Aliases are resolved in a single hop: chains are rejected",
+			test: func(t *testing.T, connector subject.AliasConnector) {
+				ctx := context.Background()
+
+				_, err := connector.UpsertAlias(ctx, subject.Alias{
+					Namespace:        namespace,
+					Subject:          "user-2",
+					CanonicalSubject: "customer-1",
+				})
+				require.NoError(t, err)
+
+				// The canonical subject is an alias
+				_, err = connector.UpsertAlias(ctx, subject.Alias{
+					Namespace:        namespace,
+					Subject:          "user-1",
+					CanonicalSubject: "user-2",
+				})
+				assert.ErrorAs(t, err, new(*subject.InvalidAliasError))
+
+				// The subject is the canonical subject of another alias
+				_, err = connector.UpsertAlias(ctx, subject.Alias{
+					Namespace:        namespace,
+					Source:           "source-1",
+					Subject:          "customer-1",
+					CanonicalSubject: "customer-2",
+				})
+				assert.ErrorAs(t, err, new(*subject.InvalidAliasError))
+
+				// Aliases of the same canonical subject are fine
+				_, err = connector.UpsertAlias(ctx, subject.Alias{
+					Namespace:        namespace,
+					Subject:          "user-3",
+					CanonicalSubject: "customer-1",
+				})
+				require.NoError(t, err)
+
+				aliases, err := connector.ListAliases(ctx, subject.ListAliasesParams{Namespace: namespace})
+				require.NoError(t, err)
+				assert.Len(t, aliases, 2)
+			},
+		},
+		{
+			name:        "UpsertAliasCycle",
+			description: "Aliases are resolved in a single hop: cycles are rejected",
+			test: func(t *testing.T, connector subject.AliasConnector) {
+				ctx := context.Background()
+
+				_, err := connector.UpsertAlias(ctx, subject.Alias{
+					Namespace:        namespace,
+					Subject:          "user-1",
+					CanonicalSubject: "user-2",
+				})
+				require.NoError(t, err)
+
+				_, err = connector.UpsertAlias(ctx, subject.Alias{
+					Namespace:        namespace,
+					Subject:          "user-2",
+					CanonicalSubject: "user-1",
+				})
+				assert.ErrorAs(t, err, new(*subject.InvalidAliasError))
+
+				// Aliases of other namespaces do not matter
+				_, err = connector.UpsertAlias(ctx, subject.Alias{
+					Namespace:        "other",
+					Subject:          "user-2",
+					CanonicalSubject: "user-1",
+				})
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:        "GetAlias",
+			description: "Get an alias by ID, aliases of other namespaces are not found",
+			test: func(t *testing.T, connector subject.AliasConnector) {
+				ctx := context.Background()
+
+				created, err := connector.UpsertAlias(ctx, subject.Alias{
+					Namespace:        namespace,
+					Subject:          "user-1",
+					CanonicalSubject: "customer-1",
+				})
+				require.NoError(t, err)
+
+				alias, err := connector.GetAlias(ctx, subject.NamespacedAliasID{Namespace: namespace, ID: *created.ID})
+				require.NoError(t, err)
+				assert.Equal(t, created, alias)
+
+				_, err = connector.GetAlias(ctx, subject.NamespacedAliasID{Namespace: "other", ID: *created.ID})
+				assert.ErrorAs(t, err, new(*subject.AliasNotFoundError))
+			},
+		},
+		{
+			name:        "DeleteAlias",
+			description: "Delete an alias",
+			test: func(t *testing.T, connector subject.AliasConnector) {
+				ctx := context.Background()
+
+				created, err := connector.UpsertAlias(ctx, subject.Alias{
+					Namespace:        namespace,
+					Subject:          "user-1",
+					CanonicalSubject: "customer-1",
+				})
+				require.NoError(t, err)
+
+				id := subject.NamespacedAliasID{Namespace: namespace, ID: *created.ID}
+
+				require.NoError(t, connector.DeleteAlias(ctx, id))
+
+				_, err = connector.GetAlias(ctx, id)
+				assert.ErrorAs(t, err, new(*subject.AliasNotFoundError))
+
+				err = connector.DeleteAlias(ctx, id)
+				assert.ErrorAs(t, err, new(*subject.AliasNotFoundError))
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			t.Log(tc.description)
+			driver := testutils.InitPostgresDB(t)
+			databaseClient := db.NewClient(db.Driver(driver))
+			defer databaseClient.Close()
+			connector := NewPostgresConnector(databaseClient)
+			tc.test(t, connector)
+		})
+	}
+}
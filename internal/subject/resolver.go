@@ -0,0 +1,95 @@
+package subject
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type aliasKey struct {
+	Source  string
+	Subject string
+}
+
+type namespaceAliases struct {
+	aliases   map[aliasKey]string
+	fetchedAt time.Time
+}
+
+// AliasResolver resolves subjects to canonical subjects.
+//
+// Aliases are cached per namespace and refetched after the TTL expires,
+// so changes made on other instances are picked up with a delay of at most TTL.
+type AliasResolver struct {
+	connector AliasConnector
+	ttl       time.Duration
+
+	mu         sync.RWMutex
+	namespaces map[string]namespaceAliases
+}
+
+// NewAliasResolver returns a new {AliasResolver}.
+func NewAliasResolver(connector AliasConnector, ttl time.Duration) *AliasResolver {
+	return &AliasResolver{
+		connector:  connector,
+		ttl:        ttl,
+		namespaces: make(map[string]namespaceAliases),
+	}
+}
+
+// ResolveSubject returns the canonical subject for a subject sent by the given source.
+// An alias for the exact source takes precedence over an alias for any source.
+func (r *AliasResolver) ResolveSubject(ctx context.Context, namespace string, source string, subject string) (string, bool, error) {
+	aliases, err := r.aliases(ctx, namespace)
+	if err != nil {
+		return "", false, err
+	}
+
+	if canonicalSubject, ok := aliases[aliasKey{Source: source, Subject: subject}]; ok {
+		return canonicalSubject, true, nil
+	}
+
+	if canonicalSubject, ok := aliases[aliasKey{Subject: subject}]; ok {
+		return canonicalSubject, true, nil
+	}
+
+	return subject, false, nil
+}
+
+// Invalidate drops the cached aliases of a namespace.
+func (r *AliasResolver) Invalidate(namespace string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.namespaces, namespace)
+}
+
+func (r *AliasResolver) aliases(ctx context.Context, namespace string) (map[aliasKey]string, error) {
+	r.mu.RLock()
+	cached, ok := r.namespaces[namespace]
+	r.mu.RUnlock()
+
+	if ok && time.Since(cached.fetchedAt) < r.ttl {
+		return cached.aliases, nil
+	}
+
+	list, err := r.connector.ListAliases(ctx, ListAliasesParams{Namespace: namespace})
+	if err != nil {
+		return nil, fmt.Errorf("list subject aliases: %w", err)
+	}
+
+	aliases := make(map[aliasKey]string, len(list))
+	for _, alias := range list {
+		aliases[aliasKey{Source: alias.Source, Subject: alias.Subject}] = alias.CanonicalSubject
+	}
+
+	r.mu.Lock()
+	r.namespaces[namespace] = namespaceAliases{
+		aliases:   aliases,
+		fetchedAt: time.Now(),
+	}
+	r.mu.Unlock()
+
+	return aliases, nil
+}
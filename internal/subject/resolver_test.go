@@ -0,0 +1,77 @@
+package subject_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/internal/subject"
+)
+
+type staticAliasConnector struct {
+	subject.AliasConnector
+
+	aliases []subject.Alias
+	calls   int
+}
+
+func (c *staticAliasConnector) ListAliases(_ context.Context, params subject.ListAliasesParams) ([]subject.Alias, error) {
+	c.calls++
+
+	var aliases []subject.Alias
+	for _, alias := range c.aliases {
+		if alias.Namespace == params.Namespace {
+			aliases = append(aliases, alias)
+		}
+	}
+
+	return aliases, nil
+}
+
+func TestAliasResolver(t *testing.T) {
+	connector := &staticAliasConnector{
+		aliases: []subject.Alias{
+			{Namespace: "default", Subject: "user-1", CanonicalSubject: "customer-1"},
+			{Namespace: "default", Source: "crm", Subject: "user-1", CanonicalSubject: "customer-2"},
+			{Namespace: "other", Subject: "user-2", CanonicalSubject: "customer-3"},
+		},
+	}
+
+	resolver := subject.NewAliasResolver(connector, time.Hour)
+	ctx := context.Background()
+
+	tt := []struct {
+		name      string
+		namespace string
+		source    string
+		subject   string
+		want      string
+		wantFound bool
+	}{
+		{name: "AnySource", namespace: "default", source: "api", subject: "user-1", want: "customer-1", wantFound: true},
+		{name: "ExactSource", namespace: "default", source: "crm", subject: "user-1", want: "customer-2", wantFound: true},
+		{name: "NoAlias", namespace: "default", source: "api", subject: "user-2", want: "user-2"},
+		{name: "OtherNamespace", namespace: "other", source: "api", subject: "user-2", want: "customer-3", wantFound: true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got, found, err := resolver.ResolveSubject(ctx, tc.namespace, tc.source, tc.subject)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantFound, found)
+		})
+	}
+
+	// Aliases are fetched once per namespace while cached
+	assert.Equal(t, 2, connector.calls)
+
+	resolver.Invalidate("default")
+
+	_, _, err := resolver.ResolveSubject(ctx, "default", "api", "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, connector.calls)
+}
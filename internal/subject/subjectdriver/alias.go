@@ -0,0 +1,167 @@
+package subjectdriver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/openmeterio/openmeter/api"
+	"github.com/openmeterio/openmeter/internal/subject"
+	"github.com/openmeterio/openmeter/pkg/framework/commonhttp"
+	"github.com/openmeterio/openmeter/pkg/framework/transport/httptransport"
+	"github.com/openmeterio/openmeter/pkg/models"
+)
+
+type ListSubjectAliasesHandler httptransport.Handler[subject.ListAliasesParams, []subject.Alias]
+
+func (b *builder) ListSubjectAliases() ListSubjectAliasesHandler {
+	return httptransport.NewHandler(
+		func(ctx context.Context, r *http.Request) (subject.ListAliasesParams, error) {
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return subject.ListAliasesParams{}, err
+			}
+
+			return subject.ListAliasesParams{
+				Namespace: ns,
+			}, nil
+		},
+		b.AliasConnector.ListAliases,
+		commonhttp.JSONResponseEncoder,
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("listSubjectAliases"),
+		)...,
+	)
+}
+
+type GetSubjectAliasHandler httptransport.HandlerWithArgs[subject.NamespacedAliasID, subject.Alias, api.SubjectAliasID]
+
+func (b *builder) GetSubjectAlias() GetSubjectAliasHandler {
+	return httptransport.NewHandlerWithArgs(
+		func(ctx context.Context, r *http.Request, aliasID api.SubjectAliasID) (subject.NamespacedAliasID, error) {
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return subject.NamespacedAliasID{}, err
+			}
+
+			return subject.NamespacedAliasID{
+				Namespace: ns,
+				ID:        aliasID,
+			}, nil
+		},
+		b.AliasConnector.GetAlias,
+		commonhttp.JSONResponseEncoder,
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("getSubjectAlias"),
+			httptransport.WithErrorEncoder(aliasNotFoundErrorEncoder),
+		)...,
+	)
+}
+
+type UpsertSubjectAliasHandler httptransport.Handler[subject.Alias, subject.Alias]
+
+func (b *builder) UpsertSubjectAlias() UpsertSubjectAliasHandler {
+	return httptransport.NewHandler(
+		func(ctx context.Context, r *http.Request) (subject.Alias, error) {
+			alias := subject.Alias{}
+			if err := commonhttp.JSONRequestBodyDecoder(r, &alias); err != nil {
+				return alias, err
+			}
+
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return alias, err
+			}
+
+			alias.Namespace = ns
+
+			if alias.Subject == "" || alias.CanonicalSubject == "" {
+				return alias, commonhttp.NewHTTPError(
+					http.StatusBadRequest,
+					errors.New("subject and canonical subject are required"),
+				)
+			}
+
+			if alias.Subject == alias.CanonicalSubject {
+				return alias, commonhttp.NewHTTPError(
+					http.StatusBadRequest,
+					errors.New("subject cannot be an alias of itself"),
+				)
+			}
+
+			return alias, nil
+		},
+		func(ctx context.Context, alias subject.Alias) (subject.Alias, error) {
+			alias, err := b.AliasConnector.UpsertAlias(ctx, alias)
+			if err != nil {
+				return alias, err
+			}
+
+			b.invalidate(alias.Namespace)
+
+			return alias, nil
+		},
+		commonhttp.JSONResponseEncoder,
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("upsertSubjectAlias"),
+			httptransport.WithErrorEncoder(invalidAliasErrorEncoder),
+		)...,
+	)
+}
+
+type DeleteSubjectAliasHandler httptransport.HandlerWithArgs[subject.NamespacedAliasID, any, api.SubjectAliasID]
+
+func (b *builder) DeleteSubjectAlias() DeleteSubjectAliasHandler {
+	return httptransport.NewHandlerWithArgs(
+		func(ctx context.Context, r *http.Request, aliasID api.SubjectAliasID) (subject.NamespacedAliasID, error) {
+			ns, err := b.resolveNamespace(ctx)
+			if err != nil {
+				return subject.NamespacedAliasID{}, err
+			}
+
+			return subject.NamespacedAliasID{
+				Namespace: ns,
+				ID:        aliasID,
+			}, nil
+		},
+		func(ctx context.Context, id subject.NamespacedAliasID) (any, error) {
+			if err := b.AliasConnector.DeleteAlias(ctx, id); err != nil {
+				return nil, err
+			}
+
+			b.invalidate(id.Namespace)
+
+			return nil, nil
+		},
+		func(ctx context.Context, w http.ResponseWriter, response any) error {
+			w.WriteHeader(http.StatusNoContent)
+			return nil
+		},
+		httptransport.AppendOptions(
+			b.Options,
+			httptransport.WithOperationName("deleteSubjectAlias"),
+			httptransport.WithErrorEncoder(aliasNotFoundErrorEncoder),
+		)...,
+	)
+}
+
+func aliasNotFoundErrorEncoder(ctx context.Context, err error, w http.ResponseWriter) bool {
+	if _, ok := err.(*subject.AliasNotFoundError); ok {
+		models.NewStatusProblem(ctx, err, http.StatusNotFound).Respond(w)
+		return true
+	}
+
+	return false
+}
+
+func invalidAliasErrorEncoder(ctx context.Context, err error, w http.ResponseWriter) bool {
+	if _, ok := err.(*subject.InvalidAliasError); ok {
+		models.NewStatusProblem(ctx, err, http.StatusBadRequest).Respond(w)
+		return true
+	}
+
+	return false
+}
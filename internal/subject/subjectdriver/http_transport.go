@@ -0,0 +1,68 @@
+package subjectdriver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/openmeterio/openmeter/internal/namespace/namespacedriver"
+	"github.com/openmeterio/openmeter/internal/subject"
+	"github.com/openmeterio/openmeter/pkg/framework/commonhttp"
+	"github.com/openmeterio/openmeter/pkg/framework/transport/httptransport"
+)
+
+// AliasCache is notified when the aliases of a namespace change.
+type AliasCache interface {
+	Invalidate(namespace string)
+}
+
+type Handlers struct {
+	ListSubjectAliases ListSubjectAliasesHandler
+	GetSubjectAlias    GetSubjectAliasHandler
+	UpsertSubjectAlias UpsertSubjectAliasHandler
+	DeleteSubjectAlias DeleteSubjectAliasHandler
+}
+
+// New returns the subject alias handlers. The alias cache is optional.
+func New(
+	aliasConnector subject.AliasConnector,
+	aliasCache AliasCache,
+	namespaceDecoder namespacedriver.NamespaceDecoder,
+	options ...httptransport.HandlerOption,
+) Handlers {
+	builder := &builder{
+		AliasConnector:   aliasConnector,
+		AliasCache:       aliasCache,
+		NamespaceDecoder: namespaceDecoder,
+		Options:          options,
+	}
+
+	return Handlers{
+		ListSubjectAliases: builder.ListSubjectAliases(),
+		GetSubjectAlias:    builder.GetSubjectAlias(),
+		UpsertSubjectAlias: builder.UpsertSubjectAlias(),
+		DeleteSubjectAlias: builder.DeleteSubjectAlias(),
+	}
+}
+
+type builder struct {
+	AliasConnector   subject.AliasConnector
+	AliasCache       AliasCache
+	NamespaceDecoder namespacedriver.NamespaceDecoder
+	Options          []httptransport.HandlerOption
+}
+
+func (b *builder) resolveNamespace(ctx context.Context) (string, error) {
+	ns, ok := b.NamespaceDecoder.GetNamespace(ctx)
+	if !ok {
+		return "", commonhttp.NewHTTPError(http.StatusInternalServerError, errors.New("internal server error"))
+	}
+
+	return ns, nil
+}
+
+func (b *builder) invalidate(namespace string) {
+	if b.AliasCache != nil {
+		b.AliasCache.Invalidate(namespace)
+	}
+}
@@ -9,6 +9,7 @@ import (
 )
 
 type Handler = creditdriver.Handlers
+type EntitlementCache = creditdriver.EntitlementCache
 
 func New(
 	creditConnector credit.Connector,
@@ -18,6 +19,24 @@ func New(
 ) Handler {
 	return creditdriver.New(
 		creditConnector,
+		nil,
+		meterRepository,
+		namespaceDecoder,
+		options...,
+	)
+}
+
+// NewWithEntitlementCache returns the credit handlers serving entitlement checks from the entitlement cache.
+func NewWithEntitlementCache(
+	creditConnector credit.Connector,
+	entitlementCache EntitlementCache,
+	meterRepository meter.Repository,
+	namespaceDecoder namespacedriver.NamespaceDecoder,
+	options ...httptransport.HandlerOption,
+) Handler {
+	return creditdriver.New(
+		creditConnector,
+		entitlementCache,
 		meterRepository,
 		namespaceDecoder,
 		options...,
@@ -7,10 +7,18 @@ import (
 
 type Ledger = credit.Ledger
 type Grant = credit.Grant
+type GrantAmendment = credit.GrantAmendment
 type HighWatermark = credit.HighWatermark
 type Reset = credit.Reset
 type Feature = credit.Feature
+type FeatureUpdate = credit.FeatureUpdate
+type FeatureFilterVersion = credit.FeatureFilterVersion
+type UnitPrice = credit.UnitPrice
+type PriceTier = credit.PriceTier
+type MonetaryUsage = credit.MonetaryUsage
 type Balance = credit.Balance
+type BalanceSnapshot = credit.BalanceSnapshot
+type Entitlement = credit.Entitlement
 type LedgerEntryList = credit.LedgerEntryList
 type NamespacedLedgerID = credit.NamespacedLedgerID
 type NamespacedGrantID = credit.NamespacedGrantID
@@ -24,5 +32,7 @@ type ListLedgersParams = credit.ListLedgersParams
 type GetLedgerHistoryRequest = creditdriver.GetLedgerHistoryRequest
 type GetLedgerBalanceRequest = creditdriver.GetLedgerBalanceRequest
 type GetLedgerBalaceHandlerParams = creditdriver.GetLedgerBalaceHandlerParams
+type CheckLedgerEntitlementRequest = creditdriver.CheckLedgerEntitlementRequest
+type UpdateFeatureRequest = creditdriver.UpdateFeatureRequest
 type GrantPathParams = creditdriver.GrantPathParams
 type ListLedgerGrantsByLedgerParams = creditdriver.ListLedgerGrantsByLedgerParams
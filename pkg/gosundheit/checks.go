@@ -0,0 +1,84 @@
+package gosundheit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	health "github.com/AppsFlyer/go-sundheit"
+	"github.com/AppsFlyer/go-sundheit/checks"
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+// defaultCheckTimeout is used when the check context has no deadline
+const defaultCheckTimeout = 5 * time.Second
+
+// KafkaClient is a Kafka producer, consumer or admin client.
+type KafkaClient interface {
+	GetMetadata(topic *string, allTopics bool, timeoutMs int) (*kafka.Metadata, error)
+}
+
+// NewKafkaCheck returns a check that fetches cluster metadata through the client.
+// It fails if the client cannot reach any broker.
+func NewKafkaCheck(name string, client KafkaClient) health.Check {
+	return &checks.CustomCheck{
+		CheckName: name,
+		CheckFunc: func(ctx context.Context) (interface{}, error) {
+			timeout := defaultCheckTimeout
+			if deadline, ok := ctx.Deadline(); ok {
+				timeout = time.Until(deadline)
+			}
+
+			metadata, err := client.GetMetadata(nil, false, int(timeout.Milliseconds()))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get kafka metadata: %w", err)
+			}
+
+			if len(metadata.Brokers) == 0 {
+				return nil, fmt.Errorf("no kafka brokers available")
+			}
+
+			return map[string]int{"brokers": len(metadata.Brokers)}, nil
+		},
+	}
+}
+
+// NewClickHouseCheck returns a check that pings ClickHouse and reports the server version.
+func NewClickHouseCheck(name string, conn clickhouse.Conn) health.Check {
+	return &checks.CustomCheck{
+		CheckName: name,
+		CheckFunc: func(ctx context.Context) (interface{}, error) {
+			if err := conn.Ping(ctx); err != nil {
+				return nil, fmt.Errorf("failed to ping clickhouse: %w", err)
+			}
+
+			version, err := conn.ServerVersion()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get clickhouse server version: %w", err)
+			}
+
+			return map[string]string{"version": version.String()}, nil
+		},
+	}
+}
+
+// NewPingCheck returns a check that fails if the ping fails.
+func NewPingCheck(name string, ping checks.PingContextFunc) health.Check {
+	return &checks.CustomCheck{
+		CheckName: name,
+		CheckFunc: func(ctx context.Context) (interface{}, error) {
+			return nil, ping(ctx)
+		},
+	}
+}
+
+// RegisterCheck registers a check of an external dependency,
+// it runs periodically and fails the readiness of the application until it passes.
+func RegisterCheck(checker health.Health, check health.Check) error {
+	return checker.RegisterCheck(
+		check,
+		health.ExecutionPeriod(10*time.Second),
+		health.ExecutionTimeout(defaultCheckTimeout),
+	)
+}
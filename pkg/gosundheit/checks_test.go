@@ -0,0 +1,44 @@
+package gosundheit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/pkg/gosundheit"
+)
+
+func TestKafkaCheck(t *testing.T) {
+	cluster, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer cluster.Close()
+
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": cluster.BootstrapServers()})
+	require.NoError(t, err)
+	defer producer.Close()
+
+	check := gosundheit.NewKafkaCheck("kafka-producer", producer)
+	assert.Equal(t, "kafka-producer", check.Name())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	details, err := check.Execute(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"brokers": 1}, details)
+
+	// Unreachable brokers fail the check once the check times out
+	unreachable, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": "127.0.0.1:1"})
+	require.NoError(t, err)
+	defer unreachable.Close()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	_, err = gosundheit.NewKafkaCheck("kafka-producer", unreachable).Execute(ctx)
+	assert.Error(t, err)
+}
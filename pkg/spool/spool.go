@@ -0,0 +1,520 @@
+// Package spool implements a durable on-disk FIFO queue (write-ahead spool).
+//
+// Records are appended to segment files. Each record is framed with its length and a CRC32 checksum,
+// so a partially written record at the end of the spool (eg. after a crash) is detected and discarded on open.
+//
+// Records are read with [Spool.Peek] and removed with [Spool.Commit].
+// The read position is persisted on commit, records peeked but not committed before a crash are read again (at-least-once).
+package spool
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	segmentExt     = ".seg"
+	cursorFileName = "cursor"
+
+	headerSize = 8
+
+	defaultMaxSegmentSize = 64 * 1024 * 1024
+
+	// maxRecordSize protects against allocating huge buffers when reading a corrupt header
+	maxRecordSize = 64 * 1024 * 1024
+)
+
+// ErrClosed is returned when operating on a closed spool.
+var ErrClosed = errors.New("spool is closed")
+
+// Options configures a [Spool].
+type Options struct {
+	// MaxSegmentSize is the size in bytes after which a new segment file is started.
+	MaxSegmentSize int64
+	// Sync makes every append and commit wait for the data to be flushed to disk.
+	Sync bool
+}
+
+type position struct {
+	Segment uint64 `json:"segment"`
+	Offset  int64  `json:"offset"`
+}
+
+// Spool is a durable FIFO queue of records backed by append-only segment files.
+type Spool struct {
+	dir  string
+	opts Options
+
+	mu sync.Mutex
+
+	// segments lists the ids of existing segment files in ascending order
+	segments []uint64
+	// segmentEnds holds the end of valid data in sealed segments
+	segmentEnds map[uint64]int64
+
+	writer      *os.File
+	writeOffset int64
+
+	cursor position
+	// peeked holds the position after each record returned by the last Peek
+	peeked []position
+
+	length int64
+	closed bool
+}
+
+// Open opens (or creates) a spool in the given directory.
+func Open(dir string, opts Options) (*Spool, error) {
+	if opts.MaxSegmentSize <= 0 {
+		opts.MaxSegmentSize = defaultMaxSegmentSize
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating spool directory: %w", err)
+	}
+
+	s := &Spool{
+		dir:         dir,
+		opts:        opts,
+		segmentEnds: make(map[uint64]int64),
+	}
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Spool) load() error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("reading spool directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), segmentExt)
+		if !ok {
+			continue
+		}
+
+		id, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		s.segments = append(s.segments, id)
+	}
+
+	slices.Sort(s.segments)
+
+	cursor, err := s.readCursor()
+	if err != nil {
+		return err
+	}
+
+	s.cursor = cursor
+
+	// Remove segments that were fully consumed before the last shutdown
+	for len(s.segments) > 0 && s.segments[0] < s.cursor.Segment {
+		if err := os.Remove(s.segmentPath(s.segments[0])); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("removing consumed segment: %w", err)
+		}
+
+		s.segments = s.segments[1:]
+	}
+
+	if len(s.segments) == 0 {
+		s.segments = []uint64{s.cursor.Segment}
+	} else if s.cursor.Segment < s.segments[0] {
+		s.cursor = position{Segment: s.segments[0]}
+	}
+
+	// Validate segments and count unconsumed records
+	for i, id := range s.segments {
+		start := int64(0)
+		if id == s.cursor.Segment {
+			start = s.cursor.Offset
+		}
+
+		end, count, err := s.scanSegment(id, start)
+		if err != nil {
+			return err
+		}
+
+		s.length += count
+
+		// Discard a partially written record at the end of the last segment
+		if i == len(s.segments)-1 {
+			if err := s.openWriter(id, end); err != nil {
+				return err
+			}
+		} else {
+			s.segmentEnds[id] = end
+		}
+	}
+
+	return nil
+}
+
+func (s *Spool) readCursor() (position, error) {
+	var cursor position
+
+	data, err := os.ReadFile(filepath.Join(s.dir, cursorFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		if len(s.segments) > 0 {
+			cursor.Segment = s.segments[0]
+		}
+
+		return cursor, nil
+	}
+	if err != nil {
+		return cursor, fmt.Errorf("reading spool cursor: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return cursor, fmt.Errorf("decoding spool cursor: %w", err)
+	}
+
+	return cursor, nil
+}
+
+func (s *Spool) writeCursor() error {
+	data, err := json.Marshal(s.cursor)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(s.dir, cursorFileName)
+
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return fmt.Errorf("writing spool cursor: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(data); err != nil {
+		return fmt.Errorf("writing spool cursor: %w", err)
+	}
+
+	if s.opts.Sync {
+		if err := f.Sync(); err != nil {
+			return fmt.Errorf("syncing spool cursor: %w", err)
+		}
+	}
+
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return fmt.Errorf("writing spool cursor: %w", err)
+	}
+
+	return nil
+}
+
+// scanSegment returns the end of valid data in a segment and the number of records after start.
+func (s *Spool) scanSegment(id uint64, start int64) (int64, int64, error) {
+	f, err := os.OpenFile(s.segmentPath(id), os.O_RDONLY|os.O_CREATE, 0o644)
+	if err != nil {
+		return 0, 0, fmt.Errorf("opening segment: %w", err)
+	}
+	defer f.Close()
+
+	var (
+		offset int64
+		count  int64
+	)
+
+	for {
+		size, err := readRecord(f, nil)
+		if err != nil {
+			// Anything after an invalid record is unreadable
+			return offset, count, nil
+		}
+
+		if offset >= start {
+			count++
+		}
+
+		offset += headerSize + size
+	}
+}
+
+func (s *Spool) openWriter(id uint64, offset int64) error {
+	f, err := os.OpenFile(s.segmentPath(id), os.O_WRONLY|os.O_CREATE, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening segment: %w", err)
+	}
+
+	if err := f.Truncate(offset); err != nil {
+		_ = f.Close()
+
+		return fmt.Errorf("truncating segment: %w", err)
+	}
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		_ = f.Close()
+
+		return fmt.Errorf("seeking segment: %w", err)
+	}
+
+	s.writer = f
+	s.writeOffset = offset
+
+	return nil
+}
+
+func (s *Spool) segmentPath(id uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", id, segmentExt))
+}
+
+// Append adds a record to the end of the spool.
+func (s *Spool) Append(record []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	if len(record) > maxRecordSize {
+		return fmt.Errorf("record size %d exceeds maximum %d", len(record), maxRecordSize)
+	}
+
+	if s.writeOffset > 0 && s.writeOffset+headerSize+int64(len(record)) > s.opts.MaxSegmentSize {
+		if err := s.rollSegment(); err != nil {
+			return err
+		}
+	}
+
+	buf := make([]byte, headerSize+len(record))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(record)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(record))
+	copy(buf[headerSize:], record)
+
+	if _, err := s.writer.Write(buf); err != nil {
+		return fmt.Errorf("writing record: %w", err)
+	}
+
+	if s.opts.Sync {
+		if err := s.writer.Sync(); err != nil {
+			return fmt.Errorf("syncing segment: %w", err)
+		}
+	}
+
+	s.writeOffset += int64(len(buf))
+	s.length++
+
+	return nil
+}
+
+func (s *Spool) rollSegment() error {
+	current := s.segments[len(s.segments)-1]
+
+	if err := s.writer.Close(); err != nil {
+		return fmt.Errorf("closing segment: %w", err)
+	}
+
+	s.segmentEnds[current] = s.writeOffset
+
+	next := current + 1
+	if err := s.openWriter(next, 0); err != nil {
+		return err
+	}
+
+	s.segments = append(s.segments, next)
+
+	return nil
+}
+
+// Peek returns up to n records from the start of the spool without removing them.
+func (s *Spool) Peek(n int) ([][]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, ErrClosed
+	}
+
+	s.peeked = s.peeked[:0]
+
+	var records [][]byte
+
+	pos := s.cursor
+
+	for len(records) < n {
+		end, ok := s.segmentEnds[pos.Segment]
+		if !ok {
+			// Active segment
+			end = s.writeOffset
+		}
+
+		if pos.Offset >= end {
+			i, found := slices.BinarySearch(s.segments, pos.Segment)
+			if !found || i == len(s.segments)-1 {
+				break
+			}
+
+			pos = position{Segment: s.segments[i+1]}
+
+			continue
+		}
+
+		segmentRecords, positions, err := s.readSegment(pos, end, n-len(records))
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, segmentRecords...)
+		s.peeked = append(s.peeked, positions...)
+
+		pos = position{Segment: pos.Segment, Offset: end}
+	}
+
+	return records, nil
+}
+
+func (s *Spool) readSegment(pos position, end int64, n int) ([][]byte, []position, error) {
+	f, err := os.Open(s.segmentPath(pos.Segment))
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening segment: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(pos.Offset, io.SeekStart); err != nil {
+		return nil, nil, fmt.Errorf("seeking segment: %w", err)
+	}
+
+	var (
+		records   [][]byte
+		positions []position
+	)
+
+	offset := pos.Offset
+
+	for len(records) < n && offset < end {
+		var record []byte
+
+		size, err := readRecord(f, &record)
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading record: %w", err)
+		}
+
+		offset += headerSize + size
+
+		records = append(records, record)
+		positions = append(positions, position{Segment: pos.Segment, Offset: offset})
+	}
+
+	return records, positions, nil
+}
+
+// readRecord reads a single record and returns its size. The record is only decoded if data is not nil.
+func readRecord(r io.Reader, data *[]byte) (int64, error) {
+	var header [headerSize]byte
+
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, err
+	}
+
+	size := binary.BigEndian.Uint32(header[0:4])
+	if size > maxRecordSize {
+		return 0, errors.New("invalid record size")
+	}
+
+	record := make([]byte, size)
+
+	if _, err := io.ReadFull(r, record); err != nil {
+		return 0, err
+	}
+
+	if crc32.ChecksumIEEE(record) != binary.BigEndian.Uint32(header[4:8]) {
+		return 0, errors.New("record checksum mismatch")
+	}
+
+	if data != nil {
+		*data = record
+	}
+
+	return int64(size), nil
+}
+
+// Commit removes the first n records returned by the last [Spool.Peek] from the spool.
+func (s *Spool) Commit(n int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	if n <= 0 {
+		return nil
+	}
+
+	if n > len(s.peeked) {
+		return fmt.Errorf("cannot commit %d records, only %d peeked", n, len(s.peeked))
+	}
+
+	s.cursor = s.peeked[n-1]
+	s.peeked = s.peeked[:0]
+	s.length -= int64(n)
+
+	// Move past the end of a fully consumed sealed segment
+	if end, ok := s.segmentEnds[s.cursor.Segment]; ok && s.cursor.Offset >= end {
+		if i, _ := slices.BinarySearch(s.segments, s.cursor.Segment); i < len(s.segments)-1 {
+			s.cursor = position{Segment: s.segments[i+1]}
+		}
+	}
+
+	if err := s.writeCursor(); err != nil {
+		return err
+	}
+
+	return s.removeConsumedSegments()
+}
+
+func (s *Spool) removeConsumedSegments() error {
+	for len(s.segments) > 1 && s.segments[0] < s.cursor.Segment {
+		id := s.segments[0]
+
+		if err := os.Remove(s.segmentPath(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("removing consumed segment: %w", err)
+		}
+
+		delete(s.segmentEnds, id)
+		s.segments = s.segments[1:]
+	}
+
+	return nil
+}
+
+// Len returns the number of records in the spool.
+func (s *Spool) Len() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.length
+}
+
+// Close closes the spool.
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+
+	return s.writer.Close()
+}
@@ -0,0 +1,138 @@
+package spool_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openmeterio/openmeter/pkg/spool"
+)
+
+func TestSpool(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := spool.Open(dir, spool.Options{MaxSegmentSize: 64})
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, s.Append([]byte(fmt.Sprintf("record-%d", i))))
+	}
+
+	assert.Equal(t, int64(10), s.Len())
+
+	records, err := s.Peek(4)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("record-0"), []byte("record-1"), []byte("record-2"), []byte("record-3")}, records)
+
+	// Peek does not remove records
+	records, err = s.Peek(1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("record-0")}, records)
+
+	records, err = s.Peek(4)
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+
+	require.NoError(t, s.Commit(3))
+	assert.Equal(t, int64(7), s.Len())
+
+	records, err = s.Peek(100)
+	require.NoError(t, err)
+	require.Len(t, records, 7)
+	assert.Equal(t, []byte("record-3"), records[0])
+	assert.Equal(t, []byte("record-9"), records[6])
+
+	require.NoError(t, s.Commit(7))
+	assert.Equal(t, int64(0), s.Len())
+
+	records, err = s.Peek(100)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	// Consumed segments are removed
+	segments, err := filepath.Glob(filepath.Join(dir, "*.seg"))
+	require.NoError(t, err)
+	assert.Len(t, segments, 1)
+
+	require.NoError(t, s.Close())
+}
+
+func TestSpool_Reopen(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := spool.Open(dir, spool.Options{MaxSegmentSize: 64, Sync: true})
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, s.Append([]byte(fmt.Sprintf("record-%d", i))))
+	}
+
+	_, err = s.Peek(5)
+	require.NoError(t, err)
+	require.NoError(t, s.Commit(5))
+
+	// Peeked but not committed records are read again after reopening
+	_, err = s.Peek(2)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Close())
+
+	s, err = spool.Open(dir, spool.Options{MaxSegmentSize: 64})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(5), s.Len())
+
+	records, err := s.Peek(100)
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+	assert.Equal(t, []byte("record-5"), records[0])
+
+	// New records are appended after existing ones
+	require.NoError(t, s.Append([]byte("record-10")))
+
+	records, err = s.Peek(100)
+	require.NoError(t, err)
+	require.Len(t, records, 6)
+	assert.Equal(t, []byte("record-10"), records[5])
+
+	require.NoError(t, s.Close())
+}
+
+func TestSpool_PartialWrite(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := spool.Open(dir, spool.Options{})
+	require.NoError(t, err)
+
+	require.NoError(t, s.Append([]byte("record-0")))
+	require.NoError(t, s.Append([]byte("record-1")))
+	require.NoError(t, s.Close())
+
+	segments, err := filepath.Glob(filepath.Join(dir, "*.seg"))
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+
+	// Simulate a crash in the middle of writing a record
+	f, err := os.OpenFile(segments[0], os.O_WRONLY|os.O_APPEND, 0o644)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0, 0, 0, 10, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	s, err = spool.Open(dir, spool.Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2), s.Len())
+
+	require.NoError(t, s.Append([]byte("record-2")))
+
+	records, err := s.Peek(100)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("record-0"), []byte("record-1"), []byte("record-2")}, records)
+
+	require.NoError(t, s.Close())
+}